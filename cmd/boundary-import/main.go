@@ -0,0 +1,70 @@
+// boundary-import は行政区域のGeoJSONから逆ジオコーディング用の範囲（admin_boundaries）を作り直し、
+// 全ルートの出発地点と目的地の地名を求め直す
+//
+//	go run ./cmd/boundary-import data/boundaries/japan.geojson
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/YukiAminaka/cycle-route-backend/config"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/geocoding"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/adminboundary"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalf("Usage: %s <boundaries.geojson>", os.Args[0])
+	}
+	ctx := context.Background()
+
+	boundaries, err := adminboundary.LoadFile(os.Args[1])
+	if err != nil {
+		log.Fatalf("Failed to load admin boundaries: %v", err)
+	}
+
+	conf := config.GetConfig()
+	pool := database.NewDB(conf.DB)
+	defer pool.Close()
+
+	// 取り込みに失敗しても行政区域が空にならないよう、入れ替えは1つのトランザクションで行う
+	q := dbgen.New(pool)
+	txManager := repository.NewTransactionManager(q, pool)
+	err = txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewAdminBoundaryRepository(q).ReplaceAdminBoundaries(ctx, boundaries)
+	})
+	if err != nil {
+		log.Fatalf("Failed to save admin boundaries: %v", err)
+	}
+	log.Printf("Imported %d admin boundaries", len(boundaries))
+
+	ids, err := q.ListRouteIDs(ctx)
+	if err != nil {
+		log.Fatalf("Failed to list routes: %v", err)
+	}
+
+	// 1ルートずつ求め直すため、途中で失敗してもそれまでの地名は残る
+	geocoder := geocoding.NewBoundaryGeocoder(repository.NewAdminBoundaryRepository(q))
+	for _, id := range ids {
+		err := txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+			routeRepo := repository.NewRouteRepository(q)
+			rt, err := routeRepo.GetRouteByID(ctx, id.String())
+			if err != nil {
+				return err
+			}
+			if err := rt.ResolvePlaces(ctx, geocoder); err != nil {
+				return err
+			}
+			return routeRepo.SaveRoutePlaces(ctx, rt)
+		})
+		if err != nil {
+			log.Fatalf("Failed to resolve places for route %s: %v", id, err)
+		}
+	}
+	log.Printf("Resolved places of %d routes", len(ids))
+}
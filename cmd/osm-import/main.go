@@ -0,0 +1,53 @@
+// osm-import はOpenStreetMapのPBF形式の抽出データから、ルート探索用の道路網（road_edges）と
+// ルート沿いのPOI（pois）を作り直す
+// ROUTING_ENGINE=graph で外部のルーティングエンジンを使わずにルートを探索するときや、POIを更新するときに実行する
+//
+//	go run ./cmd/osm-import data/osm/kanto-latest.osm.pbf
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/YukiAminaka/cycle-route-backend/config"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/osmpoi"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/roadgraph"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalf("Usage: %s <extract.osm.pbf>", os.Args[0])
+	}
+	ctx := context.Background()
+
+	edges, err := roadgraph.LoadFile(os.Args[1])
+	if err != nil {
+		log.Fatalf("Failed to load OSM extract: %v", err)
+	}
+	pois, err := osmpoi.LoadFile(os.Args[1])
+	if err != nil {
+		log.Fatalf("Failed to load POIs: %v", err)
+	}
+
+	conf := config.GetConfig()
+	pool := database.NewDB(conf.DB)
+	defer pool.Close()
+
+	// 取り込みに失敗しても探索中の道路網やPOIが空にならないよう、入れ替えは1つのトランザクションで行う
+	q := dbgen.New(pool)
+	txManager := repository.NewTransactionManager(q, pool)
+	err = txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		if err := repository.NewRoadEdgeRepository(q).ReplaceRoadEdges(ctx, edges); err != nil {
+			return err
+		}
+		return repository.NewPOIRepository(q).ReplacePOIs(ctx, pois)
+	})
+	if err != nil {
+		log.Fatalf("Failed to save road edges and POIs: %v", err)
+	}
+	log.Printf("Imported %d road edges and %d POIs", len(edges), len(pois))
+}
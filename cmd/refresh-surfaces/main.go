@@ -0,0 +1,57 @@
+// refresh-surfaces は全ルートの路面の内訳を道路網と照合して作り直し、内訳と勾配を使う難易度も求め直す
+// osm-import で道路網を取り込み直したときや、route_surfaces・難易度の追加前に作成されたルートの内訳を作るときに実行する
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/YukiAminaka/cycle-route-backend/config"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+)
+
+func main() {
+	ctx := context.Background()
+
+	conf := config.GetConfig()
+	pool := database.NewDB(conf.DB)
+	defer pool.Close()
+
+	q := dbgen.New(pool)
+	ids, err := q.ListRouteIDs(ctx)
+	if err != nil {
+		log.Fatalf("Failed to list routes: %v", err)
+	}
+
+	// 1ルートずつ作り直すため、途中で失敗してもそれまでの内訳と難易度は残る
+	txManager := repository.NewTransactionManager(q, pool)
+	for _, id := range ids {
+		err := txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+			routeRepo := repository.NewRouteRepository(q)
+			if err := routeRepo.RefreshSurfaceBreakdown(ctx, id.String()); err != nil {
+				return err
+			}
+			// 難易度は未舗装の割合と勾配も使うため、内訳と合わせて求め直す
+			route, err := routeRepo.GetRouteByID(ctx, id.String())
+			if err != nil {
+				return err
+			}
+			breakdown, err := routeRepo.GetSurfaceBreakdown(ctx, id.String())
+			if err != nil {
+				return err
+			}
+			grades, err := routeRepo.GetRouteGrades(ctx, id.String())
+			if err != nil {
+				return err
+			}
+			route.RefreshDifficulty(breakdown, grades)
+			return routeRepo.SaveRouteDifficulty(ctx, route)
+		})
+		if err != nil {
+			log.Fatalf("Failed to refresh surfaces for route %s: %v", id, err)
+		}
+	}
+	log.Printf("Refreshed surfaces and difficulties of %d routes", len(ids))
+}
@@ -0,0 +1,38 @@
+// reindex-search は全ルートの全文検索用ドキュメントを作り直す
+// トークン化の方法を変えたときや、route_search_documents 追加前に作成されたルートを検索対象にするときに実行する
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/YukiAminaka/cycle-route-backend/config"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/textsearch"
+)
+
+func main() {
+	ctx := context.Background()
+
+	conf := config.GetConfig()
+	pool := database.NewDB(conf.DB)
+	defer pool.Close()
+
+	q := dbgen.New(pool)
+	sources, err := q.ListRouteSearchSources(ctx)
+	if err != nil {
+		log.Fatalf("Failed to list routes: %v", err)
+	}
+
+	for _, src := range sources {
+		err := q.UpsertRouteSearchDocument(ctx, dbgen.UpsertRouteSearchDocumentParams{
+			RouteID:    src.ID,
+			SearchText: textsearch.Document(src.Name, src.Description, src.RoadNames),
+		})
+		if err != nil {
+			log.Fatalf("Failed to save search document for route %s: %v", src.ID, err)
+		}
+	}
+	log.Printf("Reindexed %d routes", len(sources))
+}
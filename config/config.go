@@ -13,6 +13,7 @@ import (
 type Config struct {
 	DB     DBConfig
 	Server Server
+	Routing Routing
 }
 
 type DBConfig struct {
@@ -26,6 +27,15 @@ type Server struct {
 	KratosPublicUrl string `env:"KRATOS_PUBLIC_URL" envDefault:"http://kratos:4433"`
 }
 
+// ルート探索に使うルーティングエンジン
+type Routing struct {
+	Engine  string `env:"ROUTING_ENGINE" envDefault:"osrm"` // osrm、graph（取り込んだOSMの道路網を探索する）または fake（経由地を直線で結ぶ）
+	OSRMUrl string `env:"OSRM_URL" envDefault:"http://osrm:5000"`
+	// 条件ごとに別のプロファイルで起動したOSRMを使う場合に指定する。未指定の場合はOSRM_URLを使う
+	OSRMGravelUrl        string `env:"OSRM_GRAVEL_URL"`
+	OSRMAvoidHighwaysUrl string `env:"OSRM_AVOID_HIGHWAYS_URL"`
+}
+
 // 読み込み
 var (
 	cfg  Config
@@ -0,0 +1,2 @@
+-- Create index "routes_bbox_idx" to table: "routes"
+CREATE INDEX "routes_bbox_idx" ON "public"."routes" USING gist ("bbox");
@@ -0,0 +1,2 @@
+-- Create index "route_likes_route_id_idx" to table: "route_likes"
+CREATE INDEX "route_likes_route_id_idx" ON "public"."route_likes" ("route_id");
@@ -0,0 +1,13 @@
+-- Create index "routes_name_trgm_idx" to table: "routes"
+CREATE INDEX "routes_name_trgm_idx" ON "public"."routes" USING gin ("name" gin_trgm_ops);
+-- Create "route_search_documents" table
+CREATE TABLE "public"."route_search_documents" (
+  "route_id" uuid NOT NULL,
+  "search_text" text NOT NULL DEFAULT '',
+  "search_vector" tsvector NULL GENERATED ALWAYS AS (to_tsvector('simple'::regconfig, search_text)) STORED,
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("route_id"),
+  CONSTRAINT "route_search_documents_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "route_search_documents_search_vector_idx" to table: "route_search_documents"
+CREATE INDEX "route_search_documents_search_vector_idx" ON "public"."route_search_documents" USING gin ("search_vector");
@@ -0,0 +1,25 @@
+-- Create "route_versions" table
+CREATE TABLE "public"."route_versions" (
+  "id" uuid NOT NULL,
+  "route_id" uuid NOT NULL,
+  "version_number" integer NOT NULL,
+  "user_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "highlighted_photo_id" bigint NULL DEFAULT 0,
+  "distance" double precision NOT NULL,
+  "duration" double precision NOT NULL,
+  "elevation_gain" double precision NOT NULL,
+  "elevation_loss" double precision NOT NULL,
+  "path_geom" public.geometry(LineString,4326) NOT NULL,
+  "first_point" public.geometry(Point,4326) NOT NULL,
+  "last_point" public.geometry(Point,4326) NOT NULL,
+  "visibility" smallint NOT NULL,
+  "course_points" jsonb NOT NULL DEFAULT '[]',
+  "waypoints" jsonb NOT NULL DEFAULT '[]',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "route_versions_route_id_version_number_key" UNIQUE ("route_id", "version_number"),
+  CONSTRAINT "route_versions_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "route_versions_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
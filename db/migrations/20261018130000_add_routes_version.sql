@@ -0,0 +1,2 @@
+-- Modify "routes" table
+ALTER TABLE "public"."routes" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
@@ -0,0 +1,4 @@
+-- Modify "routes" table
+ALTER TABLE "public"."routes" ADD COLUMN "forked_from_route_id" uuid NULL, ADD CONSTRAINT "routes_forked_from_route_id_fkey" FOREIGN KEY ("forked_from_route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "routes_forked_from_route_id_idx" to table: "routes"
+CREATE INDEX "routes_forked_from_route_id_idx" ON "public"."routes" ("forked_from_route_id");
@@ -0,0 +1,19 @@
+-- Create "road_edges" table
+CREATE TABLE "public"."road_edges" (
+  "id" bigserial NOT NULL,
+  "osm_way_id" bigint NOT NULL,
+  "source_node_id" bigint NOT NULL,
+  "target_node_id" bigint NOT NULL,
+  "name" text NULL,
+  "highway" text NOT NULL,
+  "surface" text NULL,
+  "bike_lane" boolean NOT NULL DEFAULT false,
+  "oneway" boolean NOT NULL DEFAULT false,
+  "length_m" double precision NOT NULL,
+  "elevation_gain" double precision NOT NULL DEFAULT 0,
+  "elevation_loss" double precision NOT NULL DEFAULT 0,
+  "geom" public.geometry(LineString,4326) NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "road_edges_geom_idx" to table: "road_edges"
+CREATE INDEX "road_edges_geom_idx" ON "public"."road_edges" USING gist ("geom");
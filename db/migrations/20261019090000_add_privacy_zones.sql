@@ -0,0 +1,14 @@
+-- Create "privacy_zones" table
+CREATE TABLE "public"."privacy_zones" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "name" text NOT NULL DEFAULT '',
+  "center" public.geometry(Point,4326) NOT NULL,
+  "radius_m" double precision NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "privacy_zones_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "privacy_zones_radius_m_check" CHECK (radius_m > (0)::double precision)
+);
+-- Create index "privacy_zones_user_id_idx" to table: "privacy_zones"
+CREATE INDEX "privacy_zones_user_id_idx" ON "public"."privacy_zones" ("user_id");
@@ -0,0 +1,11 @@
+-- Create "route_surfaces" table
+CREATE TABLE "public"."route_surfaces" (
+  "route_id" uuid NOT NULL,
+  "kind" text NOT NULL,
+  "category" text NOT NULL,
+  "distance" double precision NOT NULL,
+  PRIMARY KEY ("route_id", "kind", "category"),
+  CONSTRAINT "route_surfaces_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "route_surfaces_distance_check" CHECK (distance >= (0)::double precision),
+  CONSTRAINT "route_surfaces_kind_check" CHECK (kind = ANY (ARRAY['surface'::text, 'road_class'::text]))
+);
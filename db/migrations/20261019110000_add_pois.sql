@@ -0,0 +1,14 @@
+-- Create "pois" table
+CREATE TABLE "public"."pois" (
+  "id" bigserial NOT NULL,
+  "osm_type" text NOT NULL,
+  "osm_id" bigint NOT NULL,
+  "category" text NOT NULL,
+  "name" text NULL,
+  "location" public.geometry(Point,4326) NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "pois_osm_type_osm_id_key" UNIQUE ("osm_type", "osm_id"),
+  CONSTRAINT "pois_osm_type_check" CHECK (osm_type = ANY (ARRAY['node'::text, 'way'::text]))
+);
+-- Create index "pois_location_idx" to table: "pois"
+CREATE INDEX "pois_location_idx" ON "public"."pois" USING gist (((location)::public.geography));
@@ -0,0 +1,27 @@
+-- Create "admin_boundaries" table
+CREATE TABLE "public"."admin_boundaries" (
+  "id" bigserial NOT NULL,
+  "name" text NOT NULL,
+  "admin_level" smallint NOT NULL,
+  "country_code" character(2) NOT NULL,
+  "geom" public.geometry(MultiPolygon,4326) NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "admin_boundaries_admin_level_check" CHECK ((admin_level >= 1) AND (admin_level <= 11))
+);
+-- Create index "admin_boundaries_geom_idx" to table: "admin_boundaries"
+CREATE INDEX "admin_boundaries_geom_idx" ON "public"."admin_boundaries" USING gist ("geom");
+-- Create "route_places" table
+CREATE TABLE "public"."route_places" (
+  "route_id" uuid NOT NULL,
+  "kind" text NOT NULL,
+  "locality" text NOT NULL DEFAULT '',
+  "administrative_area" text NOT NULL DEFAULT '',
+  "country_code" character(2) NOT NULL,
+  PRIMARY KEY ("route_id", "kind"),
+  CONSTRAINT "route_places_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "route_places_kind_check" CHECK (kind = ANY (ARRAY['start'::text, 'end'::text]))
+);
+-- Create index "route_places_administrative_area_idx" to table: "route_places"
+CREATE INDEX "route_places_administrative_area_idx" ON "public"."route_places" ("administrative_area" text_pattern_ops);
+-- Create index "route_places_locality_idx" to table: "route_places"
+CREATE INDEX "route_places_locality_idx" ON "public"."route_places" ("locality" text_pattern_ops);
@@ -0,0 +1,2 @@
+-- Modify "routes" table
+ALTER TABLE "public"."routes" ADD COLUMN "difficulty" smallint NULL, ADD CONSTRAINT "routes_difficulty_check" CHECK ((difficulty >= 1) AND (difficulty <= 4));
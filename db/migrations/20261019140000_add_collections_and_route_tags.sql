@@ -0,0 +1,38 @@
+-- Create "collections" table
+CREATE TABLE "public"."collections" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "visibility" smallint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "collections_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "collections_visibility_check" CHECK (visibility = ANY (ARRAY[0, 1, 2]))
+);
+-- Create index "collections_user_id_idx" to table: "collections"
+CREATE INDEX "collections_user_id_idx" ON "public"."collections" ("user_id");
+-- Create "collection_routes" table
+CREATE TABLE "public"."collection_routes" (
+  "collection_id" uuid NOT NULL,
+  "route_id" uuid NOT NULL,
+  "position" integer NOT NULL,
+  PRIMARY KEY ("collection_id", "route_id"),
+  CONSTRAINT "collection_routes_collection_id_fkey" FOREIGN KEY ("collection_id") REFERENCES "public"."collections" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "collection_routes_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "collection_routes_position_check" CHECK (position >= 0)
+);
+-- Create index "collection_routes_route_id_idx" to table: "collection_routes"
+CREATE INDEX "collection_routes_route_id_idx" ON "public"."collection_routes" ("route_id");
+-- Create "route_tags" table
+CREATE TABLE "public"."route_tags" (
+  "route_id" uuid NOT NULL,
+  "tag" text NOT NULL,
+  "position" integer NOT NULL,
+  PRIMARY KEY ("route_id", "tag"),
+  CONSTRAINT "route_tags_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "route_tags_position_check" CHECK (position >= 0)
+);
+-- Create index "route_tags_tag_idx" to table: "route_tags"
+CREATE INDEX "route_tags_tag_idx" ON "public"."route_tags" ("tag");
@@ -0,0 +1,28 @@
+-- Create "tours" table
+CREATE TABLE "public"."tours" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "visibility" smallint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tours_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tours_visibility_check" CHECK (visibility = ANY (ARRAY[0, 1, 2]))
+);
+-- Create index "tours_user_id_idx" to table: "tours"
+CREATE INDEX "tours_user_id_idx" ON "public"."tours" ("user_id");
+-- Create "tour_stages" table
+CREATE TABLE "public"."tour_stages" (
+  "tour_id" uuid NOT NULL,
+  "position" integer NOT NULL,
+  "route_id" uuid NOT NULL,
+  "overnight_stop" text NOT NULL DEFAULT '',
+  PRIMARY KEY ("tour_id", "position"),
+  CONSTRAINT "tour_stages_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tour_stages_tour_id_fkey" FOREIGN KEY ("tour_id") REFERENCES "public"."tours" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tour_stages_position_check" CHECK (position >= 0)
+);
+-- Create index "tour_stages_route_id_idx" to table: "tour_stages"
+CREATE INDEX "tour_stages_route_id_idx" ON "public"."tour_stages" ("route_id");
@@ -0,0 +1,57 @@
+-- Create "events" table
+CREATE TABLE "public"."events" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "route_id" uuid NOT NULL,
+  "title" text NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "starts_at" timestamptz NOT NULL,
+  "meeting_point" public.geometry(Point,4326) NOT NULL,
+  "meeting_point_name" text NOT NULL DEFAULT '',
+  "capacity" integer NULL,
+  "visibility" smallint NOT NULL DEFAULT 0,
+  "cancelled_at" timestamptz NULL,
+  "cancel_reason" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "events_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "events_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "events_capacity_check" CHECK (capacity > 0),
+  CONSTRAINT "events_visibility_check" CHECK (visibility = ANY (ARRAY[0, 1, 2]))
+);
+-- Create index "events_meeting_point_idx" to table: "events"
+CREATE INDEX "events_meeting_point_idx" ON "public"."events" USING gist (((meeting_point)::public.geography));
+-- Create index "events_route_id_idx" to table: "events"
+CREATE INDEX "events_route_id_idx" ON "public"."events" ("route_id");
+-- Create index "events_starts_at_idx" to table: "events"
+CREATE INDEX "events_starts_at_idx" ON "public"."events" ("starts_at");
+-- Create index "events_user_id_idx" to table: "events"
+CREATE INDEX "events_user_id_idx" ON "public"."events" ("user_id");
+-- Create "event_comments" table
+CREATE TABLE "public"."event_comments" (
+  "id" uuid NOT NULL,
+  "event_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "body" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "event_comments_event_id_fkey" FOREIGN KEY ("event_id") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "event_comments_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "event_comments_event_id_idx" to table: "event_comments"
+CREATE INDEX "event_comments_event_id_idx" ON "public"."event_comments" ("event_id", "created_at");
+-- Create "event_rsvps" table
+CREATE TABLE "public"."event_rsvps" (
+  "event_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "status" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("event_id", "user_id"),
+  CONSTRAINT "event_rsvps_event_id_fkey" FOREIGN KEY ("event_id") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "event_rsvps_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "event_rsvps_status_check" CHECK (status = ANY (ARRAY['going'::text, 'maybe'::text, 'not_going'::text]))
+);
+-- Create index "event_rsvps_user_id_idx" to table: "event_rsvps"
+CREATE INDEX "event_rsvps_user_id_idx" ON "public"."event_rsvps" ("user_id");
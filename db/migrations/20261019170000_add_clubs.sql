@@ -0,0 +1,51 @@
+-- Create "clubs" table
+CREATE TABLE "public"."clubs" (
+  "id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  "updated_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id")
+);
+-- Create "club_join_requests" table
+CREATE TABLE "public"."club_join_requests" (
+  "club_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "message" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("club_id", "user_id"),
+  CONSTRAINT "club_join_requests_club_id_fkey" FOREIGN KEY ("club_id") REFERENCES "public"."clubs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "club_join_requests_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create "club_members" table
+CREATE TABLE "public"."club_members" (
+  "club_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "role" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("club_id", "user_id"),
+  CONSTRAINT "club_members_club_id_fkey" FOREIGN KEY ("club_id") REFERENCES "public"."clubs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "club_members_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "club_members_role_check" CHECK (role = ANY (ARRAY['owner'::text, 'admin'::text, 'member'::text]))
+);
+-- Create index "club_members_owner_idx" to table: "club_members"
+CREATE UNIQUE INDEX "club_members_owner_idx" ON "public"."club_members" ("club_id") WHERE (role = 'owner'::text);
+-- Create index "club_members_user_id_idx" to table: "club_members"
+CREATE INDEX "club_members_user_id_idx" ON "public"."club_members" ("user_id");
+-- Create "club_routes" table
+CREATE TABLE "public"."club_routes" (
+  "club_id" uuid NOT NULL,
+  "route_id" uuid NOT NULL,
+  "added_by" uuid NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("club_id", "route_id"),
+  CONSTRAINT "club_routes_added_by_fkey" FOREIGN KEY ("added_by") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "club_routes_club_id_fkey" FOREIGN KEY ("club_id") REFERENCES "public"."clubs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "club_routes_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "club_routes_route_id_idx" to table: "club_routes"
+CREATE INDEX "club_routes_route_id_idx" ON "public"."club_routes" ("route_id");
+-- Modify "routes" table
+ALTER TABLE "public"."routes" DROP CONSTRAINT "routes_visibility_check", ADD CONSTRAINT "routes_visibility_check" CHECK (visibility = ANY (ARRAY[0, 1, 2, 3])), ADD COLUMN "club_id" uuid NULL, ADD CONSTRAINT "routes_club_id_fkey" FOREIGN KEY ("club_id") REFERENCES "public"."clubs" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "events" table
+ALTER TABLE "public"."events" DROP CONSTRAINT "events_visibility_check", ADD CONSTRAINT "events_visibility_check" CHECK (visibility = ANY (ARRAY[0, 1, 2, 3])), ADD COLUMN "club_id" uuid NULL, ADD CONSTRAINT "events_club_id_fkey" FOREIGN KEY ("club_id") REFERENCES "public"."clubs" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
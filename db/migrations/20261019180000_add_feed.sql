@@ -0,0 +1,26 @@
+-- Create "user_follows" table
+CREATE TABLE "public"."user_follows" (
+  "follower_id" uuid NOT NULL,
+  "followee_id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("follower_id", "followee_id"),
+  CONSTRAINT "user_follows_followee_id_fkey" FOREIGN KEY ("followee_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "user_follows_follower_id_fkey" FOREIGN KEY ("follower_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "user_follows_check" CHECK (follower_id <> followee_id)
+);
+-- Create index "user_follows_followee_id_idx" to table: "user_follows"
+CREATE INDEX "user_follows_followee_id_idx" ON "public"."user_follows" ("followee_id");
+-- Create "feed_events" table
+CREATE TABLE "public"."feed_events" (
+  "id" uuid NOT NULL,
+  "actor_id" uuid NOT NULL,
+  "event_type" text NOT NULL,
+  "subject_id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "feed_events_actor_id_fkey" FOREIGN KEY ("actor_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "feed_events_actor_id_created_at_idx" to table: "feed_events"
+CREATE INDEX "feed_events_actor_id_created_at_idx" ON "public"."feed_events" ("actor_id", "created_at" DESC, "id" DESC);
+-- Create index "feed_events_subject_id_idx" to table: "feed_events"
+CREATE INDEX "feed_events_subject_id_idx" ON "public"."feed_events" ("subject_id");
@@ -0,0 +1,20 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "muted_notification_types" text[] NOT NULL DEFAULT '{}';
+-- Create "notifications" table
+CREATE TABLE "public"."notifications" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "actor_id" uuid NOT NULL,
+  "type" text NOT NULL,
+  "route_id" uuid NULL,
+  "read_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "notifications_actor_id_fkey" FOREIGN KEY ("actor_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "notifications_route_id_fkey" FOREIGN KEY ("route_id") REFERENCES "public"."routes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "notifications_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "notifications_user_id_created_at_idx" to table: "notifications"
+CREATE INDEX "notifications_user_id_created_at_idx" ON "public"."notifications" ("user_id", "created_at" DESC, "id" DESC);
+-- Create index "notifications_user_id_unread_idx" to table: "notifications"
+CREATE INDEX "notifications_user_id_unread_idx" ON "public"."notifications" ("user_id") WHERE (read_at IS NULL);
@@ -0,0 +1,2 @@
+-- Modify "notifications" table
+ALTER TABLE "public"."notifications" ADD COLUMN "event_id" uuid NULL, ADD CONSTRAINT "notifications_event_id_fkey" FOREIGN KEY ("event_id") REFERENCES "public"."events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,2 @@
+-- Modify "tours" table
+ALTER TABLE "public"."tours" DROP CONSTRAINT "tours_visibility_check", ADD CONSTRAINT "tours_visibility_check" CHECK (visibility = ANY (ARRAY[0, 1, 2, 3])), ADD COLUMN "club_id" uuid NULL, ADD CONSTRAINT "tours_club_id_fkey" FOREIGN KEY ("club_id") REFERENCES "public"."clubs" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
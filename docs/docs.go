@@ -1,5 +1,4 @@
-// Code generated by swaggo/swag. DO NOT EDIT.
-
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
 package docs
 
 import "github.com/swaggo/swag"
@@ -16,73 +15,20 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/routes": {
+        "/clubs": {
             "get": {
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
-                ],
-                "summary": "ユーザーのルート一覧を取得する",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Keyword to search in route names",
-                        "name": "keyword",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Minimum distance filter",
-                        "name": "min_distance",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Maximum distance filter",
-                        "name": "max_distance",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Minimum elevation gain filter",
-                        "name": "min_elevation",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Maximum elevation gain filter",
-                        "name": "max_elevation",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Visibility filter",
-                        "name": "visibility",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Author filter",
-                        "name": "author",
-                        "in": "query"
-                    }
+                    "clubs"
                 ],
+                "summary": "所属するクラブの一覧を取得する",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/route.RouteListResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/club.ClubListResponse"
                         }
                     },
                     "401": {
@@ -97,14 +43,15 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            },
-            "post": {
+                },
                 "security": [
                     {
                         "CookieAuth": []
                     }
-                ],
+                ]
+            },
+            "post": {
+                "description": "作成したユーザーがオーナーになる",
                 "consumes": [
                     "application/json"
                 ],
@@ -112,17 +59,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "clubs"
                 ],
-                "summary": "ルートを作成する",
+                "summary": "クラブを作成する",
                 "parameters": [
                     {
-                        "description": "Create Route Request",
+                        "description": "Create Club Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/route.CreateRouteRequest"
+                            "$ref": "#/definitions/club.ClubRequest"
                         }
                     }
                 ],
@@ -130,7 +77,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/route.RouteResponse"
+                            "$ref": "#/definitions/club.ClubResponse"
                         }
                     },
                     "400": {
@@ -151,85 +98,48 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/routes/explore": {
-            "get": {
+                },
                 "security": [
                     {
                         "CookieAuth": []
                     }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
+                ]
+            }
+        },
+        "/clubs/{club_id}": {
+            "get": {
+                "description": "メンバーでなくても取得でき、参加を申請できる",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "clubs"
                 ],
-                "summary": "ルートを探索する",
+                "summary": "クラブを取得する",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Keyword to search in route names",
-                        "name": "q",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Latitude of the reference point",
-                        "name": "lat",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Longitude of the reference point",
-                        "name": "lng",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Search radius (meters)",
-                        "name": "r",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Minimum distance filter (kilometers)",
-                        "name": "min_distance",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Maximum distance filter (kilometers)",
-                        "name": "max_distance",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Pagination offset",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Club ID",
+                        "name": "club_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/route.RouteListResponse"
+                            "$ref": "#/definitions/club.ClubResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -240,11 +150,15 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/routes/{route_id}": {
-            "get": {
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "オーナーと管理者のみ",
                 "consumes": [
                     "application/json"
                 ],
@@ -252,23 +166,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "clubs"
                 ],
-                "summary": "ルートを取得する",
+                "summary": "クラブの名前と説明を更新する",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Route ID",
-                        "name": "route_id",
+                        "description": "Club ID",
+                        "name": "club_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update Club Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/club.ClubRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/route.RouteResponse"
+                            "$ref": "#/definitions/club.ClubResponse"
                         }
                     },
                     "400": {
@@ -277,6 +200,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -289,54 +224,40 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            },
-            "put": {
+                },
                 "security": [
                     {
                         "CookieAuth": []
                     }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                ]
+            },
+            "delete": {
+                "description": "オーナーのみ。クラブのメンバーのみに公開していたルートとイベントは、所有者だけが閲覧できるようになる",
                 "tags": [
-                    "routes"
+                    "clubs"
                 ],
-                "summary": "ルートを更新する",
+                "summary": "クラブを削除する",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Route ID",
-                        "name": "route_id",
+                        "description": "Club ID",
+                        "name": "club_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update Route Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/route.UpdateRouteRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "204": {
                         "description": "No Content"
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -353,41 +274,38 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            },
-            "delete": {
+                },
                 "security": [
                     {
                         "CookieAuth": []
                     }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
+                ]
+            }
+        },
+        "/clubs/{club_id}/join-requests": {
+            "get": {
+                "description": "オーナーと管理者のみ。古い順に返す",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "routes"
+                    "clubs"
                 ],
-                "summary": "ルートを削除する",
+                "summary": "クラブへの参加申請の一覧を取得する",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Route ID",
-                        "name": "route_id",
+                        "description": "Club ID",
+                        "name": "club_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/club.JoinRequestListResponse"
                         }
                     },
                     "401": {
@@ -396,6 +314,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -408,41 +332,43 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/routes/{route_id}/gpx": {
-            "get": {
+                },
                 "security": [
                     {
                         "CookieAuth": []
                     }
-                ],
+                ]
+            },
+            "post": {
+                "description": "オーナーか管理者が承認するとメンバーになる。申請済みの場合は何もしない",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/gpx+xml"
-                ],
                 "tags": [
-                    "routes"
+                    "clubs"
                 ],
-                "summary": "ルートをGPX形式でエクスポートする",
+                "summary": "クラブへの参加を申請する",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Route ID",
-                        "name": "route_id",
+                        "description": "Club ID",
+                        "name": "club_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Join Club Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/club.JoinClubRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "GPX XML",
-                        "schema": {
-                            "type": "string"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -462,47 +388,132 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "すでにメンバー",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
             }
         },
-        "/users": {
-            "post": {
-                "consumes": [
-                    "application/json"
+        "/clubs/{club_id}/join-requests/{user_id}": {
+            "delete": {
+                "description": "オーナーと管理者のみ",
+                "tags": [
+                    "clubs"
+                ],
+                "summary": "クラブへの参加申請を断る",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Club ID",
+                        "name": "club_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "申請したユーザーのID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/clubs/{club_id}/join-requests/{user_id}/approve": {
+            "post": {
+                "description": "オーナーと管理者のみ。申請したユーザーは一般のメンバーになる",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "clubs"
                 ],
-                "summary": "ユーザーを作成する",
+                "summary": "クラブへの参加申請を承認する",
                 "parameters": [
                     {
-                        "description": "Create User Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user.CreateUserRequest"
-                        }
+                        "type": "string",
+                        "description": "Club ID",
+                        "name": "club_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "申請したユーザーのID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
+                            "$ref": "#/definitions/club.MemberResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -513,31 +524,38 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/users/me": {
-            "get": {
+                },
                 "security": [
                     {
                         "CookieAuth": []
                     }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
+                ]
+            }
+        },
+        "/clubs/{club_id}/members": {
+            "get": {
+                "description": "メンバーのみ。参加した順に返す",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "clubs"
+                ],
+                "summary": "クラブのメンバーの一覧を取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Club ID",
+                        "name": "club_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "ログインユーザーを取得する",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.LoginUserResponse"
+                            "$ref": "#/definitions/club.MemberListResponse"
                         }
                     },
                     "401": {
@@ -546,22 +564,35 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/users/settings/location": {
-            "put": {
+                },
                 "security": [
                     {
                         "CookieAuth": []
                     }
-                ],
+                ]
+            }
+        },
+        "/clubs/{club_id}/members/{user_id}": {
+            "put": {
+                "description": "オーナーのみ。オーナーの交代はできない",
                 "consumes": [
                     "application/json"
                 ],
@@ -569,23 +600,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "clubs"
                 ],
-                "summary": "ユーザーの位置情報を更新する",
+                "summary": "メンバーの役割を変更する",
                 "parameters": [
                     {
-                        "description": "Update User Location Request",
+                        "type": "string",
+                        "description": "Club ID",
+                        "name": "club_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Change Member Role Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user.UpdateUserLocationRequest"
+                            "$ref": "#/definitions/club.ChangeMemberRoleRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/club.MemberResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -599,55 +647,71 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/users/settings/profile": {
-            "put": {
+                },
                 "security": [
                     {
                         "CookieAuth": []
                     }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                ]
+            },
+            "delete": {
+                "description": "自分を指定した場合はクラブを抜ける。オーナーは他のメンバーを、管理者は一般のメンバーを外せる。オーナーは抜けられない",
                 "tags": [
-                    "users"
+                    "clubs"
                 ],
-                "summary": "ユーザープロフィールを更新する",
+                "summary": "メンバーをクラブから外す",
                 "parameters": [
                     {
-                        "description": "Update User Profile Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user.UpdateUserProfileRequest"
-                        }
+                        "type": "string",
+                        "description": "Club ID",
+                        "name": "club_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "204": {
                         "description": "No Content"
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -658,26 +722,29 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
             }
         },
-        "/users/{id}": {
+        "/clubs/{club_id}/routes": {
             "get": {
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "メンバーのみ。追加した新しい順に、閲覧できるルートだけを返す",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "clubs"
                 ],
-                "summary": "ユーザーの公開プロフィールを取得する",
+                "summary": "共有ルートライブラリのルートの一覧を取得する",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Club ID",
+                        "name": "club_id",
                         "in": "path",
                         "required": true
                     }
@@ -686,11 +753,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
+                            "$ref": "#/definitions/club.LibraryRouteListResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -707,104 +780,6893 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "response.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 400
                 },
-                "msg": {
-                    "type": "string",
-                    "example": "Bad Request"
-                }
-            }
-        },
-        "route.CoursePointRequest": {
-            "type": "object",
-            "required": [
-                "location"
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "オーナーと管理者のみ。公開ルートか、このクラブのメンバーのみに公開されたルートを追加できる",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clubs"
+                ],
+                "summary": "共有ルートライブラリにルートを追加する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Club ID",
+                        "name": "club_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Add Library Route Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/club.AddLibraryRouteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "他のメンバーが閲覧できないルート",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "追加済みのルート",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/clubs/{club_id}/routes/{route_id}": {
+            "delete": {
+                "description": "オーナーと管理者のみ。ルート自体は削除しない",
+                "tags": [
+                    "clubs"
+                ],
+                "summary": "共有ルートライブラリからルートを取り除く",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Club ID",
+                        "name": "club_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/collections": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "自分のコレクションの一覧を取得する",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "コレクションを作成する",
+                "parameters": [
+                    {
+                        "description": "Create Collection Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/collections/{collection_id}": {
+            "get": {
+                "description": "公開のコレクションは未ログインでも取得できる。中のルートは閲覧できるものだけを返す",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "コレクションを取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Collection ID",
+                        "name": "collection_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "コレクションの名前・説明・公開範囲を更新する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Collection ID",
+                        "name": "collection_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Collection Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "中のルートは削除しない",
+                "tags": [
+                    "collections"
+                ],
+                "summary": "コレクションを削除する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Collection ID",
+                        "name": "collection_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/collections/{collection_id}/routes": {
+            "put": {
+                "description": "コレクション内のすべてのルートを過不足なく、並べたい順に指定する",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "コレクション内のルートを並べ替える",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Collection ID",
+                        "name": "collection_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reorder Routes Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/collection.ReorderCollectionRoutesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "自分のルートのほか、他のユーザーの公開ルートも追加できる",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "コレクションの末尾にルートを追加する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Collection ID",
+                        "name": "collection_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Add Route Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/collection.AddCollectionRouteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "追加済みのルート",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/collections/{collection_id}/routes/{route_id}": {
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "コレクションからルートを取り除く",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Collection ID",
+                        "name": "collection_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/collection.CollectionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/events": {
+            "get": {
+                "description": "公開のイベントを開始日時の早い順に返す。中止したイベントは含まない。lat・lng・rを指定すると集合場所がその範囲内のイベントに絞り込む",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "開催予定のイベントの一覧を取得する",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude of the reference point",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude of the reference point",
+                        "name": "lng",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Search radius from the meeting point (meters)",
+                        "name": "r",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "ルートには自分のルートのほか、他のユーザーの公開ルートも使える。公開のイベントには公開のルートが必要",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントを作成する",
+                "parameters": [
+                    {
+                        "description": "Create Event Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.EventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "閲覧できないルート",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{event_id}": {
+            "get": {
+                "description": "公開のイベントは未ログインでも取得できる。ログインしている場合は自分の返事も返す",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントを取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "すべての項目をまとめて置き換える。中止したイベントは変更できず、上限は参加者数より少なくできない",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントを更新する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Event Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.EventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{event_id}/cancel": {
+            "post": {
+                "description": "参加する・未定と返事したユーザーに中止を知らせる。開始後のイベントは中止できない",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントを中止する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancel Event Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.CancelEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{event_id}/comments": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントへのコメントの一覧を取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.CommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントにコメントする",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.CommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/event.CommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{event_id}/comments/{comment_id}": {
+            "delete": {
+                "description": "削除できるのはコメントした本人とイベントの主催者",
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントへのコメントを削除する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{event_id}/gpx": {
+            "get": {
+                "description": "ルートのGPX出力と同じ内容を返す。イベントとルートの両方を閲覧できる場合に限る",
+                "produces": [
+                    "application/gpx+xml"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントのルートをGPXで出力する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "GPX XML",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/rsvp": {
+            "put": {
+                "description": "返事し直すと上書きする。上限に達している場合は参加するとは返事できない",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントに参加の返事をする",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "RSVP Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event.RSVPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "中止・開始後のイベント、または上限に達している",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/events/{event_id}/rsvps": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "イベントへの返事の一覧を取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event.RSVPListResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/feed": {
+            "get": {
+                "description": "ルートの作成・トリップのアップロード・ルートへのいいね・コメントを新しい順に返す。閲覧できないルートやトリップの出来事は除くため、次のページがあっても件数がlimitより少ないことがある",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "feed"
+                ],
+                "summary": "フォローしているユーザーのアクティビティフィードを取得する",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/feed.FeedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/notifications": {
+            "get": {
+                "description": "自分のルートへのいいね・コメント・フォーク・保存と、自分へのフォローの通知を新しい順に返す",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "自分への通知を取得する",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "未読の通知だけを返す",
+                        "name": "unread_only",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.NotificationListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/notifications/preferences": {
+            "get": {
+                "description": "すべての種類の設定を返す。既定ではすべての種類を受け取る",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "受け取る通知の設定を取得する",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.PreferencesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "指定した種類の設定だけを変更し、変更後のすべての種類の設定を返す。受け取らない種類の通知は作成しない",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "受け取る通知の設定を変更する",
+                "parameters": [
+                    {
+                        "description": "Update Preferences Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notification.UpdatePreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.PreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/notifications/read-all": {
+            "post": {
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "自分への通知をすべて既読にする",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/notifications/unread-count": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "自分への未読の通知の件数を取得する",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/notification.UnreadCountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/notifications/{notification_id}/read": {
+            "post": {
+                "description": "既読の通知を指定してもエラーにしない",
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "通知を既読にする",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "notification_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ユーザーのルート一覧を取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keyword to search in route names, descriptions and road names",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Minimum distance filter",
+                        "name": "min_distance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum distance filter",
+                        "name": "max_distance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Minimum elevation gain filter (meters)",
+                        "name": "min_elevation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum elevation gain filter (meters)",
+                        "name": "max_elevation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Minimum duration filter (seconds)",
+                        "name": "min_duration",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum duration filter (seconds)",
+                        "name": "max_duration",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Minimum climbing ratio filter (elevation gain m per km)",
+                        "name": "min_climbing_ratio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum climbing ratio filter (elevation gain m per km)",
+                        "name": "max_climbing_ratio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum unpaved (gravel and dirt) percentage filter (0-100)",
+                        "name": "max_unpaved_percentage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Place name filter matching the start or end locality / administrative area by prefix",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Visibility filter",
+                        "name": "visibility",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Author name filter (partial match). Only narrows results with collection_id, since other searches return the caller's own routes",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Collection ID filter (includes other users' routes in the collection that the caller can view)",
+                        "name": "collection_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag filter",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "most_liked",
+                            "longest",
+                            "hilliest",
+                            "relevance",
+                            "position"
+                        ],
+                        "type": "string",
+                        "description": "Sort order (default: relevance when keyword given, position when collection_id given, otherwise newest)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "コレクションが存在しないか閲覧できない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートを作成する",
+                "parameters": [
+                    {
+                        "description": "Create Route Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/route.CreateRouteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "共有先のクラブに所属していない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/explore": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートを探索する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keyword to search in route names, descriptions and road names",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Latitude of the reference point",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude of the reference point",
+                        "name": "lng",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Search radius (meters)",
+                        "name": "r",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum distance filter (kilometers)",
+                        "name": "min_distance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum distance filter (kilometers)",
+                        "name": "max_distance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum elevation gain filter (meters)",
+                        "name": "min_elevation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum elevation gain filter (meters)",
+                        "name": "max_elevation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum duration filter (seconds)",
+                        "name": "min_duration",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum duration filter (seconds)",
+                        "name": "max_duration",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum climbing ratio filter (elevation gain m per km)",
+                        "name": "min_climbing_ratio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum climbing ratio filter (elevation gain m per km)",
+                        "name": "max_climbing_ratio",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum unpaved (gravel and dirt) percentage filter (0-100)",
+                        "name": "max_unpaved_percentage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Place name filter matching the start or end locality / administrative area by prefix",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "easy",
+                            "moderate",
+                            "hard",
+                            "expert"
+                        ],
+                        "type": "string",
+                        "description": "Minimum difficulty filter",
+                        "name": "min_difficulty",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "easy",
+                            "moderate",
+                            "hard",
+                            "expert"
+                        ],
+                        "type": "string",
+                        "description": "Maximum difficulty filter",
+                        "name": "max_difficulty",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Author name filter",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "nearest",
+                            "newest",
+                            "most_liked",
+                            "longest",
+                            "hilliest",
+                            "relevance"
+                        ],
+                        "type": "string",
+                        "description": "Sort order (default: nearest when lat/lng given, relevance when q given, otherwise newest)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Collapse near-duplicate routes into one within the page. The page may then have fewer routes than limit even if next_cursor is set",
+                        "name": "collapse",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/plan": {
+            "post": {
+                "description": "ルーティングエンジンで経由地を順に通る経路とコースポイントを探索する。結果は保存しないため、そのままルート作成に使う",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "経由地を通るルートを探索する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "案内文の言語（ja, en）。省略時はユーザーのロケール",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Plan Route Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/route.PlanRouteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.PlanRouteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/saved": {
+            "get": {
+                "description": "保存した日時の新しい順に並ぶ。保存した後に閲覧できなくなったルートは含まないため、1ページの件数がlimitより少ないことがある",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "保存したルートの一覧を取得する",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}": {
+            "get": {
+                "description": "ログインしている場合は、過去のトリップから推定した所要時間（estimated_duration_for_me）も返す。閲覧できないルートは見つからないものとして扱う",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートを取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "ルートのバージョン。更新時にIf-Matchヘッダーに指定する"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートを更新する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ルート取得時のETag",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Route Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/route.UpdateRouteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "共有先のクラブに所属していない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "取得後に別のリクエストで更新されている",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Matchヘッダーがない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートを削除する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/comments": {
+            "get": {
+                "description": "未ログインでも取得できる。閲覧できないルートは見つからないものとして扱う。古い順に並ぶ",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートへのコメントを取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteCommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "コメントはフォロワーのフィードに表示する",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートにコメントする",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Route Comment Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteCommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/comments/{comment_id}": {
+            "delete": {
+                "description": "削除できるのはコメントした本人とルートの所有者",
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートへのコメントを削除する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/cues": {
+            "post": {
+                "description": "path_geomの向きの変化から曲がり角を検出し、コースポイントを置き換える。置き換え前の状態は版として残る",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートの形状からキューシートを生成する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "案内文の言語（ja, en）。省略時はユーザーのロケール",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.CueSheetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/cuesheet.csv": {
+            "get": {
+                "description": "見出しと補う案内文はユーザーのロケールの言語になる。出力したCSVは編集してPUTで取り込める",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "キューシートをCSVで出力する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "距離の単位（km, mi）。省略時はkm",
+                        "name": "unit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "cuesheet.csvで出力したCSVを取り込む。列は見出しで判別し、累積距離・lat・lonの列が必須。置き換え前の状態は版として残る",
+                "consumes": [
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "キューシートのCSVでコースポイントを置き換える",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ルート取得時のETag",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "キューシートのCSV",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.CueSheetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "取得後に別のリクエストで更新されている",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Matchヘッダーがない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/cuesheet.pdf": {
+            "get": {
+                "description": "見出しと補う案内文はユーザーのロケールの言語になる",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "キューシートを印刷用のPDFで出力する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "距離の単位（km, mi）。省略時はkm",
+                        "name": "unit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PDF",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/fork": {
+            "post": {
+                "description": "閲覧できるルートをコースポイント・ウェイポイントごと複製し、ログインユーザーの非公開ルートとして作成する",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートをフォークする",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/gpx": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/gpx+xml"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートをGPX形式でエクスポートする",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "GPX XML",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/join": {
+            "post": {
+                "description": "どちらも自分のルートである必要がある。終点と始点が離れている場合は直線で結ぶ。つなげたルートはそのまま残る",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "別のルートを終点の後ろにつなげる",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Join Routes Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/route.JoinRoutesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/like": {
+            "put": {
+                "description": "閲覧できないルートは見つからないものとして扱う。いいねはフォロワーのフィードに表示する",
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートにいいねする",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "すでにいいねしている",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートへのいいねを取り消す",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "いいねしていない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/pois": {
+            "get": {
+                "description": "経路から指定した距離内にあるカフェ・コンビニ・水飲み場・自転車店・トイレ・展望地を、経路上の位置（cum_dist_m）の順に返す。続きはnext_cursorで取得する",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルート沿いのPOIを取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "経路からの距離(m)。省略時は200、最大2000",
+                        "name": "buffer",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "POIの種類（cafe, convenience, drinking_water, bicycle_shop, toilets, viewpoint）。カンマ区切りで複数指定できる",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.RoutePOIListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/pois/{poi_id}/promote": {
+            "post": {
+                "description": "ウェイポイントは経路に沿った順序の位置に入れ、経路は変更しない。コースポイントは経路に射影した位置に入れ、操作タイプをpoi、修飾子をPOIの種類にする。追加前の状態は版として残る",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルート沿いのPOIをウェイポイントまたはコースポイントにする",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "POI ID",
+                        "name": "poi_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ルート取得時のETag",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Promote POI Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/route.PromotePOIRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "取得後に別のリクエストで更新されている",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Matchヘッダーがない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/reverse": {
+            "post": {
+                "description": "コースポイントは逆順になり、方位角と左右の曲がる向きも反転する。編集前の状態は版として残る",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートの進行方向を反転する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/save": {
+            "put": {
+                "description": "閲覧できないルートは見つからないものとして扱う。保存したことをルートの所有者に通知する",
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートを保存する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "すでに保存している",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートの保存を取り消す",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "保存していない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/similar": {
+            "get": {
+                "description": "未ログインでも取得できる。閲覧できないルートは見つからないものとして扱い、候補は公開のルートだけから探す",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "類似ルートを取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of candidates (default 10, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.SimilarRouteListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/routes/{route_id}/split": {
+            "post": {
+                "description": "指定した地点に最も近い経路上の地点で分割し、元のルートを前半、後半を新しいルートとして作成する",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートを2つに分割する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Split Route Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/route.SplitRouteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "後半の新しいルート",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/tags": {
+            "put": {
+                "description": "タグは前後の空白と先頭の#を取り除き、英字を小文字にして重複を除く。経路は変わらないため、If-Matchヘッダーは不要で版も作らない",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートのタグを置き換える",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Route Tags Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/route.UpdateRouteTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteTagsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/trim": {
+            "post": {
+                "description": "始点からの距離(m)で残す区間を指定する。区間外のコースポイントは削除され、距離と累積距離は再計算される",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートを指定した距離の区間に切り詰める",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Trim Route Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/route.TrimRouteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/versions": {
+            "get": {
+                "description": "更新のたびに保存された更新前の状態を新しい順に返す。差分は「その版の値 - 現在の値」",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートの更新履歴を取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteVersionListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/versions/{version}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートの特定の版を形状付きで取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "版番号",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteVersionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/routes/{route_id}/versions/{version}/restore": {
+            "post": {
+                "description": "復元前の状態も新しい版として履歴に残る",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "routes"
+                ],
+                "summary": "ルートを特定の版の状態に戻す",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Route ID",
+                        "name": "route_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "版番号",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/tours": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tours"
+                ],
+                "summary": "自分のツアーの一覧を取得する",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tour.TourListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "ステージには自分のルートのほか、他のユーザーの公開ルートも使える。同じルートを複数日に使ってもよい",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tours"
+                ],
+                "summary": "ツアーを作成する",
+                "parameters": [
+                    {
+                        "description": "Create Tour Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tour.TourRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/tour.TourResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "閲覧できないルート",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/tours/{tour_id}": {
+            "get": {
+                "description": "公開のツアーは未ログインでも取得できる。ステージと合計は閲覧できるルートのみを対象にする",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tours"
+                ],
+                "summary": "ツアーを取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "tour_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tour.TourResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "名前・説明・公開範囲とステージをまとめて置き換える",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tours"
+                ],
+                "summary": "ツアーを更新する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "tour_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Tour Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tour.TourRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tour.TourResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "ステージのルートは削除しない",
+                "tags": [
+                    "tours"
+                ],
+                "summary": "ツアーを削除する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "tour_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/tours/{tour_id}/fit": {
+            "get": {
+                "description": "ステージごとに1つのラップにし、宿泊地はステージの終点のコースポイントにする。閲覧できるステージのみを含める",
+                "produces": [
+                    "application/vnd.ant.fit"
+                ],
+                "tags": [
+                    "tours"
+                ],
+                "summary": "ツアーをFITのコースで出力する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "tour_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "FIT",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tours/{tour_id}/gpx": {
+            "get": {
+                "description": "ステージごとに1つのトラックにし、宿泊地はステージの終点のウェイポイントにする。閲覧できるステージのみを含める",
+                "produces": [
+                    "application/gpx+xml"
+                ],
+                "tags": [
+                    "tours"
+                ],
+                "summary": "ツアーをGPXで出力する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tour ID",
+                        "name": "tour_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "GPX",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips": {
+            "get": {
+                "description": "作成日時の新しい順に返す",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "自分のトリップの一覧を取得する",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/trip.TripListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "GPXのトラックから経路と距離・時間・獲得標高を求めて保存し、フォロワーのフィードに表示する。複数のトラックは記録順につなげる",
+                "consumes": [
+                    "application/gpx+xml"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "GPXをアップロードしてトリップを作成する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "トリップの名前。省略時はGPXに記録された名前",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "説明",
+                        "name": "description",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "公開範囲（0: 非公開, 1: 公開, 2: 友達のみ）。省略時は非公開",
+                        "name": "visibility",
+                        "in": "query"
+                    },
+                    {
+                        "description": "GPX",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/trip.TripResponseModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/trips/{trip_id}/match": {
+            "post": {
+                "description": "記録したトリップのGPSの軌跡を道路網に照合し、実際に通った道路に沿う経路とコースポイントを返す。結果は保存しないため、そのままルート作成に使う",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "トリップの軌跡を道路網に照合する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "案内文の言語（ja, en）。省略時はユーザーのロケール",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/route.MatchTripResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/trips/{trip_id}/to-route": {
+            "post": {
+                "description": "記録したトリップの軌跡を簡略化し、距離・獲得標高と写真を引き継いだルートを作成する。記録時刻やセンサーの値は含めず、プライバシーゾーン内の始点・終点側の軌跡は取り除く",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "トリップからルートを作成する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trip ID",
+                        "name": "trip_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Convert Trip Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/route.ConvertTripRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/route.RouteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/users": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "ユーザーを作成する",
+                "parameters": [
+                    {
+                        "description": "Create User Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "ログインユーザーを取得する",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.LoginUserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/settings/location": {
+            "put": {
+                "description": "地域・行政区・国コードは位置（geom）を含む行政区域から求める。求められなかった項目にはリクエストの値を使う",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "ユーザーの位置情報を更新する",
+                "parameters": [
+                    {
+                        "description": "Update User Location Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.UpdateUserLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/settings/privacy-zones": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "プライバシーゾーンの一覧を取得する",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.PrivacyZoneListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "範囲内にある軌跡の始点・終点側は、トリップからルートを作るときに取り除かれる",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "プライバシーゾーンを追加する",
+                "parameters": [
+                    {
+                        "description": "Create Privacy Zone Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.CreatePrivacyZoneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/user.PrivacyZoneResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/settings/privacy-zones/{zone_id}": {
+            "delete": {
+                "tags": [
+                    "users"
+                ],
+                "summary": "プライバシーゾーンを削除する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Privacy Zone ID",
+                        "name": "zone_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/settings/profile": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "ユーザープロフィールを更新する",
+                "parameters": [
+                    {
+                        "description": "Update User Profile Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.UpdateUserProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "ユーザーの公開プロフィールを取得する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/follow": {
+            "put": {
+                "description": "フォローしたユーザーのルート作成・トリップ・いいね・コメントがフィードに表示される",
+                "tags": [
+                    "users"
+                ],
+                "summary": "ユーザーをフォローする",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "自分自身はフォローできない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "すでにフォローしている",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "tags": [
+                    "users"
+                ],
+                "summary": "ユーザーのフォローを解除する",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "フォローしていない",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ]
+            }
+        }
+    },
+    "definitions": {
+        "club.AddLibraryRouteRequest": {
+            "type": "object",
+            "required": [
+                "route_id"
+            ],
+            "properties": {
+                "route_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "club.ChangeMemberRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "member"
+                    ]
+                }
+            }
+        },
+        "club.ClubListResponse": {
+            "type": "object",
+            "properties": {
+                "clubs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/club.ClubResponseModel"
+                    }
+                }
+            }
+        },
+        "club.ClubRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "club.ClubResponse": {
+            "type": "object",
+            "properties": {
+                "club": {
+                    "$ref": "#/definitions/club.ClubResponseModel"
+                }
+            }
+        },
+        "club.ClubResponseModel": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "member_count": {
+                    "type": "integer"
+                },
+                "my_role": {
+                    "description": "owner, admin, member。メンバーでない場合は省略",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "club.JoinClubRequest": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "club.JoinRequestListResponse": {
+            "type": "object",
+            "properties": {
+                "join_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/club.JoinRequestResponseModel"
+                    }
+                }
+            }
+        },
+        "club.JoinRequestResponseModel": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "club.LibraryRouteListResponse": {
+            "type": "object",
+            "properties": {
+                "routes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/club.LibraryRouteResponse"
+                    }
+                }
+            }
+        },
+        "club.LibraryRouteResponse": {
+            "type": "object",
+            "properties": {
+                "difficulty": {
+                    "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "polyline": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "integer"
+                }
+            }
+        },
+        "club.MemberListResponse": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/club.MemberResponseModel"
+                    }
+                }
+            }
+        },
+        "club.MemberResponse": {
+            "type": "object",
+            "properties": {
+                "member": {
+                    "$ref": "#/definitions/club.MemberResponseModel"
+                }
+            }
+        },
+        "club.MemberResponseModel": {
+            "type": "object",
+            "properties": {
+                "joined_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "description": "owner, admin, member",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "collection.AddCollectionRouteRequest": {
+            "type": "object",
+            "required": [
+                "route_id"
+            ],
+            "properties": {
+                "route_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "collection.CollectionListResponse": {
+            "type": "object",
+            "properties": {
+                "collections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/collection.CollectionResponseModel"
+                    }
+                }
+            }
+        },
+        "collection.CollectionRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "description": "0: 非公開, 1: 公開, 2: 友達のみ",
+                    "type": "integer",
+                    "maximum": 2,
+                    "minimum": 0
+                }
+            }
+        },
+        "collection.CollectionResponse": {
+            "type": "object",
+            "properties": {
+                "collection": {
+                    "$ref": "#/definitions/collection.CollectionResponseModel"
+                }
+            }
+        },
+        "collection.CollectionResponseModel": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "route_ids": {
+                    "description": "並び順",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "routes": {
+                    "description": "コレクション詳細のみ。閲覧できるルートを並び順に返す",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/collection.CollectionRouteResponse"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "integer"
+                }
+            }
+        },
+        "collection.CollectionRouteResponse": {
+            "type": "object",
+            "properties": {
+                "difficulty": {
+                    "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "elevation_loss": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "polyline": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "integer"
+                }
+            }
+        },
+        "collection.ReorderCollectionRoutesRequest": {
+            "type": "object",
+            "required": [
+                "route_ids"
+            ],
+            "properties": {
+                "route_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "event.CancelEventRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "event.CommentListResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.CommentResponseModel"
+                    }
+                },
+                "next_cursor": {
+                    "description": "次のページがない場合はnull",
+                    "type": "string"
+                }
+            }
+        },
+        "event.CommentRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string"
+                }
+            }
+        },
+        "event.CommentResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/event.CommentResponseModel"
+                }
+            }
+        },
+        "event.CommentResponseModel": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "event.EventListResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.EventResponseModel"
+                    }
+                }
+            }
+        },
+        "event.EventRequest": {
+            "type": "object",
+            "required": [
+                "meeting_point",
+                "route_id",
+                "starts_at",
+                "title"
+            ],
+            "properties": {
+                "capacity": {
+                    "description": "参加者の上限。省略すると上限なし",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "club_id": {
+                    "description": "公開範囲がクラブのメンバーのみの場合の共有先",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "meeting_point": {
+                    "description": "集合場所。GeoJSONのPoint",
+                    "type": "string"
+                },
+                "meeting_point_name": {
+                    "type": "string"
+                },
+                "route_id": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "description": "0: 非公開, 1: 公開, 2: 友達のみ, 3: クラブのメンバーのみ",
+                    "type": "integer",
+                    "maximum": 3,
+                    "minimum": 0
+                }
+            }
+        },
+        "event.EventResponse": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/event.EventResponseModel"
+                }
+            }
+        },
+        "event.EventResponseModel": {
+            "type": "object",
+            "properties": {
+                "cancel_reason": {
+                    "type": "string"
+                },
+                "cancelled_at": {
+                    "description": "中止していない場合はnull",
+                    "type": "string"
+                },
+                "capacity": {
+                    "description": "上限なしの場合はnull",
+                    "type": "integer"
+                },
+                "club_id": {
+                    "description": "公開範囲がクラブのメンバーのみの場合の共有先",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "going_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "meeting_point": {
+                    "description": "GeoJSONのPoint",
+                    "type": "string"
+                },
+                "meeting_point_name": {
+                    "type": "string"
+                },
+                "my_rsvp": {
+                    "description": "going, maybe, not_going。返事していない場合は省略",
+                    "type": "string"
+                },
+                "organizer_id": {
+                    "type": "string"
+                },
+                "route": {
+                    "description": "閲覧できないルートの場合はnull",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/event.EventRouteResponse"
+                        }
+                    ]
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "integer"
+                }
+            }
+        },
+        "event.EventRouteResponse": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number"
+                },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "polyline": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "event.RSVPListResponse": {
+            "type": "object",
+            "properties": {
+                "rsvps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event.RSVPResponseModel"
+                    }
+                }
+            }
+        },
+        "event.RSVPRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "going",
+                        "maybe",
+                        "not_going"
+                    ]
+                }
+            }
+        },
+        "event.RSVPResponseModel": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "feed.FeedCommentResponseModel": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "feed.FeedItemResponseModel": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_name": {
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "ルートへのコメント",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/feed.FeedCommentResponseModel"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "route": {
+                    "description": "ルートの作成・いいね・コメントの対象",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/feed.FeedRouteResponseModel"
+                        }
+                    ]
+                },
+                "trip": {
+                    "description": "アップロードしたトリップ",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/feed.FeedTripResponseModel"
+                        }
+                    ]
+                },
+                "type": {
+                    "description": "route_created, trip_uploaded, route_liked, route_commented",
+                    "type": "string"
+                }
+            }
+        },
+        "feed.FeedResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/feed.FeedItemResponseModel"
+                    }
+                },
+                "next_cursor": {
+                    "description": "次のページがない場合はnull",
+                    "type": "string"
+                }
+            }
+        },
+        "feed.FeedRouteResponseModel": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number"
+                },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "polyline": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "feed.FeedTripResponseModel": {
+            "type": "object",
+            "properties": {
+                "departed_at": {
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "moving_time": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "notification.NotificationListResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "description": "次のページがない場合はnull",
+                    "type": "string"
+                },
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/notification.NotificationResponseModel"
+                    }
+                }
+            }
+        },
+        "notification.NotificationResponseModel": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "description": "イベントの中止の通知のみ",
+                    "type": "string"
+                },
+                "event_title": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "route_id": {
+                    "description": "フォロー・イベントの通知ではnull",
+                    "type": "string"
+                },
+                "route_name": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "route_liked, route_commented, route_forked, route_saved, user_followed, event_cancelled",
+                    "type": "string"
+                }
+            }
+        },
+        "notification.PreferenceRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "route_liked",
+                        "route_commented",
+                        "route_forked",
+                        "route_saved",
+                        "user_followed",
+                        "event_cancelled"
+                    ]
+                }
+            }
+        },
+        "notification.PreferenceResponseModel": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "notification.PreferencesResponse": {
+            "type": "object",
+            "properties": {
+                "preferences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/notification.PreferenceResponseModel"
+                    }
+                }
+            }
+        },
+        "notification.UnreadCountResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "notification.UpdatePreferencesRequest": {
+            "type": "object",
+            "required": [
+                "preferences"
+            ],
+            "properties": {
+                "preferences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/notification.PreferenceRequest"
+                    }
+                }
+            }
+        },
+        "response.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "msg": {
+                    "type": "string",
+                    "example": "Bad Request"
+                }
+            }
+        },
+        "route.ConvertTripRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "visibility": {
+                    "type": "integer",
+                    "maximum": 2,
+                    "minimum": 0
+                }
+            }
+        },
+        "route.CoursePointRequest": {
+            "type": "object",
+            "required": [
+                "location"
+            ],
+            "properties": {
+                "bearing_after": {
+                    "type": "integer"
+                },
+                "bearing_before": {
+                    "type": "integer"
+                },
+                "cum_dist_m": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "instruction": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "maneuver_type": {
+                    "type": "string"
+                },
+                "modifier": {
+                    "type": "string"
+                },
+                "road_name": {
+                    "type": "string"
+                },
+                "seg_dist_m": {
+                    "type": "number"
+                }
+            }
+        },
+        "route.CoursePointResponse": {
+            "type": "object",
+            "properties": {
+                "bearing_after": {
+                    "type": "integer"
+                },
+                "bearing_before": {
+                    "type": "integer"
+                },
+                "cum_dist_m": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "instruction": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "maneuver_type": {
+                    "type": "string"
+                },
+                "modifier": {
+                    "type": "string"
+                },
+                "road_name": {
+                    "type": "string"
+                },
+                "seg_dist_m": {
+                    "type": "number"
+                },
+                "step_order": {
+                    "type": "integer"
+                }
+            }
+        },
+        "route.CreateRouteRequest": {
+            "type": "object",
+            "required": [
+                "distance",
+                "duration",
+                "first_point",
+                "last_point",
+                "name",
+                "path_geom",
+                "visibility"
+            ],
+            "properties": {
+                "club_id": {
+                    "description": "公開範囲がクラブのメンバーのみ(3)の場合の共有先",
+                    "type": "string"
+                },
+                "course_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.CoursePointRequest"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "distance": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "duration": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "elevation_gain": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "elevation_loss": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "first_point": {
+                    "type": "string"
+                },
+                "highlighted_photo_id": {
+                    "type": "integer"
+                },
+                "last_point": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "path_geom": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "integer",
+                    "maximum": 3,
+                    "minimum": 0
+                },
+                "waypoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.WaypointRequest"
+                    }
+                }
+            }
+        },
+        "route.CueSheetResponse": {
+            "type": "object",
+            "properties": {
+                "course_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.CoursePointResponse"
+                    }
+                }
+            }
+        },
+        "route.JoinRoutesRequest": {
+            "type": "object",
+            "required": [
+                "route_id"
+            ],
+            "properties": {
+                "route_id": {
+                    "description": "終点の後ろにつなげるルート",
+                    "type": "string"
+                }
+            }
+        },
+        "route.MatchTripResponse": {
+            "type": "object",
+            "properties": {
+                "course_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.CoursePointResponse"
+                    }
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "first_point": {
+                    "type": "string"
+                },
+                "last_point": {
+                    "type": "string"
+                },
+                "matched_points": {
+                    "description": "道路に照合できたGPSの点の数",
+                    "type": "integer"
+                },
+                "path_geom": {
+                    "type": "string"
+                },
+                "segments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.MatchedSegmentResponse"
+                    }
+                },
+                "total_points": {
+                    "type": "integer"
+                }
+            }
+        },
+        "route.MatchedSegmentResponse": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number"
+                },
+                "highway": {
+                    "type": "string"
+                },
+                "path_geom": {
+                    "type": "string"
+                },
+                "road_name": {
+                    "type": "string"
+                },
+                "surface": {
+                    "type": "string"
+                }
+            }
+        },
+        "route.PlaceResponse": {
+            "type": "object",
+            "properties": {
+                "administrative_area": {
+                    "description": "都道府県。分からない場合は空文字",
+                    "type": "string"
+                },
+                "country_code": {
+                    "type": "string"
+                },
+                "locality": {
+                    "description": "市区町村。分からない場合は空文字",
+                    "type": "string"
+                }
+            }
+        },
+        "route.PlanRouteRequest": {
+            "type": "object",
+            "required": [
+                "waypoints"
             ],
             "properties": {
-                "bearing_after": {
+                "profile": {
+                    "description": "省略時はroad",
+                    "type": "string",
+                    "enum": [
+                        "road",
+                        "gravel",
+                        "avoid_highways"
+                    ]
+                },
+                "waypoints": {
+                    "type": "array",
+                    "maxItems": 25,
+                    "minItems": 2,
+                    "items": {
+                        "$ref": "#/definitions/route.WaypointRequest"
+                    }
+                }
+            }
+        },
+        "route.PlanRouteResponse": {
+            "type": "object",
+            "properties": {
+                "course_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.CoursePointResponse"
+                    }
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "first_point": {
+                    "type": "string"
+                },
+                "last_point": {
+                    "type": "string"
+                },
+                "path_geom": {
+                    "type": "string"
+                },
+                "profile": {
+                    "type": "string"
+                }
+            }
+        },
+        "route.PromotePOIRequest": {
+            "type": "object",
+            "required": [
+                "as"
+            ],
+            "properties": {
+                "as": {
+                    "type": "string",
+                    "enum": [
+                        "waypoint",
+                        "course_point"
+                    ]
+                }
+            }
+        },
+        "route.RouteCommentListResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.RouteCommentResponseModel"
+                    }
+                },
+                "next_cursor": {
+                    "description": "次のページがない場合はnull",
+                    "type": "string"
+                }
+            }
+        },
+        "route.RouteCommentRequest": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                }
+            }
+        },
+        "route.RouteCommentResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/route.RouteCommentResponseModel"
+                }
+            }
+        },
+        "route.RouteCommentResponseModel": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "route.RouteHighlightResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "route.RouteListResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "description": "次のページがない場合はnull",
+                    "type": "string"
+                },
+                "routes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.RouteResponseModel"
+                    }
+                }
+            }
+        },
+        "route.RoutePOIListResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "description": "次のページがない場合はnull",
+                    "type": "string"
+                },
+                "pois": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.RoutePOIResponse"
+                    }
+                }
+            }
+        },
+        "route.RoutePOIResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "cafe, convenience, drinking_water, bicycle_shop, toilets, viewpoint",
+                    "type": "string"
+                },
+                "cum_dist_m": {
+                    "description": "経路上の位置（始点からの距離(m)）",
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "offset_m": {
+                    "description": "経路からの距離(m)",
+                    "type": "number"
+                }
+            }
+        },
+        "route.RouteResponse": {
+            "type": "object",
+            "properties": {
+                "route": {
+                    "$ref": "#/definitions/route.RouteResponseModel"
+                }
+            }
+        },
+        "route.RouteResponseModel": {
+            "type": "object",
+            "properties": {
+                "bbox": {
+                    "type": "string"
+                },
+                "club_id": {
+                    "description": "公開範囲がクラブのメンバーのみの場合の共有先",
+                    "type": "string"
+                },
+                "course_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.CoursePointResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "elevation_loss": {
+                    "type": "number"
+                },
+                "end_place": {
+                    "description": "ルート詳細のみ。地名が分からない場合は省略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/route.PlaceResponse"
+                        }
+                    ]
+                },
+                "estimated_duration_for_me": {
+                    "description": "ルート詳細のみ。ログインユーザーの過去のトリップから推定した所要時間(s)。未ログインの場合は省略",
+                    "type": "number"
+                },
+                "first_point": {
+                    "type": "string"
+                },
+                "fork_count": {
+                    "description": "ルート詳細のみ",
+                    "type": "integer"
+                },
+                "forked_from_route_id": {
+                    "description": "フォーク元のルートID",
+                    "type": "string"
+                },
+                "highlight": {
+                    "description": "キーワード検索時のみ",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/route.RouteHighlightResponse"
+                        }
+                    ]
+                },
+                "highlighted_photo_id": {
                     "type": "integer"
                 },
-                "bearing_before": {
+                "id": {
+                    "type": "string"
+                },
+                "last_point": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path_geom": {
+                    "type": "string"
+                },
+                "polyline": {
+                    "type": "string"
+                },
+                "start_place": {
+                    "description": "ルート詳細のみ。地名が分からない場合は省略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/route.PlaceResponse"
+                        }
+                    ]
+                },
+                "surface_breakdown": {
+                    "description": "ルート詳細のみ。未作成の場合は省略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/route.SurfaceBreakdownResponse"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "タグがない場合は省略",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                },
+                "visibility": {
                     "type": "integer"
                 },
-                "cum_dist_m": {
+                "waypoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.WaypointResponse"
+                    }
+                }
+            }
+        },
+        "route.RouteTagsResponse": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "route.RouteVersionDetailResponseModel": {
+            "type": "object",
+            "properties": {
+                "course_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.CoursePointResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "diff": {
+                    "$ref": "#/definitions/route.RouteVersionDiffResponse"
+                },
+                "distance": {
                     "type": "number"
                 },
                 "duration": {
                     "type": "number"
                 },
-                "instruction": {
+                "editor_id": {
+                    "description": "この版を置き換える更新を行ったユーザー",
                     "type": "string"
                 },
-                "location": {
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "elevation_loss": {
+                    "type": "number"
+                },
+                "first_point": {
                     "type": "string"
                 },
-                "maneuver_type": {
+                "highlighted_photo_id": {
+                    "type": "integer"
+                },
+                "last_point": {
                     "type": "string"
                 },
-                "modifier": {
+                "name": {
                     "type": "string"
                 },
-                "road_name": {
+                "path_geom": {
                     "type": "string"
                 },
-                "seg_dist_m": {
+                "route_id": {
+                    "type": "string"
+                },
+                "version_number": {
+                    "type": "integer"
+                },
+                "visibility": {
+                    "type": "integer"
+                },
+                "waypoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.WaypointResponse"
+                    }
+                }
+            }
+        },
+        "route.RouteVersionDiffResponse": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "elevation_loss": {
                     "type": "number"
                 }
             }
         },
-        "route.CoursePointResponse": {
+        "route.RouteVersionListResponse": {
             "type": "object",
             "properties": {
-                "bearing_after": {
-                    "type": "integer"
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.RouteVersionResponseModel"
+                    }
+                }
+            }
+        },
+        "route.RouteVersionResponse": {
+            "type": "object",
+            "properties": {
+                "version": {
+                    "$ref": "#/definitions/route.RouteVersionDetailResponseModel"
+                }
+            }
+        },
+        "route.RouteVersionResponseModel": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
                 },
-                "bearing_before": {
+                "diff": {
+                    "$ref": "#/definitions/route.RouteVersionDiffResponse"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "editor_id": {
+                    "description": "この版を置き換える更新を行ったユーザー",
+                    "type": "string"
+                },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "elevation_loss": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "version_number": {
                     "type": "integer"
+                }
+            }
+        },
+        "route.SimilarRouteListResponse": {
+            "type": "object",
+            "properties": {
+                "routes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.SimilarRouteResponseModel"
+                    }
+                }
+            }
+        },
+        "route.SimilarRouteResponseModel": {
+            "type": "object",
+            "properties": {
+                "bbox": {
+                    "type": "string"
                 },
-                "cum_dist_m": {
+                "club_id": {
+                    "description": "公開範囲がクラブのメンバーのみの場合の共有先",
+                    "type": "string"
+                },
+                "course_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.CoursePointResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
+                    "type": "string"
+                },
+                "distance": {
                     "type": "number"
                 },
                 "duration": {
                     "type": "number"
                 },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "elevation_loss": {
+                    "type": "number"
+                },
+                "end_place": {
+                    "description": "ルート詳細のみ。地名が分からない場合は省略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/route.PlaceResponse"
+                        }
+                    ]
+                },
+                "estimated_duration_for_me": {
+                    "description": "ルート詳細のみ。ログインユーザーの過去のトリップから推定した所要時間(s)。未ログインの場合は省略",
+                    "type": "number"
+                },
+                "first_point": {
+                    "type": "string"
+                },
+                "fork_count": {
+                    "description": "ルート詳細のみ",
+                    "type": "integer"
+                },
+                "forked_from_route_id": {
+                    "description": "フォーク元のルートID",
+                    "type": "string"
+                },
+                "frechet_distance": {
+                    "description": "進行方向を考慮した形状の近さ(m)",
+                    "type": "number"
+                },
+                "hausdorff_distance": {
+                    "description": "形状の近さ(m)",
+                    "type": "number"
+                },
+                "highlight": {
+                    "description": "キーワード検索時のみ",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/route.RouteHighlightResponse"
+                        }
+                    ]
+                },
+                "highlighted_photo_id": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
-                "instruction": {
+                "is_duplicate": {
+                    "description": "ほぼ同一のルートかどうか",
+                    "type": "boolean"
+                },
+                "last_point": {
                     "type": "string"
                 },
-                "location": {
+                "name": {
+                    "type": "string"
+                },
+                "path_geom": {
+                    "type": "string"
+                },
+                "polyline": {
+                    "type": "string"
+                },
+                "start_place": {
+                    "description": "ルート詳細のみ。地名が分からない場合は省略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/route.PlaceResponse"
+                        }
+                    ]
+                },
+                "surface_breakdown": {
+                    "description": "ルート詳細のみ。未作成の場合は省略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/route.SurfaceBreakdownResponse"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "タグがない場合は省略",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "integer"
+                },
+                "waypoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.WaypointResponse"
+                    }
+                }
+            }
+        },
+        "route.SplitRouteRequest": {
+            "type": "object",
+            "required": [
+                "point"
+            ],
+            "properties": {
+                "name": {
+                    "description": "後半のルートの名前。省略時は元の名前に「(2)」を付ける",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "point": {
+                    "description": "分割する地点（GeoJSON Point）",
                     "type": "string"
+                }
+            }
+        },
+        "route.SurfaceBreakdownResponse": {
+            "type": "object",
+            "properties": {
+                "road_classes": {
+                    "description": "OSMのhighwayタグ（residential, cyclewayなど）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.SurfaceShareResponse"
+                    }
                 },
-                "maneuver_type": {
-                    "type": "string"
+                "surfaces": {
+                    "description": "paved, gravel, dirt, unknown",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/route.SurfaceShareResponse"
+                    }
                 },
-                "modifier": {
+                "unpaved_percentage": {
+                    "type": "number"
+                }
+            }
+        },
+        "route.SurfaceShareResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
                     "type": "string"
                 },
-                "road_name": {
-                    "type": "string"
+                "distance": {
+                    "description": "距離(m)",
+                    "type": "number"
                 },
-                "seg_dist_m": {
+                "percentage": {
+                    "description": "割合(%)",
+                    "type": "number"
+                }
+            }
+        },
+        "route.TrimRouteRequest": {
+            "type": "object",
+            "required": [
+                "end_distance"
+            ],
+            "properties": {
+                "end_distance": {
                     "type": "number"
                 },
-                "step_order": {
-                    "type": "integer"
+                "start_distance": {
+                    "type": "number",
+                    "minimum": 0
                 }
             }
         },
-        "route.CreateRouteRequest": {
+        "route.UpdateRouteRequest": {
             "type": "object",
             "required": [
                 "distance",
@@ -816,6 +7678,10 @@ const docTemplate = `{
                 "visibility"
             ],
             "properties": {
+                "club_id": {
+                    "description": "公開範囲がクラブのメンバーのみ(3)の場合の共有先",
+                    "type": "string"
+                },
                 "course_points": {
                     "type": "array",
                     "items": {
@@ -860,7 +7726,7 @@ const docTemplate = `{
                 },
                 "visibility": {
                     "type": "integer",
-                    "maximum": 2,
+                    "maximum": 3,
                     "minimum": 0
                 },
                 "waypoints": {
@@ -871,40 +7737,101 @@ const docTemplate = `{
                 }
             }
         },
-        "route.RouteListResponse": {
+        "route.UpdateRouteTagsRequest": {
             "type": "object",
+            "required": [
+                "tags"
+            ],
             "properties": {
-                "routes": {
+                "tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/route.RouteResponseModel"
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "route.WaypointRequest": {
+            "type": "object",
+            "required": [
+                "location"
+            ],
+            "properties": {
+                "location": {
+                    "type": "string"
+                }
+            }
+        },
+        "route.WaypointResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
                 },
-                "total_count": {
-                    "type": "integer"
+                "location": {
+                    "type": "string"
                 }
             }
         },
-        "route.RouteResponse": {
+        "tour.TourListResponse": {
             "type": "object",
             "properties": {
-                "route": {
-                    "$ref": "#/definitions/route.RouteResponseModel"
+                "tours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tour.TourResponseModel"
+                    }
                 }
             }
         },
-        "route.RouteResponseModel": {
+        "tour.TourRequest": {
             "type": "object",
+            "required": [
+                "name",
+                "stages"
+            ],
             "properties": {
-                "bbox": {
+                "club_id": {
+                    "description": "公開範囲がクラブのメンバーのみの場合の共有先",
                     "type": "string"
                 },
-                "course_points": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stages": {
+                    "description": "日程の順",
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/route.CoursePointResponse"
+                        "$ref": "#/definitions/tour.TourStageRequest"
                     }
                 },
+                "visibility": {
+                    "description": "0: 非公開, 1: 公開, 2: 友達のみ, 3: クラブのメンバーのみ",
+                    "type": "integer",
+                    "maximum": 3,
+                    "minimum": 0
+                }
+            }
+        },
+        "tour.TourResponse": {
+            "type": "object",
+            "properties": {
+                "tour": {
+                    "$ref": "#/definitions/tour.TourResponseModel"
+                }
+            }
+        },
+        "tour.TourResponseModel": {
+            "type": "object",
+            "properties": {
+                "club_id": {
+                    "description": "公開範囲がクラブのメンバーのみの場合の共有先",
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -923,133 +7850,155 @@ const docTemplate = `{
                 "elevation_loss": {
                     "type": "number"
                 },
-                "first_point": {
+                "id": {
                     "type": "string"
                 },
-                "highlighted_photo_id": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "id": {
+                "stages": {
+                    "description": "日程の順",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tour.TourStageResponse"
+                    }
+                },
+                "updated_at": {
                     "type": "string"
                 },
-                "last_point": {
+                "user_id": {
                     "type": "string"
                 },
-                "name": {
+                "visibility": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tour.TourStageRequest": {
+            "type": "object",
+            "required": [
+                "route_id"
+            ],
+            "properties": {
+                "overnight_stop": {
                     "type": "string"
                 },
-                "path_geom": {
+                "route_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "tour.TourStageResponse": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "description": "1日目が1",
+                    "type": "integer"
+                },
+                "difficulty": {
+                    "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "number"
+                },
+                "elevation_gain": {
+                    "type": "number"
+                },
+                "elevation_loss": {
+                    "type": "number"
+                },
+                "overnight_stop": {
                     "type": "string"
                 },
                 "polyline": {
                     "type": "string"
                 },
-                "updated_at": {
+                "route_id": {
                     "type": "string"
                 },
-                "user_id": {
+                "route_name": {
                     "type": "string"
                 },
                 "user_name": {
                     "type": "string"
+                }
+            }
+        },
+        "trip.TripListResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "description": "次のページがない場合はnull",
+                    "type": "string"
                 },
-                "visibility": {
-                    "type": "integer"
-                },
-                "waypoints": {
+                "trips": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/route.WaypointResponse"
+                        "$ref": "#/definitions/trip.TripResponseModel"
                     }
                 }
             }
         },
-        "route.UpdateRouteRequest": {
+        "trip.TripResponseModel": {
             "type": "object",
-            "required": [
-                "distance",
-                "duration",
-                "first_point",
-                "last_point",
-                "name",
-                "path_geom",
-                "visibility"
-            ],
             "properties": {
-                "course_points": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/route.CoursePointRequest"
-                    }
+                "created_at": {
+                    "type": "string"
+                },
+                "departed_at": {
+                    "type": "string"
                 },
                 "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                    "type": "string"
                 },
                 "distance": {
-                    "type": "number",
-                    "minimum": 0
+                    "description": "計測値を求めていない場合はnull",
+                    "type": "number"
                 },
                 "duration": {
-                    "type": "number",
-                    "minimum": 0
+                    "type": "integer"
                 },
                 "elevation_gain": {
-                    "type": "number",
-                    "minimum": 0
+                    "type": "number"
                 },
                 "elevation_loss": {
-                    "type": "number",
-                    "minimum": 0
+                    "type": "number"
                 },
-                "first_point": {
+                "id": {
                     "type": "string"
                 },
-                "highlighted_photo_id": {
+                "moving_time": {
                     "type": "integer"
                 },
-                "last_point": {
-                    "type": "string"
-                },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255
-                },
-                "path_geom": {
                     "type": "string"
                 },
                 "visibility": {
-                    "type": "integer",
-                    "maximum": 2,
-                    "minimum": 0
-                },
-                "waypoints": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/route.WaypointRequest"
-                    }
+                    "type": "integer"
                 }
             }
         },
-        "route.WaypointRequest": {
+        "user.CreatePrivacyZoneRequest": {
             "type": "object",
             "required": [
-                "location"
+                "center",
+                "radius_m"
             ],
             "properties": {
-                "location": {
-                    "type": "string"
-                }
-            }
-        },
-        "route.WaypointResponse": {
-            "type": "object",
-            "properties": {
-                "id": {
+                "center": {
+                    "description": "GeoJSONのPoint",
                     "type": "string"
                 },
-                "location": {
+                "name": {
                     "type": "string"
+                },
+                "radius_m": {
+                    "description": "半径(m)。100〜2000",
+                    "type": "number"
                 }
             }
         },
@@ -1132,13 +8081,49 @@ const docTemplate = `{
                 }
             }
         },
+        "user.PrivacyZoneListResponse": {
+            "type": "object",
+            "properties": {
+                "privacy_zones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user.PrivacyZoneResponseModel"
+                    }
+                }
+            }
+        },
+        "user.PrivacyZoneResponse": {
+            "type": "object",
+            "properties": {
+                "privacy_zone": {
+                    "$ref": "#/definitions/user.PrivacyZoneResponseModel"
+                }
+            }
+        },
+        "user.PrivacyZoneResponseModel": {
+            "type": "object",
+            "properties": {
+                "center": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "radius_m": {
+                    "type": "number"
+                }
+            }
+        },
         "user.UpdateUserLocationRequest": {
             "type": "object",
             "required": [
-                "administrative_area",
-                "country_code",
                 "geom",
-                "locality",
                 "postal_code"
             ],
             "properties": {
@@ -8,206 +8,313 @@ const docTemplate = `{
     "schemes": {{ marshal .Schemes }},
     "components": {
         "schemas": {
-            "response.ErrorResponse": {
+            "club.AddLibraryRouteRequest": {
                 "properties": {
-                    "code": {
-                        "example": 400,
-                        "type": "integer"
-                    },
-                    "msg": {
-                        "example": "Bad Request",
+                    "route_id": {
                         "type": "string"
                     }
                 },
+                "required": [
+                    "route_id"
+                ],
                 "type": "object"
             },
-            "route.CoursePointRequest": {
+            "club.ChangeMemberRoleRequest": {
                 "properties": {
-                    "bearing_after": {
-                        "type": "integer"
-                    },
-                    "bearing_before": {
-                        "type": "integer"
-                    },
-                    "cum_dist_m": {
-                        "type": "number"
-                    },
-                    "duration": {
-                        "type": "number"
-                    },
-                    "instruction": {
-                        "type": "string"
-                    },
-                    "location": {
-                        "type": "string"
-                    },
-                    "maneuver_type": {
+                    "role": {
+                        "enum": [
+                            "admin",
+                            "member"
+                        ],
                         "type": "string"
-                    },
-                    "modifier": {
+                    }
+                },
+                "required": [
+                    "role"
+                ],
+                "type": "object"
+            },
+            "club.ClubListResponse": {
+                "properties": {
+                    "clubs": {
+                        "items": {
+                            "$ref": "#/components/schemas/club.ClubResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "club.ClubRequest": {
+                "properties": {
+                    "description": {
                         "type": "string"
                     },
-                    "road_name": {
+                    "name": {
                         "type": "string"
-                    },
-                    "seg_dist_m": {
-                        "type": "number"
                     }
                 },
                 "required": [
-                    "location"
+                    "name"
                 ],
                 "type": "object"
             },
-            "route.CoursePointResponse": {
+            "club.ClubResponse": {
                 "properties": {
-                    "bearing_after": {
-                        "type": "integer"
-                    },
-                    "bearing_before": {
-                        "type": "integer"
-                    },
-                    "cum_dist_m": {
-                        "type": "number"
+                    "club": {
+                        "$ref": "#/components/schemas/club.ClubResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "club.ClubResponseModel": {
+                "properties": {
+                    "created_at": {
+                        "type": "string"
                     },
-                    "duration": {
-                        "type": "number"
+                    "description": {
+                        "type": "string"
                     },
                     "id": {
                         "type": "string"
                     },
-                    "instruction": {
-                        "type": "string"
+                    "member_count": {
+                        "type": "integer"
                     },
-                    "location": {
+                    "my_role": {
+                        "description": "owner, admin, member。メンバーでない場合は省略",
                         "type": "string"
                     },
-                    "maneuver_type": {
+                    "name": {
                         "type": "string"
                     },
-                    "modifier": {
+                    "updated_at": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "club.JoinClubRequest": {
+                "properties": {
+                    "message": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "club.JoinRequestListResponse": {
+                "properties": {
+                    "join_requests": {
+                        "items": {
+                            "$ref": "#/components/schemas/club.JoinRequestResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "club.JoinRequestResponseModel": {
+                "properties": {
+                    "created_at": {
                         "type": "string"
                     },
-                    "road_name": {
+                    "message": {
                         "type": "string"
                     },
-                    "seg_dist_m": {
-                        "type": "number"
+                    "user_id": {
+                        "type": "string"
                     },
-                    "step_order": {
-                        "type": "integer"
+                    "user_name": {
+                        "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "route.CreateRouteRequest": {
+            "club.LibraryRouteListResponse": {
                 "properties": {
-                    "course_points": {
+                    "routes": {
                         "items": {
-                            "$ref": "#/components/schemas/route.CoursePointRequest"
+                            "$ref": "#/components/schemas/club.LibraryRouteResponse"
                         },
                         "type": "array",
                         "uniqueItems": false
-                    },
-                    "description": {
-                        "maxLength": 1000,
+                    }
+                },
+                "type": "object"
+            },
+            "club.LibraryRouteResponse": {
+                "properties": {
+                    "difficulty": {
+                        "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
                         "type": "string"
                     },
                     "distance": {
-                        "minimum": 0,
                         "type": "number"
                     },
                     "duration": {
-                        "minimum": 0,
                         "type": "number"
                     },
                     "elevation_gain": {
-                        "minimum": 0,
-                        "type": "number"
-                    },
-                    "elevation_loss": {
-                        "minimum": 0,
                         "type": "number"
                     },
-                    "first_point": {
+                    "id": {
                         "type": "string"
                     },
-                    "highlighted_photo_id": {
-                        "type": "integer"
+                    "name": {
+                        "type": "string"
                     },
-                    "last_point": {
+                    "polyline": {
                         "type": "string"
                     },
-                    "name": {
-                        "maxLength": 255,
+                    "user_id": {
                         "type": "string"
                     },
-                    "path_geom": {
+                    "user_name": {
                         "type": "string"
                     },
                     "visibility": {
-                        "maximum": 2,
-                        "minimum": 0,
                         "type": "integer"
-                    },
-                    "waypoints": {
+                    }
+                },
+                "type": "object"
+            },
+            "club.MemberListResponse": {
+                "properties": {
+                    "members": {
                         "items": {
-                            "$ref": "#/components/schemas/route.WaypointRequest"
+                            "$ref": "#/components/schemas/club.MemberResponseModel"
                         },
                         "type": "array",
                         "uniqueItems": false
                     }
                 },
+                "type": "object"
+            },
+            "club.MemberResponse": {
+                "properties": {
+                    "member": {
+                        "$ref": "#/components/schemas/club.MemberResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "club.MemberResponseModel": {
+                "properties": {
+                    "joined_at": {
+                        "type": "string"
+                    },
+                    "role": {
+                        "description": "owner, admin, member",
+                        "type": "string"
+                    },
+                    "user_id": {
+                        "type": "string"
+                    },
+                    "user_name": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "collection.AddCollectionRouteRequest": {
+                "properties": {
+                    "route_id": {
+                        "type": "string"
+                    }
+                },
                 "required": [
-                    "distance",
-                    "duration",
-                    "first_point",
-                    "last_point",
-                    "name",
-                    "path_geom",
-                    "visibility"
+                    "route_id"
                 ],
                 "type": "object"
             },
-            "route.RouteListResponse": {
+            "collection.CollectionListResponse": {
                 "properties": {
-                    "routes": {
+                    "collections": {
                         "items": {
-                            "$ref": "#/components/schemas/route.RouteResponseModel"
+                            "$ref": "#/components/schemas/collection.CollectionResponseModel"
                         },
                         "type": "array",
                         "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "collection.CollectionRequest": {
+                "properties": {
+                    "description": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
                     },
-                    "total_count": {
+                    "visibility": {
+                        "description": "0: 非公開, 1: 公開, 2: 友達のみ",
+                        "maximum": 2,
+                        "minimum": 0,
                         "type": "integer"
                     }
                 },
+                "required": [
+                    "name"
+                ],
                 "type": "object"
             },
-            "route.RouteResponse": {
+            "collection.CollectionResponse": {
                 "properties": {
-                    "route": {
-                        "$ref": "#/components/schemas/route.RouteResponseModel"
+                    "collection": {
+                        "$ref": "#/components/schemas/collection.CollectionResponseModel"
                     }
                 },
                 "type": "object"
             },
-            "route.RouteResponseModel": {
+            "collection.CollectionResponseModel": {
                 "properties": {
-                    "bbox": {
+                    "created_at": {
                         "type": "string"
                     },
-                    "course_points": {
+                    "description": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "route_ids": {
+                        "description": "並び順",
                         "items": {
-                            "$ref": "#/components/schemas/route.CoursePointResponse"
+                            "type": "string"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "created_at": {
+                    "routes": {
+                        "description": "コレクション詳細のみ。閲覧できるルートを並び順に返す",
+                        "items": {
+                            "$ref": "#/components/schemas/collection.CollectionRouteResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "updated_at": {
                         "type": "string"
                     },
-                    "description": {
+                    "user_id": {
+                        "type": "string"
+                    },
+                    "visibility": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "collection.CollectionRouteResponse": {
+                "properties": {
+                    "difficulty": {
+                        "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
                         "type": "string"
                     },
                     "distance": {
@@ -222,29 +329,21 @@ const docTemplate = `{
                     "elevation_loss": {
                         "type": "number"
                     },
-                    "first_point": {
-                        "type": "string"
-                    },
-                    "highlighted_photo_id": {
-                        "type": "integer"
-                    },
                     "id": {
                         "type": "string"
                     },
-                    "last_point": {
-                        "type": "string"
-                    },
                     "name": {
                         "type": "string"
                     },
-                    "path_geom": {
-                        "type": "string"
-                    },
                     "polyline": {
                         "type": "string"
                     },
-                    "updated_at": {
-                        "type": "string"
+                    "tags": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
                     "user_id": {
                         "type": "string"
@@ -254,339 +353,8733 @@ const docTemplate = `{
                     },
                     "visibility": {
                         "type": "integer"
-                    },
-                    "waypoints": {
+                    }
+                },
+                "type": "object"
+            },
+            "collection.ReorderCollectionRoutesRequest": {
+                "properties": {
+                    "route_ids": {
                         "items": {
-                            "$ref": "#/components/schemas/route.WaypointResponse"
+                            "type": "string"
                         },
                         "type": "array",
                         "uniqueItems": false
                     }
                 },
+                "required": [
+                    "route_ids"
+                ],
                 "type": "object"
             },
-            "route.UpdateRouteRequest": {
+            "event.CancelEventRequest": {
                 "properties": {
-                    "course_points": {
+                    "reason": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "event.CommentListResponse": {
+                "properties": {
+                    "comments": {
                         "items": {
-                            "$ref": "#/components/schemas/route.CoursePointRequest"
+                            "$ref": "#/components/schemas/event.CommentResponseModel"
                         },
                         "type": "array",
                         "uniqueItems": false
                     },
-                    "description": {
-                        "maxLength": 1000,
-                        "type": "string"
-                    },
-                    "distance": {
-                        "minimum": 0,
-                        "type": "number"
-                    },
-                    "duration": {
-                        "minimum": 0,
-                        "type": "number"
-                    },
-                    "elevation_gain": {
-                        "minimum": 0,
-                        "type": "number"
-                    },
-                    "elevation_loss": {
-                        "minimum": 0,
-                        "type": "number"
-                    },
-                    "first_point": {
-                        "type": "string"
-                    },
-                    "highlighted_photo_id": {
-                        "type": "integer"
-                    },
-                    "last_point": {
-                        "type": "string"
-                    },
-                    "name": {
-                        "maxLength": 255,
-                        "type": "string"
-                    },
-                    "path_geom": {
+                    "next_cursor": {
+                        "description": "次のページがない場合はnull",
                         "type": "string"
-                    },
-                    "visibility": {
-                        "maximum": 2,
-                        "minimum": 0,
-                        "type": "integer"
-                    },
-                    "waypoints": {
-                        "items": {
-                            "$ref": "#/components/schemas/route.WaypointRequest"
-                        },
-                        "type": "array",
-                        "uniqueItems": false
                     }
                 },
-                "required": [
-                    "distance",
-                    "duration",
-                    "first_point",
-                    "last_point",
-                    "name",
-                    "path_geom",
-                    "visibility"
-                ],
                 "type": "object"
             },
-            "route.WaypointRequest": {
+            "event.CommentRequest": {
                 "properties": {
-                    "location": {
+                    "body": {
                         "type": "string"
                     }
                 },
                 "required": [
-                    "location"
+                    "body"
                 ],
                 "type": "object"
             },
-            "route.WaypointResponse": {
+            "event.CommentResponse": {
+                "properties": {
+                    "comment": {
+                        "$ref": "#/components/schemas/event.CommentResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "event.CommentResponseModel": {
                 "properties": {
+                    "body": {
+                        "type": "string"
+                    },
+                    "created_at": {
+                        "type": "string"
+                    },
                     "id": {
                         "type": "string"
                     },
-                    "location": {
+                    "user_id": {
+                        "type": "string"
+                    },
+                    "user_name": {
                         "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "user.CreateUserRequest": {
+            "event.EventListResponse": {
                 "properties": {
-                    "email": {
+                    "events": {
+                        "items": {
+                            "$ref": "#/components/schemas/event.EventResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "event.EventRequest": {
+                "properties": {
+                    "capacity": {
+                        "description": "参加者の上限。省略すると上限なし",
+                        "minimum": 1,
+                        "type": "integer"
+                    },
+                    "club_id": {
+                        "description": "公開範囲がクラブのメンバーのみの場合の共有先",
                         "type": "string"
                     },
-                    "first_name": {
+                    "description": {
                         "type": "string"
                     },
-                    "kratos_id": {
+                    "meeting_point": {
+                        "description": "集合場所。GeoJSONのPoint",
                         "type": "string"
                     },
-                    "last_name": {
+                    "meeting_point_name": {
                         "type": "string"
                     },
-                    "name": {
+                    "route_id": {
+                        "type": "string"
+                    },
+                    "starts_at": {
+                        "description": "RFC3339",
+                        "type": "string"
+                    },
+                    "title": {
                         "type": "string"
+                    },
+                    "visibility": {
+                        "description": "0: 非公開, 1: 公開, 2: 友達のみ, 3: クラブのメンバーのみ",
+                        "maximum": 3,
+                        "minimum": 0,
+                        "type": "integer"
                     }
                 },
                 "required": [
-                    "kratos_id",
-                    "name"
+                    "meeting_point",
+                    "route_id",
+                    "starts_at",
+                    "title"
                 ],
                 "type": "object"
             },
-            "user.LoginUserResponse": {
+            "event.EventResponse": {
                 "properties": {
-                    "user": {
-                        "$ref": "#/components/schemas/user.LoginUserResponseModel"
+                    "event": {
+                        "$ref": "#/components/schemas/event.EventResponseModel"
                     }
                 },
                 "type": "object"
             },
-            "user.LoginUserResponseModel": {
+            "event.EventResponseModel": {
                 "properties": {
-                    "administrative_area": {
+                    "cancel_reason": {
                         "type": "string"
                     },
-                    "country_code": {
+                    "cancelled_at": {
+                        "description": "中止していない場合はnull",
                         "type": "string"
                     },
-                    "description": {
-                        "type": "string"
+                    "capacity": {
+                        "description": "上限なしの場合はnull",
+                        "type": "integer"
                     },
-                    "email": {
+                    "club_id": {
+                        "description": "公開範囲がクラブのメンバーのみの場合の共有先",
                         "type": "string"
                     },
-                    "first_name": {
+                    "created_at": {
                         "type": "string"
                     },
-                    "geom": {
+                    "description": {
                         "type": "string"
                     },
-                    "has_set_location": {
-                        "type": "boolean"
-                    },
-                    "highlighted_photo_id": {
+                    "going_count": {
                         "type": "integer"
                     },
                     "id": {
                         "type": "string"
                     },
-                    "last_name": {
+                    "meeting_point": {
+                        "description": "GeoJSONのPoint",
                         "type": "string"
                     },
-                    "locale": {
+                    "meeting_point_name": {
                         "type": "string"
                     },
-                    "locality": {
+                    "my_rsvp": {
+                        "description": "going, maybe, not_going。返事していない場合は省略",
                         "type": "string"
                     },
-                    "name": {
+                    "organizer_id": {
                         "type": "string"
                     },
-                    "postal_code": {
+                    "route": {
+                        "$ref": "#/components/schemas/event.EventRouteResponse"
+                    },
+                    "starts_at": {
+                        "type": "string"
+                    },
+                    "title": {
                         "type": "string"
+                    },
+                    "updated_at": {
+                        "type": "string"
+                    },
+                    "visibility": {
+                        "type": "integer"
                     }
                 },
                 "type": "object"
             },
-            "user.UpdateUserLocationRequest": {
+            "event.EventRouteResponse": {
+                "description": "閲覧できないルートの場合はnull",
                 "properties": {
-                    "administrative_area": {
-                        "type": "string"
+                    "distance": {
+                        "type": "number"
                     },
-                    "country_code": {
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "id": {
                         "type": "string"
                     },
-                    "geom": {
+                    "name": {
                         "type": "string"
                     },
-                    "locality": {
+                    "polyline": {
                         "type": "string"
                     },
-                    "postal_code": {
+                    "user_name": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "event.RSVPListResponse": {
+                "properties": {
+                    "rsvps": {
+                        "items": {
+                            "$ref": "#/components/schemas/event.RSVPResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "event.RSVPRequest": {
+                "properties": {
+                    "status": {
+                        "enum": [
+                            "going",
+                            "maybe",
+                            "not_going"
+                        ],
                         "type": "string"
                     }
                 },
                 "required": [
-                    "administrative_area",
-                    "country_code",
-                    "geom",
-                    "locality",
-                    "postal_code"
+                    "status"
                 ],
                 "type": "object"
             },
-            "user.UpdateUserProfileRequest": {
+            "event.RSVPResponseModel": {
                 "properties": {
-                    "description": {
+                    "status": {
                         "type": "string"
                     },
-                    "first_name": {
+                    "updated_at": {
                         "type": "string"
                     },
-                    "last_name": {
+                    "user_id": {
                         "type": "string"
                     },
-                    "name": {
+                    "user_name": {
                         "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "user.UserResponse": {
+            "feed.FeedCommentResponseModel": {
+                "description": "ルートへのコメント",
                 "properties": {
-                    "user": {
-                        "$ref": "#/components/schemas/user.UserResponseModel"
+                    "content": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "string"
                     }
                 },
                 "type": "object"
             },
-            "user.UserResponseModel": {
+            "feed.FeedItemResponseModel": {
                 "properties": {
-                    "administrative_area": {
+                    "actor_id": {
                         "type": "string"
                     },
-                    "country_code": {
+                    "actor_name": {
                         "type": "string"
                     },
-                    "description": {
-                        "type": "string"
+                    "comment": {
+                        "$ref": "#/components/schemas/feed.FeedCommentResponseModel"
                     },
-                    "highlighted_photo_id": {
-                        "type": "integer"
+                    "created_at": {
+                        "type": "string"
                     },
                     "id": {
                         "type": "string"
                     },
-                    "locality": {
+                    "route": {
+                        "$ref": "#/components/schemas/feed.FeedRouteResponseModel"
+                    },
+                    "trip": {
+                        "$ref": "#/components/schemas/feed.FeedTripResponseModel"
+                    },
+                    "type": {
+                        "description": "route_created, trip_uploaded, route_liked, route_commented",
                         "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "feed.FeedResponse": {
+                "properties": {
+                    "items": {
+                        "items": {
+                            "$ref": "#/components/schemas/feed.FeedItemResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
                     },
-                    "name": {
+                    "next_cursor": {
+                        "description": "次のページがない場合はnull",
                         "type": "string"
                     }
                 },
                 "type": "object"
-            }
-        },
-        "securitySchemes": {
-            "CookieAuth": {
-                "in": "cookie",
-                "name": "ory_kratos_session",
-                "type": "apiKey"
-            }
-        }
-    },
-    "info": {
-        "description": "{{escape .Description}}",
-        "title": "{{.Title}}",
-        "version": "{{.Version}}"
-    },
-    "externalDocs": {
-        "description": "",
-        "url": ""
-    },
-    "paths": {
-        "/routes": {
-            "get": {
+            },
+            "feed.FeedRouteResponseModel": {
+                "description": "ルートの作成・いいね・コメントの対象",
+                "properties": {
+                    "distance": {
+                        "type": "number"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "polyline": {
+                        "type": "string"
+                    },
+                    "user_id": {
+                        "type": "string"
+                    },
+                    "user_name": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "feed.FeedTripResponseModel": {
+                "description": "アップロードしたトリップ",
+                "properties": {
+                    "departed_at": {
+                        "type": "string"
+                    },
+                    "distance": {
+                        "type": "number"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "moving_time": {
+                        "type": "integer"
+                    },
+                    "name": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "notification.NotificationListResponse": {
+                "properties": {
+                    "next_cursor": {
+                        "description": "次のページがない場合はnull",
+                        "type": "string"
+                    },
+                    "notifications": {
+                        "items": {
+                            "$ref": "#/components/schemas/notification.NotificationResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "notification.NotificationResponseModel": {
+                "properties": {
+                    "actor_id": {
+                        "type": "string"
+                    },
+                    "actor_name": {
+                        "type": "string"
+                    },
+                    "created_at": {
+                        "type": "string"
+                    },
+                    "event_id": {
+                        "description": "イベントの中止の通知のみ",
+                        "type": "string"
+                    },
+                    "event_title": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "read": {
+                        "type": "boolean"
+                    },
+                    "route_id": {
+                        "description": "フォロー・イベントの通知ではnull",
+                        "type": "string"
+                    },
+                    "route_name": {
+                        "type": "string"
+                    },
+                    "type": {
+                        "description": "route_liked, route_commented, route_forked, route_saved, user_followed, event_cancelled",
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "notification.PreferenceRequest": {
+                "properties": {
+                    "enabled": {
+                        "type": "boolean"
+                    },
+                    "type": {
+                        "enum": [
+                            "route_liked",
+                            "route_commented",
+                            "route_forked",
+                            "route_saved",
+                            "user_followed",
+                            "event_cancelled"
+                        ],
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "type"
+                ],
+                "type": "object"
+            },
+            "notification.PreferenceResponseModel": {
+                "properties": {
+                    "enabled": {
+                        "type": "boolean"
+                    },
+                    "type": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "notification.PreferencesResponse": {
+                "properties": {
+                    "preferences": {
+                        "items": {
+                            "$ref": "#/components/schemas/notification.PreferenceResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "notification.UnreadCountResponse": {
+                "properties": {
+                    "count": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "notification.UpdatePreferencesRequest": {
+                "properties": {
+                    "preferences": {
+                        "items": {
+                            "$ref": "#/components/schemas/notification.PreferenceRequest"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "required": [
+                    "preferences"
+                ],
+                "type": "object"
+            },
+            "response.ErrorResponse": {
+                "properties": {
+                    "code": {
+                        "example": 400,
+                        "type": "integer"
+                    },
+                    "msg": {
+                        "example": "Bad Request",
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "route.ConvertTripRequest": {
+                "properties": {
+                    "description": {
+                        "maxLength": 1000,
+                        "type": "string"
+                    },
+                    "name": {
+                        "maxLength": 255,
+                        "type": "string"
+                    },
+                    "visibility": {
+                        "maximum": 2,
+                        "minimum": 0,
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "route.CoursePointRequest": {
+                "properties": {
+                    "bearing_after": {
+                        "type": "integer"
+                    },
+                    "bearing_before": {
+                        "type": "integer"
+                    },
+                    "cum_dist_m": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "instruction": {
+                        "type": "string"
+                    },
+                    "location": {
+                        "type": "string"
+                    },
+                    "maneuver_type": {
+                        "type": "string"
+                    },
+                    "modifier": {
+                        "type": "string"
+                    },
+                    "road_name": {
+                        "type": "string"
+                    },
+                    "seg_dist_m": {
+                        "type": "number"
+                    }
+                },
+                "required": [
+                    "location"
+                ],
+                "type": "object"
+            },
+            "route.CoursePointResponse": {
+                "properties": {
+                    "bearing_after": {
+                        "type": "integer"
+                    },
+                    "bearing_before": {
+                        "type": "integer"
+                    },
+                    "cum_dist_m": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "instruction": {
+                        "type": "string"
+                    },
+                    "location": {
+                        "type": "string"
+                    },
+                    "maneuver_type": {
+                        "type": "string"
+                    },
+                    "modifier": {
+                        "type": "string"
+                    },
+                    "road_name": {
+                        "type": "string"
+                    },
+                    "seg_dist_m": {
+                        "type": "number"
+                    },
+                    "step_order": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "route.CreateRouteRequest": {
+                "properties": {
+                    "club_id": {
+                        "description": "公開範囲がクラブのメンバーのみ(3)の場合の共有先",
+                        "type": "string"
+                    },
+                    "course_points": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.CoursePointRequest"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "description": {
+                        "maxLength": 1000,
+                        "type": "string"
+                    },
+                    "distance": {
+                        "minimum": 0,
+                        "type": "number"
+                    },
+                    "duration": {
+                        "minimum": 0,
+                        "type": "number"
+                    },
+                    "elevation_gain": {
+                        "minimum": 0,
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "minimum": 0,
+                        "type": "number"
+                    },
+                    "first_point": {
+                        "type": "string"
+                    },
+                    "highlighted_photo_id": {
+                        "type": "integer"
+                    },
+                    "last_point": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "maxLength": 255,
+                        "type": "string"
+                    },
+                    "path_geom": {
+                        "type": "string"
+                    },
+                    "visibility": {
+                        "maximum": 3,
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "waypoints": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.WaypointRequest"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "required": [
+                    "distance",
+                    "duration",
+                    "first_point",
+                    "last_point",
+                    "name",
+                    "path_geom",
+                    "visibility"
+                ],
+                "type": "object"
+            },
+            "route.CueSheetResponse": {
+                "properties": {
+                    "course_points": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.CoursePointResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "route.JoinRoutesRequest": {
+                "properties": {
+                    "route_id": {
+                        "description": "終点の後ろにつなげるルート",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "route_id"
+                ],
+                "type": "object"
+            },
+            "route.MatchTripResponse": {
+                "properties": {
+                    "course_points": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.CoursePointResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "distance": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "first_point": {
+                        "type": "string"
+                    },
+                    "last_point": {
+                        "type": "string"
+                    },
+                    "matched_points": {
+                        "description": "道路に照合できたGPSの点の数",
+                        "type": "integer"
+                    },
+                    "path_geom": {
+                        "type": "string"
+                    },
+                    "segments": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.MatchedSegmentResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "total_points": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "route.MatchedSegmentResponse": {
+                "properties": {
+                    "distance": {
+                        "type": "number"
+                    },
+                    "highway": {
+                        "type": "string"
+                    },
+                    "path_geom": {
+                        "type": "string"
+                    },
+                    "road_name": {
+                        "type": "string"
+                    },
+                    "surface": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "route.PlaceResponse": {
+                "description": "ルート詳細のみ。地名が分からない場合は省略",
+                "properties": {
+                    "administrative_area": {
+                        "description": "都道府県。分からない場合は空文字",
+                        "type": "string"
+                    },
+                    "country_code": {
+                        "type": "string"
+                    },
+                    "locality": {
+                        "description": "市区町村。分からない場合は空文字",
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "route.PlanRouteRequest": {
+                "properties": {
+                    "profile": {
+                        "description": "省略時はroad",
+                        "enum": [
+                            "road",
+                            "gravel",
+                            "avoid_highways"
+                        ],
+                        "type": "string"
+                    },
+                    "waypoints": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.WaypointRequest"
+                        },
+                        "maxItems": 25,
+                        "minItems": 2,
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "required": [
+                    "waypoints"
+                ],
+                "type": "object"
+            },
+            "route.PlanRouteResponse": {
+                "properties": {
+                    "course_points": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.CoursePointResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "distance": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "first_point": {
+                        "type": "string"
+                    },
+                    "last_point": {
+                        "type": "string"
+                    },
+                    "path_geom": {
+                        "type": "string"
+                    },
+                    "profile": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "route.PromotePOIRequest": {
+                "properties": {
+                    "as": {
+                        "enum": [
+                            "waypoint",
+                            "course_point"
+                        ],
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "as"
+                ],
+                "type": "object"
+            },
+            "route.RouteCommentListResponse": {
+                "properties": {
+                    "comments": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.RouteCommentResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "next_cursor": {
+                        "description": "次のページがない場合はnull",
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteCommentRequest": {
+                "properties": {
+                    "content": {
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "content"
+                ],
+                "type": "object"
+            },
+            "route.RouteCommentResponse": {
+                "properties": {
+                    "comment": {
+                        "$ref": "#/components/schemas/route.RouteCommentResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteCommentResponseModel": {
+                "properties": {
+                    "content": {
+                        "type": "string"
+                    },
+                    "created_at": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "user_id": {
+                        "type": "string"
+                    },
+                    "user_name": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteHighlightResponse": {
+                "description": "キーワード検索時のみ",
+                "properties": {
+                    "description": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteListResponse": {
+                "properties": {
+                    "next_cursor": {
+                        "description": "次のページがない場合はnull",
+                        "type": "string"
+                    },
+                    "routes": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.RouteResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "route.RoutePOIListResponse": {
+                "properties": {
+                    "next_cursor": {
+                        "description": "次のページがない場合はnull",
+                        "type": "string"
+                    },
+                    "pois": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.RoutePOIResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "route.RoutePOIResponse": {
+                "properties": {
+                    "category": {
+                        "description": "cafe, convenience, drinking_water, bicycle_shop, toilets, viewpoint",
+                        "type": "string"
+                    },
+                    "cum_dist_m": {
+                        "description": "経路上の位置（始点からの距離(m)）",
+                        "type": "number"
+                    },
+                    "id": {
+                        "type": "integer"
+                    },
+                    "location": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "offset_m": {
+                        "description": "経路からの距離(m)",
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteResponse": {
+                "properties": {
+                    "route": {
+                        "$ref": "#/components/schemas/route.RouteResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteResponseModel": {
+                "properties": {
+                    "bbox": {
+                        "type": "string"
+                    },
+                    "club_id": {
+                        "description": "公開範囲がクラブのメンバーのみの場合の共有先",
+                        "type": "string"
+                    },
+                    "course_points": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.CoursePointResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "created_at": {
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "difficulty": {
+                        "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
+                        "type": "string"
+                    },
+                    "distance": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "type": "number"
+                    },
+                    "end_place": {
+                        "$ref": "#/components/schemas/route.PlaceResponse"
+                    },
+                    "estimated_duration_for_me": {
+                        "description": "ルート詳細のみ。ログインユーザーの過去のトリップから推定した所要時間(s)。未ログインの場合は省略",
+                        "type": "number"
+                    },
+                    "first_point": {
+                        "type": "string"
+                    },
+                    "fork_count": {
+                        "description": "ルート詳細のみ",
+                        "type": "integer"
+                    },
+                    "forked_from_route_id": {
+                        "description": "フォーク元のルートID",
+                        "type": "string"
+                    },
+                    "highlight": {
+                        "$ref": "#/components/schemas/route.RouteHighlightResponse"
+                    },
+                    "highlighted_photo_id": {
+                        "type": "integer"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "last_point": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "path_geom": {
+                        "type": "string"
+                    },
+                    "polyline": {
+                        "type": "string"
+                    },
+                    "start_place": {
+                        "$ref": "#/components/schemas/route.PlaceResponse"
+                    },
+                    "surface_breakdown": {
+                        "$ref": "#/components/schemas/route.SurfaceBreakdownResponse"
+                    },
+                    "tags": {
+                        "description": "タグがない場合は省略",
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "updated_at": {
+                        "type": "string"
+                    },
+                    "user_id": {
+                        "type": "string"
+                    },
+                    "user_name": {
+                        "type": "string"
+                    },
+                    "visibility": {
+                        "type": "integer"
+                    },
+                    "waypoints": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.WaypointResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteTagsResponse": {
+                "properties": {
+                    "tags": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteVersionDetailResponseModel": {
+                "properties": {
+                    "course_points": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.CoursePointResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "created_at": {
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "diff": {
+                        "$ref": "#/components/schemas/route.RouteVersionDiffResponse"
+                    },
+                    "distance": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "editor_id": {
+                        "description": "この版を置き換える更新を行ったユーザー",
+                        "type": "string"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "type": "number"
+                    },
+                    "first_point": {
+                        "type": "string"
+                    },
+                    "highlighted_photo_id": {
+                        "type": "integer"
+                    },
+                    "last_point": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "path_geom": {
+                        "type": "string"
+                    },
+                    "route_id": {
+                        "type": "string"
+                    },
+                    "version_number": {
+                        "type": "integer"
+                    },
+                    "visibility": {
+                        "type": "integer"
+                    },
+                    "waypoints": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.WaypointResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteVersionDiffResponse": {
+                "properties": {
+                    "distance": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteVersionListResponse": {
+                "properties": {
+                    "versions": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.RouteVersionResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteVersionResponse": {
+                "properties": {
+                    "version": {
+                        "$ref": "#/components/schemas/route.RouteVersionDetailResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "route.RouteVersionResponseModel": {
+                "properties": {
+                    "created_at": {
+                        "type": "string"
+                    },
+                    "diff": {
+                        "$ref": "#/components/schemas/route.RouteVersionDiffResponse"
+                    },
+                    "distance": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "editor_id": {
+                        "description": "この版を置き換える更新を行ったユーザー",
+                        "type": "string"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "type": "number"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "version_number": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "route.SimilarRouteListResponse": {
+                "properties": {
+                    "routes": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.SimilarRouteResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "route.SimilarRouteResponseModel": {
+                "properties": {
+                    "bbox": {
+                        "type": "string"
+                    },
+                    "club_id": {
+                        "description": "公開範囲がクラブのメンバーのみの場合の共有先",
+                        "type": "string"
+                    },
+                    "course_points": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.CoursePointResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "created_at": {
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "difficulty": {
+                        "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
+                        "type": "string"
+                    },
+                    "distance": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "type": "number"
+                    },
+                    "end_place": {
+                        "$ref": "#/components/schemas/route.PlaceResponse"
+                    },
+                    "estimated_duration_for_me": {
+                        "description": "ルート詳細のみ。ログインユーザーの過去のトリップから推定した所要時間(s)。未ログインの場合は省略",
+                        "type": "number"
+                    },
+                    "first_point": {
+                        "type": "string"
+                    },
+                    "fork_count": {
+                        "description": "ルート詳細のみ",
+                        "type": "integer"
+                    },
+                    "forked_from_route_id": {
+                        "description": "フォーク元のルートID",
+                        "type": "string"
+                    },
+                    "frechet_distance": {
+                        "description": "進行方向を考慮した形状の近さ(m)",
+                        "type": "number"
+                    },
+                    "hausdorff_distance": {
+                        "description": "形状の近さ(m)",
+                        "type": "number"
+                    },
+                    "highlight": {
+                        "$ref": "#/components/schemas/route.RouteHighlightResponse"
+                    },
+                    "highlighted_photo_id": {
+                        "type": "integer"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "is_duplicate": {
+                        "description": "ほぼ同一のルートかどうか",
+                        "type": "boolean"
+                    },
+                    "last_point": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "path_geom": {
+                        "type": "string"
+                    },
+                    "polyline": {
+                        "type": "string"
+                    },
+                    "start_place": {
+                        "$ref": "#/components/schemas/route.PlaceResponse"
+                    },
+                    "surface_breakdown": {
+                        "$ref": "#/components/schemas/route.SurfaceBreakdownResponse"
+                    },
+                    "tags": {
+                        "description": "タグがない場合は省略",
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "updated_at": {
+                        "type": "string"
+                    },
+                    "user_id": {
+                        "type": "string"
+                    },
+                    "user_name": {
+                        "type": "string"
+                    },
+                    "visibility": {
+                        "type": "integer"
+                    },
+                    "waypoints": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.WaypointResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "route.SplitRouteRequest": {
+                "properties": {
+                    "name": {
+                        "description": "後半のルートの名前。省略時は元の名前に「(2)」を付ける",
+                        "maxLength": 255,
+                        "type": "string"
+                    },
+                    "point": {
+                        "description": "分割する地点（GeoJSON Point）",
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "point"
+                ],
+                "type": "object"
+            },
+            "route.SurfaceBreakdownResponse": {
+                "description": "ルート詳細のみ。未作成の場合は省略",
+                "properties": {
+                    "road_classes": {
+                        "description": "OSMのhighwayタグ（residential, cyclewayなど）",
+                        "items": {
+                            "$ref": "#/components/schemas/route.SurfaceShareResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "surfaces": {
+                        "description": "paved, gravel, dirt, unknown",
+                        "items": {
+                            "$ref": "#/components/schemas/route.SurfaceShareResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "unpaved_percentage": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "route.SurfaceShareResponse": {
+                "properties": {
+                    "category": {
+                        "type": "string"
+                    },
+                    "distance": {
+                        "description": "距離(m)",
+                        "type": "number"
+                    },
+                    "percentage": {
+                        "description": "割合(%)",
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "route.TrimRouteRequest": {
+                "properties": {
+                    "end_distance": {
+                        "type": "number"
+                    },
+                    "start_distance": {
+                        "minimum": 0,
+                        "type": "number"
+                    }
+                },
+                "required": [
+                    "end_distance"
+                ],
+                "type": "object"
+            },
+            "route.UpdateRouteRequest": {
+                "properties": {
+                    "club_id": {
+                        "description": "公開範囲がクラブのメンバーのみ(3)の場合の共有先",
+                        "type": "string"
+                    },
+                    "course_points": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.CoursePointRequest"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "description": {
+                        "maxLength": 1000,
+                        "type": "string"
+                    },
+                    "distance": {
+                        "minimum": 0,
+                        "type": "number"
+                    },
+                    "duration": {
+                        "minimum": 0,
+                        "type": "number"
+                    },
+                    "elevation_gain": {
+                        "minimum": 0,
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "minimum": 0,
+                        "type": "number"
+                    },
+                    "first_point": {
+                        "type": "string"
+                    },
+                    "highlighted_photo_id": {
+                        "type": "integer"
+                    },
+                    "last_point": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "maxLength": 255,
+                        "type": "string"
+                    },
+                    "path_geom": {
+                        "type": "string"
+                    },
+                    "visibility": {
+                        "maximum": 3,
+                        "minimum": 0,
+                        "type": "integer"
+                    },
+                    "waypoints": {
+                        "items": {
+                            "$ref": "#/components/schemas/route.WaypointRequest"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "required": [
+                    "distance",
+                    "duration",
+                    "first_point",
+                    "last_point",
+                    "name",
+                    "path_geom",
+                    "visibility"
+                ],
+                "type": "object"
+            },
+            "route.UpdateRouteTagsRequest": {
+                "properties": {
+                    "tags": {
+                        "items": {
+                            "type": "string"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "required": [
+                    "tags"
+                ],
+                "type": "object"
+            },
+            "route.WaypointRequest": {
+                "properties": {
+                    "location": {
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "location"
+                ],
+                "type": "object"
+            },
+            "route.WaypointResponse": {
+                "properties": {
+                    "id": {
+                        "type": "string"
+                    },
+                    "location": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "tour.TourListResponse": {
+                "properties": {
+                    "tours": {
+                        "items": {
+                            "$ref": "#/components/schemas/tour.TourResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "tour.TourRequest": {
+                "properties": {
+                    "club_id": {
+                        "description": "公開範囲がクラブのメンバーのみの場合の共有先",
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "stages": {
+                        "description": "日程の順",
+                        "items": {
+                            "$ref": "#/components/schemas/tour.TourStageRequest"
+                        },
+                        "minItems": 1,
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "visibility": {
+                        "description": "0: 非公開, 1: 公開, 2: 友達のみ, 3: クラブのメンバーのみ",
+                        "maximum": 3,
+                        "minimum": 0,
+                        "type": "integer"
+                    }
+                },
+                "required": [
+                    "name",
+                    "stages"
+                ],
+                "type": "object"
+            },
+            "tour.TourResponse": {
+                "properties": {
+                    "tour": {
+                        "$ref": "#/components/schemas/tour.TourResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "tour.TourResponseModel": {
+                "properties": {
+                    "club_id": {
+                        "description": "公開範囲がクラブのメンバーのみの場合の共有先",
+                        "type": "string"
+                    },
+                    "created_at": {
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "distance": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "type": "number"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "stages": {
+                        "description": "日程の順",
+                        "items": {
+                            "$ref": "#/components/schemas/tour.TourStageResponse"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    },
+                    "updated_at": {
+                        "type": "string"
+                    },
+                    "user_id": {
+                        "type": "string"
+                    },
+                    "visibility": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "tour.TourStageRequest": {
+                "properties": {
+                    "overnight_stop": {
+                        "type": "string"
+                    },
+                    "route_id": {
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "route_id"
+                ],
+                "type": "object"
+            },
+            "tour.TourStageResponse": {
+                "properties": {
+                    "day": {
+                        "description": "1日目が1",
+                        "type": "integer"
+                    },
+                    "difficulty": {
+                        "description": "easy, moderate, hard, expert。まだ求めていない場合は省略",
+                        "type": "string"
+                    },
+                    "distance": {
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "number"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "type": "number"
+                    },
+                    "overnight_stop": {
+                        "type": "string"
+                    },
+                    "polyline": {
+                        "type": "string"
+                    },
+                    "route_id": {
+                        "type": "string"
+                    },
+                    "route_name": {
+                        "type": "string"
+                    },
+                    "user_name": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "trip.TripListResponse": {
+                "properties": {
+                    "next_cursor": {
+                        "description": "次のページがない場合はnull",
+                        "type": "string"
+                    },
+                    "trips": {
+                        "items": {
+                            "$ref": "#/components/schemas/trip.TripResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "trip.TripResponseModel": {
+                "properties": {
+                    "created_at": {
+                        "type": "string"
+                    },
+                    "departed_at": {
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "distance": {
+                        "description": "計測値を求めていない場合はnull",
+                        "type": "number"
+                    },
+                    "duration": {
+                        "type": "integer"
+                    },
+                    "elevation_gain": {
+                        "type": "number"
+                    },
+                    "elevation_loss": {
+                        "type": "number"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "moving_time": {
+                        "type": "integer"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "visibility": {
+                        "type": "integer"
+                    }
+                },
+                "type": "object"
+            },
+            "user.CreatePrivacyZoneRequest": {
+                "properties": {
+                    "center": {
+                        "description": "GeoJSONのPoint",
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "radius_m": {
+                        "description": "半径(m)。100〜2000",
+                        "type": "number"
+                    }
+                },
+                "required": [
+                    "center",
+                    "radius_m"
+                ],
+                "type": "object"
+            },
+            "user.CreateUserRequest": {
+                "properties": {
+                    "email": {
+                        "type": "string"
+                    },
+                    "first_name": {
+                        "type": "string"
+                    },
+                    "kratos_id": {
+                        "type": "string"
+                    },
+                    "last_name": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "kratos_id",
+                    "name"
+                ],
+                "type": "object"
+            },
+            "user.LoginUserResponse": {
+                "properties": {
+                    "user": {
+                        "$ref": "#/components/schemas/user.LoginUserResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "user.LoginUserResponseModel": {
+                "properties": {
+                    "administrative_area": {
+                        "type": "string"
+                    },
+                    "country_code": {
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "email": {
+                        "type": "string"
+                    },
+                    "first_name": {
+                        "type": "string"
+                    },
+                    "geom": {
+                        "type": "string"
+                    },
+                    "has_set_location": {
+                        "type": "boolean"
+                    },
+                    "highlighted_photo_id": {
+                        "type": "integer"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "last_name": {
+                        "type": "string"
+                    },
+                    "locale": {
+                        "type": "string"
+                    },
+                    "locality": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "postal_code": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "user.PrivacyZoneListResponse": {
+                "properties": {
+                    "privacy_zones": {
+                        "items": {
+                            "$ref": "#/components/schemas/user.PrivacyZoneResponseModel"
+                        },
+                        "type": "array",
+                        "uniqueItems": false
+                    }
+                },
+                "type": "object"
+            },
+            "user.PrivacyZoneResponse": {
+                "properties": {
+                    "privacy_zone": {
+                        "$ref": "#/components/schemas/user.PrivacyZoneResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "user.PrivacyZoneResponseModel": {
+                "properties": {
+                    "center": {
+                        "type": "string"
+                    },
+                    "created_at": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    },
+                    "radius_m": {
+                        "type": "number"
+                    }
+                },
+                "type": "object"
+            },
+            "user.UpdateUserLocationRequest": {
+                "properties": {
+                    "administrative_area": {
+                        "type": "string"
+                    },
+                    "country_code": {
+                        "type": "string"
+                    },
+                    "geom": {
+                        "type": "string"
+                    },
+                    "locality": {
+                        "type": "string"
+                    },
+                    "postal_code": {
+                        "type": "string"
+                    }
+                },
+                "required": [
+                    "geom",
+                    "postal_code"
+                ],
+                "type": "object"
+            },
+            "user.UpdateUserProfileRequest": {
+                "properties": {
+                    "description": {
+                        "type": "string"
+                    },
+                    "first_name": {
+                        "type": "string"
+                    },
+                    "last_name": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            },
+            "user.UserResponse": {
+                "properties": {
+                    "user": {
+                        "$ref": "#/components/schemas/user.UserResponseModel"
+                    }
+                },
+                "type": "object"
+            },
+            "user.UserResponseModel": {
+                "properties": {
+                    "administrative_area": {
+                        "type": "string"
+                    },
+                    "country_code": {
+                        "type": "string"
+                    },
+                    "description": {
+                        "type": "string"
+                    },
+                    "highlighted_photo_id": {
+                        "type": "integer"
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "locality": {
+                        "type": "string"
+                    },
+                    "name": {
+                        "type": "string"
+                    }
+                },
+                "type": "object"
+            }
+        },
+        "securitySchemes": {
+            "CookieAuth": {
+                "in": "cookie",
+                "name": "ory_kratos_session",
+                "type": "apiKey"
+            }
+        }
+    },
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "version": "{{.Version}}"
+    },
+    "externalDocs": {
+        "description": "",
+        "url": ""
+    },
+    "paths": {
+        "/clubs": {
+            "get": {
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/club.ClubListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "所属するクラブの一覧を取得する",
+                "tags": [
+                    "clubs"
+                ]
+            },
+            "post": {
+                "description": "作成したユーザーがオーナーになる",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/club.ClubRequest",
+                                        "summary": "request",
+                                        "description": "Create Club Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Create Club Request",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/club.ClubResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "クラブを作成する",
+                "tags": [
+                    "clubs"
+                ]
+            }
+        },
+        "/clubs/{club_id}": {
+            "delete": {
+                "description": "オーナーのみ。クラブのメンバーのみに公開していたルートとイベントは、所有者だけが閲覧できるようになる",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "クラブを削除する",
+                "tags": [
+                    "clubs"
+                ]
+            },
+            "get": {
+                "description": "メンバーでなくても取得でき、参加を申請できる",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/club.ClubResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "クラブを取得する",
+                "tags": [
+                    "clubs"
+                ]
+            },
+            "put": {
+                "description": "オーナーと管理者のみ",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/club.ClubRequest",
+                                        "summary": "request",
+                                        "description": "Update Club Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Update Club Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/club.ClubResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "クラブの名前と説明を更新する",
+                "tags": [
+                    "clubs"
+                ]
+            }
+        },
+        "/clubs/{club_id}/join-requests": {
+            "get": {
+                "description": "オーナーと管理者のみ。古い順に返す",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/club.JoinRequestListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "クラブへの参加申請の一覧を取得する",
+                "tags": [
+                    "clubs"
+                ]
+            },
+            "post": {
+                "description": "オーナーか管理者が承認するとメンバーになる。申請済みの場合は何もしない",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/club.JoinClubRequest",
+                                        "summary": "request",
+                                        "description": "Join Club Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Join Club Request",
+                    "required": true
+                },
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "すでにメンバー"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "クラブへの参加を申請する",
+                "tags": [
+                    "clubs"
+                ]
+            }
+        },
+        "/clubs/{club_id}/join-requests/{user_id}": {
+            "delete": {
+                "description": "オーナーと管理者のみ",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "申請したユーザーのID",
+                        "in": "path",
+                        "name": "user_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "クラブへの参加申請を断る",
+                "tags": [
+                    "clubs"
+                ]
+            }
+        },
+        "/clubs/{club_id}/join-requests/{user_id}/approve": {
+            "post": {
+                "description": "オーナーと管理者のみ。申請したユーザーは一般のメンバーになる",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "申請したユーザーのID",
+                        "in": "path",
+                        "name": "user_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/club.MemberResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "クラブへの参加申請を承認する",
+                "tags": [
+                    "clubs"
+                ]
+            }
+        },
+        "/clubs/{club_id}/members": {
+            "get": {
+                "description": "メンバーのみ。参加した順に返す",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/club.MemberListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "クラブのメンバーの一覧を取得する",
+                "tags": [
+                    "clubs"
+                ]
+            }
+        },
+        "/clubs/{club_id}/members/{user_id}": {
+            "delete": {
+                "description": "自分を指定した場合はクラブを抜ける。オーナーは他のメンバーを、管理者は一般のメンバーを外せる。オーナーは抜けられない",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "User ID",
+                        "in": "path",
+                        "name": "user_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "メンバーをクラブから外す",
+                "tags": [
+                    "clubs"
+                ]
+            },
+            "put": {
+                "description": "オーナーのみ。オーナーの交代はできない",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "User ID",
+                        "in": "path",
+                        "name": "user_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/club.ChangeMemberRoleRequest",
+                                        "summary": "request",
+                                        "description": "Change Member Role Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Change Member Role Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/club.MemberResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "メンバーの役割を変更する",
+                "tags": [
+                    "clubs"
+                ]
+            }
+        },
+        "/clubs/{club_id}/routes": {
+            "get": {
+                "description": "メンバーのみ。追加した新しい順に、閲覧できるルートだけを返す",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/club.LibraryRouteListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "共有ルートライブラリのルートの一覧を取得する",
+                "tags": [
+                    "clubs"
+                ]
+            },
+            "post": {
+                "description": "オーナーと管理者のみ。公開ルートか、このクラブのメンバーのみに公開されたルートを追加できる",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/club.AddLibraryRouteRequest",
+                                        "summary": "request",
+                                        "description": "Add Library Route Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Add Library Route Request",
+                    "required": true
+                },
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "他のメンバーが閲覧できないルート"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "追加済みのルート"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "共有ルートライブラリにルートを追加する",
+                "tags": [
+                    "clubs"
+                ]
+            }
+        },
+        "/clubs/{club_id}/routes/{route_id}": {
+            "delete": {
+                "description": "オーナーと管理者のみ。ルート自体は削除しない",
+                "parameters": [
+                    {
+                        "description": "Club ID",
+                        "in": "path",
+                        "name": "club_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "共有ルートライブラリからルートを取り除く",
+                "tags": [
+                    "clubs"
+                ]
+            }
+        },
+        "/collections": {
+            "get": {
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/collection.CollectionListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "自分のコレクションの一覧を取得する",
+                "tags": [
+                    "collections"
+                ]
+            },
+            "post": {
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/collection.CollectionRequest",
+                                        "summary": "request",
+                                        "description": "Create Collection Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Create Collection Request",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/collection.CollectionResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "コレクションを作成する",
+                "tags": [
+                    "collections"
+                ]
+            }
+        },
+        "/collections/{collection_id}": {
+            "delete": {
+                "description": "中のルートは削除しない",
+                "parameters": [
+                    {
+                        "description": "Collection ID",
+                        "in": "path",
+                        "name": "collection_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "コレクションを削除する",
+                "tags": [
+                    "collections"
+                ]
+            },
+            "get": {
+                "description": "公開のコレクションは未ログインでも取得できる。中のルートは閲覧できるものだけを返す",
+                "parameters": [
+                    {
+                        "description": "Collection ID",
+                        "in": "path",
+                        "name": "collection_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/collection.CollectionResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "コレクションを取得する",
+                "tags": [
+                    "collections"
+                ]
+            },
+            "put": {
+                "parameters": [
+                    {
+                        "description": "Collection ID",
+                        "in": "path",
+                        "name": "collection_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/collection.CollectionRequest",
+                                        "summary": "request",
+                                        "description": "Update Collection Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Update Collection Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/collection.CollectionResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "コレクションの名前・説明・公開範囲を更新する",
+                "tags": [
+                    "collections"
+                ]
+            }
+        },
+        "/collections/{collection_id}/routes": {
+            "post": {
+                "description": "自分のルートのほか、他のユーザーの公開ルートも追加できる",
+                "parameters": [
+                    {
+                        "description": "Collection ID",
+                        "in": "path",
+                        "name": "collection_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/collection.AddCollectionRouteRequest",
+                                        "summary": "request",
+                                        "description": "Add Route Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Add Route Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/collection.CollectionResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "追加済みのルート"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "コレクションの末尾にルートを追加する",
+                "tags": [
+                    "collections"
+                ]
+            },
+            "put": {
+                "description": "コレクション内のすべてのルートを過不足なく、並べたい順に指定する",
+                "parameters": [
+                    {
+                        "description": "Collection ID",
+                        "in": "path",
+                        "name": "collection_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/collection.ReorderCollectionRoutesRequest",
+                                        "summary": "request",
+                                        "description": "Reorder Routes Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Reorder Routes Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/collection.CollectionResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "コレクション内のルートを並べ替える",
+                "tags": [
+                    "collections"
+                ]
+            }
+        },
+        "/collections/{collection_id}/routes/{route_id}": {
+            "delete": {
+                "parameters": [
+                    {
+                        "description": "Collection ID",
+                        "in": "path",
+                        "name": "collection_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/collection.CollectionResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "コレクションからルートを取り除く",
+                "tags": [
+                    "collections"
+                ]
+            }
+        },
+        "/events": {
+            "get": {
+                "description": "公開のイベントを開始日時の早い順に返す。中止したイベントは含まない。lat・lng・rを指定すると集合場所がその範囲内のイベントに絞り込む",
+                "parameters": [
+                    {
+                        "description": "Latitude of the reference point",
+                        "in": "query",
+                        "name": "lat",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Longitude of the reference point",
+                        "in": "query",
+                        "name": "lng",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Search radius from the meeting point (meters)",
+                        "in": "query",
+                        "name": "r",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/event.EventListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "開催予定のイベントの一覧を取得する",
+                "tags": [
+                    "events"
+                ]
+            },
+            "post": {
+                "description": "ルートには自分のルートのほか、他のユーザーの公開ルートも使える。公開のイベントには公開のルートが必要",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/event.EventRequest",
+                                        "summary": "request",
+                                        "description": "Create Event Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Create Event Request",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/event.EventResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "閲覧できないルート"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "イベントを作成する",
+                "tags": [
+                    "events"
+                ]
+            }
+        },
+        "/events/{event_id}": {
+            "get": {
+                "description": "公開のイベントは未ログインでも取得できる。ログインしている場合は自分の返事も返す",
+                "parameters": [
+                    {
+                        "description": "Event ID",
+                        "in": "path",
+                        "name": "event_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/event.EventResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "イベントを取得する",
+                "tags": [
+                    "events"
+                ]
+            },
+            "put": {
+                "description": "すべての項目をまとめて置き換える。中止したイベントは変更できず、上限は参加者数より少なくできない",
+                "parameters": [
+                    {
+                        "description": "Event ID",
+                        "in": "path",
+                        "name": "event_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/event.EventRequest",
+                                        "summary": "request",
+                                        "description": "Update Event Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Update Event Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/event.EventResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "イベントを更新する",
+                "tags": [
+                    "events"
+                ]
+            }
+        },
+        "/events/{event_id}/cancel": {
+            "post": {
+                "description": "参加する・未定と返事したユーザーに中止を知らせる。開始後のイベントは中止できない",
+                "parameters": [
+                    {
+                        "description": "Event ID",
+                        "in": "path",
+                        "name": "event_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/event.CancelEventRequest",
+                                        "summary": "request",
+                                        "description": "Cancel Event Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Cancel Event Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/event.EventResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "イベントを中止する",
+                "tags": [
+                    "events"
+                ]
+            }
+        },
+        "/events/{event_id}/comments": {
+            "get": {
+                "parameters": [
+                    {
+                        "description": "Event ID",
+                        "in": "path",
+                        "name": "event_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/event.CommentListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "イベントへのコメントの一覧を取得する",
+                "tags": [
+                    "events"
+                ]
+            },
+            "post": {
+                "parameters": [
+                    {
+                        "description": "Event ID",
+                        "in": "path",
+                        "name": "event_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/event.CommentRequest",
+                                        "summary": "request",
+                                        "description": "Comment Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Comment Request",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/event.CommentResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "イベントにコメントする",
+                "tags": [
+                    "events"
+                ]
+            }
+        },
+        "/events/{event_id}/comments/{comment_id}": {
+            "delete": {
+                "description": "削除できるのはコメントした本人とイベントの主催者",
+                "parameters": [
+                    {
+                        "description": "Event ID",
+                        "in": "path",
+                        "name": "event_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comment ID",
+                        "in": "path",
+                        "name": "comment_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "イベントへのコメントを削除する",
+                "tags": [
+                    "events"
+                ]
+            }
+        },
+        "/events/{event_id}/gpx": {
+            "get": {
+                "description": "ルートのGPX出力と同じ内容を返す。イベントとルートの両方を閲覧できる場合に限る",
+                "parameters": [
+                    {
+                        "description": "Event ID",
+                        "in": "path",
+                        "name": "event_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "GPX XML"
+                    },
+                    "404": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "イベントのルートをGPXで出力する",
+                "tags": [
+                    "events"
+                ]
+            }
+        },
+        "/events/{event_id}/rsvp": {
+            "put": {
+                "description": "返事し直すと上書きする。上限に達している場合は参加するとは返事できない",
+                "parameters": [
+                    {
+                        "description": "Event ID",
+                        "in": "path",
+                        "name": "event_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/event.RSVPRequest",
+                                        "summary": "request",
+                                        "description": "RSVP Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "RSVP Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/event.EventResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "中止・開始後のイベント、または上限に達している"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "イベントに参加の返事をする",
+                "tags": [
+                    "events"
+                ]
+            }
+        },
+        "/events/{event_id}/rsvps": {
+            "get": {
+                "parameters": [
+                    {
+                        "description": "Event ID",
+                        "in": "path",
+                        "name": "event_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/event.RSVPListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "イベントへの返事の一覧を取得する",
+                "tags": [
+                    "events"
+                ]
+            }
+        },
+        "/feed": {
+            "get": {
+                "description": "ルートの作成・トリップのアップロード・ルートへのいいね・コメントを新しい順に返す。閲覧できないルートやトリップの出来事は除くため、次のページがあっても件数がlimitより少ないことがある",
+                "parameters": [
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/feed.FeedResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "フォローしているユーザーのアクティビティフィードを取得する",
+                "tags": [
+                    "feed"
+                ]
+            }
+        },
+        "/notifications": {
+            "get": {
+                "description": "自分のルートへのいいね・コメント・フォーク・保存と、自分へのフォローの通知を新しい順に返す",
+                "parameters": [
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "未読の通知だけを返す",
+                        "in": "query",
+                        "name": "unread_only",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/notification.NotificationListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "自分への通知を取得する",
+                "tags": [
+                    "notifications"
+                ]
+            }
+        },
+        "/notifications/preferences": {
+            "get": {
+                "description": "すべての種類の設定を返す。既定ではすべての種類を受け取る",
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/notification.PreferencesResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "受け取る通知の設定を取得する",
+                "tags": [
+                    "notifications"
+                ]
+            },
+            "put": {
+                "description": "指定した種類の設定だけを変更し、変更後のすべての種類の設定を返す。受け取らない種類の通知は作成しない",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/notification.UpdatePreferencesRequest",
+                                        "summary": "request",
+                                        "description": "Update Preferences Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Update Preferences Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/notification.PreferencesResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "受け取る通知の設定を変更する",
+                "tags": [
+                    "notifications"
+                ]
+            }
+        },
+        "/notifications/read-all": {
+            "post": {
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "自分への通知をすべて既読にする",
+                "tags": [
+                    "notifications"
+                ]
+            }
+        },
+        "/notifications/unread-count": {
+            "get": {
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/notification.UnreadCountResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "自分への未読の通知の件数を取得する",
+                "tags": [
+                    "notifications"
+                ]
+            }
+        },
+        "/notifications/{notification_id}/read": {
+            "post": {
+                "description": "既読の通知を指定してもエラーにしない",
+                "parameters": [
+                    {
+                        "description": "Notification ID",
+                        "in": "path",
+                        "name": "notification_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "通知を既読にする",
+                "tags": [
+                    "notifications"
+                ]
+            }
+        },
+        "/routes": {
+            "get": {
+                "parameters": [
+                    {
+                        "description": "Keyword to search in route names, descriptions and road names",
+                        "in": "query",
+                        "name": "keyword",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum distance filter",
+                        "in": "query",
+                        "name": "min_distance",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum distance filter",
+                        "in": "query",
+                        "name": "max_distance",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum elevation gain filter (meters)",
+                        "in": "query",
+                        "name": "min_elevation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum elevation gain filter (meters)",
+                        "in": "query",
+                        "name": "max_elevation",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum duration filter (seconds)",
+                        "in": "query",
+                        "name": "min_duration",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum duration filter (seconds)",
+                        "in": "query",
+                        "name": "max_duration",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum climbing ratio filter (elevation gain m per km)",
+                        "in": "query",
+                        "name": "min_climbing_ratio",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum climbing ratio filter (elevation gain m per km)",
+                        "in": "query",
+                        "name": "max_climbing_ratio",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum unpaved (gravel and dirt) percentage filter (0-100)",
+                        "in": "query",
+                        "name": "max_unpaved_percentage",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Place name filter matching the start or end locality / administrative area by prefix",
+                        "in": "query",
+                        "name": "area",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Visibility filter",
+                        "in": "query",
+                        "name": "visibility",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Author name filter (partial match). Only narrows results with collection_id, since other searches return the caller's own routes",
+                        "in": "query",
+                        "name": "author",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Collection ID filter (includes other users' routes in the collection that the caller can view)",
+                        "in": "query",
+                        "name": "collection_id",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Tag filter",
+                        "in": "query",
+                        "name": "tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Sort order (default: relevance when keyword given, position when collection_id given, otherwise newest)",
+                        "in": "query",
+                        "name": "sort",
+                        "schema": {
+                            "enum": [
+                                "newest",
+                                "most_liked",
+                                "longest",
+                                "hilliest",
+                                "relevance",
+                                "position"
+                            ],
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "コレクションが存在しないか閲覧できない"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "ユーザーのルート一覧を取得する",
+                "tags": [
+                    "routes"
+                ]
+            },
+            "post": {
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.CreateRouteRequest",
+                                        "summary": "request",
+                                        "description": "Create Route Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Create Route Request",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "共有先のクラブに所属していない"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートを作成する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/explore": {
+            "get": {
+                "parameters": [
+                    {
+                        "description": "Keyword to search in route names, descriptions and road names",
+                        "in": "query",
+                        "name": "q",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Latitude of the reference point",
+                        "in": "query",
+                        "name": "lat",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Longitude of the reference point",
+                        "in": "query",
+                        "name": "lng",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Search radius (meters)",
+                        "in": "query",
+                        "name": "r",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Minimum distance filter (kilometers)",
+                        "in": "query",
+                        "name": "min_distance",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Maximum distance filter (kilometers)",
+                        "in": "query",
+                        "name": "max_distance",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Minimum elevation gain filter (meters)",
+                        "in": "query",
+                        "name": "min_elevation",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Maximum elevation gain filter (meters)",
+                        "in": "query",
+                        "name": "max_elevation",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Minimum duration filter (seconds)",
+                        "in": "query",
+                        "name": "min_duration",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Maximum duration filter (seconds)",
+                        "in": "query",
+                        "name": "max_duration",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Minimum climbing ratio filter (elevation gain m per km)",
+                        "in": "query",
+                        "name": "min_climbing_ratio",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Maximum climbing ratio filter (elevation gain m per km)",
+                        "in": "query",
+                        "name": "max_climbing_ratio",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Maximum unpaved (gravel and dirt) percentage filter (0-100)",
+                        "in": "query",
+                        "name": "max_unpaved_percentage",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "Place name filter matching the start or end locality / administrative area by prefix",
+                        "in": "query",
+                        "name": "area",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Minimum difficulty filter",
+                        "in": "query",
+                        "name": "min_difficulty",
+                        "schema": {
+                            "enum": [
+                                "easy",
+                                "moderate",
+                                "hard",
+                                "expert"
+                            ],
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum difficulty filter",
+                        "in": "query",
+                        "name": "max_difficulty",
+                        "schema": {
+                            "enum": [
+                                "easy",
+                                "moderate",
+                                "hard",
+                                "expert"
+                            ],
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Author name filter",
+                        "in": "query",
+                        "name": "author",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Sort order (default: nearest when lat/lng given, relevance when q given, otherwise newest)",
+                        "in": "query",
+                        "name": "sort",
+                        "schema": {
+                            "enum": [
+                                "nearest",
+                                "newest",
+                                "most_liked",
+                                "longest",
+                                "hilliest",
+                                "relevance"
+                            ],
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Collapse near-duplicate routes into one within the page. The page may then have fewer routes than limit even if next_cursor is set",
+                        "in": "query",
+                        "name": "collapse",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートを探索する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/plan": {
+            "post": {
+                "description": "ルーティングエンジンで経由地を順に通る経路とコースポイントを探索する。結果は保存しないため、そのままルート作成に使う",
+                "parameters": [
+                    {
+                        "description": "案内文の言語（ja, en）。省略時はユーザーのロケール",
+                        "in": "query",
+                        "name": "locale",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.PlanRouteRequest",
+                                        "summary": "request",
+                                        "description": "Plan Route Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Plan Route Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.PlanRouteResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "経由地を通るルートを探索する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/saved": {
+            "get": {
+                "description": "保存した日時の新しい順に並ぶ。保存した後に閲覧できなくなったルートは含まないため、1ページの件数がlimitより少ないことがある",
+                "parameters": [
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "保存したルートの一覧を取得する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}": {
+            "delete": {
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートを削除する",
+                "tags": [
+                    "routes"
+                ]
+            },
+            "get": {
+                "description": "ログインしている場合は、過去のトリップから推定した所要時間（estimated_duration_for_me）も返す。閲覧できないルートは見つからないものとして扱う",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteResponse"
+                                }
+                            }
+                        },
+                        "description": "OK",
+                        "headers": {
+                            "ETag": {
+                                "description": "ルートのバージョン。更新時にIf-Matchヘッダーに指定する",
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "ルートを取得する",
+                "tags": [
+                    "routes"
+                ]
+            },
+            "put": {
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "ルート取得時のETag",
+                        "in": "header",
+                        "name": "If-Match",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.UpdateRouteRequest",
+                                        "summary": "request",
+                                        "description": "Update Route Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Update Route Request",
+                    "required": true
+                },
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "共有先のクラブに所属していない"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "取得後に別のリクエストで更新されている"
+                    },
+                    "428": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "If-Matchヘッダーがない"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートを更新する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/comments": {
+            "get": {
+                "description": "未ログインでも取得できる。閲覧できないルートは見つからないものとして扱う。古い順に並ぶ",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteCommentListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "ルートへのコメントを取得する",
+                "tags": [
+                    "routes"
+                ]
+            },
+            "post": {
+                "description": "コメントはフォロワーのフィードに表示する",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.RouteCommentRequest",
+                                        "summary": "request",
+                                        "description": "Route Comment Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Route Comment Request",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteCommentResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートにコメントする",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/comments/{comment_id}": {
+            "delete": {
+                "description": "削除できるのはコメントした本人とルートの所有者",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Comment ID",
+                        "in": "path",
+                        "name": "comment_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートへのコメントを削除する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/cues": {
+            "post": {
+                "description": "path_geomの向きの変化から曲がり角を検出し、コースポイントを置き換える。置き換え前の状態は版として残る",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "案内文の言語（ja, en）。省略時はユーザーのロケール",
+                        "in": "query",
+                        "name": "locale",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.CueSheetResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートの形状からキューシートを生成する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/cuesheet.csv": {
+            "get": {
+                "description": "見出しと補う案内文はユーザーのロケールの言語になる。出力したCSVは編集してPUTで取り込める",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "距離の単位（km, mi）。省略時はkm",
+                        "in": "query",
+                        "name": "unit",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "text/csv": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "CSV"
+                    },
+                    "400": {
+                        "content": {
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "text/csv": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "キューシートをCSVで出力する",
+                "tags": [
+                    "routes"
+                ]
+            },
+            "put": {
+                "description": "cuesheet.csvで出力したCSVを取り込む。列は見出しで判別し、累積距離・lat・lonの列が必須。置き換え前の状態は版として残る",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "ルート取得時のETag",
+                        "in": "header",
+                        "name": "If-Match",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "text/csv": {
+                            "schema": {
+                                "type": "string"
+                            }
+                        },
+                        "text/plain": {
+                            "schema": {
+                                "title": "request",
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "description": "キューシートのCSV",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.CueSheetResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "取得後に別のリクエストで更新されている"
+                    },
+                    "428": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "If-Matchヘッダーがない"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "キューシートのCSVでコースポイントを置き換える",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/cuesheet.pdf": {
+            "get": {
+                "description": "見出しと補う案内文はユーザーのロケールの言語になる",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "距離の単位（km, mi）。省略時はkm",
+                        "in": "query",
+                        "name": "unit",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/pdf": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "PDF"
+                    },
+                    "400": {
+                        "content": {
+                            "application/pdf": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/pdf": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/pdf": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/pdf": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "キューシートを印刷用のPDFで出力する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/fork": {
+            "post": {
+                "description": "閲覧できるルートをコースポイント・ウェイポイントごと複製し、ログインユーザーの非公開ルートとして作成する",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートをフォークする",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/gpx": {
+            "get": {
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "GPX XML"
+                    },
+                    "400": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートをGPX形式でエクスポートする",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/join": {
+            "post": {
+                "description": "どちらも自分のルートである必要がある。終点と始点が離れている場合は直線で結ぶ。つなげたルートはそのまま残る",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.JoinRoutesRequest",
+                                        "summary": "request",
+                                        "description": "Join Routes Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Join Routes Request",
+                    "required": true
+                },
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "別のルートを終点の後ろにつなげる",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/like": {
+            "delete": {
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "いいねしていない"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートへのいいねを取り消す",
+                "tags": [
+                    "routes"
+                ]
+            },
+            "put": {
+                "description": "閲覧できないルートは見つからないものとして扱う。いいねはフォロワーのフィードに表示する",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "すでにいいねしている"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートにいいねする",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/pois": {
+            "get": {
+                "description": "経路から指定した距離内にあるカフェ・コンビニ・水飲み場・自転車店・トイレ・展望地を、経路上の位置（cum_dist_m）の順に返す。続きはnext_cursorで取得する",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "経路からの距離(m)。省略時は200、最大2000",
+                        "in": "query",
+                        "name": "buffer",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "description": "POIの種類（cafe, convenience, drinking_water, bicycle_shop, toilets, viewpoint）。カンマ区切りで複数指定できる",
+                        "in": "query",
+                        "name": "category",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RoutePOIListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルート沿いのPOIを取得する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/pois/{poi_id}/promote": {
+            "post": {
+                "description": "ウェイポイントは経路に沿った順序の位置に入れ、経路は変更しない。コースポイントは経路に射影した位置に入れ、操作タイプをpoi、修飾子をPOIの種類にする。追加前の状態は版として残る",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "POI ID",
+                        "in": "path",
+                        "name": "poi_id",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "ルート取得時のETag",
+                        "in": "header",
+                        "name": "If-Match",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.PromotePOIRequest",
+                                        "summary": "request",
+                                        "description": "Promote POI Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Promote POI Request",
+                    "required": true
+                },
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "取得後に別のリクエストで更新されている"
+                    },
+                    "428": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "If-Matchヘッダーがない"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルート沿いのPOIをウェイポイントまたはコースポイントにする",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/reverse": {
+            "post": {
+                "description": "コースポイントは逆順になり、方位角と左右の曲がる向きも反転する。編集前の状態は版として残る",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートの進行方向を反転する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/save": {
+            "delete": {
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "保存していない"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートの保存を取り消す",
+                "tags": [
+                    "routes"
+                ]
+            },
+            "put": {
+                "description": "閲覧できないルートは見つからないものとして扱う。保存したことをルートの所有者に通知する",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "すでに保存している"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートを保存する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/similar": {
+            "get": {
+                "description": "未ログインでも取得できる。閲覧できないルートは見つからないものとして扱い、候補は公開のルートだけから探す",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Maximum number of candidates (default 10, max 50)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.SimilarRouteListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "類似ルートを取得する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/split": {
+            "post": {
+                "description": "指定した地点に最も近い経路上の地点で分割し、元のルートを前半、後半を新しいルートとして作成する",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.SplitRouteRequest",
+                                        "summary": "request",
+                                        "description": "Split Route Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Split Route Request",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteResponse"
+                                }
+                            }
+                        },
+                        "description": "後半の新しいルート"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートを2つに分割する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/tags": {
+            "put": {
+                "description": "タグは前後の空白と先頭の#を取り除き、英字を小文字にして重複を除く。経路は変わらないため、If-Matchヘッダーは不要で版も作らない",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.UpdateRouteTagsRequest",
+                                        "summary": "request",
+                                        "description": "Update Route Tags Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Update Route Tags Request",
+                    "required": true
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteTagsResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートのタグを置き換える",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/trim": {
+            "post": {
+                "description": "始点からの距離(m)で残す区間を指定する。区間外のコースポイントは削除され、距離と累積距離は再計算される",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.TrimRouteRequest",
+                                        "summary": "request",
+                                        "description": "Trim Route Request"
+                                    }
+                                ]
+                            }
+                        }
+                    },
+                    "description": "Trim Route Request",
+                    "required": true
+                },
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートを指定した距離の区間に切り詰める",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/versions": {
+            "get": {
+                "description": "更新のたびに保存された更新前の状態を新しい順に返す。差分は「その版の値 - 現在の値」",
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteVersionListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートの更新履歴を取得する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/versions/{version}": {
+            "get": {
+                "parameters": [
+                    {
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "版番号",
+                        "in": "path",
+                        "name": "version",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.RouteVersionResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートの特定の版を形状付きで取得する",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/routes/{route_id}/versions/{version}/restore": {
+            "post": {
+                "description": "復元前の状態も新しい版として履歴に残る",
                 "parameters": [
                     {
-                        "description": "Keyword to search in route names",
-                        "in": "query",
-                        "name": "keyword",
+                        "description": "Route ID",
+                        "in": "path",
+                        "name": "route_id",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "Minimum distance filter",
-                        "in": "query",
-                        "name": "min_distance",
+                        "description": "版番号",
+                        "in": "path",
+                        "name": "version",
+                        "required": true,
                         "schema": {
-                            "type": "string"
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "type": "object"
+                            }
                         }
+                    }
+                },
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
                     },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
                     {
-                        "description": "Maximum distance filter",
-                        "in": "query",
-                        "name": "max_distance",
-                        "schema": {
-                            "type": "string"
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ルートを特定の版の状態に戻す",
+                "tags": [
+                    "routes"
+                ]
+            }
+        },
+        "/tours": {
+            "get": {
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/tour.TourListResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "自分のツアーの一覧を取得する",
+                "tags": [
+                    "tours"
+                ]
+            },
+            "post": {
+                "description": "ステージには自分のルートのほか、他のユーザーの公開ルートも使える。同じルートを複数日に使ってもよい",
+                "requestBody": {
+                    "content": {
+                        "application/json": {
+                            "schema": {
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/tour.TourRequest",
+                                        "summary": "request",
+                                        "description": "Create Tour Request"
+                                    }
+                                ]
+                            }
                         }
                     },
+                    "description": "Create Tour Request",
+                    "required": true
+                },
+                "responses": {
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/tour.TourResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Bad Request"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "閲覧できないルート"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ツアーを作成する",
+                "tags": [
+                    "tours"
+                ]
+            }
+        },
+        "/tours/{tour_id}": {
+            "delete": {
+                "description": "ステージのルートは削除しない",
+                "parameters": [
                     {
-                        "description": "Minimum elevation gain filter",
-                        "in": "query",
-                        "name": "min_elevation",
+                        "description": "Tour ID",
+                        "in": "path",
+                        "name": "tour_id",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
-                    {
-                        "description": "Maximum elevation gain filter",
-                        "in": "query",
-                        "name": "max_elevation",
-                        "schema": {
-                            "type": "string"
-                        }
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
                     },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
                     {
-                        "description": "Visibility filter",
-                        "in": "query",
-                        "name": "visibility",
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ツアーを削除する",
+                "tags": [
+                    "tours"
+                ]
+            },
+            "get": {
+                "description": "公開のツアーは未ログインでも取得できる。ステージと合計は閲覧できるルートのみを対象にする",
+                "parameters": [
+                    {
+                        "description": "Tour ID",
+                        "in": "path",
+                        "name": "tour_id",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/tour.TourResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
                     },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "ツアーを取得する",
+                "tags": [
+                    "tours"
+                ]
+            },
+            "put": {
+                "description": "名前・説明・公開範囲とステージをまとめて置き換える",
+                "parameters": [
                     {
-                        "description": "Author filter",
-                        "in": "query",
-                        "name": "author",
+                        "description": "Tour ID",
+                        "in": "path",
+                        "name": "tour_id",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
@@ -596,17 +9089,28 @@ const docTemplate = `{
                     "content": {
                         "application/json": {
                             "schema": {
-                                "type": "object"
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/tour.TourRequest",
+                                        "summary": "request",
+                                        "description": "Update Tour Request"
+                                    }
+                                ]
                             }
                         }
-                    }
+                    },
+                    "description": "Update Tour Request",
+                    "required": true
                 },
                 "responses": {
                     "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/route.RouteListResponse"
+                                    "$ref": "#/components/schemas/tour.TourResponse"
                                 }
                             }
                         },
@@ -632,6 +9136,26 @@ const docTemplate = `{
                         },
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
                     "500": {
                         "content": {
                             "application/json": {
@@ -643,42 +9167,152 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "ユーザーのルート一覧を取得する",
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ツアーを更新する",
                 "tags": [
-                    "routes"
+                    "tours"
                 ]
-            },
-            "post": {
-                "requestBody": {
-                    "content": {
-                        "application/json": {
-                            "schema": {
-                                "oneOf": [
-                                    {
-                                        "type": "object"
-                                    },
-                                    {
-                                        "$ref": "#/components/schemas/route.CreateRouteRequest",
-                                        "summary": "request",
-                                        "description": "Create Route Request"
-                                    }
-                                ]
+            }
+        },
+        "/tours/{tour_id}/fit": {
+            "get": {
+                "description": "ステージごとに1つのラップにし、宿泊地はステージの終点のコースポイントにする。閲覧できるステージのみを含める",
+                "parameters": [
+                    {
+                        "description": "Tour ID",
+                        "in": "path",
+                        "name": "tour_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/vnd.ant.fit": {
+                                "schema": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "description": "FIT"
+                    },
+                    "404": {
+                        "content": {
+                            "application/vnd.ant.fit": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/vnd.ant.fit": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "ツアーをFITのコースで出力する",
+                "tags": [
+                    "tours"
+                ]
+            }
+        },
+        "/tours/{tour_id}/gpx": {
+            "get": {
+                "description": "ステージごとに1つのトラックにし、宿泊地はステージの終点のウェイポイントにする。閲覧できるステージのみを含める",
+                "parameters": [
+                    {
+                        "description": "Tour ID",
+                        "in": "path",
+                        "name": "tour_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "type": "string"
+                                }
                             }
+                        },
+                        "description": "GPX"
+                    },
+                    "404": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/gpx+xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "summary": "ツアーをGPXで出力する",
+                "tags": [
+                    "tours"
+                ]
+            }
+        },
+        "/trips": {
+            "get": {
+                "description": "作成日時の新しい順に返す",
+                "parameters": [
+                    {
+                        "description": "Page size (default 20, max 100)",
+                        "in": "query",
+                        "name": "limit",
+                        "schema": {
+                            "type": "integer"
                         }
                     },
-                    "description": "Create Route Request",
-                    "required": true
-                },
+                    {
+                        "description": "Cursor returned as next_cursor in the previous page",
+                        "in": "query",
+                        "name": "cursor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
                 "responses": {
-                    "201": {
+                    "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/route.RouteResponse"
+                                    "$ref": "#/components/schemas/trip.TripListResponse"
                                 }
                             }
                         },
-                        "description": "Created"
+                        "description": "OK"
                     },
                     "400": {
                         "content": {
@@ -716,67 +9350,34 @@ const docTemplate = `{
                         "CookieAuth": []
                     }
                 ],
-                "summary": "ルートを作成する",
+                "summary": "自分のトリップの一覧を取得する",
                 "tags": [
-                    "routes"
+                    "trips"
                 ]
-            }
-        },
-        "/routes/explore": {
-            "get": {
+            },
+            "post": {
+                "description": "GPXのトラックから経路と距離・時間・獲得標高を求めて保存し、フォロワーのフィードに表示する。複数のトラックは記録順につなげる",
                 "parameters": [
                     {
-                        "description": "Keyword to search in route names",
+                        "description": "トリップの名前。省略時はGPXに記録された名前",
                         "in": "query",
-                        "name": "q",
+                        "name": "name",
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "Latitude of the reference point",
-                        "in": "query",
-                        "name": "lat",
-                        "schema": {
-                            "type": "number"
-                        }
-                    },
-                    {
-                        "description": "Longitude of the reference point",
-                        "in": "query",
-                        "name": "lng",
-                        "schema": {
-                            "type": "number"
-                        }
-                    },
-                    {
-                        "description": "Search radius (meters)",
-                        "in": "query",
-                        "name": "r",
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
-                    {
-                        "description": "Minimum distance filter (kilometers)",
-                        "in": "query",
-                        "name": "min_distance",
-                        "schema": {
-                            "type": "number"
-                        }
-                    },
-                    {
-                        "description": "Maximum distance filter (kilometers)",
+                        "description": "説明",
                         "in": "query",
-                        "name": "max_distance",
+                        "name": "description",
                         "schema": {
-                            "type": "number"
+                            "type": "string"
                         }
                     },
                     {
-                        "description": "Pagination offset",
+                        "description": "公開範囲（0: 非公開, 1: 公開, 2: 友達のみ）。省略時は非公開",
                         "in": "query",
-                        "name": "offset",
+                        "name": "visibility",
                         "schema": {
                             "type": "integer"
                         }
@@ -784,23 +9385,31 @@ const docTemplate = `{
                 ],
                 "requestBody": {
                     "content": {
-                        "application/json": {
+                        "application/gpx+xml": {
                             "schema": {
-                                "type": "object"
+                                "type": "string"
+                            }
+                        },
+                        "text/plain": {
+                            "schema": {
+                                "title": "request",
+                                "type": "string"
                             }
                         }
-                    }
+                    },
+                    "description": "GPX",
+                    "required": true
                 },
                 "responses": {
-                    "200": {
+                    "201": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/route.RouteListResponse"
+                                    "$ref": "#/components/schemas/trip.TripResponseModel"
                                 }
                             }
                         },
-                        "description": "OK"
+                        "description": "Created"
                     },
                     "400": {
                         "content": {
@@ -838,37 +9447,44 @@ const docTemplate = `{
                         "CookieAuth": []
                     }
                 ],
-                "summary": "ルートを探索する",
+                "summary": "GPXをアップロードしてトリップを作成する",
                 "tags": [
-                    "routes"
+                    "trips"
                 ]
             }
         },
-        "/routes/{route_id}": {
-            "delete": {
+        "/trips/{trip_id}/match": {
+            "post": {
+                "description": "記録したトリップのGPSの軌跡を道路網に照合し、実際に通った道路に沿う経路とコースポイントを返す。結果は保存しないため、そのままルート作成に使う",
                 "parameters": [
                     {
-                        "description": "Route ID",
+                        "description": "Trip ID",
                         "in": "path",
-                        "name": "route_id",
+                        "name": "trip_id",
                         "required": true,
                         "schema": {
                             "type": "string"
                         }
-                    }
-                ],
-                "requestBody": {
-                    "content": {
-                        "application/json": {
-                            "schema": {
-                                "type": "object"
-                            }
+                    },
+                    {
+                        "description": "案内文の言語（ja, en）。省略時はユーザーのロケール",
+                        "in": "query",
+                        "name": "locale",
+                        "schema": {
+                            "type": "string"
                         }
                     }
-                },
+                ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/route.MatchTripResponse"
+                                }
+                            }
+                        },
+                        "description": "OK"
                     },
                     "400": {
                         "content": {
@@ -890,6 +9506,16 @@ const docTemplate = `{
                         },
                         "description": "Unauthorized"
                     },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
                     "404": {
                         "content": {
                             "application/json": {
@@ -916,17 +9542,20 @@ const docTemplate = `{
                         "CookieAuth": []
                     }
                 ],
-                "summary": "ルートを削除する",
+                "summary": "トリップの軌跡を道路網に照合する",
                 "tags": [
-                    "routes"
+                    "trips"
                 ]
-            },
-            "get": {
+            }
+        },
+        "/trips/{trip_id}/to-route": {
+            "post": {
+                "description": "記録したトリップの軌跡を簡略化し、距離・獲得標高と写真を引き継いだルートを作成する。記録時刻やセンサーの値は含めず、プライバシーゾーン内の始点・終点側の軌跡は取り除く",
                 "parameters": [
                     {
-                        "description": "Route ID",
+                        "description": "Trip ID",
                         "in": "path",
-                        "name": "route_id",
+                        "name": "trip_id",
                         "required": true,
                         "schema": {
                             "type": "string"
@@ -937,13 +9566,23 @@ const docTemplate = `{
                     "content": {
                         "application/json": {
                             "schema": {
-                                "type": "object"
+                                "oneOf": [
+                                    {
+                                        "type": "object"
+                                    },
+                                    {
+                                        "$ref": "#/components/schemas/route.ConvertTripRequest",
+                                        "summary": "request",
+                                        "description": "Convert Trip Request"
+                                    }
+                                ]
                             }
                         }
-                    }
+                    },
+                    "description": "Convert Trip Request"
                 },
                 "responses": {
-                    "200": {
+                    "201": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -951,7 +9590,7 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "OK"
+                        "description": "Created"
                     },
                     "400": {
                         "content": {
@@ -963,6 +9602,26 @@ const docTemplate = `{
                         },
                         "description": "Bad Request"
                     },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Forbidden"
+                    },
                     "404": {
                         "content": {
                             "application/json": {
@@ -984,23 +9643,19 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "ルートを取得する",
-                "tags": [
-                    "routes"
-                ]
-            },
-            "put": {
-                "parameters": [
+                "security": [
                     {
-                        "description": "Route ID",
-                        "in": "path",
-                        "name": "route_id",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
+                        "CookieAuth": []
                     }
                 ],
+                "summary": "トリップからルートを作成する",
+                "tags": [
+                    "trips"
+                ]
+            }
+        },
+        "/users": {
+            "post": {
                 "requestBody": {
                     "content": {
                         "application/json": {
@@ -1010,42 +9665,29 @@ const docTemplate = `{
                                         "type": "object"
                                     },
                                     {
-                                        "$ref": "#/components/schemas/route.UpdateRouteRequest",
+                                        "$ref": "#/components/schemas/user.CreateUserRequest",
                                         "summary": "request",
-                                        "description": "Update Route Request"
+                                        "description": "Create User Request"
                                     }
                                 ]
                             }
                         }
                     },
-                    "description": "Update Route Request",
+                    "description": "Create User Request",
                     "required": true
                 },
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "content": {
-                            "application/json": {
-                                "schema": {
-                                    "$ref": "#/components/schemas/response.ErrorResponse"
-                                }
-                            }
-                        },
-                        "description": "Bad Request"
-                    },
-                    "401": {
+                    "201": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                    "$ref": "#/components/schemas/user.UserResponse"
                                 }
                             }
                         },
-                        "description": "Unauthorized"
+                        "description": "Created"
                     },
-                    "404": {
+                    "400": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1053,7 +9695,7 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Not Found"
+                        "description": "Bad Request"
                     },
                     "500": {
                         "content": {
@@ -1066,30 +9708,14 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "security": [
-                    {
-                        "CookieAuth": []
-                    }
-                ],
-                "summary": "ルートを更新する",
+                "summary": "ユーザーを作成する",
                 "tags": [
-                    "routes"
+                    "users"
                 ]
             }
         },
-        "/routes/{route_id}/gpx": {
+        "/users/me": {
             "get": {
-                "parameters": [
-                    {
-                        "description": "Route ID",
-                        "in": "path",
-                        "name": "route_id",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                ],
                 "requestBody": {
                     "content": {
                         "application/json": {
@@ -1102,47 +9728,27 @@ const docTemplate = `{
                 "responses": {
                     "200": {
                         "content": {
-                            "application/gpx+xml": {
-                                "schema": {
-                                    "type": "string"
-                                }
-                            }
-                        },
-                        "description": "GPX XML"
-                    },
-                    "400": {
-                        "content": {
-                            "application/gpx+xml": {
+                            "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                    "$ref": "#/components/schemas/user.LoginUserResponse"
                                 }
                             }
                         },
-                        "description": "Bad Request"
+                        "description": "OK"
                     },
                     "401": {
                         "content": {
-                            "application/gpx+xml": {
-                                "schema": {
-                                    "$ref": "#/components/schemas/response.ErrorResponse"
-                                }
-                            }
-                        },
-                        "description": "Unauthorized"
-                    },
-                    "404": {
-                        "content": {
-                            "application/gpx+xml": {
+                            "application/json": {
                                 "schema": {
                                     "$ref": "#/components/schemas/response.ErrorResponse"
                                 }
                             }
                         },
-                        "description": "Not Found"
+                        "description": "Unauthorized"
                     },
                     "500": {
                         "content": {
-                            "application/gpx+xml": {
+                            "application/json": {
                                 "schema": {
                                     "$ref": "#/components/schemas/response.ErrorResponse"
                                 }
@@ -1156,14 +9762,15 @@ const docTemplate = `{
                         "CookieAuth": []
                     }
                 ],
-                "summary": "ルートをGPX形式でエクスポートする",
+                "summary": "ログインユーザーを取得する",
                 "tags": [
-                    "routes"
+                    "users"
                 ]
             }
         },
-        "/users": {
-            "post": {
+        "/users/settings/location": {
+            "put": {
+                "description": "地域・行政区・国コードは位置（geom）を含む行政区域から求める。求められなかった項目にはリクエストの値を使う",
                 "requestBody": {
                     "content": {
                         "application/json": {
@@ -1173,29 +9780,32 @@ const docTemplate = `{
                                         "type": "object"
                                     },
                                     {
-                                        "$ref": "#/components/schemas/user.CreateUserRequest",
+                                        "$ref": "#/components/schemas/user.UpdateUserLocationRequest",
                                         "summary": "request",
-                                        "description": "Create User Request"
+                                        "description": "Update User Location Request"
                                     }
                                 ]
                             }
                         }
                     },
-                    "description": "Create User Request",
+                    "description": "Update User Location Request",
                     "required": true
                 },
                 "responses": {
-                    "201": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/user.UserResponse"
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
                                 }
                             }
                         },
-                        "description": "Created"
+                        "description": "Bad Request"
                     },
-                    "400": {
+                    "401": {
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1203,7 +9813,7 @@ const docTemplate = `{
                                 }
                             }
                         },
-                        "description": "Bad Request"
+                        "description": "Unauthorized"
                     },
                     "500": {
                         "content": {
@@ -1216,29 +9826,25 @@ const docTemplate = `{
                         "description": "Internal Server Error"
                     }
                 },
-                "summary": "ユーザーを作成する",
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ユーザーの位置情報を更新する",
                 "tags": [
                     "users"
                 ]
             }
         },
-        "/users/me": {
+        "/users/settings/privacy-zones": {
             "get": {
-                "requestBody": {
-                    "content": {
-                        "application/json": {
-                            "schema": {
-                                "type": "object"
-                            }
-                        }
-                    }
-                },
                 "responses": {
                     "200": {
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/user.LoginUserResponse"
+                                    "$ref": "#/components/schemas/user.PrivacyZoneListResponse"
                                 }
                             }
                         },
@@ -1270,14 +9876,13 @@ const docTemplate = `{
                         "CookieAuth": []
                     }
                 ],
-                "summary": "ログインユーザーを取得する",
+                "summary": "プライバシーゾーンの一覧を取得する",
                 "tags": [
                     "users"
                 ]
-            }
-        },
-        "/users/settings/location": {
-            "put": {
+            },
+            "post": {
+                "description": "範囲内にある軌跡の始点・終点側は、トリップからルートを作るときに取り除かれる",
                 "requestBody": {
                     "content": {
                         "application/json": {
@@ -1287,20 +9892,27 @@ const docTemplate = `{
                                         "type": "object"
                                     },
                                     {
-                                        "$ref": "#/components/schemas/user.UpdateUserLocationRequest",
+                                        "$ref": "#/components/schemas/user.CreatePrivacyZoneRequest",
                                         "summary": "request",
-                                        "description": "Update User Location Request"
+                                        "description": "Create Privacy Zone Request"
                                     }
                                 ]
                             }
                         }
                     },
-                    "description": "Update User Location Request",
+                    "description": "Create Privacy Zone Request",
                     "required": true
                 },
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/user.PrivacyZoneResponse"
+                                }
+                            }
+                        },
+                        "description": "Created"
                     },
                     "400": {
                         "content": {
@@ -1338,7 +9950,66 @@ const docTemplate = `{
                         "CookieAuth": []
                     }
                 ],
-                "summary": "ユーザーの位置情報を更新する",
+                "summary": "プライバシーゾーンを追加する",
+                "tags": [
+                    "users"
+                ]
+            }
+        },
+        "/users/settings/privacy-zones/{zone_id}": {
+            "delete": {
+                "parameters": [
+                    {
+                        "description": "Privacy Zone ID",
+                        "in": "path",
+                        "name": "zone_id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "プライバシーゾーンを削除する",
                 "tags": [
                     "users"
                 ]
@@ -1481,6 +10152,143 @@ const docTemplate = `{
                     "users"
                 ]
             }
+        },
+        "/users/{id}/follow": {
+            "delete": {
+                "parameters": [
+                    {
+                        "description": "User ID",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "フォローしていない"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ユーザーのフォローを解除する",
+                "tags": [
+                    "users"
+                ]
+            },
+            "put": {
+                "description": "フォローしたユーザーのルート作成・トリップ・いいね・コメントがフィードに表示される",
+                "parameters": [
+                    {
+                        "description": "User ID",
+                        "in": "path",
+                        "name": "id",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "自分自身はフォローできない"
+                    },
+                    "401": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Unauthorized"
+                    },
+                    "404": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "すでにフォローしている"
+                    },
+                    "500": {
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ErrorResponse"
+                                }
+                            }
+                        },
+                        "description": "Internal Server Error"
+                    }
+                },
+                "security": [
+                    {
+                        "CookieAuth": []
+                    }
+                ],
+                "summary": "ユーザーをフォローする",
+                "tags": [
+                    "users"
+                ]
+            }
         }
     },
     "openapi": "3.1.0",
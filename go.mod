@@ -21,6 +21,8 @@ require (
 	github.com/swaggo/swag/v2 v2.0.0-rc5
 	github.com/tkrajina/gpxgo v1.4.0
 	go.uber.org/mock v0.6.0
+	golang.org/x/text v0.34.0
+	google.golang.org/protobuf v1.36.11
 )
 
 require (
@@ -103,8 +105,6 @@ require (
 	golang.org/x/net v0.50.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
 	golang.org/x/sys v0.41.0 // indirect
-	golang.org/x/text v0.34.0 // indirect
 	golang.org/x/tools v0.42.0 // indirect
-	google.golang.org/protobuf v1.36.11 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,99 @@
+package club
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/google/uuid"
+)
+
+// 名前と説明の最大文字数
+const (
+	MaxNameLength        = 100
+	MaxDescriptionLength = 1000
+)
+
+type ClubID string
+
+func NewClubID() ClubID {
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		panic(err)
+	}
+	return ClubID(uuid.String())
+}
+
+func (id ClubID) String() string {
+	return string(id)
+}
+
+// Club はルートやイベントを共有するサイクリングクラブ
+// メンバーと役割はMembershipとして別に持ち、メンバー数だけを集計して保持する
+type Club struct {
+	id          ClubID
+	name        string
+	description string
+	memberCount int32
+	createdAt   string
+	updatedAt   string
+}
+
+// NewClub はクラブを作成する。作成したユーザーがオーナーになるため、メンバー数は1から始まる
+func NewClub(name string, description string) (*Club, error) {
+	c := &Club{id: NewClubID(), memberCount: 1}
+	if err := c.Update(name, description); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ReconstructClub はリポジトリ層からの復元用
+func ReconstructClub(id string, name string, description string, memberCount int32, createdAt string, updatedAt string) *Club {
+	return &Club{
+		id:          ClubID(id),
+		name:        name,
+		description: description,
+		memberCount: memberCount,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+func (c *Club) ID() ClubID          { return c.id }
+func (c *Club) Name() string        { return c.name }
+func (c *Club) Description() string { return c.description }
+func (c *Club) MemberCount() int32  { return c.memberCount }
+func (c *Club) CreatedAt() string   { return c.createdAt }
+func (c *Club) UpdatedAt() string   { return c.updatedAt }
+
+// Update は名前と説明を変更する
+func (c *Club) Update(name string, description string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domainerror.New("name is required", domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return domainerror.New(fmt.Sprintf("name must be at most %d characters", MaxNameLength), domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(description) > MaxDescriptionLength {
+		return domainerror.New(fmt.Sprintf("description must be at most %d characters", MaxDescriptionLength), domainerror.ErrValidation)
+	}
+	c.name = name
+	c.description = description
+	return nil
+}
+
+// CheckLibraryRoute は管理者がルートを共有ルートライブラリに追加できるかを確かめる
+// 管理者が閲覧できるルートのうち、他のメンバーも閲覧できる（公開か、このクラブのメンバーのみの）ルートに限る
+func (c *Club) CheckLibraryRoute(admin route.Viewer, r *route.Route) error {
+	if !r.IsVisibleTo(admin) {
+		return domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	if !r.IsVisibleTo(route.NewViewer("", []string{c.id.String()})) {
+		return domainerror.New("route must be visible to the club members", domainerror.ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package club
+
+import (
+	"context"
+)
+
+// IClubRepository はクラブとそのメンバー・参加申請・共有ルートライブラリのリポジトリのインターフェース
+type IClubRepository interface {
+	// メンバー数とともにクラブを取得する
+	GetClubByID(ctx context.Context, id string) (*Club, error)
+	// ユーザーが所属するクラブを新しい順に取得する
+	GetClubsByUserID(ctx context.Context, userID string) ([]*Club, error)
+	// ユーザーが所属するクラブのIDを取得する。ルートとイベントの公開範囲の判定に使う
+	GetClubIDsByUserID(ctx context.Context, userID string) ([]string, error)
+	SaveClub(ctx context.Context, club *Club) error
+	UpdateClub(ctx context.Context, club *Club) error
+	DeleteClub(ctx context.Context, id string) error
+
+	// メンバーでない場合はErrNotFoundを返す
+	GetMembership(ctx context.Context, clubID string, userID string) (*Membership, error)
+	// メンバーを参加した順に取得する
+	GetMemberships(ctx context.Context, clubID string) ([]*Membership, error)
+	// メンバーを追加するか、役割を更新する
+	SaveMembership(ctx context.Context, membership *Membership) error
+	DeleteMembership(ctx context.Context, clubID string, userID string) error
+
+	// 申請がない場合はErrNotFoundを返す
+	GetJoinRequest(ctx context.Context, clubID string, userID string) (*JoinRequest, error)
+	// 参加申請を古い順に取得する
+	GetJoinRequests(ctx context.Context, clubID string) ([]*JoinRequest, error)
+	SaveJoinRequest(ctx context.Context, request *JoinRequest) error
+	DeleteJoinRequest(ctx context.Context, clubID string, userID string) error
+
+	// 共有ルートライブラリのルートのIDを追加した新しい順に取得する
+	GetLibraryRouteIDs(ctx context.Context, clubID string) ([]string, error)
+	// 追加済みの場合はErrConflictを返す
+	AddLibraryRoute(ctx context.Context, clubID string, routeID string, addedBy string) error
+	RemoveLibraryRoute(ctx context.Context, clubID string, routeID string) error
+}
@@ -0,0 +1,151 @@
+package club
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+)
+
+const testUserID = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+
+func TestNewClub(t *testing.T) {
+	tests := []struct {
+		name        string
+		clubName    string
+		description string
+		wantName    string
+		wantErr     bool
+	}{
+		{name: "正常系", clubName: " 多摩川サイクリング部 ", wantName: "多摩川サイクリング部"},
+		{name: "異常系: 名前が空", clubName: "  ", wantErr: true},
+		{name: "異常系: 名前が長すぎる", clubName: strings.Repeat("あ", MaxNameLength+1), wantErr: true},
+		{name: "異常系: 説明が長すぎる", clubName: "峠", description: strings.Repeat("a", MaxDescriptionLength+1), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClub(tt.clubName, tt.description)
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("NewClub() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewClub() error = %v", err)
+			}
+			if c.ID() == "" || c.Name() != tt.wantName {
+				t.Errorf("NewClub() = %+v", c)
+			}
+		})
+	}
+}
+
+func TestClub_CheckLibraryRoute(t *testing.T) {
+	c := ReconstructClub("club-1", "多摩川サイクリング部", "", 2, "", "")
+	newRoute := func(userID string, visibility int16, clubID *string) *route.Route {
+		r, _ := route.ReconstructRoute("route-1", userID, "多摩川", "", nil, 0, 0, 0, 0, route.Geometry{}, route.Geometry{}, route.Geometry{}, route.Geometry{}, "", visibility, 1, nil, "", "")
+		r.SetClubID(clubID)
+		return r
+	}
+	admin := route.NewViewer(testUserID, []string{"club-1", "club-2"})
+
+	tests := []struct {
+		name    string
+		route   *route.Route
+		wantErr error
+	}{
+		{name: "正常系: 公開のルート", route: newRoute("other", route.VisibilityPublic, nil)},
+		{name: "正常系: このクラブのメンバーのみのルート", route: newRoute("other", route.VisibilityClub, new("club-1"))},
+		{name: "異常系: 自分の非公開ルート", route: newRoute(testUserID, route.VisibilityPrivate, nil), wantErr: domainerror.ErrValidation},
+		{name: "異常系: 別のクラブのメンバーのみのルート", route: newRoute("other", route.VisibilityClub, new("club-2")), wantErr: domainerror.ErrValidation},
+		{name: "異常系: 他のユーザーの非公開ルート", route: newRoute("other", route.VisibilityPrivate, nil), wantErr: domainerror.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.CheckLibraryRoute(admin, tt.route)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("CheckLibraryRoute() error = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckLibraryRoute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMembership_CanRemove(t *testing.T) {
+	owner := ReconstructMembership("club-1", "owner", "", RoleOwner, "")
+	admin := ReconstructMembership("club-1", "admin", "", RoleAdmin, "")
+	admin2 := ReconstructMembership("club-1", "admin2", "", RoleAdmin, "")
+	member := ReconstructMembership("club-1", "member", "", RoleMember, "")
+
+	tests := []struct {
+		name   string
+		actor  *Membership
+		target *Membership
+		want   bool
+	}{
+		{name: "オーナーは管理者を外せる", actor: owner, target: admin, want: true},
+		{name: "オーナーは抜けられない", actor: owner, target: owner, want: false},
+		{name: "管理者は一般のメンバーを外せる", actor: admin, target: member, want: true},
+		{name: "管理者は他の管理者を外せない", actor: admin, target: admin2, want: false},
+		{name: "一般のメンバーは自分で抜けられる", actor: member, target: member, want: true},
+		{name: "一般のメンバーは他のメンバーを外せない", actor: member, target: admin, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.actor.CanRemove(tt.target); got != tt.want {
+				t.Errorf("CanRemove() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMembership_ChangeRole(t *testing.T) {
+	tests := []struct {
+		name      string
+		actorRole Role
+		target    Role
+		role      Role
+		wantErr   error
+	}{
+		{name: "正常系: オーナーが管理者にする", actorRole: RoleOwner, target: RoleMember, role: RoleAdmin},
+		{name: "正常系: オーナーが一般のメンバーに戻す", actorRole: RoleOwner, target: RoleAdmin, role: RoleMember},
+		{name: "異常系: 管理者は役割を変更できない", actorRole: RoleAdmin, target: RoleMember, role: RoleAdmin, wantErr: domainerror.ErrUnauthorized},
+		{name: "異常系: オーナーにはできない", actorRole: RoleOwner, target: RoleAdmin, role: RoleOwner, wantErr: domainerror.ErrValidation},
+		{name: "異常系: オーナーの役割は変更できない", actorRole: RoleOwner, target: RoleOwner, role: RoleMember, wantErr: domainerror.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actor := ReconstructMembership("club-1", "actor", "", tt.actorRole, "")
+			m := ReconstructMembership("club-1", "target", "", tt.target, "")
+			err := m.ChangeRole(actor, tt.role)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ChangeRole() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || m.Role() != tt.role {
+				t.Errorf("ChangeRole() error = %v, role = %s", err, m.Role())
+			}
+		})
+	}
+}
+
+func TestNewJoinRequest(t *testing.T) {
+	if _, err := NewJoinRequest("club-1", testUserID, strings.Repeat("a", MaxJoinRequestMessageLength+1)); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewJoinRequest() error = %v, want ErrValidation", err)
+	}
+	r, err := NewJoinRequest("club-1", testUserID, "よろしくお願いします")
+	if err != nil {
+		t.Fatalf("NewJoinRequest() error = %v", err)
+	}
+	m, err := r.Approve()
+	if err != nil || m.UserID() != testUserID || m.Role() != RoleMember {
+		t.Errorf("Approve() = %+v, %v", m, err)
+	}
+}
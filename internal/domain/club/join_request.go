@@ -0,0 +1,46 @@
+package club
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+// 参加申請のメッセージの最大文字数
+const MaxJoinRequestMessageLength = 500
+
+// JoinRequest はユーザーのクラブへの参加申請。承認するとメンバーになり、申請は削除する
+type JoinRequest struct {
+	clubID    string
+	userID    string
+	userName  string
+	message   string
+	createdAt string
+}
+
+func NewJoinRequest(clubID string, userID string, message string) (*JoinRequest, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(message) > MaxJoinRequestMessageLength {
+		return nil, domainerror.New(fmt.Sprintf("message must be at most %d characters", MaxJoinRequestMessageLength), domainerror.ErrValidation)
+	}
+	return &JoinRequest{clubID: clubID, userID: userID, message: message}, nil
+}
+
+// ReconstructJoinRequest はリポジトリ層からの復元用
+func ReconstructJoinRequest(clubID string, userID string, userName string, message string, createdAt string) *JoinRequest {
+	return &JoinRequest{clubID: clubID, userID: userID, userName: userName, message: message, createdAt: createdAt}
+}
+
+func (r *JoinRequest) ClubID() string    { return r.clubID }
+func (r *JoinRequest) UserID() string    { return r.userID }
+func (r *JoinRequest) UserName() string  { return r.userName }
+func (r *JoinRequest) Message() string   { return r.message }
+func (r *JoinRequest) CreatedAt() string { return r.createdAt }
+
+// Approve は申請を承認して一般のメンバーにする
+func (r *JoinRequest) Approve() (*Membership, error) {
+	return NewMembership(r.clubID, r.userID, RoleMember)
+}
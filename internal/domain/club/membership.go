@@ -0,0 +1,91 @@
+package club
+
+import (
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+// Role はクラブでのメンバーの役割
+type Role string
+
+const (
+	RoleOwner  Role = "owner"  // クラブを作成したユーザー。クラブに1人
+	RoleAdmin  Role = "admin"  // 参加申請の承認や共有ルートライブラリの管理ができる
+	RoleMember Role = "member" // 一般のメンバー
+)
+
+func ParseRole(s string) (Role, error) {
+	switch role := Role(s); role {
+	case RoleOwner, RoleAdmin, RoleMember:
+		return role, nil
+	default:
+		return "", domainerror.New("role must be one of owner, admin, or member", domainerror.ErrValidation)
+	}
+}
+
+// Membership はユーザーのクラブへの所属と役割
+type Membership struct {
+	clubID   string
+	userID   string
+	userName string
+	role     Role
+	joinedAt string
+}
+
+func NewMembership(clubID string, userID string, role Role) (*Membership, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	if _, err := ParseRole(string(role)); err != nil {
+		return nil, err
+	}
+	return &Membership{clubID: clubID, userID: userID, role: role}, nil
+}
+
+// ReconstructMembership はリポジトリ層からの復元用
+func ReconstructMembership(clubID string, userID string, userName string, role Role, joinedAt string) *Membership {
+	return &Membership{clubID: clubID, userID: userID, userName: userName, role: role, joinedAt: joinedAt}
+}
+
+func (m *Membership) ClubID() string   { return m.clubID }
+func (m *Membership) UserID() string   { return m.userID }
+func (m *Membership) UserName() string { return m.userName }
+func (m *Membership) Role() Role       { return m.role }
+func (m *Membership) JoinedAt() string { return m.joinedAt }
+
+// CanManage はクラブを管理できる（オーナーか管理者である）かを返す
+func (m *Membership) CanManage() bool {
+	return m.role == RoleOwner || m.role == RoleAdmin
+}
+
+// CanRemove はメンバーをクラブから外せるかを返す。自分は抜けられるが、オーナーは抜けられない
+// オーナーは他のメンバーを、管理者は一般のメンバーを外せる
+func (m *Membership) CanRemove(target *Membership) bool {
+	if target.role == RoleOwner {
+		return false
+	}
+	if m.userID == target.userID {
+		return true
+	}
+	switch m.role {
+	case RoleOwner:
+		return true
+	case RoleAdmin:
+		return target.role == RoleMember
+	}
+	return false
+}
+
+// ChangeRole はオーナーがメンバーの役割を変更する。オーナーの交代はできない
+func (m *Membership) ChangeRole(actor *Membership, role Role) error {
+	if actor.role != RoleOwner {
+		return domainerror.New("only the owner can change roles", domainerror.ErrUnauthorized)
+	}
+	if m.role == RoleOwner {
+		return domainerror.New("the owner's role cannot be changed", domainerror.ErrValidation)
+	}
+	if role != RoleAdmin && role != RoleMember {
+		return domainerror.New("role must be admin or member", domainerror.ErrValidation)
+	}
+	m.role = role
+	return nil
+}
@@ -0,0 +1,287 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/club/club_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/club/club_repository.go -destination=internal/domain/club/mock_club_repository.go -package club
+//
+
+// Package club is a generated GoMock package.
+package club
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIClubRepository is a mock of IClubRepository interface.
+type MockIClubRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIClubRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIClubRepositoryMockRecorder is the mock recorder for MockIClubRepository.
+type MockIClubRepositoryMockRecorder struct {
+	mock *MockIClubRepository
+}
+
+// NewMockIClubRepository creates a new mock instance.
+func NewMockIClubRepository(ctrl *gomock.Controller) *MockIClubRepository {
+	mock := &MockIClubRepository{ctrl: ctrl}
+	mock.recorder = &MockIClubRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIClubRepository) EXPECT() *MockIClubRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddLibraryRoute mocks base method.
+func (m *MockIClubRepository) AddLibraryRoute(ctx context.Context, clubID, routeID, addedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLibraryRoute", ctx, clubID, routeID, addedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLibraryRoute indicates an expected call of AddLibraryRoute.
+func (mr *MockIClubRepositoryMockRecorder) AddLibraryRoute(ctx, clubID, routeID, addedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLibraryRoute", reflect.TypeOf((*MockIClubRepository)(nil).AddLibraryRoute), ctx, clubID, routeID, addedBy)
+}
+
+// DeleteClub mocks base method.
+func (m *MockIClubRepository) DeleteClub(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClub", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClub indicates an expected call of DeleteClub.
+func (mr *MockIClubRepositoryMockRecorder) DeleteClub(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClub", reflect.TypeOf((*MockIClubRepository)(nil).DeleteClub), ctx, id)
+}
+
+// DeleteJoinRequest mocks base method.
+func (m *MockIClubRepository) DeleteJoinRequest(ctx context.Context, clubID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJoinRequest", ctx, clubID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJoinRequest indicates an expected call of DeleteJoinRequest.
+func (mr *MockIClubRepositoryMockRecorder) DeleteJoinRequest(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJoinRequest", reflect.TypeOf((*MockIClubRepository)(nil).DeleteJoinRequest), ctx, clubID, userID)
+}
+
+// DeleteMembership mocks base method.
+func (m *MockIClubRepository) DeleteMembership(ctx context.Context, clubID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMembership", ctx, clubID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMembership indicates an expected call of DeleteMembership.
+func (mr *MockIClubRepositoryMockRecorder) DeleteMembership(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMembership", reflect.TypeOf((*MockIClubRepository)(nil).DeleteMembership), ctx, clubID, userID)
+}
+
+// GetClubByID mocks base method.
+func (m *MockIClubRepository) GetClubByID(ctx context.Context, id string) (*Club, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClubByID", ctx, id)
+	ret0, _ := ret[0].(*Club)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClubByID indicates an expected call of GetClubByID.
+func (mr *MockIClubRepositoryMockRecorder) GetClubByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClubByID", reflect.TypeOf((*MockIClubRepository)(nil).GetClubByID), ctx, id)
+}
+
+// GetClubIDsByUserID mocks base method.
+func (m *MockIClubRepository) GetClubIDsByUserID(ctx context.Context, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClubIDsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClubIDsByUserID indicates an expected call of GetClubIDsByUserID.
+func (mr *MockIClubRepositoryMockRecorder) GetClubIDsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClubIDsByUserID", reflect.TypeOf((*MockIClubRepository)(nil).GetClubIDsByUserID), ctx, userID)
+}
+
+// GetClubsByUserID mocks base method.
+func (m *MockIClubRepository) GetClubsByUserID(ctx context.Context, userID string) ([]*Club, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClubsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*Club)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClubsByUserID indicates an expected call of GetClubsByUserID.
+func (mr *MockIClubRepositoryMockRecorder) GetClubsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClubsByUserID", reflect.TypeOf((*MockIClubRepository)(nil).GetClubsByUserID), ctx, userID)
+}
+
+// GetJoinRequest mocks base method.
+func (m *MockIClubRepository) GetJoinRequest(ctx context.Context, clubID, userID string) (*JoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinRequest", ctx, clubID, userID)
+	ret0, _ := ret[0].(*JoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinRequest indicates an expected call of GetJoinRequest.
+func (mr *MockIClubRepositoryMockRecorder) GetJoinRequest(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinRequest", reflect.TypeOf((*MockIClubRepository)(nil).GetJoinRequest), ctx, clubID, userID)
+}
+
+// GetJoinRequests mocks base method.
+func (m *MockIClubRepository) GetJoinRequests(ctx context.Context, clubID string) ([]*JoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinRequests", ctx, clubID)
+	ret0, _ := ret[0].([]*JoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinRequests indicates an expected call of GetJoinRequests.
+func (mr *MockIClubRepositoryMockRecorder) GetJoinRequests(ctx, clubID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinRequests", reflect.TypeOf((*MockIClubRepository)(nil).GetJoinRequests), ctx, clubID)
+}
+
+// GetLibraryRouteIDs mocks base method.
+func (m *MockIClubRepository) GetLibraryRouteIDs(ctx context.Context, clubID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLibraryRouteIDs", ctx, clubID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLibraryRouteIDs indicates an expected call of GetLibraryRouteIDs.
+func (mr *MockIClubRepositoryMockRecorder) GetLibraryRouteIDs(ctx, clubID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLibraryRouteIDs", reflect.TypeOf((*MockIClubRepository)(nil).GetLibraryRouteIDs), ctx, clubID)
+}
+
+// GetMembership mocks base method.
+func (m *MockIClubRepository) GetMembership(ctx context.Context, clubID, userID string) (*Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembership", ctx, clubID, userID)
+	ret0, _ := ret[0].(*Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembership indicates an expected call of GetMembership.
+func (mr *MockIClubRepositoryMockRecorder) GetMembership(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembership", reflect.TypeOf((*MockIClubRepository)(nil).GetMembership), ctx, clubID, userID)
+}
+
+// GetMemberships mocks base method.
+func (m *MockIClubRepository) GetMemberships(ctx context.Context, clubID string) ([]*Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberships", ctx, clubID)
+	ret0, _ := ret[0].([]*Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberships indicates an expected call of GetMemberships.
+func (mr *MockIClubRepositoryMockRecorder) GetMemberships(ctx, clubID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberships", reflect.TypeOf((*MockIClubRepository)(nil).GetMemberships), ctx, clubID)
+}
+
+// RemoveLibraryRoute mocks base method.
+func (m *MockIClubRepository) RemoveLibraryRoute(ctx context.Context, clubID, routeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLibraryRoute", ctx, clubID, routeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveLibraryRoute indicates an expected call of RemoveLibraryRoute.
+func (mr *MockIClubRepositoryMockRecorder) RemoveLibraryRoute(ctx, clubID, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLibraryRoute", reflect.TypeOf((*MockIClubRepository)(nil).RemoveLibraryRoute), ctx, clubID, routeID)
+}
+
+// SaveClub mocks base method.
+func (m *MockIClubRepository) SaveClub(ctx context.Context, club *Club) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveClub", ctx, club)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveClub indicates an expected call of SaveClub.
+func (mr *MockIClubRepositoryMockRecorder) SaveClub(ctx, club any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveClub", reflect.TypeOf((*MockIClubRepository)(nil).SaveClub), ctx, club)
+}
+
+// SaveJoinRequest mocks base method.
+func (m *MockIClubRepository) SaveJoinRequest(ctx context.Context, request *JoinRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveJoinRequest", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveJoinRequest indicates an expected call of SaveJoinRequest.
+func (mr *MockIClubRepositoryMockRecorder) SaveJoinRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveJoinRequest", reflect.TypeOf((*MockIClubRepository)(nil).SaveJoinRequest), ctx, request)
+}
+
+// SaveMembership mocks base method.
+func (m *MockIClubRepository) SaveMembership(ctx context.Context, membership *Membership) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMembership", ctx, membership)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMembership indicates an expected call of SaveMembership.
+func (mr *MockIClubRepositoryMockRecorder) SaveMembership(ctx, membership any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMembership", reflect.TypeOf((*MockIClubRepository)(nil).SaveMembership), ctx, membership)
+}
+
+// UpdateClub mocks base method.
+func (m *MockIClubRepository) UpdateClub(ctx context.Context, club *Club) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClub", ctx, club)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClub indicates an expected call of UpdateClub.
+func (mr *MockIClubRepositoryMockRecorder) UpdateClub(ctx, club any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClub", reflect.TypeOf((*MockIClubRepository)(nil).UpdateClub), ctx, club)
+}
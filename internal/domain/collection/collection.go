@@ -0,0 +1,177 @@
+package collection
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/google/uuid"
+)
+
+// 名前と説明の最大文字数
+const (
+	MaxNameLength        = 100
+	MaxDescriptionLength = 1000
+)
+
+// コレクションに入れられるルートの上限
+const MaxRoutesPerCollection = 500
+
+type CollectionID string
+
+func NewCollectionID() CollectionID {
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		panic(err)
+	}
+	return CollectionID(uuid.String())
+}
+
+func (id CollectionID) String() string {
+	return string(id)
+}
+
+// Collection はユーザーがルートを整理するためのフォルダ。ルートを並び順どおりに保持する
+// 他のユーザーの公開ルートも入れられる
+type Collection struct {
+	id          CollectionID
+	userID      string
+	name        string
+	description string
+	visibility  int16
+	routeIDs    []string
+	createdAt   string
+	updatedAt   string
+}
+
+func NewCollection(userID string, name string, description string, visibility int16) (*Collection, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	c := &Collection{
+		id:       NewCollectionID(),
+		userID:   userID,
+		routeIDs: []string{},
+	}
+	if err := c.Update(name, description, visibility); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ReconstructCollection はリポジトリ層からの復元用
+func ReconstructCollection(
+	id string,
+	userID string,
+	name string,
+	description string,
+	visibility int16,
+	routeIDs []string,
+	createdAt string,
+	updatedAt string,
+) *Collection {
+	if routeIDs == nil {
+		routeIDs = []string{}
+	}
+	return &Collection{
+		id:          CollectionID(id),
+		userID:      userID,
+		name:        name,
+		description: description,
+		visibility:  visibility,
+		routeIDs:    routeIDs,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+func (c *Collection) ID() CollectionID    { return c.id }
+func (c *Collection) UserID() string      { return c.userID }
+func (c *Collection) Name() string        { return c.name }
+func (c *Collection) Description() string { return c.description }
+func (c *Collection) Visibility() int16   { return c.visibility }
+func (c *Collection) CreatedAt() string   { return c.createdAt }
+func (c *Collection) UpdatedAt() string   { return c.updatedAt }
+
+// RouteIDs はコレクション内のルートのIDを並び順に返す
+func (c *Collection) RouteIDs() []string {
+	cp := make([]string, len(c.routeIDs))
+	copy(cp, c.routeIDs)
+	return cp
+}
+
+// Update は名前・説明・公開範囲を変更する。公開範囲はルートと同じ値を使う
+func (c *Collection) Update(name string, description string, visibility int16) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domainerror.New("name is required", domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return domainerror.New(fmt.Sprintf("name must be at most %d characters", MaxNameLength), domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(description) > MaxDescriptionLength {
+		return domainerror.New(fmt.Sprintf("description must be at most %d characters", MaxDescriptionLength), domainerror.ErrValidation)
+	}
+	if visibility < route.VisibilityPrivate || visibility > route.VisibilityFriends {
+		return domainerror.New("visibility must be one of 0, 1, or 2", domainerror.ErrValidation)
+	}
+	c.name = name
+	c.description = description
+	c.visibility = visibility
+	return nil
+}
+
+// IsVisibleTo はユーザーがコレクションを閲覧できるかを返す。コレクションはクラブと共有しない
+func (c *Collection) IsVisibleTo(viewer route.Viewer) bool {
+	return viewer.CanView(c.userID, c.visibility, nil)
+}
+
+// AddRoute はルートを末尾に追加する
+func (c *Collection) AddRoute(routeID string) error {
+	if c.indexOf(routeID) >= 0 {
+		return domainerror.New("route is already in the collection", domainerror.ErrConflict)
+	}
+	if len(c.routeIDs) >= MaxRoutesPerCollection {
+		return domainerror.New(fmt.Sprintf("collection must contain at most %d routes", MaxRoutesPerCollection), domainerror.ErrValidation)
+	}
+	c.routeIDs = append(c.routeIDs, routeID)
+	return nil
+}
+
+// RemoveRoute はルートを取り除く。残りのルートの並び順は変えない
+func (c *Collection) RemoveRoute(routeID string) error {
+	i := c.indexOf(routeID)
+	if i < 0 {
+		return domainerror.New("route is not in the collection", domainerror.ErrNotFound)
+	}
+	c.routeIDs = append(c.routeIDs[:i:i], c.routeIDs[i+1:]...)
+	return nil
+}
+
+// ReorderRoutes はルートを指定した順に並べ替える
+// 過不足なく、コレクション内のすべてのルートを指定する必要がある
+func (c *Collection) ReorderRoutes(routeIDs []string) error {
+	if len(routeIDs) != len(c.routeIDs) {
+		return domainerror.New("route_ids must contain every route in the collection", domainerror.ErrValidation)
+	}
+	seen := make(map[string]bool, len(routeIDs))
+	for _, id := range routeIDs {
+		if seen[id] || c.indexOf(id) < 0 {
+			return domainerror.New("route_ids must contain every route in the collection exactly once", domainerror.ErrValidation)
+		}
+		seen[id] = true
+	}
+	c.routeIDs = append([]string{}, routeIDs...)
+	return nil
+}
+
+func (c *Collection) indexOf(routeID string) int {
+	for i, id := range c.routeIDs {
+		if id == routeID {
+			return i
+		}
+	}
+	return -1
+}
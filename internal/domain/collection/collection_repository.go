@@ -0,0 +1,17 @@
+package collection
+
+import (
+	"context"
+)
+
+// ICollectionRepository はコレクションのリポジトリのインターフェース
+type ICollectionRepository interface {
+	GetCollectionByID(ctx context.Context, id string) (*Collection, error)
+	// ユーザーのコレクションを新しい順に取得する
+	GetCollectionsByUserID(ctx context.Context, userID string) ([]*Collection, error)
+	SaveCollection(ctx context.Context, collection *Collection) error
+	UpdateCollection(ctx context.Context, collection *Collection) error
+	DeleteCollection(ctx context.Context, id string) error
+	// コレクション内のルートとその並び順を保存し直す
+	SaveCollectionRoutes(ctx context.Context, collection *Collection) error
+}
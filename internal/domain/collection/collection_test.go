@@ -0,0 +1,118 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+)
+
+const testUserID = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+
+func TestNewCollection(t *testing.T) {
+	tests := []struct {
+		name        string
+		colName     string
+		description string
+		visibility  int16
+		wantName    string
+		wantErr     bool
+	}{
+		{name: "正常系", colName: " 北海道の名ルート ", visibility: 1, wantName: "北海道の名ルート"},
+		{name: "異常系: 名前が空", colName: "  ", wantErr: true},
+		{name: "異常系: 名前が長すぎる", colName: strings.Repeat("あ", MaxNameLength+1), wantErr: true},
+		{name: "異常系: 説明が長すぎる", colName: "峠", description: strings.Repeat("a", MaxDescriptionLength+1), wantErr: true},
+		{name: "異常系: 未定義の公開範囲", colName: "峠", visibility: 3, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewCollection(testUserID, tt.colName, tt.description, tt.visibility)
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("NewCollection() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewCollection() error = %v", err)
+			}
+			if c.ID() == "" || c.Name() != tt.wantName || len(c.RouteIDs()) != 0 {
+				t.Errorf("NewCollection() = %+v", c)
+			}
+		})
+	}
+}
+
+func TestCollection_Routes(t *testing.T) {
+	c := ReconstructCollection("collection-1", testUserID, "峠", "", 0, []string{"a", "b", "c"}, "", "")
+
+	if err := c.AddRoute("b"); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("AddRoute(b) error = %v, want ErrConflict", err)
+	}
+	if err := c.AddRoute("d"); err != nil {
+		t.Fatalf("AddRoute(d) error = %v", err)
+	}
+	if err := c.RemoveRoute("b"); err != nil {
+		t.Fatalf("RemoveRoute(b) error = %v", err)
+	}
+	if err := c.RemoveRoute("b"); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("RemoveRoute(b) error = %v, want ErrNotFound", err)
+	}
+	if got, want := c.RouteIDs(), []string{"a", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RouteIDs() = %v, want %v", got, want)
+	}
+
+	for _, ids := range [][]string{
+		{"d", "a"},           // 足りない
+		{"d", "a", "a"},      // 重複
+		{"d", "a", "x"},      // コレクションにない
+		{"d", "a", "c", "x"}, // 多い
+	} {
+		if err := c.ReorderRoutes(ids); !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("ReorderRoutes(%v) error = %v, want ErrValidation", ids, err)
+		}
+	}
+	if err := c.ReorderRoutes([]string{"d", "a", "c"}); err != nil {
+		t.Fatalf("ReorderRoutes() error = %v", err)
+	}
+	if got, want := c.RouteIDs(), []string{"d", "a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RouteIDs() = %v, want %v", got, want)
+	}
+}
+
+func TestCollection_AddRoute_Limit(t *testing.T) {
+	ids := make([]string, MaxRoutesPerCollection)
+	for i := range ids {
+		ids[i] = strings.Repeat("r", i+1)
+	}
+	c := ReconstructCollection("collection-1", testUserID, "峠", "", 0, ids, "", "")
+	if err := c.AddRoute("new"); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("AddRoute() error = %v, want ErrValidation", err)
+	}
+}
+
+func TestCollection_IsVisibleTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility int16
+		viewer     route.Viewer
+		want       bool
+	}{
+		{name: "所有者は非公開でも閲覧できる", visibility: 0, viewer: route.NewViewer(testUserID, nil), want: true},
+		{name: "公開は誰でも閲覧できる", visibility: 1, viewer: route.NewViewer("other", nil), want: true},
+		{name: "未ログインでも公開は閲覧できる", visibility: 1, viewer: route.Viewer{}, want: true},
+		{name: "非公開は他のユーザーは閲覧できない", visibility: 0, viewer: route.NewViewer("other", nil), want: false},
+		{name: "友達のみは他のユーザーは閲覧できない", visibility: 2, viewer: route.NewViewer("other", nil), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := ReconstructCollection("collection-1", testUserID, "峠", "", tt.visibility, nil, "", "")
+			if got := c.IsVisibleTo(tt.viewer); got != tt.want {
+				t.Errorf("IsVisibleTo(%q) = %v, want %v", tt.viewer.UserID(), got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/collection/collection_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/collection/collection_repository.go -destination=internal/domain/collection/mock_collection_repository.go -package collection
+//
+
+// Package collection is a generated GoMock package.
+package collection
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICollectionRepository is a mock of ICollectionRepository interface.
+type MockICollectionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICollectionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockICollectionRepositoryMockRecorder is the mock recorder for MockICollectionRepository.
+type MockICollectionRepositoryMockRecorder struct {
+	mock *MockICollectionRepository
+}
+
+// NewMockICollectionRepository creates a new mock instance.
+func NewMockICollectionRepository(ctrl *gomock.Controller) *MockICollectionRepository {
+	mock := &MockICollectionRepository{ctrl: ctrl}
+	mock.recorder = &MockICollectionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICollectionRepository) EXPECT() *MockICollectionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteCollection mocks base method.
+func (m *MockICollectionRepository) DeleteCollection(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCollection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockICollectionRepositoryMockRecorder) DeleteCollection(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockICollectionRepository)(nil).DeleteCollection), ctx, id)
+}
+
+// GetCollectionByID mocks base method.
+func (m *MockICollectionRepository) GetCollectionByID(ctx context.Context, id string) (*Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionByID", ctx, id)
+	ret0, _ := ret[0].(*Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionByID indicates an expected call of GetCollectionByID.
+func (mr *MockICollectionRepositoryMockRecorder) GetCollectionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionByID", reflect.TypeOf((*MockICollectionRepository)(nil).GetCollectionByID), ctx, id)
+}
+
+// GetCollectionsByUserID mocks base method.
+func (m *MockICollectionRepository) GetCollectionsByUserID(ctx context.Context, userID string) ([]*Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionsByUserID indicates an expected call of GetCollectionsByUserID.
+func (mr *MockICollectionRepositoryMockRecorder) GetCollectionsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionsByUserID", reflect.TypeOf((*MockICollectionRepository)(nil).GetCollectionsByUserID), ctx, userID)
+}
+
+// SaveCollection mocks base method.
+func (m *MockICollectionRepository) SaveCollection(ctx context.Context, collection *Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCollection", ctx, collection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCollection indicates an expected call of SaveCollection.
+func (mr *MockICollectionRepositoryMockRecorder) SaveCollection(ctx, collection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCollection", reflect.TypeOf((*MockICollectionRepository)(nil).SaveCollection), ctx, collection)
+}
+
+// SaveCollectionRoutes mocks base method.
+func (m *MockICollectionRepository) SaveCollectionRoutes(ctx context.Context, collection *Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCollectionRoutes", ctx, collection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCollectionRoutes indicates an expected call of SaveCollectionRoutes.
+func (mr *MockICollectionRepositoryMockRecorder) SaveCollectionRoutes(ctx, collection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCollectionRoutes", reflect.TypeOf((*MockICollectionRepository)(nil).SaveCollectionRoutes), ctx, collection)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockICollectionRepository) UpdateCollection(ctx context.Context, collection *Collection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollection", ctx, collection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockICollectionRepositoryMockRecorder) UpdateCollection(ctx, collection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockICollectionRepository)(nil).UpdateCollection), ctx, collection)
+}
@@ -7,6 +7,7 @@ var (
 	ErrValidation   = errors.New("validation error")
 	ErrNotFound     = errors.New("not found")
 	ErrUnauthorized = errors.New("unauthorized")
+	ErrConflict     = errors.New("conflict")
 )
 
 type Error struct {
@@ -0,0 +1,87 @@
+package event
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/google/uuid"
+)
+
+// コメントの最大文字数
+const MaxCommentLength = 1000
+
+// Comment はイベントへのコメント（集合場所の補足や遅刻の連絡など）
+type Comment struct {
+	id        string
+	eventID   string
+	userID    string
+	userName  string
+	body      string
+	createdAt string
+}
+
+func NewComment(eventID string, userID string, body string) (*Comment, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, domainerror.New("body is required", domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(body) > MaxCommentLength {
+		return nil, domainerror.New(fmt.Sprintf("body must be at most %d characters", MaxCommentLength), domainerror.ErrValidation)
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	return &Comment{id: id.String(), eventID: eventID, userID: userID, body: body}, nil
+}
+
+// ReconstructComment はリポジトリ層からの復元用
+func ReconstructComment(id string, eventID string, userID string, userName string, body string, createdAt string) *Comment {
+	return &Comment{id: id, eventID: eventID, userID: userID, userName: userName, body: body, createdAt: createdAt}
+}
+
+func (c *Comment) ID() string        { return c.id }
+func (c *Comment) EventID() string   { return c.eventID }
+func (c *Comment) UserID() string    { return c.userID }
+func (c *Comment) UserName() string  { return c.userName }
+func (c *Comment) Body() string      { return c.body }
+func (c *Comment) CreatedAt() string { return c.createdAt }
+
+// CanBeDeletedBy はコメントを削除できるか（書いた本人かイベントの主催者か）を返す
+func (c *Comment) CanBeDeletedBy(userID string, e *Event) bool {
+	return c.userID == userID || e.UserID() == userID
+}
+
+// CommentCriteria はイベントへのコメント一覧の取得条件
+// 作成日時の古い順にキーセットページネーションで取得する
+type CommentCriteria struct {
+	eventID string
+	limit   int32
+	after   *pagination.Cursor // nilの場合は先頭から取得する
+}
+
+func NewCommentCriteria(eventID string, limit int32, after *pagination.Cursor) (*CommentCriteria, error) {
+	if eventID == "" {
+		return nil, domainerror.New("eventID is required", domainerror.ErrValidation)
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+	return &CommentCriteria{eventID: eventID, limit: limit, after: after}, nil
+}
+
+func (c *CommentCriteria) EventID() string           { return c.eventID }
+func (c *CommentCriteria) Limit() int32              { return c.limit }
+func (c *CommentCriteria) After() *pagination.Cursor { return c.after }
+
+// CommentPage はキーセットページネーションで取得したコメント一覧の1ページ
+type CommentPage struct {
+	Items []*Comment
+	Next  *pagination.Cursor // 次のページがない場合はnil
+}
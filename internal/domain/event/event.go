@@ -0,0 +1,299 @@
+package event
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+// 入力できる文字数の上限
+const (
+	MaxTitleLength            = 100
+	MaxDescriptionLength      = 2000
+	MaxMeetingPointNameLength = 100
+	MaxCancelReasonLength     = 500
+)
+
+type EventID string
+
+func NewEventID() EventID {
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		panic(err)
+	}
+	return EventID(uuid.String())
+}
+
+func (id EventID) String() string {
+	return string(id)
+}
+
+// Details はイベントの作成・更新で主催者が指定する内容
+type Details struct {
+	RouteID          string
+	Title            string
+	Description      string
+	StartsAt         time.Time
+	MeetingPoint     orb.Point // 集合場所
+	MeetingPointName string
+	Capacity         *int32  // 参加者の上限。nilの場合は上限なし
+	Visibility       int16   // ルートと同じ値を使う
+	ClubID           *string // 公開範囲がクラブのメンバーのみの場合の共有先
+}
+
+// Event はルートを走るグループライド（クラブの定例走行会など）
+// 参加の返事はRSVPとして別に持ち、参加者数だけを集計して保持する
+type Event struct {
+	id               EventID
+	userID           string // 主催者
+	routeID          string
+	title            string
+	description      string
+	startsAt         time.Time
+	meetingPoint     orb.Point
+	meetingPointName string
+	capacity         *int32
+	visibility       int16
+	clubID           *string
+	cancelledAt      *time.Time
+	cancelReason     string
+	goingCount       int32 // 参加する（going）と返事したユーザーの数
+	createdAt        string
+	updatedAt        string
+}
+
+// NewEvent はイベントを作成する。開始日時は現在より後でなければならない
+func NewEvent(userID string, details Details, now time.Time) (*Event, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	e := &Event{
+		id:     NewEventID(),
+		userID: userID,
+	}
+	if err := e.Update(details, now); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// ReconstructEvent はリポジトリ層からの復元用
+func ReconstructEvent(
+	id string,
+	userID string,
+	details Details,
+	cancelledAt *time.Time,
+	cancelReason string,
+	goingCount int32,
+	createdAt string,
+	updatedAt string,
+) *Event {
+	return &Event{
+		id:               EventID(id),
+		userID:           userID,
+		routeID:          details.RouteID,
+		title:            details.Title,
+		description:      details.Description,
+		startsAt:         details.StartsAt,
+		meetingPoint:     details.MeetingPoint,
+		meetingPointName: details.MeetingPointName,
+		capacity:         details.Capacity,
+		visibility:       details.Visibility,
+		clubID:           details.ClubID,
+		cancelledAt:      cancelledAt,
+		cancelReason:     cancelReason,
+		goingCount:       goingCount,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}
+}
+
+func (e *Event) ID() EventID              { return e.id }
+func (e *Event) UserID() string           { return e.userID }
+func (e *Event) RouteID() string          { return e.routeID }
+func (e *Event) Title() string            { return e.title }
+func (e *Event) Description() string      { return e.description }
+func (e *Event) StartsAt() time.Time      { return e.startsAt }
+func (e *Event) MeetingPoint() orb.Point  { return e.meetingPoint }
+func (e *Event) MeetingPointName() string { return e.meetingPointName }
+func (e *Event) Capacity() *int32         { return e.capacity }
+func (e *Event) Visibility() int16        { return e.visibility }
+func (e *Event) ClubID() *string          { return e.clubID }
+func (e *Event) CancelledAt() *time.Time  { return e.cancelledAt }
+func (e *Event) CancelReason() string     { return e.cancelReason }
+func (e *Event) GoingCount() int32        { return e.goingCount }
+func (e *Event) CreatedAt() string        { return e.createdAt }
+func (e *Event) UpdatedAt() string        { return e.updatedAt }
+
+func (e *Event) IsCancelled() bool {
+	return e.cancelledAt != nil
+}
+
+// Update はイベントの内容を置き換える。中止したイベントは変更できない
+// 参加者の上限は、参加すると返事した人数より少なくできない
+func (e *Event) Update(details Details, now time.Time) error {
+	if e.IsCancelled() {
+		return domainerror.New("event is cancelled", domainerror.ErrConflict)
+	}
+	if details.RouteID == "" {
+		return domainerror.New("route_id is required", domainerror.ErrValidation)
+	}
+	title := strings.TrimSpace(details.Title)
+	if title == "" {
+		return domainerror.New("title is required", domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(title) > MaxTitleLength {
+		return domainerror.New(fmt.Sprintf("title must be at most %d characters", MaxTitleLength), domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(details.Description) > MaxDescriptionLength {
+		return domainerror.New(fmt.Sprintf("description must be at most %d characters", MaxDescriptionLength), domainerror.ErrValidation)
+	}
+	if !details.StartsAt.After(now) {
+		return domainerror.New("starts_at must be in the future", domainerror.ErrValidation)
+	}
+	p := details.MeetingPoint
+	if p.Lon() < -180 || p.Lon() > 180 || p.Lat() < -90 || p.Lat() > 90 {
+		return domainerror.New("meeting point is out of range", domainerror.ErrValidation)
+	}
+	meetingPointName := strings.TrimSpace(details.MeetingPointName)
+	if utf8.RuneCountInString(meetingPointName) > MaxMeetingPointNameLength {
+		return domainerror.New(fmt.Sprintf("meeting_point_name must be at most %d characters", MaxMeetingPointNameLength), domainerror.ErrValidation)
+	}
+	if details.Capacity != nil {
+		if *details.Capacity <= 0 {
+			return domainerror.New("capacity must be positive", domainerror.ErrValidation)
+		}
+		if *details.Capacity < e.goingCount {
+			return domainerror.New("capacity must not be less than the number of attendees", domainerror.ErrConflict)
+		}
+	}
+	if details.Visibility < route.VisibilityPrivate || details.Visibility > route.VisibilityClub {
+		return domainerror.New("visibility must be between 0 and 3", domainerror.ErrValidation)
+	}
+	if (details.Visibility == route.VisibilityClub) != (details.ClubID != nil) {
+		return domainerror.New("club_id must be set only for club visibility", domainerror.ErrValidation)
+	}
+
+	e.routeID = details.RouteID
+	e.title = title
+	e.description = details.Description
+	e.startsAt = details.StartsAt
+	e.meetingPoint = p
+	e.meetingPointName = meetingPointName
+	e.capacity = details.Capacity
+	e.visibility = details.Visibility
+	e.clubID = details.ClubID
+	return nil
+}
+
+// CheckRoute は主催者がイベントの公開範囲とルートを使えるかを確かめる
+// ルートは主催者が閲覧できるものに限り、参加者がGPXを取得できるよう、公開のイベントでは公開のルート、
+// クラブのイベントではそのクラブのメンバーが閲覧できるルートに限る。クラブのイベントは所属するクラブでしか作れない
+func (e *Event) CheckRoute(organizer route.Viewer, r *route.Route) error {
+	if err := route.CheckVisibility(organizer, e.visibility, e.clubID); err != nil {
+		return err
+	}
+	if !r.IsVisibleTo(organizer) {
+		return domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	switch e.visibility {
+	case route.VisibilityPublic:
+		if r.Visibility() != route.VisibilityPublic {
+			return domainerror.New("a public event requires a public route", domainerror.ErrValidation)
+		}
+	case route.VisibilityClub:
+		// 主催者以外のメンバーとして閲覧できるかで判定する
+		if !r.IsVisibleTo(route.NewViewer("", []string{*e.clubID})) {
+			return domainerror.New("a club event requires a route visible to the club members", domainerror.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// Cancel はイベントを中止する。開始後や中止済みのイベントは中止できない
+func (e *Event) Cancel(reason string, now time.Time) error {
+	if e.IsCancelled() {
+		return domainerror.New("event is already cancelled", domainerror.ErrConflict)
+	}
+	if !now.Before(e.startsAt) {
+		return domainerror.New("event has already started", domainerror.ErrConflict)
+	}
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > MaxCancelReasonLength {
+		return domainerror.New(fmt.Sprintf("reason must be at most %d characters", MaxCancelReasonLength), domainerror.ErrValidation)
+	}
+	e.cancelledAt = &now
+	e.cancelReason = reason
+	return nil
+}
+
+// Respond はユーザーの参加の返事を受け付ける。current はそのユーザーの前回の返事で、初めての場合はnil
+// 中止・開始後のイベントには返事できず、上限に達している場合は新たに参加できない
+func (e *Event) Respond(userID string, status RSVPStatus, current *RSVP, now time.Time) (*RSVP, error) {
+	if e.IsCancelled() {
+		return nil, domainerror.New("event is cancelled", domainerror.ErrConflict)
+	}
+	if !now.Before(e.startsAt) {
+		return nil, domainerror.New("event has already started", domainerror.ErrConflict)
+	}
+	rsvp, err := NewRSVP(e.id.String(), userID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	wasGoing := current != nil && current.Status() == RSVPGoing
+	switch {
+	case status == RSVPGoing && !wasGoing:
+		if e.IsFull() {
+			return nil, domainerror.New("event is full", domainerror.ErrConflict)
+		}
+		e.goingCount++
+	case status != RSVPGoing && wasGoing:
+		e.goingCount--
+	}
+	return rsvp, nil
+}
+
+// IsFull は参加者が上限に達しているかを返す
+func (e *Event) IsFull() bool {
+	return e.capacity != nil && e.goingCount >= *e.capacity
+}
+
+// IsVisibleTo はユーザーがイベントを閲覧できるかを返す。ルートと同じ判定を使う
+func (e *Event) IsVisibleTo(viewer route.Viewer) bool {
+	return viewer.CanView(e.userID, e.visibility, e.clubID)
+}
+
+// UpcomingEventsCriteria は開催予定のイベントの検索条件
+type UpcomingEventsCriteria struct {
+	location *orb.Point
+	radius   *float64 // 集合場所までの距離(m)
+	from     time.Time
+	limit    int32
+}
+
+// NewUpcomingEventsCriteria は from 以降に始まるイベントの検索条件を作成する
+// 地点と半径を指定した場合は、集合場所がその範囲内のイベントに絞り込む
+func NewUpcomingEventsCriteria(location *orb.Point, radius *float64, from time.Time, limit int32) (*UpcomingEventsCriteria, error) {
+	if (location == nil) != (radius == nil) {
+		return nil, domainerror.New("location and radius must be provided together", domainerror.ErrValidation)
+	}
+	if radius != nil && *radius < 0 {
+		return nil, domainerror.New("radius must be non-negative", domainerror.ErrValidation)
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+	return &UpcomingEventsCriteria{location: location, radius: radius, from: from, limit: limit}, nil
+}
+
+func (c UpcomingEventsCriteria) Location() *orb.Point { return c.location }
+func (c UpcomingEventsCriteria) Radius() *float64     { return c.radius }
+func (c UpcomingEventsCriteria) From() time.Time      { return c.from }
+func (c UpcomingEventsCriteria) Limit() int32         { return c.limit }
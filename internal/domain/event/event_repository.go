@@ -0,0 +1,28 @@
+package event
+
+import (
+	"context"
+)
+
+// IEventRepository はイベントとその返事・コメントのリポジトリのインターフェース
+type IEventRepository interface {
+	// 参加者数とともにイベントを取得する
+	GetEventByID(ctx context.Context, id string) (*Event, error)
+	// 中止されていない公開のイベントを開始日時の順に取得する
+	GetUpcomingEvents(ctx context.Context, criteria *UpcomingEventsCriteria) ([]*Event, error)
+	SaveEvent(ctx context.Context, event *Event) error
+	UpdateEvent(ctx context.Context, event *Event) error
+
+	// 返事がない場合はErrNotFoundを返す
+	GetRSVP(ctx context.Context, eventID string, userID string) (*RSVP, error)
+	// 返事を古い順に取得する
+	GetRSVPs(ctx context.Context, eventID string) ([]*RSVP, error)
+	// 返事を保存する。同時に参加した場合も上限を超えないよう、イベントを排他ロックしてから数え直す
+	SaveRSVP(ctx context.Context, rsvp *RSVP) error
+
+	GetComment(ctx context.Context, id string) (*Comment, error)
+	// コメントを古い順に取得する
+	GetComments(ctx context.Context, criteria *CommentCriteria) (*CommentPage, error)
+	SaveComment(ctx context.Context, comment *Comment) error
+	DeleteComment(ctx context.Context, id string) error
+}
@@ -0,0 +1,284 @@
+package event
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/paulmach/orb"
+)
+
+const testUserID = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+
+var testNow = time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+func testDetails() Details {
+	return Details{
+		RouteID:          "route-1",
+		Title:            " 土曜朝練 ",
+		StartsAt:         testNow.Add(48 * time.Hour),
+		MeetingPoint:     orb.Point{139.6270, 35.6110},
+		MeetingPointName: "二子玉川駅",
+		Visibility:       route.VisibilityPublic,
+	}
+}
+
+func newTestEvent(capacity *int32, goingCount int32) *Event {
+	d := testDetails()
+	d.Capacity = capacity
+	return ReconstructEvent("event-1", testUserID, d, nil, "", goingCount, "", "")
+}
+
+func TestNewEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(d *Details)
+		wantErr bool
+	}{
+		{name: "正常系", modify: func(d *Details) {}},
+		{name: "正常系: 上限あり", modify: func(d *Details) { d.Capacity = new(int32(10)) }},
+		{name: "異常系: ルートがない", modify: func(d *Details) { d.RouteID = "" }, wantErr: true},
+		{name: "異常系: タイトルが空", modify: func(d *Details) { d.Title = " " }, wantErr: true},
+		{name: "異常系: タイトルが長すぎる", modify: func(d *Details) { d.Title = strings.Repeat("あ", MaxTitleLength+1) }, wantErr: true},
+		{name: "異常系: 説明が長すぎる", modify: func(d *Details) { d.Description = strings.Repeat("a", MaxDescriptionLength+1) }, wantErr: true},
+		{name: "異常系: 開始日時が過去", modify: func(d *Details) { d.StartsAt = testNow.Add(-time.Minute) }, wantErr: true},
+		{name: "異常系: 集合場所が範囲外", modify: func(d *Details) { d.MeetingPoint = orb.Point{200, 35} }, wantErr: true},
+		{name: "異常系: 上限が0", modify: func(d *Details) { d.Capacity = new(int32(0)) }, wantErr: true},
+		{name: "正常系: クラブのメンバーのみ", modify: func(d *Details) { d.Visibility = route.VisibilityClub; d.ClubID = new("club-1") }},
+		{name: "異常系: 未定義の公開範囲", modify: func(d *Details) { d.Visibility = 4 }, wantErr: true},
+		{name: "異常系: クラブのメンバーのみで共有先がない", modify: func(d *Details) { d.Visibility = route.VisibilityClub }, wantErr: true},
+		{name: "異常系: 公開で共有先がある", modify: func(d *Details) { d.ClubID = new("club-1") }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testDetails()
+			tt.modify(&d)
+			e, err := NewEvent(testUserID, d, testNow)
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("NewEvent() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewEvent() error = %v", err)
+			}
+			if e.ID() == "" || e.Title() != "土曜朝練" || e.IsCancelled() {
+				t.Errorf("NewEvent() = %+v", e)
+			}
+		})
+	}
+}
+
+func TestEvent_Update(t *testing.T) {
+	t.Run("異常系: 参加者数より少ない上限", func(t *testing.T) {
+		e := newTestEvent(nil, 5)
+		d := testDetails()
+		d.Capacity = new(int32(4))
+		if err := e.Update(d, testNow); !errors.Is(err, domainerror.ErrConflict) {
+			t.Errorf("Update() error = %v, want ErrConflict", err)
+		}
+	})
+
+	t.Run("異常系: 中止したイベント", func(t *testing.T) {
+		e := newTestEvent(nil, 0)
+		_ = e.Cancel("", testNow)
+		if err := e.Update(testDetails(), testNow); !errors.Is(err, domainerror.ErrConflict) {
+			t.Errorf("Update() error = %v, want ErrConflict", err)
+		}
+	})
+}
+
+func TestEvent_CheckRoute(t *testing.T) {
+	newRoute := func(userID string, visibility int16) *route.Route {
+		r, _ := route.ReconstructRoute("route-1", userID, "多摩川", "", nil, 0, 0, 0, 0, route.Geometry{}, route.Geometry{}, route.Geometry{}, route.Geometry{}, "", visibility, 1, nil, "", "")
+		if visibility == route.VisibilityClub {
+			r.SetClubID(new("club-1"))
+		}
+		return r
+	}
+
+	tests := []struct {
+		name            string
+		eventVisibility int16
+		clubID          *string
+		clubs           []string // 主催者が所属するクラブ
+		route           *route.Route
+		wantErr         error
+	}{
+		{name: "正常系: 公開のイベントと公開のルート", eventVisibility: route.VisibilityPublic, route: newRoute("other", route.VisibilityPublic)},
+		{name: "正常系: 非公開のイベントなら自分の非公開ルート", eventVisibility: route.VisibilityPrivate, route: newRoute(testUserID, route.VisibilityPrivate)},
+		{name: "異常系: 公開のイベントに自分の非公開ルート", eventVisibility: route.VisibilityPublic, route: newRoute(testUserID, route.VisibilityPrivate), wantErr: domainerror.ErrValidation},
+		{name: "異常系: 他のユーザーの非公開ルート", eventVisibility: route.VisibilityPrivate, route: newRoute("other", route.VisibilityPrivate), wantErr: domainerror.ErrNotFound},
+		{name: "正常系: クラブのイベントとクラブのメンバーのみのルート", eventVisibility: route.VisibilityClub, clubID: new("club-1"), clubs: []string{"club-1"}, route: newRoute("other", route.VisibilityClub)},
+		{name: "正常系: クラブのイベントと公開のルート", eventVisibility: route.VisibilityClub, clubID: new("club-1"), clubs: []string{"club-1"}, route: newRoute("other", route.VisibilityPublic)},
+		{name: "異常系: クラブのイベントに自分の非公開ルート", eventVisibility: route.VisibilityClub, clubID: new("club-1"), clubs: []string{"club-1"}, route: newRoute(testUserID, route.VisibilityPrivate), wantErr: domainerror.ErrValidation},
+		{name: "異常系: 所属していないクラブのイベント", eventVisibility: route.VisibilityClub, clubID: new("club-1"), route: newRoute("other", route.VisibilityPublic), wantErr: domainerror.ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testDetails()
+			d.Visibility = tt.eventVisibility
+			d.ClubID = tt.clubID
+			e := ReconstructEvent("event-1", testUserID, d, nil, "", 0, "", "")
+			err := e.CheckRoute(route.NewViewer(testUserID, tt.clubs), tt.route)
+			if tt.wantErr == nil && err != nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckRoute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEvent_Cancel(t *testing.T) {
+	e := newTestEvent(nil, 0)
+	if err := e.Cancel(" 雨天のため ", testNow); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if !e.IsCancelled() || e.CancelReason() != "雨天のため" {
+		t.Errorf("Cancel() = %+v", e)
+	}
+	if err := e.Cancel("", testNow); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("Cancel() twice error = %v, want ErrConflict", err)
+	}
+
+	started := newTestEvent(nil, 0)
+	if err := started.Cancel("", started.StartsAt()); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("Cancel() after start error = %v, want ErrConflict", err)
+	}
+}
+
+func TestEvent_Respond(t *testing.T) {
+	going := ReconstructRSVP("event-1", "rider", "", RSVPGoing, "")
+	maybe := ReconstructRSVP("event-1", "rider", "", RSVPMaybe, "")
+
+	tests := []struct {
+		name      string
+		capacity  *int32
+		going     int32
+		status    RSVPStatus
+		current   *RSVP
+		now       time.Time
+		wantGoing int32
+		wantErr   error
+	}{
+		{name: "正常系: 上限なしで参加", going: 3, status: RSVPGoing, wantGoing: 4},
+		{name: "正常系: 未定から参加", capacity: new(int32(4)), going: 3, status: RSVPGoing, current: maybe, wantGoing: 4},
+		{name: "正常系: 満員でも参加済みなら返事をし直せる", capacity: new(int32(4)), going: 4, status: RSVPGoing, current: going, wantGoing: 4},
+		{name: "正常系: 満員でも未定にはできる", capacity: new(int32(4)), going: 4, status: RSVPMaybe, wantGoing: 4},
+		{name: "正常系: 参加を取りやめると空きができる", capacity: new(int32(4)), going: 4, status: RSVPNotGoing, current: going, wantGoing: 3},
+		{name: "異常系: 満員", capacity: new(int32(4)), going: 4, status: RSVPGoing, current: maybe, wantErr: domainerror.ErrConflict},
+		{name: "異常系: 開始後", status: RSVPGoing, now: testNow.Add(72 * time.Hour), wantErr: domainerror.ErrConflict},
+		{name: "異常系: 未定義の返事", status: "yes", wantErr: domainerror.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEvent(tt.capacity, tt.going)
+			now := tt.now
+			if now.IsZero() {
+				now = testNow
+			}
+			rsvp, err := e.Respond("rider", tt.status, tt.current, now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Respond() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Respond() error = %v", err)
+			}
+			if rsvp.Status() != tt.status || rsvp.EventID() != "event-1" {
+				t.Errorf("Respond() = %+v", rsvp)
+			}
+			if e.GoingCount() != tt.wantGoing {
+				t.Errorf("GoingCount() = %d, want %d", e.GoingCount(), tt.wantGoing)
+			}
+		})
+	}
+
+	t.Run("異常系: 中止したイベント", func(t *testing.T) {
+		e := newTestEvent(nil, 0)
+		_ = e.Cancel("", testNow)
+		if _, err := e.Respond("rider", RSVPGoing, nil, testNow); !errors.Is(err, domainerror.ErrConflict) {
+			t.Errorf("Respond() error = %v, want ErrConflict", err)
+		}
+	})
+}
+
+func TestEvent_IsVisibleTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility int16
+		viewer     string
+		clubs      []string
+		want       bool
+	}{
+		{name: "主催者は非公開でも閲覧できる", visibility: 0, viewer: testUserID, want: true},
+		{name: "公開は未ログインでも閲覧できる", visibility: 1, viewer: "", want: true},
+		{name: "非公開は他のユーザーは閲覧できない", visibility: 0, viewer: "other", want: false},
+		{name: "友達のみは他のユーザーは閲覧できない", visibility: 2, viewer: "other", want: false},
+		{name: "クラブのメンバーは閲覧できる", visibility: 3, viewer: "other", clubs: []string{"club-1"}, want: true},
+		{name: "クラブのメンバー以外は閲覧できない", visibility: 3, viewer: "other", clubs: []string{"club-2"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testDetails()
+			d.Visibility = tt.visibility
+			if tt.visibility == route.VisibilityClub {
+				d.ClubID = new("club-1")
+			}
+			e := ReconstructEvent("event-1", testUserID, d, nil, "", 0, "", "")
+			if got := e.IsVisibleTo(route.NewViewer(tt.viewer, tt.clubs)); got != tt.want {
+				t.Errorf("IsVisibleTo(%q) = %v, want %v", tt.viewer, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewUpcomingEventsCriteria(t *testing.T) {
+	location := &orb.Point{139.6270, 35.6110}
+	if _, err := NewUpcomingEventsCriteria(location, new(10000.0), testNow, 20); err != nil {
+		t.Errorf("NewUpcomingEventsCriteria() error = %v", err)
+	}
+	if _, err := NewUpcomingEventsCriteria(nil, nil, testNow, 20); err != nil {
+		t.Errorf("NewUpcomingEventsCriteria() without location error = %v", err)
+	}
+	for name, args := range map[string]struct {
+		location *orb.Point
+		radius   *float64
+		limit    int32
+	}{
+		"半径だけ": {radius: new(10000.0), limit: 20},
+		"地点だけ": {location: location, limit: 20},
+		"負の半径": {location: location, radius: new(-1.0), limit: 20},
+		"件数が0": {limit: 0},
+	} {
+		if _, err := NewUpcomingEventsCriteria(args.location, args.radius, testNow, args.limit); !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("%s: error = %v, want ErrValidation", name, err)
+		}
+	}
+}
+
+func TestComment(t *testing.T) {
+	if _, err := NewComment("event-1", "rider", "  "); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewComment() error = %v, want ErrValidation", err)
+	}
+	if _, err := NewComment("event-1", "rider", strings.Repeat("a", MaxCommentLength+1)); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewComment() error = %v, want ErrValidation", err)
+	}
+
+	c, err := NewComment("event-1", "rider", " 5分遅れます ")
+	if err != nil {
+		t.Fatalf("NewComment() error = %v", err)
+	}
+	if c.Body() != "5分遅れます" {
+		t.Errorf("Body() = %q", c.Body())
+	}
+	e := newTestEvent(nil, 0)
+	if !c.CanBeDeletedBy("rider", e) || !c.CanBeDeletedBy(testUserID, e) || c.CanBeDeletedBy("other", e) {
+		t.Error("CanBeDeletedBy() returned an unexpected result")
+	}
+}
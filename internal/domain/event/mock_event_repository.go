@@ -0,0 +1,201 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/event/event_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/event/event_repository.go -destination=internal/domain/event/mock_event_repository.go -package event
+//
+
+// Package event is a generated GoMock package.
+package event
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIEventRepository is a mock of IEventRepository interface.
+type MockIEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEventRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIEventRepositoryMockRecorder is the mock recorder for MockIEventRepository.
+type MockIEventRepositoryMockRecorder struct {
+	mock *MockIEventRepository
+}
+
+// NewMockIEventRepository creates a new mock instance.
+func NewMockIEventRepository(ctrl *gomock.Controller) *MockIEventRepository {
+	mock := &MockIEventRepository{ctrl: ctrl}
+	mock.recorder = &MockIEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEventRepository) EXPECT() *MockIEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteComment mocks base method.
+func (m *MockIEventRepository) DeleteComment(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockIEventRepositoryMockRecorder) DeleteComment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockIEventRepository)(nil).DeleteComment), ctx, id)
+}
+
+// GetComment mocks base method.
+func (m *MockIEventRepository) GetComment(ctx context.Context, id string) (*Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComment", ctx, id)
+	ret0, _ := ret[0].(*Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComment indicates an expected call of GetComment.
+func (mr *MockIEventRepositoryMockRecorder) GetComment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockIEventRepository)(nil).GetComment), ctx, id)
+}
+
+// GetComments mocks base method.
+func (m *MockIEventRepository) GetComments(ctx context.Context, criteria *CommentCriteria) (*CommentPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComments", ctx, criteria)
+	ret0, _ := ret[0].(*CommentPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComments indicates an expected call of GetComments.
+func (mr *MockIEventRepositoryMockRecorder) GetComments(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockIEventRepository)(nil).GetComments), ctx, criteria)
+}
+
+// GetEventByID mocks base method.
+func (m *MockIEventRepository) GetEventByID(ctx context.Context, id string) (*Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventByID", ctx, id)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventByID indicates an expected call of GetEventByID.
+func (mr *MockIEventRepositoryMockRecorder) GetEventByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockIEventRepository)(nil).GetEventByID), ctx, id)
+}
+
+// GetRSVP mocks base method.
+func (m *MockIEventRepository) GetRSVP(ctx context.Context, eventID, userID string) (*RSVP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRSVP", ctx, eventID, userID)
+	ret0, _ := ret[0].(*RSVP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRSVP indicates an expected call of GetRSVP.
+func (mr *MockIEventRepositoryMockRecorder) GetRSVP(ctx, eventID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRSVP", reflect.TypeOf((*MockIEventRepository)(nil).GetRSVP), ctx, eventID, userID)
+}
+
+// GetRSVPs mocks base method.
+func (m *MockIEventRepository) GetRSVPs(ctx context.Context, eventID string) ([]*RSVP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRSVPs", ctx, eventID)
+	ret0, _ := ret[0].([]*RSVP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRSVPs indicates an expected call of GetRSVPs.
+func (mr *MockIEventRepositoryMockRecorder) GetRSVPs(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRSVPs", reflect.TypeOf((*MockIEventRepository)(nil).GetRSVPs), ctx, eventID)
+}
+
+// GetUpcomingEvents mocks base method.
+func (m *MockIEventRepository) GetUpcomingEvents(ctx context.Context, criteria *UpcomingEventsCriteria) ([]*Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpcomingEvents", ctx, criteria)
+	ret0, _ := ret[0].([]*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcomingEvents indicates an expected call of GetUpcomingEvents.
+func (mr *MockIEventRepositoryMockRecorder) GetUpcomingEvents(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingEvents", reflect.TypeOf((*MockIEventRepository)(nil).GetUpcomingEvents), ctx, criteria)
+}
+
+// SaveComment mocks base method.
+func (m *MockIEventRepository) SaveComment(ctx context.Context, comment *Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveComment indicates an expected call of SaveComment.
+func (mr *MockIEventRepositoryMockRecorder) SaveComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveComment", reflect.TypeOf((*MockIEventRepository)(nil).SaveComment), ctx, comment)
+}
+
+// SaveEvent mocks base method.
+func (m *MockIEventRepository) SaveEvent(ctx context.Context, event *Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEvent indicates an expected call of SaveEvent.
+func (mr *MockIEventRepositoryMockRecorder) SaveEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEvent", reflect.TypeOf((*MockIEventRepository)(nil).SaveEvent), ctx, event)
+}
+
+// SaveRSVP mocks base method.
+func (m *MockIEventRepository) SaveRSVP(ctx context.Context, rsvp *RSVP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRSVP", ctx, rsvp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRSVP indicates an expected call of SaveRSVP.
+func (mr *MockIEventRepositoryMockRecorder) SaveRSVP(ctx, rsvp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRSVP", reflect.TypeOf((*MockIEventRepository)(nil).SaveRSVP), ctx, rsvp)
+}
+
+// UpdateEvent mocks base method.
+func (m *MockIEventRepository) UpdateEvent(ctx context.Context, event *Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEvent indicates an expected call of UpdateEvent.
+func (mr *MockIEventRepositoryMockRecorder) UpdateEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockIEventRepository)(nil).UpdateEvent), ctx, event)
+}
@@ -0,0 +1,58 @@
+package event
+
+import (
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+// RSVPStatus はイベントへの参加の返事
+type RSVPStatus string
+
+const (
+	RSVPGoing    RSVPStatus = "going"
+	RSVPMaybe    RSVPStatus = "maybe"
+	RSVPNotGoing RSVPStatus = "not_going"
+)
+
+func ParseRSVPStatus(s string) (RSVPStatus, error) {
+	switch status := RSVPStatus(s); status {
+	case RSVPGoing, RSVPMaybe, RSVPNotGoing:
+		return status, nil
+	default:
+		return "", domainerror.New("status must be one of going, maybe, or not_going", domainerror.ErrValidation)
+	}
+}
+
+// RSVP はユーザーのイベントへの参加の返事。ユーザーごとに最新の返事だけを持つ
+type RSVP struct {
+	eventID   string
+	userID    string
+	userName  string
+	status    RSVPStatus
+	updatedAt string
+}
+
+func NewRSVP(eventID string, userID string, status RSVPStatus) (*RSVP, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	if _, err := ParseRSVPStatus(string(status)); err != nil {
+		return nil, err
+	}
+	return &RSVP{eventID: eventID, userID: userID, status: status}, nil
+}
+
+// ReconstructRSVP はリポジトリ層からの復元用
+func ReconstructRSVP(eventID string, userID string, userName string, status RSVPStatus, updatedAt string) *RSVP {
+	return &RSVP{eventID: eventID, userID: userID, userName: userName, status: status, updatedAt: updatedAt}
+}
+
+func (r *RSVP) EventID() string    { return r.eventID }
+func (r *RSVP) UserID() string     { return r.userID }
+func (r *RSVP) UserName() string   { return r.userName }
+func (r *RSVP) Status() RSVPStatus { return r.status }
+func (r *RSVP) UpdatedAt() string  { return r.updatedAt }
+
+// IsAttending は中止を知らせる相手かどうか（参加する・未定と返事したか）を返す
+func (r *RSVP) IsAttending() bool {
+	return r.status == RSVPGoing || r.status == RSVPMaybe
+}
@@ -0,0 +1,107 @@
+package feed
+
+import (
+	"slices"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/google/uuid"
+)
+
+// EventType はフィードに表示する出来事の種類
+type EventType string
+
+const (
+	EventTypeRouteCreated   EventType = "route_created"   // ルートを作成した。対象はルート（フォークを含む）
+	EventTypeTripUploaded   EventType = "trip_uploaded"   // トリップをアップロードした。対象はトリップ
+	EventTypeRouteLiked     EventType = "route_liked"     // ルートにいいねした。対象はルート
+	EventTypeRouteCommented EventType = "route_commented" // ルートにコメントした。対象はコメント
+)
+
+// eventTypes は記録できる出来事の種類
+// 種類を追加するときは、ここに加えたうえでフィードのユースケースに対象の取得方法を登録する
+var eventTypes = []EventType{
+	EventTypeRouteCreated,
+	EventTypeTripUploaded,
+	EventTypeRouteLiked,
+	EventTypeRouteCommented,
+}
+
+func (t EventType) IsValid() bool {
+	return slices.Contains(eventTypes, t)
+}
+
+// Event はフィードに表示する出来事。誰が（actor）何を（subject）したかだけを持ち、
+// 対象の中身と公開範囲は閲覧時に対象から取得する
+type Event struct {
+	id        string
+	actorID   string
+	actorName string
+	eventType EventType
+	subjectID string
+	createdAt string
+}
+
+func NewEvent(eventType EventType, actorID string, subjectID string) (*Event, error) {
+	if !eventType.IsValid() {
+		return nil, domainerror.New("invalid event type", domainerror.ErrValidation)
+	}
+	if actorID == "" {
+		return nil, domainerror.New("actorID is required", domainerror.ErrValidation)
+	}
+	if subjectID == "" {
+		return nil, domainerror.New("subjectID is required", domainerror.ErrValidation)
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	return &Event{id: id.String(), actorID: actorID, eventType: eventType, subjectID: subjectID}, nil
+}
+
+// ReconstructEvent はリポジトリ層からの復元用
+func ReconstructEvent(id string, actorID string, actorName string, eventType EventType, subjectID string, createdAt string) *Event {
+	return &Event{
+		id:        id,
+		actorID:   actorID,
+		actorName: actorName,
+		eventType: eventType,
+		subjectID: subjectID,
+		createdAt: createdAt,
+	}
+}
+
+func (e *Event) ID() string           { return e.id }
+func (e *Event) ActorID() string      { return e.actorID }
+func (e *Event) ActorName() string    { return e.actorName }
+func (e *Event) EventType() EventType { return e.eventType }
+func (e *Event) SubjectID() string    { return e.subjectID }
+func (e *Event) CreatedAt() string    { return e.createdAt }
+
+// FeedCriteria はユーザーのフィードの取得条件
+// フォローしているユーザーの出来事を新しい順にキーセットページネーションで取得する
+type FeedCriteria struct {
+	userID string
+	limit  int32
+	after  *pagination.Cursor // nilの場合は先頭から取得する
+}
+
+func NewFeedCriteria(userID string, limit int32, after *pagination.Cursor) (*FeedCriteria, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+	return &FeedCriteria{userID: userID, limit: limit, after: after}, nil
+}
+
+func (c *FeedCriteria) UserID() string            { return c.userID }
+func (c *FeedCriteria) Limit() int32              { return c.limit }
+func (c *FeedCriteria) After() *pagination.Cursor { return c.after }
+
+// FeedPage はキーセットページネーションで取得したフィードの1ページ
+type FeedPage struct {
+	Items []*Event
+	Next  *pagination.Cursor // 次のページがない場合はnil
+}
@@ -0,0 +1,53 @@
+package feed
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+func TestNewEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType EventType
+		actorID   string
+		subjectID string
+		wantErr   bool
+	}{
+		{name: "正常系", eventType: EventTypeRouteCreated, actorID: "user-1", subjectID: "route-1"},
+		{name: "正常系: コメント", eventType: EventTypeRouteCommented, actorID: "user-1", subjectID: "comment-1"},
+		{name: "異常系: 未知の種類", eventType: EventType("route_shared"), actorID: "user-1", subjectID: "route-1", wantErr: true},
+		{name: "異常系: 行為者が空", eventType: EventTypeRouteLiked, subjectID: "route-1", wantErr: true},
+		{name: "異常系: 対象が空", eventType: EventTypeTripUploaded, actorID: "user-1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewEvent(tt.eventType, tt.actorID, tt.subjectID)
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("NewEvent() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewEvent() error = %v", err)
+			}
+			if e.ID() == "" || e.EventType() != tt.eventType || e.ActorID() != tt.actorID || e.SubjectID() != tt.subjectID {
+				t.Errorf("NewEvent() = %+v", e)
+			}
+		})
+	}
+}
+
+func TestNewFeedCriteria(t *testing.T) {
+	if _, err := NewFeedCriteria("user-1", 20, nil); err != nil {
+		t.Errorf("NewFeedCriteria() error = %v", err)
+	}
+	if _, err := NewFeedCriteria("", 20, nil); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewFeedCriteria() with empty user error = %v, want ErrValidation", err)
+	}
+	if _, err := NewFeedCriteria("user-1", 0, nil); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewFeedCriteria() with zero limit error = %v, want ErrValidation", err)
+	}
+}
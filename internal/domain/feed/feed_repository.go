@@ -0,0 +1,14 @@
+package feed
+
+import (
+	"context"
+)
+
+// IFeedRepository はフィードの出来事のリポジトリのインターフェース
+type IFeedRepository interface {
+	SaveEvent(ctx context.Context, event *Event) error
+	// 行為者が対象に記録した種類の出来事を削除する。いいねやコメントを取り消したときに使う
+	DeleteEvents(ctx context.Context, actorID string, eventType EventType, subjectID string) error
+	// ユーザーがフォローしているユーザーの出来事を新しい順に取得する
+	GetFeed(ctx context.Context, criteria *FeedCriteria) (*FeedPage, error)
+}
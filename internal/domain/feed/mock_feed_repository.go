@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/feed/feed_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/feed/feed_repository.go -destination=internal/domain/feed/mock_feed_repository.go -package feed
+//
+
+// Package feed is a generated GoMock package.
+package feed
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIFeedRepository is a mock of IFeedRepository interface.
+type MockIFeedRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFeedRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIFeedRepositoryMockRecorder is the mock recorder for MockIFeedRepository.
+type MockIFeedRepositoryMockRecorder struct {
+	mock *MockIFeedRepository
+}
+
+// NewMockIFeedRepository creates a new mock instance.
+func NewMockIFeedRepository(ctrl *gomock.Controller) *MockIFeedRepository {
+	mock := &MockIFeedRepository{ctrl: ctrl}
+	mock.recorder = &MockIFeedRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFeedRepository) EXPECT() *MockIFeedRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteEvents mocks base method.
+func (m *MockIFeedRepository) DeleteEvents(ctx context.Context, actorID string, eventType EventType, subjectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvents", ctx, actorID, eventType, subjectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEvents indicates an expected call of DeleteEvents.
+func (mr *MockIFeedRepositoryMockRecorder) DeleteEvents(ctx, actorID, eventType, subjectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvents", reflect.TypeOf((*MockIFeedRepository)(nil).DeleteEvents), ctx, actorID, eventType, subjectID)
+}
+
+// GetFeed mocks base method.
+func (m *MockIFeedRepository) GetFeed(ctx context.Context, criteria *FeedCriteria) (*FeedPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, criteria)
+	ret0, _ := ret[0].(*FeedPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockIFeedRepositoryMockRecorder) GetFeed(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockIFeedRepository)(nil).GetFeed), ctx, criteria)
+}
+
+// SaveEvent mocks base method.
+func (m *MockIFeedRepository) SaveEvent(ctx context.Context, event *Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEvent indicates an expected call of SaveEvent.
+func (mr *MockIFeedRepositoryMockRecorder) SaveEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEvent", reflect.TypeOf((*MockIFeedRepository)(nil).SaveEvent), ctx, event)
+}
@@ -0,0 +1,234 @@
+package notification
+
+import (
+	"slices"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/google/uuid"
+)
+
+// Type は通知の種類
+type Type string
+
+const (
+	TypeRouteLiked     Type = "route_liked"     // 自分のルートにいいねされた
+	TypeRouteCommented Type = "route_commented" // 自分のルートにコメントされた
+	TypeRouteForked    Type = "route_forked"    // 自分のルートをフォークされた
+	TypeRouteSaved     Type = "route_saved"     // 自分のルートを保存された
+	TypeUserFollowed   Type = "user_followed"   // フォローされた
+	TypeEventCancelled Type = "event_cancelled" // 参加すると返事したイベントが中止された
+)
+
+// types は作成できる通知の種類。設定の一覧もこの順に返す
+var types = []Type{
+	TypeRouteLiked,
+	TypeRouteCommented,
+	TypeRouteForked,
+	TypeRouteSaved,
+	TypeUserFollowed,
+	TypeEventCancelled,
+}
+
+// Types は通知の種類の一覧を返す
+func Types() []Type {
+	return slices.Clone(types)
+}
+
+func (t Type) IsValid() bool {
+	return slices.Contains(types, t)
+}
+
+func ParseType(s string) (Type, error) {
+	t := Type(s)
+	if !t.IsValid() {
+		return "", domainerror.New("invalid notification type", domainerror.ErrValidation)
+	}
+	return t, nil
+}
+
+// Activity は通知のもとになるドメインイベント。誰が（actor）誰に（recipient）何をしたかを持つ
+// ユースケースはいいねやフォローなどを保存したあとにPublisherへ発行する
+type Activity struct {
+	notificationType Type
+	actorID          string
+	recipientID      string
+	routeID          string // フォロー・イベントの場合は空文字
+	eventID          string // イベントの中止の場合のみ
+}
+
+// NewRouteActivity はルートへの反応のActivityを作成する。知らせる相手はルートの所有者
+func NewRouteActivity(t Type, actorID string, routeOwnerID string, routeID string) Activity {
+	return Activity{notificationType: t, actorID: actorID, recipientID: routeOwnerID, routeID: routeID}
+}
+
+// NewFollowActivity はフォローのActivityを作成する。知らせる相手はフォローされたユーザー
+func NewFollowActivity(followerID string, followeeID string) Activity {
+	return Activity{notificationType: TypeUserFollowed, actorID: followerID, recipientID: followeeID}
+}
+
+// NewEventCancelledActivity はイベントの中止のActivityを作成する。知らせる相手は参加する・未定と返事したユーザー
+func NewEventCancelledActivity(organizerID string, attendeeID string, eventID string) Activity {
+	return Activity{notificationType: TypeEventCancelled, actorID: organizerID, recipientID: attendeeID, eventID: eventID}
+}
+
+func (a Activity) Type() Type          { return a.notificationType }
+func (a Activity) ActorID() string     { return a.actorID }
+func (a Activity) RecipientID() string { return a.recipientID }
+func (a Activity) RouteID() string     { return a.routeID }
+func (a Activity) EventID() string     { return a.eventID }
+
+// IsSelf は自分のルートへの反応のように、知らせる相手が行為者自身かを返す。自分には通知しない
+func (a Activity) IsSelf() bool {
+	return a.actorID == a.recipientID
+}
+
+// Notification はユーザーへのアプリ内の通知
+type Notification struct {
+	id               string
+	userID           string // 受け取るユーザー
+	actorID          string
+	actorName        string
+	notificationType Type
+	routeID          string // フォロー・イベントの場合は空文字
+	routeName        string
+	eventID          string // イベントの中止の場合のみ
+	eventTitle       string
+	readAt           *string
+	createdAt        string
+}
+
+// NewNotification はActivityから通知を作成する
+func NewNotification(a Activity) (*Notification, error) {
+	if !a.notificationType.IsValid() {
+		return nil, domainerror.New("invalid notification type", domainerror.ErrValidation)
+	}
+	if a.actorID == "" {
+		return nil, domainerror.New("actorID is required", domainerror.ErrValidation)
+	}
+	if a.recipientID == "" {
+		return nil, domainerror.New("recipientID is required", domainerror.ErrValidation)
+	}
+	switch a.notificationType {
+	case TypeUserFollowed:
+	case TypeEventCancelled:
+		if a.eventID == "" {
+			return nil, domainerror.New("eventID is required", domainerror.ErrValidation)
+		}
+	default:
+		if a.routeID == "" {
+			return nil, domainerror.New("routeID is required", domainerror.ErrValidation)
+		}
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	return &Notification{
+		id:               id.String(),
+		userID:           a.recipientID,
+		actorID:          a.actorID,
+		notificationType: a.notificationType,
+		routeID:          a.routeID,
+		eventID:          a.eventID,
+	}, nil
+}
+
+// ReconstructNotification はリポジトリ層からの復元用
+func ReconstructNotification(id string, userID string, actorID string, actorName string, notificationType Type, routeID string, routeName string, eventID string, eventTitle string, readAt *string, createdAt string) *Notification {
+	return &Notification{
+		id:               id,
+		userID:           userID,
+		actorID:          actorID,
+		actorName:        actorName,
+		notificationType: notificationType,
+		routeID:          routeID,
+		routeName:        routeName,
+		eventID:          eventID,
+		eventTitle:       eventTitle,
+		readAt:           readAt,
+		createdAt:        createdAt,
+	}
+}
+
+func (n *Notification) ID() string         { return n.id }
+func (n *Notification) UserID() string     { return n.userID }
+func (n *Notification) ActorID() string    { return n.actorID }
+func (n *Notification) ActorName() string  { return n.actorName }
+func (n *Notification) Type() Type         { return n.notificationType }
+func (n *Notification) RouteID() string    { return n.routeID }
+func (n *Notification) RouteName() string  { return n.routeName }
+func (n *Notification) EventID() string    { return n.eventID }
+func (n *Notification) EventTitle() string { return n.eventTitle }
+func (n *Notification) ReadAt() *string    { return n.readAt }
+func (n *Notification) CreatedAt() string  { return n.createdAt }
+func (n *Notification) IsRead() bool       { return n.readAt != nil }
+
+// Preferences はユーザーが受け取る通知の種類の設定
+// 種類を追加しても既定で受け取るよう、受け取らない種類だけを持つ
+type Preferences struct {
+	muted []Type
+}
+
+func NewPreferences(muted []Type) (*Preferences, error) {
+	p := &Preferences{muted: []Type{}}
+	for _, t := range muted {
+		if !t.IsValid() {
+			return nil, domainerror.New("invalid notification type", domainerror.ErrValidation)
+		}
+		p.Set(t, false)
+	}
+	return p, nil
+}
+
+// Muted は受け取らない種類を種類の一覧の順に返す
+func (p *Preferences) Muted() []Type {
+	return slices.Clone(p.muted)
+}
+
+// Allows は種類の通知を受け取るかを返す
+func (p *Preferences) Allows(t Type) bool {
+	return !slices.Contains(p.muted, t)
+}
+
+// Set は種類の通知を受け取るかを変更する
+func (p *Preferences) Set(t Type, enabled bool) {
+	p.muted = slices.DeleteFunc(p.muted, func(m Type) bool { return m == t })
+	if enabled {
+		return
+	}
+	p.muted = append(p.muted, t)
+	slices.SortFunc(p.muted, func(a, b Type) int {
+		return slices.Index(types, a) - slices.Index(types, b)
+	})
+}
+
+// NotificationCriteria はユーザーの通知一覧の取得条件
+// 新しい順にキーセットページネーションで取得する
+type NotificationCriteria struct {
+	userID     string
+	unreadOnly bool
+	limit      int32
+	after      *pagination.Cursor // nilの場合は先頭から取得する
+}
+
+func NewNotificationCriteria(userID string, unreadOnly bool, limit int32, after *pagination.Cursor) (*NotificationCriteria, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+	return &NotificationCriteria{userID: userID, unreadOnly: unreadOnly, limit: limit, after: after}, nil
+}
+
+func (c *NotificationCriteria) UserID() string            { return c.userID }
+func (c *NotificationCriteria) UnreadOnly() bool          { return c.unreadOnly }
+func (c *NotificationCriteria) Limit() int32              { return c.limit }
+func (c *NotificationCriteria) After() *pagination.Cursor { return c.after }
+
+// NotificationPage はキーセットページネーションで取得した通知の1ページ
+type NotificationPage struct {
+	Items []*Notification
+	Next  *pagination.Cursor // 次のページがない場合はnil
+}
@@ -0,0 +1,19 @@
+package notification
+
+import (
+	"context"
+)
+
+// INotificationRepository は通知のリポジトリのインターフェース
+type INotificationRepository interface {
+	SaveNotification(ctx context.Context, notification *Notification) error
+	// ユーザーの通知を新しい順に取得する
+	GetNotifications(ctx context.Context, criteria *NotificationCriteria) (*NotificationPage, error)
+	CountUnread(ctx context.Context, userID string) (int64, error)
+	// ユーザーの通知を既読にする。既読の通知はそのまま。ユーザーの通知でない場合はErrNotFoundを返す
+	MarkRead(ctx context.Context, userID string, notificationID string) error
+	MarkAllRead(ctx context.Context, userID string) error
+	// 受け取る通知の設定はユーザーに保存する。ユーザーが存在しない場合はErrNotFoundを返す
+	GetPreferences(ctx context.Context, userID string) (*Preferences, error)
+	SavePreferences(ctx context.Context, userID string, preferences *Preferences) error
+}
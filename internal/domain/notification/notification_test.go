@@ -0,0 +1,90 @@
+package notification
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+func TestNewNotification(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity Activity
+		wantErr  bool
+	}{
+		{name: "正常系: いいね", activity: NewRouteActivity(TypeRouteLiked, "user-1", "user-2", "route-1")},
+		{name: "正常系: フォロー", activity: NewFollowActivity("user-1", "user-2")},
+		{name: "正常系: イベントの中止", activity: NewEventCancelledActivity("user-1", "user-2", "event-1")},
+		{name: "異常系: 未知の種類", activity: NewRouteActivity(Type("route_shared"), "user-1", "user-2", "route-1"), wantErr: true},
+		{name: "異常系: 行為者が空", activity: NewRouteActivity(TypeRouteSaved, "", "user-2", "route-1"), wantErr: true},
+		{name: "異常系: 相手が空", activity: NewFollowActivity("user-1", ""), wantErr: true},
+		{name: "異常系: ルートへの反応でルートが空", activity: NewRouteActivity(TypeRouteForked, "user-1", "user-2", ""), wantErr: true},
+		{name: "異常系: イベントの中止でイベントが空", activity: NewEventCancelledActivity("user-1", "user-2", ""), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := NewNotification(tt.activity)
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("NewNotification() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewNotification() error = %v", err)
+			}
+			if n.ID() == "" || n.Type() != tt.activity.Type() || n.UserID() != tt.activity.RecipientID() || n.ActorID() != tt.activity.ActorID() || n.RouteID() != tt.activity.RouteID() || n.EventID() != tt.activity.EventID() {
+				t.Errorf("NewNotification() = %+v", n)
+			}
+			if n.IsRead() {
+				t.Error("NewNotification() is read, want unread")
+			}
+		})
+	}
+}
+
+func TestActivity_IsSelf(t *testing.T) {
+	if !NewRouteActivity(TypeRouteLiked, "user-1", "user-1", "route-1").IsSelf() {
+		t.Error("IsSelf() = false for a reaction to own route")
+	}
+	if NewFollowActivity("user-1", "user-2").IsSelf() {
+		t.Error("IsSelf() = true for a follow of another user")
+	}
+}
+
+func TestPreferences(t *testing.T) {
+	p, err := NewPreferences([]Type{TypeUserFollowed, TypeRouteLiked, TypeRouteLiked})
+	if err != nil {
+		t.Fatalf("NewPreferences() error = %v", err)
+	}
+	if got := p.Muted(); !slices.Equal(got, []Type{TypeRouteLiked, TypeUserFollowed}) {
+		t.Errorf("Muted() = %v, want types order without duplicates", got)
+	}
+	if p.Allows(TypeRouteLiked) || !p.Allows(TypeRouteCommented) {
+		t.Errorf("Allows() does not match muted types %v", p.Muted())
+	}
+
+	p.Set(TypeRouteLiked, true)
+	p.Set(TypeRouteSaved, false)
+	if got := p.Muted(); !slices.Equal(got, []Type{TypeRouteSaved, TypeUserFollowed}) {
+		t.Errorf("Muted() after Set = %v", got)
+	}
+
+	if _, err := NewPreferences([]Type{Type("route_shared")}); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewPreferences() with unknown type error = %v, want ErrValidation", err)
+	}
+}
+
+func TestNewNotificationCriteria(t *testing.T) {
+	if _, err := NewNotificationCriteria("user-1", false, 20, nil); err != nil {
+		t.Errorf("NewNotificationCriteria() error = %v", err)
+	}
+	if _, err := NewNotificationCriteria("", false, 20, nil); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewNotificationCriteria() with empty user error = %v, want ErrValidation", err)
+	}
+	if _, err := NewNotificationCriteria("user-1", true, 0, nil); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewNotificationCriteria() with zero limit error = %v, want ErrValidation", err)
+	}
+}
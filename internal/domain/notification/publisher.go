@@ -0,0 +1,11 @@
+package notification
+
+import (
+	"context"
+)
+
+// Publisher はユースケースが発行したActivityを受け取り、受け取る相手に通知する
+type Publisher interface {
+	// Publish は相手の設定で受け取らない種類や、自分自身への反応の場合は何もしない
+	Publish(ctx context.Context, activity Activity) error
+}
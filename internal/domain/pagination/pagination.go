@@ -0,0 +1,49 @@
+package pagination
+
+import (
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/google/uuid"
+)
+
+// 1ページあたりの取得件数
+const (
+	DefaultLimit int32 = 20
+	MaxLimit     int32 = 100
+)
+
+// Cursor はキーセットページネーションの位置を表す
+// 直前のページの最後の要素の (ソートキー, ID) を保持し、次のページはこれより後ろの要素から取得する
+// IDはUUIDv7のため、ソートキーが同じ要素同士でも順序が一意に決まる
+type Cursor struct {
+	sortKey float64
+	id      string
+}
+
+func NewCursor(sortKey float64, id string) (*Cursor, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, domainerror.New("cursor id must be a valid UUID", domainerror.ErrValidation)
+	}
+	return &Cursor{sortKey: sortKey, id: id}, nil
+}
+
+func (c Cursor) SortKey() float64 {
+	return c.sortKey
+}
+
+func (c Cursor) ID() string {
+	return c.id
+}
+
+// NormalizeLimit は取得件数を既定値・上限の範囲に収める
+func NormalizeLimit(limit int32) (int32, error) {
+	if limit < 0 {
+		return 0, domainerror.New("limit must be non-negative", domainerror.ErrValidation)
+	}
+	if limit == 0 {
+		return DefaultLimit, nil
+	}
+	if limit > MaxLimit {
+		return 0, domainerror.New("limit must be less than or equal to 100", domainerror.ErrValidation)
+	}
+	return limit, nil
+}
@@ -0,0 +1,42 @@
+package pagination
+
+import "testing"
+
+func TestNormalizeLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   int32
+		want    int32
+		wantErr bool
+	}{
+		{name: "正常系: 未指定の場合は既定値", limit: 0, want: DefaultLimit},
+		{name: "正常系: 指定値をそのまま使う", limit: 50, want: 50},
+		{name: "正常系: 上限ちょうど", limit: MaxLimit, want: MaxLimit},
+		{name: "異常系: 負の値", limit: -1, wantErr: true},
+		{name: "異常系: 上限を超える", limit: MaxLimit + 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeLimit(tt.limit)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeLimit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeLimit() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCursor(t *testing.T) {
+	if _, err := NewCursor(1.5, "not-a-uuid"); err == nil {
+		t.Errorf("NewCursor() with invalid id should return error")
+	}
+	c, err := NewCursor(1.5, "019b5a50-0000-7000-8000-000000000001")
+	if err != nil {
+		t.Fatalf("NewCursor() unexpected error: %v", err)
+	}
+	if c.SortKey() != 1.5 || c.ID() != "019b5a50-0000-7000-8000-000000000001" {
+		t.Errorf("NewCursor() = (%v, %s), want (1.5, 019b5a50-0000-7000-8000-000000000001)", c.SortKey(), c.ID())
+	}
+}
@@ -0,0 +1,13 @@
+package place
+
+import (
+	"context"
+
+	"github.com/paulmach/orb"
+)
+
+// IAdminBoundaryRepository は行政区域の範囲のリポジトリのインターフェース
+type IAdminBoundaryRepository interface {
+	ListAdminAreasContaining(ctx context.Context, location orb.Point) ([]AdminArea, error)
+	ReplaceAdminBoundaries(ctx context.Context, boundaries []*AdminBoundary) error
+}
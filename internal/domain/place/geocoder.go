@@ -0,0 +1,13 @@
+package place
+
+import (
+	"context"
+
+	"github.com/paulmach/orb"
+)
+
+// Geocoder は座標から地名を求める（逆ジオコーディング）
+// 地名が分からない地点ではErrNotFoundを返す
+type Geocoder interface {
+	ReverseGeocode(ctx context.Context, location orb.Point) (*Place, error)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/place/admin_boundary_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/place/admin_boundary_repository.go -destination=internal/domain/place/mock_admin_boundary_repository.go -package place
+//
+
+// Package place is a generated GoMock package.
+package place
+
+import (
+	context "context"
+	reflect "reflect"
+
+	orb "github.com/paulmach/orb"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAdminBoundaryRepository is a mock of IAdminBoundaryRepository interface.
+type MockIAdminBoundaryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAdminBoundaryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIAdminBoundaryRepositoryMockRecorder is the mock recorder for MockIAdminBoundaryRepository.
+type MockIAdminBoundaryRepositoryMockRecorder struct {
+	mock *MockIAdminBoundaryRepository
+}
+
+// NewMockIAdminBoundaryRepository creates a new mock instance.
+func NewMockIAdminBoundaryRepository(ctrl *gomock.Controller) *MockIAdminBoundaryRepository {
+	mock := &MockIAdminBoundaryRepository{ctrl: ctrl}
+	mock.recorder = &MockIAdminBoundaryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAdminBoundaryRepository) EXPECT() *MockIAdminBoundaryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListAdminAreasContaining mocks base method.
+func (m *MockIAdminBoundaryRepository) ListAdminAreasContaining(ctx context.Context, location orb.Point) ([]AdminArea, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminAreasContaining", ctx, location)
+	ret0, _ := ret[0].([]AdminArea)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdminAreasContaining indicates an expected call of ListAdminAreasContaining.
+func (mr *MockIAdminBoundaryRepositoryMockRecorder) ListAdminAreasContaining(ctx, location any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminAreasContaining", reflect.TypeOf((*MockIAdminBoundaryRepository)(nil).ListAdminAreasContaining), ctx, location)
+}
+
+// ReplaceAdminBoundaries mocks base method.
+func (m *MockIAdminBoundaryRepository) ReplaceAdminBoundaries(ctx context.Context, boundaries []*AdminBoundary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAdminBoundaries", ctx, boundaries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceAdminBoundaries indicates an expected call of ReplaceAdminBoundaries.
+func (mr *MockIAdminBoundaryRepositoryMockRecorder) ReplaceAdminBoundaries(ctx, boundaries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAdminBoundaries", reflect.TypeOf((*MockIAdminBoundaryRepository)(nil).ReplaceAdminBoundaries), ctx, boundaries)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/place/geocoder.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/place/geocoder.go -destination=internal/domain/place/mock_geocoder.go -package place
+//
+
+// Package place is a generated GoMock package.
+package place
+
+import (
+	context "context"
+	reflect "reflect"
+
+	orb "github.com/paulmach/orb"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGeocoder is a mock of Geocoder interface.
+type MockGeocoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockGeocoderMockRecorder
+	isgomock struct{}
+}
+
+// MockGeocoderMockRecorder is the mock recorder for MockGeocoder.
+type MockGeocoderMockRecorder struct {
+	mock *MockGeocoder
+}
+
+// NewMockGeocoder creates a new mock instance.
+func NewMockGeocoder(ctrl *gomock.Controller) *MockGeocoder {
+	mock := &MockGeocoder{ctrl: ctrl}
+	mock.recorder = &MockGeocoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGeocoder) EXPECT() *MockGeocoderMockRecorder {
+	return m.recorder
+}
+
+// ReverseGeocode mocks base method.
+func (m *MockGeocoder) ReverseGeocode(ctx context.Context, location orb.Point) (*Place, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseGeocode", ctx, location)
+	ret0, _ := ret[0].(*Place)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReverseGeocode indicates an expected call of ReverseGeocode.
+func (mr *MockGeocoderMockRecorder) ReverseGeocode(ctx, location any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseGeocode", reflect.TypeOf((*MockGeocoder)(nil).ReverseGeocode), ctx, location)
+}
@@ -0,0 +1,106 @@
+package place
+
+import (
+	"sort"
+	"strings"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+// 行政区域の階層（OpenStreetMapのadmin_level）
+const (
+	AdminLevelAdministrativeArea = 4 // 都道府県
+	AdminLevelLocality           = 7 // 市区町村（政令市の区は8）
+)
+
+// Place は地点が属する行政区域の名前
+type Place struct {
+	locality           string // 市区町村。分からない場合は空
+	administrativeArea string // 都道府県。分からない場合は空
+	countryCode        string // ISO 3166-1 alpha-2
+}
+
+// ReconstructPlace はリポジトリ層からの復元用
+func ReconstructPlace(locality, administrativeArea, countryCode string) *Place {
+	return &Place{
+		locality:           locality,
+		administrativeArea: administrativeArea,
+		countryCode:        countryCode,
+	}
+}
+
+func (p *Place) Locality() string           { return p.locality }
+func (p *Place) AdministrativeArea() string { return p.administrativeArea }
+func (p *Place) CountryCode() string        { return p.countryCode }
+
+// AdminArea は行政区域の名前と階層
+type AdminArea struct {
+	Name        string
+	AdminLevel  int
+	CountryCode string
+}
+
+// AdminBoundary は行政区域とその範囲
+type AdminBoundary struct {
+	AdminArea
+	Geom orb.MultiPolygon
+}
+
+func NewAdminBoundary(name string, adminLevel int, countryCode string, geom orb.MultiPolygon) (*AdminBoundary, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domainerror.New("name is required", domainerror.ErrValidation)
+	}
+	if adminLevel < 1 || adminLevel > 11 {
+		return nil, domainerror.New("admin level must be between 1 and 11", domainerror.ErrValidation)
+	}
+	if len(countryCode) != 2 {
+		return nil, domainerror.New("country code must be ISO 3166-1 alpha-2", domainerror.ErrValidation)
+	}
+	if len(geom) == 0 {
+		return nil, domainerror.New("geom must not be empty", domainerror.ErrValidation)
+	}
+	return &AdminBoundary{
+		AdminArea: AdminArea{
+			Name:        name,
+			AdminLevel:  adminLevel,
+			CountryCode: strings.ToUpper(countryCode),
+		},
+		Geom: geom,
+	}, nil
+}
+
+// PlaceFromAreas は地点を含む行政区域から地名を決める
+// 市区町村は admin_level 7 以下で最も細かい区域とし、政令市の区ではなく市を使う
+// 7 以下の区域がない場合は、都道府県より細かい区域のうち最も粗いものを使う
+func PlaceFromAreas(areas []AdminArea) (*Place, bool) {
+	if len(areas) == 0 {
+		return nil, false
+	}
+	sorted := append([]AdminArea{}, areas...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].AdminLevel < sorted[j].AdminLevel })
+
+	p := &Place{countryCode: sorted[0].CountryCode}
+	var below *AdminArea
+	for i := range sorted {
+		a := &sorted[i]
+		switch {
+		case a.AdminLevel == AdminLevelAdministrativeArea:
+			if p.administrativeArea == "" {
+				p.administrativeArea = a.Name
+			}
+		case a.AdminLevel > AdminLevelAdministrativeArea && a.AdminLevel <= AdminLevelLocality:
+			p.locality = a.Name
+		case a.AdminLevel > AdminLevelLocality && below == nil:
+			below = a
+		}
+	}
+	if p.locality == "" && below != nil {
+		p.locality = below.Name
+	}
+	if p.locality == "" && p.administrativeArea == "" {
+		return nil, false
+	}
+	return p, true
+}
@@ -0,0 +1,66 @@
+package place
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+func TestPlaceFromAreas(t *testing.T) {
+	kyotoPref := AdminArea{Name: "京都府", AdminLevel: 4, CountryCode: "JP"}
+	kyotoCity := AdminArea{Name: "京都市", AdminLevel: 7, CountryCode: "JP"}
+	nakagyo := AdminArea{Name: "中京区", AdminLevel: 8, CountryCode: "JP"}
+
+	tests := []struct {
+		name         string
+		areas        []AdminArea
+		wantLocality string
+		wantArea     string
+		wantOK       bool
+	}{
+		{name: "政令市の区より市を使う", areas: []AdminArea{nakagyo, kyotoCity, kyotoPref}, wantLocality: "京都市", wantArea: "京都府", wantOK: true},
+		{name: "郡より町村を使う", areas: []AdminArea{kyotoPref, {Name: "乙訓郡", AdminLevel: 6, CountryCode: "JP"}, {Name: "大山崎町", AdminLevel: 7, CountryCode: "JP"}}, wantLocality: "大山崎町", wantArea: "京都府", wantOK: true},
+		{name: "市区町村がない場合は細かい区域を使う", areas: []AdminArea{kyotoPref, nakagyo}, wantLocality: "中京区", wantArea: "京都府", wantOK: true},
+		{name: "都道府県だけ", areas: []AdminArea{kyotoPref}, wantArea: "京都府", wantOK: true},
+		{name: "国だけでは地名にしない", areas: []AdminArea{{Name: "日本", AdminLevel: 2, CountryCode: "JP"}}},
+		{name: "行政区域の外", areas: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := PlaceFromAreas(tt.areas)
+			if ok != tt.wantOK {
+				t.Fatalf("PlaceFromAreas() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if got.Locality() != tt.wantLocality || got.AdministrativeArea() != tt.wantArea || got.CountryCode() != "JP" {
+				t.Errorf("PlaceFromAreas() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestNewAdminBoundary(t *testing.T) {
+	square := orb.MultiPolygon{{{{135.7, 35.0}, {135.8, 35.0}, {135.8, 35.1}, {135.7, 35.1}, {135.7, 35.0}}}}
+
+	b, err := NewAdminBoundary(" 京都市 ", 7, "jp", square)
+	if err != nil {
+		t.Fatalf("NewAdminBoundary() error = %v", err)
+	}
+	if b.Name != "京都市" || b.CountryCode != "JP" {
+		t.Errorf("NewAdminBoundary() = %+v", b.AdminArea)
+	}
+
+	if _, err := NewAdminBoundary("京都市", 12, "JP", square); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewAdminBoundary(level 12) error = %v, want ErrValidation", err)
+	}
+	if _, err := NewAdminBoundary("京都市", 7, "JPN", square); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewAdminBoundary(JPN) error = %v, want ErrValidation", err)
+	}
+	if _, err := NewAdminBoundary("京都市", 7, "JP", nil); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewAdminBoundary(empty) error = %v, want ErrValidation", err)
+	}
+}
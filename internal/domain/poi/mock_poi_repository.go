@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/poi/poi_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/poi/poi_repository.go -destination=internal/domain/poi/mock_poi_repository.go -package poi
+//
+
+// Package poi is a generated GoMock package.
+package poi
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIPOIRepository is a mock of IPOIRepository interface.
+type MockIPOIRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPOIRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIPOIRepositoryMockRecorder is the mock recorder for MockIPOIRepository.
+type MockIPOIRepositoryMockRecorder struct {
+	mock *MockIPOIRepository
+}
+
+// NewMockIPOIRepository creates a new mock instance.
+func NewMockIPOIRepository(ctrl *gomock.Controller) *MockIPOIRepository {
+	mock := &MockIPOIRepository{ctrl: ctrl}
+	mock.recorder = &MockIPOIRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPOIRepository) EXPECT() *MockIPOIRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetPOIByID mocks base method.
+func (m *MockIPOIRepository) GetPOIByID(ctx context.Context, id int64) (*POI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPOIByID", ctx, id)
+	ret0, _ := ret[0].(*POI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPOIByID indicates an expected call of GetPOIByID.
+func (mr *MockIPOIRepositoryMockRecorder) GetPOIByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPOIByID", reflect.TypeOf((*MockIPOIRepository)(nil).GetPOIByID), ctx, id)
+}
+
+// ListPOIsAlongRoute mocks base method.
+func (m *MockIPOIRepository) ListPOIsAlongRoute(ctx context.Context, criteria *AlongRouteCriteria) (*RoutePOIPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPOIsAlongRoute", ctx, criteria)
+	ret0, _ := ret[0].(*RoutePOIPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPOIsAlongRoute indicates an expected call of ListPOIsAlongRoute.
+func (mr *MockIPOIRepositoryMockRecorder) ListPOIsAlongRoute(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPOIsAlongRoute", reflect.TypeOf((*MockIPOIRepository)(nil).ListPOIsAlongRoute), ctx, criteria)
+}
+
+// ReplacePOIs mocks base method.
+func (m *MockIPOIRepository) ReplacePOIs(ctx context.Context, pois []*POI) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePOIs", ctx, pois)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplacePOIs indicates an expected call of ReplacePOIs.
+func (mr *MockIPOIRepositoryMockRecorder) ReplacePOIs(ctx, pois any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePOIs", reflect.TypeOf((*MockIPOIRepository)(nil).ReplacePOIs), ctx, pois)
+}
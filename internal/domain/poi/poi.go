@@ -0,0 +1,200 @@
+package poi
+
+import (
+	"strings"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+// Category はPOIの種類
+type Category string
+
+const (
+	CategoryCafe          Category = "cafe"
+	CategoryConvenience   Category = "convenience"
+	CategoryDrinkingWater Category = "drinking_water"
+	CategoryBicycleShop   Category = "bicycle_shop"
+	CategoryToilets       Category = "toilets"
+	CategoryViewpoint     Category = "viewpoint"
+)
+
+// Categories は取り込むPOIの種類を返す
+func Categories() []Category {
+	return []Category{
+		CategoryCafe,
+		CategoryConvenience,
+		CategoryDrinkingWater,
+		CategoryBicycleShop,
+		CategoryToilets,
+		CategoryViewpoint,
+	}
+}
+
+// ParseCategory は文字列からPOIの種類を取得する
+func ParseCategory(s string) (Category, error) {
+	for _, c := range Categories() {
+		if string(c) == s {
+			return c, nil
+		}
+	}
+	return "", domainerror.New("invalid poi category: "+s, domainerror.ErrValidation)
+}
+
+// CategoryFromTags はOSMのタグからPOIの種類を判定する
+// 関係者以外が使えない施設は含めない
+func CategoryFromTags(tags map[string]string) (Category, bool) {
+	switch tags["access"] {
+	case "no", "private":
+		return "", false
+	}
+	switch tags["amenity"] {
+	case "cafe":
+		return CategoryCafe, true
+	case "drinking_water", "water_point":
+		return CategoryDrinkingWater, true
+	case "toilets":
+		return CategoryToilets, true
+	}
+	switch tags["shop"] {
+	case "convenience":
+		return CategoryConvenience, true
+	case "bicycle":
+		return CategoryBicycleShop, true
+	}
+	if tags["tourism"] == "viewpoint" {
+		return CategoryViewpoint, true
+	}
+	return "", false
+}
+
+// OSMの要素の種類
+const (
+	OSMTypeNode = "node"
+	OSMTypeWay  = "way" // 建物などの範囲で描かれたPOI。中心を位置とする
+)
+
+// POI は経路沿いで立ち寄れる施設や地点（OSMの抽出データから取り込む）
+type POI struct {
+	id       int64 // 保存後のID。取り込み前は0
+	osmType  string
+	osmID    int64
+	category Category
+	name     string
+	location orb.Point
+}
+
+func NewPOI(osmType string, osmID int64, category Category, name string, location orb.Point) (*POI, error) {
+	if osmType != OSMTypeNode && osmType != OSMTypeWay {
+		return nil, domainerror.New("invalid osm type: "+osmType, domainerror.ErrValidation)
+	}
+	if _, err := ParseCategory(string(category)); err != nil {
+		return nil, err
+	}
+	if location.Lon() < -180 || location.Lon() > 180 || location.Lat() < -90 || location.Lat() > 90 {
+		return nil, domainerror.New("location is out of range", domainerror.ErrValidation)
+	}
+	return &POI{
+		osmType:  osmType,
+		osmID:    osmID,
+		category: category,
+		name:     strings.TrimSpace(name),
+		location: location,
+	}, nil
+}
+
+// ReconstructPOI はリポジトリ層からの復元用
+func ReconstructPOI(id int64, osmType string, osmID int64, category Category, name string, location orb.Point) *POI {
+	return &POI{
+		id:       id,
+		osmType:  osmType,
+		osmID:    osmID,
+		category: category,
+		name:     name,
+		location: location,
+	}
+}
+
+func (p *POI) ID() int64           { return p.id }
+func (p *POI) OSMType() string     { return p.osmType }
+func (p *POI) OSMID() int64        { return p.osmID }
+func (p *POI) Category() Category  { return p.category }
+func (p *POI) Name() string        { return p.name }
+func (p *POI) Location() orb.Point { return p.location }
+
+// RoutePOI はルート沿いのPOIと経路上の位置
+type RoutePOI struct {
+	POI      *POI
+	CumDistM float64 // 経路に射影した位置の始点からの距離(m)
+	OffsetM  float64 // 経路からの距離(m)
+}
+
+// AlongRouteCursor はルート沿いのPOIのキーセットページネーションの位置
+// 直前のページの最後のPOIの (経路上の位置, ID) を保持する。POIのIDは連番のため pagination.Cursor は使わない
+type AlongRouteCursor struct {
+	cumDistM float64
+	id       int64
+}
+
+func NewAlongRouteCursor(cumDistM float64, id int64) *AlongRouteCursor {
+	return &AlongRouteCursor{cumDistM: cumDistM, id: id}
+}
+
+func (c AlongRouteCursor) CumDistM() float64 { return c.cumDistM }
+func (c AlongRouteCursor) ID() int64         { return c.id }
+
+// RoutePOIPage はルート沿いのPOIの1ページ分の結果
+type RoutePOIPage struct {
+	Items []*RoutePOI
+	Next  *AlongRouteCursor // 次のページがない場合はnil
+}
+
+// ルートからPOIを探す範囲(m)
+const (
+	DefaultBufferM = 200.0
+	MaxBufferM     = 2000.0
+)
+
+// AlongRouteCriteria はルート沿いのPOIの検索条件
+// 経路上の位置の順にキーセットページネーションで取得する
+type AlongRouteCriteria struct {
+	routeID    string
+	bufferM    float64
+	categories []Category
+	limit      int32
+	after      *AlongRouteCursor // nilの場合は始点から取得する
+}
+
+// NewAlongRouteCriteria は検索条件を作成する。bufferM が0の場合は既定値、categories が空の場合はすべての種類を探す
+func NewAlongRouteCriteria(routeID string, bufferM float64, categories []Category, limit int32, after *AlongRouteCursor) (*AlongRouteCriteria, error) {
+	if routeID == "" {
+		return nil, domainerror.New("routeID is required", domainerror.ErrValidation)
+	}
+	if bufferM == 0 {
+		bufferM = DefaultBufferM
+	}
+	if bufferM < 0 || bufferM > MaxBufferM {
+		return nil, domainerror.New("buffer must be between 0 and 2000 meters", domainerror.ErrValidation)
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+	return &AlongRouteCriteria{
+		routeID:    routeID,
+		bufferM:    bufferM,
+		categories: categories,
+		limit:      limit,
+		after:      after,
+	}, nil
+}
+
+func (c *AlongRouteCriteria) RouteID() string          { return c.routeID }
+func (c *AlongRouteCriteria) BufferM() float64         { return c.bufferM }
+func (c *AlongRouteCriteria) Limit() int32             { return c.limit }
+func (c *AlongRouteCriteria) After() *AlongRouteCursor { return c.after }
+
+func (c *AlongRouteCriteria) Categories() []Category {
+	categories := make([]Category, len(c.categories))
+	copy(categories, c.categories)
+	return categories
+}
@@ -0,0 +1,12 @@
+package poi
+
+import (
+	"context"
+)
+
+// IPOIRepository はPOIのリポジトリのインターフェース
+type IPOIRepository interface {
+	ListPOIsAlongRoute(ctx context.Context, criteria *AlongRouteCriteria) (*RoutePOIPage, error)
+	GetPOIByID(ctx context.Context, id int64) (*POI, error)
+	ReplacePOIs(ctx context.Context, pois []*POI) error
+}
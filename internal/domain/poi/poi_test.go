@@ -0,0 +1,85 @@
+package poi
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+func TestCategoryFromTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		tags   map[string]string
+		want   Category
+		wantOK bool
+	}{
+		{name: "カフェ", tags: map[string]string{"amenity": "cafe", "name": "喫茶"}, want: CategoryCafe, wantOK: true},
+		{name: "給水所", tags: map[string]string{"amenity": "water_point"}, want: CategoryDrinkingWater, wantOK: true},
+		{name: "コンビニ", tags: map[string]string{"shop": "convenience"}, want: CategoryConvenience, wantOK: true},
+		{name: "自転車店", tags: map[string]string{"shop": "bicycle", "building": "yes"}, want: CategoryBicycleShop, wantOK: true},
+		{name: "展望地", tags: map[string]string{"tourism": "viewpoint"}, want: CategoryViewpoint, wantOK: true},
+		{name: "関係者以外使えないトイレは含めない", tags: map[string]string{"amenity": "toilets", "access": "private"}},
+		{name: "対象外の施設", tags: map[string]string{"amenity": "restaurant"}},
+		{name: "タグなし", tags: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := CategoryFromTags(tt.tags)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("CategoryFromTags() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNewPOI(t *testing.T) {
+	p, err := NewPOI(OSMTypeNode, 201, CategoryCafe, " 喫茶 ", orb.Point{139.70, 35.68})
+	if err != nil {
+		t.Fatalf("NewPOI() error = %v", err)
+	}
+	if p.Name() != "喫茶" || p.OSMID() != 201 || p.ID() != 0 {
+		t.Errorf("NewPOI() = %+v", p)
+	}
+
+	if _, err := NewPOI("relation", 1, CategoryCafe, "", orb.Point{139.70, 35.68}); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewPOI(relation) error = %v, want ErrValidation", err)
+	}
+	if _, err := NewPOI(OSMTypeNode, 1, "restaurant", "", orb.Point{139.70, 35.68}); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewPOI(restaurant) error = %v, want ErrValidation", err)
+	}
+}
+
+func TestNewAlongRouteCriteria(t *testing.T) {
+	tests := []struct {
+		name    string
+		bufferM float64
+		limit   int32
+		want    float64
+		wantErr bool
+	}{
+		{name: "省略時は既定値", bufferM: 0, limit: 20, want: DefaultBufferM},
+		{name: "指定した範囲", bufferM: 500, limit: 20, want: 500},
+		{name: "異常系: 負の値", bufferM: -1, limit: 20, wantErr: true},
+		{name: "異常系: 上限を超える", bufferM: 5000, limit: 20, wantErr: true},
+		{name: "異常系: 取得件数が0", bufferM: 500, limit: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewAlongRouteCriteria("route-1", tt.bufferM, nil, tt.limit, nil)
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("NewAlongRouteCriteria() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewAlongRouteCriteria() error = %v", err)
+			}
+			if c.BufferM() != tt.want || len(c.Categories()) != 0 || c.Limit() != tt.limit || c.After() != nil {
+				t.Errorf("NewAlongRouteCriteria() = %+v", c)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package route
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/google/uuid"
+)
+
+// コメントの最大文字数
+const MaxCommentLength = 1000
+
+// Comment はルートへのコメント
+type Comment struct {
+	id        string
+	routeID   string
+	userID    string
+	userName  string
+	content   string
+	createdAt string
+}
+
+func NewComment(routeID string, userID string, content string) (*Comment, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, domainerror.New("content is required", domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(content) > MaxCommentLength {
+		return nil, domainerror.New(fmt.Sprintf("content must be at most %d characters", MaxCommentLength), domainerror.ErrValidation)
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	return &Comment{id: id.String(), routeID: routeID, userID: userID, content: content}, nil
+}
+
+// ReconstructComment はリポジトリ層からの復元用
+func ReconstructComment(id string, routeID string, userID string, userName string, content string, createdAt string) *Comment {
+	return &Comment{id: id, routeID: routeID, userID: userID, userName: userName, content: content, createdAt: createdAt}
+}
+
+func (c *Comment) ID() string        { return c.id }
+func (c *Comment) RouteID() string   { return c.routeID }
+func (c *Comment) UserID() string    { return c.userID }
+func (c *Comment) UserName() string  { return c.userName }
+func (c *Comment) Content() string   { return c.content }
+func (c *Comment) CreatedAt() string { return c.createdAt }
+
+// CanBeDeletedBy はコメントを削除できるか（書いた本人かルートの所有者か）を返す
+func (c *Comment) CanBeDeletedBy(userID string, r *Route) bool {
+	return c.userID == userID || r.UserID() == userID
+}
+
+// CommentCriteria はルートへのコメント一覧の取得条件
+// 作成日時の古い順にキーセットページネーションで取得する
+type CommentCriteria struct {
+	routeID string
+	limit   int32
+	after   *pagination.Cursor // nilの場合は先頭から取得する
+}
+
+func NewCommentCriteria(routeID string, limit int32, after *pagination.Cursor) (*CommentCriteria, error) {
+	if routeID == "" {
+		return nil, domainerror.New("routeID is required", domainerror.ErrValidation)
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+	return &CommentCriteria{routeID: routeID, limit: limit, after: after}, nil
+}
+
+func (c *CommentCriteria) RouteID() string           { return c.routeID }
+func (c *CommentCriteria) Limit() int32              { return c.limit }
+func (c *CommentCriteria) After() *pagination.Cursor { return c.after }
+
+// CommentPage はキーセットページネーションで取得したコメント一覧の1ページ
+type CommentPage struct {
+	Items []*Comment
+	Next  *pagination.Cursor // 次のページがない場合はnil
+}
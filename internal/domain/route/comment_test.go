@@ -0,0 +1,30 @@
+package route
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+func TestComment(t *testing.T) {
+	if _, err := NewComment("route-1", "rider", "  "); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewComment() error = %v, want ErrValidation", err)
+	}
+	if _, err := NewComment("route-1", "rider", strings.Repeat("a", MaxCommentLength+1)); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewComment() error = %v, want ErrValidation", err)
+	}
+
+	c, err := NewComment("route-1", "rider", " 2つ目の信号は右折レーンが混みます ")
+	if err != nil {
+		t.Fatalf("NewComment() error = %v", err)
+	}
+	if c.Content() != "2つ目の信号は右折レーンが混みます" {
+		t.Errorf("Content() = %q", c.Content())
+	}
+	r, _ := ReconstructRoute("route-1", "owner", "多摩川", "", nil, 0, 0, 0, 0, Geometry{}, Geometry{}, Geometry{}, Geometry{}, "", VisibilityPublic, 1, nil, "", "")
+	if !c.CanBeDeletedBy("rider", r) || !c.CanBeDeletedBy("owner", r) || c.CanBeDeletedBy("other", r) {
+		t.Error("CanBeDeletedBy() returned an unexpected result")
+	}
+}
@@ -0,0 +1,337 @@
+package route
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// キュー生成の既定値
+const (
+	DefaultMinTurnAngle   = 30.0 // これ以上向きが変わる地点を曲がり角とみなす(度)
+	DefaultBearingWindowM = 20.0 // 方位角を求めるときに前後を見る距離(m)。GPSの細かいぶれを無視する
+	DefaultMinCueSpacingM = 40.0 // キューどうしの最小間隔(m)。これより近い曲がり角は大きい方だけ残す
+
+	// 曲がる向きの分類に使う角度(度)
+	slightTurnMaxAngle = 45.0
+	turnMaxAngle       = 135.0
+	sharpTurnMaxAngle  = 165.0
+)
+
+// 操作タイプ・修飾子（OSRM/Mapbox Directions APIの値に合わせる）
+const (
+	maneuverDepart = "depart"
+	maneuverArrive = "arrive"
+	maneuverTurn   = "turn"
+
+	modifierUturn    = "uturn"
+	modifierStraight = "straight"
+)
+
+// CueLanguage はキューの案内文の言語
+type CueLanguage string
+
+const (
+	CueLanguageJa CueLanguage = "ja"
+	CueLanguageEn CueLanguage = "en"
+)
+
+// ParseCueLanguage はロケール（"ja"、"en-US"など）から案内文の言語を決める
+// 対応していない言語は日本語にする
+func ParseCueLanguage(locale string) CueLanguage {
+	lang, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	lang, _, _ = strings.Cut(lang, "_")
+	if CueLanguage(lang) == CueLanguageEn {
+		return CueLanguageEn
+	}
+	return CueLanguageJa
+}
+
+// CueGenerator はpath_geomの向きの変化から曲がり角を検出し、コースポイントを生成するドメインサービス
+type CueGenerator struct {
+	minTurnAngle   float64
+	bearingWindowM float64
+	minCueSpacingM float64
+}
+
+func NewCueGenerator(minTurnAngle, bearingWindowM, minCueSpacingM float64) (*CueGenerator, error) {
+	if minTurnAngle <= 0 || minTurnAngle >= 180 {
+		return nil, errors.New("minTurnAngle must be between 0 and 180")
+	}
+	if bearingWindowM <= 0 {
+		return nil, errors.New("bearingWindowM must be positive")
+	}
+	if minCueSpacingM < 0 {
+		return nil, errors.New("minCueSpacingM must be non-negative")
+	}
+
+	return &CueGenerator{
+		minTurnAngle:   minTurnAngle,
+		bearingWindowM: bearingWindowM,
+		minCueSpacingM: minCueSpacingM,
+	}, nil
+}
+
+// NewDefaultCueGenerator は既定値でキュー生成サービスを作成する
+func NewDefaultCueGenerator() *CueGenerator {
+	return &CueGenerator{
+		minTurnAngle:   DefaultMinTurnAngle,
+		bearingWindowM: DefaultBearingWindowM,
+		minCueSpacingM: DefaultMinCueSpacingM,
+	}
+}
+
+// turnCandidate は向きが変わる地点
+type turnCandidate struct {
+	measure       float64
+	location      orb.Point
+	bearingBefore float64
+	bearingAfter  float64
+	angle         float64 // 右回りを正とした向きの変化(度)
+}
+
+// GenerateCoursePoints はルートのコースポイントをpath_geomから生成したキューで置き換える
+// 出発・曲がり角・到着のコースポイントを作る。道路名は分からないため設定しない
+func (g *CueGenerator) GenerateCoursePoints(r *Route, lang CueLanguage) error {
+	path, err := r.editablePath()
+	if err != nil {
+		return err
+	}
+	total := lineLength(path)
+
+	located := []locatedCoursePoint{}
+	departBearing := bearingDegrees(path[0], pointAlong(path, math.Min(g.bearingWindowM, total)))
+	located = append(located, newCue(r, 0, path[0], maneuverDepart, nil, nil, &departBearing, lang))
+
+	for _, turn := range g.detectTurns(path) {
+		modifier := TurnModifier(turn.angle)
+		located = append(located, newCue(r, turn.measure, turn.location, maneuverTurn, &modifier, &turn.bearingBefore, &turn.bearingAfter, lang))
+	}
+
+	arriveBearing := bearingDegrees(pointAlong(path, math.Max(total-g.bearingWindowM, 0)), path[len(path)-1])
+	located = append(located, newCue(r, total, path[len(path)-1], maneuverArrive, nil, &arriveBearing, nil, lang))
+
+	r.applyCoursePoints(located)
+	return nil
+}
+
+// detectTurns は各頂点の前後の方位角を比べて曲がり角を検出する
+// 近い曲がり角が続く場合は向きの変化が最も大きいものだけを残す
+// 前後の地点は頂点とともに進む2つのカーソルで求め、経路を1度だけ辿る
+func (g *CueGenerator) detectTurns(path orb.LineString) []turnCandidate {
+	segLens := make([]float64, len(path)-1)
+	total := 0.0
+	for i := range segLens {
+		segLens[i] = geo.Distance(path[i], path[i+1])
+		total += segLens[i]
+	}
+	back := newPathCursor(path, segLens)
+	front := newPathCursor(path, segLens)
+
+	turns := []turnCandidate{}
+	cum := 0.0
+	for i := 1; i < len(path)-1; i++ {
+		cum += segLens[i-1]
+		// 始点・終点の近くの向きの変化は出発・到着のキューに含める
+		if cum < g.bearingWindowM || total-cum < g.bearingWindowM {
+			continue
+		}
+
+		before := bearingDegrees(back.pointAt(cum-g.bearingWindowM), path[i])
+		after := bearingDegrees(path[i], front.pointAt(cum+g.bearingWindowM))
+		angle := normalizeAngle(after - before)
+		if math.Abs(angle) < g.minTurnAngle {
+			continue
+		}
+
+		turn := turnCandidate{measure: cum, location: path[i], bearingBefore: before, bearingAfter: after, angle: angle}
+		if n := len(turns); n > 0 && turn.measure-turns[n-1].measure < g.minCueSpacingM {
+			if math.Abs(turn.angle) > math.Abs(turns[n-1].angle) {
+				turns[n-1] = turn
+			}
+			continue
+		}
+		turns = append(turns, turn)
+	}
+	return turns
+}
+
+// pathCursor は経路上の地点を始点から順に求める。求める距離は前回以上でなければならない
+// 区間の位置と区間の始点までの距離を覚えておき、毎回始点から辿り直さないようにする
+type pathCursor struct {
+	path    orb.LineString
+	segLens []float64 // segLens[i] は path[i]〜path[i+1] の長さ(m)
+	seg     int       // 現在の区間
+	cum     float64   // 現在の区間の始点までの距離(m)
+}
+
+func newPathCursor(path orb.LineString, segLens []float64) *pathCursor {
+	return &pathCursor{path: path, segLens: segLens}
+}
+
+// pointAt は始点から distance(m) の経路上の地点を求める。pointAlong と同じ地点を返す
+func (c *pathCursor) pointAt(distance float64) orb.Point {
+	for ; c.seg < len(c.segLens); c.seg++ {
+		segLen := c.segLens[c.seg]
+		if segLen > 0 && c.cum+segLen >= distance {
+			from, to := c.path[c.seg], c.path[c.seg+1]
+			t := math.Max(0, (distance-c.cum)/segLen)
+			return orb.Point{
+				from[0] + (to[0]-from[0])*t,
+				from[1] + (to[1]-from[1])*t,
+			}
+		}
+		c.cum += segLen
+	}
+	return c.path[len(c.path)-1]
+}
+
+func newCue(
+	r *Route,
+	measure float64,
+	location orb.Point,
+	maneuverType string,
+	modifier *string,
+	bearingBefore *float64,
+	bearingAfter *float64,
+	lang CueLanguage) locatedCoursePoint {
+
+	instruction := CueInstruction(maneuverType, modifier, lang)
+	loc := Geometry{Geometry: location}
+	return locatedCoursePoint{
+		cp: &CoursePoint{
+			id:            NewCoursePointID().String(),
+			routeID:       r.id,
+			instruction:   &instruction,
+			maneuverType:  &maneuverType,
+			modifier:      modifier,
+			location:      &loc,
+			bearingBefore: roundBearing(bearingBefore),
+			bearingAfter:  roundBearing(bearingAfter),
+		},
+		measure: measure,
+	}
+}
+
+// TurnModifier は向きの変化（右回りを正とする角度）から曲がる向きを分類する
+func TurnModifier(angle float64) string {
+	side := "right"
+	if angle < 0 {
+		side = "left"
+	}
+	switch abs := math.Abs(angle); {
+	case abs < slightTurnMaxAngle:
+		return "slight " + side
+	case abs < turnMaxAngle:
+		return side
+	case abs < sharpTurnMaxAngle:
+		return "sharp " + side
+	default:
+		return modifierUturn
+	}
+}
+
+var cueInstructions = map[CueLanguage]map[string]string{
+	CueLanguageJa: {
+		maneuverDepart: "出発",
+		maneuverArrive: "目的地に到着",
+		"slight right": "やや右方向です",
+		"right":        "右折です",
+		"sharp right":  "大きく右折です",
+		"slight left":  "やや左方向です",
+		"left":         "左折です",
+		"sharp left":   "大きく左折です",
+		modifierUturn:  "Uターンです",
+	},
+	CueLanguageEn: {
+		maneuverDepart: "Depart",
+		maneuverArrive: "Arrive at your destination",
+		"slight right": "Bear right",
+		"right":        "Turn right",
+		"sharp right":  "Turn sharp right",
+		"slight left":  "Bear left",
+		"left":         "Turn left",
+		"sharp left":   "Turn sharp left",
+		modifierUturn:  "Make a U-turn",
+	},
+}
+
+// CueInstruction は操作タイプと修飾子から案内文を作る
+// 出発・到着以外（turn、end of road、forkなど）は曲がる向きで案内する
+func CueInstruction(maneuverType string, modifier *string, lang CueLanguage) string {
+	key := maneuverType
+	if maneuverType != maneuverDepart && maneuverType != maneuverArrive && modifier != nil {
+		key = *modifier
+	}
+	return cueInstructions[lang][key]
+}
+
+// bearingDegrees は from から to への方位角を0〜360度で求める
+func bearingDegrees(from, to orb.Point) float64 {
+	return math.Mod(geo.Bearing(from, to)+360, 360)
+}
+
+// normalizeAngle は角度を-180〜180度に収める
+func normalizeAngle(angle float64) float64 {
+	angle = math.Mod(angle+540, 360) - 180
+	if angle == -180 {
+		return 180
+	}
+	return angle
+}
+
+func roundBearing(bearing *float64) *int32 {
+	if bearing == nil {
+		return nil
+	}
+	rounded := int32(math.Round(*bearing)) % 360
+	return &rounded
+}
+
+// CueSheetEntry はキューシートの1行。キューシートからコースポイントを取り込むときに使う
+type CueSheetEntry struct {
+	CumDistM      float64 // 始点からの距離(m)
+	Instruction   *string
+	RoadName      *string
+	ManeuverType  *string
+	Modifier      *string
+	Location      Geometry
+	BearingBefore *int32
+	BearingAfter  *int32
+}
+
+// ImportCueSheet はキューシートの各行でコースポイントを置き換える
+// 行は始点からの距離の順に並べ直し、区間距離と所要時間は距離から振り直す
+func (r *Route) ImportCueSheet(entries []CueSheetEntry) error {
+	located := make([]locatedCoursePoint, len(entries))
+	for i, e := range entries {
+		if e.CumDistM < 0 {
+			return domainerror.New("cumulative distance must be non-negative", domainerror.ErrValidation)
+		}
+		if _, ok := e.Location.Geometry.(orb.Point); !ok {
+			return domainerror.New("course point location must be a Point", domainerror.ErrValidation)
+		}
+		location := e.Location
+		located[i] = locatedCoursePoint{
+			cp: &CoursePoint{
+				id:            NewCoursePointID().String(),
+				routeID:       r.id,
+				instruction:   e.Instruction,
+				roadName:      e.RoadName,
+				maneuverType:  e.ManeuverType,
+				modifier:      e.Modifier,
+				location:      &location,
+				bearingBefore: e.BearingBefore,
+				bearingAfter:  e.BearingAfter,
+			},
+			measure: e.CumDistM,
+		}
+	}
+
+	r.applyCoursePoints(located)
+	return nil
+}
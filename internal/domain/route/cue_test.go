@@ -0,0 +1,171 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestParseCueLanguage(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   CueLanguage
+	}{
+		{locale: "ja", want: CueLanguageJa},
+		{locale: "en", want: CueLanguageEn},
+		{locale: "en-US", want: CueLanguageEn},
+		{locale: "EN_gb", want: CueLanguageEn},
+		{locale: "fr", want: CueLanguageJa},
+		{locale: "", want: CueLanguageJa},
+	}
+	for _, tt := range tests {
+		if got := ParseCueLanguage(tt.locale); got != tt.want {
+			t.Errorf("ParseCueLanguage(%q) = %s, want %s", tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestNewCueGenerator(t *testing.T) {
+	if _, err := NewCueGenerator(30, 20, 40); err != nil {
+		t.Errorf("NewCueGenerator() error = %v", err)
+	}
+	if _, err := NewCueGenerator(0, 20, 40); err == nil {
+		t.Error("NewCueGenerator() with zero angle should return error")
+	}
+	if _, err := NewCueGenerator(30, 0, 40); err == nil {
+		t.Error("NewCueGenerator() with zero window should return error")
+	}
+}
+
+func TestCueGenerator_GenerateCoursePoints(t *testing.T) {
+	// 東へ進み、左折して北へ、やや右に曲がって北東へ進む
+	// 直線区間には数mのぶれを入れ、曲がり角として検出されないことを確かめる
+	path := orb.LineString{
+		{139.000, 35.000},
+		{139.0025, 35.00002},
+		{139.005, 35.000},
+		{139.005, 35.004},
+		{139.008, 35.008},
+	}
+	r := newTestRouteForVersion(t, "Route", 2000, path)
+	if err := r.AddCoursePoint(nil, nil, nil, nil, nil, new("turn"), nil, &Geometry{Geometry: path[1]}, nil, nil); err != nil {
+		t.Fatalf("AddCoursePoint() error = %v", err)
+	}
+
+	if err := NewDefaultCueGenerator().GenerateCoursePoints(r, CueLanguageJa); err != nil {
+		t.Fatalf("GenerateCoursePoints() error = %v", err)
+	}
+
+	cps := r.CoursePoints()
+	want := []struct {
+		maneuverType string
+		modifier     string
+		instruction  string
+		location     orb.Point
+	}{
+		{maneuverType: "depart", instruction: "出発", location: path[0]},
+		{maneuverType: "turn", modifier: "left", instruction: "左折です", location: path[2]},
+		{maneuverType: "turn", modifier: "slight right", instruction: "やや右方向です", location: path[3]},
+		{maneuverType: "arrive", instruction: "目的地に到着", location: path[4]},
+	}
+	if len(cps) != len(want) {
+		t.Fatalf("len(CoursePoints) = %d, want %d", len(cps), len(want))
+	}
+	for i, w := range want {
+		cp := cps[i]
+		if *cp.ManeuverType() != w.maneuverType || *cp.Instruction() != w.instruction {
+			t.Errorf("cps[%d] = %s/%s, want %s/%s", i, *cp.ManeuverType(), *cp.Instruction(), w.maneuverType, w.instruction)
+		}
+		if w.modifier != "" && (cp.Modifier() == nil || *cp.Modifier() != w.modifier) {
+			t.Errorf("cps[%d].Modifier = %v, want %s", i, cp.Modifier(), w.modifier)
+		}
+		if cp.Location().Geometry != w.location {
+			t.Errorf("cps[%d].Location = %v, want %v", i, cp.Location().Geometry, w.location)
+		}
+		if cp.StepOrder() != int32(i) {
+			t.Errorf("cps[%d].StepOrder = %d", i, cp.StepOrder())
+		}
+	}
+
+	left := cps[1]
+	if !approxEqual(float64(*left.BearingBefore()), 90, 2) || *left.BearingAfter() != 0 {
+		t.Errorf("BearingBefore/After = %d/%d, want about 90/0", *left.BearingBefore(), *left.BearingAfter())
+	}
+	total := lineLength(path)
+	if *cps[3].CumDistM() != total || *cps[0].CumDistM() != 0 {
+		t.Errorf("CumDistM = %v..%v, want 0..%v", *cps[0].CumDistM(), *cps[3].CumDistM(), total)
+	}
+	if !approxEqual(*cps[0].SegDistM(), *cps[1].CumDistM(), 0.001) {
+		t.Errorf("SegDistM = %v, want %v", *cps[0].SegDistM(), *cps[1].CumDistM())
+	}
+
+	if err := NewDefaultCueGenerator().GenerateCoursePoints(r, CueLanguageEn); err != nil {
+		t.Fatalf("GenerateCoursePoints() error = %v", err)
+	}
+	if got := *r.CoursePoints()[2].Instruction(); got != "Bear right" {
+		t.Errorf("Instruction = %s, want Bear right", got)
+	}
+}
+
+func TestCueGenerator_detectTurns_LongTrack(t *testing.T) {
+	// 東へ約90m、北へ約110mずつ進む階段状の経路。各辺は10区間に分かれている
+	// 頂点ごとに始点から辿り直すと、この長さでは数十秒かかる
+	const legs, segmentsPerLeg = 5000, 10
+	path := orb.LineString{{139.0, 35.0}}
+	for leg := range legs {
+		for range segmentsPerLeg {
+			last := path[len(path)-1]
+			if leg%2 == 0 {
+				path = append(path, orb.Point{last[0] + 0.0001, last[1]})
+			} else {
+				path = append(path, orb.Point{last[0], last[1] + 0.0001})
+			}
+		}
+	}
+
+	g := NewDefaultCueGenerator()
+	turns := g.detectTurns(path)
+	if len(turns) != legs-1 {
+		t.Fatalf("len(detectTurns()) = %d, want %d", len(turns), legs-1)
+	}
+	for i, turn := range turns {
+		corner := (i + 1) * segmentsPerLeg
+		if turn.location != path[corner] {
+			t.Fatalf("turns[%d].location = %v, want %v", i, turn.location, path[corner])
+		}
+		// 東から北へは左折、北から東へは右折
+		want := "left"
+		if i%2 == 1 {
+			want = "right"
+		}
+		if got := TurnModifier(turn.angle); got != want {
+			t.Fatalf("turns[%d] = %s (%.1f), want %s", i, got, turn.angle, want)
+		}
+		// カーソルで求めた前後の地点は始点から辿り直した地点と一致する
+		if i%500 == 0 {
+			before := bearingDegrees(pointAlong(path, turn.measure-g.bearingWindowM), path[corner])
+			after := bearingDegrees(path[corner], pointAlong(path, turn.measure+g.bearingWindowM))
+			if turn.bearingBefore != before || turn.bearingAfter != after {
+				t.Errorf("turns[%d] bearings = %v/%v, want %v/%v", i, turn.bearingBefore, turn.bearingAfter, before, after)
+			}
+		}
+	}
+}
+
+func TestTurnModifier(t *testing.T) {
+	tests := []struct {
+		angle float64
+		want  string
+	}{
+		{angle: 35, want: "slight right"},
+		{angle: -90, want: "left"},
+		{angle: 150, want: "sharp right"},
+		{angle: -150, want: "sharp left"},
+		{angle: 175, want: "uturn"},
+	}
+	for _, tt := range tests {
+		if got := TurnModifier(tt.angle); got != tt.want {
+			t.Errorf("TurnModifier(%v) = %s, want %s", tt.angle, got, tt.want)
+		}
+	}
+}
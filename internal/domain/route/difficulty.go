@@ -0,0 +1,172 @@
+package route
+
+import (
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+// Difficulty はルートの難易度。易しい順に大きくなる
+type Difficulty int16
+
+const (
+	DifficultyUnknown  Difficulty = iota // まだ求めていない
+	DifficultyEasy                       // 初心者向け
+	DifficultyModerate                   // 中級者向け
+	DifficultyHard                       // 上級者向け
+	DifficultyExpert                     // 健脚向け
+)
+
+var difficultyNames = map[Difficulty]string{
+	DifficultyEasy:     "easy",
+	DifficultyModerate: "moderate",
+	DifficultyHard:     "hard",
+	DifficultyExpert:   "expert",
+}
+
+// String は難易度の名前を返す。まだ求めていない場合は空文字
+func (d Difficulty) String() string {
+	return difficultyNames[d]
+}
+
+// ParseDifficulty は難易度の名前（easy, moderate, hard, expert）を変換する
+func ParseDifficulty(s string) (Difficulty, error) {
+	for d, name := range difficultyNames {
+		if name == s {
+			return d, nil
+		}
+	}
+	return DifficultyUnknown, domainerror.New("invalid difficulty: "+s, domainerror.ErrValidation)
+}
+
+// DifficultyInput は難易度を求めるためのルートの特徴
+type DifficultyInput struct {
+	Distance             float64  // 距離(m)
+	ElevationGain        float64  // 獲得標高(m)
+	MaxSustainedGradient *float64 // 最も厳しい登坂区間の平均勾配(%)。標高のデータがない場合はnil
+	UnpavedPercentage    float64  // 未舗装（gravel, dirt）の割合(%)
+}
+
+// 難易度の点数（0〜100）の配点。各要素は下限から上限までの間で比例して加点する
+// 調整するときは difficulty_test.go の想定ルートの難易度も見直すこと
+const (
+	difficultyDistanceWeight = 35.0
+	difficultyDistanceMax    = 160000.0 // 160km以上で満点
+
+	difficultyElevationWeight = 30.0
+	difficultyElevationMax    = 2500.0 // 獲得標高2500m以上で満点
+
+	difficultyGradientWeight = 20.0
+	difficultyGradientMin    = 3.0  // 3%までの勾配は加点しない
+	difficultyGradientMax    = 12.0 // 12%以上で満点
+
+	difficultyUnpavedWeight = 15.0
+	difficultyUnpavedMax    = 50.0 // 未舗装が半分以上で満点
+)
+
+// 難易度ごとの点数の下限
+var difficultyThresholds = []struct {
+	minScore   float64
+	difficulty Difficulty
+}{
+	{minScore: 75, difficulty: DifficultyExpert},
+	{minScore: 50, difficulty: DifficultyHard},
+	{minScore: 25, difficulty: DifficultyModerate},
+}
+
+// GradeSample は経路を短く区切った区間の長さと勾配。経路の始点から順に並べて勾配のプロファイルにする
+type GradeSample struct {
+	Distance float64  // 長さ(m)
+	Gradient *float64 // 経路の進む向きの勾配(%)。下りは負。標高のデータがない区間はnil
+}
+
+// 登坂区間の検出の条件
+const (
+	climbMinGradient = 3.0   // この勾配以上の区間が続く部分を登坂区間とする(%)
+	climbMinDistance = 500.0 // これより短い上りは登坂区間とみなさない(m)
+	climbMaxDip      = 100.0 // 登坂区間の途中で、これ以下の長さの緩い区間は登坂区間に含める(m)
+)
+
+// MaxClimbGradient は勾配のプロファイルから登坂区間を検出し、最も厳しい登坂区間の平均勾配(%)を返す
+// 登坂区間は標高のデータがない区間で途切れる。標高のデータが1区間もない場合はnil、登坂区間がない場合は0を返す
+func MaxClimbGradient(samples []GradeSample) *float64 {
+	var (
+		hasData            bool
+		steepest           float64
+		climbDist, climbUp float64 // 検出中の登坂区間
+		dipDist, dipUp     float64 // 登坂区間の途中の緩い区間
+	)
+	endClimb := func() {
+		if climbDist >= climbMinDistance {
+			steepest = max(steepest, climbUp/climbDist*100)
+		}
+		climbDist, climbUp, dipDist, dipUp = 0, 0, 0, 0
+	}
+	for _, s := range samples {
+		if s.Gradient == nil {
+			endClimb()
+			continue
+		}
+		hasData = true
+		up := s.Distance * *s.Gradient / 100
+		if *s.Gradient >= climbMinGradient {
+			climbDist += dipDist + s.Distance
+			climbUp += dipUp + up
+			dipDist, dipUp = 0, 0
+			continue
+		}
+		if climbDist == 0 {
+			continue
+		}
+		dipDist += s.Distance
+		dipUp += up
+		if dipDist > climbMaxDip {
+			endClimb()
+		}
+	}
+	endClimb()
+	if !hasData {
+		return nil
+	}
+	return &steepest
+}
+
+// NewDifficultyInput はルートの距離・獲得標高、路面の内訳と勾配のプロファイルから難易度を求めるための特徴を作る
+// 路面の内訳がない場合は舗装路とみなす
+func NewDifficultyInput(distance, elevationGain float64, breakdown *SurfaceBreakdown, grades []GradeSample) DifficultyInput {
+	in := DifficultyInput{
+		Distance:             distance,
+		ElevationGain:        elevationGain,
+		MaxSustainedGradient: MaxClimbGradient(grades),
+	}
+	if breakdown != nil {
+		in.UnpavedPercentage = breakdown.UnpavedPercentage()
+	}
+	return in
+}
+
+// DifficultyScore は難易度の点数（0〜100）を求める
+// 最も厳しい登坂区間の勾配が分からない場合は、勾配の点数を加えない
+func DifficultyScore(in DifficultyInput) float64 {
+	score := difficultyDistanceWeight*proportion(in.Distance, 0, difficultyDistanceMax) +
+		difficultyElevationWeight*proportion(in.ElevationGain, 0, difficultyElevationMax) +
+		difficultyUnpavedWeight*proportion(in.UnpavedPercentage, 0, difficultyUnpavedMax)
+	if in.MaxSustainedGradient != nil {
+		score += difficultyGradientWeight * proportion(*in.MaxSustainedGradient, difficultyGradientMin, difficultyGradientMax)
+	}
+	return score
+}
+
+// RateDifficulty は点数から難易度を決める
+func RateDifficulty(in DifficultyInput) Difficulty {
+	score := DifficultyScore(in)
+	for _, t := range difficultyThresholds {
+		if score >= t.minScore {
+			return t.difficulty
+		}
+	}
+	return DifficultyEasy
+}
+
+// proportion は値が下限から上限までのどの位置にあるかを0〜1で返す
+func proportion(v, lo, hi float64) float64 {
+	return min(max((v-lo)/(hi-lo), 0), 1)
+}
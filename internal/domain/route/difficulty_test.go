@@ -0,0 +1,206 @@
+package route
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+func TestRateDifficulty(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     DifficultyInput
+		wantScore float64
+		want      Difficulty
+	}{
+		{
+			name:      "皇居一周",
+			input:     DifficultyInput{Distance: 5000, ElevationGain: 20, MaxSustainedGradient: new(1.6)},
+			wantScore: 1.3,
+			want:      DifficultyEasy,
+		},
+		{
+			name:      "河川敷のサイクリングロード",
+			input:     DifficultyInput{Distance: 40000, ElevationGain: 100, MaxSustainedGradient: new(1.0)},
+			wantScore: 9.9,
+			want:      DifficultyEasy,
+		},
+		{
+			name:      "峠を1つ越える",
+			input:     DifficultyInput{Distance: 40000, ElevationGain: 760, MaxSustainedGradient: new(7.6)},
+			wantScore: 28.1,
+			want:      DifficultyModerate,
+		},
+		{
+			name:      "距離は短いが激坂がある",
+			input:     DifficultyInput{Distance: 10000, ElevationGain: 600, MaxSustainedGradient: new(15.0)},
+			wantScore: 29.4,
+			want:      DifficultyModerate,
+		},
+		{
+			name:      "100kmのロングライド",
+			input:     DifficultyInput{Distance: 100000, ElevationGain: 1500, MaxSustainedGradient: new(6.0)},
+			wantScore: 46.5,
+			want:      DifficultyModerate,
+		},
+		{
+			name:      "未舗装の多いグラベルライド",
+			input:     DifficultyInput{Distance: 80000, ElevationGain: 1200, MaxSustainedGradient: new(6.0), UnpavedPercentage: 50},
+			wantScore: 53.6,
+			want:      DifficultyHard,
+		},
+		{
+			name:      "山岳のグランフォンド",
+			input:     DifficultyInput{Distance: 180000, ElevationGain: 4000, MaxSustainedGradient: new(9.0)},
+			wantScore: 78.3,
+			want:      DifficultyExpert,
+		},
+		{
+			name:      "すべての要素が上限を超える",
+			input:     DifficultyInput{Distance: 300000, ElevationGain: 6000, MaxSustainedGradient: new(20.0), UnpavedPercentage: 100},
+			wantScore: 100,
+			want:      DifficultyExpert,
+		},
+		{
+			name:      "境界: 25点ちょうどは中級者向け",
+			input:     DifficultyInput{ElevationGain: 1250, MaxSustainedGradient: new(7.5)},
+			wantScore: 25,
+			want:      DifficultyModerate,
+		},
+		{
+			name:      "標高のデータがなく勾配が分からない",
+			input:     DifficultyInput{Distance: 40000, ElevationGain: 760},
+			wantScore: 17.9,
+			want:      DifficultyEasy,
+		},
+		{
+			name:  "距離0のルート",
+			input: DifficultyInput{},
+			want:  DifficultyEasy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DifficultyScore(tt.input); math.Abs(got-tt.wantScore) > 0.1 {
+				t.Errorf("DifficultyScore() = %.2f, want %.1f", got, tt.wantScore)
+			}
+			if got := RateDifficulty(tt.input); got != tt.want {
+				t.Errorf("RateDifficulty() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// grades は同じ長さの区間の勾配を並べたプロファイルを作る。NaNは標高のデータがない区間
+func grades(distance float64, gradients ...float64) []GradeSample {
+	samples := make([]GradeSample, len(gradients))
+	for i, g := range gradients {
+		samples[i] = GradeSample{Distance: distance}
+		if !math.IsNaN(g) {
+			samples[i].Gradient = new(g)
+		}
+	}
+	return samples
+}
+
+func TestMaxClimbGradient(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		name    string
+		samples []GradeSample
+		want    *float64
+	}{
+		{
+			name:    "標高のデータがない",
+			samples: grades(100, nan, nan, nan),
+			want:    nil,
+		},
+		{
+			name:    "プロファイルがない",
+			samples: nil,
+			want:    nil,
+		},
+		{
+			name:    "平坦なルートは登坂区間がない",
+			samples: grades(100, 1, 0, -1, 2, 1, 0),
+			want:    new(0.0),
+		},
+		{
+			name:    "500mに満たない上りは登坂区間とみなさない",
+			samples: grades(100, 0, 10, 10, 10, 10, 0),
+			want:    new(0.0),
+		},
+		{
+			name:    "500m続く上りを登坂区間とする",
+			samples: grades(100, 0, 6, 8, 6, 8, 7, 0),
+			want:    new(7.0),
+		},
+		{
+			name:    "途中の短い緩い区間は登坂区間に含める",
+			samples: grades(100, 8, 8, 8, 0, 8, 8, 8),
+			want:    new(48.0 / 7),
+		},
+		{
+			name:    "長い緩い区間で登坂区間が途切れる",
+			samples: grades(100, 8, 8, 8, 0, 0, 8, 8, 8),
+			want:    new(0.0),
+		},
+		{
+			name:    "標高のデータがない区間で登坂区間が途切れる",
+			samples: grades(100, 8, 8, 8, nan, 8, 8, 8),
+			want:    new(0.0),
+		},
+		{
+			name:    "最も厳しい登坂区間の勾配を返す",
+			samples: append(grades(200, 5, 5, 5, -2, -2), grades(100, 10, 9, 10, 9, 10, 9, -5)...),
+			want:    new(9.5),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MaxClimbGradient(tt.samples)
+			if (got == nil) != (tt.want == nil) {
+				t.Fatalf("MaxClimbGradient() = %v, want %v", got, tt.want)
+			}
+			if got != nil && math.Abs(*got-*tt.want) > 1e-9 {
+				t.Errorf("MaxClimbGradient() = %v, want %v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDifficultyInput(t *testing.T) {
+	breakdown := NewSurfaceBreakdown(map[string]float64{"paved": 7000, "gravel": 3000}, nil)
+
+	got := NewDifficultyInput(40000, 760, breakdown, grades(100, 2, 6, 6, 6, 6, 6, 2))
+	if got.MaxSustainedGradient == nil || math.Abs(*got.MaxSustainedGradient-6) > 1e-9 {
+		t.Errorf("MaxSustainedGradient = %v, want 6", got.MaxSustainedGradient)
+	}
+	if math.Abs(got.UnpavedPercentage-30) > 1e-9 {
+		t.Errorf("UnpavedPercentage = %v, want 30", got.UnpavedPercentage)
+	}
+
+	// 路面の内訳がないルートは舗装路とみなし、標高のデータがない場合は勾配を見積もらない
+	if got := NewDifficultyInput(0, 0, nil, nil); got.MaxSustainedGradient != nil || got.UnpavedPercentage != 0 {
+		t.Errorf("NewDifficultyInput(0, 0, nil, nil) = %+v", got)
+	}
+}
+
+func TestParseDifficulty(t *testing.T) {
+	for _, d := range []Difficulty{DifficultyEasy, DifficultyModerate, DifficultyHard, DifficultyExpert} {
+		got, err := ParseDifficulty(d.String())
+		if err != nil || got != d {
+			t.Errorf("ParseDifficulty(%q) = %v, %v", d.String(), got, err)
+		}
+	}
+	if _, err := ParseDifficulty("extreme"); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("ParseDifficulty(extreme) error = %v, want ErrValidation", err)
+	}
+	if DifficultyUnknown.String() != "" {
+		t.Errorf("DifficultyUnknown.String() = %q, want empty", DifficultyUnknown.String())
+	}
+}
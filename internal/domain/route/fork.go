@@ -0,0 +1,42 @@
+package route
+
+import (
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+// Fork はルートを複製して閲覧ユーザーのルートを作成する
+// コースポイントとウェイポイントも新しいIDで複製し、フォーク元を記録する
+// 複製したルートは編集してから公開できるよう非公開で作成する
+func (r *Route) Fork(viewer Viewer) (*Route, error) {
+	if !r.IsVisibleTo(viewer) {
+		// 閲覧できないルートは存在を明かさない
+		return nil, domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+
+	// 写真はフォーク元の所有者のものなので引き継がない
+	forked, err := newRoute(
+		viewer.UserID(),
+		r.name,
+		r.description,
+		nil,
+		r.distance,
+		r.duration,
+		r.elevationGain,
+		r.elevationLoss,
+		r.pathGeom,
+		r.firstPoint,
+		r.lastPoint,
+		VisibilityPrivate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.copyChildrenTo(forked)
+	forked.startPlace, forked.endPlace = r.startPlace, r.endPlace
+
+	originalID := r.id
+	forked.forkedFromRouteID = &originalID
+
+	return forked, nil
+}
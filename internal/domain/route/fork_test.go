@@ -0,0 +1,90 @@
+package route
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+)
+
+func newTestRouteForFork(t *testing.T, ownerID string, visibility int16) *Route {
+	t.Helper()
+	path := orb.LineString{{139.0, 35.0}, {139.1, 35.1}}
+	photoID := int64(10)
+	r, err := NewRoute(ownerID, "Original", "description", &photoID, 1000, 600, 100, 80,
+		Geometry{Geometry: path}, Geometry{Geometry: path[0]}, Geometry{Geometry: path[1]}, visibility)
+	if err != nil {
+		t.Fatalf("NewRoute() error = %v", err)
+	}
+	if err := r.AddWaypoint(Geometry{Geometry: orb.Point{139.0, 35.0}}); err != nil {
+		t.Fatalf("AddWaypoint() error = %v", err)
+	}
+	if err := r.AddCoursePoint(new(0.0), new(0.0), new(0.0), new("Start"), nil, new("depart"), nil,
+		&Geometry{Geometry: orb.Point{139.0, 35.0}}, nil, nil); err != nil {
+		t.Fatalf("AddCoursePoint() error = %v", err)
+	}
+	return r
+}
+
+func TestRoute_Fork(t *testing.T) {
+	ownerID := user.NewUserID().String()
+	otherID := user.NewUserID().String()
+
+	tests := []struct {
+		name       string
+		visibility int16
+		forkerID   string
+		clubs      []string // フォークするユーザーが所属するクラブ
+		wantErr    error
+	}{
+		{name: "正常系: 公開ルートは他のユーザーがフォークできる", visibility: VisibilityPublic, forkerID: otherID},
+		{name: "正常系: 非公開ルートでも所有者はフォークできる", visibility: VisibilityPrivate, forkerID: ownerID},
+		{name: "異常系: 他のユーザーの非公開ルート", visibility: VisibilityPrivate, forkerID: otherID, wantErr: domainerror.ErrNotFound},
+		{name: "異常系: 他のユーザーの友達のみのルート", visibility: VisibilityFriends, forkerID: otherID, wantErr: domainerror.ErrNotFound},
+		{name: "正常系: クラブのメンバーはクラブのメンバーのみのルートをフォークできる", visibility: VisibilityClub, forkerID: otherID, clubs: []string{"club-1"}},
+		{name: "異常系: クラブのメンバー以外", visibility: VisibilityClub, forkerID: otherID, clubs: []string{"club-2"}, wantErr: domainerror.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := newTestRouteForFork(t, ownerID, tt.visibility)
+			if tt.visibility == VisibilityClub {
+				original.SetClubID(new("club-1"))
+			}
+
+			forked, err := original.Fork(NewViewer(tt.forkerID, tt.clubs))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Fork() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Fork() error = %v", err)
+			}
+
+			if forked.ID() == original.ID() || forked.UserID() != tt.forkerID {
+				t.Errorf("ID/UserID = %s/%s, want new ID owned by %s", forked.ID(), forked.UserID(), tt.forkerID)
+			}
+			if forked.ForkedFromRouteID() == nil || *forked.ForkedFromRouteID() != original.ID() {
+				t.Errorf("ForkedFromRouteID = %v, want %s", forked.ForkedFromRouteID(), original.ID())
+			}
+			if forked.Visibility() != VisibilityPrivate || forked.HighlightedPhotoID() != nil {
+				t.Errorf("Visibility/HighlightedPhotoID = %d/%v, want private without photo", forked.Visibility(), forked.HighlightedPhotoID())
+			}
+			if forked.Name() != original.Name() || forked.Distance() != original.Distance() {
+				t.Errorf("Name/Distance = %s/%v, want %s/%v", forked.Name(), forked.Distance(), original.Name(), original.Distance())
+			}
+
+			cps := forked.CoursePoints()
+			if len(cps) != 1 || cps[0].ID() == original.CoursePoints()[0].ID() || cps[0].RouteID() != forked.ID() {
+				t.Errorf("course points were not deep-copied: %+v", cps)
+			}
+			wps := forked.Waypoints()
+			if len(wps) != 1 || wps[0].ID() == original.Waypoints()[0].ID() || wps[0].RouteID() != forked.ID() {
+				t.Errorf("waypoints were not deep-copied: %+v", wps)
+			}
+		})
+	}
+}
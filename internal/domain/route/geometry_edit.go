@@ -0,0 +1,486 @@
+package route
+
+import (
+	"math"
+	"sort"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// ジオメトリ編集の既定値
+const (
+	// 結合時に終点と始点がこの距離以内なら同じ地点とみなす(m)
+	joinSnapToleranceM = 1.0
+	// 分割・切り出しで端点とみなす距離(m)。これより短い区間は作らない
+	minEditSegmentM = 1.0
+)
+
+// ジオメトリ編集ではpath_geomから距離を測り直し、コースポイントの累積距離もその距離で振り直す。
+// 標高は地点ごとの値を持っていないため、切り出した距離の割合で按分する
+
+// Reverse はルートの進行方向を反転する
+// コースポイントは逆順に並べ替え、方位角を反転して左右の曲がる向きを入れ替える
+func (r *Route) Reverse() error {
+	path, err := r.editablePath()
+	if err != nil {
+		return err
+	}
+
+	reversed := path.Clone()
+	reversed.Reverse()
+
+	points := r.locateCoursePoints(path)
+	total := lineLength(path)
+	located := make([]locatedCoursePoint, len(points))
+	for i, src := range points {
+		cp := *src.cp
+		cp.bearingBefore = reverseBearing(src.cp.bearingAfter)
+		cp.bearingAfter = reverseBearing(src.cp.bearingBefore)
+		cp.modifier = reverseModifier(src.cp.modifier)
+		cp.maneuverType = reverseManeuverType(src.cp.maneuverType)
+		// 道路名は地点を出た後の道路を表すため、反転後は元の直前の区間の道路になる
+		if i > 0 {
+			cp.roadName = points[i-1].cp.roadName
+		}
+		// 左右が入れ替わるため、元の案内文はそのままでは使えない
+		cp.instruction = nil
+		located[len(points)-1-i] = locatedCoursePoint{cp: &cp, measure: total - src.measure}
+	}
+
+	waypoints := make([]*Waypoint, len(r.waypoints))
+	for i, wp := range r.waypoints {
+		waypoints[len(r.waypoints)-1-i] = wp
+	}
+
+	r.elevationGain, r.elevationLoss = r.elevationLoss, r.elevationGain
+	r.applyPath(reversed, r.duration)
+	r.waypoints = waypoints
+	r.applyCoursePoints(located)
+	return nil
+}
+
+// Trim は始点から from〜to(m) の区間だけを残す
+// to が全長を超える場合は終点までとする
+func (r *Route) Trim(from, to float64) error {
+	path, err := r.editablePath()
+	if err != nil {
+		return err
+	}
+
+	total := lineLength(path)
+	to = math.Min(to, total)
+	if from < 0 || to-from < minEditSegmentM {
+		return domainerror.New("trim range must satisfy 0 <= from < to", domainerror.ErrValidation)
+	}
+
+	r.cut(path, from, to)
+	return nil
+}
+
+// SplitAt はルートを at に最も近い経路上の地点で2つに分割する
+// レシーバは前半のルートになり、後半は name の新しいルートとして返す。name が空の場合は元の名前に「(2)」を付ける
+func (r *Route) SplitAt(at Geometry, name string) (*Route, error) {
+	path, err := r.editablePath()
+	if err != nil {
+		return nil, err
+	}
+	point, ok := at.Geometry.(orb.Point)
+	if !ok {
+		return nil, domainerror.New("split point must be a Point", domainerror.ErrValidation)
+	}
+
+	total := lineLength(path)
+	measure := locateOnLine(path, point, 0)
+	if measure < minEditSegmentM || total-measure < minEditSegmentM {
+		return nil, domainerror.New("split point must not be at either end of the route", domainerror.ErrValidation)
+	}
+
+	if name == "" {
+		name = r.name + " (2)"
+	}
+	// 写真は前半のルートに残す
+	latter, err := newRoute(
+		r.userID,
+		name,
+		r.description,
+		nil,
+		r.distance,
+		r.duration,
+		r.elevationGain,
+		r.elevationLoss,
+		r.pathGeom,
+		r.firstPoint,
+		r.lastPoint,
+		r.visibility,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// クラブのメンバーのみに公開したルートは後半も同じクラブに共有する
+	latter.clubID = r.clubID
+	r.copyChildrenTo(latter)
+
+	latter.cut(path, measure, total)
+	r.cut(path, 0, measure)
+	return latter, nil
+}
+
+// Join は other をこのルートの終点の後ろにつなげる
+// 終点と other の始点が離れている場合は直線で結ぶ。other 自体は変更しない
+func (r *Route) Join(other *Route) error {
+	if other == nil {
+		return domainerror.New("route to join is required", domainerror.ErrValidation)
+	}
+	if other.id == r.id {
+		return domainerror.New("cannot join a route to itself", domainerror.ErrValidation)
+	}
+	if other.userID != r.userID {
+		return domainerror.New("routes to join must have the same owner", domainerror.ErrValidation)
+	}
+	path, err := r.editablePath()
+	if err != nil {
+		return err
+	}
+	otherPath, err := other.editablePath()
+	if err != nil {
+		return err
+	}
+
+	total := lineLength(path)
+	gap := geo.Distance(path[len(path)-1], otherPath[0])
+	joined := path.Clone()
+	if gap <= joinSnapToleranceM {
+		joined = append(joined, otherPath[1:]...)
+		gap = 0
+	} else {
+		joined = append(joined, otherPath...)
+	}
+	offset := total + gap
+
+	// 結合点の到着・出発はルートの途中になるため取り除く
+	located := []locatedCoursePoint{}
+	for _, p := range r.locateCoursePoints(path) {
+		if !isManeuverType(p.cp.maneuverType, maneuverArrive) {
+			located = append(located, p)
+		}
+	}
+	for _, p := range other.locateCoursePoints(otherPath) {
+		if isManeuverType(p.cp.maneuverType, maneuverDepart) {
+			continue
+		}
+		cp := *p.cp
+		cp.id = NewCoursePointID().String()
+		cp.routeID = r.id
+		located = append(located, locatedCoursePoint{cp: &cp, measure: p.measure + offset})
+	}
+
+	waypoints := append([]*Waypoint{}, r.waypoints...)
+	for i, wp := range other.waypoints {
+		if i == 0 && len(waypoints) > 0 && samePoint(waypoints[len(waypoints)-1].location, wp.location) {
+			continue
+		}
+		copied := *wp
+		copied.id = NewWaypointID().String()
+		copied.routeID = r.id
+		waypoints = append(waypoints, &copied)
+	}
+
+	r.elevationGain += other.elevationGain
+	r.elevationLoss += other.elevationLoss
+	r.applyPath(joined, r.duration+other.duration)
+	r.waypoints = waypoints
+	r.applyCoursePoints(located)
+	return nil
+}
+
+// cut はルートを path の from〜to(m) の区間に切り詰める
+// 区間外のコースポイントは取り除き、ウェイポイントは区間の両端を新しい始点・終点にする
+func (r *Route) cut(path orb.LineString, from, to float64) {
+	total := lineLength(path)
+	ratio := (to - from) / total
+
+	located := []locatedCoursePoint{}
+	for _, p := range r.locateCoursePoints(path) {
+		if p.measure < from || p.measure > to {
+			continue
+		}
+		located = append(located, locatedCoursePoint{cp: p.cp, measure: p.measure - from})
+	}
+
+	sub := subLine(path, from, to)
+	waypoints := []*Waypoint{}
+	if len(r.waypoints) > 0 {
+		waypoints = append(waypoints, r.newWaypoint(sub[0]))
+		for i, measure := range locatePoints(path, waypointLocations(r.waypoints)) {
+			if measure > from && measure < to {
+				wp := *r.waypoints[i]
+				wp.routeID = r.id
+				waypoints = append(waypoints, &wp)
+			}
+		}
+		waypoints = append(waypoints, r.newWaypoint(sub[len(sub)-1]))
+	}
+
+	r.elevationGain *= ratio
+	r.elevationLoss *= ratio
+	r.applyPath(sub, r.duration*ratio)
+	r.waypoints = waypoints
+	r.applyCoursePoints(located)
+}
+
+// editablePath は編集対象のpath_geomを取り出す
+func (r *Route) editablePath() (orb.LineString, error) {
+	path, ok := r.pathGeom.Geometry.(orb.LineString)
+	if !ok || len(path) < 2 {
+		return nil, domainerror.New("route path must be a LineString with at least 2 points", domainerror.ErrValidation)
+	}
+	if lineLength(path) == 0 {
+		return nil, domainerror.New("route path must have a length", domainerror.ErrValidation)
+	}
+	return path, nil
+}
+
+// applyPath は新しいpath_geomから距離・始点終点・bboxを設定する
+func (r *Route) applyPath(path orb.LineString, duration float64) {
+	r.pathGeom = Geometry{Geometry: path}
+	r.firstPoint = Geometry{Geometry: path[0]}
+	r.lastPoint = Geometry{Geometry: path[len(path)-1]}
+	r.bbox = Geometry{Geometry: path.Bound().ToPolygon()}
+	r.distance = lineLength(path)
+	r.duration = duration
+}
+
+// applyCoursePoints は経路上の位置の順にコースポイントを並べ、区間距離・累積距離・所要時間を振り直す
+// 所要時間はルート全体の所要時間を区間距離で按分する
+func (r *Route) applyCoursePoints(located []locatedCoursePoint) {
+	sort.SliceStable(located, func(i, j int) bool {
+		return located[i].measure < located[j].measure
+	})
+
+	coursePoints := make([]*CoursePoint, len(located))
+	for i, p := range located {
+		end := r.distance
+		if i+1 < len(located) {
+			end = located[i+1].measure
+		}
+		cumDist := p.measure
+		segDist := math.Max(end-p.measure, 0)
+		duration := 0.0
+		if r.distance > 0 {
+			duration = r.duration * segDist / r.distance
+		}
+
+		cp := *p.cp
+		cp.routeID = r.id
+		cp.stepOrder = int32(i)
+		cp.cumDistM = &cumDist
+		cp.segDistM = &segDist
+		cp.duration = &duration
+		coursePoints[i] = &cp
+	}
+	r.coursePoints = coursePoints
+}
+
+// copyChildrenTo はコースポイントとウェイポイントを新しいIDで dst に複製する
+func (r *Route) copyChildrenTo(dst *Route) {
+	coursePoints := make([]*CoursePoint, len(r.coursePoints))
+	for i, cp := range r.coursePoints {
+		copied := *cp
+		copied.id = NewCoursePointID().String()
+		copied.routeID = dst.id
+		coursePoints[i] = &copied
+	}
+	waypoints := make([]*Waypoint, len(r.waypoints))
+	for i, wp := range r.waypoints {
+		copied := *wp
+		copied.id = NewWaypointID().String()
+		copied.routeID = dst.id
+		waypoints[i] = &copied
+	}
+	dst.coursePoints = coursePoints
+	dst.waypoints = waypoints
+}
+
+func (r *Route) newWaypoint(p orb.Point) *Waypoint {
+	return &Waypoint{
+		id:       NewWaypointID().String(),
+		routeID:  r.id,
+		location: Geometry{Geometry: p},
+	}
+}
+
+// locatedCoursePoint は経路上の位置（始点からの距離）を求めたコースポイント
+type locatedCoursePoint struct {
+	cp      *CoursePoint
+	measure float64
+}
+
+// locateCoursePoints はコースポイントの経路上の位置を求める
+func (r *Route) locateCoursePoints(path orb.LineString) []locatedCoursePoint {
+	ordered := make([]*CoursePoint, len(r.coursePoints))
+	copy(ordered, r.coursePoints)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].stepOrder < ordered[j].stepOrder
+	})
+
+	locations := make([]orb.Point, len(ordered))
+	for i, cp := range ordered {
+		if cp.location != nil {
+			locations[i], _ = cp.location.Geometry.(orb.Point)
+		}
+	}
+
+	measures := locatePoints(path, locations)
+	located := make([]locatedCoursePoint, len(ordered))
+	for i, cp := range ordered {
+		located[i] = locatedCoursePoint{cp: cp, measure: measures[i]}
+	}
+	return located
+}
+
+func waypointLocations(waypoints []*Waypoint) []orb.Point {
+	locations := make([]orb.Point, len(waypoints))
+	for i, wp := range waypoints {
+		locations[i], _ = wp.location.Geometry.(orb.Point)
+	}
+	return locations
+}
+
+func samePoint(a, b Geometry) bool {
+	pa, okA := a.Geometry.(orb.Point)
+	pb, okB := b.Geometry.(orb.Point)
+	return okA && okB && geo.Distance(pa, pb) <= joinSnapToleranceM
+}
+
+// 反転したときの修飾子
+var reversedModifiers = map[string]string{
+	"left":         "right",
+	"right":        "left",
+	"slight left":  "slight right",
+	"slight right": "slight left",
+	"sharp left":   "sharp right",
+	"sharp right":  "sharp left",
+}
+
+func isManeuverType(maneuverType *string, want string) bool {
+	return maneuverType != nil && *maneuverType == want
+}
+
+func reverseManeuverType(maneuverType *string) *string {
+	switch {
+	case isManeuverType(maneuverType, maneuverDepart):
+		v := maneuverArrive
+		return &v
+	case isManeuverType(maneuverType, maneuverArrive):
+		v := maneuverDepart
+		return &v
+	}
+	return maneuverType
+}
+
+func reverseModifier(modifier *string) *string {
+	if modifier == nil {
+		return nil
+	}
+	if reversed, ok := reversedModifiers[*modifier]; ok {
+		return &reversed
+	}
+	return modifier
+}
+
+// reverseBearing は逆向きに進んだときの方位角を返す
+func reverseBearing(bearing *int32) *int32 {
+	if bearing == nil {
+		return nil
+	}
+	reversed := (*bearing + 180) % 360
+	return &reversed
+}
+
+// lineLength はラインの長さ(m)を求める
+func lineLength(ls orb.LineString) float64 {
+	return geo.Length(ls)
+}
+
+// locatePoints は各点を経路に射影した位置（始点からの距離(m)）を求める
+// 点は経路に沿った順に並んでいるものとし、周回ルートで始点と終点が重なる場合も前の点より後ろで探す
+func locatePoints(ls orb.LineString, points []orb.Point) []float64 {
+	measures := make([]float64, len(points))
+	from := 0.0
+	for i, p := range points {
+		measures[i] = locateOnLine(ls, p, from)
+		from = measures[i]
+	}
+	return measures
+}
+
+// locateOnLine は点を経路に射影した位置（始点からの距離(m)）を求める。from(m)より手前の区間は探さない
+func locateOnLine(ls orb.LineString, p orb.Point, from float64) float64 {
+	proj := newLocalProjection(p)
+	target := proj.point(p)
+
+	best, bestDist := from, math.Inf(1)
+	cum := 0.0
+	for i := 1; i < len(ls); i++ {
+		segLen := geo.Distance(ls[i-1], ls[i])
+		start, end := cum, cum+segLen
+		cum = end
+		if end < from {
+			continue
+		}
+
+		a, b := proj.point(ls[i-1]), proj.point(ls[i])
+		t := projectOnSegment(a, b, target)
+		if start+segLen*t < from {
+			t = (from - start) / segLen
+		}
+		q := orb.Point{a[0] + (b[0]-a[0])*t, a[1] + (b[1]-a[1])*t}
+		if d := math.Hypot(q[0]-target[0], q[1]-target[1]); d < bestDist {
+			best, bestDist = start+segLen*t, d
+		}
+	}
+	return best
+}
+
+// projectOnSegment は線分ab上で p に最も近い点の位置を0〜1で返す
+func projectOnSegment(a, b, p orb.Point) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	lenSq := dx*dx + dy*dy
+	if lenSq == 0 {
+		return 0
+	}
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / lenSq
+	return math.Max(0, math.Min(1, t))
+}
+
+// subLine は経路の from〜to(m) の区間を切り出す
+func subLine(ls orb.LineString, from, to float64) orb.LineString {
+	result := orb.LineString{pointAlong(ls, from)}
+	cum := 0.0
+	for i := 1; i < len(ls); i++ {
+		cum += geo.Distance(ls[i-1], ls[i])
+		if cum > from && cum < to {
+			result = append(result, ls[i])
+		}
+	}
+	return append(result, pointAlong(ls, to))
+}
+
+// pointAlong は始点から distance(m) の経路上の地点を求める
+func pointAlong(ls orb.LineString, distance float64) orb.Point {
+	cum := 0.0
+	for i := 1; i < len(ls); i++ {
+		segLen := geo.Distance(ls[i-1], ls[i])
+		if segLen > 0 && cum+segLen >= distance {
+			t := math.Max(0, (distance-cum)/segLen)
+			return orb.Point{
+				ls[i-1][0] + (ls[i][0]-ls[i-1][0])*t,
+				ls[i-1][1] + (ls[i][1]-ls[i-1][1])*t,
+			}
+		}
+		cum += segLen
+	}
+	return ls[len(ls)-1]
+}
@@ -0,0 +1,268 @@
+package route
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+)
+
+// 東西にまっすぐ進む3点のルート。始点・中間点・終点にコースポイントとウェイポイントを持つ
+func newTestRouteForEdit(t *testing.T, path orb.LineString) *Route {
+	t.Helper()
+	r := newTestRouteForVersion(t, "Route", 2000, path)
+	steps := []struct {
+		maneuverType string
+		modifier     *string
+		roadName     string
+		before       *int32
+		after        *int32
+		location     orb.Point
+	}{
+		{maneuverType: "depart", roadName: "A", after: new(int32(90)), location: path[0]},
+		{maneuverType: "turn", modifier: new("slight left"), roadName: "B", before: new(int32(90)), after: new(int32(80)), location: path[1]},
+		{maneuverType: "arrive", roadName: "B", before: new(int32(80)), location: path[len(path)-1]},
+	}
+	for _, s := range steps {
+		location := Geometry{Geometry: s.location}
+		if err := r.AddCoursePoint(nil, nil, nil, new("instruction"), new(s.roadName), new(s.maneuverType), s.modifier, &location, s.before, s.after); err != nil {
+			t.Fatalf("AddCoursePoint() error = %v", err)
+		}
+		if err := r.AddWaypoint(location); err != nil {
+			t.Fatalf("AddWaypoint() error = %v", err)
+		}
+	}
+	// AddCoursePointで距離が0に再計算されるため元に戻す
+	r.distance, r.duration = 2000, 600
+	return r
+}
+
+var testEditPath = orb.LineString{{139.00, 35.0}, {139.01, 35.0}, {139.02, 35.0}}
+
+func approxEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestRoute_Reverse(t *testing.T) {
+	r := newTestRouteForEdit(t, testEditPath)
+	total := lineLength(testEditPath)
+
+	if err := r.Reverse(); err != nil {
+		t.Fatalf("Reverse() error = %v", err)
+	}
+
+	if r.FirstPoint().Geometry != testEditPath[2] || r.LastPoint().Geometry != testEditPath[0] {
+		t.Errorf("FirstPoint/LastPoint = %v/%v, want reversed", r.FirstPoint().Geometry, r.LastPoint().Geometry)
+	}
+	if r.ElevationGain() != 80 || r.ElevationLoss() != 100 {
+		t.Errorf("ElevationGain/Loss = %v/%v, want 80/100", r.ElevationGain(), r.ElevationLoss())
+	}
+	if !approxEqual(r.Distance(), total, 0.01) {
+		t.Errorf("Distance = %v, want %v", r.Distance(), total)
+	}
+
+	cps := r.CoursePoints()
+	if len(cps) != 3 {
+		t.Fatalf("len(CoursePoints) = %d, want 3", len(cps))
+	}
+	if *cps[0].ManeuverType() != "depart" || *cps[2].ManeuverType() != "arrive" {
+		t.Errorf("maneuver types = %s/%s, want depart/arrive", *cps[0].ManeuverType(), *cps[2].ManeuverType())
+	}
+	turn := cps[1]
+	if *turn.Modifier() != "slight right" {
+		t.Errorf("Modifier = %s, want slight right", *turn.Modifier())
+	}
+	if *turn.BearingBefore() != 260 || *turn.BearingAfter() != 270 {
+		t.Errorf("BearingBefore/After = %d/%d, want 260/270", *turn.BearingBefore(), *turn.BearingAfter())
+	}
+	if *turn.RoadName() != "A" {
+		t.Errorf("RoadName = %s, want A", *turn.RoadName())
+	}
+	if turn.Instruction() != nil {
+		t.Errorf("Instruction = %v, want nil", *turn.Instruction())
+	}
+	if *cps[0].BearingAfter() != 260 || cps[0].BearingBefore() != nil {
+		t.Errorf("depart bearings = %v/%d, want nil/260", cps[0].BearingBefore(), *cps[0].BearingAfter())
+	}
+	for i, cp := range cps {
+		if cp.StepOrder() != int32(i) {
+			t.Errorf("cps[%d].StepOrder = %d", i, cp.StepOrder())
+		}
+	}
+	if !approxEqual(*turn.CumDistM(), total/2, 1) || !approxEqual(*cps[2].CumDistM(), total, 1) {
+		t.Errorf("CumDistM = %v/%v, want %v/%v", *turn.CumDistM(), *cps[2].CumDistM(), total/2, total)
+	}
+
+	wps := r.Waypoints()
+	if wps[0].Location().Geometry != testEditPath[2] || wps[2].Location().Geometry != testEditPath[0] {
+		t.Errorf("waypoints are not reversed: %v", wps)
+	}
+}
+
+func TestRoute_Trim(t *testing.T) {
+	total := lineLength(testEditPath)
+
+	t.Run("正常系: 区間外のコースポイントを取り除き、距離を振り直す", func(t *testing.T) {
+		r := newTestRouteForEdit(t, testEditPath)
+		from := total / 4
+		if err := r.Trim(from, total); err != nil {
+			t.Fatalf("Trim() error = %v", err)
+		}
+
+		if !approxEqual(r.Distance(), total*3/4, 1) {
+			t.Errorf("Distance = %v, want %v", r.Distance(), total*3/4)
+		}
+		if !approxEqual(r.Duration(), 450, 1) || !approxEqual(r.ElevationGain(), 75, 0.1) {
+			t.Errorf("Duration/ElevationGain = %v/%v, want 450/75", r.Duration(), r.ElevationGain())
+		}
+		start := r.FirstPoint().Geometry.(orb.Point)
+		if !approxEqual(start.Lon(), 139.005, 1e-6) {
+			t.Errorf("FirstPoint = %v, want lon 139.005", start)
+		}
+
+		cps := r.CoursePoints()
+		if len(cps) != 2 || *cps[0].ManeuverType() != "turn" {
+			t.Fatalf("CoursePoints = %d, want turn and arrive", len(cps))
+		}
+		if !approxEqual(*cps[0].CumDistM(), total/4, 1) {
+			t.Errorf("CumDistM = %v, want %v", *cps[0].CumDistM(), total/4)
+		}
+		if !approxEqual(*cps[0].SegDistM()+*cps[1].SegDistM(), r.Distance()-*cps[0].CumDistM(), 0.01) {
+			t.Errorf("SegDistM does not add up to the remaining distance")
+		}
+
+		wps := r.Waypoints()
+		if len(wps) != 3 || wps[0].Location().Geometry != start {
+			t.Errorf("Waypoints = %d, want new start, middle and end", len(wps))
+		}
+	})
+
+	t.Run("異常系: 範囲が不正", func(t *testing.T) {
+		r := newTestRouteForEdit(t, testEditPath)
+		if err := r.Trim(500, 100); !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("Trim() error = %v, want ErrValidation", err)
+		}
+		if err := r.Trim(-1, 100); !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("Trim() error = %v, want ErrValidation", err)
+		}
+	})
+}
+
+func TestRoute_SplitAt(t *testing.T) {
+	total := lineLength(testEditPath)
+
+	t.Run("正常系: 分割点の前後で2つのルートになる", func(t *testing.T) {
+		r := newTestRouteForEdit(t, testEditPath)
+		at := Geometry{Geometry: orb.Point{139.015, 35.0001}}
+
+		latter, err := r.SplitAt(at, "")
+		if err != nil {
+			t.Fatalf("SplitAt() error = %v", err)
+		}
+
+		if latter.Name() != "Route (2)" || latter.UserID() != r.UserID() || latter.ID() == r.ID() {
+			t.Errorf("latter Name/UserID/ID = %s/%s/%s", latter.Name(), latter.UserID(), latter.ID())
+		}
+		if !approxEqual(r.Distance(), total*3/4, 1) || !approxEqual(latter.Distance(), total/4, 1) {
+			t.Errorf("Distance = %v/%v, want %v/%v", r.Distance(), latter.Distance(), total*3/4, total/4)
+		}
+		if r.LastPoint().Geometry != latter.FirstPoint().Geometry {
+			t.Errorf("split point mismatch: %v/%v", r.LastPoint().Geometry, latter.FirstPoint().Geometry)
+		}
+		if len(r.CoursePoints()) != 2 || len(latter.CoursePoints()) != 1 {
+			t.Errorf("CoursePoints = %d/%d, want 2/1", len(r.CoursePoints()), len(latter.CoursePoints()))
+		}
+		for _, cp := range latter.CoursePoints() {
+			if cp.RouteID() != latter.ID() {
+				t.Errorf("course point RouteID = %s, want %s", cp.RouteID(), latter.ID())
+			}
+			for _, original := range r.CoursePoints() {
+				if cp.ID() == original.ID() {
+					t.Errorf("course point ID %s is shared between split routes", cp.ID())
+				}
+			}
+		}
+	})
+
+	t.Run("正常系: クラブのルートは後半も同じクラブに共有する", func(t *testing.T) {
+		const clubID = "019b5a66-0000-7000-8000-000000000001"
+		r := newTestRouteForEdit(t, testEditPath)
+		if err := r.ChangeVisibility(NewViewer(r.UserID(), []string{clubID}), VisibilityClub, new(clubID)); err != nil {
+			t.Fatal(err)
+		}
+
+		latter, err := r.SplitAt(Geometry{Geometry: orb.Point{139.015, 35.0001}}, "")
+		if err != nil {
+			t.Fatalf("SplitAt() error = %v", err)
+		}
+		if latter.Visibility() != VisibilityClub || latter.ClubID() == nil || *latter.ClubID() != clubID {
+			t.Errorf("latter Visibility/ClubID = %d/%v, want %d/%s", latter.Visibility(), latter.ClubID(), VisibilityClub, clubID)
+		}
+		// 同じクラブのメンバーは後半のルートも閲覧できる
+		if !latter.IsVisibleTo(NewViewer("019b5a46-1e77-7b9d-ac62-b438a0fc89cb", []string{clubID})) {
+			t.Error("latter should be visible to club members")
+		}
+	})
+
+	t.Run("異常系: 端点では分割できない", func(t *testing.T) {
+		r := newTestRouteForEdit(t, testEditPath)
+		if _, err := r.SplitAt(Geometry{Geometry: testEditPath[0]}, ""); !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("SplitAt() error = %v, want ErrValidation", err)
+		}
+	})
+}
+
+func TestRoute_Join(t *testing.T) {
+	first := newTestRouteForEdit(t, testEditPath)
+	secondPath := orb.LineString{{139.02, 35.0}, {139.02, 35.01}}
+	second := newTestRouteForEdit(t, append(secondPath, orb.Point{139.02, 35.02}))
+	second.userID = first.userID
+	firstLength := lineLength(testEditPath)
+	secondLength := lineLength(second.PathGeom().Geometry.(orb.LineString))
+
+	if err := first.Join(second); err != nil {
+		t.Fatalf("Join() error = %v", err)
+	}
+
+	path := first.PathGeom().Geometry.(orb.LineString)
+	if len(path) != 5 {
+		t.Errorf("len(path) = %d, want 5 (shared end point is merged)", len(path))
+	}
+	if !approxEqual(first.Distance(), firstLength+secondLength, 0.01) {
+		t.Errorf("Distance = %v, want %v", first.Distance(), firstLength+secondLength)
+	}
+	if first.Duration() != 1200 || first.ElevationGain() != 200 {
+		t.Errorf("Duration/ElevationGain = %v/%v, want 1200/200", first.Duration(), first.ElevationGain())
+	}
+
+	cps := first.CoursePoints()
+	if len(cps) != 4 {
+		t.Fatalf("len(CoursePoints) = %d, want 4 (arrive and depart at the joint are removed)", len(cps))
+	}
+	if *cps[0].ManeuverType() != "depart" || *cps[3].ManeuverType() != "arrive" {
+		t.Errorf("maneuver types = %s/%s, want depart/arrive", *cps[0].ManeuverType(), *cps[3].ManeuverType())
+	}
+	if !approxEqual(*cps[2].CumDistM(), firstLength+secondLength/2, 1) {
+		t.Errorf("CumDistM = %v, want %v", *cps[2].CumDistM(), firstLength+secondLength/2)
+	}
+	if len(first.Waypoints()) != 5 {
+		t.Errorf("len(Waypoints) = %d, want 5", len(first.Waypoints()))
+	}
+
+	// 結合したルートは変更しない
+	if len(second.CoursePoints()) != 3 {
+		t.Errorf("joined route should not be modified")
+	}
+
+	other := newTestRouteForEdit(t, secondPath)
+	other.userID = user.NewUserID().String()
+	if err := first.Join(other); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("Join() with other user's route error = %v, want ErrValidation", err)
+	}
+	if err := first.Join(first); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("Join() with itself error = %v, want ErrValidation", err)
+	}
+}
@@ -0,0 +1,48 @@
+package route
+
+import (
+	"context"
+	"fmt"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+// MatchedSegment は照合した経路のうち、1つの道路の区間を通る部分
+type MatchedSegment struct {
+	RoadName *string
+	Highway  string  // OSMのhighwayタグ
+	Surface  *string // OSMのsurfaceタグ
+	Distance float64 // 長さ(m)
+	Path     orb.LineString
+}
+
+// MatchedRoute は記録された軌跡を道路網に照合した結果
+// 曲がり角の案内は照合した道路から作るため、探索した経路と同じようにコースポイントにできる
+type MatchedRoute struct {
+	PlannedRoute
+	Segments      []MatchedSegment
+	MatchedPoints int // 道路に照合できたGPSの点の数
+	TotalPoints   int
+}
+
+// MapMatcher はGPSの軌跡を道路網に照合する
+type MapMatcher interface {
+	Match(ctx context.Context, track orb.LineString) (*MatchedRoute, error)
+}
+
+// ValidateMatchTrack は照合する軌跡を検証する
+func ValidateMatchTrack(track orb.LineString) error {
+	if len(track) < 2 {
+		return domainerror.New("track must have at least 2 points", domainerror.ErrValidation)
+	}
+	for _, p := range track {
+		if p.Lon() < -180 || p.Lon() > 180 || p.Lat() < -90 || p.Lat() > 90 {
+			return domainerror.New("track point is out of range", domainerror.ErrValidation)
+		}
+	}
+	if !withinRoutingSpan(track.Bound()) {
+		return domainerror.New(fmt.Sprintf("track must fit within %g degrees of longitude and latitude", MaxRoutingSpan), domainerror.ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestValidateMatchTrack(t *testing.T) {
+	tests := []struct {
+		name    string
+		track   orb.LineString
+		wantErr bool
+	}{
+		{name: "正常系: 2点の軌跡", track: orb.LineString{{139.70, 35.68}, {139.71, 35.69}}},
+		{name: "異常系: 1点のみ", track: orb.LineString{{139.70, 35.68}}, wantErr: true},
+		{name: "異常系: 経度が範囲外", track: orb.LineString{{139.70, 35.68}, {181, 35.69}}, wantErr: true},
+		{name: "異常系: 範囲の幅が上限を超える", track: orb.LineString{{139.70, 35.68}, {139.75, 35.70}, {141.0, 35.70}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMatchTrack(tt.track)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateMatchTrack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -40,6 +40,35 @@ func (m *MockIRouteRepository) EXPECT() *MockIRouteRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddSavedRoute mocks base method.
+func (m *MockIRouteRepository) AddSavedRoute(ctx context.Context, userID, routeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSavedRoute", ctx, userID, routeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSavedRoute indicates an expected call of AddSavedRoute.
+func (mr *MockIRouteRepositoryMockRecorder) AddSavedRoute(ctx, userID, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSavedRoute", reflect.TypeOf((*MockIRouteRepository)(nil).AddSavedRoute), ctx, userID, routeID)
+}
+
+// CountForks mocks base method.
+func (m *MockIRouteRepository) CountForks(ctx context.Context, routeID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountForks", ctx, routeID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountForks indicates an expected call of CountForks.
+func (mr *MockIRouteRepositoryMockRecorder) CountForks(ctx, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountForks", reflect.TypeOf((*MockIRouteRepository)(nil).CountForks), ctx, routeID)
+}
+
 // CountRoutesByUserID mocks base method.
 func (m *MockIRouteRepository) CountRoutesByUserID(ctx context.Context, userID string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +84,20 @@ func (mr *MockIRouteRepositoryMockRecorder) CountRoutesByUserID(ctx, userID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRoutesByUserID", reflect.TypeOf((*MockIRouteRepository)(nil).CountRoutesByUserID), ctx, userID)
 }
 
+// DeleteComment mocks base method.
+func (m *MockIRouteRepository) DeleteComment(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockIRouteRepositoryMockRecorder) DeleteComment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockIRouteRepository)(nil).DeleteComment), ctx, id)
+}
+
 // DeleteRoute mocks base method.
 func (m *MockIRouteRepository) DeleteRoute(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -70,10 +113,10 @@ func (mr *MockIRouteRepositoryMockRecorder) DeleteRoute(ctx, id any) *gomock.Cal
 }
 
 // ExploreRoutes mocks base method.
-func (m *MockIRouteRepository) ExploreRoutes(ctx context.Context, criteria *ExploreRoutesCriteria) ([]*ExploreRouteResult, error) {
+func (m *MockIRouteRepository) ExploreRoutes(ctx context.Context, criteria *ExploreRoutesCriteria) (*RoutePage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ExploreRoutes", ctx, criteria)
-	ret0, _ := ret[0].([]*ExploreRouteResult)
+	ret0, _ := ret[0].(*RoutePage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -84,6 +127,66 @@ func (mr *MockIRouteRepositoryMockRecorder) ExploreRoutes(ctx, criteria any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExploreRoutes", reflect.TypeOf((*MockIRouteRepository)(nil).ExploreRoutes), ctx, criteria)
 }
 
+// FindSimilarRouteCandidates mocks base method.
+func (m *MockIRouteRepository) FindSimilarRouteCandidates(ctx context.Context, criteria *SimilarRouteCandidatesCriteria) ([]*ExploreRouteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilarRouteCandidates", ctx, criteria)
+	ret0, _ := ret[0].([]*ExploreRouteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilarRouteCandidates indicates an expected call of FindSimilarRouteCandidates.
+func (mr *MockIRouteRepositoryMockRecorder) FindSimilarRouteCandidates(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilarRouteCandidates", reflect.TypeOf((*MockIRouteRepository)(nil).FindSimilarRouteCandidates), ctx, criteria)
+}
+
+// GetComment mocks base method.
+func (m *MockIRouteRepository) GetComment(ctx context.Context, id string) (*Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComment", ctx, id)
+	ret0, _ := ret[0].(*Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComment indicates an expected call of GetComment.
+func (mr *MockIRouteRepositoryMockRecorder) GetComment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockIRouteRepository)(nil).GetComment), ctx, id)
+}
+
+// GetComments mocks base method.
+func (m *MockIRouteRepository) GetComments(ctx context.Context, criteria *CommentCriteria) (*CommentPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComments", ctx, criteria)
+	ret0, _ := ret[0].(*CommentPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComments indicates an expected call of GetComments.
+func (mr *MockIRouteRepositoryMockRecorder) GetComments(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockIRouteRepository)(nil).GetComments), ctx, criteria)
+}
+
+// GetCommentsByIDs mocks base method.
+func (m *MockIRouteRepository) GetCommentsByIDs(ctx context.Context, ids []string) ([]*Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentsByIDs indicates an expected call of GetCommentsByIDs.
+func (mr *MockIRouteRepositoryMockRecorder) GetCommentsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByIDs", reflect.TypeOf((*MockIRouteRepository)(nil).GetCommentsByIDs), ctx, ids)
+}
+
 // GetRouteByID mocks base method.
 func (m *MockIRouteRepository) GetRouteByID(ctx context.Context, id string) (*Route, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +202,66 @@ func (mr *MockIRouteRepositoryMockRecorder) GetRouteByID(ctx, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteByID", reflect.TypeOf((*MockIRouteRepository)(nil).GetRouteByID), ctx, id)
 }
 
+// GetRouteGrades mocks base method.
+func (m *MockIRouteRepository) GetRouteGrades(ctx context.Context, routeID string) ([]GradeSample, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRouteGrades", ctx, routeID)
+	ret0, _ := ret[0].([]GradeSample)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRouteGrades indicates an expected call of GetRouteGrades.
+func (mr *MockIRouteRepositoryMockRecorder) GetRouteGrades(ctx, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteGrades", reflect.TypeOf((*MockIRouteRepository)(nil).GetRouteGrades), ctx, routeID)
+}
+
+// GetRouteVersion mocks base method.
+func (m *MockIRouteRepository) GetRouteVersion(ctx context.Context, routeID string, versionNumber int32) (*RouteVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRouteVersion", ctx, routeID, versionNumber)
+	ret0, _ := ret[0].(*RouteVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRouteVersion indicates an expected call of GetRouteVersion.
+func (mr *MockIRouteRepositoryMockRecorder) GetRouteVersion(ctx, routeID, versionNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteVersion", reflect.TypeOf((*MockIRouteRepository)(nil).GetRouteVersion), ctx, routeID, versionNumber)
+}
+
+// GetRouteVersions mocks base method.
+func (m *MockIRouteRepository) GetRouteVersions(ctx context.Context, routeID string) ([]*RouteVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRouteVersions", ctx, routeID)
+	ret0, _ := ret[0].([]*RouteVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRouteVersions indicates an expected call of GetRouteVersions.
+func (mr *MockIRouteRepositoryMockRecorder) GetRouteVersions(ctx, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteVersions", reflect.TypeOf((*MockIRouteRepository)(nil).GetRouteVersions), ctx, routeID)
+}
+
+// GetRoutesByIDs mocks base method.
+func (m *MockIRouteRepository) GetRoutesByIDs(ctx context.Context, ids []string) ([]*ExploreRouteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoutesByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*ExploreRouteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoutesByIDs indicates an expected call of GetRoutesByIDs.
+func (mr *MockIRouteRepositoryMockRecorder) GetRoutesByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutesByIDs", reflect.TypeOf((*MockIRouteRepository)(nil).GetRoutesByIDs), ctx, ids)
+}
+
 // GetRoutesByUserID mocks base method.
 func (m *MockIRouteRepository) GetRoutesByUserID(ctx context.Context, userID string) ([]*Route, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +277,107 @@ func (mr *MockIRouteRepositoryMockRecorder) GetRoutesByUserID(ctx, userID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutesByUserID", reflect.TypeOf((*MockIRouteRepository)(nil).GetRoutesByUserID), ctx, userID)
 }
 
+// GetRoutesInCollection mocks base method.
+func (m *MockIRouteRepository) GetRoutesInCollection(ctx context.Context, collectionID string) ([]*ExploreRouteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoutesInCollection", ctx, collectionID)
+	ret0, _ := ret[0].([]*ExploreRouteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoutesInCollection indicates an expected call of GetRoutesInCollection.
+func (mr *MockIRouteRepositoryMockRecorder) GetRoutesInCollection(ctx, collectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutesInCollection", reflect.TypeOf((*MockIRouteRepository)(nil).GetRoutesInCollection), ctx, collectionID)
+}
+
+// GetSavedRoutes mocks base method.
+func (m *MockIRouteRepository) GetSavedRoutes(ctx context.Context, criteria *SavedRouteCriteria) (*RoutePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedRoutes", ctx, criteria)
+	ret0, _ := ret[0].(*RoutePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedRoutes indicates an expected call of GetSavedRoutes.
+func (mr *MockIRouteRepositoryMockRecorder) GetSavedRoutes(ctx, criteria any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedRoutes", reflect.TypeOf((*MockIRouteRepository)(nil).GetSavedRoutes), ctx, criteria)
+}
+
+// GetSurfaceBreakdown mocks base method.
+func (m *MockIRouteRepository) GetSurfaceBreakdown(ctx context.Context, routeID string) (*SurfaceBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSurfaceBreakdown", ctx, routeID)
+	ret0, _ := ret[0].(*SurfaceBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSurfaceBreakdown indicates an expected call of GetSurfaceBreakdown.
+func (mr *MockIRouteRepositoryMockRecorder) GetSurfaceBreakdown(ctx, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurfaceBreakdown", reflect.TypeOf((*MockIRouteRepository)(nil).GetSurfaceBreakdown), ctx, routeID)
+}
+
+// LikeRoute mocks base method.
+func (m *MockIRouteRepository) LikeRoute(ctx context.Context, userID, routeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikeRoute", ctx, userID, routeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LikeRoute indicates an expected call of LikeRoute.
+func (mr *MockIRouteRepositoryMockRecorder) LikeRoute(ctx, userID, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeRoute", reflect.TypeOf((*MockIRouteRepository)(nil).LikeRoute), ctx, userID, routeID)
+}
+
+// RefreshSurfaceBreakdown mocks base method.
+func (m *MockIRouteRepository) RefreshSurfaceBreakdown(ctx context.Context, routeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSurfaceBreakdown", ctx, routeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshSurfaceBreakdown indicates an expected call of RefreshSurfaceBreakdown.
+func (mr *MockIRouteRepositoryMockRecorder) RefreshSurfaceBreakdown(ctx, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSurfaceBreakdown", reflect.TypeOf((*MockIRouteRepository)(nil).RefreshSurfaceBreakdown), ctx, routeID)
+}
+
+// RemoveSavedRoute mocks base method.
+func (m *MockIRouteRepository) RemoveSavedRoute(ctx context.Context, userID, routeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSavedRoute", ctx, userID, routeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSavedRoute indicates an expected call of RemoveSavedRoute.
+func (mr *MockIRouteRepositoryMockRecorder) RemoveSavedRoute(ctx, userID, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSavedRoute", reflect.TypeOf((*MockIRouteRepository)(nil).RemoveSavedRoute), ctx, userID, routeID)
+}
+
+// SaveComment mocks base method.
+func (m *MockIRouteRepository) SaveComment(ctx context.Context, comment *Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveComment indicates an expected call of SaveComment.
+func (mr *MockIRouteRepositoryMockRecorder) SaveComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveComment", reflect.TypeOf((*MockIRouteRepository)(nil).SaveComment), ctx, comment)
+}
+
 // SaveRoute mocks base method.
 func (m *MockIRouteRepository) SaveRoute(ctx context.Context, route *Route) error {
 	m.ctrl.T.Helper()
@@ -128,11 +392,81 @@ func (mr *MockIRouteRepositoryMockRecorder) SaveRoute(ctx, route any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRoute", reflect.TypeOf((*MockIRouteRepository)(nil).SaveRoute), ctx, route)
 }
 
+// SaveRouteDifficulty mocks base method.
+func (m *MockIRouteRepository) SaveRouteDifficulty(ctx context.Context, route *Route) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRouteDifficulty", ctx, route)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRouteDifficulty indicates an expected call of SaveRouteDifficulty.
+func (mr *MockIRouteRepositoryMockRecorder) SaveRouteDifficulty(ctx, route any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRouteDifficulty", reflect.TypeOf((*MockIRouteRepository)(nil).SaveRouteDifficulty), ctx, route)
+}
+
+// SaveRouteImages mocks base method.
+func (m *MockIRouteRepository) SaveRouteImages(ctx context.Context, routeID string, images []*RouteImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRouteImages", ctx, routeID, images)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRouteImages indicates an expected call of SaveRouteImages.
+func (mr *MockIRouteRepositoryMockRecorder) SaveRouteImages(ctx, routeID, images any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRouteImages", reflect.TypeOf((*MockIRouteRepository)(nil).SaveRouteImages), ctx, routeID, images)
+}
+
+// SaveRoutePlaces mocks base method.
+func (m *MockIRouteRepository) SaveRoutePlaces(ctx context.Context, route *Route) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRoutePlaces", ctx, route)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRoutePlaces indicates an expected call of SaveRoutePlaces.
+func (mr *MockIRouteRepositoryMockRecorder) SaveRoutePlaces(ctx, route any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRoutePlaces", reflect.TypeOf((*MockIRouteRepository)(nil).SaveRoutePlaces), ctx, route)
+}
+
+// SaveRouteTags mocks base method.
+func (m *MockIRouteRepository) SaveRouteTags(ctx context.Context, route *Route) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRouteTags", ctx, route)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRouteTags indicates an expected call of SaveRouteTags.
+func (mr *MockIRouteRepositoryMockRecorder) SaveRouteTags(ctx, route any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRouteTags", reflect.TypeOf((*MockIRouteRepository)(nil).SaveRouteTags), ctx, route)
+}
+
+// SaveRouteVersion mocks base method.
+func (m *MockIRouteRepository) SaveRouteVersion(ctx context.Context, version *RouteVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRouteVersion", ctx, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRouteVersion indicates an expected call of SaveRouteVersion.
+func (mr *MockIRouteRepositoryMockRecorder) SaveRouteVersion(ctx, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRouteVersion", reflect.TypeOf((*MockIRouteRepository)(nil).SaveRouteVersion), ctx, version)
+}
+
 // SearchRoutesByUserID mocks base method.
-func (m *MockIRouteRepository) SearchRoutesByUserID(ctx context.Context, criteria *RouteSearchCriteria) ([]*Route, error) {
+func (m *MockIRouteRepository) SearchRoutesByUserID(ctx context.Context, criteria *RouteSearchCriteria) (*RoutePage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchRoutesByUserID", ctx, criteria)
-	ret0, _ := ret[0].([]*Route)
+	ret0, _ := ret[0].(*RoutePage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -143,6 +477,20 @@ func (mr *MockIRouteRepositoryMockRecorder) SearchRoutesByUserID(ctx, criteria a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRoutesByUserID", reflect.TypeOf((*MockIRouteRepository)(nil).SearchRoutesByUserID), ctx, criteria)
 }
 
+// UnlikeRoute mocks base method.
+func (m *MockIRouteRepository) UnlikeRoute(ctx context.Context, userID, routeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlikeRoute", ctx, userID, routeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlikeRoute indicates an expected call of UnlikeRoute.
+func (mr *MockIRouteRepositoryMockRecorder) UnlikeRoute(ctx, userID, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlikeRoute", reflect.TypeOf((*MockIRouteRepository)(nil).UnlikeRoute), ctx, userID, routeID)
+}
+
 // UpdateRoute mocks base method.
 func (m *MockIRouteRepository) UpdateRoute(ctx context.Context, route *Route) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/route/visibility.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/route/visibility.go -destination=internal/domain/route/mock_visibility.go -package route
+//
+
+// Package route is a generated GoMock package.
+package route
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClubMembershipReader is a mock of ClubMembershipReader interface.
+type MockClubMembershipReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockClubMembershipReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockClubMembershipReaderMockRecorder is the mock recorder for MockClubMembershipReader.
+type MockClubMembershipReaderMockRecorder struct {
+	mock *MockClubMembershipReader
+}
+
+// NewMockClubMembershipReader creates a new mock instance.
+func NewMockClubMembershipReader(ctrl *gomock.Controller) *MockClubMembershipReader {
+	mock := &MockClubMembershipReader{ctrl: ctrl}
+	mock.recorder = &MockClubMembershipReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClubMembershipReader) EXPECT() *MockClubMembershipReaderMockRecorder {
+	return m.recorder
+}
+
+// GetClubIDsByUserID mocks base method.
+func (m *MockClubMembershipReader) GetClubIDsByUserID(ctx context.Context, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClubIDsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClubIDsByUserID indicates an expected call of GetClubIDsByUserID.
+func (mr *MockClubMembershipReaderMockRecorder) GetClubIDsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClubIDsByUserID", reflect.TypeOf((*MockClubMembershipReader)(nil).GetClubIDsByUserID), ctx, userID)
+}
@@ -0,0 +1,38 @@
+package route
+
+import (
+	"context"
+	"errors"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	"github.com/paulmach/orb"
+)
+
+// ResolvePlaces は出発地点と目的地の地名を geocoder で求め直す
+// 出発地点や目的地を変更したときに呼び出す。行政区域の外にある地点の地名はnilにする
+func (r *Route) ResolvePlaces(ctx context.Context, geocoder place.Geocoder) error {
+	start, err := reverseGeocode(ctx, geocoder, r.firstPoint)
+	if err != nil {
+		return err
+	}
+	end, err := reverseGeocode(ctx, geocoder, r.lastPoint)
+	if err != nil {
+		return err
+	}
+	r.startPlace = start
+	r.endPlace = end
+	return nil
+}
+
+func reverseGeocode(ctx context.Context, geocoder place.Geocoder, location Geometry) (*place.Place, error) {
+	point, ok := location.Geometry.(orb.Point)
+	if !ok {
+		return nil, nil
+	}
+	p, err := geocoder.ReverseGeocode(ctx, point)
+	if errors.Is(err, domainerror.ErrNotFound) {
+		return nil, nil
+	}
+	return p, err
+}
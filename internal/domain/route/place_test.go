@@ -0,0 +1,58 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRoute_ResolvePlaces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	geocoder := place.NewMockGeocoder(ctrl)
+	r := newTestRouteForFork(t, user.NewUserID().String(), VisibilityPublic)
+
+	kyoto := place.ReconstructPlace("京都市", "京都府", "JP")
+	geocoder.EXPECT().ReverseGeocode(gomock.Any(), orb.Point{139.0, 35.0}).Return(kyoto, nil)
+	// 目的地は行政区域の外
+	geocoder.EXPECT().ReverseGeocode(gomock.Any(), orb.Point{139.1, 35.1}).Return(nil, domainerror.New("place not found", domainerror.ErrNotFound))
+
+	if err := r.ResolvePlaces(context.Background(), geocoder); err != nil {
+		t.Fatalf("ResolvePlaces() error = %v", err)
+	}
+	if r.StartPlace() != kyoto || r.EndPlace() != nil {
+		t.Errorf("places = %+v, %+v", r.StartPlace(), r.EndPlace())
+	}
+
+	// フォークしたルートは地名を引き継ぐ
+	forked, err := r.Fork(NewViewer(user.NewUserID().String(), nil))
+	if err != nil {
+		t.Fatalf("Fork() error = %v", err)
+	}
+	if forked.StartPlace() != kyoto {
+		t.Errorf("forked StartPlace() = %+v", forked.StartPlace())
+	}
+}
+
+func TestRoute_ResolvePlaces_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	geocoder := place.NewMockGeocoder(ctrl)
+	r := newTestRouteForFork(t, user.NewUserID().String(), VisibilityPublic)
+	r.SetPlaces(place.ReconstructPlace("京都市", "京都府", "JP"), nil)
+
+	dbErr := errors.New("connection refused")
+	geocoder.EXPECT().ReverseGeocode(gomock.Any(), gomock.Any()).Return(nil, dbErr)
+
+	if err := r.ResolvePlaces(context.Background(), geocoder); !errors.Is(err, dbErr) {
+		t.Fatalf("ResolvePlaces() error = %v, want %v", err, dbErr)
+	}
+	// 失敗した場合は元の地名を残す
+	if r.StartPlace() == nil || r.StartPlace().Locality() != "京都市" {
+		t.Errorf("StartPlace() = %+v", r.StartPlace())
+	}
+}
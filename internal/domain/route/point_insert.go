@@ -0,0 +1,86 @@
+package route
+
+import (
+	"slices"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+// ManeuverPOI はルート沿いのPOIから追加したコースポイントの操作タイプ
+// 修飾子にはPOIの種類（cafe, drinking_waterなど）を入れる
+const ManeuverPOI = "poi"
+
+// InsertWaypoint は経路に沿った順序になる位置にウェイポイントを追加する
+// 始点と終点のウェイポイントの間に入れる。経路は変更しないため、経由地として通るには探索し直す
+func (r *Route) InsertWaypoint(location Geometry) error {
+	path, err := r.editablePath()
+	if err != nil {
+		return err
+	}
+	point, ok := location.Geometry.(orb.Point)
+	if !ok {
+		return domainerror.New("waypoint location must be a Point", domainerror.ErrValidation)
+	}
+	for _, wp := range r.waypoints {
+		if samePoint(wp.location, location) {
+			return domainerror.New("waypoint already exists at the location", domainerror.ErrValidation)
+		}
+	}
+
+	measure := locateOnLine(path, point, 0)
+	index := len(r.waypoints)
+	for i, m := range locatePoints(path, waypointLocations(r.waypoints)) {
+		if m > measure {
+			index = i
+			break
+		}
+	}
+	if len(r.waypoints) >= 2 {
+		index = max(1, min(index, len(r.waypoints)-1))
+	}
+
+	r.waypoints = slices.Insert(r.waypoints, index, r.newWaypoint(point))
+	return nil
+}
+
+// InsertCoursePoint は location を経路に射影した位置にコースポイントを追加する
+// 地点は location のままにし、前後のコースポイントの区間距離・所要時間を振り直す
+func (r *Route) InsertCoursePoint(location Geometry, maneuverType string, modifier *string, instruction *string) error {
+	path, err := r.editablePath()
+	if err != nil {
+		return err
+	}
+	point, ok := location.Geometry.(orb.Point)
+	if !ok {
+		return domainerror.New("course point location must be a Point", domainerror.ErrValidation)
+	}
+	for _, cp := range r.coursePoints {
+		if cp.location != nil && isManeuverType(cp.maneuverType, maneuverType) && samePoint(*cp.location, location) {
+			return domainerror.New("course point already exists at the location", domainerror.ErrValidation)
+		}
+	}
+
+	measure := locateOnLine(path, point, 0)
+	located := r.locateCoursePoints(path)
+	// 同じ位置なら出発の後、到着の前に入れる
+	index := len(located)
+	for i, p := range located {
+		if p.measure > measure || isManeuverType(p.cp.maneuverType, maneuverArrive) {
+			index = i
+			break
+		}
+	}
+	cp := &CoursePoint{
+		id:           NewCoursePointID().String(),
+		routeID:      r.id,
+		instruction:  instruction,
+		maneuverType: &maneuverType,
+		modifier:     modifier,
+		location:     &location,
+	}
+	located = slices.Insert(located, index, locatedCoursePoint{cp: cp, measure: measure})
+
+	r.applyCoursePoints(located)
+	return nil
+}
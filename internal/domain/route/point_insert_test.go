@@ -0,0 +1,100 @@
+package route
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+func TestRoute_InsertWaypoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		location  orb.Point
+		wantIndex int
+		wantErr   bool
+	}{
+		// 経路から少し北に外れた地点
+		{name: "前半の地点は始点と中間点の間に入れる", location: orb.Point{139.005, 35.001}, wantIndex: 1},
+		{name: "後半の地点は中間点と終点の間に入れる", location: orb.Point{139.015, 35.001}, wantIndex: 2},
+		{name: "終点より先の地点も終点の前に入れる", location: orb.Point{139.03, 35.0}, wantIndex: 2},
+		{name: "異常系: 既にウェイポイントがある地点", location: testEditPath[1], wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRouteForEdit(t, testEditPath)
+
+			err := r.InsertWaypoint(Geometry{Geometry: tt.location})
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("InsertWaypoint() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("InsertWaypoint() error = %v", err)
+			}
+
+			wps := r.Waypoints()
+			if len(wps) != 4 {
+				t.Fatalf("len(Waypoints) = %d, want 4", len(wps))
+			}
+			if wps[tt.wantIndex].Location().Geometry != tt.location || wps[tt.wantIndex].RouteID() != r.ID() {
+				t.Errorf("Waypoints[%d] = %+v, want %v", tt.wantIndex, wps[tt.wantIndex], tt.location)
+			}
+			// 経路は変更しない
+			if r.PathGeom().Geometry.(orb.LineString)[1] != testEditPath[1] {
+				t.Error("InsertWaypoint() should not modify path")
+			}
+		})
+	}
+}
+
+func TestRoute_InsertCoursePoint(t *testing.T) {
+	r := newTestRouteForEdit(t, testEditPath)
+	total := lineLength(testEditPath)
+	location := Geometry{Geometry: orb.Point{139.015, 35.001}}
+
+	if err := r.InsertCoursePoint(location, ManeuverPOI, new("cafe"), new("喫茶")); err != nil {
+		t.Fatalf("InsertCoursePoint() error = %v", err)
+	}
+
+	cps := r.CoursePoints()
+	if len(cps) != 4 {
+		t.Fatalf("len(CoursePoints) = %d, want 4", len(cps))
+	}
+	poi := cps[2]
+	if *poi.ManeuverType() != ManeuverPOI || *poi.Modifier() != "cafe" || *poi.Instruction() != "喫茶" || poi.StepOrder() != 2 {
+		t.Errorf("CoursePoints[2] = %+v", poi)
+	}
+	// 地点はPOIの位置のまま、累積距離は経路に射影した位置にする
+	if poi.Location().Geometry != location.Geometry {
+		t.Errorf("Location = %v, want %v", poi.Location().Geometry, location.Geometry)
+	}
+	if !approxEqual(*poi.CumDistM(), total*0.75, 1) {
+		t.Errorf("CumDistM = %v, want %v", *poi.CumDistM(), total*0.75)
+	}
+	// 前のコースポイントの区間距離はPOIまでになる
+	if !approxEqual(*cps[1].SegDistM(), total*0.25, 1) || *cps[3].ManeuverType() != "arrive" {
+		t.Errorf("CoursePoints[1].SegDistM = %v, CoursePoints[3] = %s", *cps[1].SegDistM(), *cps[3].ManeuverType())
+	}
+
+	// 同じPOIは2回追加できない
+	if err := r.InsertCoursePoint(location, ManeuverPOI, new("cafe"), new("喫茶")); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("InsertCoursePoint() twice error = %v, want ErrValidation", err)
+	}
+}
+
+func TestRoute_InsertCoursePoint_AtEnd(t *testing.T) {
+	r := newTestRouteForEdit(t, testEditPath)
+
+	// 終点の先の地点は到着の前に入れる
+	if err := r.InsertCoursePoint(Geometry{Geometry: orb.Point{139.03, 35.0}}, ManeuverPOI, new("viewpoint"), nil); err != nil {
+		t.Fatalf("InsertCoursePoint() error = %v", err)
+	}
+	cps := r.CoursePoints()
+	if *cps[2].ManeuverType() != ManeuverPOI || *cps[3].ManeuverType() != "arrive" {
+		t.Errorf("maneuver types = %s/%s, want poi/arrive", *cps[2].ManeuverType(), *cps[3].ManeuverType())
+	}
+}
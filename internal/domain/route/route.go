@@ -2,8 +2,12 @@ package route
 
 import (
 	"errors"
+	"slices"
+	"strings"
 
 	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
 	"github.com/google/uuid"
 	"github.com/paulmach/orb"
 )
@@ -161,8 +165,16 @@ type Route struct {
 	lastPoint          Geometry
 	polyline           string
 	visibility         int16
+	version            int32   // 楽観的排他制御用。保存済みの状態を読み込んだ時点の値を保持する
+	forkedFromRouteID  *string // フォーク元のルートID
 	createdAt          string
 	updatedAt          string
+	surfaceBreakdown   *SurfaceBreakdown // 保存時に道路網から作る路面の内訳。未作成の場合はnil
+	startPlace         *place.Place      // 出発地点の地名。分からない場合はnil
+	endPlace           *place.Place      // 目的地の地名。分からない場合はnil
+	difficulty         Difficulty        // 保存時に路面の内訳・勾配と合わせて求める難易度
+	tags               []string          // ユーザーが付けた自由なタグ。NormalizeTagsで正規化済み
+	clubID             *string           // 公開範囲がクラブのメンバーのみの場合の共有先
 
 	// 集約内のエンティティコレクション
 	coursePoints []*CoursePoint
@@ -237,6 +249,7 @@ func newRoute(
 		lastPoint:          lastPoint,
 		polyline:           "",
 		visibility:         visibility,
+		version:            1,
 		coursePoints:       []*CoursePoint{},
 		waypoints:          []*Waypoint{},
 	}, nil
@@ -444,6 +457,18 @@ func (r *Route) Visibility() int16 {
 	return r.visibility
 }
 
+func (r *Route) ClubID() *string {
+	return r.clubID
+}
+
+func (r *Route) Version() int32 {
+	return r.version
+}
+
+func (r *Route) ForkedFromRouteID() *string {
+	return r.forkedFromRouteID
+}
+
 func (r *Route) CreatedAt() string {
 	return r.createdAt
 }
@@ -452,6 +477,37 @@ func (r *Route) UpdatedAt() string {
 	return r.updatedAt
 }
 
+func (r *Route) SurfaceBreakdown() *SurfaceBreakdown {
+	return r.surfaceBreakdown
+}
+
+func (r *Route) Difficulty() Difficulty {
+	return r.difficulty
+}
+
+func (r *Route) Tags() []string {
+	cp := make([]string, len(r.tags))
+	copy(cp, r.tags)
+	return cp
+}
+
+func (r *Route) StartPlace() *place.Place {
+	return r.startPlace
+}
+
+func (r *Route) EndPlace() *place.Place {
+	return r.endPlace
+}
+
+
+// CheckVersion はクライアントが編集を始めた時点のバージョンと現在のバージョンを比較する
+// 一致しない場合は別のリクエストで更新済みのため、上書きせずにエラーを返す
+func (r *Route) CheckVersion(expected int32) error {
+	if r.version != expected {
+		return domainerror.New("route has been modified by another request", domainerror.ErrConflict)
+	}
+	return nil
+}
 
 // コースポイントとウェイポイントをクリア（更新時に使用）
 func (r *Route) ClearCoursePointsAndWaypoints() {
@@ -517,6 +573,8 @@ func ReconstructRoute(
 	lastPoint Geometry,
 	polyline string,
 	visibility int16,
+	version int32,
+	forkedFromRouteID *string,
 	createdAt string,
 	updatedAt string,
 	) (*Route, error) {
@@ -536,6 +594,8 @@ func ReconstructRoute(
 		lastPoint:          lastPoint,
 		polyline:           polyline,
 		visibility:         visibility,
+		version:            version,
+		forkedFromRouteID:  forkedFromRouteID,
 		createdAt:          createdAt,
 		updatedAt:          updatedAt,
 		coursePoints:       []*CoursePoint{},
@@ -553,6 +613,38 @@ func (r *Route) SetWaypoints(waypoints []*Waypoint) {
 	r.waypoints = waypoints
 }
 
+// 路面の内訳を直接設定（リポジトリ層での復元用）
+func (r *Route) SetSurfaceBreakdown(breakdown *SurfaceBreakdown) {
+	r.surfaceBreakdown = breakdown
+}
+
+// 難易度を直接設定（リポジトリ層での復元用）
+func (r *Route) SetDifficulty(difficulty Difficulty) {
+	r.difficulty = difficulty
+}
+
+// RefreshDifficulty は保存時に道路網と照合した路面の内訳と勾配のプロファイルから難易度を求め直す
+func (r *Route) RefreshDifficulty(breakdown *SurfaceBreakdown, grades []GradeSample) {
+	r.surfaceBreakdown = breakdown
+	r.difficulty = RateDifficulty(NewDifficultyInput(r.distance, r.elevationGain, breakdown, grades))
+}
+
+// タグを直接設定（リポジトリ層での復元用）
+func (r *Route) SetTags(tags []string) {
+	r.tags = tags
+}
+
+// 共有先のクラブを直接設定（リポジトリ層での復元用）
+func (r *Route) SetClubID(clubID *string) {
+	r.clubID = clubID
+}
+
+// 出発地点と目的地の地名を直接設定（リポジトリ層での復元用）
+func (r *Route) SetPlaces(start, end *place.Place) {
+	r.startPlace = start
+	r.endPlace = end
+}
+
 // 作成したルートの基本情報を更新する（名前、説明、写真など）
 func (r *Route) UpdateBasicInfo(
 	name string,
@@ -568,6 +660,9 @@ func (r *Route) UpdateBasicInfo(
 	r.description = description
 	r.highlightedPhotoID = highlightedPhotoID
 	r.visibility = visibility
+	if visibility != VisibilityClub {
+		r.clubID = nil
+	}
 
 	return nil
 }
@@ -620,12 +715,12 @@ func (r *Route) UpdateRouteGeometry(
 }
 
 type ExploreRouteResult struct {
-	Route      *Route
-	UserName   string
-	TotalCount int64
+	Route    *Route
+	UserName string
+	SortKey  float64 // 並び順の基準値。次ページのカーソルに使う
 }
 
-func ReconstructExploreRouteResult(route *Route, userName string, totalCount int64) (*ExploreRouteResult, error) {
+func ReconstructExploreRouteResult(route *Route, userName string, sortKey float64) (*ExploreRouteResult, error) {
 
 	if route == nil {
 		return nil, errors.New("route is nil")
@@ -636,32 +731,194 @@ func ReconstructExploreRouteResult(route *Route, userName string, totalCount int
 	}
 
 	return &ExploreRouteResult{
-		Route:      route,
-		UserName:   userName,
-		TotalCount: totalCount,
+		Route:    route,
+		UserName: userName,
+		SortKey:  sortKey,
+	}, nil
+}
+
+// RoutePage はキーセットページネーションで取得したルート一覧の1ページ
+type RoutePage struct {
+	Items []*ExploreRouteResult
+	Next  *pagination.Cursor // 次のページがない場合はnil
+}
+
+// NewRoutePage は limit+1 件まで取得した結果からページを作成する
+// limit件を超えていれば次のページがあるとみなし、limit件目の要素をカーソルにする
+func NewRoutePage(results []*ExploreRouteResult, limit int32) (*RoutePage, error) {
+	if int32(len(results)) <= limit {
+		return &RoutePage{Items: results}, nil
+	}
+
+	items := results[:limit]
+	last := items[len(items)-1]
+	next, err := pagination.NewCursor(last.SortKey, last.Route.ID())
+	if err != nil {
+		return nil, err
+	}
+	return &RoutePage{Items: items, Next: next}, nil
+}
+
+// RouteSort はルート一覧の並び順
+type RouteSort string
+
+const (
+	RouteSortNearest   RouteSort = "nearest"    // 指定地点から近い順（探索のみ）
+	RouteSortNewest    RouteSort = "newest"     // 作成日時の新しい順
+	RouteSortMostLiked RouteSort = "most_liked" // いいね数の多い順
+	RouteSortLongest   RouteSort = "longest"    // 距離の長い順
+	RouteSortHilliest  RouteSort = "hilliest"   // 獲得標高/距離(m/km)の大きい順
+	RouteSortRelevance RouteSort = "relevance"  // キーワードとの関連度の高い順
+	RouteSortPosition  RouteSort = "position"   // コレクション内の並び順（コレクションの絞り込みのみ）
+)
+
+// ParseRouteSort は文字列から並び順を取得する。空文字の場合は空のRouteSortを返す
+func ParseRouteSort(s string) (RouteSort, error) {
+	switch sort := RouteSort(s); sort {
+	case "", RouteSortNearest, RouteSortNewest, RouteSortMostLiked, RouteSortLongest, RouteSortHilliest, RouteSortRelevance, RouteSortPosition:
+		return sort, nil
+	default:
+		return "", domainerror.New("sort must be one of nearest, newest, most_liked, longest, hilliest, relevance, or position", domainerror.ErrValidation)
+	}
+}
+
+// RouteFilter はルート検索・探索に共通する絞り込み条件
+// 獲得標高(m)、所要時間(s)、作成者名、登坂率(獲得標高m/距離km)、未舗装の割合、地域で絞り込む
+type RouteFilter struct {
+	minElevationGain     *float64
+	maxElevationGain     *float64
+	minDuration          *float64
+	maxDuration          *float64
+	author               string // 作成者名（部分一致）。自分のルートの検索ではコレクション内の他のユーザーのルートにだけ効く
+	minClimbingRatio     *float64
+	maxClimbingRatio     *float64
+	maxUnpavedPercentage *float64 // 未舗装の割合(%)の上限
+	area                 string   // 出発地点か目的地の市区町村・都道府県名（前方一致）
+}
+
+func NewRouteFilter(
+	minElevationGain *float64,
+	maxElevationGain *float64,
+	minDuration *float64,
+	maxDuration *float64,
+	author string,
+	minClimbingRatio *float64,
+	maxClimbingRatio *float64,
+	maxUnpavedPercentage *float64,
+	area string) (RouteFilter, error) {
+
+	if err := validateRange("ElevationGain", minElevationGain, maxElevationGain); err != nil {
+		return RouteFilter{}, err
+	}
+	if err := validateRange("Duration", minDuration, maxDuration); err != nil {
+		return RouteFilter{}, err
+	}
+	if err := validateRange("ClimbingRatio", minClimbingRatio, maxClimbingRatio); err != nil {
+		return RouteFilter{}, err
+	}
+	if maxUnpavedPercentage != nil && (*maxUnpavedPercentage < 0 || *maxUnpavedPercentage > 100) {
+		return RouteFilter{}, domainerror.New("maxUnpavedPercentage must be between 0 and 100", domainerror.ErrValidation)
+	}
+
+	return RouteFilter{
+		minElevationGain:     minElevationGain,
+		maxElevationGain:     maxElevationGain,
+		minDuration:          minDuration,
+		maxDuration:          maxDuration,
+		author:               strings.TrimSpace(author),
+		minClimbingRatio:     minClimbingRatio,
+		maxClimbingRatio:     maxClimbingRatio,
+		maxUnpavedPercentage: maxUnpavedPercentage,
+		area:                 strings.TrimSpace(area),
 	}, nil
 }
 
+// validateRange は min/max の組が非負かつ min <= max であることを確認する
+func validateRange(field string, min, max *float64) error {
+	if min != nil && *min < 0 {
+		return domainerror.New("min"+field+" must be non-negative", domainerror.ErrValidation)
+	}
+	if max != nil && *max < 0 {
+		return domainerror.New("max"+field+" must be non-negative", domainerror.ErrValidation)
+	}
+	if min != nil && max != nil && *min > *max {
+		return domainerror.New("min"+field+" must be less than or equal to max"+field, domainerror.ErrValidation)
+	}
+	return nil
+}
+
+func (f RouteFilter) MinElevationGain() *float64 {
+	return f.minElevationGain
+}
+
+func (f RouteFilter) MaxElevationGain() *float64 {
+	return f.maxElevationGain
+}
+
+func (f RouteFilter) MinDuration() *float64 {
+	return f.minDuration
+}
+
+func (f RouteFilter) MaxDuration() *float64 {
+	return f.maxDuration
+}
+
+func (f RouteFilter) Author() string {
+	return f.author
+}
+
+func (f RouteFilter) MinClimbingRatio() *float64 {
+	return f.minClimbingRatio
+}
+
+func (f RouteFilter) MaxClimbingRatio() *float64 {
+	return f.maxClimbingRatio
+}
+
+func (f RouteFilter) MaxUnpavedPercentage() *float64 {
+	return f.maxUnpavedPercentage
+}
+
+func (f RouteFilter) Area() string {
+	return f.area
+}
+
+// RouteSearchCriteria はルート検索の条件
+// コレクションを指定した場合は、他のユーザーのルートも含めてコレクション内の閲覧できるルートを対象にする
+// 指定しない場合は閲覧ユーザー自身のルートだけを対象にする
 type RouteSearchCriteria struct {
-	userID      string
-	keywords    []string
-	visibility  *int16
-	minDistance *float64
-	maxDistance *float64
+	userID        string
+	viewerClubIDs []string // 閲覧ユーザーが所属するクラブ。コレクション内のクラブのルートの閲覧に使う
+	keywords      []string
+	visibility    *int16
+	minDistance   *float64
+	maxDistance   *float64
+	filter        RouteFilter
+	collectionID  string // 空の場合は指定なし
+	tag           string // NormalizeTagで正規化済み。空の場合は指定なし
+	sort          RouteSort
+	limit         int32
+	after         *pagination.Cursor
 }
 
 func NewRouteSearchCriteria(
-	userID string,
+	viewer Viewer,
 	keywords []string,
 	visibility *int16,
 	minDistance *float64,
-	maxDistance *float64) (*RouteSearchCriteria, error) {
+	maxDistance *float64,
+	filter RouteFilter,
+	collectionID string,
+	tag string,
+	sort RouteSort,
+	limit int32,
+	after *pagination.Cursor) (*RouteSearchCriteria, error) {
 
-	if userID == "" {
+	if viewer.UserID() == "" {
 		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
 	}
-	if visibility != nil && (*visibility < 0 || *visibility > 2) {
-		return nil, domainerror.New("visibility must be one of 0, 1, or 2", domainerror.ErrValidation)
+	if visibility != nil && (*visibility < VisibilityPrivate || *visibility > VisibilityClub) {
+		return nil, domainerror.New("visibility must be between 0 and 3", domainerror.ErrValidation)
 	}
 	if minDistance != nil && *minDistance < 0 {
 		return nil, domainerror.New("minDistance must be non-negative", domainerror.ErrValidation)
@@ -672,13 +929,51 @@ func NewRouteSearchCriteria(
 	if minDistance != nil && maxDistance != nil && *minDistance > *maxDistance {
 		return nil, domainerror.New("minDistance must be less than or equal to maxDistance", domainerror.ErrValidation)
 	}
+	// 基準地点を持たないため近い順には並べられない
+	if sort == RouteSortNearest {
+		return nil, domainerror.New("sort nearest is not supported for searching own routes", domainerror.ErrValidation)
+	}
+	if sort == RouteSortRelevance && len(keywords) == 0 {
+		return nil, domainerror.New("keyword is required to sort by relevance", domainerror.ErrValidation)
+	}
+	if sort == RouteSortPosition && collectionID == "" {
+		return nil, domainerror.New("collection_id is required to sort by position", domainerror.ErrValidation)
+	}
+	// 並び順の指定がなければ、キーワード指定時は関連度順、コレクション指定時はコレクション内の並び順、それ以外は新しい順
+	if sort == "" {
+		switch {
+		case len(keywords) > 0:
+			sort = RouteSortRelevance
+		case collectionID != "":
+			sort = RouteSortPosition
+		default:
+			sort = RouteSortNewest
+		}
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+	if tag != "" {
+		normalized, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		tag = normalized
+	}
 
 	return &RouteSearchCriteria{
-		userID:      userID,
-		keywords:    keywords,
-		visibility:  visibility,
-		minDistance: minDistance,
-		maxDistance: maxDistance,
+		userID:        viewer.UserID(),
+		viewerClubIDs: viewer.ClubIDs(),
+		keywords:      keywords,
+		visibility:    visibility,
+		minDistance:   minDistance,
+		maxDistance:   maxDistance,
+		filter:        filter,
+		collectionID:  collectionID,
+		tag:           tag,
+		sort:          sort,
+		limit:         limit,
+		after:         after,
 	}, nil
 }
 
@@ -686,6 +981,11 @@ func (c RouteSearchCriteria) UserID() string {
 	return c.userID
 }
 
+// ViewerClubIDs は閲覧ユーザーが所属するクラブ
+func (c RouteSearchCriteria) ViewerClubIDs() []string {
+	return slices.Clone(c.viewerClubIDs)
+}
+
 func (c RouteSearchCriteria) Keywords() []string {
 	cp := make([]string, len(c.keywords))
 	copy(cp, c.keywords)
@@ -704,15 +1004,46 @@ func (c RouteSearchCriteria) MaxDistance() *float64 {
 	return c.maxDistance
 }
 
+func (c RouteSearchCriteria) Filter() RouteFilter {
+	return c.filter
+}
+
+// CollectionID は絞り込むコレクション。空の場合は指定なし
+func (c RouteSearchCriteria) CollectionID() string {
+	return c.collectionID
+}
+
+// Tag は絞り込むタグ。空の場合は指定なし
+func (c RouteSearchCriteria) Tag() string {
+	return c.tag
+}
+
+func (c RouteSearchCriteria) Sort() RouteSort {
+	return c.sort
+}
+
+func (c RouteSearchCriteria) Limit() int32 {
+	return c.limit
+}
+
+// After は前のページの最後の位置。nilの場合は先頭から取得する
+func (c RouteSearchCriteria) After() *pagination.Cursor {
+	return c.after
+}
+
 
 type ExploreRoutesCriteria struct {
-	keywords    []string
-	location    *Geometry
-	radius      *float64
-	minDistance *float64
-	maxDistance *float64
-	limit       int32
-	offset      int32
+	keywords      []string
+	location      *Geometry
+	radius        *float64
+	minDistance   *float64
+	maxDistance   *float64
+	filter        RouteFilter
+	minDifficulty Difficulty // DifficultyUnknownの場合は絞り込まない
+	maxDifficulty Difficulty
+	sort          RouteSort
+	limit         int32
+	after         *pagination.Cursor
 }
 
 func NewExploreRoutesCriteria(
@@ -721,8 +1052,12 @@ func NewExploreRoutesCriteria(
 	radius *float64,
 	minDistance *float64,
 	maxDistance *float64,
+	filter RouteFilter,
+	minDifficulty Difficulty,
+	maxDifficulty Difficulty,
+	sort RouteSort,
 	limit int32,
-	offset int32) (*ExploreRoutesCriteria, error) {
+	after *pagination.Cursor) (*ExploreRoutesCriteria, error) {
 
 	if (location == nil) != (radius == nil) {
 		return nil, domainerror.New("location and radius must be provided together", domainerror.ErrValidation)
@@ -739,15 +1074,45 @@ func NewExploreRoutesCriteria(
 	if minDistance != nil && maxDistance != nil && *minDistance > *maxDistance {
 		return nil, domainerror.New("minDistance must be less than or equal to maxDistance", domainerror.ErrValidation)
 	}
+	if minDifficulty != DifficultyUnknown && maxDifficulty != DifficultyUnknown && minDifficulty > maxDifficulty {
+		return nil, domainerror.New("minDifficulty must be less than or equal to maxDifficulty", domainerror.ErrValidation)
+	}
+	if sort == RouteSortNearest && location == nil {
+		return nil, domainerror.New("location is required to sort by nearest", domainerror.ErrValidation)
+	}
+	if sort == RouteSortRelevance && len(keywords) == 0 {
+		return nil, domainerror.New("keyword is required to sort by relevance", domainerror.ErrValidation)
+	}
+	if sort == RouteSortPosition {
+		return nil, domainerror.New("sort position is only supported for searching a collection", domainerror.ErrValidation)
+	}
+	// 並び順の指定がなければ、地点指定時は近い順、キーワード指定時は関連度順、それ以外は新しい順
+	if sort == "" {
+		switch {
+		case location != nil:
+			sort = RouteSortNearest
+		case len(keywords) > 0:
+			sort = RouteSortRelevance
+		default:
+			sort = RouteSortNewest
+		}
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
 
 	return &ExploreRoutesCriteria{
-		keywords:    keywords,
-		location:    location,
-		radius:      radius,
-		minDistance: minDistance,
-		maxDistance: maxDistance,
-		limit:       limit,
-		offset:      offset,
+		keywords:      keywords,
+		location:      location,
+		radius:        radius,
+		minDistance:   minDistance,
+		maxDistance:   maxDistance,
+		filter:        filter,
+		minDifficulty: minDifficulty,
+		maxDifficulty: maxDifficulty,
+		sort:          sort,
+		limit:         limit,
+		after:         after,
 	}, nil
 }
 
@@ -773,10 +1138,107 @@ func (c ExploreRoutesCriteria) MaxDistance() *float64 {
 	return c.maxDistance
 }
 
+func (c ExploreRoutesCriteria) Filter() RouteFilter {
+	return c.filter
+}
+
+// MinDifficulty は難易度の下限。DifficultyUnknownの場合は指定なし
+func (c ExploreRoutesCriteria) MinDifficulty() Difficulty {
+	return c.minDifficulty
+}
+
+// MaxDifficulty は難易度の上限。DifficultyUnknownの場合は指定なし
+func (c ExploreRoutesCriteria) MaxDifficulty() Difficulty {
+	return c.maxDifficulty
+}
+
+func (c ExploreRoutesCriteria) Sort() RouteSort {
+	return c.sort
+}
+
 func (c ExploreRoutesCriteria) Limit() int32 {
 	return c.limit
 }
 
-func (c ExploreRoutesCriteria) Offset() int32 {
-	return c.offset
-}
\ No newline at end of file
+// After は前のページの最後の位置。nilの場合は先頭から取得する
+func (c ExploreRoutesCriteria) After() *pagination.Cursor {
+	return c.after
+}
+
+// SimilarRouteCandidatesCriteria は類似ルート候補をbboxで絞り込むための条件
+type SimilarRouteCandidatesCriteria struct {
+	routeID     string
+	pathGeom    Geometry
+	firstPoint  Geometry
+	paddingM    float64
+	minDistance float64
+	maxDistance float64
+	limit       int32
+}
+
+func NewSimilarRouteCandidatesCriteria(
+	routeID string,
+	pathGeom Geometry,
+	firstPoint Geometry,
+	paddingM float64,
+	minDistance float64,
+	maxDistance float64,
+	limit int32) (*SimilarRouteCandidatesCriteria, error) {
+
+	if routeID == "" {
+		return nil, domainerror.New("routeID is required", domainerror.ErrValidation)
+	}
+	if pathGeom.Geometry == nil || pathGeom.Geometry.GeoJSONType() != "LineString" {
+		return nil, domainerror.New("pathGeom must be a LineString", domainerror.ErrValidation)
+	}
+	if firstPoint.Geometry == nil || firstPoint.Geometry.GeoJSONType() != "Point" {
+		return nil, domainerror.New("firstPoint must be a Point", domainerror.ErrValidation)
+	}
+	if paddingM < 0 {
+		return nil, domainerror.New("paddingM must be non-negative", domainerror.ErrValidation)
+	}
+	if minDistance > maxDistance {
+		return nil, domainerror.New("minDistance must be less than or equal to maxDistance", domainerror.ErrValidation)
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+
+	return &SimilarRouteCandidatesCriteria{
+		routeID:     routeID,
+		pathGeom:    pathGeom,
+		firstPoint:  firstPoint,
+		paddingM:    paddingM,
+		minDistance: minDistance,
+		maxDistance: maxDistance,
+		limit:       limit,
+	}, nil
+}
+
+func (c SimilarRouteCandidatesCriteria) RouteID() string {
+	return c.routeID
+}
+
+func (c SimilarRouteCandidatesCriteria) PathGeom() Geometry {
+	return c.pathGeom
+}
+
+func (c SimilarRouteCandidatesCriteria) FirstPoint() Geometry {
+	return c.firstPoint
+}
+
+func (c SimilarRouteCandidatesCriteria) PaddingM() float64 {
+	return c.paddingM
+}
+
+func (c SimilarRouteCandidatesCriteria) MinDistance() float64 {
+	return c.minDistance
+}
+
+func (c SimilarRouteCandidatesCriteria) MaxDistance() float64 {
+	return c.maxDistance
+}
+
+func (c SimilarRouteCandidatesCriteria) Limit() int32 {
+	return c.limit
+}
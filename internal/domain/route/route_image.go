@@ -0,0 +1,63 @@
+package route
+
+import (
+	"strings"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/google/uuid"
+)
+
+type RouteImageID string
+
+func NewRouteImageID() RouteImageID {
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		panic(err)
+	}
+	return RouteImageID(uuid.String())
+}
+
+func (id RouteImageID) String() string {
+	return string(id)
+}
+
+// RouteImage はルートの写真
+// 画像ファイルはS3等に保存済みで、ここではその保存先と属性だけを持つ
+type RouteImage struct {
+	id         string
+	s3Key      string
+	width      *int32
+	height     *int32
+	size       *int64
+	imageType  string // jpg/png等
+	visibility int16
+}
+
+func NewRouteImage(s3Key string, width, height *int32, size *int64, imageType string, visibility int16) (*RouteImage, error) {
+	if strings.TrimSpace(s3Key) == "" {
+		return nil, domainerror.New("s3Key must not be empty", domainerror.ErrValidation)
+	}
+	if strings.TrimSpace(imageType) == "" {
+		return nil, domainerror.New("image type must not be empty", domainerror.ErrValidation)
+	}
+	if visibility < 0 || visibility > 2 {
+		return nil, domainerror.New("visibility must be one of 0, 1, or 2", domainerror.ErrValidation)
+	}
+	return &RouteImage{
+		id:         NewRouteImageID().String(),
+		s3Key:      s3Key,
+		width:      width,
+		height:     height,
+		size:       size,
+		imageType:  imageType,
+		visibility: visibility,
+	}, nil
+}
+
+func (i *RouteImage) ID() string        { return i.id }
+func (i *RouteImage) S3Key() string     { return i.s3Key }
+func (i *RouteImage) Width() *int32     { return i.width }
+func (i *RouteImage) Height() *int32    { return i.height }
+func (i *RouteImage) Size() *int64      { return i.size }
+func (i *RouteImage) Type() string      { return i.imageType }
+func (i *RouteImage) Visibility() int16 { return i.visibility }
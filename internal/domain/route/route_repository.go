@@ -6,11 +6,48 @@ import (
 
 type IRouteRepository interface {
 	GetRoutesByUserID(ctx context.Context, userID string) ([]*Route, error)
-	SearchRoutesByUserID(ctx context.Context, criteria *RouteSearchCriteria) ([]*Route, error)
-	ExploreRoutes(ctx context.Context, criteria *ExploreRoutesCriteria) ([]*ExploreRouteResult, error)
+	SearchRoutesByUserID(ctx context.Context, criteria *RouteSearchCriteria) (*RoutePage, error)
+	ExploreRoutes(ctx context.Context, criteria *ExploreRoutesCriteria) (*RoutePage, error)
+	FindSimilarRouteCandidates(ctx context.Context, criteria *SimilarRouteCandidatesCriteria) ([]*ExploreRouteResult, error)
 	CountRoutesByUserID(ctx context.Context, userID string) (int64, error)
+	CountForks(ctx context.Context, routeID string) (int64, error)
 	GetRouteByID(ctx context.Context, id string) (*Route, error)
 	SaveRoute(ctx context.Context, route *Route) error
 	DeleteRoute(ctx context.Context, id string) error
 	UpdateRoute(ctx context.Context, route *Route) error
+	SaveRouteVersion(ctx context.Context, version *RouteVersion) error
+	GetRouteVersions(ctx context.Context, routeID string) ([]*RouteVersion, error)
+	GetRouteVersion(ctx context.Context, routeID string, versionNumber int32) (*RouteVersion, error)
+	SaveRouteImages(ctx context.Context, routeID string, images []*RouteImage) error
+	RefreshSurfaceBreakdown(ctx context.Context, routeID string) error
+	// 保存済みの路面の内訳を取得する。まだ作成していない場合はnil
+	GetSurfaceBreakdown(ctx context.Context, routeID string) (*SurfaceBreakdown, error)
+	// 保存済みの経路を道路網と照合し、始点から順に勾配のプロファイルを返す
+	GetRouteGrades(ctx context.Context, routeID string) ([]GradeSample, error)
+	// Route.RefreshDifficultyで求めた難易度を保存する
+	SaveRouteDifficulty(ctx context.Context, route *Route) error
+	SaveRoutePlaces(ctx context.Context, route *Route) error
+	// タグを保存し直す
+	SaveRouteTags(ctx context.Context, route *Route) error
+	// コレクション内のルートを並び順に取得する
+	GetRoutesInCollection(ctx context.Context, collectionID string) ([]*ExploreRouteResult, error)
+	// IDで指定したルートをまとめて取得する。存在しないIDは無視する
+	GetRoutesByIDs(ctx context.Context, ids []string) ([]*ExploreRouteResult, error)
+	// いいね済みの場合はErrConflictを返す
+	LikeRoute(ctx context.Context, userID string, routeID string) error
+	// いいねしていない場合はErrNotFoundを返す
+	UnlikeRoute(ctx context.Context, userID string, routeID string) error
+	// 保存済みの場合はErrConflictを返す。保存を取り消したルートは保存し直せる
+	AddSavedRoute(ctx context.Context, userID string, routeID string) error
+	// 保存していない場合はErrNotFoundを返す
+	RemoveSavedRoute(ctx context.Context, userID string, routeID string) error
+	// 保存したルートを保存した日時の新しい順に取得する。保存を取り消したルートは含まない
+	GetSavedRoutes(ctx context.Context, criteria *SavedRouteCriteria) (*RoutePage, error)
+	// コメントを古い順に取得する。削除したコメントは含まない
+	GetComments(ctx context.Context, criteria *CommentCriteria) (*CommentPage, error)
+	GetComment(ctx context.Context, id string) (*Comment, error)
+	// IDで指定したコメントをまとめて取得する。存在しないIDと削除したコメントは無視する
+	GetCommentsByIDs(ctx context.Context, ids []string) ([]*Comment, error)
+	SaveComment(ctx context.Context, comment *Comment) error
+	DeleteComment(ctx context.Context, id string) error
 }
@@ -1,8 +1,10 @@
 package route
 
 import (
+	"errors"
 	"testing"
 
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
 	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
 	"github.com/paulmach/orb"
 )
@@ -736,3 +738,224 @@ func TestAddWaypoint_MultiplePoints(t *testing.T) {
 		}
 	}
 }
+
+func TestNewRouteFilter(t *testing.T) {
+	tests := []struct {
+		name             string
+		minElevationGain *float64
+		maxElevationGain *float64
+		minDuration      *float64
+		maxDuration      *float64
+		author           string
+		minClimbingRatio *float64
+		maxClimbingRatio *float64
+		maxUnpaved       *float64
+		area             string
+		wantAuthor       string
+		wantArea         string
+		wantErr          bool
+	}{
+		{
+			name:             "正常系: 全ての条件を指定",
+			minElevationGain: new(100.0),
+			maxElevationGain: new(1000.0),
+			minDuration:      new(600.0),
+			maxDuration:      new(7200.0),
+			author:           "  taro ",
+			minClimbingRatio: new(5.0),
+			maxClimbingRatio: new(20.0),
+			maxUnpaved:       new(10.0),
+			area:             " 京都 ",
+			wantAuthor:       "taro",
+			wantArea:         "京都",
+		},
+		{
+			name: "正常系: 条件を指定しない",
+		},
+		{
+			name:             "異常系: 獲得標高の下限が負",
+			minElevationGain: new(-1.0),
+			wantErr:          true,
+		},
+		{
+			name:        "異常系: 所要時間の下限が上限より大きい",
+			minDuration: new(7200.0),
+			maxDuration: new(600.0),
+			wantErr:     true,
+		},
+		{
+			name:             "異常系: 登坂率の上限が負",
+			maxClimbingRatio: new(-5.0),
+			wantErr:          true,
+		},
+		{
+			name:       "異常系: 未舗装の割合の上限が100%を超える",
+			maxUnpaved: new(120.0),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRouteFilter(tt.minElevationGain, tt.maxElevationGain, tt.minDuration, tt.maxDuration, tt.author, tt.minClimbingRatio, tt.maxClimbingRatio, tt.maxUnpaved, tt.area)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRouteFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Author() != tt.wantAuthor {
+				t.Errorf("Author() = %q, want %q", got.Author(), tt.wantAuthor)
+			}
+			if got.Area() != tt.wantArea {
+				t.Errorf("Area() = %q, want %q", got.Area(), tt.wantArea)
+			}
+		})
+	}
+}
+
+func TestParseRouteSort(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    RouteSort
+		wantErr bool
+	}{
+		{name: "正常系: 未指定", input: "", want: ""},
+		{name: "正常系: newest", input: "newest", want: RouteSortNewest},
+		{name: "正常系: most_liked", input: "most_liked", want: RouteSortMostLiked},
+		{name: "正常系: longest", input: "longest", want: RouteSortLongest},
+		{name: "正常系: hilliest", input: "hilliest", want: RouteSortHilliest},
+		{name: "正常系: nearest", input: "nearest", want: RouteSortNearest},
+		{name: "正常系: relevance", input: "relevance", want: RouteSortRelevance},
+		{name: "正常系: position", input: "position", want: RouteSortPosition},
+		{name: "異常系: 未定義の並び順", input: "popular", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRouteSort(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRouteSort() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRouteSort() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRouteSearchCriteria_Sort(t *testing.T) {
+	viewer := NewViewer("70d6037a-b67b-4aa8-b5a3-da393b514f24", nil)
+	collectionID := "019b5a63-0000-7000-8000-000000000001"
+
+	tests := []struct {
+		name         string
+		keywords     []string
+		collectionID string
+		sort         RouteSort
+		want         RouteSort
+		wantErr      bool
+	}{
+		{name: "正常系: 未指定なら新しい順", want: RouteSortNewest},
+		{name: "正常系: キーワード指定ありで未指定なら関連度順", keywords: []string{"東京"}, want: RouteSortRelevance},
+		{name: "正常系: コレクション指定ありで未指定ならコレクション内の並び順", collectionID: collectionID, want: RouteSortPosition},
+		{name: "正常系: コレクション指定ありで指定した並び順を使う", collectionID: collectionID, sort: RouteSortLongest, want: RouteSortLongest},
+		{name: "異常系: コレクション指定なしでコレクション内の並び順", sort: RouteSortPosition, wantErr: true},
+		{name: "異常系: 近い順", sort: RouteSortNearest, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRouteSearchCriteria(viewer, tt.keywords, nil, nil, nil, RouteFilter{}, tt.collectionID, "", tt.sort, 20, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRouteSearchCriteria() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Sort() != tt.want {
+				t.Errorf("Sort() = %q, want %q", got.Sort(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewExploreRoutesCriteria_Sort(t *testing.T) {
+	location := &Geometry{orb.Point{139.6917, 35.6895}}
+	radius := new(5000.0)
+
+	tests := []struct {
+		name     string
+		keywords []string
+		location *Geometry
+		radius   *float64
+		sort     RouteSort
+		want     RouteSort
+		wantErr  bool
+	}{
+		{name: "正常系: 地点指定ありで未指定なら近い順", location: location, radius: radius, want: RouteSortNearest},
+		{name: "正常系: 地点指定なしで未指定なら新しい順", want: RouteSortNewest},
+		{name: "正常系: キーワード指定ありで未指定なら関連度順", keywords: []string{"東京"}, want: RouteSortRelevance},
+		{name: "正常系: 地点とキーワードの指定ありで未指定なら近い順", keywords: []string{"東京"}, location: location, radius: radius, want: RouteSortNearest},
+		{name: "正常系: 指定した並び順を使う", location: location, radius: radius, sort: RouteSortMostLiked, want: RouteSortMostLiked},
+		{name: "異常系: 地点指定なしで近い順", sort: RouteSortNearest, wantErr: true},
+		{name: "異常系: キーワード指定なしで関連度順", sort: RouteSortRelevance, wantErr: true},
+		{name: "異常系: コレクション内の並び順", location: location, radius: radius, sort: RouteSortPosition, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewExploreRoutesCriteria(tt.keywords, tt.location, tt.radius, nil, nil, RouteFilter{}, DifficultyUnknown, DifficultyUnknown, tt.sort, 20, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewExploreRoutesCriteria() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Sort() != tt.want {
+				t.Errorf("Sort() = %q, want %q", got.Sort(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewExploreRoutesCriteria_Difficulty(t *testing.T) {
+	tests := []struct {
+		name    string
+		min     Difficulty
+		max     Difficulty
+		wantErr bool
+	}{
+		{name: "正常系: 指定なし", min: DifficultyUnknown, max: DifficultyUnknown},
+		{name: "正常系: 下限のみ", min: DifficultyHard, max: DifficultyUnknown},
+		{name: "正常系: 上限のみ", min: DifficultyUnknown, max: DifficultyEasy},
+		{name: "正常系: 下限と上限が同じ", min: DifficultyModerate, max: DifficultyModerate},
+		{name: "異常系: 下限が上限より難しい", min: DifficultyExpert, max: DifficultyModerate, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewExploreRoutesCriteria(nil, nil, nil, nil, nil, RouteFilter{}, tt.min, tt.max, "", 20, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewExploreRoutesCriteria() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.MinDifficulty() != tt.min || got.MaxDifficulty() != tt.max {
+				t.Errorf("MinDifficulty()/MaxDifficulty() = %v/%v, want %v/%v", got.MinDifficulty(), got.MaxDifficulty(), tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestRoute_CheckVersion(t *testing.T) {
+	r, err := ReconstructRoute("route-id", "user-id", "name", "", nil, 0, 0, 0, 0,
+		Geometry{}, Geometry{}, Geometry{}, Geometry{}, "", 1, 3, nil, "", "")
+	if err != nil {
+		t.Fatalf("ReconstructRoute() error = %v", err)
+	}
+
+	if err := r.CheckVersion(3); err != nil {
+		t.Errorf("CheckVersion(3) error = %v, want nil", err)
+	}
+	if err := r.CheckVersion(2); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("CheckVersion(2) error = %v, want ErrConflict", err)
+	}
+}
@@ -0,0 +1,213 @@
+package route
+
+import (
+	"errors"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/google/uuid"
+)
+
+type RouteVersionID string
+
+func NewRouteVersionID() RouteVersionID {
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		panic(err)
+	}
+	return RouteVersionID(uuid.String())
+}
+
+func (id RouteVersionID) String() string {
+	return string(id)
+}
+
+// RouteVersion は更新直前のルート集約のスナップショット
+// ルートを更新するたびに1件作成され、誤った編集から元の状態に戻すために使う
+type RouteVersion struct {
+	id                 string
+	routeID            string
+	versionNumber      int32  // ルートごとに1から採番する。保存前は0
+	editorID           string // この版を置き換える更新を行ったユーザー
+	name               string
+	description        string
+	highlightedPhotoID *int64
+	distance           float64
+	duration           float64
+	elevationGain      float64
+	elevationLoss      float64
+	pathGeom           Geometry
+	firstPoint         Geometry
+	lastPoint          Geometry
+	visibility         int16
+	createdAt          string
+
+	coursePoints []*CoursePoint
+	waypoints    []*Waypoint
+}
+
+// NewRouteVersion は現在のルートの状態からスナップショットを作成する
+func NewRouteVersion(route *Route, editorID string) (*RouteVersion, error) {
+	if route == nil {
+		return nil, errors.New("route is nil")
+	}
+	if editorID == "" {
+		return nil, errors.New("editorID is required")
+	}
+
+	return &RouteVersion{
+		id:                 NewRouteVersionID().String(),
+		routeID:            route.id,
+		editorID:           editorID,
+		name:               route.name,
+		description:        route.description,
+		highlightedPhotoID: route.highlightedPhotoID,
+		distance:           route.distance,
+		duration:           route.duration,
+		elevationGain:      route.elevationGain,
+		elevationLoss:      route.elevationLoss,
+		pathGeom:           route.pathGeom,
+		firstPoint:         route.firstPoint,
+		lastPoint:          route.lastPoint,
+		visibility:         route.visibility,
+		coursePoints:       route.CoursePoints(),
+		waypoints:          route.Waypoints(),
+	}, nil
+}
+
+// RouteVersionを再構築（リポジトリ層からの復元用）
+func ReconstructRouteVersion(
+	id string,
+	routeID string,
+	versionNumber int32,
+	editorID string,
+	name string,
+	description string,
+	highlightedPhotoID *int64,
+	distance float64,
+	duration float64,
+	elevationGain float64,
+	elevationLoss float64,
+	pathGeom Geometry,
+	firstPoint Geometry,
+	lastPoint Geometry,
+	visibility int16,
+	coursePoints []*CoursePoint,
+	waypoints []*Waypoint,
+	createdAt string,
+) *RouteVersion {
+	return &RouteVersion{
+		id:                 id,
+		routeID:            routeID,
+		versionNumber:      versionNumber,
+		editorID:           editorID,
+		name:               name,
+		description:        description,
+		highlightedPhotoID: highlightedPhotoID,
+		distance:           distance,
+		duration:           duration,
+		elevationGain:      elevationGain,
+		elevationLoss:      elevationLoss,
+		pathGeom:           pathGeom,
+		firstPoint:         firstPoint,
+		lastPoint:          lastPoint,
+		visibility:         visibility,
+		coursePoints:       coursePoints,
+		waypoints:          waypoints,
+		createdAt:          createdAt,
+	}
+}
+
+func (v *RouteVersion) ID() string                 { return v.id }
+func (v *RouteVersion) RouteID() string            { return v.routeID }
+func (v *RouteVersion) VersionNumber() int32       { return v.versionNumber }
+func (v *RouteVersion) EditorID() string           { return v.editorID }
+func (v *RouteVersion) Name() string               { return v.name }
+func (v *RouteVersion) Description() string        { return v.description }
+func (v *RouteVersion) HighlightedPhotoID() *int64 { return v.highlightedPhotoID }
+func (v *RouteVersion) Distance() float64          { return v.distance }
+func (v *RouteVersion) Duration() float64          { return v.duration }
+func (v *RouteVersion) ElevationGain() float64     { return v.elevationGain }
+func (v *RouteVersion) ElevationLoss() float64     { return v.elevationLoss }
+func (v *RouteVersion) PathGeom() Geometry         { return v.pathGeom }
+func (v *RouteVersion) FirstPoint() Geometry       { return v.firstPoint }
+func (v *RouteVersion) LastPoint() Geometry        { return v.lastPoint }
+func (v *RouteVersion) Visibility() int16          { return v.visibility }
+func (v *RouteVersion) CreatedAt() string          { return v.createdAt }
+
+func (v *RouteVersion) CoursePoints() []*CoursePoint {
+	points := make([]*CoursePoint, len(v.coursePoints))
+	copy(points, v.coursePoints)
+	return points
+}
+
+func (v *RouteVersion) Waypoints() []*Waypoint {
+	points := make([]*Waypoint, len(v.waypoints))
+	copy(points, v.waypoints)
+	return points
+}
+
+// RouteVersionDiff は版と現在のルートの差分（版の値 - 現在の値）
+// この版に戻したときに距離や獲得標高がどれだけ変わるかを表す
+type RouteVersionDiff struct {
+	DistanceChange      float64 // 距離(m)
+	DurationChange      float64 // 所要時間(s)
+	ElevationGainChange float64 // 獲得標高(m)
+	ElevationLossChange float64 // 獲得標高(下り)(m)
+}
+
+func (v *RouteVersion) DiffFrom(current *Route) RouteVersionDiff {
+	return RouteVersionDiff{
+		DistanceChange:      v.distance - current.distance,
+		DurationChange:      v.duration - current.duration,
+		ElevationGainChange: v.elevationGain - current.elevationGain,
+		ElevationLossChange: v.elevationLoss - current.elevationLoss,
+	}
+}
+
+// RestoreVersion はルートを版の状態に戻す
+// コースポイントとウェイポイントは新しいIDで作り直す
+func (r *Route) RestoreVersion(v *RouteVersion) error {
+	if v == nil {
+		return errors.New("version is nil")
+	}
+	if v.routeID != r.id {
+		return domainerror.New("version does not belong to the route", domainerror.ErrValidation)
+	}
+
+	r.name = v.name
+	r.description = v.description
+	r.highlightedPhotoID = v.highlightedPhotoID
+	r.distance = v.distance
+	r.duration = v.duration
+	r.elevationGain = v.elevationGain
+	r.elevationLoss = v.elevationLoss
+	r.pathGeom = v.pathGeom
+	r.firstPoint = v.firstPoint
+	r.lastPoint = v.lastPoint
+	r.visibility = v.visibility
+	// 版には共有先のクラブを残していないため、今の共有先を使う。共有先がなければ非公開に戻す
+	if r.visibility != VisibilityClub {
+		r.clubID = nil
+	} else if r.clubID == nil {
+		r.visibility = VisibilityPrivate
+	}
+
+	coursePoints := make([]*CoursePoint, len(v.coursePoints))
+	for i, cp := range v.coursePoints {
+		restored := *cp
+		restored.id = NewCoursePointID().String()
+		restored.routeID = r.id
+		coursePoints[i] = &restored
+	}
+	waypoints := make([]*Waypoint, len(v.waypoints))
+	for i, wp := range v.waypoints {
+		restored := *wp
+		restored.id = NewWaypointID().String()
+		restored.routeID = r.id
+		waypoints[i] = &restored
+	}
+	r.coursePoints = coursePoints
+	r.waypoints = waypoints
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package route
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+)
+
+func newTestRouteForVersion(t *testing.T, name string, distance float64, path orb.LineString) *Route {
+	t.Helper()
+	r, err := NewRoute(
+		user.NewUserID().String(),
+		name,
+		"description",
+		nil,
+		distance,
+		600,
+		100,
+		80,
+		Geometry{Geometry: path},
+		Geometry{Geometry: path[0]},
+		Geometry{Geometry: path[len(path)-1]},
+		1,
+	)
+	if err != nil {
+		t.Fatalf("NewRoute() error = %v", err)
+	}
+	return r
+}
+
+func TestNewRouteVersion(t *testing.T) {
+	path := orb.LineString{{139.0, 35.0}, {139.1, 35.1}}
+	r := newTestRouteForVersion(t, "Before", 1000, path)
+	if err := r.AddWaypoint(Geometry{Geometry: orb.Point{139.0, 35.0}}); err != nil {
+		t.Fatalf("AddWaypoint() error = %v", err)
+	}
+
+	editorID := user.NewUserID().String()
+	v, err := NewRouteVersion(r, editorID)
+	if err != nil {
+		t.Fatalf("NewRouteVersion() error = %v", err)
+	}
+	if v.RouteID() != r.ID() || v.EditorID() != editorID {
+		t.Errorf("RouteID/EditorID = %s/%s, want %s/%s", v.RouteID(), v.EditorID(), r.ID(), editorID)
+	}
+	if v.Name() != "Before" || v.Distance() != 1000 {
+		t.Errorf("Name/Distance = %s/%v, want Before/1000", v.Name(), v.Distance())
+	}
+	if len(v.Waypoints()) != 1 {
+		t.Errorf("len(Waypoints) = %d, want 1", len(v.Waypoints()))
+	}
+
+	// スナップショット作成後にルートを変更しても版は変わらない
+	if err := r.UpdateBasicInfo("After", "", nil, 1); err != nil {
+		t.Fatalf("UpdateBasicInfo() error = %v", err)
+	}
+	if v.Name() != "Before" {
+		t.Errorf("Name = %s after route update, want Before", v.Name())
+	}
+
+	if _, err := NewRouteVersion(r, ""); err == nil {
+		t.Error("NewRouteVersion() with empty editorID should return error")
+	}
+}
+
+func TestRouteVersion_DiffFrom(t *testing.T) {
+	path := orb.LineString{{139.0, 35.0}, {139.1, 35.1}}
+	r := newTestRouteForVersion(t, "Route", 1000, path)
+	v, err := NewRouteVersion(r, user.NewUserID().String())
+	if err != nil {
+		t.Fatalf("NewRouteVersion() error = %v", err)
+	}
+
+	if err := r.UpdateRouteGeometry(1500, 900, 250, 60, Geometry{Geometry: path}, Geometry{Geometry: path[0]}, Geometry{Geometry: path[1]}); err != nil {
+		t.Fatalf("UpdateRouteGeometry() error = %v", err)
+	}
+
+	got := v.DiffFrom(r)
+	want := RouteVersionDiff{
+		DistanceChange:      -500,
+		DurationChange:      -300,
+		ElevationGainChange: -150,
+		ElevationLossChange: 20,
+	}
+	if got != want {
+		t.Errorf("DiffFrom() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRoute_RestoreVersion(t *testing.T) {
+	oldPath := orb.LineString{{139.0, 35.0}, {139.1, 35.1}}
+	r := newTestRouteForVersion(t, "Old", 1000, oldPath)
+	if err := r.AddWaypoint(Geometry{Geometry: orb.Point{139.0, 35.0}}); err != nil {
+		t.Fatalf("AddWaypoint() error = %v", err)
+	}
+	v, err := NewRouteVersion(r, user.NewUserID().String())
+	if err != nil {
+		t.Fatalf("NewRouteVersion() error = %v", err)
+	}
+	oldWaypointID := r.Waypoints()[0].ID()
+
+	newPath := orb.LineString{{140.0, 36.0}, {140.1, 36.1}}
+	if err := r.UpdateBasicInfo("New", "", nil, 0); err != nil {
+		t.Fatalf("UpdateBasicInfo() error = %v", err)
+	}
+	if err := r.UpdateRouteGeometry(3000, 1200, 300, 300, Geometry{Geometry: newPath}, Geometry{Geometry: newPath[0]}, Geometry{Geometry: newPath[1]}); err != nil {
+		t.Fatalf("UpdateRouteGeometry() error = %v", err)
+	}
+	r.ClearCoursePointsAndWaypoints()
+
+	if err := r.RestoreVersion(v); err != nil {
+		t.Fatalf("RestoreVersion() error = %v", err)
+	}
+	if r.Name() != "Old" || r.Distance() != 1000 || r.Visibility() != 1 {
+		t.Errorf("Name/Distance/Visibility = %s/%v/%d, want Old/1000/1", r.Name(), r.Distance(), r.Visibility())
+	}
+	if !orb.Equal(r.PathGeom().Geometry, oldPath) {
+		t.Errorf("PathGeom = %v, want %v", r.PathGeom().Geometry, oldPath)
+	}
+	wps := r.Waypoints()
+	if len(wps) != 1 {
+		t.Fatalf("len(Waypoints) = %d, want 1", len(wps))
+	}
+	if wps[0].ID() == oldWaypointID {
+		t.Error("restored waypoint should have a new ID")
+	}
+	if wps[0].RouteID() != r.ID() {
+		t.Errorf("waypoint RouteID = %s, want %s", wps[0].RouteID(), r.ID())
+	}
+
+	// 別のルートの版は復元できない
+	other := newTestRouteForVersion(t, "Other", 500, oldPath)
+	if err := other.RestoreVersion(v); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("RestoreVersion() with other route's version error = %v, want ErrValidation", err)
+	}
+}
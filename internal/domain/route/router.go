@@ -0,0 +1,99 @@
+package route
+
+import (
+	"context"
+	"fmt"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+// ルート探索で指定できる経由地の数
+const (
+	MinPlanWaypoints = 2
+	MaxPlanWaypoints = 25 // フロントエンドで使っていたMapbox Directions APIの上限に合わせる
+)
+
+// MaxRoutingSpan は経由地や軌跡を囲む範囲の東西・南北それぞれの幅の上限(度、約100km)
+// アプリ内のルーターはこの範囲の道路網をすべて読み込むため、広すぎる範囲は受け付けない
+const MaxRoutingSpan = 1.0
+
+// RoutingProfile はルート探索の条件
+type RoutingProfile string
+
+const (
+	RoutingProfileRoad          RoutingProfile = "road"           // 舗装路を優先する
+	RoutingProfileGravel        RoutingProfile = "gravel"         // 未舗装路も通る
+	RoutingProfileAvoidHighways RoutingProfile = "avoid_highways" // 幹線道路を避ける
+)
+
+// ParseRoutingProfile はルート探索の条件を解釈する。空の場合はroadにする
+func ParseRoutingProfile(s string) (RoutingProfile, error) {
+	switch p := RoutingProfile(s); p {
+	case "":
+		return RoutingProfileRoad, nil
+	case RoutingProfileRoad, RoutingProfileGravel, RoutingProfileAvoidHighways:
+		return p, nil
+	}
+	return "", domainerror.New(fmt.Sprintf("unsupported routing profile: %s", s), domainerror.ErrValidation)
+}
+
+// PlannedManeuver はルーティングエンジンが返す曲がり角などの操作
+type PlannedManeuver struct {
+	ManeuverType  string  // OSRM/Mapbox Directions APIの操作タイプ
+	Modifier      *string // 曲がる向き
+	RoadName      *string // 操作後に進む道路の名前
+	Location      orb.Point
+	BearingBefore *int32
+	BearingAfter  *int32
+	CumDistM      float64 // 始点からの距離(m)
+	CumDuration   float64 // 始点からの所要時間(秒)
+}
+
+// PlannedRoute はルーティングエンジンが探索した経路。保存する前の下書きとして使う
+type PlannedRoute struct {
+	Path      orb.LineString
+	Distance  float64 // 総距離(m)
+	Duration  float64 // 所要時間(秒)
+	Maneuvers []PlannedManeuver
+}
+
+// Router は経由地を順に通る経路を探索するルーティングエンジン
+type Router interface {
+	Plan(ctx context.Context, waypoints []orb.Point, profile RoutingProfile) (*PlannedRoute, error)
+}
+
+// ValidatePlanWaypoints はルート探索に渡す経由地を検証する
+func ValidatePlanWaypoints(waypoints []orb.Point) error {
+	if len(waypoints) < MinPlanWaypoints || len(waypoints) > MaxPlanWaypoints {
+		return domainerror.New(fmt.Sprintf("waypoints must be between %d and %d", MinPlanWaypoints, MaxPlanWaypoints), domainerror.ErrValidation)
+	}
+	for _, p := range waypoints {
+		if p.Lon() < -180 || p.Lon() > 180 || p.Lat() < -90 || p.Lat() > 90 {
+			return domainerror.New("waypoint is out of range", domainerror.ErrValidation)
+		}
+	}
+	if !withinRoutingSpan(orb.MultiPoint(waypoints).Bound()) {
+		return domainerror.New(fmt.Sprintf("waypoints must fit within %g degrees of longitude and latitude", MaxRoutingSpan), domainerror.ErrValidation)
+	}
+	return nil
+}
+
+// withinRoutingSpan は範囲の幅がMaxRoutingSpanに収まるかを返す
+func withinRoutingSpan(b orb.Bound) bool {
+	return b.Max.Lon()-b.Min.Lon() <= MaxRoutingSpan && b.Max.Lat()-b.Min.Lat() <= MaxRoutingSpan
+}
+
+// CueManeuvers は案内が必要な操作だけを返す
+// 直進のまま道路名が変わるだけの操作などは、キューシートに載せても役に立たないため除く
+func (p *PlannedRoute) CueManeuvers() []PlannedManeuver {
+	cues := []PlannedManeuver{}
+	for _, m := range p.Maneuvers {
+		if m.ManeuverType != maneuverDepart && m.ManeuverType != maneuverArrive &&
+			(m.Modifier == nil || *m.Modifier == modifierStraight) {
+			continue
+		}
+		cues = append(cues, m)
+	}
+	return cues
+}
@@ -0,0 +1,83 @@
+package route
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+func TestParseRoutingProfile(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    RoutingProfile
+		wantErr bool
+	}{
+		{s: "", want: RoutingProfileRoad},
+		{s: "road", want: RoutingProfileRoad},
+		{s: "gravel", want: RoutingProfileGravel},
+		{s: "avoid_highways", want: RoutingProfileAvoidHighways},
+		{s: "mtb", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseRoutingProfile(tt.s)
+		if tt.wantErr {
+			if !errors.Is(err, domainerror.ErrValidation) {
+				t.Errorf("ParseRoutingProfile(%q) error = %v, want ErrValidation", tt.s, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseRoutingProfile(%q) = %s, %v, want %s", tt.s, got, err, tt.want)
+		}
+	}
+}
+
+func TestValidatePlanWaypoints(t *testing.T) {
+	tooMany := make([]orb.Point, MaxPlanWaypoints+1)
+	tests := []struct {
+		name      string
+		waypoints []orb.Point
+		wantErr   bool
+	}{
+		{name: "正常系: 始点と終点", waypoints: []orb.Point{{139.70, 35.68}, {139.71, 35.69}}},
+		{name: "異常系: 1地点のみ", waypoints: []orb.Point{{139.70, 35.68}}, wantErr: true},
+		{name: "異常系: 経由地が多すぎる", waypoints: tooMany, wantErr: true},
+		{name: "異常系: 緯度が範囲外", waypoints: []orb.Point{{139.70, 35.68}, {139.71, 95}}, wantErr: true},
+		{name: "正常系: 範囲の幅が上限ちょうど", waypoints: []orb.Point{{139.0, 35.0}, {139.0 + MaxRoutingSpan, 35.0 + MaxRoutingSpan}}},
+		{name: "異常系: 東西の幅が上限を超える", waypoints: []orb.Point{{139.0, 35.68}, {139.5, 35.68}, {140.1, 35.68}}, wantErr: true},
+		{name: "異常系: 南北の幅が上限を超える", waypoints: []orb.Point{{139.70, 34.5}, {139.70, 35.6}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePlanWaypoints(tt.waypoints)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePlanWaypoints() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlannedRoute_CueManeuvers(t *testing.T) {
+	p := &PlannedRoute{
+		Maneuvers: []PlannedManeuver{
+			{ManeuverType: "depart", Modifier: new("right")},
+			{ManeuverType: "new name", Modifier: new("straight")},
+			{ManeuverType: "turn", Modifier: new("left")},
+			{ManeuverType: "continue"},
+			{ManeuverType: "end of road", Modifier: new("right")},
+			{ManeuverType: "arrive", Modifier: new("straight")},
+		},
+	}
+	got := p.CueManeuvers()
+	want := []string{"depart", "turn", "end of road", "arrive"}
+	if len(got) != len(want) {
+		t.Fatalf("len = %d, want %d", len(got), len(want))
+	}
+	for i, m := range got {
+		if m.ManeuverType != want[i] {
+			t.Errorf("CueManeuvers()[%d] = %s, want %s", i, m.ManeuverType, want[i])
+		}
+	}
+}
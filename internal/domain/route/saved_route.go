@@ -0,0 +1,28 @@
+package route
+
+import (
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+)
+
+// SavedRouteCriteria はユーザーが保存したルート一覧の取得条件
+// 保存した日時の新しい順にキーセットページネーションで取得する
+type SavedRouteCriteria struct {
+	userID string
+	limit  int32
+	after  *pagination.Cursor // nilの場合は先頭から取得する
+}
+
+func NewSavedRouteCriteria(userID string, limit int32, after *pagination.Cursor) (*SavedRouteCriteria, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+	return &SavedRouteCriteria{userID: userID, limit: limit, after: after}, nil
+}
+
+func (c *SavedRouteCriteria) UserID() string            { return c.userID }
+func (c *SavedRouteCriteria) Limit() int32              { return c.limit }
+func (c *SavedRouteCriteria) After() *pagination.Cursor { return c.after }
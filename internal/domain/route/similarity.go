@@ -0,0 +1,267 @@
+package route
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+	"github.com/paulmach/orb/simplify"
+)
+
+// 類似ルート判定の既定値
+const (
+	DefaultSimplifyToleranceM  = 20.0  // 比較前にpath_geomを簡略化するときの許容誤差(m)
+	DefaultDuplicateThresholdM = 100.0 // フレシェ距離がこれ以下なら重複ルートとみなす(m)
+	DefaultSimilarThresholdM   = 500.0 // ハウスドルフ距離がこれ以下なら類似ルートとみなす(m)
+
+	// 候補を絞り込むときに許容する総距離の差(割合)
+	candidateDistanceRatio = 0.25
+	// 距離計算に使う1ルートあたりの最大サンプル数（フレシェ距離はO(n*m)のため上限を設ける）
+	maxSimilaritySamples = 500
+)
+
+// SimilarityResult は2つのルートのジオメトリ比較結果
+type SimilarityResult struct {
+	HausdorffDistance float64 // ハウスドルフ距離(m)。向きを考慮しない形状の近さ
+	FrechetDistance   float64 // フレシェ距離(m)。進行方向を考慮した形状の近さ
+	IsDuplicate       bool    // ほぼ同一のルートかどうか
+}
+
+// SimilarRouteResult は類似ルート検索の結果
+type SimilarRouteResult struct {
+	Route      *Route
+	UserName   string
+	Similarity SimilarityResult
+}
+
+// SimilarityService はpath_geomの近さからルートの類似・重複を判定するドメインサービス
+type SimilarityService struct {
+	simplifyToleranceM  float64
+	duplicateThresholdM float64
+	similarThresholdM   float64
+}
+
+func NewSimilarityService(simplifyToleranceM, duplicateThresholdM, similarThresholdM float64) (*SimilarityService, error) {
+	if simplifyToleranceM < 0 {
+		return nil, errors.New("simplifyToleranceM must be non-negative")
+	}
+	if duplicateThresholdM <= 0 {
+		return nil, errors.New("duplicateThresholdM must be positive")
+	}
+	if similarThresholdM < duplicateThresholdM {
+		return nil, errors.New("similarThresholdM must be greater than or equal to duplicateThresholdM")
+	}
+
+	return &SimilarityService{
+		simplifyToleranceM:  simplifyToleranceM,
+		duplicateThresholdM: duplicateThresholdM,
+		similarThresholdM:   similarThresholdM,
+	}, nil
+}
+
+// NewDefaultSimilarityService は既定値で類似判定サービスを作成する
+func NewDefaultSimilarityService() *SimilarityService {
+	return &SimilarityService{
+		simplifyToleranceM:  DefaultSimplifyToleranceM,
+		duplicateThresholdM: DefaultDuplicateThresholdM,
+		similarThresholdM:   DefaultSimilarThresholdM,
+	}
+}
+
+// CandidateCriteria は類似ルート候補をDBから絞り込むための条件を作成する
+// bboxが重なり、総距離が近いルートだけを候補にする
+func (s *SimilarityService) CandidateCriteria(target *Route, limit int32) (*SimilarRouteCandidatesCriteria, error) {
+	return NewSimilarRouteCandidatesCriteria(
+		target.ID(),
+		target.PathGeom(),
+		target.FirstPoint(),
+		s.similarThresholdM,
+		target.Distance()*(1-candidateDistanceRatio),
+		target.Distance()*(1+candidateDistanceRatio),
+		limit,
+	)
+}
+
+// Compare は2つのルートを簡略化したpath_geom同士で比較する
+func (s *SimilarityService) Compare(a, b *Route) (*SimilarityResult, error) {
+	lineA, ok := a.PathGeom().Geometry.(orb.LineString)
+	if !ok {
+		return nil, errors.New("pathGeom must be a LineString")
+	}
+	lineB, ok := b.PathGeom().Geometry.(orb.LineString)
+	if !ok {
+		return nil, errors.New("pathGeom must be a LineString")
+	}
+
+	// 経度方向の歪みを抑えるため、2つのルートの中心緯度を基準にメートル座標へ投影する
+	proj := newLocalProjection(lineA.Bound().Union(lineB.Bound()).Center())
+	sampledA := s.prepare(proj.lineString(lineA))
+	sampledB := s.prepare(proj.lineString(lineB))
+
+	hausdorff := hausdorffDistance(sampledA, sampledB)
+	frechet := discreteFrechetDistance(sampledA, sampledB)
+
+	return &SimilarityResult{
+		HausdorffDistance: hausdorff,
+		FrechetDistance:   frechet,
+		IsDuplicate:       frechet <= s.duplicateThresholdM,
+	}, nil
+}
+
+// IsNearDuplicate は2つのルートがほぼ同一かどうかを返す
+func (s *SimilarityService) IsNearDuplicate(a, b *Route) bool {
+	result, err := s.Compare(a, b)
+	if err != nil {
+		return false
+	}
+	return result.IsDuplicate
+}
+
+// RankSimilar は候補の中から類似ルートを抽出し、ハウスドルフ距離の近い順に並べる
+func (s *SimilarityService) RankSimilar(target *Route, candidates []*ExploreRouteResult) []*SimilarRouteResult {
+	results := make([]*SimilarRouteResult, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Route.ID() == target.ID() {
+			continue
+		}
+		similarity, err := s.Compare(target, c.Route)
+		if err != nil {
+			continue
+		}
+		if similarity.HausdorffDistance > s.similarThresholdM {
+			continue
+		}
+		results = append(results, &SimilarRouteResult{
+			Route:      c.Route,
+			UserName:   c.UserName,
+			Similarity: *similarity,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Similarity.HausdorffDistance < results[j].Similarity.HausdorffDistance
+	})
+	return results
+}
+
+// CollapseNearDuplicates は重複ルートを取り除き、先に現れたルートだけを残す
+// 並び順は維持される
+func (s *SimilarityService) CollapseNearDuplicates(results []*ExploreRouteResult) []*ExploreRouteResult {
+	kept := make([]*ExploreRouteResult, 0, len(results))
+	for _, r := range results {
+		duplicated := false
+		for _, k := range kept {
+			if s.IsNearDuplicate(k.Route, r.Route) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// prepare は比較用にラインを簡略化し、距離計算用に一定間隔で再サンプリングする
+func (s *SimilarityService) prepare(ls orb.LineString) orb.LineString {
+	if s.simplifyToleranceM > 0 && len(ls) > 2 {
+		ls = simplify.DouglasPeucker(s.simplifyToleranceM).LineString(ls.Clone())
+	}
+
+	interval := s.duplicateThresholdM / 2
+	if length := planar.Length(ls); length/interval > maxSimilaritySamples {
+		interval = length / maxSimilaritySamples
+	}
+	return densify(ls, interval)
+}
+
+// localProjection は基準点周辺を正距円筒図法でメートル座標に投影する
+type localProjection struct {
+	cosLat float64
+}
+
+func newLocalProjection(center orb.Point) localProjection {
+	return localProjection{cosLat: math.Cos(center.Lat() * math.Pi / 180)}
+}
+
+func (p localProjection) point(pt orb.Point) orb.Point {
+	return orb.Point{
+		orb.EarthRadius * pt.Lon() * math.Pi / 180 * p.cosLat,
+		orb.EarthRadius * pt.Lat() * math.Pi / 180,
+	}
+}
+
+func (p localProjection) lineString(ls orb.LineString) orb.LineString {
+	projected := make(orb.LineString, len(ls))
+	for i, pt := range ls {
+		projected[i] = p.point(pt)
+	}
+	return projected
+}
+
+// densify は各セグメントがinterval以下になるよう点を補間する
+func densify(ls orb.LineString, interval float64) orb.LineString {
+	if len(ls) < 2 || interval <= 0 {
+		return ls
+	}
+
+	result := orb.LineString{ls[0]}
+	for i := 1; i < len(ls); i++ {
+		from, to := ls[i-1], ls[i]
+		n := int(math.Ceil(planar.Distance(from, to) / interval))
+		for k := 1; k < n; k++ {
+			t := float64(k) / float64(n)
+			result = append(result, orb.Point{
+				from[0] + (to[0]-from[0])*t,
+				from[1] + (to[1]-from[1])*t,
+			})
+		}
+		result = append(result, to)
+	}
+	return result
+}
+
+// hausdorffDistance は双方向のハウスドルフ距離を求める
+func hausdorffDistance(a, b orb.LineString) float64 {
+	return math.Max(directedHausdorffDistance(a, b), directedHausdorffDistance(b, a))
+}
+
+func directedHausdorffDistance(from, to orb.LineString) float64 {
+	maxDist := 0.0
+	for _, p := range from {
+		if d := planar.DistanceFrom(to, p); d > maxDist {
+			maxDist = d
+		}
+	}
+	return maxDist
+}
+
+// discreteFrechetDistance は離散フレシェ距離を動的計画法で求める
+func discreteFrechetDistance(a, b orb.LineString) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return math.Inf(1)
+	}
+
+	prev := make([]float64, len(b))
+	curr := make([]float64, len(b))
+	for i := range a {
+		for j := range b {
+			d := planar.Distance(a[i], b[j])
+			switch {
+			case i == 0 && j == 0:
+				curr[j] = d
+			case i == 0:
+				curr[j] = math.Max(curr[j-1], d)
+			case j == 0:
+				curr[j] = math.Max(prev[j], d)
+			default:
+				curr[j] = math.Max(math.Min(prev[j], math.Min(prev[j-1], curr[j-1])), d)
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)-1]
+}
@@ -0,0 +1,165 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func newTestRoute(t *testing.T, id string, ls orb.LineString) *Route {
+	t.Helper()
+	r, err := ReconstructRoute(
+		id,
+		"019b5a8d-16a7-700a-be92-9ae11e7e5b9a",
+		"Test Route",
+		"",
+		nil, 1000, 3600, 0, 0,
+		Geometry{ls}, Geometry{ls.Bound().ToPolygon()},
+		Geometry{ls[0]}, Geometry{ls[len(ls)-1]},
+		"", 1, 1, nil, "", "",
+	)
+	if err != nil {
+		t.Fatalf("failed to reconstruct route: %v", err)
+	}
+	return r
+}
+
+func TestSimilarityService_Compare(t *testing.T) {
+	// 東京駅付近を東西に約1.8km走るルート
+	base := orb.LineString{{139.7600, 35.6800}, {139.7700, 35.6800}, {139.7800, 35.6800}}
+
+	tests := []struct {
+		name          string
+		other         orb.LineString
+		wantDuplicate bool
+		wantMaxHaus   float64 // ハウスドルフ距離の上限(m)
+		wantMinHaus   float64 // ハウスドルフ距離の下限(m)
+	}{
+		{
+			name:          "正常系: 同一ルートは重複と判定される",
+			other:         base,
+			wantDuplicate: true,
+			wantMaxHaus:   1,
+		},
+		{
+			name:          "正常系: 約30m北にずれたルートは重複と判定される",
+			other:         orb.LineString{{139.7600, 35.68027}, {139.7700, 35.68027}, {139.7800, 35.68027}},
+			wantDuplicate: true,
+			wantMinHaus:   20,
+			wantMaxHaus:   40,
+		},
+		{
+			name:          "正常系: 中間点が多いだけのルートは重複と判定される",
+			other:         orb.LineString{{139.7600, 35.6800}, {139.7650, 35.6800}, {139.7700, 35.6800}, {139.7750, 35.6800}, {139.7800, 35.6800}},
+			wantDuplicate: true,
+			wantMaxHaus:   1,
+		},
+		{
+			name:          "正常系: 逆向きのルートは形状が同じでも重複ではない",
+			other:         orb.LineString{{139.7800, 35.6800}, {139.7700, 35.6800}, {139.7600, 35.6800}},
+			wantDuplicate: false,
+			wantMaxHaus:   1,
+		},
+		{
+			name:          "正常系: 約1km離れたルートは重複ではない",
+			other:         orb.LineString{{139.7600, 35.6890}, {139.7700, 35.6890}, {139.7800, 35.6890}},
+			wantDuplicate: false,
+			wantMinHaus:   900,
+			wantMaxHaus:   1100,
+		},
+	}
+
+	s := NewDefaultSimilarityService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestRoute(t, "019b5a50-0000-7000-8000-000000000001", base)
+			b := newTestRoute(t, "019b5a50-0000-7000-8000-000000000002", tt.other)
+
+			got, err := s.Compare(a, b)
+			if err != nil {
+				t.Fatalf("Compare() unexpected error: %v", err)
+			}
+			if got.IsDuplicate != tt.wantDuplicate {
+				t.Errorf("IsDuplicate = %v, want %v (frechet=%.1f)", got.IsDuplicate, tt.wantDuplicate, got.FrechetDistance)
+			}
+			if got.HausdorffDistance < tt.wantMinHaus || got.HausdorffDistance > tt.wantMaxHaus {
+				t.Errorf("HausdorffDistance = %.1f, want between %.1f and %.1f", got.HausdorffDistance, tt.wantMinHaus, tt.wantMaxHaus)
+			}
+			if got.FrechetDistance < got.HausdorffDistance {
+				t.Errorf("FrechetDistance (%.1f) must not be less than HausdorffDistance (%.1f)", got.FrechetDistance, got.HausdorffDistance)
+			}
+		})
+	}
+}
+
+func TestSimilarityService_RankSimilar(t *testing.T) {
+	base := orb.LineString{{139.7600, 35.6800}, {139.7700, 35.6800}, {139.7800, 35.6800}}
+	target := newTestRoute(t, "019b5a50-0000-7000-8000-000000000001", base)
+	near := newTestRoute(t, "019b5a50-0000-7000-8000-000000000002", orb.LineString{{139.7600, 35.6820}, {139.7700, 35.6820}, {139.7800, 35.6820}})
+	same := newTestRoute(t, "019b5a50-0000-7000-8000-000000000003", base)
+	far := newTestRoute(t, "019b5a50-0000-7000-8000-000000000004", orb.LineString{{139.7600, 35.7000}, {139.7700, 35.7000}, {139.7800, 35.7000}})
+
+	candidates := []*ExploreRouteResult{
+		{Route: near, UserName: "near"},
+		{Route: far, UserName: "far"},
+		{Route: target, UserName: "self"},
+		{Route: same, UserName: "same"},
+	}
+
+	got := NewDefaultSimilarityService().RankSimilar(target, candidates)
+	if len(got) != 2 {
+		t.Fatalf("RankSimilar() returned %d results, want 2", len(got))
+	}
+	if got[0].UserName != "same" || got[1].UserName != "near" {
+		t.Errorf("RankSimilar() order = [%s, %s], want [same, near]", got[0].UserName, got[1].UserName)
+	}
+	if !got[0].Similarity.IsDuplicate {
+		t.Errorf("identical route should be marked as duplicate")
+	}
+	if got[1].Similarity.IsDuplicate {
+		t.Errorf("route about 220m away should not be marked as duplicate")
+	}
+}
+
+func TestSimilarityService_CollapseNearDuplicates(t *testing.T) {
+	base := orb.LineString{{139.7600, 35.6800}, {139.7700, 35.6800}, {139.7800, 35.6800}}
+	first := newTestRoute(t, "019b5a50-0000-7000-8000-000000000001", base)
+	dup := newTestRoute(t, "019b5a50-0000-7000-8000-000000000002", orb.LineString{{139.7600, 35.68010}, {139.7800, 35.68010}})
+	other := newTestRoute(t, "019b5a50-0000-7000-8000-000000000003", orb.LineString{{139.7600, 35.7000}, {139.7800, 35.7000}})
+
+	got := NewDefaultSimilarityService().CollapseNearDuplicates([]*ExploreRouteResult{
+		{Route: first, UserName: "first"},
+		{Route: dup, UserName: "dup"},
+		{Route: other, UserName: "other"},
+	})
+	if len(got) != 2 {
+		t.Fatalf("CollapseNearDuplicates() returned %d results, want 2", len(got))
+	}
+	if got[0].UserName != "first" || got[1].UserName != "other" {
+		t.Errorf("CollapseNearDuplicates() = [%s, %s], want [first, other]", got[0].UserName, got[1].UserName)
+	}
+}
+
+func TestNewSimilarityService(t *testing.T) {
+	tests := []struct {
+		name      string
+		simplify  float64
+		duplicate float64
+		similar   float64
+		wantErr   bool
+	}{
+		{name: "正常系: 既定値と同じ設定", simplify: 20, duplicate: 100, similar: 500},
+		{name: "正常系: 簡略化しない", simplify: 0, duplicate: 100, similar: 100},
+		{name: "異常系: 許容誤差が負", simplify: -1, duplicate: 100, similar: 500, wantErr: true},
+		{name: "異常系: 重複判定の閾値が0", simplify: 20, duplicate: 0, similar: 500, wantErr: true},
+		{name: "異常系: 類似判定の閾値が重複判定より小さい", simplify: 20, duplicate: 100, similar: 50, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSimilarityService(tt.simplify, tt.duplicate, tt.similar)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSimilarityService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
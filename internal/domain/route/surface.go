@@ -0,0 +1,106 @@
+package route
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SurfaceType は路面の分類
+type SurfaceType string
+
+const (
+	SurfaceTypePaved   SurfaceType = "paved"
+	SurfaceTypeGravel  SurfaceType = "gravel"
+	SurfaceTypeDirt    SurfaceType = "dirt"
+	SurfaceTypeUnknown SurfaceType = "unknown" // 近くに道路がない、またはsurfaceタグから分類できない
+)
+
+// IsUnpaved は未舗装の分類かどうかを判定する
+func (t SurfaceType) IsUnpaved() bool {
+	return t == SurfaceTypeGravel || t == SurfaceTypeDirt
+}
+
+// RoadClassUnknown は近くに道路がない区間の道路の種類
+const RoadClassUnknown = "unknown"
+
+// 路面の分類に使うOSMのsurfaceタグ
+var surfaceTags = map[SurfaceType][]string{
+	SurfaceTypePaved: {
+		"paved", "asphalt", "chipseal", "concrete", "concrete:plates", "concrete:lanes",
+		"paving_stones", "sett", "cobblestone", "unhewn_cobblestone", "metal", "wood",
+	},
+	SurfaceTypeGravel: {"gravel", "fine_gravel", "compacted", "pebblestone", "unpaved"},
+	SurfaceTypeDirt:   {"dirt", "earth", "ground", "grass", "mud", "sand", "rock", "woodchips"},
+}
+
+// SurfaceTags は路面の分類に含めるsurfaceタグを返す
+func SurfaceTags(t SurfaceType) []string {
+	return slices.Clone(surfaceTags[t])
+}
+
+// UnpavedHighways はsurfaceタグがない場合に舗装路とみなさない道路の種類
+func UnpavedHighways() []string {
+	return []string{"track", "path"}
+}
+
+// SurfaceShare は分類ごとの距離と割合
+type SurfaceShare struct {
+	Category   string
+	Distance   float64 // 距離(m)
+	Percentage float64 // 全体に占める割合(%)
+}
+
+// SurfaceBreakdown はルートの路面と道路の種類の内訳
+type SurfaceBreakdown struct {
+	surfaces    []SurfaceShare
+	roadClasses []SurfaceShare
+}
+
+// NewSurfaceBreakdown は分類ごとの距離から内訳を作る。距離の長い順に並べる
+func NewSurfaceBreakdown(surfaceDistances, roadClassDistances map[string]float64) *SurfaceBreakdown {
+	return &SurfaceBreakdown{
+		surfaces:    toSurfaceShares(surfaceDistances),
+		roadClasses: toSurfaceShares(roadClassDistances),
+	}
+}
+
+func toSurfaceShares(distances map[string]float64) []SurfaceShare {
+	total := 0.0
+	for _, d := range distances {
+		total += d
+	}
+	shares := make([]SurfaceShare, 0, len(distances))
+	for category, d := range distances {
+		share := SurfaceShare{Category: category, Distance: d}
+		if total > 0 {
+			share.Percentage = d / total * 100
+		}
+		shares = append(shares, share)
+	}
+	slices.SortFunc(shares, func(a, b SurfaceShare) int {
+		if c := cmp.Compare(b.Distance, a.Distance); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Category, b.Category)
+	})
+	return shares
+}
+
+func (b *SurfaceBreakdown) Surfaces() []SurfaceShare {
+	return slices.Clone(b.surfaces)
+}
+
+func (b *SurfaceBreakdown) RoadClasses() []SurfaceShare {
+	return slices.Clone(b.roadClasses)
+}
+
+// UnpavedPercentage は未舗装（gravel, dirt）の割合(%)を返す
+func (b *SurfaceBreakdown) UnpavedPercentage() float64 {
+	percentage := 0.0
+	for _, s := range b.surfaces {
+		if SurfaceType(s.Category).IsUnpaved() {
+			percentage += s.Percentage
+		}
+	}
+	return percentage
+}
@@ -0,0 +1,43 @@
+package route
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestNewSurfaceBreakdown(t *testing.T) {
+	b := NewSurfaceBreakdown(
+		map[string]float64{"paved": 6000, "gravel": 3000, "dirt": 500, "unknown": 500},
+		map[string]float64{"tertiary": 6000, "track": 4000},
+	)
+
+	surfaces := b.Surfaces()
+	// 距離の長い順に並び、同じ距離の場合は分類の名前順
+	got := []string{}
+	for _, s := range surfaces {
+		got = append(got, s.Category)
+	}
+	if !slices.Equal(got, []string{"paved", "gravel", "dirt", "unknown"}) {
+		t.Errorf("Surfaces() order = %v", got)
+	}
+	if surfaces[0].Percentage != 60 || surfaces[1].Distance != 3000 {
+		t.Errorf("Surfaces() = %+v", surfaces)
+	}
+	if math.Abs(b.UnpavedPercentage()-35) > 1e-9 {
+		t.Errorf("UnpavedPercentage() = %v, want 35", b.UnpavedPercentage())
+	}
+	if roadClasses := b.RoadClasses(); len(roadClasses) != 2 || roadClasses[1].Percentage != 40 {
+		t.Errorf("RoadClasses() = %+v", roadClasses)
+	}
+}
+
+func TestNewSurfaceBreakdown_Empty(t *testing.T) {
+	b := NewSurfaceBreakdown(map[string]float64{"unknown": 0}, nil)
+	if s := b.Surfaces(); len(s) != 1 || s[0].Percentage != 0 {
+		t.Errorf("Surfaces() = %+v", s)
+	}
+	if b.UnpavedPercentage() != 0 {
+		t.Errorf("UnpavedPercentage() = %v", b.UnpavedPercentage())
+	}
+}
@@ -0,0 +1,63 @@
+package route
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+// タグの最大文字数と、ルートに付けられるタグの上限
+const (
+	MaxTagLength    = 30
+	MaxTagsPerRoute = 20
+)
+
+// NormalizeTag はタグの表記を揃える
+// 前後の空白と先頭の#を取り除き、英字は小文字にする（"#Gravel" と "gravel" は同じタグ）
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if tag == "" {
+		return "", domainerror.New("tag must not be empty", domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(tag) > MaxTagLength {
+		return "", domainerror.New(fmt.Sprintf("tag must be at most %d characters", MaxTagLength), domainerror.ErrValidation)
+	}
+	if strings.ContainsAny(tag, ", \t\n") {
+		return "", domainerror.New("tag must not contain commas or spaces: "+tag, domainerror.ErrValidation)
+	}
+	return tag, nil
+}
+
+// NormalizeTags はタグの表記を揃え、重複を取り除く。順序は最初に現れた順を保つ
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		tag, err := NormalizeTag(t)
+		if err != nil {
+			return nil, err
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > MaxTagsPerRoute {
+		return nil, domainerror.New(fmt.Sprintf("tags must be at most %d", MaxTagsPerRoute), domainerror.ErrValidation)
+	}
+	return normalized, nil
+}
+
+// UpdateTags はルートのタグを置き換える
+// タグは整理のためのもので経路を変えないため、版（RouteVersion）は作らない
+func (r *Route) UpdateTags(tags []string) error {
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return err
+	}
+	r.tags = normalized
+	return nil
+}
@@ -0,0 +1,50 @@
+package route
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tooMany := make([]string, MaxTagsPerRoute+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag%d", i)
+	}
+
+	tests := []struct {
+		name    string
+		tags    []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "表記を揃えて重複を取り除く", tags: []string{" #Gravel", "北海道", "gravel", "峠"}, want: []string{"gravel", "北海道", "峠"}},
+		{name: "タグなし", tags: nil, want: []string{}},
+		{name: "異常系: 空のタグ", tags: []string{"峠", " # "}, wantErr: true},
+		{name: "異常系: 空白を含む", tags: []string{"best of hokkaido"}, wantErr: true},
+		{name: "異常系: カンマを含む", tags: []string{"峠,坂"}, wantErr: true},
+		{name: "異常系: 長すぎる", tags: []string{strings.Repeat("坂", MaxTagLength+1)}, wantErr: true},
+		{name: "異常系: 多すぎる", tags: tooMany, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeTags(tt.tags)
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("NormalizeTags() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeTags() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizeTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
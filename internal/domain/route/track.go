@@ -0,0 +1,32 @@
+package route
+
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/simplify"
+)
+
+// DefaultTrackSimplifyToleranceM は記録した軌跡からルートを作るときの簡略化の許容誤差(m)
+// GPSの揺れは消えるが、交差点の曲がり角は残る程度にする
+const DefaultTrackSimplifyToleranceM = 5.0
+
+// SimplifyTrack は軌跡を許容誤差(m)の範囲で間引く
+// 残した点は投影前の座標をそのまま使う
+func SimplifyTrack(track orb.LineString, toleranceM float64) orb.LineString {
+	if len(track) <= 2 || toleranceM <= 0 {
+		return track
+	}
+	proj := newLocalProjection(track.Bound().Center())
+	projected := proj.lineString(track)
+	simplified := simplify.DouglasPeucker(toleranceM).LineString(projected.Clone())
+
+	// 間引いた後の点は元の点の部分列なので、順に突き合わせて元の座標に戻す
+	result := make(orb.LineString, 0, len(simplified))
+	j := 0
+	for i, p := range projected {
+		if j < len(simplified) && p == simplified[j] {
+			result = append(result, track[i])
+			j++
+		}
+	}
+	return result
+}
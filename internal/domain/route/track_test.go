@@ -0,0 +1,34 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestSimplifyTrack(t *testing.T) {
+	// 東へ進み、1mほど揺れながら北へ曲がる軌跡
+	track := orb.LineString{
+		{139.70000, 35.68000},
+		{139.70100, 35.68001},
+		{139.70200, 35.67999},
+		{139.70300, 35.68000},
+		{139.70301, 35.68100},
+		{139.70299, 35.68200},
+		{139.70300, 35.68300},
+	}
+
+	got := SimplifyTrack(track, DefaultTrackSimplifyToleranceM)
+	want := orb.LineString{track[0], track[3], track[6]}
+	if !got.Equal(want) {
+		t.Errorf("SimplifyTrack() = %v, want %v", got, want)
+	}
+
+	// 許容誤差より大きく曲がる点は残す
+	if got := SimplifyTrack(track, 0.5); len(got) != len(track) {
+		t.Errorf("SimplifyTrack(0.5) = %d points, want %d", len(got), len(track))
+	}
+	if got := SimplifyTrack(track[:2], DefaultTrackSimplifyToleranceM); len(got) != 2 {
+		t.Errorf("SimplifyTrack() with 2 points = %v", got)
+	}
+}
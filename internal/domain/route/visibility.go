@@ -0,0 +1,107 @@
+package route
+
+import (
+	"context"
+	"slices"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+// 公開範囲
+const (
+	VisibilityPrivate int16 = 0
+	VisibilityPublic  int16 = 1
+	VisibilityFriends int16 = 2
+	VisibilityClub    int16 = 3 // 共有先のクラブのメンバーのみ
+)
+
+// ClubMembershipReader はユーザーが所属するクラブを取得する
+type ClubMembershipReader interface {
+	GetClubIDsByUserID(ctx context.Context, userID string) ([]string, error)
+}
+
+// Viewer は公開範囲を判定するときの閲覧ユーザー。未ログインの場合はゼロ値を使う
+type Viewer struct {
+	userID  string
+	clubIDs []string // 所属するクラブ
+}
+
+func NewViewer(userID string, clubIDs []string) Viewer {
+	return Viewer{userID: userID, clubIDs: clubIDs}
+}
+
+// ResolveViewer は閲覧ユーザーを所属するクラブとともに作成する。userIDが空の場合は未ログインとして扱う
+func ResolveViewer(ctx context.Context, reader ClubMembershipReader, userID string) (Viewer, error) {
+	if userID == "" {
+		return Viewer{}, nil
+	}
+	clubIDs, err := reader.GetClubIDsByUserID(ctx, userID)
+	if err != nil {
+		return Viewer{}, err
+	}
+	return NewViewer(userID, clubIDs), nil
+}
+
+func (v Viewer) UserID() string { return v.userID }
+
+// ClubIDs は閲覧ユーザーが所属するクラブ
+func (v Viewer) ClubIDs() []string { return slices.Clone(v.clubIDs) }
+
+// IsMemberOf は閲覧ユーザーがクラブに所属しているかを返す
+func (v Viewer) IsMemberOf(clubID string) bool {
+	return slices.Contains(v.clubIDs, clubID)
+}
+
+// CanView は所有者・公開範囲・共有先のクラブから閲覧できるかを返す。ルートとイベントで同じ判定を使う
+// 友達の関係はまだないため、友達のみは所有者だけが閲覧できる
+func (v Viewer) CanView(ownerID string, visibility int16, clubID *string) bool {
+	if v.userID != "" && v.userID == ownerID {
+		return true
+	}
+	switch visibility {
+	case VisibilityPublic:
+		return true
+	case VisibilityClub:
+		return clubID != nil && v.IsMemberOf(*clubID)
+	}
+	return false
+}
+
+// CheckVisibility は公開範囲と共有先のクラブの組み合わせを確かめる
+// クラブのメンバーのみにする場合は、所有者が所属するクラブを指定しなければならない
+func CheckVisibility(owner Viewer, visibility int16, clubID *string) error {
+	if visibility < VisibilityPrivate || visibility > VisibilityClub {
+		return domainerror.New("visibility must be between 0 and 3", domainerror.ErrValidation)
+	}
+	if visibility != VisibilityClub {
+		if clubID != nil {
+			return domainerror.New("club_id can only be set for club visibility", domainerror.ErrValidation)
+		}
+		return nil
+	}
+	if clubID == nil {
+		return domainerror.New("club_id is required for club visibility", domainerror.ErrValidation)
+	}
+	if !owner.IsMemberOf(*clubID) {
+		return domainerror.New("user is not a member of the club", domainerror.ErrUnauthorized)
+	}
+	return nil
+}
+
+// IsVisibleTo はユーザーがルートを閲覧できるかを返す
+func (r *Route) IsVisibleTo(viewer Viewer) bool {
+	return viewer.CanView(r.userID, r.visibility, r.clubID)
+}
+
+// ChangeVisibility はルートの公開範囲を変更する。クラブのメンバーのみにする場合は共有先のクラブを指定する
+func (r *Route) ChangeVisibility(owner Viewer, visibility int16, clubID *string) error {
+	if owner.UserID() != r.userID {
+		return domainerror.New("user does not own the route", domainerror.ErrUnauthorized)
+	}
+	if err := CheckVisibility(owner, visibility, clubID); err != nil {
+		return err
+	}
+	r.visibility = visibility
+	r.clubID = clubID
+	return nil
+}
@@ -0,0 +1,72 @@
+package route
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+func TestRoute_ChangeVisibility(t *testing.T) {
+	const ownerID = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+
+	tests := []struct {
+		name       string
+		owner      Viewer
+		visibility int16
+		clubID     *string
+		wantErr    error
+	}{
+		{name: "正常系: 公開", owner: NewViewer(ownerID, nil), visibility: VisibilityPublic},
+		{name: "正常系: 所属するクラブのメンバーのみ", owner: NewViewer(ownerID, []string{"club-1"}), visibility: VisibilityClub, clubID: new("club-1")},
+		{name: "異常系: 未定義の公開範囲", owner: NewViewer(ownerID, nil), visibility: 4, wantErr: domainerror.ErrValidation},
+		{name: "異常系: クラブのメンバーのみで共有先がない", owner: NewViewer(ownerID, nil), visibility: VisibilityClub, wantErr: domainerror.ErrValidation},
+		{name: "異常系: 公開で共有先がある", owner: NewViewer(ownerID, []string{"club-1"}), visibility: VisibilityPublic, clubID: new("club-1"), wantErr: domainerror.ErrValidation},
+		{name: "異常系: 所属していないクラブ", owner: NewViewer(ownerID, []string{"club-2"}), visibility: VisibilityClub, clubID: new("club-1"), wantErr: domainerror.ErrUnauthorized},
+		{name: "異常系: 所有者以外", owner: NewViewer("other", []string{"club-1"}), visibility: VisibilityClub, clubID: new("club-1"), wantErr: domainerror.ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := ReconstructRoute("route-1", ownerID, "多摩川", "", nil, 0, 0, 0, 0, Geometry{}, Geometry{}, Geometry{}, Geometry{}, "", VisibilityPrivate, 1, nil, "", "")
+			err := r.ChangeVisibility(tt.owner, tt.visibility, tt.clubID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ChangeVisibility() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || r.Visibility() != tt.visibility {
+				t.Fatalf("ChangeVisibility() error = %v, visibility = %d", err, r.Visibility())
+			}
+		})
+	}
+}
+
+func TestRoute_IsVisibleTo(t *testing.T) {
+	const ownerID = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+
+	tests := []struct {
+		name       string
+		visibility int16
+		viewer     Viewer
+		want       bool
+	}{
+		{name: "所有者は非公開でも閲覧できる", visibility: VisibilityPrivate, viewer: NewViewer(ownerID, nil), want: true},
+		{name: "公開は未ログインでも閲覧できる", visibility: VisibilityPublic, viewer: Viewer{}, want: true},
+		{name: "友達のみは他のユーザーは閲覧できない", visibility: VisibilityFriends, viewer: NewViewer("other", nil), want: false},
+		{name: "クラブのメンバーは閲覧できる", visibility: VisibilityClub, viewer: NewViewer("other", []string{"club-1"}), want: true},
+		{name: "クラブのメンバー以外は閲覧できない", visibility: VisibilityClub, viewer: NewViewer("other", []string{"club-2"}), want: false},
+		{name: "未ログインはクラブのメンバーのみを閲覧できない", visibility: VisibilityClub, viewer: Viewer{}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := ReconstructRoute("route-1", ownerID, "多摩川", "", nil, 0, 0, 0, 0, Geometry{}, Geometry{}, Geometry{}, Geometry{}, "", tt.visibility, 1, nil, "", "")
+			if tt.visibility == VisibilityClub {
+				r.SetClubID(new("club-1"))
+			}
+			if got := r.IsVisibleTo(tt.viewer); got != tt.want {
+				t.Errorf("IsVisibleTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
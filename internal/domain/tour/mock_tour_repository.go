@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/tour/tour_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/tour/tour_repository.go -destination=internal/domain/tour/mock_tour_repository.go -package tour
+//
+
+// Package tour is a generated GoMock package.
+package tour
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITourRepository is a mock of ITourRepository interface.
+type MockITourRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITourRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITourRepositoryMockRecorder is the mock recorder for MockITourRepository.
+type MockITourRepositoryMockRecorder struct {
+	mock *MockITourRepository
+}
+
+// NewMockITourRepository creates a new mock instance.
+func NewMockITourRepository(ctrl *gomock.Controller) *MockITourRepository {
+	mock := &MockITourRepository{ctrl: ctrl}
+	mock.recorder = &MockITourRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITourRepository) EXPECT() *MockITourRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteTour mocks base method.
+func (m *MockITourRepository) DeleteTour(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTour", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTour indicates an expected call of DeleteTour.
+func (mr *MockITourRepositoryMockRecorder) DeleteTour(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTour", reflect.TypeOf((*MockITourRepository)(nil).DeleteTour), ctx, id)
+}
+
+// GetTourByID mocks base method.
+func (m *MockITourRepository) GetTourByID(ctx context.Context, id string) (*Tour, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTourByID", ctx, id)
+	ret0, _ := ret[0].(*Tour)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTourByID indicates an expected call of GetTourByID.
+func (mr *MockITourRepositoryMockRecorder) GetTourByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTourByID", reflect.TypeOf((*MockITourRepository)(nil).GetTourByID), ctx, id)
+}
+
+// GetToursByUserID mocks base method.
+func (m *MockITourRepository) GetToursByUserID(ctx context.Context, userID string) ([]*Tour, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToursByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*Tour)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToursByUserID indicates an expected call of GetToursByUserID.
+func (mr *MockITourRepositoryMockRecorder) GetToursByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToursByUserID", reflect.TypeOf((*MockITourRepository)(nil).GetToursByUserID), ctx, userID)
+}
+
+// SaveTour mocks base method.
+func (m *MockITourRepository) SaveTour(ctx context.Context, tour *Tour) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTour", ctx, tour)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTour indicates an expected call of SaveTour.
+func (mr *MockITourRepositoryMockRecorder) SaveTour(ctx, tour any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTour", reflect.TypeOf((*MockITourRepository)(nil).SaveTour), ctx, tour)
+}
+
+// UpdateTour mocks base method.
+func (m *MockITourRepository) UpdateTour(ctx context.Context, tour *Tour) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTour", ctx, tour)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTour indicates an expected call of UpdateTour.
+func (mr *MockITourRepositoryMockRecorder) UpdateTour(ctx, tour any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTour", reflect.TypeOf((*MockITourRepository)(nil).UpdateTour), ctx, tour)
+}
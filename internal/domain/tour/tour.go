@@ -0,0 +1,200 @@
+package tour
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/google/uuid"
+)
+
+// 名前と説明の最大文字数
+const (
+	MaxNameLength          = 100
+	MaxDescriptionLength   = 1000
+	MaxOvernightStopLength = 100
+)
+
+// ツアーに含められるステージの上限
+const MaxStages = 30
+
+type TourID string
+
+func NewTourID() TourID {
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		panic(err)
+	}
+	return TourID(uuid.String())
+}
+
+func (id TourID) String() string {
+	return string(id)
+}
+
+// Stage はツアーの1日分。ルートを走り、終点の宿泊地に泊まる
+type Stage struct {
+	routeID       string
+	overnightStop string
+}
+
+// NewStage はステージを作成する。最終日など宿泊しない場合は overnightStop を空にする
+func NewStage(routeID string, overnightStop string) (Stage, error) {
+	if routeID == "" {
+		return Stage{}, domainerror.New("route_id is required", domainerror.ErrValidation)
+	}
+	overnightStop = strings.TrimSpace(overnightStop)
+	if utf8.RuneCountInString(overnightStop) > MaxOvernightStopLength {
+		return Stage{}, domainerror.New(fmt.Sprintf("overnight_stop must be at most %d characters", MaxOvernightStopLength), domainerror.ErrValidation)
+	}
+	return Stage{routeID: routeID, overnightStop: overnightStop}, nil
+}
+
+// ReconstructStage はリポジトリ層からの復元用
+func ReconstructStage(routeID string, overnightStop string) Stage {
+	return Stage{routeID: routeID, overnightStop: overnightStop}
+}
+
+func (s Stage) RouteID() string       { return s.routeID }
+func (s Stage) OvernightStop() string { return s.overnightStop }
+
+// Tour はルートを日ごとのステージとして順に並べた複数日のツアー（バイクパッキングなど）
+// 距離や獲得標高はステージのルートから都度求めるため、ルートを編集するとツアーの合計にも反映される
+type Tour struct {
+	id          TourID
+	userID      string
+	name        string
+	description string
+	visibility  int16
+	clubID      *string // 公開範囲がクラブのメンバーのみの場合の共有先
+	stages      []Stage
+	createdAt   string
+	updatedAt   string
+}
+
+// NewTour は所有者のツアーを作成する。クラブのメンバーのみにする場合は所有者が所属するクラブを共有先にする
+func NewTour(owner route.Viewer, name string, description string, visibility int16, clubID *string, stages []Stage) (*Tour, error) {
+	if owner.UserID() == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	t := &Tour{
+		id:     NewTourID(),
+		userID: owner.UserID(),
+	}
+	if err := t.Update(owner, name, description, visibility, clubID, stages); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ReconstructTour はリポジトリ層からの復元用
+func ReconstructTour(
+	id string,
+	userID string,
+	name string,
+	description string,
+	visibility int16,
+	clubID *string,
+	stages []Stage,
+	createdAt string,
+	updatedAt string,
+) *Tour {
+	if stages == nil {
+		stages = []Stage{}
+	}
+	return &Tour{
+		id:          TourID(id),
+		userID:      userID,
+		name:        name,
+		description: description,
+		visibility:  visibility,
+		clubID:      clubID,
+		stages:      stages,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+func (t *Tour) ID() TourID          { return t.id }
+func (t *Tour) UserID() string      { return t.userID }
+func (t *Tour) Name() string        { return t.name }
+func (t *Tour) Description() string { return t.description }
+func (t *Tour) Visibility() int16   { return t.visibility }
+func (t *Tour) ClubID() *string     { return t.clubID }
+func (t *Tour) CreatedAt() string   { return t.createdAt }
+func (t *Tour) UpdatedAt() string   { return t.updatedAt }
+
+// Stages はステージを日程の順に返す
+func (t *Tour) Stages() []Stage {
+	cp := make([]Stage, len(t.stages))
+	copy(cp, t.stages)
+	return cp
+}
+
+// RouteIDs はステージのルートのIDを日程の順に返す。同じルートを複数日に使う場合は重複する
+func (t *Tour) RouteIDs() []string {
+	ids := make([]string, len(t.stages))
+	for i, s := range t.stages {
+		ids[i] = s.routeID
+	}
+	return ids
+}
+
+// Update は名前・説明・公開範囲とステージをまとめて置き換える。公開範囲はルートと同じ値を使う
+func (t *Tour) Update(owner route.Viewer, name string, description string, visibility int16, clubID *string, stages []Stage) error {
+	if owner.UserID() != t.userID {
+		return domainerror.New("user does not own the tour", domainerror.ErrUnauthorized)
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domainerror.New("name is required", domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return domainerror.New(fmt.Sprintf("name must be at most %d characters", MaxNameLength), domainerror.ErrValidation)
+	}
+	if utf8.RuneCountInString(description) > MaxDescriptionLength {
+		return domainerror.New(fmt.Sprintf("description must be at most %d characters", MaxDescriptionLength), domainerror.ErrValidation)
+	}
+	if err := route.CheckVisibility(owner, visibility, clubID); err != nil {
+		return err
+	}
+	if len(stages) == 0 {
+		return domainerror.New("tour must have at least one stage", domainerror.ErrValidation)
+	}
+	if len(stages) > MaxStages {
+		return domainerror.New(fmt.Sprintf("tour must have at most %d stages", MaxStages), domainerror.ErrValidation)
+	}
+	t.name = name
+	t.description = description
+	t.visibility = visibility
+	t.clubID = clubID
+	t.stages = append([]Stage{}, stages...)
+	return nil
+}
+
+// IsVisibleTo はユーザーがツアーを閲覧できるかを返す
+func (t *Tour) IsVisibleTo(viewer route.Viewer) bool {
+	return viewer.CanView(t.userID, t.visibility, t.clubID)
+}
+
+// Totals はツアーの合計
+type Totals struct {
+	Distance      float64 // 距離(m)
+	Duration      float64 // 所要時間(s)
+	ElevationGain float64 // 獲得標高(m)
+	ElevationLoss float64
+}
+
+// SumRoutes はステージのルートの距離・所要時間・獲得標高を合計する
+func SumRoutes(routes []*route.Route) Totals {
+	var totals Totals
+	for _, r := range routes {
+		totals.Distance += r.Distance()
+		totals.Duration += r.Duration()
+		totals.ElevationGain += r.ElevationGain()
+		totals.ElevationLoss += r.ElevationLoss()
+	}
+	return totals
+}
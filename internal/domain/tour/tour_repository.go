@@ -0,0 +1,17 @@
+package tour
+
+import (
+	"context"
+)
+
+// ITourRepository はツアーのリポジトリのインターフェース
+type ITourRepository interface {
+	GetTourByID(ctx context.Context, id string) (*Tour, error)
+	// ユーザーのツアーを新しい順に取得する
+	GetToursByUserID(ctx context.Context, userID string) ([]*Tour, error)
+	// ツアーとステージを保存する
+	SaveTour(ctx context.Context, tour *Tour) error
+	// ツアーを更新し、ステージを保存し直す
+	UpdateTour(ctx context.Context, tour *Tour) error
+	DeleteTour(ctx context.Context, id string) error
+}
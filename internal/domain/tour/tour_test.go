@@ -0,0 +1,154 @@
+package tour
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+)
+
+const (
+	testUserID = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+	testClubID = "019b5a8d-16a7-700a-be92-9ae11e7e5c01"
+)
+
+func TestNewStage(t *testing.T) {
+	s, err := NewStage("route-1", " 尾道のゲストハウス ")
+	if err != nil {
+		t.Fatalf("NewStage() error = %v", err)
+	}
+	if s.RouteID() != "route-1" || s.OvernightStop() != "尾道のゲストハウス" {
+		t.Errorf("NewStage() = %+v", s)
+	}
+
+	if _, err := NewStage("", ""); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewStage(\"\") error = %v, want ErrValidation", err)
+	}
+	if _, err := NewStage("route-1", strings.Repeat("宿", MaxOvernightStopLength+1)); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("NewStage() error = %v, want ErrValidation", err)
+	}
+}
+
+func TestNewTour(t *testing.T) {
+	stages := []Stage{ReconstructStage("a", "宿"), ReconstructStage("b", "")}
+	tooMany := make([]Stage, MaxStages+1)
+	for i := range tooMany {
+		tooMany[i] = ReconstructStage("a", "")
+	}
+
+	tests := []struct {
+		name        string
+		tourName    string
+		description string
+		visibility  int16
+		clubID      *string
+		stages      []Stage
+		wantName    string
+		wantErr     error
+	}{
+		{name: "正常系", tourName: " しまなみ2泊3日 ", visibility: 1, stages: stages, wantName: "しまなみ2泊3日"},
+		{name: "正常系: 同じルートを複数日に使う", tourName: "往復", stages: []Stage{ReconstructStage("a", ""), ReconstructStage("a", "")}, wantName: "往復"},
+		{name: "正常系: 所属するクラブのメンバーのみ", tourName: "旅", visibility: route.VisibilityClub, clubID: new(testClubID), stages: stages, wantName: "旅"},
+		{name: "異常系: 名前が空", tourName: "  ", stages: stages, wantErr: domainerror.ErrValidation},
+		{name: "異常系: 名前が長すぎる", tourName: strings.Repeat("あ", MaxNameLength+1), stages: stages, wantErr: domainerror.ErrValidation},
+		{name: "異常系: 説明が長すぎる", tourName: "旅", description: strings.Repeat("a", MaxDescriptionLength+1), stages: stages, wantErr: domainerror.ErrValidation},
+		{name: "異常系: 未定義の公開範囲", tourName: "旅", visibility: 4, stages: stages, wantErr: domainerror.ErrValidation},
+		{name: "異常系: クラブのメンバーのみで共有先がない", tourName: "旅", visibility: route.VisibilityClub, stages: stages, wantErr: domainerror.ErrValidation},
+		{name: "異常系: 公開で共有先のクラブを指定", tourName: "旅", visibility: route.VisibilityPublic, clubID: new(testClubID), stages: stages, wantErr: domainerror.ErrValidation},
+		{name: "異常系: 所属していないクラブを共有先にする", tourName: "旅", visibility: route.VisibilityClub, clubID: new("other-club"), stages: stages, wantErr: domainerror.ErrUnauthorized},
+		{name: "異常系: ステージがない", tourName: "旅", wantErr: domainerror.ErrValidation},
+		{name: "異常系: ステージが多すぎる", tourName: "旅", stages: tooMany, wantErr: domainerror.ErrValidation},
+	}
+	owner := route.NewViewer(testUserID, []string{testClubID})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTour(owner, tt.tourName, tt.description, tt.visibility, tt.clubID, tt.stages)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("NewTour() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewTour() error = %v", err)
+			}
+			if got.ID() == "" || got.Name() != tt.wantName || len(got.Stages()) != len(tt.stages) {
+				t.Errorf("NewTour() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestTour_Update(t *testing.T) {
+	tr := ReconstructTour("tour-1", testUserID, "旅", "", 0, nil, []Stage{ReconstructStage("a", "宿")}, "", "")
+	owner := route.NewViewer(testUserID, []string{testClubID})
+
+	if err := tr.Update(owner, "旅", "", 0, nil, nil); !errors.Is(err, domainerror.ErrValidation) {
+		t.Fatalf("Update() error = %v, want ErrValidation", err)
+	}
+	if err := tr.Update(route.NewViewer("other", nil), "旅", "", 0, nil, []Stage{ReconstructStage("b", "")}); !errors.Is(err, domainerror.ErrUnauthorized) {
+		t.Fatalf("Update() by other user error = %v, want ErrUnauthorized", err)
+	}
+	// 失敗した場合は変更しない
+	if got, want := tr.RouteIDs(), []string{"a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RouteIDs() = %v, want %v", got, want)
+	}
+
+	if err := tr.Update(owner, "新しい旅", "説明", route.VisibilityClub, new(testClubID), []Stage{ReconstructStage("b", "宿"), ReconstructStage("c", "")}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got, want := tr.RouteIDs(), []string{"b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RouteIDs() = %v, want %v", got, want)
+	}
+	if tr.Name() != "新しい旅" || tr.Visibility() != route.VisibilityClub || *tr.ClubID() != testClubID || tr.Stages()[0].OvernightStop() != "宿" {
+		t.Errorf("Update() = %+v", tr)
+	}
+}
+
+func TestTour_IsVisibleTo(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility int16
+		clubID     *string
+		viewer     route.Viewer
+		want       bool
+	}{
+		{name: "所有者は非公開でも閲覧できる", visibility: 0, viewer: route.NewViewer(testUserID, nil), want: true},
+		{name: "公開は誰でも閲覧できる", visibility: 1, viewer: route.NewViewer("other", nil), want: true},
+		{name: "未ログインでも公開は閲覧できる", visibility: 1, viewer: route.Viewer{}, want: true},
+		{name: "非公開は他のユーザーは閲覧できない", visibility: 0, viewer: route.NewViewer("other", nil), want: false},
+		{name: "友達のみは他のユーザーは閲覧できない", visibility: 2, viewer: route.NewViewer("other", nil), want: false},
+		{name: "クラブのメンバーのみは共有先のメンバーが閲覧できる", visibility: 3, clubID: new(testClubID), viewer: route.NewViewer("other", []string{testClubID}), want: true},
+		{name: "クラブのメンバーのみはメンバーでなければ閲覧できない", visibility: 3, clubID: new(testClubID), viewer: route.NewViewer("other", []string{"other-club"}), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := ReconstructTour("tour-1", testUserID, "旅", "", tt.visibility, tt.clubID, nil, "", "")
+			if got := tr.IsVisibleTo(tt.viewer); got != tt.want {
+				t.Errorf("IsVisibleTo(%q) = %v, want %v", tt.viewer.UserID(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSumRoutes(t *testing.T) {
+	newRoute := func(distance, duration, gain, loss float64) *route.Route {
+		r, err := route.ReconstructRoute("r", testUserID, "r", "", nil, distance, duration, gain, loss, route.Geometry{}, route.Geometry{}, route.Geometry{}, route.Geometry{}, "", 0, 1, nil, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	got := SumRoutes([]*route.Route{newRoute(70000, 14400, 800, 700), newRoute(50000, 9000, 300, 400)})
+	want := Totals{Distance: 120000, Duration: 23400, ElevationGain: 1100, ElevationLoss: 1100}
+	if got != want {
+		t.Errorf("SumRoutes() = %+v, want %+v", got, want)
+	}
+	if got := SumRoutes(nil); got != (Totals{}) {
+		t.Errorf("SumRoutes(nil) = %+v, want zero", got)
+	}
+}
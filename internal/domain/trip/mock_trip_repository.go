@@ -69,6 +69,21 @@ func (mr *MockITripRepositoryMockRecorder) DeleteTrip(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrip", reflect.TypeOf((*MockITripRepository)(nil).DeleteTrip), ctx, id)
 }
 
+// GetRideStatsByUserID mocks base method.
+func (m *MockITripRepository) GetRideStatsByUserID(ctx context.Context, userID string, limit int32) ([]RideStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRideStatsByUserID", ctx, userID, limit)
+	ret0, _ := ret[0].([]RideStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRideStatsByUserID indicates an expected call of GetRideStatsByUserID.
+func (mr *MockITripRepositoryMockRecorder) GetRideStatsByUserID(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRideStatsByUserID", reflect.TypeOf((*MockITripRepository)(nil).GetRideStatsByUserID), ctx, userID, limit)
+}
+
 // GetTripByID mocks base method.
 func (m *MockITripRepository) GetTripByID(ctx context.Context, id string) (*Trip, error) {
 	m.ctrl.T.Helper()
@@ -99,19 +114,49 @@ func (mr *MockITripRepositoryMockRecorder) GetTripByKratosID(ctx, kratosID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTripByKratosID", reflect.TypeOf((*MockITripRepository)(nil).GetTripByKratosID), ctx, kratosID)
 }
 
-// GetTripsByUserID mocks base method.
-func (m *MockITripRepository) GetTripsByUserID(ctx context.Context, userID string) ([]*Trip, error) {
+// GetTripImages mocks base method.
+func (m *MockITripRepository) GetTripImages(ctx context.Context, tripID string) ([]*TripImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTripImages", ctx, tripID)
+	ret0, _ := ret[0].([]*TripImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTripImages indicates an expected call of GetTripImages.
+func (mr *MockITripRepositoryMockRecorder) GetTripImages(ctx, tripID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTripImages", reflect.TypeOf((*MockITripRepository)(nil).GetTripImages), ctx, tripID)
+}
+
+// GetTripsByIDs mocks base method.
+func (m *MockITripRepository) GetTripsByIDs(ctx context.Context, ids []string) ([]*Trip, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTripsByUserID", ctx, userID)
+	ret := m.ctrl.Call(m, "GetTripsByIDs", ctx, ids)
 	ret0, _ := ret[0].([]*Trip)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetTripsByIDs indicates an expected call of GetTripsByIDs.
+func (mr *MockITripRepositoryMockRecorder) GetTripsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTripsByIDs", reflect.TypeOf((*MockITripRepository)(nil).GetTripsByIDs), ctx, ids)
+}
+
+// GetTripsByUserID mocks base method.
+func (m *MockITripRepository) GetTripsByUserID(ctx context.Context, criteria *TripListCriteria) (*TripPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTripsByUserID", ctx, criteria)
+	ret0, _ := ret[0].(*TripPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // GetTripsByUserID indicates an expected call of GetTripsByUserID.
-func (mr *MockITripRepositoryMockRecorder) GetTripsByUserID(ctx, userID any) *gomock.Call {
+func (mr *MockITripRepositoryMockRecorder) GetTripsByUserID(ctx, criteria any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTripsByUserID", reflect.TypeOf((*MockITripRepository)(nil).GetTripsByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTripsByUserID", reflect.TypeOf((*MockITripRepository)(nil).GetTripsByUserID), ctx, criteria)
 }
 
 // SaveTrip mocks base method.
@@ -0,0 +1,165 @@
+package trip
+
+import "math"
+
+// RideStats は所要時間の推定に使うトリップの計測値
+type RideStats struct {
+	Distance      float64 // 距離(m)
+	MovingTime    float64 // 移動時間(s)
+	ElevationGain float64 // 獲得標高(m)
+	ElevationLoss float64 // 獲得標高の下り(m)
+}
+
+// SpeedProfile は平坦・登り・下りの区間ごとの速度(m/s)
+type SpeedProfile struct {
+	Flat       float64
+	Climbing   float64
+	Descending float64
+	RideCount  int // 当てはめに使ったトリップの数。0の場合は既定のモデル
+}
+
+// 速度の当てはめに使う直近のトリップの数
+const SpeedProfileRideLimit = 50
+
+// 履歴のないユーザーに使う既定の速度(km/h)
+const (
+	defaultFlatSpeed       = 22.0
+	defaultClimbingSpeed   = 10.0
+	defaultDescendingSpeed = 32.0
+)
+
+// 当てはめた速度の範囲(km/h)。記録の誤りなどで極端な値にならないようにする
+var speedRanges = [3][2]float64{
+	{10, 45}, // 平坦
+	{4, 30},  // 登り
+	{10, 60}, // 下り
+}
+
+// トリップは区間ごとの時間を持たないため、獲得標高をこの勾配で上り下りしたものとして区間に分ける
+const assumedSectionGradient = 0.05
+
+// 当てはめに使わないトリップの条件。短すぎる記録や車での移動などを除く
+const (
+	minRideDistance = 1000.0 // 1km
+	minRideSpeed    = 5.0    // km/h
+	maxRideSpeed    = 60.0   // km/h
+)
+
+// 既定のモデルを各区間この距離だけ走ったトリップとして当てはめに加える
+// 履歴が少ないうちは既定の速度に寄せ、登りと下りを同じだけ含む周回ばかりでも解が定まるようにする
+const speedProfilePriorDistance = 10000.0
+
+// DefaultSpeedProfile は履歴のないユーザーに使う既定の速度を返す
+func DefaultSpeedProfile() SpeedProfile {
+	return SpeedProfile{
+		Flat:       kmhToMps(defaultFlatSpeed),
+		Climbing:   kmhToMps(defaultClimbingSpeed),
+		Descending: kmhToMps(defaultDescendingSpeed),
+	}
+}
+
+// FitSpeedProfile はトリップの距離・獲得標高と移動時間から区間ごとの速度を求める
+// 各トリップの移動時間が「区間の距離 / 区間の速度」の和になるとして、区間ごとのペース(s/m)を最小二乗法で当てはめる
+func FitSpeedProfile(rides []RideStats) SpeedProfile {
+	def := DefaultSpeedProfile()
+	prior := [3]float64{1 / def.Flat, 1 / def.Climbing, 1 / def.Descending}
+
+	// 正規方程式 AᵀA p = Aᵀt を組み立てる。既定のモデルの分から始める
+	var ata [3][3]float64
+	var att [3]float64
+	for i := range 3 {
+		ata[i][i] = speedProfilePriorDistance * speedProfilePriorDistance
+		att[i] = speedProfilePriorDistance * speedProfilePriorDistance * prior[i]
+	}
+
+	count := 0
+	for _, r := range rides {
+		if !isUsableRide(r) {
+			continue
+		}
+		x := splitSections(r.Distance, r.ElevationGain, r.ElevationLoss)
+		for i := range 3 {
+			for j := range 3 {
+				ata[i][j] += x[i] * x[j]
+			}
+			att[i] += x[i] * r.MovingTime
+		}
+		count++
+	}
+	if count == 0 {
+		return def
+	}
+
+	pace := solve3(ata, att)
+	var speeds [3]float64
+	for i := range 3 {
+		lo, hi := kmhToMps(speedRanges[i][0]), kmhToMps(speedRanges[i][1])
+		if pace[i] <= 0 {
+			speeds[i] = hi
+			continue
+		}
+		speeds[i] = min(max(1/pace[i], lo), hi)
+	}
+	return SpeedProfile{Flat: speeds[0], Climbing: speeds[1], Descending: speeds[2], RideCount: count}
+}
+
+// EstimateDuration は距離(m)・獲得標高(m)・獲得標高の下り(m)のルートを走る移動時間(s)を推定する
+func (p SpeedProfile) EstimateDuration(distance, elevationGain, elevationLoss float64) float64 {
+	x := splitSections(distance, elevationGain, elevationLoss)
+	return x[0]/p.Flat + x[1]/p.Climbing + x[2]/p.Descending
+}
+
+// splitSections は距離を平坦・登り・下りの区間の距離に分ける
+// 登りと下りが距離を超える場合は、平坦な区間がないものとして割合を保ったまま縮める
+func splitSections(distance, elevationGain, elevationLoss float64) [3]float64 {
+	climbing := elevationGain / assumedSectionGradient
+	descending := elevationLoss / assumedSectionGradient
+	if sloped := climbing + descending; sloped > distance {
+		climbing *= distance / sloped
+		descending *= distance / sloped
+	}
+	return [3]float64{distance - climbing - descending, climbing, descending}
+}
+
+func isUsableRide(r RideStats) bool {
+	if r.Distance < minRideDistance || r.MovingTime <= 0 {
+		return false
+	}
+	speed := r.Distance / r.MovingTime * 3.6
+	return speed >= minRideSpeed && speed <= maxRideSpeed
+}
+
+// solve3 は3元連立一次方程式を部分ピボット選択付きのガウスの消去法で解く
+// 既定のモデルの分を対角に加えているため、係数行列は正則になる
+func solve3(a [3][3]float64, b [3]float64) [3]float64 {
+	for col := range 3 {
+		pivot := col
+		for row := col + 1; row < 3; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+		for row := col + 1; row < 3; row++ {
+			f := a[row][col] / a[col][col]
+			for k := col; k < 3; k++ {
+				a[row][k] -= f * a[col][k]
+			}
+			b[row] -= f * b[col]
+		}
+	}
+	var x [3]float64
+	for row := 2; row >= 0; row-- {
+		sum := b[row]
+		for k := row + 1; k < 3; k++ {
+			sum -= a[row][k] * x[k]
+		}
+		x[row] = sum / a[row][row]
+	}
+	return x
+}
+
+func kmhToMps(kmh float64) float64 {
+	return kmh / 3.6
+}
@@ -0,0 +1,115 @@
+package trip
+
+import (
+	"math"
+	"testing"
+)
+
+// newRideStats は区間ごとの速度(km/h)から移動時間を求めたトリップを作る
+func newRideStats(distance, gain, loss float64, flat, climbing, descending float64) RideStats {
+	p := SpeedProfile{Flat: kmhToMps(flat), Climbing: kmhToMps(climbing), Descending: kmhToMps(descending)}
+	return RideStats{
+		Distance:      distance,
+		MovingTime:    p.EstimateDuration(distance, gain, loss),
+		ElevationGain: gain,
+		ElevationLoss: loss,
+	}
+}
+
+func TestFitSpeedProfile(t *testing.T) {
+	t.Run("履歴がない場合は既定のモデルを使う", func(t *testing.T) {
+		got := FitSpeedProfile(nil)
+		if got != DefaultSpeedProfile() || got.RideCount != 0 {
+			t.Errorf("FitSpeedProfile(nil) = %+v, want %+v", got, DefaultSpeedProfile())
+		}
+	})
+
+	t.Run("区間ごとの速度を当てはめる", func(t *testing.T) {
+		// 平坦30km/h・登り14km/h・下り40km/hで走るユーザー
+		var rides []RideStats
+		for range 5 {
+			rides = append(rides,
+				newRideStats(60000, 100, 100, 30, 14, 40),   // 河川敷
+				newRideStats(80000, 1200, 1200, 30, 14, 40), // 峠を越えて戻る
+				newRideStats(50000, 900, 200, 30, 14, 40),   // 山の上がゴール
+				newRideStats(50000, 200, 900, 30, 14, 40),   // 山から下る
+			)
+		}
+
+		got := FitSpeedProfile(rides)
+		if got.RideCount != len(rides) {
+			t.Errorf("RideCount = %d, want %d", got.RideCount, len(rides))
+		}
+		for _, c := range []struct {
+			name      string
+			got, want float64
+		}{
+			{"Flat", got.Flat, kmhToMps(30)},
+			{"Climbing", got.Climbing, kmhToMps(14)},
+			{"Descending", got.Descending, kmhToMps(40)},
+		} {
+			// 既定のモデルに寄せる分だけずれる
+			if math.Abs(c.got-c.want)/c.want > 0.05 {
+				t.Errorf("%s = %.2f km/h, want %.2f km/h", c.name, c.got*3.6, c.want*3.6)
+			}
+		}
+	})
+
+	t.Run("トリップが少ないうちは既定のモデルに近い", func(t *testing.T) {
+		got := FitSpeedProfile([]RideStats{newRideStats(10000, 0, 0, 30, 14, 40)})
+		def := DefaultSpeedProfile()
+		if got.Flat <= def.Flat || got.Flat >= kmhToMps(30) {
+			t.Errorf("Flat = %.2f km/h, want between 22 and 30", got.Flat*3.6)
+		}
+		// 平坦なトリップからは登り・下りの速度は分からない
+		if math.Abs(got.Climbing-def.Climbing) > 1e-9 || math.Abs(got.Descending-def.Descending) > 1e-9 {
+			t.Errorf("Climbing, Descending = %.2f, %.2f km/h, want default", got.Climbing*3.6, got.Descending*3.6)
+		}
+	})
+
+	t.Run("使えないトリップは除く", func(t *testing.T) {
+		rides := []RideStats{
+			{Distance: 500, MovingTime: 120},     // 短すぎる
+			{Distance: 30000, MovingTime: 0},     // 移動時間がない
+			{Distance: 30000, MovingTime: 1200},  // 90km/h。車での移動
+			{Distance: 30000, MovingTime: 36000}, // 3km/h。押し歩き
+		}
+		if got := FitSpeedProfile(rides); got != DefaultSpeedProfile() {
+			t.Errorf("FitSpeedProfile() = %+v, want default", got)
+		}
+	})
+
+	t.Run("極端な速度は範囲に収める", func(t *testing.T) {
+		var rides []RideStats
+		for range 20 {
+			rides = append(rides, newRideStats(100000, 0, 0, 58, 14, 40))
+		}
+		if got := FitSpeedProfile(rides); math.Abs(got.Flat-kmhToMps(45)) > 1e-9 {
+			t.Errorf("Flat = %.2f km/h, want 45", got.Flat*3.6)
+		}
+	})
+}
+
+func TestSpeedProfile_EstimateDuration(t *testing.T) {
+	p := SpeedProfile{Flat: kmhToMps(20), Climbing: kmhToMps(10), Descending: kmhToMps(30)}
+
+	tests := []struct {
+		name                 string
+		distance, gain, loss float64
+		want                 float64
+	}{
+		{name: "平坦", distance: 20000, want: 3600},
+		// 500mを5%で上る10kmと下る10kmに、残り20kmの平坦
+		{name: "峠を越える", distance: 40000, gain: 500, loss: 500, want: 3600 + 3600 + 1200},
+		// 登りと下りが距離を超える場合は平坦な区間がない
+		{name: "激坂", distance: 10000, gain: 750, loss: 250, want: 2700 + 300},
+		{name: "距離0", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.EstimateDuration(tt.distance, tt.gain, tt.loss); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("EstimateDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
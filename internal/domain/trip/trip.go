@@ -3,6 +3,8 @@ package trip
 import (
 	"errors"
 
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
 	"github.com/google/uuid"
 	"github.com/paulmach/orb"
 )
@@ -350,3 +352,31 @@ func (t *Trip) UtcOffset() *int32   { return t.utcOffset }
 func (t *Trip) ActivityTypeID() int32 { return t.activityTypeID }
 func (t *Trip) Pace() *float64        { return t.pace }
 func (t *Trip) MovingPace() *float64  { return t.movingPace }
+
+// TripListCriteria はユーザーのトリップ一覧の取得条件
+// 作成日時の新しい順にキーセットページネーションで取得する
+type TripListCriteria struct {
+	userID string
+	limit  int32
+	after  *pagination.Cursor // nilの場合は先頭から取得する
+}
+
+func NewTripListCriteria(userID string, limit int32, after *pagination.Cursor) (*TripListCriteria, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	if limit <= 0 {
+		return nil, domainerror.New("limit must be positive", domainerror.ErrValidation)
+	}
+	return &TripListCriteria{userID: userID, limit: limit, after: after}, nil
+}
+
+func (c *TripListCriteria) UserID() string            { return c.userID }
+func (c *TripListCriteria) Limit() int32              { return c.limit }
+func (c *TripListCriteria) After() *pagination.Cursor { return c.after }
+
+// TripPage はキーセットページネーションで取得したトリップ一覧の1ページ
+type TripPage struct {
+	Items []*Trip
+	Next  *pagination.Cursor // 次のページがない場合はnil
+}
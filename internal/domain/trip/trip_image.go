@@ -0,0 +1,50 @@
+package trip
+
+// TripImage はトリップの写真
+// 画像ファイルはS3等に保存済みで、ここではその保存先と属性だけを持つ
+type TripImage struct {
+	id         string
+	tripID     string
+	s3Key      string
+	width      *int32
+	height     *int32
+	size       *int64
+	imageType  string // jpg/png等
+	visibility int16
+	createdAt  string
+}
+
+// ReconstructTripImage はリポジトリ層からの復元用
+func ReconstructTripImage(
+	id string,
+	tripID string,
+	s3Key string,
+	width *int32,
+	height *int32,
+	size *int64,
+	imageType string,
+	visibility int16,
+	createdAt string,
+) *TripImage {
+	return &TripImage{
+		id:         id,
+		tripID:     tripID,
+		s3Key:      s3Key,
+		width:      width,
+		height:     height,
+		size:       size,
+		imageType:  imageType,
+		visibility: visibility,
+		createdAt:  createdAt,
+	}
+}
+
+func (i *TripImage) ID() string        { return i.id }
+func (i *TripImage) TripID() string    { return i.tripID }
+func (i *TripImage) S3Key() string     { return i.s3Key }
+func (i *TripImage) Width() *int32     { return i.width }
+func (i *TripImage) Height() *int32    { return i.height }
+func (i *TripImage) Size() *int64      { return i.size }
+func (i *TripImage) Type() string      { return i.imageType }
+func (i *TripImage) Visibility() int16 { return i.visibility }
+func (i *TripImage) CreatedAt() string { return i.createdAt }
@@ -3,11 +3,16 @@ package trip
 import "context"
 
 type ITripRepository interface {
-	GetTripsByUserID(ctx context.Context, userID string) ([]*Trip, error)
+	GetTripsByUserID(ctx context.Context, criteria *TripListCriteria) (*TripPage, error)
 	CountTripsByUserID(ctx context.Context, userID string) (int64, error)
 	GetTripByID(ctx context.Context, id string) (*Trip, error)
+	// IDで指定したトリップをまとめて取得する。存在しないIDは無視する
+	GetTripsByIDs(ctx context.Context, ids []string) ([]*Trip, error)
 	GetTripByKratosID(ctx context.Context, kratosID string) ([]*Trip, error)
 	SaveTrip(ctx context.Context, trip *Trip) error
 	DeleteTrip(ctx context.Context, id string) error
 	UpdateTrip(ctx context.Context, trip *Trip) error
+	GetTripImages(ctx context.Context, tripID string) ([]*TripImage, error)
+	// 室内トレーニングを除いた直近のトリップの計測値を新しい順に取得する
+	GetRideStatsByUserID(ctx context.Context, userID string, limit int32) ([]RideStats, error)
 }
\ No newline at end of file
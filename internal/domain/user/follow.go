@@ -0,0 +1,24 @@
+package user
+
+import (
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+// Follow はユーザーのフォロー。フォローしたユーザーの出来事がフィードに表示される
+type Follow struct {
+	followerID string
+	followeeID string
+}
+
+func NewFollow(followerID string, followeeID string) (*Follow, error) {
+	if followerID == "" || followeeID == "" {
+		return nil, domainerror.New("followerID and followeeID are required", domainerror.ErrValidation)
+	}
+	if followerID == followeeID {
+		return nil, domainerror.New("user cannot follow themselves", domainerror.ErrValidation)
+	}
+	return &Follow{followerID: followerID, followeeID: followeeID}, nil
+}
+
+func (f *Follow) FollowerID() string { return f.followerID }
+func (f *Follow) FolloweeID() string { return f.followeeID }
@@ -0,0 +1,13 @@
+package user
+
+import (
+	"context"
+)
+
+// IFollowRepository はフォローのリポジトリのインターフェース
+type IFollowRepository interface {
+	// フォロー済みの場合はErrConflictを返す
+	SaveFollow(ctx context.Context, follow *Follow) error
+	// フォローしていない場合はErrNotFoundを返す
+	DeleteFollow(ctx context.Context, followerID string, followeeID string) error
+}
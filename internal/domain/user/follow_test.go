@@ -0,0 +1,38 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+func TestNewFollow(t *testing.T) {
+	tests := []struct {
+		name       string
+		followerID string
+		followeeID string
+		wantErr    bool
+	}{
+		{name: "正常系", followerID: "user-1", followeeID: "user-2"},
+		{name: "異常系: 自分自身", followerID: "user-1", followeeID: "user-1", wantErr: true},
+		{name: "異常系: フォローするユーザーが空", followeeID: "user-2", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFollow(tt.followerID, tt.followeeID)
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("NewFollow() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewFollow() error = %v", err)
+			}
+			if f.FollowerID() != tt.followerID || f.FolloweeID() != tt.followeeID {
+				t.Errorf("NewFollow() = %+v", f)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/user/follow_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/user/follow_repository.go -destination=internal/domain/user/mock_follow_repository.go -package user
+//
+
+// Package user is a generated GoMock package.
+package user
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIFollowRepository is a mock of IFollowRepository interface.
+type MockIFollowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFollowRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIFollowRepositoryMockRecorder is the mock recorder for MockIFollowRepository.
+type MockIFollowRepositoryMockRecorder struct {
+	mock *MockIFollowRepository
+}
+
+// NewMockIFollowRepository creates a new mock instance.
+func NewMockIFollowRepository(ctrl *gomock.Controller) *MockIFollowRepository {
+	mock := &MockIFollowRepository{ctrl: ctrl}
+	mock.recorder = &MockIFollowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFollowRepository) EXPECT() *MockIFollowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteFollow mocks base method.
+func (m *MockIFollowRepository) DeleteFollow(ctx context.Context, followerID, followeeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFollow", ctx, followerID, followeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFollow indicates an expected call of DeleteFollow.
+func (mr *MockIFollowRepositoryMockRecorder) DeleteFollow(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFollow", reflect.TypeOf((*MockIFollowRepository)(nil).DeleteFollow), ctx, followerID, followeeID)
+}
+
+// SaveFollow mocks base method.
+func (m *MockIFollowRepository) SaveFollow(ctx context.Context, follow *Follow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFollow", ctx, follow)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFollow indicates an expected call of SaveFollow.
+func (mr *MockIFollowRepositoryMockRecorder) SaveFollow(ctx, follow any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFollow", reflect.TypeOf((*MockIFollowRepository)(nil).SaveFollow), ctx, follow)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/user/privacy_zone_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/user/privacy_zone_repository.go -destination=internal/domain/user/mock_privacy_zone_repository.go -package user
+//
+
+// Package user is a generated GoMock package.
+package user
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIPrivacyZoneRepository is a mock of IPrivacyZoneRepository interface.
+type MockIPrivacyZoneRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPrivacyZoneRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIPrivacyZoneRepositoryMockRecorder is the mock recorder for MockIPrivacyZoneRepository.
+type MockIPrivacyZoneRepositoryMockRecorder struct {
+	mock *MockIPrivacyZoneRepository
+}
+
+// NewMockIPrivacyZoneRepository creates a new mock instance.
+func NewMockIPrivacyZoneRepository(ctrl *gomock.Controller) *MockIPrivacyZoneRepository {
+	mock := &MockIPrivacyZoneRepository{ctrl: ctrl}
+	mock.recorder = &MockIPrivacyZoneRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPrivacyZoneRepository) EXPECT() *MockIPrivacyZoneRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeletePrivacyZone mocks base method.
+func (m *MockIPrivacyZoneRepository) DeletePrivacyZone(ctx context.Context, userID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivacyZone", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivacyZone indicates an expected call of DeletePrivacyZone.
+func (mr *MockIPrivacyZoneRepositoryMockRecorder) DeletePrivacyZone(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivacyZone", reflect.TypeOf((*MockIPrivacyZoneRepository)(nil).DeletePrivacyZone), ctx, userID, id)
+}
+
+// ListPrivacyZones mocks base method.
+func (m *MockIPrivacyZoneRepository) ListPrivacyZones(ctx context.Context, userID string) ([]*PrivacyZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrivacyZones", ctx, userID)
+	ret0, _ := ret[0].([]*PrivacyZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrivacyZones indicates an expected call of ListPrivacyZones.
+func (mr *MockIPrivacyZoneRepositoryMockRecorder) ListPrivacyZones(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivacyZones", reflect.TypeOf((*MockIPrivacyZoneRepository)(nil).ListPrivacyZones), ctx, userID)
+}
+
+// SavePrivacyZone mocks base method.
+func (m *MockIPrivacyZoneRepository) SavePrivacyZone(ctx context.Context, zone *PrivacyZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePrivacyZone", ctx, zone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePrivacyZone indicates an expected call of SavePrivacyZone.
+func (mr *MockIPrivacyZoneRepositoryMockRecorder) SavePrivacyZone(ctx, zone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePrivacyZone", reflect.TypeOf((*MockIPrivacyZoneRepository)(nil).SavePrivacyZone), ctx, zone)
+}
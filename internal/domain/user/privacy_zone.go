@@ -0,0 +1,109 @@
+package user
+
+import (
+	"strings"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// プライバシーゾーンの半径の範囲(m)
+// 小さすぎると自宅の位置が推測できてしまうため下限を設ける
+const (
+	MinPrivacyZoneRadiusM = 100.0
+	MaxPrivacyZoneRadiusM = 2000.0
+)
+
+// ユーザーあたりのプライバシーゾーンの上限
+const MaxPrivacyZonesPerUser = 10
+
+type PrivacyZoneID string
+
+func NewPrivacyZoneID() PrivacyZoneID {
+	uuid, err := uuid.NewV7()
+	if err != nil {
+		panic(err)
+	}
+	return PrivacyZoneID(uuid.String())
+}
+
+func (id PrivacyZoneID) String() string {
+	return string(id)
+}
+
+// PrivacyZone は自宅などの周辺で、公開する軌跡から隠す範囲
+type PrivacyZone struct {
+	id        PrivacyZoneID
+	userID    string
+	name      string
+	center    orb.Point
+	radiusM   float64
+	createdAt string
+}
+
+func NewPrivacyZone(userID string, name string, center orb.Point, radiusM float64) (*PrivacyZone, error) {
+	if userID == "" {
+		return nil, domainerror.New("userID is required", domainerror.ErrValidation)
+	}
+	if center.Lon() < -180 || center.Lon() > 180 || center.Lat() < -90 || center.Lat() > 90 {
+		return nil, domainerror.New("center is out of range", domainerror.ErrValidation)
+	}
+	if radiusM < MinPrivacyZoneRadiusM || radiusM > MaxPrivacyZoneRadiusM {
+		return nil, domainerror.New("radius must be between 100 and 2000 meters", domainerror.ErrValidation)
+	}
+	return &PrivacyZone{
+		id:      NewPrivacyZoneID(),
+		userID:  userID,
+		name:    strings.TrimSpace(name),
+		center:  center,
+		radiusM: radiusM,
+	}, nil
+}
+
+// ReconstructPrivacyZone はリポジトリ層からの復元用
+func ReconstructPrivacyZone(id string, userID string, name string, center orb.Point, radiusM float64, createdAt string) *PrivacyZone {
+	return &PrivacyZone{
+		id:        PrivacyZoneID(id),
+		userID:    userID,
+		name:      name,
+		center:    center,
+		radiusM:   radiusM,
+		createdAt: createdAt,
+	}
+}
+
+func (z *PrivacyZone) ID() PrivacyZoneID { return z.id }
+func (z *PrivacyZone) UserID() string    { return z.userID }
+func (z *PrivacyZone) Name() string      { return z.name }
+func (z *PrivacyZone) Center() orb.Point { return z.center }
+func (z *PrivacyZone) RadiusM() float64  { return z.radiusM }
+func (z *PrivacyZone) CreatedAt() string { return z.createdAt }
+
+// Contains は p がゾーンの範囲内かどうかを判定する
+func (z *PrivacyZone) Contains(p orb.Point) bool {
+	return geo.Distance(z.center, p) <= z.radiusM
+}
+
+// TrimPrivacyZones は軌跡の始点・終点側でプライバシーゾーンの範囲内にある点を取り除く
+// 出発地や到着地（自宅など）を隠すためのもので、途中で範囲内を通り過ぎる部分は残す
+func TrimPrivacyZones(track orb.LineString, zones []*PrivacyZone) orb.LineString {
+	inside := func(p orb.Point) bool {
+		for _, z := range zones {
+			if z.Contains(p) {
+				return true
+			}
+		}
+		return false
+	}
+
+	start, end := 0, len(track)
+	for start < end && inside(track[start]) {
+		start++
+	}
+	for end > start && inside(track[end-1]) {
+		end--
+	}
+	return track[start:end]
+}
@@ -0,0 +1,12 @@
+package user
+
+import (
+	"context"
+)
+
+// IPrivacyZoneRepository はプライバシーゾーンのリポジトリのインターフェース
+type IPrivacyZoneRepository interface {
+	ListPrivacyZones(ctx context.Context, userID string) ([]*PrivacyZone, error)
+	SavePrivacyZone(ctx context.Context, zone *PrivacyZone) error
+	DeletePrivacyZone(ctx context.Context, userID string, id string) error
+}
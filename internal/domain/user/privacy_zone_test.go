@@ -0,0 +1,77 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/paulmach/orb"
+)
+
+func TestNewPrivacyZone(t *testing.T) {
+	tests := []struct {
+		name    string
+		center  orb.Point
+		radiusM float64
+		wantErr bool
+	}{
+		{name: "正常系", center: orb.Point{139.70, 35.68}, radiusM: 200},
+		{name: "異常系: 半径が小さすぎる", center: orb.Point{139.70, 35.68}, radiusM: 50, wantErr: true},
+		{name: "異常系: 半径が大きすぎる", center: orb.Point{139.70, 35.68}, radiusM: 5000, wantErr: true},
+		{name: "異常系: 緯度が範囲外", center: orb.Point{139.70, 95}, radiusM: 200, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone, err := NewPrivacyZone("019b5a8d-16a7-700a-be92-9ae11e7e5b9a", " 自宅 ", tt.center, tt.radiusM)
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("NewPrivacyZone() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewPrivacyZone() error = %v", err)
+			}
+			if zone.ID() == "" || zone.Name() != "自宅" || zone.RadiusM() != tt.radiusM {
+				t.Errorf("NewPrivacyZone() = %+v", zone)
+			}
+		})
+	}
+}
+
+func TestTrimPrivacyZones(t *testing.T) {
+	// 自宅（始点）の半径200m
+	home := ReconstructPrivacyZone("zone-1", "user-1", "自宅", orb.Point{139.700, 35.680}, 200, "")
+	// 東へ約90mごとに進む
+	track := orb.LineString{
+		{139.700, 35.680}, {139.701, 35.680}, {139.702, 35.680}, {139.703, 35.680},
+		{139.704, 35.680}, {139.705, 35.680},
+	}
+	reversed := track.Clone()
+	reversed.Reverse()
+
+	tests := []struct {
+		name  string
+		track orb.LineString
+		zones []*PrivacyZone
+		want  orb.LineString
+	}{
+		{name: "始点側の範囲内の点を取り除く", track: track, zones: []*PrivacyZone{home}, want: track[3:]},
+		{name: "終点側の範囲内の点も取り除く", track: reversed, zones: []*PrivacyZone{home}, want: reversed[:3]},
+		{name: "ゾーンがなければそのまま", track: track, want: track},
+		{name: "すべて範囲内なら空になる", track: track[:2], zones: []*PrivacyZone{home}, want: orb.LineString{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TrimPrivacyZones(tt.track, tt.zones); !got.Equal(tt.want) {
+				t.Errorf("TrimPrivacyZones() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// 途中で範囲内を通り過ぎる部分は残す
+	through := orb.LineString{{139.697, 35.680}, {139.700, 35.680}, {139.703, 35.680}}
+	if got := TrimPrivacyZones(through, []*PrivacyZone{home}); len(got) != 3 {
+		t.Errorf("TrimPrivacyZones() = %v, should keep points passing through", got)
+	}
+}
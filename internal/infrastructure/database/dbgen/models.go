@@ -11,6 +11,59 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AdminBoundary struct {
+	ID          int64       `json:"id"`
+	Name        string      `json:"name"`
+	AdminLevel  int16       `json:"admin_level"`
+	CountryCode string      `json:"country_code"`
+	Geom        OrbGeometry `json:"geom"`
+}
+
+type Club struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ClubJoinRequest struct {
+	ClubID    uuid.UUID `json:"club_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ClubMember struct {
+	ClubID    uuid.UUID `json:"club_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ClubRoute struct {
+	ClubID    uuid.UUID   `json:"club_id"`
+	RouteID   uuid.UUID   `json:"route_id"`
+	AddedBy   pgtype.UUID `json:"added_by"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type Collection struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Visibility  int16     `json:"visibility"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type CollectionRoute struct {
+	CollectionID uuid.UUID `json:"collection_id"`
+	RouteID      uuid.UUID `json:"route_id"`
+	Position     int32     `json:"position"`
+}
+
 type CoursePoint struct {
 	ID            uuid.UUID    `json:"id"`
 	RouteID       uuid.UUID    `json:"route_id"`
@@ -27,6 +80,93 @@ type CoursePoint struct {
 	BearingAfter  *int32       `json:"bearing_after"`
 }
 
+type Event struct {
+	ID               uuid.UUID   `json:"id"`
+	UserID           uuid.UUID   `json:"user_id"`
+	RouteID          uuid.UUID   `json:"route_id"`
+	Title            string      `json:"title"`
+	Description      string      `json:"description"`
+	StartsAt         time.Time   `json:"starts_at"`
+	MeetingPoint     OrbGeometry `json:"meeting_point"`
+	MeetingPointName string      `json:"meeting_point_name"`
+	Capacity         *int32      `json:"capacity"`
+	Visibility       int16       `json:"visibility"`
+	CancelledAt      *time.Time  `json:"cancelled_at"`
+	CancelReason     string      `json:"cancel_reason"`
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+	ClubID           pgtype.UUID `json:"club_id"`
+}
+
+type EventComment struct {
+	ID        uuid.UUID `json:"id"`
+	EventID   uuid.UUID `json:"event_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type EventRsvp struct {
+	EventID   uuid.UUID `json:"event_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type FeedEvent struct {
+	ID        uuid.UUID `json:"id"`
+	ActorID   uuid.UUID `json:"actor_id"`
+	EventType string    `json:"event_type"`
+	SubjectID uuid.UUID `json:"subject_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Notification struct {
+	ID        uuid.UUID   `json:"id"`
+	UserID    uuid.UUID   `json:"user_id"`
+	ActorID   uuid.UUID   `json:"actor_id"`
+	Type      string      `json:"type"`
+	RouteID   pgtype.UUID `json:"route_id"`
+	EventID   pgtype.UUID `json:"event_id"`
+	ReadAt    *time.Time  `json:"read_at"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type Poi struct {
+	ID       int64       `json:"id"`
+	OsmType  string      `json:"osm_type"`
+	OsmID    int64       `json:"osm_id"`
+	Category string      `json:"category"`
+	Name     *string     `json:"name"`
+	Location OrbGeometry `json:"location"`
+}
+
+type PrivacyZone struct {
+	ID        uuid.UUID   `json:"id"`
+	UserID    uuid.UUID   `json:"user_id"`
+	Name      string      `json:"name"`
+	Center    OrbGeometry `json:"center"`
+	RadiusM   float64     `json:"radius_m"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type RoadEdge struct {
+	ID            int64       `json:"id"`
+	OsmWayID      int64       `json:"osm_way_id"`
+	SourceNodeID  int64       `json:"source_node_id"`
+	TargetNodeID  int64       `json:"target_node_id"`
+	Name          *string     `json:"name"`
+	Highway       string      `json:"highway"`
+	Surface       *string     `json:"surface"`
+	BikeLane      bool        `json:"bike_lane"`
+	Oneway        bool        `json:"oneway"`
+	LengthM       float64     `json:"length_m"`
+	ElevationGain float64     `json:"elevation_gain"`
+	ElevationLoss float64     `json:"elevation_loss"`
+	Geom          OrbGeometry `json:"geom"`
+}
+
 type Route struct {
 	ID                 uuid.UUID   `json:"id"`
 	UserID             uuid.UUID   `json:"user_id"`
@@ -45,6 +185,10 @@ type Route struct {
 	CreatedAt          time.Time   `json:"created_at"`
 	UpdatedAt          time.Time   `json:"updated_at"`
 	Visibility         int16       `json:"visibility"`
+	Version            int32       `json:"version"`
+	ForkedFromRouteID  pgtype.UUID `json:"forked_from_route_id"`
+	Difficulty         *int16      `json:"difficulty"`
+	ClubID             pgtype.UUID `json:"club_id"`
 }
 
 type RouteComment struct {
@@ -78,6 +222,14 @@ type RouteLike struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type RoutePlace struct {
+	RouteID            uuid.UUID `json:"route_id"`
+	Kind               string    `json:"kind"`
+	Locality           string    `json:"locality"`
+	AdministrativeArea string    `json:"administrative_area"`
+	CountryCode        string    `json:"country_code"`
+}
+
 type RouteSafe struct {
 	ID        uuid.UUID  `json:"id"`
 	UserID    uuid.UUID  `json:"user_id"`
@@ -87,6 +239,65 @@ type RouteSafe struct {
 	DeletedAt *time.Time `json:"deleted_at"`
 }
 
+type RouteSearchDocument struct {
+	RouteID      uuid.UUID   `json:"route_id"`
+	SearchText   string      `json:"search_text"`
+	SearchVector interface{} `json:"search_vector"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+type RouteSurface struct {
+	RouteID  uuid.UUID `json:"route_id"`
+	Kind     string    `json:"kind"`
+	Category string    `json:"category"`
+	Distance float64   `json:"distance"`
+}
+
+type RouteTag struct {
+	RouteID  uuid.UUID `json:"route_id"`
+	Tag      string    `json:"tag"`
+	Position int32     `json:"position"`
+}
+
+type RouteVersion struct {
+	ID                 uuid.UUID   `json:"id"`
+	RouteID            uuid.UUID   `json:"route_id"`
+	VersionNumber      int32       `json:"version_number"`
+	UserID             uuid.UUID   `json:"user_id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	HighlightedPhotoID *int64      `json:"highlighted_photo_id"`
+	Distance           float64     `json:"distance"`
+	Duration           float64     `json:"duration"`
+	ElevationGain      float64     `json:"elevation_gain"`
+	ElevationLoss      float64     `json:"elevation_loss"`
+	PathGeom           OrbGeometry `json:"path_geom"`
+	FirstPoint         OrbGeometry `json:"first_point"`
+	LastPoint          OrbGeometry `json:"last_point"`
+	Visibility         int16       `json:"visibility"`
+	CoursePoints       []byte      `json:"course_points"`
+	Waypoints          []byte      `json:"waypoints"`
+	CreatedAt          time.Time   `json:"created_at"`
+}
+
+type Tour struct {
+	ID          uuid.UUID   `json:"id"`
+	UserID      uuid.UUID   `json:"user_id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Visibility  int16       `json:"visibility"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	ClubID      pgtype.UUID `json:"club_id"`
+}
+
+type TourStage struct {
+	TourID        uuid.UUID `json:"tour_id"`
+	Position      int32     `json:"position"`
+	RouteID       uuid.UUID `json:"route_id"`
+	OvernightStop string    `json:"overnight_stop"`
+}
+
 type Trip struct {
 	ID                 uuid.UUID    `json:"id"`
 	UserID             uuid.UUID    `json:"user_id"`
@@ -144,23 +355,30 @@ type TripImage struct {
 }
 
 type User struct {
-	ID                 uuid.UUID    `json:"id"`
-	KratosID           uuid.UUID    `json:"kratos_id"`
-	Name               string       `json:"name"`
-	HighlightedPhotoID *int64       `json:"highlighted_photo_id"`
-	Locale             *string      `json:"locale"`
-	CreatedAt          time.Time    `json:"created_at"`
-	UpdatedAt          time.Time    `json:"updated_at"`
-	Description        *string      `json:"description"`
-	Locality           *string      `json:"locality"`
-	AdministrativeArea *string      `json:"administrative_area"`
-	CountryCode        *string      `json:"country_code"`
-	PostalCode         *string      `json:"postal_code"`
-	Geom               *OrbGeometry `json:"geom"`
-	FirstName          *string      `json:"first_name"`
-	LastName           *string      `json:"last_name"`
-	Email              *string      `json:"email"`
-	HasSetLocation     bool         `json:"has_set_location"`
+	ID                     uuid.UUID    `json:"id"`
+	KratosID               uuid.UUID    `json:"kratos_id"`
+	Name                   string       `json:"name"`
+	HighlightedPhotoID     *int64       `json:"highlighted_photo_id"`
+	Locale                 *string      `json:"locale"`
+	CreatedAt              time.Time    `json:"created_at"`
+	UpdatedAt              time.Time    `json:"updated_at"`
+	Description            *string      `json:"description"`
+	Locality               *string      `json:"locality"`
+	AdministrativeArea     *string      `json:"administrative_area"`
+	CountryCode            *string      `json:"country_code"`
+	PostalCode             *string      `json:"postal_code"`
+	Geom                   *OrbGeometry `json:"geom"`
+	FirstName              *string      `json:"first_name"`
+	LastName               *string      `json:"last_name"`
+	Email                  *string      `json:"email"`
+	HasSetLocation         bool         `json:"has_set_location"`
+	MutedNotificationTypes []string     `json:"muted_notification_types"`
+}
+
+type UserFollow struct {
+	FollowerID uuid.UUID `json:"follower_id"`
+	FolloweeID uuid.UUID `json:"followee_id"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 type Waypoint struct {
@@ -10,8 +10,37 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countGoingEventRSVPs = `-- name: CountGoingEventRSVPs :one
+SELECT count(*) FROM event_rsvps
+WHERE event_id = $1 AND status = 'going' AND user_id <> $2
+`
+
+type CountGoingEventRSVPsParams struct {
+	EventID       uuid.UUID `json:"event_id"`
+	ExcludeUserID uuid.UUID `json:"exclude_user_id"`
+}
+
+func (q *Queries) CountGoingEventRSVPs(ctx context.Context, arg CountGoingEventRSVPsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countGoingEventRSVPs, arg.EventID, arg.ExcludeUserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRouteForks = `-- name: CountRouteForks :one
+SELECT COUNT(*) FROM routes WHERE forked_from_route_id = $1
+`
+
+func (q *Queries) CountRouteForks(ctx context.Context, forkedFromRouteID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countRouteForks, forkedFromRouteID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countRoutesByUserID = `-- name: CountRoutesByUserID :one
 SELECT COUNT(*) FROM routes WHERE user_id = $1
 `
@@ -23,6 +52,157 @@ func (q *Queries) CountRoutesByUserID(ctx context.Context, userID uuid.UUID) (in
 	return count, err
 }
 
+const countTripsByUserID = `-- name: CountTripsByUserID :one
+SELECT COUNT(*) FROM trips WHERE user_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) CountTripsByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countTripsByUserID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUnreadNotifications = `-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL
+`
+
+func (q *Queries) CountUnreadNotifications(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadNotifications, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAdminBoundary = `-- name: CreateAdminBoundary :exec
+INSERT INTO admin_boundaries (name, admin_level, country_code, geom)
+VALUES ($1, $2, $3, ST_Multi(ST_GeomFromText($4::TEXT, 4326)))
+`
+
+type CreateAdminBoundaryParams struct {
+	Name        string `json:"name"`
+	AdminLevel  int16  `json:"admin_level"`
+	CountryCode string `json:"country_code"`
+	Geom        string `json:"geom"`
+}
+
+func (q *Queries) CreateAdminBoundary(ctx context.Context, arg CreateAdminBoundaryParams) error {
+	_, err := q.db.Exec(ctx, createAdminBoundary,
+		arg.Name,
+		arg.AdminLevel,
+		arg.CountryCode,
+		arg.Geom,
+	)
+	return err
+}
+
+const createClub = `-- name: CreateClub :exec
+INSERT INTO clubs (id, name, description)
+VALUES ($1, $2, $3)
+`
+
+type CreateClubParams struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+}
+
+func (q *Queries) CreateClub(ctx context.Context, arg CreateClubParams) error {
+	_, err := q.db.Exec(ctx, createClub, arg.ID, arg.Name, arg.Description)
+	return err
+}
+
+const createClubJoinRequest = `-- name: CreateClubJoinRequest :execrows
+INSERT INTO club_join_requests (club_id, user_id, message)
+VALUES ($1, $2, $3)
+ON CONFLICT (club_id, user_id) DO NOTHING
+`
+
+type CreateClubJoinRequestParams struct {
+	ClubID  uuid.UUID `json:"club_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	Message string    `json:"message"`
+}
+
+func (q *Queries) CreateClubJoinRequest(ctx context.Context, arg CreateClubJoinRequestParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createClubJoinRequest, arg.ClubID, arg.UserID, arg.Message)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createClubRoute = `-- name: CreateClubRoute :execrows
+INSERT INTO club_routes (club_id, route_id, added_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (club_id, route_id) DO NOTHING
+`
+
+type CreateClubRouteParams struct {
+	ClubID  uuid.UUID   `json:"club_id"`
+	RouteID uuid.UUID   `json:"route_id"`
+	AddedBy pgtype.UUID `json:"added_by"`
+}
+
+func (q *Queries) CreateClubRoute(ctx context.Context, arg CreateClubRouteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createClubRoute, arg.ClubID, arg.RouteID, arg.AddedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createCollection = `-- name: CreateCollection :exec
+INSERT INTO collections (
+    id,
+    user_id,
+    name,
+    description,
+    visibility
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+`
+
+type CreateCollectionParams struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Visibility  int16     `json:"visibility"`
+}
+
+func (q *Queries) CreateCollection(ctx context.Context, arg CreateCollectionParams) error {
+	_, err := q.db.Exec(ctx, createCollection,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Description,
+		arg.Visibility,
+	)
+	return err
+}
+
+const createCollectionRoute = `-- name: CreateCollectionRoute :exec
+INSERT INTO collection_routes (collection_id, route_id, position)
+VALUES ($1, $2, $3)
+`
+
+type CreateCollectionRouteParams struct {
+	CollectionID uuid.UUID `json:"collection_id"`
+	RouteID      uuid.UUID `json:"route_id"`
+	Position     int32     `json:"position"`
+}
+
+func (q *Queries) CreateCollectionRoute(ctx context.Context, arg CreateCollectionRouteParams) error {
+	_, err := q.db.Exec(ctx, createCollectionRoute, arg.CollectionID, arg.RouteID, arg.Position)
+	return err
+}
+
 const createCoursePoint = `-- name: CreateCoursePoint :exec
 INSERT INTO course_points (
     id,
@@ -78,6 +258,166 @@ func (q *Queries) CreateCoursePoint(ctx context.Context, arg CreateCoursePointPa
 	return err
 }
 
+const createEvent = `-- name: CreateEvent :exec
+INSERT INTO events (
+    id,
+    user_id,
+    route_id,
+    title,
+    description,
+    starts_at,
+    meeting_point,
+    meeting_point_name,
+    capacity,
+    visibility,
+    club_id
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    ST_GeomFromEWKB($7),
+    $8,
+    $9,
+    $10,
+    $11
+)
+`
+
+type CreateEventParams struct {
+	ID               uuid.UUID   `json:"id"`
+	UserID           uuid.UUID   `json:"user_id"`
+	RouteID          uuid.UUID   `json:"route_id"`
+	Title            string      `json:"title"`
+	Description      string      `json:"description"`
+	StartsAt         time.Time   `json:"starts_at"`
+	MeetingPoint     interface{} `json:"meeting_point"`
+	MeetingPointName string      `json:"meeting_point_name"`
+	Capacity         *int32      `json:"capacity"`
+	Visibility       int16       `json:"visibility"`
+	ClubID           pgtype.UUID `json:"club_id"`
+}
+
+func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) error {
+	_, err := q.db.Exec(ctx, createEvent,
+		arg.ID,
+		arg.UserID,
+		arg.RouteID,
+		arg.Title,
+		arg.Description,
+		arg.StartsAt,
+		arg.MeetingPoint,
+		arg.MeetingPointName,
+		arg.Capacity,
+		arg.Visibility,
+		arg.ClubID,
+	)
+	return err
+}
+
+const createEventComment = `-- name: CreateEventComment :exec
+INSERT INTO event_comments (id, event_id, user_id, body)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateEventCommentParams struct {
+	ID      uuid.UUID `json:"id"`
+	EventID uuid.UUID `json:"event_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	Body    string    `json:"body"`
+}
+
+func (q *Queries) CreateEventComment(ctx context.Context, arg CreateEventCommentParams) error {
+	_, err := q.db.Exec(ctx, createEventComment,
+		arg.ID,
+		arg.EventID,
+		arg.UserID,
+		arg.Body,
+	)
+	return err
+}
+
+const createFeedEvent = `-- name: CreateFeedEvent :exec
+INSERT INTO feed_events (id, actor_id, event_type, subject_id)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateFeedEventParams struct {
+	ID        uuid.UUID `json:"id"`
+	ActorID   uuid.UUID `json:"actor_id"`
+	EventType string    `json:"event_type"`
+	SubjectID uuid.UUID `json:"subject_id"`
+}
+
+func (q *Queries) CreateFeedEvent(ctx context.Context, arg CreateFeedEventParams) error {
+	_, err := q.db.Exec(ctx, createFeedEvent,
+		arg.ID,
+		arg.ActorID,
+		arg.EventType,
+		arg.SubjectID,
+	)
+	return err
+}
+
+const createNotification = `-- name: CreateNotification :exec
+INSERT INTO notifications (id, user_id, actor_id, type, route_id, event_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateNotificationParams struct {
+	ID      uuid.UUID   `json:"id"`
+	UserID  uuid.UUID   `json:"user_id"`
+	ActorID uuid.UUID   `json:"actor_id"`
+	Type    string      `json:"type"`
+	RouteID pgtype.UUID `json:"route_id"`
+	EventID pgtype.UUID `json:"event_id"`
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) error {
+	_, err := q.db.Exec(ctx, createNotification,
+		arg.ID,
+		arg.UserID,
+		arg.ActorID,
+		arg.Type,
+		arg.RouteID,
+		arg.EventID,
+	)
+	return err
+}
+
+const createPrivacyZone = `-- name: CreatePrivacyZone :exec
+INSERT INTO privacy_zones (
+    id,
+    user_id,
+    name,
+    center,
+    radius_m
+) VALUES (
+    $1, $2, $3, ST_GeomFromEWKB($4), $5
+)
+`
+
+type CreatePrivacyZoneParams struct {
+	ID      uuid.UUID   `json:"id"`
+	UserID  uuid.UUID   `json:"user_id"`
+	Name    string      `json:"name"`
+	Center  interface{} `json:"center"`
+	RadiusM float64     `json:"radius_m"`
+}
+
+func (q *Queries) CreatePrivacyZone(ctx context.Context, arg CreatePrivacyZoneParams) error {
+	_, err := q.db.Exec(ctx, createPrivacyZone,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Center,
+		arg.RadiusM,
+	)
+	return err
+}
+
 const createRoute = `-- name: CreateRoute :exec
 INSERT INTO routes (
     id,
@@ -94,9 +434,11 @@ INSERT INTO routes (
     first_point,
     last_point,
     polyline,
-    visibility
+    visibility,
+    forked_from_route_id,
+    club_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, ST_GeomFromEWKB($10), ST_GeomFromEWKB($11), ST_GeomFromEWKB($12), ST_GeomFromEWKB($13), ST_AsEncodedPolyline(ST_SimplifyPreserveTopology(ST_GeomFromEWKB($10), 0.0001)), $14
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, ST_GeomFromEWKB($10), ST_GeomFromEWKB($11), ST_GeomFromEWKB($12), ST_GeomFromEWKB($13), ST_AsEncodedPolyline(ST_SimplifyPreserveTopology(ST_GeomFromEWKB($10), 0.0001)), $14, $15, $16
 )
 `
 
@@ -115,6 +457,8 @@ type CreateRouteParams struct {
 	FirstPoint         interface{} `json:"first_point"`
 	LastPoint          interface{} `json:"last_point"`
 	Visibility         int16       `json:"visibility"`
+	ForkedFromRouteID  pgtype.UUID `json:"forked_from_route_id"`
+	ClubID             pgtype.UUID `json:"club_id"`
 }
 
 func (q *Queries) CreateRoute(ctx context.Context, arg CreateRouteParams) error {
@@ -133,212 +477,3332 @@ func (q *Queries) CreateRoute(ctx context.Context, arg CreateRouteParams) error
 		arg.FirstPoint,
 		arg.LastPoint,
 		arg.Visibility,
+		arg.ForkedFromRouteID,
+		arg.ClubID,
 	)
 	return err
 }
 
-const createUser = `-- name: CreateUser :one
-INSERT INTO users (
-    id,
-    kratos_id,
-    name,
-    highlighted_photo_id,
-    locale,
-    description,
-    locality,
-    administrative_area,
-    country_code,
-    postal_code,
-    geom,
-    first_name,
-    last_name,
-    email,
-    has_set_location
-) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, ST_GeomFromEWKB($11), $12, $13, $14, $15
-) RETURNING id, kratos_id, name, highlighted_photo_id, locale, created_at, updated_at, description, locality, administrative_area, country_code, postal_code, geom, first_name, last_name, email, has_set_location
+const createRouteComment = `-- name: CreateRouteComment :exec
+INSERT INTO route_comments (id, user_id, route_id, content)
+VALUES ($1, $2, $3, $4)
 `
 
-type CreateUserParams struct {
-	ID                 uuid.UUID   `json:"id"`
-	KratosID           uuid.UUID   `json:"kratos_id"`
-	Name               string      `json:"name"`
-	HighlightedPhotoID *int64      `json:"highlighted_photo_id"`
-	Locale             *string     `json:"locale"`
-	Description        *string     `json:"description"`
-	Locality           *string     `json:"locality"`
-	AdministrativeArea *string     `json:"administrative_area"`
-	CountryCode        *string     `json:"country_code"`
-	PostalCode         *string     `json:"postal_code"`
-	Geom               interface{} `json:"geom"`
-	FirstName          *string     `json:"first_name"`
-	LastName           *string     `json:"last_name"`
-	Email              *string     `json:"email"`
-	HasSetLocation     bool        `json:"has_set_location"`
+type CreateRouteCommentParams struct {
+	ID      uuid.UUID `json:"id"`
+	UserID  uuid.UUID `json:"user_id"`
+	RouteID uuid.UUID `json:"route_id"`
+	Content string    `json:"content"`
 }
 
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRow(ctx, createUser,
+func (q *Queries) CreateRouteComment(ctx context.Context, arg CreateRouteCommentParams) error {
+	_, err := q.db.Exec(ctx, createRouteComment,
 		arg.ID,
-		arg.KratosID,
-		arg.Name,
-		arg.HighlightedPhotoID,
-		arg.Locale,
-		arg.Description,
-		arg.Locality,
-		arg.AdministrativeArea,
-		arg.CountryCode,
-		arg.PostalCode,
-		arg.Geom,
-		arg.FirstName,
-		arg.LastName,
-		arg.Email,
-		arg.HasSetLocation,
-	)
-	var i User
-	err := row.Scan(
-		&i.ID,
-		&i.KratosID,
-		&i.Name,
-		&i.HighlightedPhotoID,
-		&i.Locale,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.Description,
-		&i.Locality,
-		&i.AdministrativeArea,
-		&i.CountryCode,
-		&i.PostalCode,
-		&i.Geom,
-		&i.FirstName,
-		&i.LastName,
-		&i.Email,
-		&i.HasSetLocation,
+		arg.UserID,
+		arg.RouteID,
+		arg.Content,
 	)
-	return i, err
+	return err
 }
 
-const createWaypoint = `-- name: CreateWaypoint :exec
-INSERT INTO waypoints (
+const createRouteImage = `-- name: CreateRouteImage :exec
+INSERT INTO route_images (
     id,
     route_id,
-    location
+    s3_key,
+    width,
+    height,
+    size,
+    type,
+    visibility
 ) VALUES (
-    $1, $2, ST_GeomFromEWKB($3)
+    $1, $2, $3, $4, $5, $6, $7, $8
 )
+ON CONFLICT (s3_key) DO NOTHING
 `
 
-type CreateWaypointParams struct {
-	ID       uuid.UUID   `json:"id"`
-	RouteID  uuid.UUID   `json:"route_id"`
-	Location interface{} `json:"location"`
+type CreateRouteImageParams struct {
+	ID         uuid.UUID `json:"id"`
+	RouteID    uuid.UUID `json:"route_id"`
+	S3Key      string    `json:"s3_key"`
+	Width      *int32    `json:"width"`
+	Height     *int32    `json:"height"`
+	Size       *int64    `json:"size"`
+	Type       string    `json:"type"`
+	Visibility int16     `json:"visibility"`
 }
 
-func (q *Queries) CreateWaypoint(ctx context.Context, arg CreateWaypointParams) error {
-	_, err := q.db.Exec(ctx, createWaypoint, arg.ID, arg.RouteID, arg.Location)
+func (q *Queries) CreateRouteImage(ctx context.Context, arg CreateRouteImageParams) error {
+	_, err := q.db.Exec(ctx, createRouteImage,
+		arg.ID,
+		arg.RouteID,
+		arg.S3Key,
+		arg.Width,
+		arg.Height,
+		arg.Size,
+		arg.Type,
+		arg.Visibility,
+	)
 	return err
 }
 
-const deleteCoursePoint = `-- name: DeleteCoursePoint :exec
-DELETE FROM course_points WHERE id = $1
+const createRouteLike = `-- name: CreateRouteLike :execrows
+INSERT INTO route_likes (id, user_id, route_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, route_id) DO NOTHING
 `
 
-func (q *Queries) DeleteCoursePoint(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteCoursePoint, id)
+type CreateRouteLikeParams struct {
+	ID      uuid.UUID `json:"id"`
+	UserID  uuid.UUID `json:"user_id"`
+	RouteID uuid.UUID `json:"route_id"`
+}
+
+func (q *Queries) CreateRouteLike(ctx context.Context, arg CreateRouteLikeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createRouteLike, arg.ID, arg.UserID, arg.RouteID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createRoutePlace = `-- name: CreateRoutePlace :exec
+INSERT INTO route_places (route_id, kind, locality, administrative_area, country_code)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateRoutePlaceParams struct {
+	RouteID            uuid.UUID `json:"route_id"`
+	Kind               string    `json:"kind"`
+	Locality           string    `json:"locality"`
+	AdministrativeArea string    `json:"administrative_area"`
+	CountryCode        string    `json:"country_code"`
+}
+
+func (q *Queries) CreateRoutePlace(ctx context.Context, arg CreateRoutePlaceParams) error {
+	_, err := q.db.Exec(ctx, createRoutePlace,
+		arg.RouteID,
+		arg.Kind,
+		arg.Locality,
+		arg.AdministrativeArea,
+		arg.CountryCode,
+	)
+	return err
+}
+
+const createRouteSave = `-- name: CreateRouteSave :execrows
+-- 保存を取り消したルートは保存し直せるよう、取り消し済みの行を戻す
+INSERT INTO route_saves (id, user_id, route_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, route_id) DO UPDATE SET deleted_at = NULL, created_at = now()
+WHERE route_saves.deleted_at IS NOT NULL
+`
+
+type CreateRouteSaveParams struct {
+	ID      uuid.UUID `json:"id"`
+	UserID  uuid.UUID `json:"user_id"`
+	RouteID uuid.UUID `json:"route_id"`
+}
+
+func (q *Queries) CreateRouteSave(ctx context.Context, arg CreateRouteSaveParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createRouteSave, arg.ID, arg.UserID, arg.RouteID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createRouteSurfaces = `-- name: CreateRouteSurfaces :exec
+-- 経路を25m以下の区間に分け、区間の中点から20m以内で最も近い道路の舗装と種類ごとに距離を集計する
+-- 近くに道路がない区間はunknownにする。surfaceタグのない道路は、未舗装になりやすい種類を除き舗装路とみなす
+INSERT INTO route_surfaces (route_id, kind, category, distance)
+WITH pieces AS (
+    SELECT ST_Length(segments.geom::geography) AS length,
+           ST_LineInterpolatePoint(segments.geom, 0.5) AS midpoint
+    FROM routes,
+         LATERAL ST_DumpSegments(ST_Segmentize(routes.path_geom::geography, 25)::geometry) AS segments
+    WHERE routes.id = $1
+),
+classified AS (
+    SELECT pieces.length,
+      CASE
+        WHEN road.highway IS NULL THEN 'unknown'
+        WHEN road.surface = ANY($2::TEXT[]) THEN 'paved'
+        WHEN road.surface = ANY($3::TEXT[]) THEN 'gravel'
+        WHEN road.surface = ANY($4::TEXT[]) THEN 'dirt'
+        WHEN road.surface IS NULL AND NOT road.highway = ANY($5::TEXT[]) THEN 'paved'
+        ELSE 'unknown'
+      END AS surface,
+      COALESCE(regexp_replace(road.highway, '_link$', ''), 'unknown') AS road_class
+    FROM pieces
+    LEFT JOIN LATERAL (
+        SELECT road_edges.highway, road_edges.surface
+        FROM road_edges
+        -- 度単位の範囲でインデックスを使って絞り込んでから、メートルで判定する
+        WHERE ST_DWithin(road_edges.geom, pieces.midpoint, 0.0005)
+          AND ST_DWithin(road_edges.geom::geography, pieces.midpoint::geography, 20)
+        ORDER BY road_edges.geom <-> pieces.midpoint
+        LIMIT 1
+    ) AS road ON TRUE
+)
+SELECT $1, 'surface', surface, SUM(length) FROM classified GROUP BY surface
+UNION ALL
+SELECT $1, 'road_class', road_class, SUM(length) FROM classified GROUP BY road_class
+`
+
+type CreateRouteSurfacesParams struct {
+	RouteID         uuid.UUID `json:"route_id"`
+	PavedSurfaces   []string  `json:"paved_surfaces"`
+	GravelSurfaces  []string  `json:"gravel_surfaces"`
+	DirtSurfaces    []string  `json:"dirt_surfaces"`
+	UnpavedHighways []string  `json:"unpaved_highways"`
+}
+
+func (q *Queries) CreateRouteSurfaces(ctx context.Context, arg CreateRouteSurfacesParams) error {
+	_, err := q.db.Exec(ctx, createRouteSurfaces,
+		arg.RouteID,
+		arg.PavedSurfaces,
+		arg.GravelSurfaces,
+		arg.DirtSurfaces,
+		arg.UnpavedHighways,
+	)
+	return err
+}
+
+const createRouteTag = `-- name: CreateRouteTag :exec
+INSERT INTO route_tags (route_id, tag, position)
+VALUES ($1, $2, $3)
+`
+
+type CreateRouteTagParams struct {
+	RouteID  uuid.UUID `json:"route_id"`
+	Tag      string    `json:"tag"`
+	Position int32     `json:"position"`
+}
+
+func (q *Queries) CreateRouteTag(ctx context.Context, arg CreateRouteTagParams) error {
+	_, err := q.db.Exec(ctx, createRouteTag, arg.RouteID, arg.Tag, arg.Position)
+	return err
+}
+
+const createRouteVersion = `-- name: CreateRouteVersion :exec
+INSERT INTO route_versions (
+    id,
+    route_id,
+    version_number,
+    user_id,
+    name,
+    description,
+    highlighted_photo_id,
+    distance,
+    duration,
+    elevation_gain,
+    elevation_loss,
+    path_geom,
+    first_point,
+    last_point,
+    visibility,
+    course_points,
+    waypoints
+) VALUES (
+    $1, $2, (SELECT COALESCE(MAX(version_number), 0) + 1 FROM route_versions WHERE route_id = $2), $3, $4, $5, $6, $7, $8, $9, $10, ST_GeomFromEWKB($11), ST_GeomFromEWKB($12), ST_GeomFromEWKB($13), $14, $15, $16
+)
+`
+
+type CreateRouteVersionParams struct {
+	ID                 uuid.UUID   `json:"id"`
+	RouteID            uuid.UUID   `json:"route_id"`
+	UserID             uuid.UUID   `json:"user_id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	HighlightedPhotoID *int64      `json:"highlighted_photo_id"`
+	Distance           float64     `json:"distance"`
+	Duration           float64     `json:"duration"`
+	ElevationGain      float64     `json:"elevation_gain"`
+	ElevationLoss      float64     `json:"elevation_loss"`
+	PathGeom           interface{} `json:"path_geom"`
+	FirstPoint         interface{} `json:"first_point"`
+	LastPoint          interface{} `json:"last_point"`
+	Visibility         int16       `json:"visibility"`
+	CoursePoints       []byte      `json:"course_points"`
+	Waypoints          []byte      `json:"waypoints"`
+}
+
+func (q *Queries) CreateRouteVersion(ctx context.Context, arg CreateRouteVersionParams) error {
+	_, err := q.db.Exec(ctx, createRouteVersion,
+		arg.ID,
+		arg.RouteID,
+		arg.UserID,
+		arg.Name,
+		arg.Description,
+		arg.HighlightedPhotoID,
+		arg.Distance,
+		arg.Duration,
+		arg.ElevationGain,
+		arg.ElevationLoss,
+		arg.PathGeom,
+		arg.FirstPoint,
+		arg.LastPoint,
+		arg.Visibility,
+		arg.CoursePoints,
+		arg.Waypoints,
+	)
+	return err
+}
+
+const createTour = `-- name: CreateTour :exec
+INSERT INTO tours (
+    id,
+    user_id,
+    name,
+    description,
+    visibility,
+    club_id
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+`
+
+type CreateTourParams struct {
+	ID          uuid.UUID   `json:"id"`
+	UserID      uuid.UUID   `json:"user_id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Visibility  int16       `json:"visibility"`
+	ClubID      pgtype.UUID `json:"club_id"`
+}
+
+func (q *Queries) CreateTour(ctx context.Context, arg CreateTourParams) error {
+	_, err := q.db.Exec(ctx, createTour,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Description,
+		arg.Visibility,
+		arg.ClubID,
+	)
+	return err
+}
+
+const createTourStage = `-- name: CreateTourStage :exec
+INSERT INTO tour_stages (tour_id, position, route_id, overnight_stop)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateTourStageParams struct {
+	TourID        uuid.UUID `json:"tour_id"`
+	Position      int32     `json:"position"`
+	RouteID       uuid.UUID `json:"route_id"`
+	OvernightStop string    `json:"overnight_stop"`
+}
+
+func (q *Queries) CreateTourStage(ctx context.Context, arg CreateTourStageParams) error {
+	_, err := q.db.Exec(ctx, createTourStage,
+		arg.TourID,
+		arg.Position,
+		arg.RouteID,
+		arg.OvernightStop,
+	)
+	return err
+}
+
+const createTrip = `-- name: CreateTrip :exec
+INSERT INTO trips (
+    id,
+    user_id,
+    name,
+    description,
+    visibility,
+    highlighted_photo_id,
+    path_geom,
+    first_point,
+    last_point,
+    bbox_geom,
+    distance,
+    duration,
+    moving_time,
+    elevation_gain,
+    elevation_loss,
+    avg_speed,
+    max_speed,
+    avg_cad,
+    max_cad,
+    min_cad,
+    max_hr,
+    min_hr,
+    avg_watts,
+    max_watts,
+    min_watts,
+    avg_watts_estimated,
+    avg_power_estimated,
+    calories,
+    is_gps,
+    is_stationary,
+    processed,
+    departed_at,
+    time_zone,
+    utc_offset,
+    activity_type_id,
+    pace,
+    moving_pace
+) VALUES (
+    $1, $2, $3, $4, $5, $6, ST_GeomFromEWKB($7), ST_GeomFromEWKB($8), ST_GeomFromEWKB($9), ST_GeomFromEWKB($10), $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37
+)
+`
+
+type CreateTripParams struct {
+	ID                 uuid.UUID    `json:"id"`
+	UserID             uuid.UUID    `json:"user_id"`
+	Name               string       `json:"name"`
+	Description        string       `json:"description"`
+	Visibility         int16        `json:"visibility"`
+	HighlightedPhotoID int64        `json:"highlighted_photo_id"`
+	PathGeom           *OrbGeometry `json:"path_geom"`
+	FirstPoint         *OrbGeometry `json:"first_point"`
+	LastPoint          *OrbGeometry `json:"last_point"`
+	BboxGeom           *OrbGeometry `json:"bbox_geom"`
+	Distance           *float64     `json:"distance"`
+	Duration           *int32       `json:"duration"`
+	MovingTime         *int32       `json:"moving_time"`
+	ElevationGain      *float64     `json:"elevation_gain"`
+	ElevationLoss      *float64     `json:"elevation_loss"`
+	AvgSpeed           *float64     `json:"avg_speed"`
+	MaxSpeed           *float64     `json:"max_speed"`
+	AvgCad             *float64     `json:"avg_cad"`
+	MaxCad             *float64     `json:"max_cad"`
+	MinCad             *float64     `json:"min_cad"`
+	MaxHr              *int32       `json:"max_hr"`
+	MinHr              *int32       `json:"min_hr"`
+	AvgWatts           *float64     `json:"avg_watts"`
+	MaxWatts           *float64     `json:"max_watts"`
+	MinWatts           *float64     `json:"min_watts"`
+	AvgWattsEstimated  *bool        `json:"avg_watts_estimated"`
+	AvgPowerEstimated  *float64     `json:"avg_power_estimated"`
+	Calories           *float64     `json:"calories"`
+	IsGps              bool         `json:"is_gps"`
+	IsStationary       bool         `json:"is_stationary"`
+	Processed          bool         `json:"processed"`
+	DepartedAt         *time.Time   `json:"departed_at"`
+	TimeZone           *string      `json:"time_zone"`
+	UtcOffset          *int32       `json:"utc_offset"`
+	ActivityTypeID     int32        `json:"activity_type_id"`
+	Pace               *float64     `json:"pace"`
+	MovingPace         *float64     `json:"moving_pace"`
+}
+
+func (q *Queries) CreateTrip(ctx context.Context, arg CreateTripParams) error {
+	_, err := q.db.Exec(ctx, createTrip,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.Description,
+		arg.Visibility,
+		arg.HighlightedPhotoID,
+		arg.PathGeom,
+		arg.FirstPoint,
+		arg.LastPoint,
+		arg.BboxGeom,
+		arg.Distance,
+		arg.Duration,
+		arg.MovingTime,
+		arg.ElevationGain,
+		arg.ElevationLoss,
+		arg.AvgSpeed,
+		arg.MaxSpeed,
+		arg.AvgCad,
+		arg.MaxCad,
+		arg.MinCad,
+		arg.MaxHr,
+		arg.MinHr,
+		arg.AvgWatts,
+		arg.MaxWatts,
+		arg.MinWatts,
+		arg.AvgWattsEstimated,
+		arg.AvgPowerEstimated,
+		arg.Calories,
+		arg.IsGps,
+		arg.IsStationary,
+		arg.Processed,
+		arg.DepartedAt,
+		arg.TimeZone,
+		arg.UtcOffset,
+		arg.ActivityTypeID,
+		arg.Pace,
+		arg.MovingPace,
+	)
 	return err
 }
 
-const deleteCoursePointsByRouteID = `-- name: DeleteCoursePointsByRouteID :exec
-DELETE FROM course_points WHERE route_id = $1
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (
+    id,
+    kratos_id,
+    name,
+    highlighted_photo_id,
+    locale,
+    description,
+    locality,
+    administrative_area,
+    country_code,
+    postal_code,
+    geom,
+    first_name,
+    last_name,
+    email,
+    has_set_location
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, ST_GeomFromEWKB($11), $12, $13, $14, $15
+) RETURNING id, kratos_id, name, highlighted_photo_id, locale, created_at, updated_at, description, locality, administrative_area, country_code, postal_code, geom, first_name, last_name, email, has_set_location, muted_notification_types
+`
+
+type CreateUserParams struct {
+	ID                 uuid.UUID   `json:"id"`
+	KratosID           uuid.UUID   `json:"kratos_id"`
+	Name               string      `json:"name"`
+	HighlightedPhotoID *int64      `json:"highlighted_photo_id"`
+	Locale             *string     `json:"locale"`
+	Description        *string     `json:"description"`
+	Locality           *string     `json:"locality"`
+	AdministrativeArea *string     `json:"administrative_area"`
+	CountryCode        *string     `json:"country_code"`
+	PostalCode         *string     `json:"postal_code"`
+	Geom               interface{} `json:"geom"`
+	FirstName          *string     `json:"first_name"`
+	LastName           *string     `json:"last_name"`
+	Email              *string     `json:"email"`
+	HasSetLocation     bool        `json:"has_set_location"`
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createUser,
+		arg.ID,
+		arg.KratosID,
+		arg.Name,
+		arg.HighlightedPhotoID,
+		arg.Locale,
+		arg.Description,
+		arg.Locality,
+		arg.AdministrativeArea,
+		arg.CountryCode,
+		arg.PostalCode,
+		arg.Geom,
+		arg.FirstName,
+		arg.LastName,
+		arg.Email,
+		arg.HasSetLocation,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.KratosID,
+		&i.Name,
+		&i.HighlightedPhotoID,
+		&i.Locale,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Description,
+		&i.Locality,
+		&i.AdministrativeArea,
+		&i.CountryCode,
+		&i.PostalCode,
+		&i.Geom,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.HasSetLocation,
+		&i.MutedNotificationTypes,
+	)
+	return i, err
+}
+
+const createUserFollow = `-- name: CreateUserFollow :execrows
+INSERT INTO user_follows (follower_id, followee_id)
+VALUES ($1, $2)
+ON CONFLICT (follower_id, followee_id) DO NOTHING
+`
+
+type CreateUserFollowParams struct {
+	FollowerID uuid.UUID `json:"follower_id"`
+	FolloweeID uuid.UUID `json:"followee_id"`
+}
+
+func (q *Queries) CreateUserFollow(ctx context.Context, arg CreateUserFollowParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createUserFollow, arg.FollowerID, arg.FolloweeID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createWaypoint = `-- name: CreateWaypoint :exec
+INSERT INTO waypoints (
+    id,
+    route_id,
+    location
+) VALUES (
+    $1, $2, ST_GeomFromEWKB($3)
+)
+`
+
+type CreateWaypointParams struct {
+	ID       uuid.UUID   `json:"id"`
+	RouteID  uuid.UUID   `json:"route_id"`
+	Location interface{} `json:"location"`
+}
+
+func (q *Queries) CreateWaypoint(ctx context.Context, arg CreateWaypointParams) error {
+	_, err := q.db.Exec(ctx, createWaypoint, arg.ID, arg.RouteID, arg.Location)
+	return err
+}
+
+const deleteAdminBoundaries = `-- name: DeleteAdminBoundaries :exec
+DELETE FROM admin_boundaries
+`
+
+func (q *Queries) DeleteAdminBoundaries(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteAdminBoundaries)
+	return err
+}
+
+const deleteClub = `-- name: DeleteClub :execrows
+DELETE FROM clubs WHERE id = $1
+`
+
+func (q *Queries) DeleteClub(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteClub, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteClubJoinRequest = `-- name: DeleteClubJoinRequest :execrows
+DELETE FROM club_join_requests WHERE club_id = $1 AND user_id = $2
+`
+
+type DeleteClubJoinRequestParams struct {
+	ClubID uuid.UUID `json:"club_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteClubJoinRequest(ctx context.Context, arg DeleteClubJoinRequestParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteClubJoinRequest, arg.ClubID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteClubMember = `-- name: DeleteClubMember :execrows
+DELETE FROM club_members WHERE club_id = $1 AND user_id = $2
+`
+
+type DeleteClubMemberParams struct {
+	ClubID uuid.UUID `json:"club_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteClubMember(ctx context.Context, arg DeleteClubMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteClubMember, arg.ClubID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteClubRoute = `-- name: DeleteClubRoute :execrows
+DELETE FROM club_routes WHERE club_id = $1 AND route_id = $2
+`
+
+type DeleteClubRouteParams struct {
+	ClubID  uuid.UUID `json:"club_id"`
+	RouteID uuid.UUID `json:"route_id"`
+}
+
+func (q *Queries) DeleteClubRoute(ctx context.Context, arg DeleteClubRouteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteClubRoute, arg.ClubID, arg.RouteID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCollection = `-- name: DeleteCollection :execrows
+DELETE FROM collections WHERE id = $1
+`
+
+func (q *Queries) DeleteCollection(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCollection, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteCollectionRoutes = `-- name: DeleteCollectionRoutes :exec
+DELETE FROM collection_routes WHERE collection_id = $1
+`
+
+func (q *Queries) DeleteCollectionRoutes(ctx context.Context, collectionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCollectionRoutes, collectionID)
+	return err
+}
+
+const deleteCoursePoint = `-- name: DeleteCoursePoint :exec
+DELETE FROM course_points WHERE id = $1
+`
+
+func (q *Queries) DeleteCoursePoint(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCoursePoint, id)
+	return err
+}
+
+const deleteCoursePointsByRouteID = `-- name: DeleteCoursePointsByRouteID :exec
+DELETE FROM course_points WHERE route_id = $1
+`
+
+func (q *Queries) DeleteCoursePointsByRouteID(ctx context.Context, routeID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCoursePointsByRouteID, routeID)
+	return err
+}
+
+const deleteEventComment = `-- name: DeleteEventComment :execrows
+DELETE FROM event_comments WHERE id = $1
+`
+
+func (q *Queries) DeleteEventComment(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEventComment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteFeedEvents = `-- name: DeleteFeedEvents :exec
+DELETE FROM feed_events
+WHERE actor_id = $1 AND event_type = $2 AND subject_id = $3
+`
+
+type DeleteFeedEventsParams struct {
+	ActorID   uuid.UUID `json:"actor_id"`
+	EventType string    `json:"event_type"`
+	SubjectID uuid.UUID `json:"subject_id"`
+}
+
+func (q *Queries) DeleteFeedEvents(ctx context.Context, arg DeleteFeedEventsParams) error {
+	_, err := q.db.Exec(ctx, deleteFeedEvents, arg.ActorID, arg.EventType, arg.SubjectID)
+	return err
+}
+
+const deletePOIs = `-- name: DeletePOIs :exec
+DELETE FROM pois
+`
+
+func (q *Queries) DeletePOIs(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deletePOIs)
+	return err
+}
+
+const deletePrivacyZone = `-- name: DeletePrivacyZone :execrows
+DELETE FROM privacy_zones WHERE id = $1 AND user_id = $2
+`
+
+type DeletePrivacyZoneParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeletePrivacyZone(ctx context.Context, arg DeletePrivacyZoneParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePrivacyZone, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRoadEdges = `-- name: DeleteRoadEdges :exec
+DELETE FROM road_edges
+`
+
+func (q *Queries) DeleteRoadEdges(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteRoadEdges)
+	return err
+}
+
+const deleteRoute = `-- name: DeleteRoute :one
+DELETE FROM routes WHERE id = $1 RETURNING id
+`
+
+func (q *Queries) DeleteRoute(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteRoute, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteRouteComment = `-- name: DeleteRouteComment :execrows
+UPDATE route_comments SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteRouteComment(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRouteComment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRouteLike = `-- name: DeleteRouteLike :execrows
+DELETE FROM route_likes WHERE user_id = $1 AND route_id = $2
+`
+
+type DeleteRouteLikeParams struct {
+	UserID  uuid.UUID `json:"user_id"`
+	RouteID uuid.UUID `json:"route_id"`
+}
+
+func (q *Queries) DeleteRouteLike(ctx context.Context, arg DeleteRouteLikeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRouteLike, arg.UserID, arg.RouteID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRoutePlaces = `-- name: DeleteRoutePlaces :exec
+DELETE FROM route_places WHERE route_id = $1
+`
+
+func (q *Queries) DeleteRoutePlaces(ctx context.Context, routeID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRoutePlaces, routeID)
+	return err
+}
+
+const deleteRouteSave = `-- name: DeleteRouteSave :execrows
+UPDATE route_saves SET deleted_at = now()
+WHERE user_id = $1 AND route_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteRouteSaveParams struct {
+	UserID  uuid.UUID `json:"user_id"`
+	RouteID uuid.UUID `json:"route_id"`
+}
+
+func (q *Queries) DeleteRouteSave(ctx context.Context, arg DeleteRouteSaveParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRouteSave, arg.UserID, arg.RouteID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRouteSurfaces = `-- name: DeleteRouteSurfaces :exec
+DELETE FROM route_surfaces WHERE route_id = $1
+`
+
+func (q *Queries) DeleteRouteSurfaces(ctx context.Context, routeID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRouteSurfaces, routeID)
+	return err
+}
+
+const deleteRouteTags = `-- name: DeleteRouteTags :exec
+DELETE FROM route_tags WHERE route_id = $1
+`
+
+func (q *Queries) DeleteRouteTags(ctx context.Context, routeID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRouteTags, routeID)
+	return err
+}
+
+const deleteTour = `-- name: DeleteTour :execrows
+DELETE FROM tours WHERE id = $1
+`
+
+func (q *Queries) DeleteTour(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTour, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTourStages = `-- name: DeleteTourStages :exec
+DELETE FROM tour_stages WHERE tour_id = $1
+`
+
+func (q *Queries) DeleteTourStages(ctx context.Context, tourID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTourStages, tourID)
+	return err
+}
+
+const deleteTrip = `-- name: DeleteTrip :execrows
+UPDATE trips SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteTrip(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTrip, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserFollow = `-- name: DeleteUserFollow :execrows
+DELETE FROM user_follows WHERE follower_id = $1 AND followee_id = $2
+`
+
+type DeleteUserFollowParams struct {
+	FollowerID uuid.UUID `json:"follower_id"`
+	FolloweeID uuid.UUID `json:"followee_id"`
+}
+
+func (q *Queries) DeleteUserFollow(ctx context.Context, arg DeleteUserFollowParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserFollow, arg.FollowerID, arg.FolloweeID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWaypoint = `-- name: DeleteWaypoint :exec
+DELETE FROM waypoints WHERE id = $1
+`
+
+func (q *Queries) DeleteWaypoint(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteWaypoint, id)
+	return err
+}
+
+const deleteWaypointsByRouteID = `-- name: DeleteWaypointsByRouteID :exec
+DELETE FROM waypoints WHERE route_id = $1
+`
+
+func (q *Queries) DeleteWaypointsByRouteID(ctx context.Context, routeID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteWaypointsByRouteID, routeID)
+	return err
+}
+
+const exploreRoutes = `-- name: ExploreRoutes :many
+SELECT
+  ranked_routes.id,
+  ranked_routes.user_id,
+  ranked_routes.name,
+  ranked_routes.description,
+  ranked_routes.highlighted_photo_id,
+  ranked_routes.distance,
+  ranked_routes.duration,
+  ranked_routes.elevation_gain,
+  ranked_routes.elevation_loss,
+  ranked_routes.path_geom,
+  ranked_routes.bbox,
+  ranked_routes.first_point,
+  ranked_routes.last_point,
+  ranked_routes.polyline,
+  ranked_routes.created_at,
+  ranked_routes.updated_at,
+  ranked_routes.visibility,
+  ranked_routes.version,
+  ranked_routes.forked_from_route_id,
+  ranked_routes.difficulty,
+  ranked_routes.user_name,
+  ranked_routes.sort_key
+FROM (
+    SELECT routes.id, routes.user_id, routes.name, routes.description, routes.highlighted_photo_id, routes.distance, routes.duration, routes.elevation_gain, routes.elevation_loss, routes.path_geom, routes.bbox, routes.first_point, routes.last_point, routes.polyline, routes.created_at, routes.updated_at, routes.visibility, routes.version, routes.forked_from_route_id, routes.difficulty, users.name AS user_name,
+      CASE $1::TEXT
+        -- 近い順も降順で扱えるよう、距離の符号を反転する
+        WHEN 'nearest' THEN -ST_Distance(routes.first_point::geography, ST_GeomFromEWKB($2)::geography)
+        WHEN 'most_liked' THEN (SELECT COUNT(*) FROM route_likes WHERE route_likes.route_id = routes.id)::DOUBLE PRECISION
+        WHEN 'longest' THEN routes.distance
+        WHEN 'hilliest' THEN CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END
+        -- 全文検索の一致度と、ルート名のあいまい一致度の合計
+        WHEN 'relevance' THEN COALESCE(ts_rank_cd(route_search_documents.search_vector, plainto_tsquery('simple', $3::TEXT)), 0)
+                              + word_similarity($4::TEXT, routes.name)
+        ELSE EXTRACT(EPOCH FROM routes.created_at)::DOUBLE PRECISION
+      END::DOUBLE PRECISION AS sort_key
+    FROM routes
+    INNER JOIN users ON routes.user_id = users.id
+    LEFT JOIN route_search_documents ON route_search_documents.route_id = routes.id
+    WHERE routes.visibility = 1
+    AND ($5::float8 < 0 OR ST_DWithin(
+        routes.first_point::geography,
+        ST_GeomFromEWKB($2)::geography,
+        $5::float8
+    ))
+    AND (cardinality($6::TEXT[]) = 0
+         OR route_search_documents.search_vector @@ plainto_tsquery('simple', $3::TEXT)
+         OR routes.name ILIKE ANY($6::TEXT[])
+         OR $4::TEXT <% routes.name)
+    AND ($7::DOUBLE PRECISION < 0 OR routes.distance >= $7::DOUBLE PRECISION)
+    AND ($8::DOUBLE PRECISION < 0 OR routes.distance <= $8::DOUBLE PRECISION)
+    AND ($9::DOUBLE PRECISION < 0 OR routes.elevation_gain >= $9::DOUBLE PRECISION)
+    AND ($10::DOUBLE PRECISION < 0 OR routes.elevation_gain <= $10::DOUBLE PRECISION)
+    AND ($11::DOUBLE PRECISION < 0 OR routes.duration >= $11::DOUBLE PRECISION)
+    AND ($12::DOUBLE PRECISION < 0 OR routes.duration <= $12::DOUBLE PRECISION)
+    AND ($13::TEXT = '' OR users.name ILIKE $13::TEXT ESCAPE '\')
+    AND ($14::DOUBLE PRECISION < 0
+         OR (CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END) >= $14::DOUBLE PRECISION)
+    AND ($15::DOUBLE PRECISION < 0
+         OR (CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END) <= $15::DOUBLE PRECISION)
+    -- 未舗装（gravel, dirt）の割合(%)。路面の内訳がまだないルートは含めない
+    AND ($16::DOUBLE PRECISION < 0
+         OR (SELECT SUM(CASE WHEN route_surfaces.category IN ('gravel', 'dirt') THEN route_surfaces.distance ELSE 0 END) * 100
+                    / NULLIF(SUM(route_surfaces.distance), 0)
+             FROM route_surfaces
+             WHERE route_surfaces.route_id = routes.id AND route_surfaces.kind = 'surface') <= $16::DOUBLE PRECISION)
+    -- 出発地点か目的地の市区町村・都道府県名の前方一致。地名がまだないルートは含めない
+    AND ($17::TEXT = ''
+         OR EXISTS (SELECT 1 FROM route_places
+                    WHERE route_places.route_id = routes.id
+                      AND (route_places.locality LIKE $17::TEXT OR route_places.administrative_area LIKE $17::TEXT)))
+    -- 難易度の範囲（0は指定なし）。難易度をまだ求めていないルートは含めない
+    AND ($18::SMALLINT = 0 OR routes.difficulty >= $18::SMALLINT)
+    AND ($19::SMALLINT = 0 OR routes.difficulty <= $19::SMALLINT)
+) AS ranked_routes
+WHERE NOT $20::BOOLEAN
+   OR (ranked_routes.sort_key, ranked_routes.id) < ($21::DOUBLE PRECISION, $22::UUID)
+ORDER BY ranked_routes.sort_key DESC, ranked_routes.id DESC
+LIMIT $23::INT
+`
+
+type ExploreRoutesParams struct {
+	Sort                 string      `json:"sort"`
+	Location             interface{} `json:"location"`
+	SearchQuery          string      `json:"search_query"`
+	Keyword              string      `json:"keyword"`
+	RadiusM              float64     `json:"radius_m"`
+	NameKeywords         []string    `json:"name_keywords"`
+	MinDistance          float64     `json:"min_distance"`
+	MaxDistance          float64     `json:"max_distance"`
+	MinElevationGain     float64     `json:"min_elevation_gain"`
+	MaxElevationGain     float64     `json:"max_elevation_gain"`
+	MinDuration          float64     `json:"min_duration"`
+	MaxDuration          float64     `json:"max_duration"`
+	Author               string      `json:"author"`
+	MinClimbingRatio     float64     `json:"min_climbing_ratio"`
+	MaxClimbingRatio     float64     `json:"max_climbing_ratio"`
+	MaxUnpavedPercentage float64     `json:"max_unpaved_percentage"`
+	Area                 string      `json:"area"`
+	MinDifficulty        int16       `json:"min_difficulty"`
+	MaxDifficulty        int16       `json:"max_difficulty"`
+	HasCursor            bool        `json:"has_cursor"`
+	CursorSortKey        float64     `json:"cursor_sort_key"`
+	CursorID             uuid.UUID   `json:"cursor_id"`
+	LimitCount           int32       `json:"limit_count"`
+}
+
+type ExploreRoutesRow struct {
+	ID                 uuid.UUID   `json:"id"`
+	UserID             uuid.UUID   `json:"user_id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	HighlightedPhotoID *int64      `json:"highlighted_photo_id"`
+	Distance           float64     `json:"distance"`
+	Duration           float64     `json:"duration"`
+	ElevationGain      float64     `json:"elevation_gain"`
+	ElevationLoss      float64     `json:"elevation_loss"`
+	PathGeom           OrbGeometry `json:"path_geom"`
+	Bbox               OrbGeometry `json:"bbox"`
+	FirstPoint         OrbGeometry `json:"first_point"`
+	LastPoint          OrbGeometry `json:"last_point"`
+	Polyline           string      `json:"polyline"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+	Visibility         int16       `json:"visibility"`
+	Version            int32       `json:"version"`
+	ForkedFromRouteID  pgtype.UUID `json:"forked_from_route_id"`
+	Difficulty         *int16      `json:"difficulty"`
+	UserName           string      `json:"user_name"`
+	SortKey            float64     `json:"sort_key"`
+}
+
+func (q *Queries) ExploreRoutes(ctx context.Context, arg ExploreRoutesParams) ([]ExploreRoutesRow, error) {
+	rows, err := q.db.Query(ctx, exploreRoutes,
+		arg.Sort,
+		arg.Location,
+		arg.SearchQuery,
+		arg.Keyword,
+		arg.RadiusM,
+		arg.NameKeywords,
+		arg.MinDistance,
+		arg.MaxDistance,
+		arg.MinElevationGain,
+		arg.MaxElevationGain,
+		arg.MinDuration,
+		arg.MaxDuration,
+		arg.Author,
+		arg.MinClimbingRatio,
+		arg.MaxClimbingRatio,
+		arg.MaxUnpavedPercentage,
+		arg.Area,
+		arg.MinDifficulty,
+		arg.MaxDifficulty,
+		arg.HasCursor,
+		arg.CursorSortKey,
+		arg.CursorID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExploreRoutesRow
+	for rows.Next() {
+		var i ExploreRoutesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.HighlightedPhotoID,
+			&i.Distance,
+			&i.Duration,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+			&i.PathGeom,
+			&i.Bbox,
+			&i.FirstPoint,
+			&i.LastPoint,
+			&i.Polyline,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Visibility,
+			&i.Version,
+			&i.ForkedFromRouteID,
+			&i.Difficulty,
+			&i.UserName,
+			&i.SortKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSimilarRouteCandidates = `-- name: FindSimilarRouteCandidates :many
+SELECT
+  routes.id,
+  routes.user_id,
+  routes.name,
+  routes.description,
+  routes.highlighted_photo_id,
+  routes.distance,
+  routes.duration,
+  routes.elevation_gain,
+  routes.elevation_loss,
+  routes.path_geom,
+  routes.bbox,
+  routes.first_point,
+  routes.last_point,
+  routes.polyline,
+  routes.created_at,
+  routes.updated_at,
+  routes.visibility,
+  routes.version,
+  routes.forked_from_route_id,
+  routes.difficulty,
+  users.name AS user_name
+FROM routes
+INNER JOIN users ON routes.user_id = users.id
+WHERE routes.visibility = 1
+  AND routes.id <> $1
+  AND routes.bbox && ST_Expand(ST_GeomFromEWKB($2), $3::float8)
+  AND routes.distance BETWEEN $4::DOUBLE PRECISION AND $5::DOUBLE PRECISION
+ORDER BY
+  ST_Distance(routes.first_point::geography, ST_GeomFromEWKB($6)::geography),
+  routes.id
+LIMIT $7::INT
+`
+
+type FindSimilarRouteCandidatesParams struct {
+	RouteID     uuid.UUID   `json:"route_id"`
+	Bbox        interface{} `json:"bbox"`
+	PaddingDeg  float64     `json:"padding_deg"`
+	MinDistance float64     `json:"min_distance"`
+	MaxDistance float64     `json:"max_distance"`
+	FirstPoint  interface{} `json:"first_point"`
+	LimitCount  int32       `json:"limit_count"`
+}
+
+type FindSimilarRouteCandidatesRow struct {
+	ID                 uuid.UUID   `json:"id"`
+	UserID             uuid.UUID   `json:"user_id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	HighlightedPhotoID *int64      `json:"highlighted_photo_id"`
+	Distance           float64     `json:"distance"`
+	Duration           float64     `json:"duration"`
+	ElevationGain      float64     `json:"elevation_gain"`
+	ElevationLoss      float64     `json:"elevation_loss"`
+	PathGeom           OrbGeometry `json:"path_geom"`
+	Bbox               OrbGeometry `json:"bbox"`
+	FirstPoint         OrbGeometry `json:"first_point"`
+	LastPoint          OrbGeometry `json:"last_point"`
+	Polyline           string      `json:"polyline"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+	Visibility         int16       `json:"visibility"`
+	Version            int32       `json:"version"`
+	ForkedFromRouteID  pgtype.UUID `json:"forked_from_route_id"`
+	Difficulty         *int16      `json:"difficulty"`
+	UserName           string      `json:"user_name"`
+}
+
+func (q *Queries) FindSimilarRouteCandidates(ctx context.Context, arg FindSimilarRouteCandidatesParams) ([]FindSimilarRouteCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, findSimilarRouteCandidates,
+		arg.RouteID,
+		arg.Bbox,
+		arg.PaddingDeg,
+		arg.MinDistance,
+		arg.MaxDistance,
+		arg.FirstPoint,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindSimilarRouteCandidatesRow
+	for rows.Next() {
+		var i FindSimilarRouteCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.HighlightedPhotoID,
+			&i.Distance,
+			&i.Duration,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+			&i.PathGeom,
+			&i.Bbox,
+			&i.FirstPoint,
+			&i.LastPoint,
+			&i.Polyline,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Visibility,
+			&i.Version,
+			&i.ForkedFromRouteID,
+			&i.Difficulty,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getClubByID = `-- name: GetClubByID :one
+SELECT clubs.id, clubs.name, clubs.description, clubs.created_at, clubs.updated_at,
+    (SELECT count(*) FROM club_members WHERE club_members.club_id = clubs.id)::INTEGER AS member_count
+FROM clubs
+WHERE clubs.id = $1
+`
+
+type GetClubByIDRow struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	MemberCount int32     `json:"member_count"`
+}
+
+func (q *Queries) GetClubByID(ctx context.Context, id uuid.UUID) (GetClubByIDRow, error) {
+	row := q.db.QueryRow(ctx, getClubByID, id)
+	var i GetClubByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MemberCount,
+	)
+	return i, err
+}
+
+const getClubIDsByUserID = `-- name: GetClubIDsByUserID :many
+SELECT club_id FROM club_members WHERE user_id = $1
+`
+
+func (q *Queries) GetClubIDsByUserID(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getClubIDsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var clubID uuid.UUID
+		if err := rows.Scan(&clubID); err != nil {
+			return nil, err
+		}
+		items = append(items, clubID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getClubJoinRequest = `-- name: GetClubJoinRequest :one
+SELECT club_join_requests.club_id, club_join_requests.user_id, club_join_requests.message, club_join_requests.created_at, users.name AS user_name
+FROM club_join_requests
+INNER JOIN users ON club_join_requests.user_id = users.id
+WHERE club_join_requests.club_id = $1 AND club_join_requests.user_id = $2
+`
+
+type GetClubJoinRequestParams struct {
+	ClubID uuid.UUID `json:"club_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type GetClubJoinRequestRow struct {
+	ClubID    uuid.UUID `json:"club_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+	UserName  string    `json:"user_name"`
+}
+
+func (q *Queries) GetClubJoinRequest(ctx context.Context, arg GetClubJoinRequestParams) (GetClubJoinRequestRow, error) {
+	row := q.db.QueryRow(ctx, getClubJoinRequest, arg.ClubID, arg.UserID)
+	var i GetClubJoinRequestRow
+	err := row.Scan(
+		&i.ClubID,
+		&i.UserID,
+		&i.Message,
+		&i.CreatedAt,
+		&i.UserName,
+	)
+	return i, err
+}
+
+const getClubMember = `-- name: GetClubMember :one
+SELECT club_members.club_id, club_members.user_id, club_members.role, club_members.created_at, users.name AS user_name
+FROM club_members
+INNER JOIN users ON club_members.user_id = users.id
+WHERE club_members.club_id = $1 AND club_members.user_id = $2
+`
+
+type GetClubMemberParams struct {
+	ClubID uuid.UUID `json:"club_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type GetClubMemberRow struct {
+	ClubID    uuid.UUID `json:"club_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+	UserName  string    `json:"user_name"`
+}
+
+func (q *Queries) GetClubMember(ctx context.Context, arg GetClubMemberParams) (GetClubMemberRow, error) {
+	row := q.db.QueryRow(ctx, getClubMember, arg.ClubID, arg.UserID)
+	var i GetClubMemberRow
+	err := row.Scan(
+		&i.ClubID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UserName,
+	)
+	return i, err
+}
+
+const getClubsByUserID = `-- name: GetClubsByUserID :many
+SELECT clubs.id, clubs.name, clubs.description, clubs.created_at, clubs.updated_at,
+    (SELECT count(*) FROM club_members AS members WHERE members.club_id = clubs.id)::INTEGER AS member_count
+FROM clubs
+INNER JOIN club_members ON club_members.club_id = clubs.id
+WHERE club_members.user_id = $1
+ORDER BY clubs.created_at DESC, clubs.id DESC
+`
+
+type GetClubsByUserIDRow struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	MemberCount int32     `json:"member_count"`
+}
+
+func (q *Queries) GetClubsByUserID(ctx context.Context, userID uuid.UUID) ([]GetClubsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, getClubsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetClubsByUserIDRow
+	for rows.Next() {
+		var i GetClubsByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MemberCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCollectionByID = `-- name: GetCollectionByID :one
+SELECT id, user_id, name, description, visibility, created_at, updated_at FROM collections WHERE id = $1
+`
+
+func (q *Queries) GetCollectionByID(ctx context.Context, id uuid.UUID) (Collection, error) {
+	row := q.db.QueryRow(ctx, getCollectionByID, id)
+	var i Collection
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Description,
+		&i.Visibility,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCoursePointsByRouteID = `-- name: GetCoursePointsByRouteID :many
+SELECT id, route_id, step_order, seg_dist_m, cum_dist_m, duration, instruction, road_name, maneuver_type, modifier, location, bearing_before, bearing_after FROM course_points WHERE route_id = $1 ORDER BY step_order ASC
+`
+
+func (q *Queries) GetCoursePointsByRouteID(ctx context.Context, routeID uuid.UUID) ([]CoursePoint, error) {
+	rows, err := q.db.Query(ctx, getCoursePointsByRouteID, routeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoursePoint
+	for rows.Next() {
+		var i CoursePoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.RouteID,
+			&i.StepOrder,
+			&i.SegDistM,
+			&i.CumDistM,
+			&i.Duration,
+			&i.Instruction,
+			&i.RoadName,
+			&i.ManeuverType,
+			&i.Modifier,
+			&i.Location,
+			&i.BearingBefore,
+			&i.BearingAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventByID = `-- name: GetEventByID :one
+SELECT events.id, events.user_id, events.route_id, events.title, events.description, events.starts_at, events.meeting_point, events.meeting_point_name, events.capacity, events.visibility, events.cancelled_at, events.cancel_reason, events.created_at, events.updated_at, events.club_id,
+    (SELECT count(*) FROM event_rsvps WHERE event_rsvps.event_id = events.id AND event_rsvps.status = 'going')::INTEGER AS going_count
+FROM events
+WHERE events.id = $1
+`
+
+type GetEventByIDRow struct {
+	ID               uuid.UUID   `json:"id"`
+	UserID           uuid.UUID   `json:"user_id"`
+	RouteID          uuid.UUID   `json:"route_id"`
+	Title            string      `json:"title"`
+	Description      string      `json:"description"`
+	StartsAt         time.Time   `json:"starts_at"`
+	MeetingPoint     OrbGeometry `json:"meeting_point"`
+	MeetingPointName string      `json:"meeting_point_name"`
+	Capacity         *int32      `json:"capacity"`
+	Visibility       int16       `json:"visibility"`
+	CancelledAt      *time.Time  `json:"cancelled_at"`
+	CancelReason     string      `json:"cancel_reason"`
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+	ClubID           pgtype.UUID `json:"club_id"`
+	GoingCount       int32       `json:"going_count"`
+}
+
+func (q *Queries) GetEventByID(ctx context.Context, id uuid.UUID) (GetEventByIDRow, error) {
+	row := q.db.QueryRow(ctx, getEventByID, id)
+	var i GetEventByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RouteID,
+		&i.Title,
+		&i.Description,
+		&i.StartsAt,
+		&i.MeetingPoint,
+		&i.MeetingPointName,
+		&i.Capacity,
+		&i.Visibility,
+		&i.CancelledAt,
+		&i.CancelReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClubID,
+		&i.GoingCount,
+	)
+	return i, err
+}
+
+const getEventComment = `-- name: GetEventComment :one
+SELECT event_comments.id, event_comments.event_id, event_comments.user_id, event_comments.body, event_comments.created_at, users.name AS user_name
+FROM event_comments
+INNER JOIN users ON event_comments.user_id = users.id
+WHERE event_comments.id = $1
+`
+
+type GetEventCommentRow struct {
+	ID        uuid.UUID `json:"id"`
+	EventID   uuid.UUID `json:"event_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UserName  string    `json:"user_name"`
+}
+
+func (q *Queries) GetEventComment(ctx context.Context, id uuid.UUID) (GetEventCommentRow, error) {
+	row := q.db.QueryRow(ctx, getEventComment, id)
+	var i GetEventCommentRow
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.UserID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UserName,
+	)
+	return i, err
+}
+
+const getEventRSVP = `-- name: GetEventRSVP :one
+SELECT event_rsvps.event_id, event_rsvps.user_id, event_rsvps.status, event_rsvps.created_at, event_rsvps.updated_at, users.name AS user_name
+FROM event_rsvps
+INNER JOIN users ON event_rsvps.user_id = users.id
+WHERE event_rsvps.event_id = $1 AND event_rsvps.user_id = $2
+`
+
+type GetEventRSVPParams struct {
+	EventID uuid.UUID `json:"event_id"`
+	UserID  uuid.UUID `json:"user_id"`
+}
+
+type GetEventRSVPRow struct {
+	EventID   uuid.UUID `json:"event_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UserName  string    `json:"user_name"`
+}
+
+func (q *Queries) GetEventRSVP(ctx context.Context, arg GetEventRSVPParams) (GetEventRSVPRow, error) {
+	row := q.db.QueryRow(ctx, getEventRSVP, arg.EventID, arg.UserID)
+	var i GetEventRSVPRow
+	err := row.Scan(
+		&i.EventID,
+		&i.UserID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.UserName,
+	)
+	return i, err
+}
+
+const getMutedNotificationTypes = `-- name: GetMutedNotificationTypes :one
+SELECT muted_notification_types FROM users WHERE id = $1
+`
+
+func (q *Queries) GetMutedNotificationTypes(ctx context.Context, id uuid.UUID) ([]string, error) {
+	row := q.db.QueryRow(ctx, getMutedNotificationTypes, id)
+	var mutedNotificationTypes []string
+	err := row.Scan(&mutedNotificationTypes)
+	return mutedNotificationTypes, err
+}
+
+const getPOIByID = `-- name: GetPOIByID :one
+SELECT id, osm_type, osm_id, category, name, location FROM pois WHERE id = $1
+`
+
+func (q *Queries) GetPOIByID(ctx context.Context, id int64) (Poi, error) {
+	row := q.db.QueryRow(ctx, getPOIByID, id)
+	var i Poi
+	err := row.Scan(
+		&i.ID,
+		&i.OsmType,
+		&i.OsmID,
+		&i.Category,
+		&i.Name,
+		&i.Location,
+	)
+	return i, err
+}
+
+const getRouteByID = `-- name: GetRouteByID :one
+SELECT id, user_id, name, description, highlighted_photo_id, distance, duration, elevation_gain, elevation_loss, path_geom, bbox, first_point, last_point, polyline, created_at, updated_at, visibility, version, forked_from_route_id, difficulty, club_id FROM routes WHERE id = $1
+`
+
+func (q *Queries) GetRouteByID(ctx context.Context, id uuid.UUID) (Route, error) {
+	row := q.db.QueryRow(ctx, getRouteByID, id)
+	var i Route
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Description,
+		&i.HighlightedPhotoID,
+		&i.Distance,
+		&i.Duration,
+		&i.ElevationGain,
+		&i.ElevationLoss,
+		&i.PathGeom,
+		&i.Bbox,
+		&i.FirstPoint,
+		&i.LastPoint,
+		&i.Polyline,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Visibility,
+		&i.Version,
+		&i.ForkedFromRouteID,
+		&i.Difficulty,
+		&i.ClubID,
+	)
+	return i, err
+}
+
+const getRouteComment = `-- name: GetRouteComment :one
+SELECT route_comments.id, route_comments.user_id, route_comments.route_id, route_comments.parent_id, route_comments.content, route_comments.created_at, route_comments.updated_at, route_comments.deleted_at, users.name AS user_name
+FROM route_comments
+INNER JOIN users ON route_comments.user_id = users.id
+WHERE route_comments.id = $1 AND route_comments.deleted_at IS NULL
+`
+
+type GetRouteCommentRow struct {
+	ID        uuid.UUID   `json:"id"`
+	UserID    uuid.UUID   `json:"user_id"`
+	RouteID   uuid.UUID   `json:"route_id"`
+	ParentID  pgtype.UUID `json:"parent_id"`
+	Content   string      `json:"content"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	DeletedAt *time.Time  `json:"deleted_at"`
+	UserName  string      `json:"user_name"`
+}
+
+func (q *Queries) GetRouteComment(ctx context.Context, id uuid.UUID) (GetRouteCommentRow, error) {
+	row := q.db.QueryRow(ctx, getRouteComment, id)
+	var i GetRouteCommentRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.RouteID,
+		&i.ParentID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.UserName,
+	)
+	return i, err
+}
+
+const getRoutePlacesByRouteID = `-- name: GetRoutePlacesByRouteID :many
+SELECT route_id, kind, locality, administrative_area, country_code FROM route_places WHERE route_id = $1
+`
+
+func (q *Queries) GetRoutePlacesByRouteID(ctx context.Context, routeID uuid.UUID) ([]RoutePlace, error) {
+	rows, err := q.db.Query(ctx, getRoutePlacesByRouteID, routeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoutePlace
+	for rows.Next() {
+		var i RoutePlace
+		if err := rows.Scan(
+			&i.RouteID,
+			&i.Kind,
+			&i.Locality,
+			&i.AdministrativeArea,
+			&i.CountryCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoutesByUserID = `-- name: GetRoutesByUserID :many
+SELECT id, user_id, name, description, highlighted_photo_id, distance, duration, elevation_gain, elevation_loss, path_geom, bbox, first_point, last_point, polyline, created_at, updated_at, visibility, version, forked_from_route_id, difficulty, club_id FROM routes WHERE user_id = $1
+`
+
+func (q *Queries) GetRoutesByUserID(ctx context.Context, userID uuid.UUID) ([]Route, error) {
+	rows, err := q.db.Query(ctx, getRoutesByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Route
+	for rows.Next() {
+		var i Route
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.HighlightedPhotoID,
+			&i.Distance,
+			&i.Duration,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+			&i.PathGeom,
+			&i.Bbox,
+			&i.FirstPoint,
+			&i.LastPoint,
+			&i.Polyline,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Visibility,
+			&i.Version,
+			&i.ForkedFromRouteID,
+			&i.Difficulty,
+			&i.ClubID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoutesInCollection = `-- name: GetRoutesInCollection :many
+SELECT routes.id, routes.user_id, routes.name, routes.description, routes.highlighted_photo_id, routes.distance, routes.duration, routes.elevation_gain, routes.elevation_loss, routes.path_geom, routes.bbox, routes.first_point, routes.last_point, routes.polyline, routes.created_at, routes.updated_at, routes.visibility, routes.version, routes.forked_from_route_id, routes.difficulty, routes.club_id, users.name AS user_name
+FROM collection_routes
+INNER JOIN routes ON collection_routes.route_id = routes.id
+INNER JOIN users ON routes.user_id = users.id
+WHERE collection_routes.collection_id = $1
+ORDER BY collection_routes.position
+`
+
+type GetRoutesInCollectionRow struct {
+	ID                 uuid.UUID   `json:"id"`
+	UserID             uuid.UUID   `json:"user_id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	HighlightedPhotoID *int64      `json:"highlighted_photo_id"`
+	Distance           float64     `json:"distance"`
+	Duration           float64     `json:"duration"`
+	ElevationGain      float64     `json:"elevation_gain"`
+	ElevationLoss      float64     `json:"elevation_loss"`
+	PathGeom           OrbGeometry `json:"path_geom"`
+	Bbox               OrbGeometry `json:"bbox"`
+	FirstPoint         OrbGeometry `json:"first_point"`
+	LastPoint          OrbGeometry `json:"last_point"`
+	Polyline           string      `json:"polyline"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+	Visibility         int16       `json:"visibility"`
+	Version            int32       `json:"version"`
+	ForkedFromRouteID  pgtype.UUID `json:"forked_from_route_id"`
+	Difficulty         *int16      `json:"difficulty"`
+	ClubID             pgtype.UUID `json:"club_id"`
+	UserName           string      `json:"user_name"`
+}
+
+func (q *Queries) GetRoutesInCollection(ctx context.Context, collectionID uuid.UUID) ([]GetRoutesInCollectionRow, error) {
+	rows, err := q.db.Query(ctx, getRoutesInCollection, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRoutesInCollectionRow
+	for rows.Next() {
+		var i GetRoutesInCollectionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.HighlightedPhotoID,
+			&i.Distance,
+			&i.Duration,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+			&i.PathGeom,
+			&i.Bbox,
+			&i.FirstPoint,
+			&i.LastPoint,
+			&i.Polyline,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Visibility,
+			&i.Version,
+			&i.ForkedFromRouteID,
+			&i.Difficulty,
+			&i.ClubID,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRouteSurfacesByRouteID = `-- name: GetRouteSurfacesByRouteID :many
+SELECT route_id, kind, category, distance FROM route_surfaces WHERE route_id = $1 ORDER BY kind ASC, distance DESC, category ASC
+`
+
+func (q *Queries) GetRouteSurfacesByRouteID(ctx context.Context, routeID uuid.UUID) ([]RouteSurface, error) {
+	rows, err := q.db.Query(ctx, getRouteSurfacesByRouteID, routeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RouteSurface
+	for rows.Next() {
+		var i RouteSurface
+		if err := rows.Scan(
+			&i.RouteID,
+			&i.Kind,
+			&i.Category,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRouteVersion = `-- name: GetRouteVersion :one
+SELECT id, route_id, version_number, user_id, name, description, highlighted_photo_id, distance, duration, elevation_gain, elevation_loss, path_geom, first_point, last_point, visibility, course_points, waypoints, created_at FROM route_versions WHERE route_id = $1 AND version_number = $2
+`
+
+type GetRouteVersionParams struct {
+	RouteID       uuid.UUID `json:"route_id"`
+	VersionNumber int32     `json:"version_number"`
+}
+
+func (q *Queries) GetRouteVersion(ctx context.Context, arg GetRouteVersionParams) (RouteVersion, error) {
+	row := q.db.QueryRow(ctx, getRouteVersion, arg.RouteID, arg.VersionNumber)
+	var i RouteVersion
+	err := row.Scan(
+		&i.ID,
+		&i.RouteID,
+		&i.VersionNumber,
+		&i.UserID,
+		&i.Name,
+		&i.Description,
+		&i.HighlightedPhotoID,
+		&i.Distance,
+		&i.Duration,
+		&i.ElevationGain,
+		&i.ElevationLoss,
+		&i.PathGeom,
+		&i.FirstPoint,
+		&i.LastPoint,
+		&i.Visibility,
+		&i.CoursePoints,
+		&i.Waypoints,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getRouteVersionsByRouteID = `-- name: GetRouteVersionsByRouteID :many
+SELECT id, route_id, version_number, user_id, name, description, highlighted_photo_id, distance, duration, elevation_gain, elevation_loss, path_geom, first_point, last_point, visibility, course_points, waypoints, created_at FROM route_versions WHERE route_id = $1 ORDER BY version_number DESC
+`
+
+func (q *Queries) GetRouteVersionsByRouteID(ctx context.Context, routeID uuid.UUID) ([]RouteVersion, error) {
+	rows, err := q.db.Query(ctx, getRouteVersionsByRouteID, routeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RouteVersion
+	for rows.Next() {
+		var i RouteVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.RouteID,
+			&i.VersionNumber,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.HighlightedPhotoID,
+			&i.Distance,
+			&i.Duration,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+			&i.PathGeom,
+			&i.FirstPoint,
+			&i.LastPoint,
+			&i.Visibility,
+			&i.CoursePoints,
+			&i.Waypoints,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTourByID = `-- name: GetTourByID :one
+SELECT id, user_id, name, description, visibility, created_at, updated_at, club_id FROM tours WHERE id = $1
+`
+
+func (q *Queries) GetTourByID(ctx context.Context, id uuid.UUID) (Tour, error) {
+	row := q.db.QueryRow(ctx, getTourByID, id)
+	var i Tour
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Description,
+		&i.Visibility,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ClubID,
+	)
+	return i, err
+}
+
+const getTripByID = `-- name: GetTripByID :one
+SELECT id, user_id, name, description, visibility, highlighted_photo_id, path_geom, first_point, last_point, bbox_geom, distance, duration, moving_time, elevation_gain, elevation_loss, avg_speed, max_speed, avg_cad, max_cad, min_cad, max_hr, min_hr, avg_watts, max_watts, min_watts, avg_watts_estimated, avg_power_estimated, calories, is_gps, is_stationary, processed, created_at, updated_at, deleted_at, departed_at, time_zone, utc_offset, activity_type_id, pace, moving_pace FROM trips WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetTripByID(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTripByID, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Description,
+		&i.Visibility,
+		&i.HighlightedPhotoID,
+		&i.PathGeom,
+		&i.FirstPoint,
+		&i.LastPoint,
+		&i.BboxGeom,
+		&i.Distance,
+		&i.Duration,
+		&i.MovingTime,
+		&i.ElevationGain,
+		&i.ElevationLoss,
+		&i.AvgSpeed,
+		&i.MaxSpeed,
+		&i.AvgCad,
+		&i.MaxCad,
+		&i.MinCad,
+		&i.MaxHr,
+		&i.MinHr,
+		&i.AvgWatts,
+		&i.MaxWatts,
+		&i.MinWatts,
+		&i.AvgWattsEstimated,
+		&i.AvgPowerEstimated,
+		&i.Calories,
+		&i.IsGps,
+		&i.IsStationary,
+		&i.Processed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.DepartedAt,
+		&i.TimeZone,
+		&i.UtcOffset,
+		&i.ActivityTypeID,
+		&i.Pace,
+		&i.MovingPace,
+	)
+	return i, err
+}
+
+const getTripImagesByTripID = `-- name: GetTripImagesByTripID :many
+SELECT id, trip_id, s3_key, width, height, size, type, visibility, created_at, updated_at FROM trip_images WHERE trip_id = $1 ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) GetTripImagesByTripID(ctx context.Context, tripID uuid.UUID) ([]TripImage, error) {
+	rows, err := q.db.Query(ctx, getTripImagesByTripID, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TripImage
+	for rows.Next() {
+		var i TripImage
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.S3Key,
+			&i.Width,
+			&i.Height,
+			&i.Size,
+			&i.Type,
+			&i.Visibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripsByKratosID = `-- name: GetTripsByKratosID :many
+SELECT trips.id, trips.user_id, trips.name, trips.description, trips.visibility, trips.highlighted_photo_id, trips.path_geom, trips.first_point, trips.last_point, trips.bbox_geom, trips.distance, trips.duration, trips.moving_time, trips.elevation_gain, trips.elevation_loss, trips.avg_speed, trips.max_speed, trips.avg_cad, trips.max_cad, trips.min_cad, trips.max_hr, trips.min_hr, trips.avg_watts, trips.max_watts, trips.min_watts, trips.avg_watts_estimated, trips.avg_power_estimated, trips.calories, trips.is_gps, trips.is_stationary, trips.processed, trips.created_at, trips.updated_at, trips.deleted_at, trips.departed_at, trips.time_zone, trips.utc_offset, trips.activity_type_id, trips.pace, trips.moving_pace FROM trips
+INNER JOIN users ON trips.user_id = users.id
+WHERE users.kratos_id = $1 AND trips.deleted_at IS NULL
+ORDER BY trips.created_at DESC, trips.id DESC
+`
+
+func (q *Queries) GetTripsByKratosID(ctx context.Context, kratosID uuid.UUID) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getTripsByKratosID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.Visibility,
+			&i.HighlightedPhotoID,
+			&i.PathGeom,
+			&i.FirstPoint,
+			&i.LastPoint,
+			&i.BboxGeom,
+			&i.Distance,
+			&i.Duration,
+			&i.MovingTime,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+			&i.AvgSpeed,
+			&i.MaxSpeed,
+			&i.AvgCad,
+			&i.MaxCad,
+			&i.MinCad,
+			&i.MaxHr,
+			&i.MinHr,
+			&i.AvgWatts,
+			&i.MaxWatts,
+			&i.MinWatts,
+			&i.AvgWattsEstimated,
+			&i.AvgPowerEstimated,
+			&i.Calories,
+			&i.IsGps,
+			&i.IsStationary,
+			&i.Processed,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DepartedAt,
+			&i.TimeZone,
+			&i.UtcOffset,
+			&i.ActivityTypeID,
+			&i.Pace,
+			&i.MovingPace,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, kratos_id, name, highlighted_photo_id, locale, created_at, updated_at, description, locality, administrative_area, country_code, postal_code, geom, first_name, last_name, email, has_set_location, muted_notification_types FROM users WHERE id = $1
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.KratosID,
+		&i.Name,
+		&i.HighlightedPhotoID,
+		&i.Locale,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Description,
+		&i.Locality,
+		&i.AdministrativeArea,
+		&i.CountryCode,
+		&i.PostalCode,
+		&i.Geom,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.HasSetLocation,
+		&i.MutedNotificationTypes,
+	)
+	return i, err
+}
+
+const getUserByKratosID = `-- name: GetUserByKratosID :one
+SELECT id, kratos_id, name, highlighted_photo_id, locale, created_at, updated_at, description, locality, administrative_area, country_code, postal_code, geom, first_name, last_name, email, has_set_location, muted_notification_types FROM users WHERE kratos_id = $1
+`
+
+func (q *Queries) GetUserByKratosID(ctx context.Context, kratosID uuid.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByKratosID, kratosID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.KratosID,
+		&i.Name,
+		&i.HighlightedPhotoID,
+		&i.Locale,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Description,
+		&i.Locality,
+		&i.AdministrativeArea,
+		&i.CountryCode,
+		&i.PostalCode,
+		&i.Geom,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.HasSetLocation,
+		&i.MutedNotificationTypes,
+	)
+	return i, err
+}
+
+const getWaypointsByRouteID = `-- name: GetWaypointsByRouteID :many
+SELECT id, route_id, location, created_at FROM waypoints WHERE route_id = $1 ORDER BY id ASC
+`
+
+func (q *Queries) GetWaypointsByRouteID(ctx context.Context, routeID uuid.UUID) ([]Waypoint, error) {
+	rows, err := q.db.Query(ctx, getWaypointsByRouteID, routeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Waypoint
+	for rows.Next() {
+		var i Waypoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.RouteID,
+			&i.Location,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertPOIs = `-- name: InsertPOIs :exec
+INSERT INTO pois (osm_type, osm_id, category, name, location)
+SELECT osm_type, osm_id, category, NULLIF(name, ''), ST_GeomFromText(location, 4326)
+FROM unnest(
+    $1::TEXT[],
+    $2::BIGINT[],
+    $3::TEXT[],
+    $4::TEXT[],
+    $5::TEXT[]
+) AS t(osm_type, osm_id, category, name, location)
+`
+
+type InsertPOIsParams struct {
+	OsmTypes   []string `json:"osm_types"`
+	OsmIds     []int64  `json:"osm_ids"`
+	Categories []string `json:"categories"`
+	Names      []string `json:"names"`
+	Locations  []string `json:"locations"`
+}
+
+func (q *Queries) InsertPOIs(ctx context.Context, arg InsertPOIsParams) error {
+	_, err := q.db.Exec(ctx, insertPOIs,
+		arg.OsmTypes,
+		arg.OsmIds,
+		arg.Categories,
+		arg.Names,
+		arg.Locations,
+	)
+	return err
+}
+
+const insertRoadEdges = `-- name: InsertRoadEdges :exec
+INSERT INTO road_edges (
+    osm_way_id,
+    source_node_id,
+    target_node_id,
+    name,
+    highway,
+    surface,
+    bike_lane,
+    oneway,
+    length_m,
+    elevation_gain,
+    elevation_loss,
+    geom
+)
+SELECT
+    osm_way_id,
+    source_node_id,
+    target_node_id,
+    NULLIF(name, ''),
+    highway,
+    NULLIF(surface, ''),
+    bike_lane,
+    oneway,
+    length_m,
+    elevation_gain,
+    elevation_loss,
+    ST_GeomFromText(geom, 4326)
+FROM unnest(
+    $1::BIGINT[],
+    $2::BIGINT[],
+    $3::BIGINT[],
+    $4::TEXT[],
+    $5::TEXT[],
+    $6::TEXT[],
+    $7::BOOLEAN[],
+    $8::BOOLEAN[],
+    $9::DOUBLE PRECISION[],
+    $10::DOUBLE PRECISION[],
+    $11::DOUBLE PRECISION[],
+    $12::TEXT[]
+) AS t(osm_way_id, source_node_id, target_node_id, name, highway, surface, bike_lane, oneway, length_m, elevation_gain, elevation_loss, geom)
+`
+
+type InsertRoadEdgesParams struct {
+	OsmWayIds       []int64   `json:"osm_way_ids"`
+	SourceNodeIds   []int64   `json:"source_node_ids"`
+	TargetNodeIds   []int64   `json:"target_node_ids"`
+	Names           []string  `json:"names"`
+	Highways        []string  `json:"highways"`
+	Surfaces        []string  `json:"surfaces"`
+	BikeLanes       []bool    `json:"bike_lanes"`
+	Oneways         []bool    `json:"oneways"`
+	LengthsM        []float64 `json:"lengths_m"`
+	ElevationGains  []float64 `json:"elevation_gains"`
+	ElevationLosses []float64 `json:"elevation_losses"`
+	Geoms           []string  `json:"geoms"`
+}
+
+func (q *Queries) InsertRoadEdges(ctx context.Context, arg InsertRoadEdgesParams) error {
+	_, err := q.db.Exec(ctx, insertRoadEdges,
+		arg.OsmWayIds,
+		arg.SourceNodeIds,
+		arg.TargetNodeIds,
+		arg.Names,
+		arg.Highways,
+		arg.Surfaces,
+		arg.BikeLanes,
+		arg.Oneways,
+		arg.LengthsM,
+		arg.ElevationGains,
+		arg.ElevationLosses,
+		arg.Geoms,
+	)
+	return err
+}
+
+const listAdminAreasContaining = `-- name: ListAdminAreasContaining :many
+SELECT name, admin_level, country_code
+FROM admin_boundaries
+WHERE ST_Covers(geom, ST_GeomFromText($1::TEXT, 4326))
+ORDER BY admin_level, id
+`
+
+type ListAdminAreasContainingRow struct {
+	Name        string `json:"name"`
+	AdminLevel  int16  `json:"admin_level"`
+	CountryCode string `json:"country_code"`
+}
+
+func (q *Queries) ListAdminAreasContaining(ctx context.Context, location string) ([]ListAdminAreasContainingRow, error) {
+	rows, err := q.db.Query(ctx, listAdminAreasContaining, location)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAdminAreasContainingRow
+	for rows.Next() {
+		var i ListAdminAreasContainingRow
+		if err := rows.Scan(&i.Name, &i.AdminLevel, &i.CountryCode); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listClubJoinRequests = `-- name: ListClubJoinRequests :many
+SELECT club_join_requests.club_id, club_join_requests.user_id, club_join_requests.message, club_join_requests.created_at, users.name AS user_name
+FROM club_join_requests
+INNER JOIN users ON club_join_requests.user_id = users.id
+WHERE club_join_requests.club_id = $1
+ORDER BY club_join_requests.created_at, club_join_requests.user_id
+`
+
+type ListClubJoinRequestsRow struct {
+	ClubID    uuid.UUID `json:"club_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+	UserName  string    `json:"user_name"`
+}
+
+func (q *Queries) ListClubJoinRequests(ctx context.Context, clubID uuid.UUID) ([]ListClubJoinRequestsRow, error) {
+	rows, err := q.db.Query(ctx, listClubJoinRequests, clubID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListClubJoinRequestsRow
+	for rows.Next() {
+		var i ListClubJoinRequestsRow
+		if err := rows.Scan(
+			&i.ClubID,
+			&i.UserID,
+			&i.Message,
+			&i.CreatedAt,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listClubMembers = `-- name: ListClubMembers :many
+SELECT club_members.club_id, club_members.user_id, club_members.role, club_members.created_at, users.name AS user_name
+FROM club_members
+INNER JOIN users ON club_members.user_id = users.id
+WHERE club_members.club_id = $1
+ORDER BY club_members.created_at, club_members.user_id
+`
+
+type ListClubMembersRow struct {
+	ClubID    uuid.UUID `json:"club_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+	UserName  string    `json:"user_name"`
+}
+
+func (q *Queries) ListClubMembers(ctx context.Context, clubID uuid.UUID) ([]ListClubMembersRow, error) {
+	rows, err := q.db.Query(ctx, listClubMembers, clubID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListClubMembersRow
+	for rows.Next() {
+		var i ListClubMembersRow
+		if err := rows.Scan(
+			&i.ClubID,
+			&i.UserID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listClubRouteIDs = `-- name: ListClubRouteIDs :many
+SELECT route_id FROM club_routes
+WHERE club_id = $1
+ORDER BY created_at DESC, route_id
+`
+
+func (q *Queries) ListClubRouteIDs(ctx context.Context, clubID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listClubRouteIDs, clubID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var routeID uuid.UUID
+		if err := rows.Scan(&routeID); err != nil {
+			return nil, err
+		}
+		items = append(items, routeID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCollectionRoutesByCollectionIDs = `-- name: ListCollectionRoutesByCollectionIDs :many
+SELECT collection_id, route_id, position FROM collection_routes
+WHERE collection_id = ANY($1::UUID[])
+ORDER BY collection_id, position
+`
+
+func (q *Queries) ListCollectionRoutesByCollectionIDs(ctx context.Context, collectionIds []uuid.UUID) ([]CollectionRoute, error) {
+	rows, err := q.db.Query(ctx, listCollectionRoutesByCollectionIDs, collectionIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CollectionRoute
+	for rows.Next() {
+		var i CollectionRoute
+		if err := rows.Scan(&i.CollectionID, &i.RouteID, &i.Position); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCollectionsByUserID = `-- name: ListCollectionsByUserID :many
+SELECT id, user_id, name, description, visibility, created_at, updated_at FROM collections WHERE user_id = $1 ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListCollectionsByUserID(ctx context.Context, userID uuid.UUID) ([]Collection, error) {
+	rows, err := q.db.Query(ctx, listCollectionsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Collection
+	for rows.Next() {
+		var i Collection
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.Visibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventComments = `-- name: ListEventComments :many
+-- 古い順。カーソルより後のコメントを取得する
+SELECT event_comments.id, event_comments.event_id, event_comments.user_id, event_comments.body, event_comments.created_at, users.name AS user_name, EXTRACT(EPOCH FROM event_comments.created_at)::DOUBLE PRECISION AS sort_key
+FROM event_comments
+INNER JOIN users ON event_comments.user_id = users.id
+WHERE event_comments.event_id = $1
+  AND (NOT $2::BOOLEAN
+       OR (EXTRACT(EPOCH FROM event_comments.created_at)::DOUBLE PRECISION, event_comments.id) > ($3::DOUBLE PRECISION, $4::UUID))
+ORDER BY EXTRACT(EPOCH FROM event_comments.created_at)::DOUBLE PRECISION, event_comments.id
+LIMIT $5::INT
+`
+
+type ListEventCommentsParams struct {
+	EventID       uuid.UUID `json:"event_id"`
+	HasCursor     bool      `json:"has_cursor"`
+	CursorSortKey float64   `json:"cursor_sort_key"`
+	CursorID      uuid.UUID `json:"cursor_id"`
+	LimitCount    int32     `json:"limit_count"`
+}
+
+type ListEventCommentsRow struct {
+	ID        uuid.UUID `json:"id"`
+	EventID   uuid.UUID `json:"event_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UserName  string    `json:"user_name"`
+	SortKey   float64   `json:"sort_key"`
+}
+
+func (q *Queries) ListEventComments(ctx context.Context, arg ListEventCommentsParams) ([]ListEventCommentsRow, error) {
+	rows, err := q.db.Query(ctx, listEventComments,
+		arg.EventID,
+		arg.HasCursor,
+		arg.CursorSortKey,
+		arg.CursorID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventCommentsRow
+	for rows.Next() {
+		var i ListEventCommentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.UserID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UserName,
+			&i.SortKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventRSVPs = `-- name: ListEventRSVPs :many
+SELECT event_rsvps.event_id, event_rsvps.user_id, event_rsvps.status, event_rsvps.created_at, event_rsvps.updated_at, users.name AS user_name
+FROM event_rsvps
+INNER JOIN users ON event_rsvps.user_id = users.id
+WHERE event_rsvps.event_id = $1
+ORDER BY event_rsvps.created_at, event_rsvps.user_id
+`
+
+type ListEventRSVPsRow struct {
+	EventID   uuid.UUID `json:"event_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UserName  string    `json:"user_name"`
+}
+
+func (q *Queries) ListEventRSVPs(ctx context.Context, eventID uuid.UUID) ([]ListEventRSVPsRow, error) {
+	rows, err := q.db.Query(ctx, listEventRSVPs, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventRSVPsRow
+	for rows.Next() {
+		var i ListEventRSVPsRow
+		if err := rows.Scan(
+			&i.EventID,
+			&i.UserID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFeedEvents = `-- name: ListFeedEvents :many
+SELECT feed_events.id, feed_events.actor_id, feed_events.event_type, feed_events.subject_id, feed_events.created_at, users.name AS actor_name, EXTRACT(EPOCH FROM feed_events.created_at)::DOUBLE PRECISION AS sort_key
+FROM feed_events
+INNER JOIN user_follows ON user_follows.followee_id = feed_events.actor_id
+INNER JOIN users ON feed_events.actor_id = users.id
+WHERE user_follows.follower_id = $1
+  AND (NOT $2::BOOLEAN
+       OR (EXTRACT(EPOCH FROM feed_events.created_at)::DOUBLE PRECISION, feed_events.id) < ($3::DOUBLE PRECISION, $4::UUID))
+ORDER BY EXTRACT(EPOCH FROM feed_events.created_at)::DOUBLE PRECISION DESC, feed_events.id DESC
+LIMIT $5::INT
+`
+
+type ListFeedEventsParams struct {
+	FollowerID    uuid.UUID `json:"follower_id"`
+	HasCursor     bool      `json:"has_cursor"`
+	CursorSortKey float64   `json:"cursor_sort_key"`
+	CursorID      uuid.UUID `json:"cursor_id"`
+	LimitCount    int32     `json:"limit_count"`
+}
+
+type ListFeedEventsRow struct {
+	ID        uuid.UUID `json:"id"`
+	ActorID   uuid.UUID `json:"actor_id"`
+	EventType string    `json:"event_type"`
+	SubjectID uuid.UUID `json:"subject_id"`
+	CreatedAt time.Time `json:"created_at"`
+	ActorName string    `json:"actor_name"`
+	SortKey   float64   `json:"sort_key"`
+}
+
+func (q *Queries) ListFeedEvents(ctx context.Context, arg ListFeedEventsParams) ([]ListFeedEventsRow, error) {
+	rows, err := q.db.Query(ctx, listFeedEvents,
+		arg.FollowerID,
+		arg.HasCursor,
+		arg.CursorSortKey,
+		arg.CursorID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFeedEventsRow
+	for rows.Next() {
+		var i ListFeedEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorID,
+			&i.EventType,
+			&i.SubjectID,
+			&i.CreatedAt,
+			&i.ActorName,
+			&i.SortKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT notifications.id, notifications.user_id, notifications.actor_id, notifications.type, notifications.route_id, notifications.event_id, notifications.read_at, notifications.created_at, users.name AS actor_name, routes.name AS route_name, events.title AS event_title, EXTRACT(EPOCH FROM notifications.created_at)::DOUBLE PRECISION AS sort_key
+FROM notifications
+INNER JOIN users ON notifications.actor_id = users.id
+LEFT JOIN routes ON notifications.route_id = routes.id
+LEFT JOIN events ON notifications.event_id = events.id
+WHERE notifications.user_id = $1
+  AND (NOT $2::BOOLEAN OR notifications.read_at IS NULL)
+  AND (NOT $3::BOOLEAN
+       OR (EXTRACT(EPOCH FROM notifications.created_at)::DOUBLE PRECISION, notifications.id) < ($4::DOUBLE PRECISION, $5::UUID))
+ORDER BY EXTRACT(EPOCH FROM notifications.created_at)::DOUBLE PRECISION DESC, notifications.id DESC
+LIMIT $6::INT
+`
+
+type ListNotificationsParams struct {
+	UserID        uuid.UUID `json:"user_id"`
+	UnreadOnly    bool      `json:"unread_only"`
+	HasCursor     bool      `json:"has_cursor"`
+	CursorSortKey float64   `json:"cursor_sort_key"`
+	CursorID      uuid.UUID `json:"cursor_id"`
+	LimitCount    int32     `json:"limit_count"`
+}
+
+type ListNotificationsRow struct {
+	ID         uuid.UUID   `json:"id"`
+	UserID     uuid.UUID   `json:"user_id"`
+	ActorID    uuid.UUID   `json:"actor_id"`
+	Type       string      `json:"type"`
+	RouteID    pgtype.UUID `json:"route_id"`
+	EventID    pgtype.UUID `json:"event_id"`
+	ReadAt     *time.Time  `json:"read_at"`
+	CreatedAt  time.Time   `json:"created_at"`
+	ActorName  string      `json:"actor_name"`
+	RouteName  *string     `json:"route_name"`
+	EventTitle *string     `json:"event_title"`
+	SortKey    float64     `json:"sort_key"`
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]ListNotificationsRow, error) {
+	rows, err := q.db.Query(ctx, listNotifications,
+		arg.UserID,
+		arg.UnreadOnly,
+		arg.HasCursor,
+		arg.CursorSortKey,
+		arg.CursorID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNotificationsRow
+	for rows.Next() {
+		var i ListNotificationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ActorID,
+			&i.Type,
+			&i.RouteID,
+			&i.EventID,
+			&i.ReadAt,
+			&i.CreatedAt,
+			&i.ActorName,
+			&i.RouteName,
+			&i.EventTitle,
+			&i.SortKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPOIsAlongRoute = `-- name: ListPOIsAlongRoute :many
+-- 経路上の位置はPOIを経路に射影した地点の始点からの距離。周回ルートでは最も近い地点を使う
+SELECT pois.id, pois.osm_type, pois.osm_id, pois.category, pois.name, pois.location,
+    (ST_LineLocatePoint(routes.path_geom, pois.location) * ST_Length(routes.path_geom::geography))::DOUBLE PRECISION AS cum_dist_m,
+    ST_Distance(pois.location::geography, routes.path_geom::geography)::DOUBLE PRECISION AS offset_m
+FROM routes
+INNER JOIN pois ON ST_DWithin(pois.location::geography, routes.path_geom::geography, $1::DOUBLE PRECISION)
+WHERE routes.id = $2
+  AND (cardinality($3::TEXT[]) = 0 OR pois.category = ANY($3::TEXT[]))
+  AND (NOT $4::BOOLEAN
+       OR ((ST_LineLocatePoint(routes.path_geom, pois.location) * ST_Length(routes.path_geom::geography))::DOUBLE PRECISION, pois.id) > ($5::DOUBLE PRECISION, $6::BIGINT))
+ORDER BY cum_dist_m, pois.id
+LIMIT $7::INT
+`
+
+type ListPOIsAlongRouteParams struct {
+	BufferM        float64   `json:"buffer_m"`
+	RouteID        uuid.UUID `json:"route_id"`
+	Categories     []string  `json:"categories"`
+	HasCursor      bool      `json:"has_cursor"`
+	CursorCumDistM float64   `json:"cursor_cum_dist_m"`
+	CursorID       int64     `json:"cursor_id"`
+	LimitCount     int32     `json:"limit_count"`
+}
+
+type ListPOIsAlongRouteRow struct {
+	ID       int64       `json:"id"`
+	OsmType  string      `json:"osm_type"`
+	OsmID    int64       `json:"osm_id"`
+	Category string      `json:"category"`
+	Name     *string     `json:"name"`
+	Location OrbGeometry `json:"location"`
+	CumDistM float64     `json:"cum_dist_m"`
+	OffsetM  float64     `json:"offset_m"`
+}
+
+func (q *Queries) ListPOIsAlongRoute(ctx context.Context, arg ListPOIsAlongRouteParams) ([]ListPOIsAlongRouteRow, error) {
+	rows, err := q.db.Query(ctx, listPOIsAlongRoute,
+		arg.BufferM,
+		arg.RouteID,
+		arg.Categories,
+		arg.HasCursor,
+		arg.CursorCumDistM,
+		arg.CursorID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPOIsAlongRouteRow
+	for rows.Next() {
+		var i ListPOIsAlongRouteRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OsmType,
+			&i.OsmID,
+			&i.Category,
+			&i.Name,
+			&i.Location,
+			&i.CumDistM,
+			&i.OffsetM,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPrivacyZonesByUserID = `-- name: ListPrivacyZonesByUserID :many
+SELECT id, user_id, name, center, radius_m, created_at FROM privacy_zones WHERE user_id = $1 ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) ListPrivacyZonesByUserID(ctx context.Context, userID uuid.UUID) ([]PrivacyZone, error) {
+	rows, err := q.db.Query(ctx, listPrivacyZonesByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PrivacyZone
+	for rows.Next() {
+		var i PrivacyZone
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Center,
+			&i.RadiusM,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRideStatsByUserID = `-- name: ListRideStatsByUserID :many
+SELECT
+    distance::DOUBLE PRECISION AS distance,
+    moving_time::INTEGER AS moving_time,
+    COALESCE(elevation_gain, 0)::DOUBLE PRECISION AS elevation_gain,
+    COALESCE(elevation_loss, 0)::DOUBLE PRECISION AS elevation_loss
+FROM trips
+WHERE user_id = $1
+  AND deleted_at IS NULL
+  AND NOT is_stationary
+  AND distance IS NOT NULL
+  AND moving_time IS NOT NULL
+ORDER BY COALESCE(departed_at, created_at) DESC, id DESC
+LIMIT $2::INT
+`
+
+type ListRideStatsByUserIDParams struct {
+	UserID     uuid.UUID `json:"user_id"`
+	LimitCount int32     `json:"limit_count"`
+}
+
+type ListRideStatsByUserIDRow struct {
+	Distance      float64 `json:"distance"`
+	MovingTime    int32   `json:"moving_time"`
+	ElevationGain float64 `json:"elevation_gain"`
+	ElevationLoss float64 `json:"elevation_loss"`
+}
+
+func (q *Queries) ListRideStatsByUserID(ctx context.Context, arg ListRideStatsByUserIDParams) ([]ListRideStatsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, listRideStatsByUserID, arg.UserID, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRideStatsByUserIDRow
+	for rows.Next() {
+		var i ListRideStatsByUserIDRow
+		if err := rows.Scan(
+			&i.Distance,
+			&i.MovingTime,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoadEdgesInBBox = `-- name: ListRoadEdgesInBBox :many
+SELECT id, osm_way_id, source_node_id, target_node_id, name, highway, surface, bike_lane, oneway, length_m, elevation_gain, elevation_loss, geom FROM road_edges
+WHERE geom && ST_MakeEnvelope($1::DOUBLE PRECISION, $2::DOUBLE PRECISION, $3::DOUBLE PRECISION, $4::DOUBLE PRECISION, 4326)
+ORDER BY id
+LIMIT $5::INT
+`
+
+type ListRoadEdgesInBBoxParams struct {
+	MinLon     float64 `json:"min_lon"`
+	MinLat     float64 `json:"min_lat"`
+	MaxLon     float64 `json:"max_lon"`
+	MaxLat     float64 `json:"max_lat"`
+	LimitCount int32   `json:"limit_count"`
+}
+
+func (q *Queries) ListRoadEdgesInBBox(ctx context.Context, arg ListRoadEdgesInBBoxParams) ([]RoadEdge, error) {
+	rows, err := q.db.Query(ctx, listRoadEdgesInBBox,
+		arg.MinLon,
+		arg.MinLat,
+		arg.MaxLon,
+		arg.MaxLat,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoadEdge
+	for rows.Next() {
+		var i RoadEdge
+		if err := rows.Scan(
+			&i.ID,
+			&i.OsmWayID,
+			&i.SourceNodeID,
+			&i.TargetNodeID,
+			&i.Name,
+			&i.Highway,
+			&i.Surface,
+			&i.BikeLane,
+			&i.Oneway,
+			&i.LengthM,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+			&i.Geom,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRouteComments = `-- name: ListRouteComments :many
+-- 古い順。カーソルより後のコメントを取得する
+SELECT route_comments.id, route_comments.user_id, route_comments.route_id, route_comments.parent_id, route_comments.content, route_comments.created_at, route_comments.updated_at, route_comments.deleted_at, users.name AS user_name, EXTRACT(EPOCH FROM route_comments.created_at)::DOUBLE PRECISION AS sort_key
+FROM route_comments
+INNER JOIN users ON route_comments.user_id = users.id
+WHERE route_comments.route_id = $1 AND route_comments.deleted_at IS NULL
+  AND (NOT $2::BOOLEAN
+       OR (EXTRACT(EPOCH FROM route_comments.created_at)::DOUBLE PRECISION, route_comments.id) > ($3::DOUBLE PRECISION, $4::UUID))
+ORDER BY EXTRACT(EPOCH FROM route_comments.created_at)::DOUBLE PRECISION, route_comments.id
+LIMIT $5::INT
+`
+
+type ListRouteCommentsParams struct {
+	RouteID       uuid.UUID `json:"route_id"`
+	HasCursor     bool      `json:"has_cursor"`
+	CursorSortKey float64   `json:"cursor_sort_key"`
+	CursorID      uuid.UUID `json:"cursor_id"`
+	LimitCount    int32     `json:"limit_count"`
+}
+
+type ListRouteCommentsRow struct {
+	ID        uuid.UUID   `json:"id"`
+	UserID    uuid.UUID   `json:"user_id"`
+	RouteID   uuid.UUID   `json:"route_id"`
+	ParentID  pgtype.UUID `json:"parent_id"`
+	Content   string      `json:"content"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	DeletedAt *time.Time  `json:"deleted_at"`
+	UserName  string      `json:"user_name"`
+	SortKey   float64     `json:"sort_key"`
+}
+
+func (q *Queries) ListRouteComments(ctx context.Context, arg ListRouteCommentsParams) ([]ListRouteCommentsRow, error) {
+	rows, err := q.db.Query(ctx, listRouteComments,
+		arg.RouteID,
+		arg.HasCursor,
+		arg.CursorSortKey,
+		arg.CursorID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRouteCommentsRow
+	for rows.Next() {
+		var i ListRouteCommentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.RouteID,
+			&i.ParentID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserName,
+			&i.SortKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRouteCommentsByIDs = `-- name: ListRouteCommentsByIDs :many
+SELECT route_comments.id, route_comments.user_id, route_comments.route_id, route_comments.parent_id, route_comments.content, route_comments.created_at, route_comments.updated_at, route_comments.deleted_at, users.name AS user_name
+FROM route_comments
+INNER JOIN users ON route_comments.user_id = users.id
+WHERE route_comments.id = ANY($1::UUID[]) AND route_comments.deleted_at IS NULL
+`
+
+type ListRouteCommentsByIDsRow struct {
+	ID        uuid.UUID   `json:"id"`
+	UserID    uuid.UUID   `json:"user_id"`
+	RouteID   uuid.UUID   `json:"route_id"`
+	ParentID  pgtype.UUID `json:"parent_id"`
+	Content   string      `json:"content"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	DeletedAt *time.Time  `json:"deleted_at"`
+	UserName  string      `json:"user_name"`
+}
+
+func (q *Queries) ListRouteCommentsByIDs(ctx context.Context, ids []uuid.UUID) ([]ListRouteCommentsByIDsRow, error) {
+	rows, err := q.db.Query(ctx, listRouteCommentsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRouteCommentsByIDsRow
+	for rows.Next() {
+		var i ListRouteCommentsByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.RouteID,
+			&i.ParentID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRouteGrades = `-- name: ListRouteGrades :many
+-- 経路を25m以下の区間に分け、区間の中点から20m以内で最も近い道路の勾配を経路の進む向きに合わせて返す
+-- 道路網は区間の端から端までの上り・下りしか持たないため、道路の区間内の勾配は一定とみなす。近くに道路がない区間の勾配はNULL
+WITH pieces AS (
+    SELECT segments.path[1] AS seq,
+           ST_Length(segments.geom::geography) AS length,
+           ST_StartPoint(segments.geom) AS start_point,
+           ST_EndPoint(segments.geom) AS end_point,
+           ST_LineInterpolatePoint(segments.geom, 0.5) AS midpoint
+    FROM routes,
+         LATERAL ST_DumpSegments(ST_Segmentize(routes.path_geom::geography, 25)::geometry) AS segments
+    WHERE routes.id = $1
+)
+SELECT pieces.length::DOUBLE PRECISION AS length,
+       (CASE WHEN road.length_m > 0
+             THEN (road.elevation_gain - road.elevation_loss) / road.length_m * 100 * road.direction
+        END)::DOUBLE PRECISION AS gradient
+FROM pieces
+LEFT JOIN LATERAL (
+    SELECT road_edges.length_m, road_edges.elevation_gain, road_edges.elevation_loss,
+           -- 道路の区間のsource→targetの向きに進む場合は1、逆向きは-1
+           CASE WHEN ST_LineLocatePoint(road_edges.geom, pieces.end_point) >= ST_LineLocatePoint(road_edges.geom, pieces.start_point)
+                THEN 1 ELSE -1 END AS direction
+    FROM road_edges
+    WHERE ST_DWithin(road_edges.geom, pieces.midpoint, 0.0005)
+      AND ST_DWithin(road_edges.geom::geography, pieces.midpoint::geography, 20)
+    ORDER BY road_edges.geom <-> pieces.midpoint
+    LIMIT 1
+) AS road ON TRUE
+ORDER BY pieces.seq
 `
 
-func (q *Queries) DeleteCoursePointsByRouteID(ctx context.Context, routeID uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteCoursePointsByRouteID, routeID)
-	return err
+type ListRouteGradesRow struct {
+	Length   float64  `json:"length"`
+	Gradient *float64 `json:"gradient"`
 }
 
-const deleteRoute = `-- name: DeleteRoute :one
-DELETE FROM routes WHERE id = $1 RETURNING id
+func (q *Queries) ListRouteGrades(ctx context.Context, routeID uuid.UUID) ([]ListRouteGradesRow, error) {
+	rows, err := q.db.Query(ctx, listRouteGrades, routeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRouteGradesRow
+	for rows.Next() {
+		var i ListRouteGradesRow
+		if err := rows.Scan(&i.Length, &i.Gradient); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRouteIDs = `-- name: ListRouteIDs :many
+SELECT id FROM routes ORDER BY id
 `
 
-func (q *Queries) DeleteRoute(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, deleteRoute, id)
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) ListRouteIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listRouteIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const deleteWaypoint = `-- name: DeleteWaypoint :exec
-DELETE FROM waypoints WHERE id = $1
+const listRoutesByIDs = `-- name: ListRoutesByIDs :many
+SELECT routes.id, routes.user_id, routes.name, routes.description, routes.highlighted_photo_id, routes.distance, routes.duration, routes.elevation_gain, routes.elevation_loss, routes.path_geom, routes.bbox, routes.first_point, routes.last_point, routes.polyline, routes.created_at, routes.updated_at, routes.visibility, routes.version, routes.forked_from_route_id, routes.difficulty, routes.club_id, users.name AS user_name
+FROM routes
+INNER JOIN users ON routes.user_id = users.id
+WHERE routes.id = ANY($1::UUID[])
 `
 
-func (q *Queries) DeleteWaypoint(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteWaypoint, id)
-	return err
+type ListRoutesByIDsRow struct {
+	ID                 uuid.UUID   `json:"id"`
+	UserID             uuid.UUID   `json:"user_id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	HighlightedPhotoID *int64      `json:"highlighted_photo_id"`
+	Distance           float64     `json:"distance"`
+	Duration           float64     `json:"duration"`
+	ElevationGain      float64     `json:"elevation_gain"`
+	ElevationLoss      float64     `json:"elevation_loss"`
+	PathGeom           OrbGeometry `json:"path_geom"`
+	Bbox               OrbGeometry `json:"bbox"`
+	FirstPoint         OrbGeometry `json:"first_point"`
+	LastPoint          OrbGeometry `json:"last_point"`
+	Polyline           string      `json:"polyline"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+	Visibility         int16       `json:"visibility"`
+	Version            int32       `json:"version"`
+	ForkedFromRouteID  pgtype.UUID `json:"forked_from_route_id"`
+	Difficulty         *int16      `json:"difficulty"`
+	ClubID             pgtype.UUID `json:"club_id"`
+	UserName           string      `json:"user_name"`
 }
 
-const deleteWaypointsByRouteID = `-- name: DeleteWaypointsByRouteID :exec
-DELETE FROM waypoints WHERE route_id = $1
+func (q *Queries) ListRoutesByIDs(ctx context.Context, ids []uuid.UUID) ([]ListRoutesByIDsRow, error) {
+	rows, err := q.db.Query(ctx, listRoutesByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRoutesByIDsRow
+	for rows.Next() {
+		var i ListRoutesByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.HighlightedPhotoID,
+			&i.Distance,
+			&i.Duration,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+			&i.PathGeom,
+			&i.Bbox,
+			&i.FirstPoint,
+			&i.LastPoint,
+			&i.Polyline,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Visibility,
+			&i.Version,
+			&i.ForkedFromRouteID,
+			&i.Difficulty,
+			&i.ClubID,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRouteSearchSources = `-- name: ListRouteSearchSources :many
+SELECT
+  routes.id,
+  routes.name,
+  routes.description,
+  COALESCE(string_agg(course_points.road_name, ' ' ORDER BY course_points.step_order), '')::TEXT AS road_names
+FROM routes
+LEFT JOIN course_points ON course_points.route_id = routes.id
+GROUP BY routes.id
+ORDER BY routes.id
 `
 
-func (q *Queries) DeleteWaypointsByRouteID(ctx context.Context, routeID uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteWaypointsByRouteID, routeID)
-	return err
+type ListRouteSearchSourcesRow struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	RoadNames   string    `json:"road_names"`
 }
 
-const exploreRoutes = `-- name: ExploreRoutes :many
-SELECT
-  filtered_routes.id,
-  filtered_routes.user_id,
-  filtered_routes.name,
-  filtered_routes.description,
-  filtered_routes.highlighted_photo_id,
-  filtered_routes.distance,
-  filtered_routes.duration,
-  filtered_routes.elevation_gain,
-  filtered_routes.elevation_loss,
-  filtered_routes.path_geom,
-  filtered_routes.bbox,
-  filtered_routes.first_point,
-  filtered_routes.last_point,
-  filtered_routes.polyline,
-  filtered_routes.created_at,
-  filtered_routes.updated_at,
-  filtered_routes.visibility,
-  filtered_routes.total_count,
-  users.name AS user_name
-FROM (
-    SELECT id, user_id, name, description, highlighted_photo_id, distance, duration, elevation_gain, elevation_loss, path_geom, bbox, first_point, last_point, polyline, created_at, updated_at, visibility, COUNT(*) OVER() AS total_count  
-    FROM routes
-    WHERE visibility = 1
-    AND ($1::float8 < 0 OR ST_DWithin(
-        routes.first_point::geography,
-        ST_GeomFromEWKB($2)::geography,
-        $1::float8
-    ))
-    AND (cardinality($3::TEXT[]) = 0 OR name ILIKE ANY($3::TEXT[]))
-    AND ($4::DOUBLE PRECISION < 0 OR distance >= $4::DOUBLE PRECISION)
-    AND ($5::DOUBLE PRECISION < 0 OR distance <= $5::DOUBLE PRECISION)
-) AS filtered_routes
-INNER JOIN users ON filtered_routes.user_id = users.id
-ORDER BY
-  CASE WHEN $1::float8 < 0 THEN 0
-       ELSE ST_Distance(filtered_routes.first_point::geography, ST_GeomFromEWKB($2)::geography)
-  END
-LIMIT $7::INT
-OFFSET $6::INT
+func (q *Queries) ListRouteSearchSources(ctx context.Context) ([]ListRouteSearchSourcesRow, error) {
+	rows, err := q.db.Query(ctx, listRouteSearchSources)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRouteSearchSourcesRow
+	for rows.Next() {
+		var i ListRouteSearchSourcesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.RoadNames,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRouteTagsByRouteIDs = `-- name: ListRouteTagsByRouteIDs :many
+SELECT route_id, tag, position FROM route_tags
+WHERE route_id = ANY($1::UUID[])
+ORDER BY route_id, position
 `
 
-type ExploreRoutesParams struct {
-	RadiusM      float64     `json:"radius_m"`
-	Location     interface{} `json:"location"`
-	NameKeywords []string    `json:"name_keywords"`
-	MinDistance  float64     `json:"min_distance"`
-	MaxDistance  float64     `json:"max_distance"`
-	OffsetCount  int32       `json:"offset_count"`
-	LimitCount   int32       `json:"limit_count"`
+func (q *Queries) ListRouteTagsByRouteIDs(ctx context.Context, routeIds []uuid.UUID) ([]RouteTag, error) {
+	rows, err := q.db.Query(ctx, listRouteTagsByRouteIDs, routeIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RouteTag
+	for rows.Next() {
+		var i RouteTag
+		if err := rows.Scan(&i.RouteID, &i.Tag, &i.Position); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-type ExploreRoutesRow struct {
+const listSavedRoutes = `-- name: ListSavedRoutes :many
+-- 保存した日時の新しい順。同じ日時のルートはIDで順序を決める
+SELECT routes.id, routes.user_id, routes.name, routes.description, routes.highlighted_photo_id, routes.distance, routes.duration, routes.elevation_gain, routes.elevation_loss, routes.path_geom, routes.bbox, routes.first_point, routes.last_point, routes.polyline, routes.created_at, routes.updated_at, routes.visibility, routes.version, routes.forked_from_route_id, routes.difficulty, routes.club_id, users.name AS user_name, EXTRACT(EPOCH FROM route_saves.created_at)::DOUBLE PRECISION AS sort_key
+FROM route_saves
+INNER JOIN routes ON route_saves.route_id = routes.id
+INNER JOIN users ON routes.user_id = users.id
+WHERE route_saves.user_id = $1
+  AND route_saves.deleted_at IS NULL
+  AND (NOT $2::BOOLEAN
+       OR (EXTRACT(EPOCH FROM route_saves.created_at)::DOUBLE PRECISION, routes.id) < ($3::DOUBLE PRECISION, $4::UUID))
+ORDER BY EXTRACT(EPOCH FROM route_saves.created_at)::DOUBLE PRECISION DESC, routes.id DESC
+LIMIT $5::INT
+`
+
+type ListSavedRoutesParams struct {
+	UserID        uuid.UUID `json:"user_id"`
+	HasCursor     bool      `json:"has_cursor"`
+	CursorSortKey float64   `json:"cursor_sort_key"`
+	CursorID      uuid.UUID `json:"cursor_id"`
+	LimitCount    int32     `json:"limit_count"`
+}
+
+type ListSavedRoutesRow struct {
 	ID                 uuid.UUID   `json:"id"`
 	UserID             uuid.UUID   `json:"user_id"`
 	Name               string      `json:"name"`
@@ -356,27 +3820,29 @@ type ExploreRoutesRow struct {
 	CreatedAt          time.Time   `json:"created_at"`
 	UpdatedAt          time.Time   `json:"updated_at"`
 	Visibility         int16       `json:"visibility"`
-	TotalCount         int64       `json:"total_count"`
+	Version            int32       `json:"version"`
+	ForkedFromRouteID  pgtype.UUID `json:"forked_from_route_id"`
+	Difficulty         *int16      `json:"difficulty"`
+	ClubID             pgtype.UUID `json:"club_id"`
 	UserName           string      `json:"user_name"`
+	SortKey            float64     `json:"sort_key"`
 }
 
-func (q *Queries) ExploreRoutes(ctx context.Context, arg ExploreRoutesParams) ([]ExploreRoutesRow, error) {
-	rows, err := q.db.Query(ctx, exploreRoutes,
-		arg.RadiusM,
-		arg.Location,
-		arg.NameKeywords,
-		arg.MinDistance,
-		arg.MaxDistance,
-		arg.OffsetCount,
+func (q *Queries) ListSavedRoutes(ctx context.Context, arg ListSavedRoutesParams) ([]ListSavedRoutesRow, error) {
+	rows, err := q.db.Query(ctx, listSavedRoutes,
+		arg.UserID,
+		arg.HasCursor,
+		arg.CursorSortKey,
+		arg.CursorID,
 		arg.LimitCount,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ExploreRoutesRow
+	var items []ListSavedRoutesRow
 	for rows.Next() {
-		var i ExploreRoutesRow
+		var i ListSavedRoutesRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
@@ -395,8 +3861,12 @@ func (q *Queries) ExploreRoutes(ctx context.Context, arg ExploreRoutesParams) ([
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Visibility,
-			&i.TotalCount,
+			&i.Version,
+			&i.ForkedFromRouteID,
+			&i.Difficulty,
+			&i.ClubID,
 			&i.UserName,
+			&i.SortKey,
 		); err != nil {
 			return nil, err
 		}
@@ -408,33 +3878,59 @@ func (q *Queries) ExploreRoutes(ctx context.Context, arg ExploreRoutesParams) ([
 	return items, nil
 }
 
-const getCoursePointsByRouteID = `-- name: GetCoursePointsByRouteID :many
-SELECT id, route_id, step_order, seg_dist_m, cum_dist_m, duration, instruction, road_name, maneuver_type, modifier, location, bearing_before, bearing_after FROM course_points WHERE route_id = $1 ORDER BY step_order ASC
+const listToursByUserID = `-- name: ListToursByUserID :many
+SELECT id, user_id, name, description, visibility, created_at, updated_at, club_id FROM tours WHERE user_id = $1 ORDER BY created_at DESC, id DESC
 `
 
-func (q *Queries) GetCoursePointsByRouteID(ctx context.Context, routeID uuid.UUID) ([]CoursePoint, error) {
-	rows, err := q.db.Query(ctx, getCoursePointsByRouteID, routeID)
+func (q *Queries) ListToursByUserID(ctx context.Context, userID uuid.UUID) ([]Tour, error) {
+	rows, err := q.db.Query(ctx, listToursByUserID, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []CoursePoint
+	var items []Tour
 	for rows.Next() {
-		var i CoursePoint
+		var i Tour
 		if err := rows.Scan(
 			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.Visibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ClubID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTourStagesByTourIDs = `-- name: ListTourStagesByTourIDs :many
+SELECT tour_id, position, route_id, overnight_stop FROM tour_stages
+WHERE tour_id = ANY($1::UUID[])
+ORDER BY tour_id, position
+`
+
+func (q *Queries) ListTourStagesByTourIDs(ctx context.Context, tourIds []uuid.UUID) ([]TourStage, error) {
+	rows, err := q.db.Query(ctx, listTourStagesByTourIDs, tourIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TourStage
+	for rows.Next() {
+		var i TourStage
+		if err := rows.Scan(
+			&i.TourID,
+			&i.Position,
 			&i.RouteID,
-			&i.StepOrder,
-			&i.SegDistM,
-			&i.CumDistM,
-			&i.Duration,
-			&i.Instruction,
-			&i.RoadName,
-			&i.ManeuverType,
-			&i.Modifier,
-			&i.Location,
-			&i.BearingBefore,
-			&i.BearingAfter,
+			&i.OvernightStop,
 		); err != nil {
 			return nil, err
 		}
@@ -446,66 +3942,190 @@ func (q *Queries) GetCoursePointsByRouteID(ctx context.Context, routeID uuid.UUI
 	return items, nil
 }
 
-const getRouteByID = `-- name: GetRouteByID :one
-SELECT id, user_id, name, description, highlighted_photo_id, distance, duration, elevation_gain, elevation_loss, path_geom, bbox, first_point, last_point, polyline, created_at, updated_at, visibility FROM routes WHERE id = $1
+const listTripsByIDs = `-- name: ListTripsByIDs :many
+SELECT id, user_id, name, description, visibility, highlighted_photo_id, path_geom, first_point, last_point, bbox_geom, distance, duration, moving_time, elevation_gain, elevation_loss, avg_speed, max_speed, avg_cad, max_cad, min_cad, max_hr, min_hr, avg_watts, max_watts, min_watts, avg_watts_estimated, avg_power_estimated, calories, is_gps, is_stationary, processed, created_at, updated_at, deleted_at, departed_at, time_zone, utc_offset, activity_type_id, pace, moving_pace FROM trips WHERE id = ANY($1::UUID[]) AND deleted_at IS NULL
 `
 
-func (q *Queries) GetRouteByID(ctx context.Context, id uuid.UUID) (Route, error) {
-	row := q.db.QueryRow(ctx, getRouteByID, id)
-	var i Route
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.Name,
-		&i.Description,
-		&i.HighlightedPhotoID,
-		&i.Distance,
-		&i.Duration,
-		&i.ElevationGain,
-		&i.ElevationLoss,
-		&i.PathGeom,
-		&i.Bbox,
-		&i.FirstPoint,
-		&i.LastPoint,
-		&i.Polyline,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.Visibility,
-	)
-	return i, err
+func (q *Queries) ListTripsByIDs(ctx context.Context, ids []uuid.UUID) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, listTripsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.Visibility,
+			&i.HighlightedPhotoID,
+			&i.PathGeom,
+			&i.FirstPoint,
+			&i.LastPoint,
+			&i.BboxGeom,
+			&i.Distance,
+			&i.Duration,
+			&i.MovingTime,
+			&i.ElevationGain,
+			&i.ElevationLoss,
+			&i.AvgSpeed,
+			&i.MaxSpeed,
+			&i.AvgCad,
+			&i.MaxCad,
+			&i.MinCad,
+			&i.MaxHr,
+			&i.MinHr,
+			&i.AvgWatts,
+			&i.MaxWatts,
+			&i.MinWatts,
+			&i.AvgWattsEstimated,
+			&i.AvgPowerEstimated,
+			&i.Calories,
+			&i.IsGps,
+			&i.IsStationary,
+			&i.Processed,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.DepartedAt,
+			&i.TimeZone,
+			&i.UtcOffset,
+			&i.ActivityTypeID,
+			&i.Pace,
+			&i.MovingPace,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getRoutesByUserID = `-- name: GetRoutesByUserID :many
-SELECT id, user_id, name, description, highlighted_photo_id, distance, duration, elevation_gain, elevation_loss, path_geom, bbox, first_point, last_point, polyline, created_at, updated_at, visibility FROM routes WHERE user_id = $1
+const listTripsByUserID = `-- name: ListTripsByUserID :many
+SELECT id, user_id, name, description, visibility, highlighted_photo_id, path_geom, first_point, last_point, bbox_geom, distance, duration, moving_time, elevation_gain, elevation_loss, avg_speed, max_speed, avg_cad, max_cad, min_cad, max_hr, min_hr, avg_watts, max_watts, min_watts, avg_watts_estimated, avg_power_estimated, calories, is_gps, is_stationary, processed, created_at, updated_at, deleted_at, departed_at, time_zone, utc_offset, activity_type_id, pace, moving_pace, EXTRACT(EPOCH FROM created_at)::DOUBLE PRECISION AS sort_key FROM trips
+WHERE user_id = $1
+  AND deleted_at IS NULL
+  AND (NOT $2::BOOLEAN
+       OR (EXTRACT(EPOCH FROM created_at)::DOUBLE PRECISION, id) < ($3::DOUBLE PRECISION, $4::UUID))
+ORDER BY EXTRACT(EPOCH FROM created_at)::DOUBLE PRECISION DESC, id DESC
+LIMIT $5::INT
 `
 
-func (q *Queries) GetRoutesByUserID(ctx context.Context, userID uuid.UUID) ([]Route, error) {
-	rows, err := q.db.Query(ctx, getRoutesByUserID, userID)
+type ListTripsByUserIDParams struct {
+	UserID        uuid.UUID `json:"user_id"`
+	HasCursor     bool      `json:"has_cursor"`
+	CursorSortKey float64   `json:"cursor_sort_key"`
+	CursorID      uuid.UUID `json:"cursor_id"`
+	LimitCount    int32     `json:"limit_count"`
+}
+
+type ListTripsByUserIDRow struct {
+	ID                 uuid.UUID    `json:"id"`
+	UserID             uuid.UUID    `json:"user_id"`
+	Name               string       `json:"name"`
+	Description        string       `json:"description"`
+	Visibility         int16        `json:"visibility"`
+	HighlightedPhotoID int64        `json:"highlighted_photo_id"`
+	PathGeom           *OrbGeometry `json:"path_geom"`
+	FirstPoint         *OrbGeometry `json:"first_point"`
+	LastPoint          *OrbGeometry `json:"last_point"`
+	BboxGeom           *OrbGeometry `json:"bbox_geom"`
+	Distance           *float64     `json:"distance"`
+	Duration           *int32       `json:"duration"`
+	MovingTime         *int32       `json:"moving_time"`
+	ElevationGain      *float64     `json:"elevation_gain"`
+	ElevationLoss      *float64     `json:"elevation_loss"`
+	AvgSpeed           *float64     `json:"avg_speed"`
+	MaxSpeed           *float64     `json:"max_speed"`
+	AvgCad             *float64     `json:"avg_cad"`
+	MaxCad             *float64     `json:"max_cad"`
+	MinCad             *float64     `json:"min_cad"`
+	MaxHr              *int32       `json:"max_hr"`
+	MinHr              *int32       `json:"min_hr"`
+	AvgWatts           *float64     `json:"avg_watts"`
+	MaxWatts           *float64     `json:"max_watts"`
+	MinWatts           *float64     `json:"min_watts"`
+	AvgWattsEstimated  *bool        `json:"avg_watts_estimated"`
+	AvgPowerEstimated  *float64     `json:"avg_power_estimated"`
+	Calories           *float64     `json:"calories"`
+	IsGps              bool         `json:"is_gps"`
+	IsStationary       bool         `json:"is_stationary"`
+	Processed          bool         `json:"processed"`
+	CreatedAt          time.Time    `json:"created_at"`
+	UpdatedAt          time.Time    `json:"updated_at"`
+	DeletedAt          *time.Time   `json:"deleted_at"`
+	DepartedAt         *time.Time   `json:"departed_at"`
+	TimeZone           *string      `json:"time_zone"`
+	UtcOffset          *int32       `json:"utc_offset"`
+	ActivityTypeID     int32        `json:"activity_type_id"`
+	Pace               *float64     `json:"pace"`
+	MovingPace         *float64     `json:"moving_pace"`
+	SortKey            float64      `json:"sort_key"`
+}
+
+func (q *Queries) ListTripsByUserID(ctx context.Context, arg ListTripsByUserIDParams) ([]ListTripsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, listTripsByUserID,
+		arg.UserID,
+		arg.HasCursor,
+		arg.CursorSortKey,
+		arg.CursorID,
+		arg.LimitCount,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Route
+	var items []ListTripsByUserIDRow
 	for rows.Next() {
-		var i Route
+		var i ListTripsByUserIDRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
 			&i.Name,
 			&i.Description,
+			&i.Visibility,
 			&i.HighlightedPhotoID,
+			&i.PathGeom,
+			&i.FirstPoint,
+			&i.LastPoint,
+			&i.BboxGeom,
 			&i.Distance,
 			&i.Duration,
+			&i.MovingTime,
 			&i.ElevationGain,
 			&i.ElevationLoss,
-			&i.PathGeom,
-			&i.Bbox,
-			&i.FirstPoint,
-			&i.LastPoint,
-			&i.Polyline,
+			&i.AvgSpeed,
+			&i.MaxSpeed,
+			&i.AvgCad,
+			&i.MaxCad,
+			&i.MinCad,
+			&i.MaxHr,
+			&i.MinHr,
+			&i.AvgWatts,
+			&i.MaxWatts,
+			&i.MinWatts,
+			&i.AvgWattsEstimated,
+			&i.AvgPowerEstimated,
+			&i.Calories,
+			&i.IsGps,
+			&i.IsStationary,
+			&i.Processed,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.Visibility,
+			&i.DeletedAt,
+			&i.DepartedAt,
+			&i.TimeZone,
+			&i.UtcOffset,
+			&i.ActivityTypeID,
+			&i.Pace,
+			&i.MovingPace,
+			&i.SortKey,
 		); err != nil {
 			return nil, err
 		}
@@ -517,82 +4137,80 @@ func (q *Queries) GetRoutesByUserID(ctx context.Context, userID uuid.UUID) ([]Ro
 	return items, nil
 }
 
-const getUserByID = `-- name: GetUserByID :one
-SELECT id, kratos_id, name, highlighted_photo_id, locale, created_at, updated_at, description, locality, administrative_area, country_code, postal_code, geom, first_name, last_name, email, has_set_location FROM users WHERE id = $1
+const listUpcomingEvents = `-- name: ListUpcomingEvents :many
+SELECT events.id, events.user_id, events.route_id, events.title, events.description, events.starts_at, events.meeting_point, events.meeting_point_name, events.capacity, events.visibility, events.cancelled_at, events.cancel_reason, events.created_at, events.updated_at, events.club_id,
+    (SELECT count(*) FROM event_rsvps WHERE event_rsvps.event_id = events.id AND event_rsvps.status = 'going')::INTEGER AS going_count
+FROM events
+WHERE events.visibility = 1
+  AND events.cancelled_at IS NULL
+  AND events.starts_at >= $1::TIMESTAMPTZ
+  -- radius_mが負の場合は範囲で絞り込まない（ExploreRoutesと同じ）
+  AND ($2::float8 < 0 OR ST_DWithin(
+      events.meeting_point::geography,
+      ST_GeomFromEWKB($3)::geography,
+      $2::float8
+  ))
+ORDER BY events.starts_at, events.id
+LIMIT $4
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByID, id)
-	var i User
-	err := row.Scan(
-		&i.ID,
-		&i.KratosID,
-		&i.Name,
-		&i.HighlightedPhotoID,
-		&i.Locale,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.Description,
-		&i.Locality,
-		&i.AdministrativeArea,
-		&i.CountryCode,
-		&i.PostalCode,
-		&i.Geom,
-		&i.FirstName,
-		&i.LastName,
-		&i.Email,
-		&i.HasSetLocation,
-	)
-	return i, err
+type ListUpcomingEventsParams struct {
+	StartsFrom time.Time   `json:"starts_from"`
+	RadiusM    float64     `json:"radius_m"`
+	Location   interface{} `json:"location"`
+	LimitCount int32       `json:"limit_count"`
 }
 
-const getUserByKratosID = `-- name: GetUserByKratosID :one
-SELECT id, kratos_id, name, highlighted_photo_id, locale, created_at, updated_at, description, locality, administrative_area, country_code, postal_code, geom, first_name, last_name, email, has_set_location FROM users WHERE kratos_id = $1
-`
-
-func (q *Queries) GetUserByKratosID(ctx context.Context, kratosID uuid.UUID) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByKratosID, kratosID)
-	var i User
-	err := row.Scan(
-		&i.ID,
-		&i.KratosID,
-		&i.Name,
-		&i.HighlightedPhotoID,
-		&i.Locale,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.Description,
-		&i.Locality,
-		&i.AdministrativeArea,
-		&i.CountryCode,
-		&i.PostalCode,
-		&i.Geom,
-		&i.FirstName,
-		&i.LastName,
-		&i.Email,
-		&i.HasSetLocation,
-	)
-	return i, err
+type ListUpcomingEventsRow struct {
+	ID               uuid.UUID   `json:"id"`
+	UserID           uuid.UUID   `json:"user_id"`
+	RouteID          uuid.UUID   `json:"route_id"`
+	Title            string      `json:"title"`
+	Description      string      `json:"description"`
+	StartsAt         time.Time   `json:"starts_at"`
+	MeetingPoint     OrbGeometry `json:"meeting_point"`
+	MeetingPointName string      `json:"meeting_point_name"`
+	Capacity         *int32      `json:"capacity"`
+	Visibility       int16       `json:"visibility"`
+	CancelledAt      *time.Time  `json:"cancelled_at"`
+	CancelReason     string      `json:"cancel_reason"`
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+	ClubID           pgtype.UUID `json:"club_id"`
+	GoingCount       int32       `json:"going_count"`
 }
 
-const getWaypointsByRouteID = `-- name: GetWaypointsByRouteID :many
-SELECT id, route_id, location, created_at FROM waypoints WHERE route_id = $1 ORDER BY id ASC
-`
-
-func (q *Queries) GetWaypointsByRouteID(ctx context.Context, routeID uuid.UUID) ([]Waypoint, error) {
-	rows, err := q.db.Query(ctx, getWaypointsByRouteID, routeID)
+func (q *Queries) ListUpcomingEvents(ctx context.Context, arg ListUpcomingEventsParams) ([]ListUpcomingEventsRow, error) {
+	rows, err := q.db.Query(ctx, listUpcomingEvents,
+		arg.StartsFrom,
+		arg.RadiusM,
+		arg.Location,
+		arg.LimitCount,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Waypoint
+	var items []ListUpcomingEventsRow
 	for rows.Next() {
-		var i Waypoint
+		var i ListUpcomingEventsRow
 		if err := rows.Scan(
 			&i.ID,
+			&i.UserID,
 			&i.RouteID,
-			&i.Location,
+			&i.Title,
+			&i.Description,
+			&i.StartsAt,
+			&i.MeetingPoint,
+			&i.MeetingPointName,
+			&i.Capacity,
+			&i.Visibility,
+			&i.CancelledAt,
+			&i.CancelReason,
 			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ClubID,
+			&i.GoingCount,
 		); err != nil {
 			return nil, err
 		}
@@ -604,38 +4222,219 @@ func (q *Queries) GetWaypointsByRouteID(ctx context.Context, routeID uuid.UUID)
 	return items, nil
 }
 
+const lockEventCapacity = `-- name: LockEventCapacity :one
+SELECT capacity FROM events WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) LockEventCapacity(ctx context.Context, id uuid.UUID) (*int32, error) {
+	row := q.db.QueryRow(ctx, lockEventCapacity, id)
+	var capacity *int32
+	err := row.Scan(&capacity)
+	return capacity, err
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :exec
+UPDATE notifications SET read_at = now()
+WHERE user_id = $1 AND read_at IS NULL
+`
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markAllNotificationsRead, userID)
+	return err
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :execrows
+-- 既読の通知は既読にした日時を変えない
+UPDATE notifications SET read_at = COALESCE(read_at, now())
+WHERE id = $1 AND user_id = $2
+`
+
+type MarkNotificationReadParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markNotificationRead, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const searchRoutesByUserID = `-- name: SearchRoutesByUserID :many
-SELECT id, user_id, name, description, highlighted_photo_id, distance, duration, elevation_gain, elevation_loss, path_geom, bbox, first_point, last_point, polyline, created_at, updated_at, visibility FROM routes
-WHERE user_id = $1
-  AND (cardinality($2::TEXT[]) = 0 OR name ILIKE ANY($2::TEXT[]))
-  AND ($3::SMALLINT < 0 OR visibility = $3::SMALLINT)
-  AND ($4::DOUBLE PRECISION < 0 OR distance >= $4::DOUBLE PRECISION)
-  AND ($5::DOUBLE PRECISION < 0 OR distance <= $5::DOUBLE PRECISION)
+SELECT
+  ranked_routes.id,
+  ranked_routes.user_id,
+  ranked_routes.name,
+  ranked_routes.description,
+  ranked_routes.highlighted_photo_id,
+  ranked_routes.distance,
+  ranked_routes.duration,
+  ranked_routes.elevation_gain,
+  ranked_routes.elevation_loss,
+  ranked_routes.path_geom,
+  ranked_routes.bbox,
+  ranked_routes.first_point,
+  ranked_routes.last_point,
+  ranked_routes.polyline,
+  ranked_routes.created_at,
+  ranked_routes.updated_at,
+  ranked_routes.visibility,
+  ranked_routes.version,
+  ranked_routes.forked_from_route_id,
+  ranked_routes.difficulty,
+  ranked_routes.club_id,
+  ranked_routes.user_name,
+  ranked_routes.sort_key
+FROM (
+    SELECT routes.id, routes.user_id, routes.name, routes.description, routes.highlighted_photo_id, routes.distance, routes.duration, routes.elevation_gain, routes.elevation_loss, routes.path_geom, routes.bbox, routes.first_point, routes.last_point, routes.polyline, routes.created_at, routes.updated_at, routes.visibility, routes.version, routes.forked_from_route_id, routes.difficulty, routes.club_id, users.name AS user_name,
+      CASE $1::TEXT
+        WHEN 'most_liked' THEN (SELECT COUNT(*) FROM route_likes WHERE route_likes.route_id = routes.id)::DOUBLE PRECISION
+        WHEN 'longest' THEN routes.distance
+        WHEN 'hilliest' THEN CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END
+        -- 全文検索の一致度と、ルート名のあいまい一致度の合計
+        WHEN 'relevance' THEN COALESCE(ts_rank_cd(route_search_documents.search_vector, plainto_tsquery('simple', $2::TEXT)), 0)
+                              + word_similarity($3::TEXT, routes.name)
+        -- 降順に並べるため、コレクション内の位置の符号を反転する
+        WHEN 'position' THEN -collection_routes.position
+        ELSE EXTRACT(EPOCH FROM routes.created_at)::DOUBLE PRECISION
+      END::DOUBLE PRECISION AS sort_key
+    FROM routes
+    INNER JOIN users ON routes.user_id = users.id
+    LEFT JOIN route_search_documents ON route_search_documents.route_id = routes.id
+    LEFT JOIN collection_routes ON collection_routes.collection_id = $4::UUID
+                               AND collection_routes.route_id = routes.id
+    -- コレクション指定時は、他のユーザーのルートも含めてコレクション内の閲覧できるルートを対象にする
+    WHERE (($4::UUID IS NULL AND routes.user_id = $5)
+           OR (collection_routes.route_id IS NOT NULL
+               AND (routes.user_id = $5
+                    OR routes.visibility = 1
+                    OR (routes.visibility = 3 AND routes.club_id = ANY($6::UUID[])))))
+    AND (cardinality($7::TEXT[]) = 0
+         OR route_search_documents.search_vector @@ plainto_tsquery('simple', $2::TEXT)
+         OR routes.name ILIKE ANY($7::TEXT[])
+         OR $3::TEXT <% routes.name)
+    AND ($8::SMALLINT < 0 OR routes.visibility = $8::SMALLINT)
+    AND ($9::DOUBLE PRECISION < 0 OR routes.distance >= $9::DOUBLE PRECISION)
+    AND ($10::DOUBLE PRECISION < 0 OR routes.distance <= $10::DOUBLE PRECISION)
+    AND ($11::DOUBLE PRECISION < 0 OR routes.elevation_gain >= $11::DOUBLE PRECISION)
+    AND ($12::DOUBLE PRECISION < 0 OR routes.elevation_gain <= $12::DOUBLE PRECISION)
+    AND ($13::DOUBLE PRECISION < 0 OR routes.duration >= $13::DOUBLE PRECISION)
+    AND ($14::DOUBLE PRECISION < 0 OR routes.duration <= $14::DOUBLE PRECISION)
+    AND ($15::TEXT = '' OR users.name ILIKE $15::TEXT ESCAPE '\')
+    AND ($16::DOUBLE PRECISION < 0
+         OR (CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END) >= $16::DOUBLE PRECISION)
+    AND ($17::DOUBLE PRECISION < 0
+         OR (CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END) <= $17::DOUBLE PRECISION)
+    -- 未舗装（gravel, dirt）の割合(%)。路面の内訳がまだないルートは含めない
+    AND ($18::DOUBLE PRECISION < 0
+         OR (SELECT SUM(CASE WHEN route_surfaces.category IN ('gravel', 'dirt') THEN route_surfaces.distance ELSE 0 END) * 100
+                    / NULLIF(SUM(route_surfaces.distance), 0)
+             FROM route_surfaces
+             WHERE route_surfaces.route_id = routes.id AND route_surfaces.kind = 'surface') <= $18::DOUBLE PRECISION)
+    -- 出発地点か目的地の市区町村・都道府県名の前方一致。地名がまだないルートは含めない
+    AND ($19::TEXT = ''
+         OR EXISTS (SELECT 1 FROM route_places
+                    WHERE route_places.route_id = routes.id
+                      AND (route_places.locality LIKE $19::TEXT OR route_places.administrative_area LIKE $19::TEXT)))
+    -- タグでの絞り込み
+    AND ($20::TEXT = ''
+         OR EXISTS (SELECT 1 FROM route_tags WHERE route_tags.route_id = routes.id AND route_tags.tag = $20::TEXT))
+) AS ranked_routes
+WHERE NOT $21::BOOLEAN
+   OR (ranked_routes.sort_key, ranked_routes.id) < ($22::DOUBLE PRECISION, $23::UUID)
+ORDER BY ranked_routes.sort_key DESC, ranked_routes.id DESC
+LIMIT $24::INT
 `
 
 type SearchRoutesByUserIDParams struct {
-	UserID       uuid.UUID `json:"user_id"`
-	NameKeywords []string  `json:"name_keywords"`
-	Visibility   int16     `json:"visibility"`
-	MinDistance  float64   `json:"min_distance"`
-	MaxDistance  float64   `json:"max_distance"`
+	Sort                 string      `json:"sort"`
+	SearchQuery          string      `json:"search_query"`
+	Keyword              string      `json:"keyword"`
+	CollectionID         pgtype.UUID `json:"collection_id"`
+	UserID               uuid.UUID   `json:"user_id"`
+	ViewerClubIds        []uuid.UUID `json:"viewer_club_ids"`
+	NameKeywords         []string    `json:"name_keywords"`
+	Visibility           int16       `json:"visibility"`
+	MinDistance          float64     `json:"min_distance"`
+	MaxDistance          float64     `json:"max_distance"`
+	MinElevationGain     float64     `json:"min_elevation_gain"`
+	MaxElevationGain     float64     `json:"max_elevation_gain"`
+	MinDuration          float64     `json:"min_duration"`
+	MaxDuration          float64     `json:"max_duration"`
+	Author               string      `json:"author"`
+	MinClimbingRatio     float64     `json:"min_climbing_ratio"`
+	MaxClimbingRatio     float64     `json:"max_climbing_ratio"`
+	MaxUnpavedPercentage float64     `json:"max_unpaved_percentage"`
+	Area                 string      `json:"area"`
+	Tag                  string      `json:"tag"`
+	HasCursor            bool        `json:"has_cursor"`
+	CursorSortKey        float64     `json:"cursor_sort_key"`
+	CursorID             uuid.UUID   `json:"cursor_id"`
+	LimitCount           int32       `json:"limit_count"`
+}
+
+type SearchRoutesByUserIDRow struct {
+	ID                 uuid.UUID   `json:"id"`
+	UserID             uuid.UUID   `json:"user_id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	HighlightedPhotoID *int64      `json:"highlighted_photo_id"`
+	Distance           float64     `json:"distance"`
+	Duration           float64     `json:"duration"`
+	ElevationGain      float64     `json:"elevation_gain"`
+	ElevationLoss      float64     `json:"elevation_loss"`
+	PathGeom           OrbGeometry `json:"path_geom"`
+	Bbox               OrbGeometry `json:"bbox"`
+	FirstPoint         OrbGeometry `json:"first_point"`
+	LastPoint          OrbGeometry `json:"last_point"`
+	Polyline           string      `json:"polyline"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+	Visibility         int16       `json:"visibility"`
+	Version            int32       `json:"version"`
+	ForkedFromRouteID  pgtype.UUID `json:"forked_from_route_id"`
+	Difficulty         *int16      `json:"difficulty"`
+	ClubID             pgtype.UUID `json:"club_id"`
+	UserName           string      `json:"user_name"`
+	SortKey            float64     `json:"sort_key"`
 }
 
-func (q *Queries) SearchRoutesByUserID(ctx context.Context, arg SearchRoutesByUserIDParams) ([]Route, error) {
+func (q *Queries) SearchRoutesByUserID(ctx context.Context, arg SearchRoutesByUserIDParams) ([]SearchRoutesByUserIDRow, error) {
 	rows, err := q.db.Query(ctx, searchRoutesByUserID,
+		arg.Sort,
+		arg.SearchQuery,
+		arg.Keyword,
+		arg.CollectionID,
 		arg.UserID,
+		arg.ViewerClubIds,
 		arg.NameKeywords,
 		arg.Visibility,
 		arg.MinDistance,
 		arg.MaxDistance,
+		arg.MinElevationGain,
+		arg.MaxElevationGain,
+		arg.MinDuration,
+		arg.MaxDuration,
+		arg.Author,
+		arg.MinClimbingRatio,
+		arg.MaxClimbingRatio,
+		arg.MaxUnpavedPercentage,
+		arg.Area,
+		arg.Tag,
+		arg.HasCursor,
+		arg.CursorSortKey,
+		arg.CursorID,
+		arg.LimitCount,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Route
+	var items []SearchRoutesByUserIDRow
 	for rows.Next() {
-		var i Route
+		var i SearchRoutesByUserIDRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
@@ -654,6 +4453,12 @@ func (q *Queries) SearchRoutesByUserID(ctx context.Context, arg SearchRoutesByUs
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Visibility,
+			&i.Version,
+			&i.ForkedFromRouteID,
+			&i.Difficulty,
+			&i.ClubID,
+			&i.UserName,
+			&i.SortKey,
 		); err != nil {
 			return nil, err
 		}
@@ -665,7 +4470,128 @@ func (q *Queries) SearchRoutesByUserID(ctx context.Context, arg SearchRoutesByUs
 	return items, nil
 }
 
-const updateRoute = `-- name: UpdateRoute :exec
+const updateClub = `-- name: UpdateClub :execrows
+UPDATE clubs
+SET name = $1,
+    description = $2,
+    updated_at = now()
+WHERE id = $3
+`
+
+type UpdateClubParams struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	ID          uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateClub(ctx context.Context, arg UpdateClubParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateClub, arg.Name, arg.Description, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateCollection = `-- name: UpdateCollection :execrows
+UPDATE collections
+SET name = $1,
+    description = $2,
+    visibility = $3,
+    updated_at = now()
+WHERE id = $4
+`
+
+type UpdateCollectionParams struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Visibility  int16     `json:"visibility"`
+	ID          uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateCollection(ctx context.Context, arg UpdateCollectionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateCollection,
+		arg.Name,
+		arg.Description,
+		arg.Visibility,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateEvent = `-- name: UpdateEvent :execrows
+UPDATE events
+SET route_id = $1,
+    title = $2,
+    description = $3,
+    starts_at = $4,
+    meeting_point = ST_GeomFromEWKB($5),
+    meeting_point_name = $6,
+    capacity = $7,
+    visibility = $8,
+    club_id = $9,
+    cancelled_at = $10,
+    cancel_reason = $11,
+    updated_at = now()
+WHERE id = $12
+`
+
+type UpdateEventParams struct {
+	RouteID          uuid.UUID   `json:"route_id"`
+	Title            string      `json:"title"`
+	Description      string      `json:"description"`
+	StartsAt         time.Time   `json:"starts_at"`
+	MeetingPoint     interface{} `json:"meeting_point"`
+	MeetingPointName string      `json:"meeting_point_name"`
+	Capacity         *int32      `json:"capacity"`
+	Visibility       int16       `json:"visibility"`
+	ClubID           pgtype.UUID `json:"club_id"`
+	CancelledAt      *time.Time  `json:"cancelled_at"`
+	CancelReason     string      `json:"cancel_reason"`
+	ID               uuid.UUID   `json:"id"`
+}
+
+func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateEvent,
+		arg.RouteID,
+		arg.Title,
+		arg.Description,
+		arg.StartsAt,
+		arg.MeetingPoint,
+		arg.MeetingPointName,
+		arg.Capacity,
+		arg.Visibility,
+		arg.ClubID,
+		arg.CancelledAt,
+		arg.CancelReason,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateMutedNotificationTypes = `-- name: UpdateMutedNotificationTypes :execrows
+UPDATE users SET muted_notification_types = $2 WHERE id = $1
+`
+
+type UpdateMutedNotificationTypesParams struct {
+	ID                     uuid.UUID `json:"id"`
+	MutedNotificationTypes []string  `json:"muted_notification_types"`
+}
+
+func (q *Queries) UpdateMutedNotificationTypes(ctx context.Context, arg UpdateMutedNotificationTypesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateMutedNotificationTypes, arg.ID, arg.MutedNotificationTypes)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateRoute = `-- name: UpdateRoute :execrows
 UPDATE routes SET
     name = $1,
     description = $2,
@@ -679,8 +4605,10 @@ UPDATE routes SET
     first_point = ST_GeomFromEWKB($10),
     last_point = ST_GeomFromEWKB($11),
     polyline = ST_AsEncodedPolyline(ST_SimplifyPreserveTopology(ST_GeomFromEWKB($8), 0.0001)),
-    visibility = $12
-WHERE id = $13
+    visibility = $12,
+    club_id = $13,
+    version = version + 1
+WHERE id = $14 AND version = $15
 `
 
 type UpdateRouteParams struct {
@@ -696,11 +4624,13 @@ type UpdateRouteParams struct {
 	FirstPoint         interface{} `json:"first_point"`
 	LastPoint          interface{} `json:"last_point"`
 	Visibility         int16       `json:"visibility"`
+	ClubID             pgtype.UUID `json:"club_id"`
 	ID                 uuid.UUID   `json:"id"`
+	Version            int32       `json:"version"`
 }
 
-func (q *Queries) UpdateRoute(ctx context.Context, arg UpdateRouteParams) error {
-	_, err := q.db.Exec(ctx, updateRoute,
+func (q *Queries) UpdateRoute(ctx context.Context, arg UpdateRouteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRoute,
 		arg.Name,
 		arg.Description,
 		arg.HighlightedPhotoID,
@@ -713,11 +4643,187 @@ func (q *Queries) UpdateRoute(ctx context.Context, arg UpdateRouteParams) error
 		arg.FirstPoint,
 		arg.LastPoint,
 		arg.Visibility,
+		arg.ClubID,
 		arg.ID,
+		arg.Version,
 	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateRouteDifficulty = `-- name: UpdateRouteDifficulty :exec
+-- 難易度は路面の内訳と合わせて求め直すため、楽観的排他制御のバージョンは変えない
+UPDATE routes SET difficulty = $1::SMALLINT WHERE id = $2
+`
+
+type UpdateRouteDifficultyParams struct {
+	Difficulty int16     `json:"difficulty"`
+	ID         uuid.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateRouteDifficulty(ctx context.Context, arg UpdateRouteDifficultyParams) error {
+	_, err := q.db.Exec(ctx, updateRouteDifficulty, arg.Difficulty, arg.ID)
 	return err
 }
 
+const updateTour = `-- name: UpdateTour :execrows
+UPDATE tours
+SET name = $1,
+    description = $2,
+    visibility = $3,
+    club_id = $4,
+    updated_at = now()
+WHERE id = $5
+`
+
+type UpdateTourParams struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Visibility  int16       `json:"visibility"`
+	ClubID      pgtype.UUID `json:"club_id"`
+	ID          uuid.UUID   `json:"id"`
+}
+
+func (q *Queries) UpdateTour(ctx context.Context, arg UpdateTourParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTour,
+		arg.Name,
+		arg.Description,
+		arg.Visibility,
+		arg.ClubID,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTrip = `-- name: UpdateTrip :execrows
+UPDATE trips SET
+    name = $1,
+    description = $2,
+    visibility = $3,
+    highlighted_photo_id = $4,
+    path_geom = ST_GeomFromEWKB($5),
+    first_point = ST_GeomFromEWKB($6),
+    last_point = ST_GeomFromEWKB($7),
+    bbox_geom = ST_GeomFromEWKB($8),
+    distance = $9,
+    duration = $10,
+    moving_time = $11,
+    elevation_gain = $12,
+    elevation_loss = $13,
+    avg_speed = $14,
+    max_speed = $15,
+    avg_cad = $16,
+    max_cad = $17,
+    min_cad = $18,
+    max_hr = $19,
+    min_hr = $20,
+    avg_watts = $21,
+    max_watts = $22,
+    min_watts = $23,
+    avg_watts_estimated = $24,
+    avg_power_estimated = $25,
+    calories = $26,
+    is_gps = $27,
+    is_stationary = $28,
+    processed = $29,
+    departed_at = $30,
+    time_zone = $31,
+    utc_offset = $32,
+    activity_type_id = $33,
+    pace = $34,
+    moving_pace = $35
+WHERE id = $36 AND deleted_at IS NULL
+`
+
+type UpdateTripParams struct {
+	Name               string       `json:"name"`
+	Description        string       `json:"description"`
+	Visibility         int16        `json:"visibility"`
+	HighlightedPhotoID int64        `json:"highlighted_photo_id"`
+	PathGeom           *OrbGeometry `json:"path_geom"`
+	FirstPoint         *OrbGeometry `json:"first_point"`
+	LastPoint          *OrbGeometry `json:"last_point"`
+	BboxGeom           *OrbGeometry `json:"bbox_geom"`
+	Distance           *float64     `json:"distance"`
+	Duration           *int32       `json:"duration"`
+	MovingTime         *int32       `json:"moving_time"`
+	ElevationGain      *float64     `json:"elevation_gain"`
+	ElevationLoss      *float64     `json:"elevation_loss"`
+	AvgSpeed           *float64     `json:"avg_speed"`
+	MaxSpeed           *float64     `json:"max_speed"`
+	AvgCad             *float64     `json:"avg_cad"`
+	MaxCad             *float64     `json:"max_cad"`
+	MinCad             *float64     `json:"min_cad"`
+	MaxHr              *int32       `json:"max_hr"`
+	MinHr              *int32       `json:"min_hr"`
+	AvgWatts           *float64     `json:"avg_watts"`
+	MaxWatts           *float64     `json:"max_watts"`
+	MinWatts           *float64     `json:"min_watts"`
+	AvgWattsEstimated  *bool        `json:"avg_watts_estimated"`
+	AvgPowerEstimated  *float64     `json:"avg_power_estimated"`
+	Calories           *float64     `json:"calories"`
+	IsGps              bool         `json:"is_gps"`
+	IsStationary       bool         `json:"is_stationary"`
+	Processed          bool         `json:"processed"`
+	DepartedAt         *time.Time   `json:"departed_at"`
+	TimeZone           *string      `json:"time_zone"`
+	UtcOffset          *int32       `json:"utc_offset"`
+	ActivityTypeID     int32        `json:"activity_type_id"`
+	Pace               *float64     `json:"pace"`
+	MovingPace         *float64     `json:"moving_pace"`
+	ID                 uuid.UUID    `json:"id"`
+}
+
+func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTrip,
+		arg.Name,
+		arg.Description,
+		arg.Visibility,
+		arg.HighlightedPhotoID,
+		arg.PathGeom,
+		arg.FirstPoint,
+		arg.LastPoint,
+		arg.BboxGeom,
+		arg.Distance,
+		arg.Duration,
+		arg.MovingTime,
+		arg.ElevationGain,
+		arg.ElevationLoss,
+		arg.AvgSpeed,
+		arg.MaxSpeed,
+		arg.AvgCad,
+		arg.MaxCad,
+		arg.MinCad,
+		arg.MaxHr,
+		arg.MinHr,
+		arg.AvgWatts,
+		arg.MaxWatts,
+		arg.MinWatts,
+		arg.AvgWattsEstimated,
+		arg.AvgPowerEstimated,
+		arg.Calories,
+		arg.IsGps,
+		arg.IsStationary,
+		arg.Processed,
+		arg.DepartedAt,
+		arg.TimeZone,
+		arg.UtcOffset,
+		arg.ActivityTypeID,
+		arg.Pace,
+		arg.MovingPace,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users SET
     name = $1,
@@ -734,7 +4840,7 @@ UPDATE users SET
     highlighted_photo_id = $12,
     locale = $13
 WHERE id = $14
-RETURNING id, kratos_id, name, highlighted_photo_id, locale, created_at, updated_at, description, locality, administrative_area, country_code, postal_code, geom, first_name, last_name, email, has_set_location
+RETURNING id, kratos_id, name, highlighted_photo_id, locale, created_at, updated_at, description, locality, administrative_area, country_code, postal_code, geom, first_name, last_name, email, has_set_location, muted_notification_types
 `
 
 type UpdateUserParams struct {
@@ -790,6 +4896,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.LastName,
 		&i.Email,
 		&i.HasSetLocation,
+		&i.MutedNotificationTypes,
 	)
 	return i, err
 }
@@ -853,3 +4960,58 @@ func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfilePa
 	)
 	return err
 }
+
+const upsertClubMember = `-- name: UpsertClubMember :exec
+INSERT INTO club_members (club_id, user_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (club_id, user_id) DO UPDATE
+SET role = EXCLUDED.role
+`
+
+type UpsertClubMemberParams struct {
+	ClubID uuid.UUID `json:"club_id"`
+	UserID uuid.UUID `json:"user_id"`
+	Role   string    `json:"role"`
+}
+
+func (q *Queries) UpsertClubMember(ctx context.Context, arg UpsertClubMemberParams) error {
+	_, err := q.db.Exec(ctx, upsertClubMember, arg.ClubID, arg.UserID, arg.Role)
+	return err
+}
+
+const upsertEventRSVP = `-- name: UpsertEventRSVP :exec
+INSERT INTO event_rsvps (event_id, user_id, status)
+VALUES ($1, $2, $3)
+ON CONFLICT (event_id, user_id) DO UPDATE
+SET status = EXCLUDED.status,
+    updated_at = now()
+`
+
+type UpsertEventRSVPParams struct {
+	EventID uuid.UUID `json:"event_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	Status  string    `json:"status"`
+}
+
+func (q *Queries) UpsertEventRSVP(ctx context.Context, arg UpsertEventRSVPParams) error {
+	_, err := q.db.Exec(ctx, upsertEventRSVP, arg.EventID, arg.UserID, arg.Status)
+	return err
+}
+
+const upsertRouteSearchDocument = `-- name: UpsertRouteSearchDocument :exec
+INSERT INTO route_search_documents (route_id, search_text)
+VALUES ($1, $2)
+ON CONFLICT (route_id) DO UPDATE SET
+    search_text = EXCLUDED.search_text,
+    updated_at = now()
+`
+
+type UpsertRouteSearchDocumentParams struct {
+	RouteID    uuid.UUID `json:"route_id"`
+	SearchText string    `json:"search_text"`
+}
+
+func (q *Queries) UpsertRouteSearchDocument(ctx context.Context, arg UpsertRouteSearchDocumentParams) error {
+	_, err := q.db.Exec(ctx, upsertRouteSearchDocument, arg.RouteID, arg.SearchText)
+	return err
+}
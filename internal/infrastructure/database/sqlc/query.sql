@@ -77,12 +77,14 @@ INSERT INTO routes (
     first_point,
     last_point,
     polyline,
-    visibility
+    visibility,
+    forked_from_route_id,
+    club_id
 ) VALUES (
-    sqlc.arg(id), sqlc.arg(user_id), sqlc.arg(name), sqlc.arg(description), sqlc.arg(highlighted_photo_id), sqlc.arg(distance), sqlc.arg(duration), sqlc.arg(elevation_gain), sqlc.arg(elevation_loss), ST_GeomFromEWKB(sqlc.arg(path_geom)), ST_GeomFromEWKB(sqlc.arg(bbox)), ST_GeomFromEWKB(sqlc.arg(first_point)), ST_GeomFromEWKB(sqlc.arg(last_point)), ST_AsEncodedPolyline(ST_SimplifyPreserveTopology(ST_GeomFromEWKB(sqlc.arg(path_geom)), 0.0001)), sqlc.arg(visibility)
+    sqlc.arg(id), sqlc.arg(user_id), sqlc.arg(name), sqlc.arg(description), sqlc.arg(highlighted_photo_id), sqlc.arg(distance), sqlc.arg(duration), sqlc.arg(elevation_gain), sqlc.arg(elevation_loss), ST_GeomFromEWKB(sqlc.arg(path_geom)), ST_GeomFromEWKB(sqlc.arg(bbox)), ST_GeomFromEWKB(sqlc.arg(first_point)), ST_GeomFromEWKB(sqlc.arg(last_point)), ST_AsEncodedPolyline(ST_SimplifyPreserveTopology(ST_GeomFromEWKB(sqlc.arg(path_geom)), 0.0001)), sqlc.arg(visibility), sqlc.narg(forked_from_route_id), sqlc.narg(club_id)
 );
 
--- name: UpdateRoute :exec
+-- name: UpdateRoute :execrows
 UPDATE routes SET
     name = sqlc.arg(name),
     description = sqlc.arg(description),
@@ -96,8 +98,10 @@ UPDATE routes SET
     first_point = ST_GeomFromEWKB(sqlc.arg(first_point)),
     last_point = ST_GeomFromEWKB(sqlc.arg(last_point)),
     polyline = ST_AsEncodedPolyline(ST_SimplifyPreserveTopology(ST_GeomFromEWKB(sqlc.arg(path_geom)), 0.0001)),
-    visibility = sqlc.arg(visibility)
-WHERE id = sqlc.arg(id);
+    visibility = sqlc.arg(visibility),
+    club_id = sqlc.narg(club_id),
+    version = version + 1
+WHERE id = sqlc.arg(id) AND version = sqlc.arg(version);
 
 -- name: GetRouteByID :one
 SELECT * FROM routes WHERE id = $1;
@@ -106,59 +110,211 @@ SELECT * FROM routes WHERE id = $1;
 SELECT * FROM routes WHERE user_id = $1;
 
 -- name: SearchRoutesByUserID :many
-SELECT * FROM routes
-WHERE user_id = sqlc.arg(user_id)
-  AND (cardinality(sqlc.arg(name_keywords)::TEXT[]) = 0 OR name ILIKE ANY(sqlc.arg(name_keywords)::TEXT[]))
-  AND (sqlc.arg(visibility)::SMALLINT < 0 OR visibility = sqlc.arg(visibility)::SMALLINT)
-  AND (sqlc.arg(min_distance)::DOUBLE PRECISION < 0 OR distance >= sqlc.arg(min_distance)::DOUBLE PRECISION)
-  AND (sqlc.arg(max_distance)::DOUBLE PRECISION < 0 OR distance <= sqlc.arg(max_distance)::DOUBLE PRECISION);
-  
+SELECT
+  ranked_routes.id,
+  ranked_routes.user_id,
+  ranked_routes.name,
+  ranked_routes.description,
+  ranked_routes.highlighted_photo_id,
+  ranked_routes.distance,
+  ranked_routes.duration,
+  ranked_routes.elevation_gain,
+  ranked_routes.elevation_loss,
+  ranked_routes.path_geom,
+  ranked_routes.bbox,
+  ranked_routes.first_point,
+  ranked_routes.last_point,
+  ranked_routes.polyline,
+  ranked_routes.created_at,
+  ranked_routes.updated_at,
+  ranked_routes.visibility,
+  ranked_routes.version,
+  ranked_routes.forked_from_route_id,
+  ranked_routes.difficulty,
+  ranked_routes.club_id,
+  ranked_routes.user_name,
+  ranked_routes.sort_key
+FROM (
+    SELECT routes.*, users.name AS user_name,
+      CASE sqlc.arg(sort)::TEXT
+        WHEN 'most_liked' THEN (SELECT COUNT(*) FROM route_likes WHERE route_likes.route_id = routes.id)::DOUBLE PRECISION
+        WHEN 'longest' THEN routes.distance
+        WHEN 'hilliest' THEN CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END
+        -- 全文検索の一致度と、ルート名のあいまい一致度の合計
+        WHEN 'relevance' THEN COALESCE(ts_rank_cd(route_search_documents.search_vector, plainto_tsquery('simple', sqlc.arg(search_query)::TEXT)), 0)
+                              + word_similarity(sqlc.arg(keyword)::TEXT, routes.name)
+        -- 降順に並べるため、コレクション内の位置の符号を反転する
+        WHEN 'position' THEN -collection_routes.position
+        ELSE EXTRACT(EPOCH FROM routes.created_at)::DOUBLE PRECISION
+      END::DOUBLE PRECISION AS sort_key
+    FROM routes
+    INNER JOIN users ON routes.user_id = users.id
+    LEFT JOIN route_search_documents ON route_search_documents.route_id = routes.id
+    LEFT JOIN collection_routes ON collection_routes.collection_id = sqlc.narg(collection_id)::UUID
+                               AND collection_routes.route_id = routes.id
+    -- コレクション指定時は、他のユーザーのルートも含めてコレクション内の閲覧できるルートを対象にする
+    WHERE ((sqlc.narg(collection_id)::UUID IS NULL AND routes.user_id = sqlc.arg(user_id))
+           OR (collection_routes.route_id IS NOT NULL
+               AND (routes.user_id = sqlc.arg(user_id)
+                    OR routes.visibility = 1
+                    OR (routes.visibility = 3 AND routes.club_id = ANY(sqlc.arg(viewer_club_ids)::UUID[])))))
+    AND (cardinality(sqlc.arg(name_keywords)::TEXT[]) = 0
+         OR route_search_documents.search_vector @@ plainto_tsquery('simple', sqlc.arg(search_query)::TEXT)
+         OR routes.name ILIKE ANY(sqlc.arg(name_keywords)::TEXT[])
+         OR sqlc.arg(keyword)::TEXT <% routes.name)
+    AND (sqlc.arg(visibility)::SMALLINT < 0 OR routes.visibility = sqlc.arg(visibility)::SMALLINT)
+    AND (sqlc.arg(min_distance)::DOUBLE PRECISION < 0 OR routes.distance >= sqlc.arg(min_distance)::DOUBLE PRECISION)
+    AND (sqlc.arg(max_distance)::DOUBLE PRECISION < 0 OR routes.distance <= sqlc.arg(max_distance)::DOUBLE PRECISION)
+    AND (sqlc.arg(min_elevation_gain)::DOUBLE PRECISION < 0 OR routes.elevation_gain >= sqlc.arg(min_elevation_gain)::DOUBLE PRECISION)
+    AND (sqlc.arg(max_elevation_gain)::DOUBLE PRECISION < 0 OR routes.elevation_gain <= sqlc.arg(max_elevation_gain)::DOUBLE PRECISION)
+    AND (sqlc.arg(min_duration)::DOUBLE PRECISION < 0 OR routes.duration >= sqlc.arg(min_duration)::DOUBLE PRECISION)
+    AND (sqlc.arg(max_duration)::DOUBLE PRECISION < 0 OR routes.duration <= sqlc.arg(max_duration)::DOUBLE PRECISION)
+    AND (sqlc.arg(author)::TEXT = '' OR users.name ILIKE sqlc.arg(author)::TEXT ESCAPE '\')
+    AND (sqlc.arg(min_climbing_ratio)::DOUBLE PRECISION < 0
+         OR (CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END) >= sqlc.arg(min_climbing_ratio)::DOUBLE PRECISION)
+    AND (sqlc.arg(max_climbing_ratio)::DOUBLE PRECISION < 0
+         OR (CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END) <= sqlc.arg(max_climbing_ratio)::DOUBLE PRECISION)
+    -- 未舗装（gravel, dirt）の割合(%)。路面の内訳がまだないルートは含めない
+    AND (sqlc.arg(max_unpaved_percentage)::DOUBLE PRECISION < 0
+         OR (SELECT SUM(CASE WHEN route_surfaces.category IN ('gravel', 'dirt') THEN route_surfaces.distance ELSE 0 END) * 100
+                    / NULLIF(SUM(route_surfaces.distance), 0)
+             FROM route_surfaces
+             WHERE route_surfaces.route_id = routes.id AND route_surfaces.kind = 'surface') <= sqlc.arg(max_unpaved_percentage)::DOUBLE PRECISION)
+    -- 出発地点か目的地の市区町村・都道府県名の前方一致。地名がまだないルートは含めない
+    AND (sqlc.arg(area)::TEXT = ''
+         OR EXISTS (SELECT 1 FROM route_places
+                    WHERE route_places.route_id = routes.id
+                      AND (route_places.locality LIKE sqlc.arg(area)::TEXT OR route_places.administrative_area LIKE sqlc.arg(area)::TEXT)))
+    -- タグでの絞り込み
+    AND (sqlc.arg(tag)::TEXT = ''
+         OR EXISTS (SELECT 1 FROM route_tags WHERE route_tags.route_id = routes.id AND route_tags.tag = sqlc.arg(tag)::TEXT))
+) AS ranked_routes
+WHERE NOT sqlc.arg(has_cursor)::BOOLEAN
+   OR (ranked_routes.sort_key, ranked_routes.id) < (sqlc.arg(cursor_sort_key)::DOUBLE PRECISION, sqlc.arg(cursor_id)::UUID)
+ORDER BY ranked_routes.sort_key DESC, ranked_routes.id DESC
+LIMIT sqlc.arg(limit_count)::INT;
 
 -- name: ExploreRoutes :many
 SELECT
-  filtered_routes.id,
-  filtered_routes.user_id,
-  filtered_routes.name,
-  filtered_routes.description,
-  filtered_routes.highlighted_photo_id,
-  filtered_routes.distance,
-  filtered_routes.duration,
-  filtered_routes.elevation_gain,
-  filtered_routes.elevation_loss,
-  filtered_routes.path_geom,
-  filtered_routes.bbox,
-  filtered_routes.first_point,
-  filtered_routes.last_point,
-  filtered_routes.polyline,
-  filtered_routes.created_at,
-  filtered_routes.updated_at,
-  filtered_routes.visibility,
-  filtered_routes.total_count,
-  users.name AS user_name
+  ranked_routes.id,
+  ranked_routes.user_id,
+  ranked_routes.name,
+  ranked_routes.description,
+  ranked_routes.highlighted_photo_id,
+  ranked_routes.distance,
+  ranked_routes.duration,
+  ranked_routes.elevation_gain,
+  ranked_routes.elevation_loss,
+  ranked_routes.path_geom,
+  ranked_routes.bbox,
+  ranked_routes.first_point,
+  ranked_routes.last_point,
+  ranked_routes.polyline,
+  ranked_routes.created_at,
+  ranked_routes.updated_at,
+  ranked_routes.visibility,
+  ranked_routes.version,
+  ranked_routes.forked_from_route_id,
+  ranked_routes.difficulty,
+  ranked_routes.user_name,
+  ranked_routes.sort_key
 FROM (
-    SELECT *, COUNT(*) OVER() AS total_count  
+    SELECT routes.*, users.name AS user_name,
+      CASE sqlc.arg(sort)::TEXT
+        -- 近い順も降順で扱えるよう、距離の符号を反転する
+        WHEN 'nearest' THEN -ST_Distance(routes.first_point::geography, ST_GeomFromEWKB(sqlc.arg(location))::geography)
+        WHEN 'most_liked' THEN (SELECT COUNT(*) FROM route_likes WHERE route_likes.route_id = routes.id)::DOUBLE PRECISION
+        WHEN 'longest' THEN routes.distance
+        WHEN 'hilliest' THEN CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END
+        -- 全文検索の一致度と、ルート名のあいまい一致度の合計
+        WHEN 'relevance' THEN COALESCE(ts_rank_cd(route_search_documents.search_vector, plainto_tsquery('simple', sqlc.arg(search_query)::TEXT)), 0)
+                              + word_similarity(sqlc.arg(keyword)::TEXT, routes.name)
+        ELSE EXTRACT(EPOCH FROM routes.created_at)::DOUBLE PRECISION
+      END::DOUBLE PRECISION AS sort_key
     FROM routes
-    WHERE visibility = 1
+    INNER JOIN users ON routes.user_id = users.id
+    LEFT JOIN route_search_documents ON route_search_documents.route_id = routes.id
+    WHERE routes.visibility = 1
     AND (sqlc.arg(radius_m)::float8 < 0 OR ST_DWithin(
         routes.first_point::geography,
         ST_GeomFromEWKB(sqlc.arg(location))::geography,
         sqlc.arg(radius_m)::float8
     ))
-    AND (cardinality(sqlc.arg(name_keywords)::TEXT[]) = 0 OR name ILIKE ANY(sqlc.arg(name_keywords)::TEXT[]))
-    AND (sqlc.arg(min_distance)::DOUBLE PRECISION < 0 OR distance >= sqlc.arg(min_distance)::DOUBLE PRECISION)
-    AND (sqlc.arg(max_distance)::DOUBLE PRECISION < 0 OR distance <= sqlc.arg(max_distance)::DOUBLE PRECISION)
-) AS filtered_routes
-INNER JOIN users ON filtered_routes.user_id = users.id
+    AND (cardinality(sqlc.arg(name_keywords)::TEXT[]) = 0
+         OR route_search_documents.search_vector @@ plainto_tsquery('simple', sqlc.arg(search_query)::TEXT)
+         OR routes.name ILIKE ANY(sqlc.arg(name_keywords)::TEXT[])
+         OR sqlc.arg(keyword)::TEXT <% routes.name)
+    AND (sqlc.arg(min_distance)::DOUBLE PRECISION < 0 OR routes.distance >= sqlc.arg(min_distance)::DOUBLE PRECISION)
+    AND (sqlc.arg(max_distance)::DOUBLE PRECISION < 0 OR routes.distance <= sqlc.arg(max_distance)::DOUBLE PRECISION)
+    AND (sqlc.arg(min_elevation_gain)::DOUBLE PRECISION < 0 OR routes.elevation_gain >= sqlc.arg(min_elevation_gain)::DOUBLE PRECISION)
+    AND (sqlc.arg(max_elevation_gain)::DOUBLE PRECISION < 0 OR routes.elevation_gain <= sqlc.arg(max_elevation_gain)::DOUBLE PRECISION)
+    AND (sqlc.arg(min_duration)::DOUBLE PRECISION < 0 OR routes.duration >= sqlc.arg(min_duration)::DOUBLE PRECISION)
+    AND (sqlc.arg(max_duration)::DOUBLE PRECISION < 0 OR routes.duration <= sqlc.arg(max_duration)::DOUBLE PRECISION)
+    AND (sqlc.arg(author)::TEXT = '' OR users.name ILIKE sqlc.arg(author)::TEXT ESCAPE '\')
+    AND (sqlc.arg(min_climbing_ratio)::DOUBLE PRECISION < 0
+         OR (CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END) >= sqlc.arg(min_climbing_ratio)::DOUBLE PRECISION)
+    AND (sqlc.arg(max_climbing_ratio)::DOUBLE PRECISION < 0
+         OR (CASE WHEN routes.distance > 0 THEN routes.elevation_gain * 1000 / routes.distance ELSE 0 END) <= sqlc.arg(max_climbing_ratio)::DOUBLE PRECISION)
+    -- 未舗装（gravel, dirt）の割合(%)。路面の内訳がまだないルートは含めない
+    AND (sqlc.arg(max_unpaved_percentage)::DOUBLE PRECISION < 0
+         OR (SELECT SUM(CASE WHEN route_surfaces.category IN ('gravel', 'dirt') THEN route_surfaces.distance ELSE 0 END) * 100
+                    / NULLIF(SUM(route_surfaces.distance), 0)
+             FROM route_surfaces
+             WHERE route_surfaces.route_id = routes.id AND route_surfaces.kind = 'surface') <= sqlc.arg(max_unpaved_percentage)::DOUBLE PRECISION)
+    -- 出発地点か目的地の市区町村・都道府県名の前方一致。地名がまだないルートは含めない
+    AND (sqlc.arg(area)::TEXT = ''
+         OR EXISTS (SELECT 1 FROM route_places
+                    WHERE route_places.route_id = routes.id
+                      AND (route_places.locality LIKE sqlc.arg(area)::TEXT OR route_places.administrative_area LIKE sqlc.arg(area)::TEXT)))
+    -- 難易度の範囲（0は指定なし）。難易度をまだ求めていないルートは含めない
+    AND (sqlc.arg(min_difficulty)::SMALLINT = 0 OR routes.difficulty >= sqlc.arg(min_difficulty)::SMALLINT)
+    AND (sqlc.arg(max_difficulty)::SMALLINT = 0 OR routes.difficulty <= sqlc.arg(max_difficulty)::SMALLINT)
+) AS ranked_routes
+WHERE NOT sqlc.arg(has_cursor)::BOOLEAN
+   OR (ranked_routes.sort_key, ranked_routes.id) < (sqlc.arg(cursor_sort_key)::DOUBLE PRECISION, sqlc.arg(cursor_id)::UUID)
+ORDER BY ranked_routes.sort_key DESC, ranked_routes.id DESC
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: FindSimilarRouteCandidates :many
+SELECT
+  routes.id,
+  routes.user_id,
+  routes.name,
+  routes.description,
+  routes.highlighted_photo_id,
+  routes.distance,
+  routes.duration,
+  routes.elevation_gain,
+  routes.elevation_loss,
+  routes.path_geom,
+  routes.bbox,
+  routes.first_point,
+  routes.last_point,
+  routes.polyline,
+  routes.created_at,
+  routes.updated_at,
+  routes.visibility,
+  routes.version,
+  routes.forked_from_route_id,
+  routes.difficulty,
+  users.name AS user_name
+FROM routes
+INNER JOIN users ON routes.user_id = users.id
+WHERE routes.visibility = 1
+  AND routes.id <> sqlc.arg(route_id)
+  AND routes.bbox && ST_Expand(ST_GeomFromEWKB(sqlc.arg(bbox)), sqlc.arg(padding_deg)::float8)
+  AND routes.distance BETWEEN sqlc.arg(min_distance)::DOUBLE PRECISION AND sqlc.arg(max_distance)::DOUBLE PRECISION
 ORDER BY
-  CASE WHEN sqlc.arg(radius_m)::float8 < 0 THEN 0
-       ELSE ST_Distance(filtered_routes.first_point::geography, ST_GeomFromEWKB(sqlc.arg(location))::geography)
-  END
-LIMIT sqlc.arg(limit_count)::INT
-OFFSET sqlc.arg(offset_count)::INT;
+  ST_Distance(routes.first_point::geography, ST_GeomFromEWKB(sqlc.arg(first_point))::geography),
+  routes.id
+LIMIT sqlc.arg(limit_count)::INT;
 
 -- name: CountRoutesByUserID :one
 SELECT COUNT(*) FROM routes WHERE user_id = $1;
 
+-- name: CountRouteForks :one
+SELECT COUNT(*) FROM routes WHERE forked_from_route_id = $1;
+
 -- name: DeleteRoute :one
 DELETE FROM routes WHERE id = $1 RETURNING id;
 
@@ -181,6 +337,156 @@ INSERT INTO course_points (
     sqlc.arg(id), sqlc.arg(route_id), sqlc.arg(step_order), sqlc.arg(seg_dist_m), sqlc.arg(cum_dist_m), sqlc.arg(duration), sqlc.arg(instruction), sqlc.arg(road_name), sqlc.arg(maneuver_type), sqlc.arg(modifier), ST_GeomFromEWKB(sqlc.arg(location)), sqlc.arg(bearing_before), sqlc.arg(bearing_after)
 );
 
+-- name: CreateRouteVersion :exec
+INSERT INTO route_versions (
+    id,
+    route_id,
+    version_number,
+    user_id,
+    name,
+    description,
+    highlighted_photo_id,
+    distance,
+    duration,
+    elevation_gain,
+    elevation_loss,
+    path_geom,
+    first_point,
+    last_point,
+    visibility,
+    course_points,
+    waypoints
+) VALUES (
+    sqlc.arg(id), sqlc.arg(route_id), (SELECT COALESCE(MAX(version_number), 0) + 1 FROM route_versions WHERE route_id = sqlc.arg(route_id)), sqlc.arg(user_id), sqlc.arg(name), sqlc.arg(description), sqlc.arg(highlighted_photo_id), sqlc.arg(distance), sqlc.arg(duration), sqlc.arg(elevation_gain), sqlc.arg(elevation_loss), ST_GeomFromEWKB(sqlc.arg(path_geom)), ST_GeomFromEWKB(sqlc.arg(first_point)), ST_GeomFromEWKB(sqlc.arg(last_point)), sqlc.arg(visibility), sqlc.arg(course_points), sqlc.arg(waypoints)
+);
+
+-- name: GetRouteVersionsByRouteID :many
+SELECT * FROM route_versions WHERE route_id = $1 ORDER BY version_number DESC;
+
+-- name: GetRouteVersion :one
+SELECT * FROM route_versions WHERE route_id = $1 AND version_number = $2;
+
+-- name: UpsertRouteSearchDocument :exec
+INSERT INTO route_search_documents (route_id, search_text)
+VALUES (sqlc.arg(route_id), sqlc.arg(search_text))
+ON CONFLICT (route_id) DO UPDATE SET
+    search_text = EXCLUDED.search_text,
+    updated_at = now();
+
+-- name: UpdateRouteDifficulty :exec
+-- 難易度は路面の内訳と合わせて求め直すため、楽観的排他制御のバージョンは変えない
+UPDATE routes SET difficulty = sqlc.arg(difficulty)::SMALLINT WHERE id = sqlc.arg(id);
+
+-- name: GetRouteSurfacesByRouteID :many
+SELECT * FROM route_surfaces WHERE route_id = $1 ORDER BY kind ASC, distance DESC, category ASC;
+
+-- name: DeleteRouteSurfaces :exec
+DELETE FROM route_surfaces WHERE route_id = $1;
+
+-- name: CreateRouteSurfaces :exec
+-- 経路を25m以下の区間に分け、区間の中点から20m以内で最も近い道路の舗装と種類ごとに距離を集計する
+-- 近くに道路がない区間はunknownにする。surfaceタグのない道路は、未舗装になりやすい種類を除き舗装路とみなす
+INSERT INTO route_surfaces (route_id, kind, category, distance)
+WITH pieces AS (
+    SELECT ST_Length(segments.geom::geography) AS length,
+           ST_LineInterpolatePoint(segments.geom, 0.5) AS midpoint
+    FROM routes,
+         LATERAL ST_DumpSegments(ST_Segmentize(routes.path_geom::geography, 25)::geometry) AS segments
+    WHERE routes.id = sqlc.arg(route_id)
+),
+classified AS (
+    SELECT pieces.length,
+      CASE
+        WHEN road.highway IS NULL THEN 'unknown'
+        WHEN road.surface = ANY(sqlc.arg(paved_surfaces)::TEXT[]) THEN 'paved'
+        WHEN road.surface = ANY(sqlc.arg(gravel_surfaces)::TEXT[]) THEN 'gravel'
+        WHEN road.surface = ANY(sqlc.arg(dirt_surfaces)::TEXT[]) THEN 'dirt'
+        WHEN road.surface IS NULL AND NOT road.highway = ANY(sqlc.arg(unpaved_highways)::TEXT[]) THEN 'paved'
+        ELSE 'unknown'
+      END AS surface,
+      COALESCE(regexp_replace(road.highway, '_link$', ''), 'unknown') AS road_class
+    FROM pieces
+    LEFT JOIN LATERAL (
+        SELECT road_edges.highway, road_edges.surface
+        FROM road_edges
+        -- 度単位の範囲でインデックスを使って絞り込んでから、メートルで判定する
+        WHERE ST_DWithin(road_edges.geom, pieces.midpoint, 0.0005)
+          AND ST_DWithin(road_edges.geom::geography, pieces.midpoint::geography, 20)
+        ORDER BY road_edges.geom <-> pieces.midpoint
+        LIMIT 1
+    ) AS road ON TRUE
+)
+SELECT sqlc.arg(route_id), 'surface', surface, SUM(length) FROM classified GROUP BY surface
+UNION ALL
+SELECT sqlc.arg(route_id), 'road_class', road_class, SUM(length) FROM classified GROUP BY road_class;
+
+-- name: ListRouteGrades :many
+-- 経路を25m以下の区間に分け、区間の中点から20m以内で最も近い道路の勾配を経路の進む向きに合わせて返す
+-- 道路網は区間の端から端までの上り・下りしか持たないため、道路の区間内の勾配は一定とみなす。近くに道路がない区間の勾配はNULL
+WITH pieces AS (
+    SELECT segments.path[1] AS seq,
+           ST_Length(segments.geom::geography) AS length,
+           ST_StartPoint(segments.geom) AS start_point,
+           ST_EndPoint(segments.geom) AS end_point,
+           ST_LineInterpolatePoint(segments.geom, 0.5) AS midpoint
+    FROM routes,
+         LATERAL ST_DumpSegments(ST_Segmentize(routes.path_geom::geography, 25)::geometry) AS segments
+    WHERE routes.id = sqlc.arg(route_id)
+)
+SELECT pieces.length::DOUBLE PRECISION AS length,
+       (CASE WHEN road.length_m > 0
+             THEN (road.elevation_gain - road.elevation_loss) / road.length_m * 100 * road.direction
+        END)::DOUBLE PRECISION AS gradient
+FROM pieces
+LEFT JOIN LATERAL (
+    SELECT road_edges.length_m, road_edges.elevation_gain, road_edges.elevation_loss,
+           -- 道路の区間のsource→targetの向きに進む場合は1、逆向きは-1
+           CASE WHEN ST_LineLocatePoint(road_edges.geom, pieces.end_point) >= ST_LineLocatePoint(road_edges.geom, pieces.start_point)
+                THEN 1 ELSE -1 END AS direction
+    FROM road_edges
+    WHERE ST_DWithin(road_edges.geom, pieces.midpoint, 0.0005)
+      AND ST_DWithin(road_edges.geom::geography, pieces.midpoint::geography, 20)
+    ORDER BY road_edges.geom <-> pieces.midpoint
+    LIMIT 1
+) AS road ON TRUE
+ORDER BY pieces.seq;
+
+-- name: GetRoutePlacesByRouteID :many
+SELECT * FROM route_places WHERE route_id = $1;
+
+-- name: DeleteRoutePlaces :exec
+DELETE FROM route_places WHERE route_id = $1;
+
+-- name: CreateRoutePlace :exec
+INSERT INTO route_places (route_id, kind, locality, administrative_area, country_code)
+VALUES (sqlc.arg(route_id), sqlc.arg(kind), sqlc.arg(locality), sqlc.arg(administrative_area), sqlc.arg(country_code));
+
+-- name: ListRouteTagsByRouteIDs :many
+SELECT * FROM route_tags
+WHERE route_id = ANY(sqlc.arg(route_ids)::UUID[])
+ORDER BY route_id, position;
+
+-- name: DeleteRouteTags :exec
+DELETE FROM route_tags WHERE route_id = $1;
+
+-- name: CreateRouteTag :exec
+INSERT INTO route_tags (route_id, tag, position)
+VALUES (sqlc.arg(route_id), sqlc.arg(tag), sqlc.arg(position));
+
+-- name: ListRouteIDs :many
+SELECT id FROM routes ORDER BY id;
+
+-- name: ListRouteSearchSources :many
+SELECT
+  routes.id,
+  routes.name,
+  routes.description,
+  COALESCE(string_agg(course_points.road_name, ' ' ORDER BY course_points.step_order), '')::TEXT AS road_names
+FROM routes
+LEFT JOIN course_points ON course_points.route_id = routes.id
+GROUP BY routes.id
+ORDER BY routes.id;
+
 -- name: GetCoursePointsByRouteID :many
 SELECT * FROM course_points WHERE route_id = $1 ORDER BY step_order ASC;
 
@@ -207,3 +513,690 @@ DELETE FROM waypoints WHERE id = $1;
 
 -- name: DeleteWaypointsByRouteID :exec
 DELETE FROM waypoints WHERE route_id = $1;
+
+-- name: DeleteRoadEdges :exec
+DELETE FROM road_edges;
+
+-- name: InsertRoadEdges :exec
+INSERT INTO road_edges (
+    osm_way_id,
+    source_node_id,
+    target_node_id,
+    name,
+    highway,
+    surface,
+    bike_lane,
+    oneway,
+    length_m,
+    elevation_gain,
+    elevation_loss,
+    geom
+)
+SELECT
+    osm_way_id,
+    source_node_id,
+    target_node_id,
+    NULLIF(name, ''),
+    highway,
+    NULLIF(surface, ''),
+    bike_lane,
+    oneway,
+    length_m,
+    elevation_gain,
+    elevation_loss,
+    ST_GeomFromText(geom, 4326)
+FROM unnest(
+    sqlc.arg(osm_way_ids)::BIGINT[],
+    sqlc.arg(source_node_ids)::BIGINT[],
+    sqlc.arg(target_node_ids)::BIGINT[],
+    sqlc.arg(names)::TEXT[],
+    sqlc.arg(highways)::TEXT[],
+    sqlc.arg(surfaces)::TEXT[],
+    sqlc.arg(bike_lanes)::BOOLEAN[],
+    sqlc.arg(oneways)::BOOLEAN[],
+    sqlc.arg(lengths_m)::DOUBLE PRECISION[],
+    sqlc.arg(elevation_gains)::DOUBLE PRECISION[],
+    sqlc.arg(elevation_losses)::DOUBLE PRECISION[],
+    sqlc.arg(geoms)::TEXT[]
+) AS t(osm_way_id, source_node_id, target_node_id, name, highway, surface, bike_lane, oneway, length_m, elevation_gain, elevation_loss, geom);
+
+-- name: ListRoadEdgesInBBox :many
+SELECT * FROM road_edges
+WHERE geom && ST_MakeEnvelope(sqlc.arg(min_lon)::DOUBLE PRECISION, sqlc.arg(min_lat)::DOUBLE PRECISION, sqlc.arg(max_lon)::DOUBLE PRECISION, sqlc.arg(max_lat)::DOUBLE PRECISION, 4326)
+ORDER BY id
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: DeletePOIs :exec
+DELETE FROM pois;
+
+-- name: InsertPOIs :exec
+INSERT INTO pois (osm_type, osm_id, category, name, location)
+SELECT osm_type, osm_id, category, NULLIF(name, ''), ST_GeomFromText(location, 4326)
+FROM unnest(
+    sqlc.arg(osm_types)::TEXT[],
+    sqlc.arg(osm_ids)::BIGINT[],
+    sqlc.arg(categories)::TEXT[],
+    sqlc.arg(names)::TEXT[],
+    sqlc.arg(locations)::TEXT[]
+) AS t(osm_type, osm_id, category, name, location);
+
+-- name: GetPOIByID :one
+SELECT * FROM pois WHERE id = $1;
+
+-- name: ListPOIsAlongRoute :many
+-- 経路上の位置はPOIを経路に射影した地点の始点からの距離。周回ルートでは最も近い地点を使う
+SELECT pois.*,
+    (ST_LineLocatePoint(routes.path_geom, pois.location) * ST_Length(routes.path_geom::geography))::DOUBLE PRECISION AS cum_dist_m,
+    ST_Distance(pois.location::geography, routes.path_geom::geography)::DOUBLE PRECISION AS offset_m
+FROM routes
+INNER JOIN pois ON ST_DWithin(pois.location::geography, routes.path_geom::geography, sqlc.arg(buffer_m)::DOUBLE PRECISION)
+WHERE routes.id = sqlc.arg(route_id)
+  AND (cardinality(sqlc.arg(categories)::TEXT[]) = 0 OR pois.category = ANY(sqlc.arg(categories)::TEXT[]))
+  AND (NOT sqlc.arg(has_cursor)::BOOLEAN
+       OR ((ST_LineLocatePoint(routes.path_geom, pois.location) * ST_Length(routes.path_geom::geography))::DOUBLE PRECISION, pois.id) > (sqlc.arg(cursor_cum_dist_m)::DOUBLE PRECISION, sqlc.arg(cursor_id)::BIGINT))
+ORDER BY cum_dist_m, pois.id
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: DeleteAdminBoundaries :exec
+DELETE FROM admin_boundaries;
+
+-- name: CreateAdminBoundary :exec
+INSERT INTO admin_boundaries (name, admin_level, country_code, geom)
+VALUES (sqlc.arg(name), sqlc.arg(admin_level), sqlc.arg(country_code), ST_Multi(ST_GeomFromText(sqlc.arg(geom)::TEXT, 4326)));
+
+-- name: ListAdminAreasContaining :many
+SELECT name, admin_level, country_code
+FROM admin_boundaries
+WHERE ST_Covers(geom, ST_GeomFromText(sqlc.arg(location)::TEXT, 4326))
+ORDER BY admin_level, id;
+
+-- name: GetTripByID :one
+SELECT * FROM trips WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListTripsByIDs :many
+SELECT * FROM trips WHERE id = ANY(sqlc.arg(ids)::UUID[]) AND deleted_at IS NULL;
+
+-- name: GetTripsByKratosID :many
+SELECT trips.* FROM trips
+INNER JOIN users ON trips.user_id = users.id
+WHERE users.kratos_id = $1 AND trips.deleted_at IS NULL
+ORDER BY trips.created_at DESC, trips.id DESC;
+
+-- name: ListTripsByUserID :many
+SELECT *, EXTRACT(EPOCH FROM created_at)::DOUBLE PRECISION AS sort_key FROM trips
+WHERE user_id = sqlc.arg(user_id)
+  AND deleted_at IS NULL
+  AND (NOT sqlc.arg(has_cursor)::BOOLEAN
+       OR (EXTRACT(EPOCH FROM created_at)::DOUBLE PRECISION, id) < (sqlc.arg(cursor_sort_key)::DOUBLE PRECISION, sqlc.arg(cursor_id)::UUID))
+ORDER BY EXTRACT(EPOCH FROM created_at)::DOUBLE PRECISION DESC, id DESC
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: ListRideStatsByUserID :many
+SELECT
+    distance::DOUBLE PRECISION AS distance,
+    moving_time::INTEGER AS moving_time,
+    COALESCE(elevation_gain, 0)::DOUBLE PRECISION AS elevation_gain,
+    COALESCE(elevation_loss, 0)::DOUBLE PRECISION AS elevation_loss
+FROM trips
+WHERE user_id = sqlc.arg(user_id)
+  AND deleted_at IS NULL
+  AND NOT is_stationary
+  AND distance IS NOT NULL
+  AND moving_time IS NOT NULL
+ORDER BY COALESCE(departed_at, created_at) DESC, id DESC
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: CountTripsByUserID :one
+SELECT COUNT(*) FROM trips WHERE user_id = $1 AND deleted_at IS NULL;
+
+-- name: CreateTrip :exec
+INSERT INTO trips (
+    id,
+    user_id,
+    name,
+    description,
+    visibility,
+    highlighted_photo_id,
+    path_geom,
+    first_point,
+    last_point,
+    bbox_geom,
+    distance,
+    duration,
+    moving_time,
+    elevation_gain,
+    elevation_loss,
+    avg_speed,
+    max_speed,
+    avg_cad,
+    max_cad,
+    min_cad,
+    max_hr,
+    min_hr,
+    avg_watts,
+    max_watts,
+    min_watts,
+    avg_watts_estimated,
+    avg_power_estimated,
+    calories,
+    is_gps,
+    is_stationary,
+    processed,
+    departed_at,
+    time_zone,
+    utc_offset,
+    activity_type_id,
+    pace,
+    moving_pace
+) VALUES (
+    sqlc.arg(id), sqlc.arg(user_id), sqlc.arg(name), sqlc.arg(description), sqlc.arg(visibility), sqlc.arg(highlighted_photo_id), ST_GeomFromEWKB(sqlc.narg(path_geom)), ST_GeomFromEWKB(sqlc.narg(first_point)), ST_GeomFromEWKB(sqlc.narg(last_point)), ST_GeomFromEWKB(sqlc.narg(bbox_geom)), sqlc.narg(distance), sqlc.narg(duration), sqlc.narg(moving_time), sqlc.narg(elevation_gain), sqlc.narg(elevation_loss), sqlc.narg(avg_speed), sqlc.narg(max_speed), sqlc.narg(avg_cad), sqlc.narg(max_cad), sqlc.narg(min_cad), sqlc.narg(max_hr), sqlc.narg(min_hr), sqlc.narg(avg_watts), sqlc.narg(max_watts), sqlc.narg(min_watts), sqlc.narg(avg_watts_estimated), sqlc.narg(avg_power_estimated), sqlc.narg(calories), sqlc.arg(is_gps), sqlc.arg(is_stationary), sqlc.arg(processed), sqlc.narg(departed_at), sqlc.narg(time_zone), sqlc.narg(utc_offset), sqlc.arg(activity_type_id), sqlc.narg(pace), sqlc.narg(moving_pace)
+);
+
+-- name: UpdateTrip :execrows
+UPDATE trips SET
+    name = sqlc.arg(name),
+    description = sqlc.arg(description),
+    visibility = sqlc.arg(visibility),
+    highlighted_photo_id = sqlc.arg(highlighted_photo_id),
+    path_geom = ST_GeomFromEWKB(sqlc.narg(path_geom)),
+    first_point = ST_GeomFromEWKB(sqlc.narg(first_point)),
+    last_point = ST_GeomFromEWKB(sqlc.narg(last_point)),
+    bbox_geom = ST_GeomFromEWKB(sqlc.narg(bbox_geom)),
+    distance = sqlc.narg(distance),
+    duration = sqlc.narg(duration),
+    moving_time = sqlc.narg(moving_time),
+    elevation_gain = sqlc.narg(elevation_gain),
+    elevation_loss = sqlc.narg(elevation_loss),
+    avg_speed = sqlc.narg(avg_speed),
+    max_speed = sqlc.narg(max_speed),
+    avg_cad = sqlc.narg(avg_cad),
+    max_cad = sqlc.narg(max_cad),
+    min_cad = sqlc.narg(min_cad),
+    max_hr = sqlc.narg(max_hr),
+    min_hr = sqlc.narg(min_hr),
+    avg_watts = sqlc.narg(avg_watts),
+    max_watts = sqlc.narg(max_watts),
+    min_watts = sqlc.narg(min_watts),
+    avg_watts_estimated = sqlc.narg(avg_watts_estimated),
+    avg_power_estimated = sqlc.narg(avg_power_estimated),
+    calories = sqlc.narg(calories),
+    is_gps = sqlc.arg(is_gps),
+    is_stationary = sqlc.arg(is_stationary),
+    processed = sqlc.arg(processed),
+    departed_at = sqlc.narg(departed_at),
+    time_zone = sqlc.narg(time_zone),
+    utc_offset = sqlc.narg(utc_offset),
+    activity_type_id = sqlc.arg(activity_type_id),
+    pace = sqlc.narg(pace),
+    moving_pace = sqlc.narg(moving_pace)
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL;
+
+-- name: DeleteTrip :execrows
+UPDATE trips SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetTripImagesByTripID :many
+SELECT * FROM trip_images WHERE trip_id = $1 ORDER BY created_at ASC, id ASC;
+
+-- name: CreateRouteImage :exec
+INSERT INTO route_images (
+    id,
+    route_id,
+    s3_key,
+    width,
+    height,
+    size,
+    type,
+    visibility
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+ON CONFLICT (s3_key) DO NOTHING;
+
+-- name: ListPrivacyZonesByUserID :many
+SELECT * FROM privacy_zones WHERE user_id = $1 ORDER BY created_at ASC, id ASC;
+
+-- name: CreatePrivacyZone :exec
+INSERT INTO privacy_zones (
+    id,
+    user_id,
+    name,
+    center,
+    radius_m
+) VALUES (
+    sqlc.arg(id), sqlc.arg(user_id), sqlc.arg(name), ST_GeomFromEWKB(sqlc.arg(center)), sqlc.arg(radius_m)
+);
+
+-- name: DeletePrivacyZone :execrows
+DELETE FROM privacy_zones WHERE id = $1 AND user_id = $2;
+
+-- name: GetCollectionByID :one
+SELECT * FROM collections WHERE id = $1;
+
+-- name: ListCollectionsByUserID :many
+SELECT * FROM collections WHERE user_id = $1 ORDER BY created_at DESC, id DESC;
+
+-- name: CreateCollection :exec
+INSERT INTO collections (
+    id,
+    user_id,
+    name,
+    description,
+    visibility
+) VALUES (
+    sqlc.arg(id),
+    sqlc.arg(user_id),
+    sqlc.arg(name),
+    sqlc.arg(description),
+    sqlc.arg(visibility)
+);
+
+-- name: UpdateCollection :execrows
+UPDATE collections
+SET name = sqlc.arg(name),
+    description = sqlc.arg(description),
+    visibility = sqlc.arg(visibility),
+    updated_at = now()
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteCollection :execrows
+DELETE FROM collections WHERE id = $1;
+
+-- name: ListCollectionRoutesByCollectionIDs :many
+SELECT * FROM collection_routes
+WHERE collection_id = ANY(sqlc.arg(collection_ids)::UUID[])
+ORDER BY collection_id, position;
+
+-- name: DeleteCollectionRoutes :exec
+DELETE FROM collection_routes WHERE collection_id = $1;
+
+-- name: CreateCollectionRoute :exec
+INSERT INTO collection_routes (collection_id, route_id, position)
+VALUES (sqlc.arg(collection_id), sqlc.arg(route_id), sqlc.arg(position));
+
+-- name: GetRoutesInCollection :many
+SELECT routes.*, users.name AS user_name
+FROM collection_routes
+INNER JOIN routes ON collection_routes.route_id = routes.id
+INNER JOIN users ON routes.user_id = users.id
+WHERE collection_routes.collection_id = $1
+ORDER BY collection_routes.position;
+
+-- name: ListRoutesByIDs :many
+SELECT routes.*, users.name AS user_name
+FROM routes
+INNER JOIN users ON routes.user_id = users.id
+WHERE routes.id = ANY(sqlc.arg(ids)::UUID[]);
+
+-- name: GetTourByID :one
+SELECT * FROM tours WHERE id = $1;
+
+-- name: ListToursByUserID :many
+SELECT * FROM tours WHERE user_id = $1 ORDER BY created_at DESC, id DESC;
+
+-- name: CreateTour :exec
+INSERT INTO tours (
+    id,
+    user_id,
+    name,
+    description,
+    visibility,
+    club_id
+) VALUES (
+    sqlc.arg(id),
+    sqlc.arg(user_id),
+    sqlc.arg(name),
+    sqlc.arg(description),
+    sqlc.arg(visibility),
+    sqlc.arg(club_id)
+);
+
+-- name: UpdateTour :execrows
+UPDATE tours
+SET name = sqlc.arg(name),
+    description = sqlc.arg(description),
+    visibility = sqlc.arg(visibility),
+    club_id = sqlc.arg(club_id),
+    updated_at = now()
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteTour :execrows
+DELETE FROM tours WHERE id = $1;
+
+-- name: ListTourStagesByTourIDs :many
+SELECT * FROM tour_stages
+WHERE tour_id = ANY(sqlc.arg(tour_ids)::UUID[])
+ORDER BY tour_id, position;
+
+-- name: DeleteTourStages :exec
+DELETE FROM tour_stages WHERE tour_id = $1;
+
+-- name: CreateTourStage :exec
+INSERT INTO tour_stages (tour_id, position, route_id, overnight_stop)
+VALUES (sqlc.arg(tour_id), sqlc.arg(position), sqlc.arg(route_id), sqlc.arg(overnight_stop));
+
+-- name: GetEventByID :one
+SELECT events.*,
+    (SELECT count(*) FROM event_rsvps WHERE event_rsvps.event_id = events.id AND event_rsvps.status = 'going')::INTEGER AS going_count
+FROM events
+WHERE events.id = $1;
+
+-- name: ListUpcomingEvents :many
+SELECT events.*,
+    (SELECT count(*) FROM event_rsvps WHERE event_rsvps.event_id = events.id AND event_rsvps.status = 'going')::INTEGER AS going_count
+FROM events
+WHERE events.visibility = 1
+  AND events.cancelled_at IS NULL
+  AND events.starts_at >= sqlc.arg(starts_from)::TIMESTAMPTZ
+  -- radius_mが負の場合は範囲で絞り込まない（ExploreRoutesと同じ）
+  AND (sqlc.arg(radius_m)::float8 < 0 OR ST_DWithin(
+      events.meeting_point::geography,
+      ST_GeomFromEWKB(sqlc.arg(location))::geography,
+      sqlc.arg(radius_m)::float8
+  ))
+ORDER BY events.starts_at, events.id
+LIMIT sqlc.arg(limit_count);
+
+-- name: CreateEvent :exec
+INSERT INTO events (
+    id,
+    user_id,
+    route_id,
+    title,
+    description,
+    starts_at,
+    meeting_point,
+    meeting_point_name,
+    capacity,
+    visibility,
+    club_id
+) VALUES (
+    sqlc.arg(id),
+    sqlc.arg(user_id),
+    sqlc.arg(route_id),
+    sqlc.arg(title),
+    sqlc.arg(description),
+    sqlc.arg(starts_at),
+    ST_GeomFromEWKB(sqlc.arg(meeting_point)),
+    sqlc.arg(meeting_point_name),
+    sqlc.narg(capacity),
+    sqlc.arg(visibility),
+    sqlc.narg(club_id)
+);
+
+-- name: UpdateEvent :execrows
+UPDATE events
+SET route_id = sqlc.arg(route_id),
+    title = sqlc.arg(title),
+    description = sqlc.arg(description),
+    starts_at = sqlc.arg(starts_at),
+    meeting_point = ST_GeomFromEWKB(sqlc.arg(meeting_point)),
+    meeting_point_name = sqlc.arg(meeting_point_name),
+    capacity = sqlc.narg(capacity),
+    visibility = sqlc.arg(visibility),
+    club_id = sqlc.narg(club_id),
+    cancelled_at = sqlc.narg(cancelled_at),
+    cancel_reason = sqlc.arg(cancel_reason),
+    updated_at = now()
+WHERE id = sqlc.arg(id);
+
+-- name: LockEventCapacity :one
+SELECT capacity FROM events WHERE id = $1 FOR UPDATE;
+
+-- name: CountGoingEventRSVPs :one
+SELECT count(*) FROM event_rsvps
+WHERE event_id = sqlc.arg(event_id) AND status = 'going' AND user_id <> sqlc.arg(exclude_user_id);
+
+-- name: UpsertEventRSVP :exec
+INSERT INTO event_rsvps (event_id, user_id, status)
+VALUES (sqlc.arg(event_id), sqlc.arg(user_id), sqlc.arg(status))
+ON CONFLICT (event_id, user_id) DO UPDATE
+SET status = EXCLUDED.status,
+    updated_at = now();
+
+-- name: GetEventRSVP :one
+SELECT event_rsvps.*, users.name AS user_name
+FROM event_rsvps
+INNER JOIN users ON event_rsvps.user_id = users.id
+WHERE event_rsvps.event_id = $1 AND event_rsvps.user_id = $2;
+
+-- name: ListEventRSVPs :many
+SELECT event_rsvps.*, users.name AS user_name
+FROM event_rsvps
+INNER JOIN users ON event_rsvps.user_id = users.id
+WHERE event_rsvps.event_id = $1
+ORDER BY event_rsvps.created_at, event_rsvps.user_id;
+
+-- name: GetEventComment :one
+SELECT event_comments.*, users.name AS user_name
+FROM event_comments
+INNER JOIN users ON event_comments.user_id = users.id
+WHERE event_comments.id = $1;
+
+-- name: ListEventComments :many
+-- 古い順。カーソルより後のコメントを取得する
+SELECT event_comments.*, users.name AS user_name, EXTRACT(EPOCH FROM event_comments.created_at)::DOUBLE PRECISION AS sort_key
+FROM event_comments
+INNER JOIN users ON event_comments.user_id = users.id
+WHERE event_comments.event_id = sqlc.arg(event_id)
+  AND (NOT sqlc.arg(has_cursor)::BOOLEAN
+       OR (EXTRACT(EPOCH FROM event_comments.created_at)::DOUBLE PRECISION, event_comments.id) > (sqlc.arg(cursor_sort_key)::DOUBLE PRECISION, sqlc.arg(cursor_id)::UUID))
+ORDER BY EXTRACT(EPOCH FROM event_comments.created_at)::DOUBLE PRECISION, event_comments.id
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: CreateEventComment :exec
+INSERT INTO event_comments (id, event_id, user_id, body)
+VALUES (sqlc.arg(id), sqlc.arg(event_id), sqlc.arg(user_id), sqlc.arg(body));
+
+-- name: DeleteEventComment :execrows
+DELETE FROM event_comments WHERE id = $1;
+
+-- name: GetClubByID :one
+SELECT clubs.*,
+    (SELECT count(*) FROM club_members WHERE club_members.club_id = clubs.id)::INTEGER AS member_count
+FROM clubs
+WHERE clubs.id = $1;
+
+-- name: GetClubsByUserID :many
+SELECT clubs.*,
+    (SELECT count(*) FROM club_members AS members WHERE members.club_id = clubs.id)::INTEGER AS member_count
+FROM clubs
+INNER JOIN club_members ON club_members.club_id = clubs.id
+WHERE club_members.user_id = $1
+ORDER BY clubs.created_at DESC, clubs.id DESC;
+
+-- name: GetClubIDsByUserID :many
+SELECT club_id FROM club_members WHERE user_id = $1;
+
+-- name: CreateClub :exec
+INSERT INTO clubs (id, name, description)
+VALUES (sqlc.arg(id), sqlc.arg(name), sqlc.arg(description));
+
+-- name: UpdateClub :execrows
+UPDATE clubs
+SET name = sqlc.arg(name),
+    description = sqlc.arg(description),
+    updated_at = now()
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteClub :execrows
+DELETE FROM clubs WHERE id = $1;
+
+-- name: GetClubMember :one
+SELECT club_members.*, users.name AS user_name
+FROM club_members
+INNER JOIN users ON club_members.user_id = users.id
+WHERE club_members.club_id = $1 AND club_members.user_id = $2;
+
+-- name: ListClubMembers :many
+SELECT club_members.*, users.name AS user_name
+FROM club_members
+INNER JOIN users ON club_members.user_id = users.id
+WHERE club_members.club_id = $1
+ORDER BY club_members.created_at, club_members.user_id;
+
+-- name: UpsertClubMember :exec
+INSERT INTO club_members (club_id, user_id, role)
+VALUES (sqlc.arg(club_id), sqlc.arg(user_id), sqlc.arg(role))
+ON CONFLICT (club_id, user_id) DO UPDATE
+SET role = EXCLUDED.role;
+
+-- name: DeleteClubMember :execrows
+DELETE FROM club_members WHERE club_id = $1 AND user_id = $2;
+
+-- name: GetClubJoinRequest :one
+SELECT club_join_requests.*, users.name AS user_name
+FROM club_join_requests
+INNER JOIN users ON club_join_requests.user_id = users.id
+WHERE club_join_requests.club_id = $1 AND club_join_requests.user_id = $2;
+
+-- name: ListClubJoinRequests :many
+SELECT club_join_requests.*, users.name AS user_name
+FROM club_join_requests
+INNER JOIN users ON club_join_requests.user_id = users.id
+WHERE club_join_requests.club_id = $1
+ORDER BY club_join_requests.created_at, club_join_requests.user_id;
+
+-- name: CreateClubJoinRequest :execrows
+INSERT INTO club_join_requests (club_id, user_id, message)
+VALUES (sqlc.arg(club_id), sqlc.arg(user_id), sqlc.arg(message))
+ON CONFLICT (club_id, user_id) DO NOTHING;
+
+-- name: DeleteClubJoinRequest :execrows
+DELETE FROM club_join_requests WHERE club_id = $1 AND user_id = $2;
+
+-- name: ListClubRouteIDs :many
+SELECT route_id FROM club_routes
+WHERE club_id = $1
+ORDER BY created_at DESC, route_id;
+
+-- name: CreateClubRoute :execrows
+INSERT INTO club_routes (club_id, route_id, added_by)
+VALUES (sqlc.arg(club_id), sqlc.arg(route_id), sqlc.arg(added_by))
+ON CONFLICT (club_id, route_id) DO NOTHING;
+
+-- name: DeleteClubRoute :execrows
+DELETE FROM club_routes WHERE club_id = $1 AND route_id = $2;
+
+-- name: CreateRouteLike :execrows
+INSERT INTO route_likes (id, user_id, route_id)
+VALUES (sqlc.arg(id), sqlc.arg(user_id), sqlc.arg(route_id))
+ON CONFLICT (user_id, route_id) DO NOTHING;
+
+-- name: DeleteRouteLike :execrows
+DELETE FROM route_likes WHERE user_id = $1 AND route_id = $2;
+
+-- name: GetRouteComment :one
+SELECT route_comments.*, users.name AS user_name
+FROM route_comments
+INNER JOIN users ON route_comments.user_id = users.id
+WHERE route_comments.id = $1 AND route_comments.deleted_at IS NULL;
+
+-- name: ListRouteComments :many
+-- 古い順。カーソルより後のコメントを取得する
+SELECT route_comments.*, users.name AS user_name, EXTRACT(EPOCH FROM route_comments.created_at)::DOUBLE PRECISION AS sort_key
+FROM route_comments
+INNER JOIN users ON route_comments.user_id = users.id
+WHERE route_comments.route_id = sqlc.arg(route_id) AND route_comments.deleted_at IS NULL
+  AND (NOT sqlc.arg(has_cursor)::BOOLEAN
+       OR (EXTRACT(EPOCH FROM route_comments.created_at)::DOUBLE PRECISION, route_comments.id) > (sqlc.arg(cursor_sort_key)::DOUBLE PRECISION, sqlc.arg(cursor_id)::UUID))
+ORDER BY EXTRACT(EPOCH FROM route_comments.created_at)::DOUBLE PRECISION, route_comments.id
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: ListRouteCommentsByIDs :many
+SELECT route_comments.*, users.name AS user_name
+FROM route_comments
+INNER JOIN users ON route_comments.user_id = users.id
+WHERE route_comments.id = ANY(sqlc.arg(ids)::UUID[]) AND route_comments.deleted_at IS NULL;
+
+-- name: CreateRouteComment :exec
+INSERT INTO route_comments (id, user_id, route_id, content)
+VALUES (sqlc.arg(id), sqlc.arg(user_id), sqlc.arg(route_id), sqlc.arg(content));
+
+-- name: DeleteRouteComment :execrows
+UPDATE route_comments SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: CreateUserFollow :execrows
+INSERT INTO user_follows (follower_id, followee_id)
+VALUES (sqlc.arg(follower_id), sqlc.arg(followee_id))
+ON CONFLICT (follower_id, followee_id) DO NOTHING;
+
+-- name: DeleteUserFollow :execrows
+DELETE FROM user_follows WHERE follower_id = $1 AND followee_id = $2;
+
+-- name: CreateFeedEvent :exec
+INSERT INTO feed_events (id, actor_id, event_type, subject_id)
+VALUES (sqlc.arg(id), sqlc.arg(actor_id), sqlc.arg(event_type), sqlc.arg(subject_id));
+
+-- name: DeleteFeedEvents :exec
+DELETE FROM feed_events
+WHERE actor_id = sqlc.arg(actor_id) AND event_type = sqlc.arg(event_type) AND subject_id = sqlc.arg(subject_id);
+
+-- name: ListFeedEvents :many
+SELECT feed_events.*, users.name AS actor_name, EXTRACT(EPOCH FROM feed_events.created_at)::DOUBLE PRECISION AS sort_key
+FROM feed_events
+INNER JOIN user_follows ON user_follows.followee_id = feed_events.actor_id
+INNER JOIN users ON feed_events.actor_id = users.id
+WHERE user_follows.follower_id = sqlc.arg(follower_id)
+  AND (NOT sqlc.arg(has_cursor)::BOOLEAN
+       OR (EXTRACT(EPOCH FROM feed_events.created_at)::DOUBLE PRECISION, feed_events.id) < (sqlc.arg(cursor_sort_key)::DOUBLE PRECISION, sqlc.arg(cursor_id)::UUID))
+ORDER BY EXTRACT(EPOCH FROM feed_events.created_at)::DOUBLE PRECISION DESC, feed_events.id DESC
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: CreateRouteSave :execrows
+-- 保存を取り消したルートは保存し直せるよう、取り消し済みの行を戻す
+INSERT INTO route_saves (id, user_id, route_id)
+VALUES (sqlc.arg(id), sqlc.arg(user_id), sqlc.arg(route_id))
+ON CONFLICT (user_id, route_id) DO UPDATE SET deleted_at = NULL, created_at = now()
+WHERE route_saves.deleted_at IS NOT NULL;
+
+-- name: DeleteRouteSave :execrows
+UPDATE route_saves SET deleted_at = now()
+WHERE user_id = $1 AND route_id = $2 AND deleted_at IS NULL;
+
+-- name: ListSavedRoutes :many
+-- 保存した日時の新しい順。同じ日時のルートはIDで順序を決める
+SELECT routes.*, users.name AS user_name, EXTRACT(EPOCH FROM route_saves.created_at)::DOUBLE PRECISION AS sort_key
+FROM route_saves
+INNER JOIN routes ON route_saves.route_id = routes.id
+INNER JOIN users ON routes.user_id = users.id
+WHERE route_saves.user_id = sqlc.arg(user_id)
+  AND route_saves.deleted_at IS NULL
+  AND (NOT sqlc.arg(has_cursor)::BOOLEAN
+       OR (EXTRACT(EPOCH FROM route_saves.created_at)::DOUBLE PRECISION, routes.id) < (sqlc.arg(cursor_sort_key)::DOUBLE PRECISION, sqlc.arg(cursor_id)::UUID))
+ORDER BY EXTRACT(EPOCH FROM route_saves.created_at)::DOUBLE PRECISION DESC, routes.id DESC
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: CreateNotification :exec
+INSERT INTO notifications (id, user_id, actor_id, type, route_id, event_id)
+VALUES (sqlc.arg(id), sqlc.arg(user_id), sqlc.arg(actor_id), sqlc.arg(type), sqlc.narg(route_id), sqlc.narg(event_id));
+
+-- name: ListNotifications :many
+SELECT notifications.*, users.name AS actor_name, routes.name AS route_name, events.title AS event_title, EXTRACT(EPOCH FROM notifications.created_at)::DOUBLE PRECISION AS sort_key
+FROM notifications
+INNER JOIN users ON notifications.actor_id = users.id
+LEFT JOIN routes ON notifications.route_id = routes.id
+LEFT JOIN events ON notifications.event_id = events.id
+WHERE notifications.user_id = sqlc.arg(user_id)
+  AND (NOT sqlc.arg(unread_only)::BOOLEAN OR notifications.read_at IS NULL)
+  AND (NOT sqlc.arg(has_cursor)::BOOLEAN
+       OR (EXTRACT(EPOCH FROM notifications.created_at)::DOUBLE PRECISION, notifications.id) < (sqlc.arg(cursor_sort_key)::DOUBLE PRECISION, sqlc.arg(cursor_id)::UUID))
+ORDER BY EXTRACT(EPOCH FROM notifications.created_at)::DOUBLE PRECISION DESC, notifications.id DESC
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: MarkNotificationRead :execrows
+-- 既読の通知は既読にした日時を変えない
+UPDATE notifications SET read_at = COALESCE(read_at, now())
+WHERE id = $1 AND user_id = $2;
+
+-- name: MarkAllNotificationsRead :exec
+UPDATE notifications SET read_at = now()
+WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: GetMutedNotificationTypes :one
+SELECT muted_notification_types FROM users WHERE id = $1;
+
+-- name: UpdateMutedNotificationTypes :execrows
+UPDATE users SET muted_notification_types = $2 WHERE id = $1;
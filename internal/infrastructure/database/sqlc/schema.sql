@@ -20,7 +20,38 @@ CREATE TABLE users (
     first_name TEXT,                             -- 名
     last_name TEXT,                              -- 姓
     email TEXT UNIQUE,                           -- メールアドレス
-    has_set_location BOOLEAN NOT NULL DEFAULT FALSE       -- 位置情報設定済みフラグ
+    has_set_location BOOLEAN NOT NULL DEFAULT FALSE,      -- 位置情報設定済みフラグ
+    muted_notification_types TEXT[] NOT NULL DEFAULT '{}' -- 受け取らない通知の種類。種類を追加しても既定で受け取るよう、受け取らないものを持つ
+);
+
+-- ルートやイベントを共有するクラブ
+CREATE TABLE clubs (
+  id          UUID PRIMARY KEY,
+  name        TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- クラブのメンバーと役割
+CREATE TABLE club_members (
+  club_id    UUID NOT NULL REFERENCES clubs(id) ON DELETE CASCADE,
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  role       TEXT NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(), -- 参加した日時
+  PRIMARY KEY (club_id, user_id)
+);
+
+CREATE INDEX club_members_user_id_idx ON club_members (user_id); -- 所属するクラブの取得用
+CREATE UNIQUE INDEX club_members_owner_idx ON club_members (club_id) WHERE role = 'owner'; -- オーナーはクラブに1人
+
+-- クラブへの参加申請。承認するとメンバーになり、申請は削除する
+CREATE TABLE club_join_requests (
+  club_id    UUID NOT NULL REFERENCES clubs(id) ON DELETE CASCADE,
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  message    TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (club_id, user_id)
 );
 
 CREATE TABLE routes (
@@ -40,9 +71,17 @@ CREATE TABLE routes (
   polyline            TEXT NOT NULL,                -- エンコード済みポリライン（静的地図画像で使う）
   created_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
   updated_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
-  visibility          SMALLINT NOT NULL DEFAULT 1 CHECK (visibility IN (0,1,2)) -- 公開範囲0:非公開,1:公開,2:友達のみ
+  visibility          SMALLINT NOT NULL DEFAULT 1 CHECK (visibility IN (0,1,2,3)), -- 公開範囲0:非公開,1:公開,2:友達のみ,3:クラブのメンバーのみ
+  version             INT NOT NULL DEFAULT 1,       -- 楽観的排他制御用のバージョン。更新のたびに1増える
+  forked_from_route_id UUID REFERENCES routes(id) ON DELETE SET NULL, -- フォーク元のルート
+  difficulty          SMALLINT CHECK (difficulty BETWEEN 1 AND 4), -- 難易度1:easy,2:moderate,3:hard,4:expert。路面の内訳と合わせて求める
+  club_id             UUID REFERENCES clubs(id) ON DELETE SET NULL -- 公開範囲がクラブのメンバーのみの場合の共有先
 );
 
+CREATE INDEX routes_bbox_idx ON routes USING GIST (bbox); -- 類似ルート検索のbbox絞り込み用
+CREATE INDEX routes_forked_from_route_id_idx ON routes (forked_from_route_id); -- フォーク数の集計用
+CREATE INDEX routes_name_trgm_idx ON routes USING GIN (name gin_trgm_ops); -- ルート名のあいまい検索用
+
 -- トリップの写真
 CREATE TABLE route_images (
   id           UUID PRIMARY KEY,
@@ -83,6 +122,40 @@ CREATE TABLE  course_points(
   UNIQUE(route_id, step_order)
 );
 
+-- ルートの更新履歴（更新直前の状態のスナップショット）
+CREATE TABLE route_versions (
+  id                   UUID PRIMARY KEY,                -- UUIDv7
+  route_id             UUID NOT NULL REFERENCES routes(id) ON DELETE CASCADE,
+  version_number       INT NOT NULL,                    -- ルートごとに1から採番
+  user_id              UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE, -- この版を置き換える更新を行ったユーザー
+  name                 TEXT NOT NULL,
+  description          TEXT NOT NULL DEFAULT '',
+  highlighted_photo_id BIGINT DEFAULT 0,
+  distance             DOUBLE PRECISION NOT NULL,
+  duration             DOUBLE PRECISION NOT NULL,
+  elevation_gain       DOUBLE PRECISION NOT NULL,
+  elevation_loss       DOUBLE PRECISION NOT NULL,
+  path_geom            geometry(LineString, 4326) NOT NULL,
+  first_point          geometry(Point,4326) NOT NULL,
+  last_point           geometry(Point,4326) NOT NULL,
+  visibility           SMALLINT NOT NULL,
+  course_points        JSONB NOT NULL DEFAULT '[]', -- コースポイントの配列
+  waypoints            JSONB NOT NULL DEFAULT '[]', -- ウェイポイントの配列
+  created_at           TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (route_id, version_number)
+);
+
+-- ルートの全文検索用ドキュメント（名前・説明・コースポイントの道路名）
+-- 日本語はPostgreSQLのパーサで分割できないため、アプリ側でbi-gramに分割した空白区切りのトークンを保存する
+CREATE TABLE route_search_documents (
+  route_id      UUID PRIMARY KEY REFERENCES routes(id) ON DELETE CASCADE,
+  search_text   TEXT NOT NULL DEFAULT '',
+  search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', search_text)) STORED,
+  updated_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX route_search_documents_search_vector_idx ON route_search_documents USING GIN (search_vector);
+
 -- 活動
 CREATE TABLE trips (
   id                     UUID PRIMARY KEY,  
@@ -166,6 +239,8 @@ CREATE TABLE route_likes (
   UNIQUE (user_id, route_id)
 );
 
+CREATE INDEX route_likes_route_id_idx ON route_likes (route_id); -- いいね数での並び替え用
+
 
 CREATE TABLE route_comments (
   id           UUID PRIMARY KEY,
@@ -192,6 +267,243 @@ CREATE TABLE route_saves (
 );
 
 
+-- ルート探索用の道路網（OpenStreetMapの抽出データから cmd/osm-import で取り込む）
+-- ウェイを交差点で区切った区間ごとに1行。source/targetは両端のOSMノードID
+CREATE TABLE road_edges (
+  id             BIGSERIAL PRIMARY KEY,
+  osm_way_id     BIGINT NOT NULL,
+  source_node_id BIGINT NOT NULL,
+  target_node_id BIGINT NOT NULL,
+  name           TEXT,
+  highway        TEXT NOT NULL,                 -- highwayタグ（residential, cyclewayなど）
+  surface        TEXT,                          -- surfaceタグ（asphalt, gravelなど）
+  bike_lane      BOOLEAN NOT NULL DEFAULT FALSE,
+  oneway         BOOLEAN NOT NULL DEFAULT FALSE, -- source→targetの向きにしか通れない
+  length_m       DOUBLE PRECISION NOT NULL,
+  elevation_gain DOUBLE PRECISION NOT NULL DEFAULT 0, -- source→targetの向きに進んだときの上り(m)
+  elevation_loss DOUBLE PRECISION NOT NULL DEFAULT 0,
+  geom           geometry(LineString, 4326) NOT NULL
+);
+
+CREATE INDEX road_edges_geom_idx ON road_edges USING GIST (geom); -- 探索範囲の絞り込み用
+
+-- プライバシーゾーン（自宅などの周辺）。トリップを公開するときに範囲内の軌跡を隠す
+CREATE TABLE privacy_zones (
+  id         UUID PRIMARY KEY,                -- UUIDv7
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name       TEXT NOT NULL DEFAULT '',
+  center     geometry(Point, 4326) NOT NULL,
+  radius_m   DOUBLE PRECISION NOT NULL CHECK (radius_m > 0), -- 半径(m)
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX privacy_zones_user_id_idx ON privacy_zones (user_id);
+
+-- ルートの路面の内訳。ルートを保存するたびに road_edges と照合して作り直す
+-- kindがsurfaceの行は舗装の分類（paved, gravel, dirt, unknown）、road_classの行は道路の種類ごとの距離
+CREATE TABLE route_surfaces (
+  route_id UUID NOT NULL REFERENCES routes(id) ON DELETE CASCADE,
+  kind     TEXT NOT NULL CHECK (kind IN ('surface', 'road_class')),
+  category TEXT NOT NULL,
+  distance DOUBLE PRECISION NOT NULL CHECK (distance >= 0), -- 距離(m)
+  PRIMARY KEY (route_id, kind, category)
+);
+
+-- 経路沿いで立ち寄れるPOI（カフェ、コンビニ、水飲み場など）。osm-importでOSMの抽出データから作り直す
+CREATE TABLE pois (
+  id       BIGSERIAL PRIMARY KEY,
+  osm_type TEXT NOT NULL CHECK (osm_type IN ('node', 'way')),
+  osm_id   BIGINT NOT NULL,
+  category TEXT NOT NULL,                 -- cafe, convenience, drinking_water, bicycle_shop, toilets, viewpoint
+  name     TEXT,
+  location geometry(Point, 4326) NOT NULL, -- ウェイで描かれたPOIは中心
+  UNIQUE (osm_type, osm_id)
+);
+
+CREATE INDEX pois_location_idx ON pois USING GIST ((location::geography)); -- ルートから一定距離内の絞り込み用
+
+-- 逆ジオコーディングに使う行政区域の範囲。boundary-importでGeoJSONから作り直す
+CREATE TABLE admin_boundaries (
+  id           BIGSERIAL PRIMARY KEY,
+  name         TEXT NOT NULL,
+  admin_level  SMALLINT NOT NULL CHECK (admin_level BETWEEN 1 AND 11), -- OpenStreetMapのadmin_level（4:都道府県, 7:市区町村）
+  country_code CHAR(2) NOT NULL,                                       -- 国コード（ISO形式）
+  geom         geometry(MultiPolygon, 4326) NOT NULL
+);
+
+CREATE INDEX admin_boundaries_geom_idx ON admin_boundaries USING GIST (geom); -- 地点を含む区域の絞り込み用
+
+-- ルートの出発地点と目的地の地名。出発地点や目的地を変更したときに作り直す
+CREATE TABLE route_places (
+  route_id            UUID NOT NULL REFERENCES routes(id) ON DELETE CASCADE,
+  kind                TEXT NOT NULL CHECK (kind IN ('start', 'end')),
+  locality            TEXT NOT NULL DEFAULT '',  -- 市区町村
+  administrative_area TEXT NOT NULL DEFAULT '',  -- 都道府県
+  country_code        CHAR(2) NOT NULL,          -- 国コード（ISO形式）
+  PRIMARY KEY (route_id, kind)
+);
+
+CREATE INDEX route_places_locality_idx ON route_places (locality text_pattern_ops); -- 地域での絞り込み用（前方一致）
+CREATE INDEX route_places_administrative_area_idx ON route_places (administrative_area text_pattern_ops);
+
+-- ルートを整理するためのコレクション（フォルダ）。公開範囲はルートと同じ値を使う
+CREATE TABLE collections (
+  id          UUID PRIMARY KEY,
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name        TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  visibility  SMALLINT NOT NULL DEFAULT 0 CHECK (visibility IN (0,1,2)),
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX collections_user_id_idx ON collections (user_id);
+
+-- コレクション内のルートと並び順。他のユーザーの公開ルートも入れられる
+CREATE TABLE collection_routes (
+  collection_id UUID NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+  route_id      UUID NOT NULL REFERENCES routes(id) ON DELETE CASCADE,
+  position      INTEGER NOT NULL CHECK (position >= 0), -- 0から始まる並び順
+  PRIMARY KEY (collection_id, route_id)
+);
+
+CREATE INDEX collection_routes_route_id_idx ON collection_routes (route_id); -- ルートの削除時と絞り込み用
+
+-- ルートに付けた自由なタグ。表記はNormalizeTagで揃えてから保存する
+CREATE TABLE route_tags (
+  route_id UUID NOT NULL REFERENCES routes(id) ON DELETE CASCADE,
+  tag      TEXT NOT NULL,
+  position INTEGER NOT NULL CHECK (position >= 0), -- 付けた順
+  PRIMARY KEY (route_id, tag)
+);
+
+CREATE INDEX route_tags_tag_idx ON route_tags (tag); -- タグでの絞り込み用
+
+-- 複数日のツアー（バイクパッキングなど）。公開範囲はルートと同じ値を使う
+CREATE TABLE tours (
+  id          UUID PRIMARY KEY,
+  user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name        TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  visibility  SMALLINT NOT NULL DEFAULT 0 CHECK (visibility IN (0,1,2,3)),
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  club_id     UUID REFERENCES clubs(id) ON DELETE SET NULL -- 公開範囲がクラブのメンバーのみの場合の共有先
+);
+
+CREATE INDEX tours_user_id_idx ON tours (user_id);
+
+-- ツアーの日ごとのステージ。同じルートを複数日に使えるよう、日程の順を主キーにする
+CREATE TABLE tour_stages (
+  tour_id        UUID NOT NULL REFERENCES tours(id) ON DELETE CASCADE,
+  position       INTEGER NOT NULL CHECK (position >= 0), -- 0から始まる日程の順
+  route_id       UUID NOT NULL REFERENCES routes(id) ON DELETE CASCADE,
+  overnight_stop TEXT NOT NULL DEFAULT '', -- ステージの終点の宿泊地。泊まらない日は空
+  PRIMARY KEY (tour_id, position)
+);
+
+CREATE INDEX tour_stages_route_id_idx ON tour_stages (route_id); -- ルートの削除時用
+
+-- ルートを走るグループライド。公開範囲はルートと同じ値を使う
+CREATE TABLE events (
+  id                 UUID PRIMARY KEY,
+  user_id            UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,  -- 主催者
+  route_id           UUID NOT NULL REFERENCES routes(id) ON DELETE CASCADE,
+  title              TEXT NOT NULL,
+  description        TEXT NOT NULL DEFAULT '',
+  starts_at          TIMESTAMPTZ NOT NULL,
+  meeting_point      geometry(Point, 4326) NOT NULL, -- 集合場所
+  meeting_point_name TEXT NOT NULL DEFAULT '',
+  capacity           INTEGER CHECK (capacity > 0),   -- 参加者の上限。NULLは上限なし
+  visibility         SMALLINT NOT NULL DEFAULT 0 CHECK (visibility IN (0,1,2,3)),
+  cancelled_at       TIMESTAMPTZ,                     -- 中止した日時
+  cancel_reason      TEXT NOT NULL DEFAULT '',
+  created_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+  club_id            UUID REFERENCES clubs(id) ON DELETE SET NULL -- 公開範囲がクラブのメンバーのみの場合の共有先
+);
+
+CREATE INDEX events_user_id_idx ON events (user_id);
+CREATE INDEX events_route_id_idx ON events (route_id);
+CREATE INDEX events_starts_at_idx ON events (starts_at);
+CREATE INDEX events_meeting_point_idx ON events USING GIST ((meeting_point::geography)); -- 近くのイベントの絞り込み用
+
+-- イベントへの参加の返事。ユーザーごとに最新の返事だけを持つ
+CREATE TABLE event_rsvps (
+  event_id   UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  status     TEXT NOT NULL CHECK (status IN ('going', 'maybe', 'not_going')),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX event_rsvps_user_id_idx ON event_rsvps (user_id);
+
+-- イベントへのコメント
+CREATE TABLE event_comments (
+  id         UUID PRIMARY KEY,
+  event_id   UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  body       TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX event_comments_event_id_idx ON event_comments (event_id, created_at);
+
+-- クラブの管理者が選んだ共有ルートライブラリ
+CREATE TABLE club_routes (
+  club_id    UUID NOT NULL REFERENCES clubs(id) ON DELETE CASCADE,
+  route_id   UUID NOT NULL REFERENCES routes(id) ON DELETE CASCADE,
+  added_by   UUID REFERENCES users(id) ON DELETE SET NULL, -- 追加した管理者
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (club_id, route_id)
+);
+
+CREATE INDEX club_routes_route_id_idx ON club_routes (route_id); -- ルートの削除時用
+
+-- ユーザーのフォロー。フォローしたユーザーの出来事をフィードに表示する
+CREATE TABLE user_follows (
+  follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  followee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (follower_id, followee_id),
+  CHECK (follower_id <> followee_id)
+);
+
+CREATE INDEX user_follows_followee_id_idx ON user_follows (followee_id); -- フォロワーの一覧用
+
+-- フィードに表示する出来事（ルートの作成・トリップのアップロード・いいね・コメント）
+-- 書き込み時は行為者の出来事を1行だけ記録し、閲覧時にフォローしているユーザーの出来事を集める
+-- subject_idは種類に応じてルート・トリップ・コメントを指す。種類ごとに参照先が異なるため外部キーは張らず、
+-- 対象が削除された出来事は閲覧時に除く。種類はアプリケーションで検証する
+CREATE TABLE feed_events (
+  id         UUID PRIMARY KEY,
+  actor_id   UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  event_type TEXT NOT NULL,
+  subject_id UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX feed_events_actor_id_created_at_idx ON feed_events (actor_id, created_at DESC, id DESC); -- フィードのページ送り用
+CREATE INDEX feed_events_subject_id_idx ON feed_events (subject_id); -- いいね・コメントの取り消し時用
+
+-- アプリ内の通知。ルートへのいいね・コメント・フォーク・保存とフォロー、イベントの中止をそのユーザーに知らせる
+-- 通知を作成するときに受け取るユーザーの設定（users.muted_notification_types）を確認し、受け取らない種類は作成しない
+CREATE TABLE notifications (
+  id         UUID PRIMARY KEY,
+  user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,  -- 受け取るユーザー
+  actor_id   UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,  -- いいね・フォローなどをしたユーザー
+  type       TEXT NOT NULL,                                         -- 種類はアプリケーションで検証する
+  route_id   UUID REFERENCES routes(id) ON DELETE CASCADE,          -- 対象のルート。フォロー・イベントの場合はNULL
+  event_id   UUID REFERENCES events(id) ON DELETE CASCADE,          -- 対象のイベント。イベントの中止の場合のみ
+  read_at    TIMESTAMPTZ,                                           -- 既読にした日時。未読の場合はNULL
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX notifications_user_id_created_at_idx ON notifications (user_id, created_at DESC, id DESC); -- 通知一覧のページ送り用
+CREATE INDEX notifications_user_id_unread_idx ON notifications (user_id) WHERE read_at IS NULL; -- 未読数の集計用
+
 -- updated_atを自動更新する関数
 CREATE OR REPLACE FUNCTION set_updated_at()
 RETURNS TRIGGER AS $$
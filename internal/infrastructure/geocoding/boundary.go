@@ -0,0 +1,36 @@
+package geocoding
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	"github.com/paulmach/orb"
+)
+
+// AdminAreaSource は地点を含む行政区域を読み込む
+type AdminAreaSource interface {
+	ListAdminAreasContaining(ctx context.Context, location orb.Point) ([]place.AdminArea, error)
+}
+
+// BoundaryGeocoder は取り込み済みの行政区域の範囲から地名を求めるジオコーダー
+// 外部のジオコーディングAPIを使わないため、利用回数の制限や位置情報の送信を気にせず使える
+type BoundaryGeocoder struct {
+	source AdminAreaSource
+}
+
+func NewBoundaryGeocoder(source AdminAreaSource) *BoundaryGeocoder {
+	return &BoundaryGeocoder{source: source}
+}
+
+func (g *BoundaryGeocoder) ReverseGeocode(ctx context.Context, location orb.Point) (*place.Place, error) {
+	areas, err := g.source.ListAdminAreasContaining(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := place.PlaceFromAreas(areas)
+	if !ok {
+		return nil, domainerror.New("place not found", domainerror.ErrNotFound)
+	}
+	return p, nil
+}
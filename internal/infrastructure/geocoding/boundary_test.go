@@ -0,0 +1,65 @@
+package geocoding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/adminboundary"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+// memoryAreaSource は抽出データの行政区域から地点を含むものを返す
+type memoryAreaSource []*place.AdminBoundary
+
+func (s memoryAreaSource) ListAdminAreasContaining(ctx context.Context, location orb.Point) ([]place.AdminArea, error) {
+	areas := []place.AdminArea{}
+	for _, b := range s {
+		if planar.MultiPolygonContains(b.Geom, location) {
+			areas = append(areas, b.AdminArea)
+		}
+	}
+	return areas, nil
+}
+
+func TestBoundaryGeocoder_ReverseGeocode(t *testing.T) {
+	// 京都府・大阪府と、京都市（中京区）・亀岡市を長方形で表した行政区域
+	boundaries, err := adminboundary.LoadFile("../fixtures/boundaries/kyoto.geojson")
+	if err != nil {
+		t.Fatal(err)
+	}
+	geocoder := NewBoundaryGeocoder(memoryAreaSource(boundaries))
+
+	tests := []struct {
+		name         string
+		location     orb.Point
+		wantLocality string
+		wantArea     string
+		wantErr      error
+	}{
+		{name: "政令市の区の中では市を返す", location: orb.Point{135.7681, 35.0116}, wantLocality: "京都市", wantArea: "京都府"},
+		{name: "市の中", location: orb.Point{135.50, 35.01}, wantLocality: "亀岡市", wantArea: "京都府"},
+		{name: "市区町村の範囲がない地点は都道府県だけ", location: orb.Point{135.45, 34.60}, wantArea: "大阪府"},
+		{name: "行政区域の外", location: orb.Point{139.70, 35.68}, wantErr: domainerror.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := geocoder.ReverseGeocode(context.Background(), tt.location)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ReverseGeocode() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReverseGeocode() error = %v", err)
+			}
+			if got.Locality() != tt.wantLocality || got.AdministrativeArea() != tt.wantArea || got.CountryCode() != "JP" {
+				t.Errorf("ReverseGeocode() = %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkt"
+)
+
+type adminBoundaryRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// 行政区域の範囲のリポジトリの実装
+func NewAdminBoundaryRepository(queries *dbgen.Queries) place.IAdminBoundaryRepository {
+	return &adminBoundaryRepositoryImpl{queries: queries}
+}
+
+// ListAdminAreasContaining は地点を含む行政区域を粗い順に返す
+func (r *adminBoundaryRepositoryImpl) ListAdminAreasContaining(ctx context.Context, location orb.Point) ([]place.AdminArea, error) {
+	rows, err := r.queries.ListAdminAreasContaining(ctx, wkt.MarshalString(location))
+	if err != nil {
+		return nil, err
+	}
+	areas := make([]place.AdminArea, len(rows))
+	for i, row := range rows {
+		areas[i] = place.AdminArea{
+			Name:        row.Name,
+			AdminLevel:  int(row.AdminLevel),
+			CountryCode: row.CountryCode,
+		}
+	}
+	return areas, nil
+}
+
+// ReplaceAdminBoundaries は保存済みの行政区域をすべて削除し、boundaries で置き換える
+// 途中で失敗したときに行政区域が空にならないよう、トランザクション内で呼び出すこと
+func (r *adminBoundaryRepositoryImpl) ReplaceAdminBoundaries(ctx context.Context, boundaries []*place.AdminBoundary) error {
+	if err := r.queries.DeleteAdminBoundaries(ctx); err != nil {
+		return err
+	}
+	// 市区町村の範囲は頂点が多いため、1件ずつ保存する
+	for _, b := range boundaries {
+		err := r.queries.CreateAdminBoundary(ctx, dbgen.CreateAdminBoundaryParams{
+			Name:        b.Name,
+			AdminLevel:  int16(b.AdminLevel),
+			CountryCode: b.CountryCode,
+			Geom:        wkt.MarshalString(b.Geom),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
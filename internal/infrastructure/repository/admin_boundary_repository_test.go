@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	"github.com/paulmach/orb"
+)
+
+func TestAdminBoundaryRepository_ListAdminAreasContaining(t *testing.T) {
+	q := GetTestQueries()
+	repo := NewAdminBoundaryRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	tests := []struct {
+		name      string
+		location  orb.Point
+		wantNames []string
+	}{
+		{
+			name:      "地点を含む区域を粗い順に返すこと",
+			location:  orb.Point{139.7528, 35.6850}, // 皇居
+			wantNames: []string{"東京都", "千代田区"},
+		},
+		{
+			name:      "市区町村の範囲がない地点は都道府県だけを返すこと",
+			location:  orb.Point{139.2257, 35.3706}, // ヤビツ峠の麓
+			wantNames: []string{"神奈川県"},
+		},
+		{
+			name:      "区域の外の地点は空になること",
+			location:  orb.Point{135.768, 35.011}, // 京都市
+			wantNames: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.ListAdminAreasContaining(ctx, tt.location)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.wantNames) {
+				t.Fatalf("ListAdminAreasContaining() = %+v, want %v", got, tt.wantNames)
+			}
+			for i, a := range got {
+				if a.Name != tt.wantNames[i] {
+					t.Errorf("areas[%d].Name = %s, want %s", i, a.Name, tt.wantNames[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAdminBoundaryRepository_ReplaceAdminBoundaries(t *testing.T) {
+	q := GetTestQueries()
+	repo := NewAdminBoundaryRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	kyoto, err := place.NewAdminBoundary("京都府", place.AdminLevelAdministrativeArea, "JP",
+		orb.MultiPolygon{{{{135.5, 34.8}, {135.9, 34.8}, {135.9, 35.3}, {135.5, 35.3}, {135.5, 34.8}}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := repo.ReplaceAdminBoundaries(ctx, []*place.AdminBoundary{kyoto}); err != nil {
+		t.Fatalf("ReplaceAdminBoundaries() error = %v", err)
+	}
+
+	// 取り込み前の区域は削除される
+	tokyo, err := repo.ListAdminAreasContaining(ctx, orb.Point{139.7528, 35.6850})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tokyo) != 0 {
+		t.Errorf("ListAdminAreasContaining(東京) = %+v, want empty", tokyo)
+	}
+	got, err := repo.ListAdminAreasContaining(ctx, orb.Point{135.768, 35.011})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "京都府" || got[0].AdminLevel != 4 || got[0].CountryCode != "JP" {
+		t.Errorf("ListAdminAreasContaining(京都) = %+v", got)
+	}
+}
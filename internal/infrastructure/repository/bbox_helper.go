@@ -9,6 +9,9 @@ import (
 	"github.com/paulmach/orb/encoding/wkt"
 )
 
+// 赤道上の経度1度あたりの距離(m)
+const metersPerDegree = 111320.0
+
 // CalculateBbox は LineString から Bounding Box (Polygon) を計算します
 // path_geom から bbox を生成する際に使用します
 func CalculateBbox(pathGeom orb.Geometry) dbgen.OrbGeometry {
@@ -59,6 +62,13 @@ func CalculateBboxWithPadding(pathGeom orb.Geometry, padding float64) dbgen.OrbG
 	return dbgen.OrbGeometry{Geometry: polygon}
 }
 
+// MetersToDegrees はメートル単位の距離を緯度経度の度数に概算変換します
+// 経度方向は高緯度ほど短くなるため、bboxの絞り込みなど大まかな用途にのみ使用してください
+// 例: 111320m ≈ 1度
+func MetersToDegrees(meters float64) float64 {
+	return meters / metersPerDegree
+}
+
 // ParseEWKT はEWKT文字列をorb.Geometryに変換します
 // "SRID=4326;POLYGON(...)" -> orb.Polygon
 func ParseEWKT(ewktString string) (orb.Geometry, error) {
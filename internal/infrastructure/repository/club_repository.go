@@ -0,0 +1,386 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/club"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type clubRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// クラブリポジトリの実装
+func NewClubRepository(queries *dbgen.Queries) club.IClubRepository {
+	return &clubRepositoryImpl{queries: queries}
+}
+
+func (r *clubRepositoryImpl) GetClubByID(ctx context.Context, id string) (*club.Club, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+
+	row, err := r.queries.GetClubByID(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("club not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	return clubFromRow(row), nil
+}
+
+func (r *clubRepositoryImpl) GetClubsByUserID(ctx context.Context, userID string) ([]*club.Club, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	rows, err := r.queries.GetClubsByUserID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	clubs := make([]*club.Club, 0, len(rows))
+	for _, row := range rows {
+		clubs = append(clubs, clubFromRow(dbgen.GetClubByIDRow(row)))
+	}
+	return clubs, nil
+}
+
+func (r *clubRepositoryImpl) GetClubIDsByUserID(ctx context.Context, userID string) ([]string, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	rows, err := r.queries.GetClubIDsByUserID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(rows))
+	for _, id := range rows {
+		ids = append(ids, id.String())
+	}
+	return ids, nil
+}
+
+func (r *clubRepositoryImpl) SaveClub(ctx context.Context, c *club.Club) error {
+	clubID, err := uuid.Parse(c.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid club id: %w", err)
+	}
+
+	err = r.queries.CreateClub(ctx, dbgen.CreateClubParams{
+		ID:          clubID,
+		Name:        c.Name(),
+		Description: c.Description(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create club: %w", err)
+	}
+	return nil
+}
+
+func (r *clubRepositoryImpl) UpdateClub(ctx context.Context, c *club.Club) error {
+	clubID, err := uuid.Parse(c.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid club id: %w", err)
+	}
+
+	rows, err := r.queries.UpdateClub(ctx, dbgen.UpdateClubParams{
+		Name:        c.Name(),
+		Description: c.Description(),
+		ID:          clubID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update club: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *clubRepositoryImpl) DeleteClub(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+
+	// メンバー・参加申請・共有ルートライブラリはカスケード削除される
+	rows, err := r.queries.DeleteClub(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("failed to delete club: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *clubRepositoryImpl) GetMembership(ctx context.Context, clubID string, userID string) (*club.Membership, error) {
+	cid, err := uuid.Parse(clubID)
+	if err != nil {
+		return nil, domainerror.New("membership not found", domainerror.ErrNotFound)
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	row, err := r.queries.GetClubMember(ctx, dbgen.GetClubMemberParams{ClubID: cid, UserID: uid})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("membership not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	return club.ReconstructMembership(
+		row.ClubID.String(),
+		row.UserID.String(),
+		row.UserName,
+		club.Role(row.Role),
+		row.CreatedAt.Format(time.RFC3339),
+	), nil
+}
+
+func (r *clubRepositoryImpl) GetMemberships(ctx context.Context, clubID string) ([]*club.Membership, error) {
+	cid, err := uuid.Parse(clubID)
+	if err != nil {
+		return nil, domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.ListClubMembers(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	memberships := make([]*club.Membership, 0, len(rows))
+	for _, row := range rows {
+		memberships = append(memberships, club.ReconstructMembership(
+			row.ClubID.String(),
+			row.UserID.String(),
+			row.UserName,
+			club.Role(row.Role),
+			row.CreatedAt.Format(time.RFC3339),
+		))
+	}
+	return memberships, nil
+}
+
+func (r *clubRepositoryImpl) SaveMembership(ctx context.Context, m *club.Membership) error {
+	clubID, err := uuid.Parse(m.ClubID())
+	if err != nil {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+	userID, err := uuid.Parse(m.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	err = r.queries.UpsertClubMember(ctx, dbgen.UpsertClubMemberParams{
+		ClubID: clubID,
+		UserID: userID,
+		Role:   string(m.Role()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save membership: %w", err)
+	}
+	return nil
+}
+
+func (r *clubRepositoryImpl) DeleteMembership(ctx context.Context, clubID string, userID string) error {
+	cid, err := uuid.Parse(clubID)
+	if err != nil {
+		return domainerror.New("membership not found", domainerror.ErrNotFound)
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return domainerror.New("membership not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteClubMember(ctx, dbgen.DeleteClubMemberParams{ClubID: cid, UserID: uid})
+	if err != nil {
+		return fmt.Errorf("failed to delete membership: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("membership not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *clubRepositoryImpl) GetJoinRequest(ctx context.Context, clubID string, userID string) (*club.JoinRequest, error) {
+	cid, err := uuid.Parse(clubID)
+	if err != nil {
+		return nil, domainerror.New("join request not found", domainerror.ErrNotFound)
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainerror.New("join request not found", domainerror.ErrNotFound)
+	}
+
+	row, err := r.queries.GetClubJoinRequest(ctx, dbgen.GetClubJoinRequestParams{ClubID: cid, UserID: uid})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("join request not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	return club.ReconstructJoinRequest(
+		row.ClubID.String(),
+		row.UserID.String(),
+		row.UserName,
+		row.Message,
+		row.CreatedAt.Format(time.RFC3339),
+	), nil
+}
+
+func (r *clubRepositoryImpl) GetJoinRequests(ctx context.Context, clubID string) ([]*club.JoinRequest, error) {
+	cid, err := uuid.Parse(clubID)
+	if err != nil {
+		return nil, domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.ListClubJoinRequests(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	requests := make([]*club.JoinRequest, 0, len(rows))
+	for _, row := range rows {
+		requests = append(requests, club.ReconstructJoinRequest(
+			row.ClubID.String(),
+			row.UserID.String(),
+			row.UserName,
+			row.Message,
+			row.CreatedAt.Format(time.RFC3339),
+		))
+	}
+	return requests, nil
+}
+
+func (r *clubRepositoryImpl) SaveJoinRequest(ctx context.Context, req *club.JoinRequest) error {
+	clubID, err := uuid.Parse(req.ClubID())
+	if err != nil {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+	userID, err := uuid.Parse(req.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	rows, err := r.queries.CreateClubJoinRequest(ctx, dbgen.CreateClubJoinRequestParams{
+		ClubID:  clubID,
+		UserID:  userID,
+		Message: req.Message(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create join request: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("join request already exists", domainerror.ErrConflict)
+	}
+	return nil
+}
+
+func (r *clubRepositoryImpl) DeleteJoinRequest(ctx context.Context, clubID string, userID string) error {
+	cid, err := uuid.Parse(clubID)
+	if err != nil {
+		return domainerror.New("join request not found", domainerror.ErrNotFound)
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return domainerror.New("join request not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteClubJoinRequest(ctx, dbgen.DeleteClubJoinRequestParams{ClubID: cid, UserID: uid})
+	if err != nil {
+		return fmt.Errorf("failed to delete join request: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("join request not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *clubRepositoryImpl) GetLibraryRouteIDs(ctx context.Context, clubID string) ([]string, error) {
+	cid, err := uuid.Parse(clubID)
+	if err != nil {
+		return nil, domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.ListClubRouteIDs(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(rows))
+	for _, id := range rows {
+		ids = append(ids, id.String())
+	}
+	return ids, nil
+}
+
+func (r *clubRepositoryImpl) AddLibraryRoute(ctx context.Context, clubID string, routeID string, addedBy string) error {
+	cid, err := uuid.Parse(clubID)
+	if err != nil {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+	rid, err := uuid.Parse(routeID)
+	if err != nil {
+		return domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	addedByID, err := toNullUUID(&addedBy)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	rows, err := r.queries.CreateClubRoute(ctx, dbgen.CreateClubRouteParams{
+		ClubID:  cid,
+		RouteID: rid,
+		AddedBy: addedByID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add library route: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("route is already in the library", domainerror.ErrConflict)
+	}
+	return nil
+}
+
+func (r *clubRepositoryImpl) RemoveLibraryRoute(ctx context.Context, clubID string, routeID string) error {
+	cid, err := uuid.Parse(clubID)
+	if err != nil {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+	rid, err := uuid.Parse(routeID)
+	if err != nil {
+		return domainerror.New("route not found in the library", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteClubRoute(ctx, dbgen.DeleteClubRouteParams{ClubID: cid, RouteID: rid})
+	if err != nil {
+		return fmt.Errorf("failed to remove library route: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("route not found in the library", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func clubFromRow(row dbgen.GetClubByIDRow) *club.Club {
+	return club.ReconstructClub(
+		row.ID.String(),
+		row.Name,
+		row.Description,
+		row.MemberCount,
+		row.CreatedAt.Format(time.RFC3339),
+		row.UpdatedAt.Format(time.RFC3339),
+	)
+}
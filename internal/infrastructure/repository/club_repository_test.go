@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/club"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+)
+
+const fixtureClubID = "019b5a66-0000-7000-8000-000000000001"
+
+func TestClubRepository_GetClubs(t *testing.T) {
+	q := GetTestQueries()
+	clubRepository := NewClubRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	c, err := clubRepository.GetClubByID(ctx, fixtureClubID)
+	if err != nil {
+		t.Fatalf("GetClubByID() error = %v", err)
+	}
+	if c.Name() != "多摩川サイクリング部" || c.MemberCount() != 2 {
+		t.Errorf("club = %+v", c)
+	}
+	if _, err := clubRepository.GetClubByID(ctx, "invalid"); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetClubByID() error = %v, want not found", err)
+	}
+
+	clubs, err := clubRepository.GetClubsByUserID(ctx, "019b5a46-1e77-7b9d-ac62-b438a0fc89cb")
+	if err != nil {
+		t.Fatalf("GetClubsByUserID() error = %v", err)
+	}
+	if len(clubs) != 1 || clubs[0].ID().String() != fixtureClubID || clubs[0].MemberCount() != 2 {
+		t.Errorf("clubs = %+v", clubs)
+	}
+
+	// 参加申請中のユーザーはまだ所属していない
+	ids, err := clubRepository.GetClubIDsByUserID(ctx, "019b5a46-a03e-7ea3-af09-86f74ff39aa2")
+	if err != nil {
+		t.Fatalf("GetClubIDsByUserID() error = %v", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("ids = %v, want empty", ids)
+	}
+}
+
+func TestClubRepository_SaveUpdateDeleteClub(t *testing.T) {
+	q := GetTestQueries()
+	clubRepository := NewClubRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	c, err := club.NewClub("ヤビツ峠を登る会", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := clubRepository.SaveClub(ctx, c); err != nil {
+		t.Fatalf("SaveClub() error = %v", err)
+	}
+	owner, _ := club.NewMembership(c.ID().String(), fixtureUserID, club.RoleOwner)
+	if err := clubRepository.SaveMembership(ctx, owner); err != nil {
+		t.Fatalf("SaveMembership() error = %v", err)
+	}
+
+	if err := c.Update("ヤビツ峠を登る会", "毎月第1日曜日"); err != nil {
+		t.Fatal(err)
+	}
+	if err := clubRepository.UpdateClub(ctx, c); err != nil {
+		t.Fatalf("UpdateClub() error = %v", err)
+	}
+	saved, err := clubRepository.GetClubByID(ctx, c.ID().String())
+	if err != nil {
+		t.Fatalf("GetClubByID() error = %v", err)
+	}
+	if saved.Description() != "毎月第1日曜日" || saved.MemberCount() != 1 {
+		t.Errorf("saved = %+v", saved)
+	}
+
+	ids, err := clubRepository.GetClubIDsByUserID(ctx, fixtureUserID)
+	if err != nil {
+		t.Fatalf("GetClubIDsByUserID() error = %v", err)
+	}
+	if len(ids) != 2 {
+		t.Errorf("ids = %v, want 2 clubs", ids)
+	}
+
+	if err := clubRepository.DeleteClub(ctx, c.ID().String()); err != nil {
+		t.Fatalf("DeleteClub() error = %v", err)
+	}
+	if err := clubRepository.DeleteClub(ctx, c.ID().String()); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeleteClub() error = %v, want not found", err)
+	}
+}
+
+func TestClubRepository_Memberships(t *testing.T) {
+	q := GetTestQueries()
+	clubRepository := NewClubRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	const memberID = "019b5a46-1e77-7b9d-ac62-b438a0fc89cb"
+	m, err := clubRepository.GetMembership(ctx, fixtureClubID, memberID)
+	if err != nil {
+		t.Fatalf("GetMembership() error = %v", err)
+	}
+	if m.Role() != club.RoleMember || m.UserName() == "" {
+		t.Errorf("membership = %+v", m)
+	}
+
+	// 役割を変更する
+	admin := club.ReconstructMembership(fixtureClubID, memberID, "", club.RoleAdmin, "")
+	if err := clubRepository.SaveMembership(ctx, admin); err != nil {
+		t.Fatalf("SaveMembership() error = %v", err)
+	}
+	memberships, err := clubRepository.GetMemberships(ctx, fixtureClubID)
+	if err != nil {
+		t.Fatalf("GetMemberships() error = %v", err)
+	}
+	if len(memberships) != 2 || memberships[0].Role() != club.RoleOwner || memberships[1].Role() != club.RoleAdmin {
+		t.Errorf("memberships = %+v", memberships)
+	}
+
+	if err := clubRepository.DeleteMembership(ctx, fixtureClubID, memberID); err != nil {
+		t.Fatalf("DeleteMembership() error = %v", err)
+	}
+	if _, err := clubRepository.GetMembership(ctx, fixtureClubID, memberID); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetMembership() error = %v, want not found", err)
+	}
+	if err := clubRepository.DeleteMembership(ctx, fixtureClubID, memberID); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeleteMembership() error = %v, want not found", err)
+	}
+}
+
+func TestClubRepository_JoinRequests(t *testing.T) {
+	q := GetTestQueries()
+	clubRepository := NewClubRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	const requesterID = "019b5a46-a03e-7ea3-af09-86f74ff39aa2"
+	requests, err := clubRepository.GetJoinRequests(ctx, fixtureClubID)
+	if err != nil {
+		t.Fatalf("GetJoinRequests() error = %v", err)
+	}
+	if len(requests) != 1 || requests[0].UserID() != requesterID || requests[0].Message() != "ヤビツ峠によく行きます" {
+		t.Errorf("requests = %+v", requests)
+	}
+
+	duplicate, _ := club.NewJoinRequest(fixtureClubID, requesterID, "")
+	if err := clubRepository.SaveJoinRequest(ctx, duplicate); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("SaveJoinRequest() error = %v, want conflict", err)
+	}
+
+	if err := clubRepository.DeleteJoinRequest(ctx, fixtureClubID, requesterID); err != nil {
+		t.Fatalf("DeleteJoinRequest() error = %v", err)
+	}
+	if _, err := clubRepository.GetJoinRequest(ctx, fixtureClubID, requesterID); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetJoinRequest() error = %v, want not found", err)
+	}
+	if err := clubRepository.SaveJoinRequest(ctx, duplicate); err != nil {
+		t.Fatalf("SaveJoinRequest() error = %v", err)
+	}
+}
+
+func TestClubRepository_LibraryRoutes(t *testing.T) {
+	q := GetTestQueries()
+	clubRepository := NewClubRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	const yabitsuRouteID = "019b5a50-0000-7000-8000-000000000004"
+	if err := clubRepository.AddLibraryRoute(ctx, fixtureClubID, yabitsuRouteID, fixtureUserID); err != nil {
+		t.Fatalf("AddLibraryRoute() error = %v", err)
+	}
+	if err := clubRepository.AddLibraryRoute(ctx, fixtureClubID, yabitsuRouteID, fixtureUserID); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("AddLibraryRoute() error = %v, want conflict", err)
+	}
+
+	ids, err := clubRepository.GetLibraryRouteIDs(ctx, fixtureClubID)
+	if err != nil {
+		t.Fatalf("GetLibraryRouteIDs() error = %v", err)
+	}
+	if want := []string{yabitsuRouteID, fixtureTamagawaRouteID}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+
+	if err := clubRepository.RemoveLibraryRoute(ctx, fixtureClubID, fixtureTamagawaRouteID); err != nil {
+		t.Fatalf("RemoveLibraryRoute() error = %v", err)
+	}
+	if err := clubRepository.RemoveLibraryRoute(ctx, fixtureClubID, fixtureTamagawaRouteID); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("RemoveLibraryRoute() error = %v, want not found", err)
+	}
+}
+
+func TestRouteRepository_ClubVisibility(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	r, err := routeRepository.GetRouteByID(ctx, fixtureTamagawaRouteID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := route.NewViewer(fixtureUserID, []string{fixtureClubID})
+	if err := r.ChangeVisibility(owner, route.VisibilityClub, new(fixtureClubID)); err != nil {
+		t.Fatal(err)
+	}
+	if err := routeRepository.UpdateRoute(ctx, r); err != nil {
+		t.Fatalf("UpdateRoute() error = %v", err)
+	}
+
+	saved, err := routeRepository.GetRouteByID(ctx, fixtureTamagawaRouteID)
+	if err != nil {
+		t.Fatalf("GetRouteByID() error = %v", err)
+	}
+	if saved.Visibility() != route.VisibilityClub || saved.ClubID() == nil || *saved.ClubID() != fixtureClubID {
+		t.Errorf("Visibility() = %d, ClubID() = %v", saved.Visibility(), saved.ClubID())
+	}
+	if !saved.IsVisibleTo(route.NewViewer("019b5a46-1e77-7b9d-ac62-b438a0fc89cb", []string{fixtureClubID})) {
+		t.Error("club member cannot view the route")
+	}
+
+	// クラブを削除すると共有先が外れる
+	if err := NewClubRepository(q).DeleteClub(ctx, fixtureClubID); err != nil {
+		t.Fatal(err)
+	}
+	saved, err = routeRepository.GetRouteByID(ctx, fixtureTamagawaRouteID)
+	if err != nil {
+		t.Fatalf("GetRouteByID() error = %v", err)
+	}
+	if saved.ClubID() != nil {
+		t.Errorf("ClubID() = %v, want nil", *saved.ClubID())
+	}
+}
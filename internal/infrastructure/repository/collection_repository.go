@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/collection"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type collectionRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// コレクションリポジトリの実装
+func NewCollectionRepository(queries *dbgen.Queries) collection.ICollectionRepository {
+	return &collectionRepositoryImpl{queries: queries}
+}
+
+func (r *collectionRepositoryImpl) GetCollectionByID(ctx context.Context, id string) (*collection.Collection, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, domainerror.New("collection not found", domainerror.ErrNotFound)
+	}
+
+	row, err := r.queries.GetCollectionByID(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("collection not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	collections, err := r.withRoutes(ctx, []dbgen.Collection{row})
+	if err != nil {
+		return nil, err
+	}
+	return collections[0], nil
+}
+
+func (r *collectionRepositoryImpl) GetCollectionsByUserID(ctx context.Context, userID string) ([]*collection.Collection, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	rows, err := r.queries.ListCollectionsByUserID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return r.withRoutes(ctx, rows)
+}
+
+func (r *collectionRepositoryImpl) SaveCollection(ctx context.Context, c *collection.Collection) error {
+	collectionID, err := uuid.Parse(c.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid collection id: %w", err)
+	}
+	userID, err := uuid.Parse(c.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	err = r.queries.CreateCollection(ctx, dbgen.CreateCollectionParams{
+		ID:          collectionID,
+		UserID:      userID,
+		Name:        c.Name(),
+		Description: c.Description(),
+		Visibility:  c.Visibility(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create collection: %w", err)
+	}
+	return r.SaveCollectionRoutes(ctx, c)
+}
+
+func (r *collectionRepositoryImpl) UpdateCollection(ctx context.Context, c *collection.Collection) error {
+	collectionID, err := uuid.Parse(c.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid collection id: %w", err)
+	}
+
+	rows, err := r.queries.UpdateCollection(ctx, dbgen.UpdateCollectionParams{
+		Name:        c.Name(),
+		Description: c.Description(),
+		Visibility:  c.Visibility(),
+		ID:          collectionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update collection: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("collection not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *collectionRepositoryImpl) DeleteCollection(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return domainerror.New("collection not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteCollection(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("failed to delete collection: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("collection not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+// SaveCollectionRoutes はコレクション内のルートを並び順どおりに保存し直す
+func (r *collectionRepositoryImpl) SaveCollectionRoutes(ctx context.Context, c *collection.Collection) error {
+	collectionID, err := uuid.Parse(c.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid collection id: %w", err)
+	}
+
+	if err := r.queries.DeleteCollectionRoutes(ctx, collectionID); err != nil {
+		return fmt.Errorf("failed to delete collection routes: %w", err)
+	}
+	for i, id := range c.RouteIDs() {
+		routeID, err := uuid.Parse(id)
+		if err != nil {
+			return fmt.Errorf("invalid route id: %w", err)
+		}
+		err = r.queries.CreateCollectionRoute(ctx, dbgen.CreateCollectionRouteParams{
+			CollectionID: collectionID,
+			RouteID:      routeID,
+			Position:     int32(i),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create collection route: %w", err)
+		}
+	}
+	return nil
+}
+
+// withRoutes はコレクション内のルートのIDをまとめて取得して復元する
+func (r *collectionRepositoryImpl) withRoutes(ctx context.Context, rows []dbgen.Collection) ([]*collection.Collection, error) {
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	routeRows, err := r.queries.ListCollectionRoutesByCollectionIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection routes: %w", err)
+	}
+	routeIDs := make(map[uuid.UUID][]string, len(rows))
+	for _, rr := range routeRows {
+		routeIDs[rr.CollectionID] = append(routeIDs[rr.CollectionID], rr.RouteID.String())
+	}
+
+	collections := make([]*collection.Collection, 0, len(rows))
+	for _, row := range rows {
+		collections = append(collections, collection.ReconstructCollection(
+			row.ID.String(),
+			row.UserID.String(),
+			row.Name,
+			row.Description,
+			row.Visibility,
+			routeIDs[row.ID],
+			row.CreatedAt.Format(time.RFC3339),
+			row.UpdatedAt.Format(time.RFC3339),
+		))
+	}
+	return collections, nil
+}
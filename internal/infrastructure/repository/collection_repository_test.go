@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/collection"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+)
+
+func TestCollectionRepository(t *testing.T) {
+	q := GetTestQueries()
+	collectionRepository := NewCollectionRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	collections, err := collectionRepository.GetCollectionsByUserID(ctx, fixtureUserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 新しい順
+	if len(collections) != 2 || collections[0].Name() != "あとで走る" || collections[1].Name() != "東京の定番" {
+		t.Fatalf("collections = %+v", collections)
+	}
+	if got := collections[1].RouteIDs(); len(got) != 3 || got[0] != "019b5a50-0000-7000-8000-000000000006" {
+		t.Errorf("RouteIDs() = %v", got)
+	}
+
+	c, _ := collection.NewCollection(fixtureUserID, "週末", "", 1)
+	_ = c.AddRoute("019b5a50-0000-7000-8000-000000000002")
+	if err := collectionRepository.SaveCollection(ctx, c); err != nil {
+		t.Fatalf("SaveCollection() error = %v", err)
+	}
+
+	_ = c.Update("週末のロングライド", "100km以上", 0)
+	_ = c.AddRoute("019b5a50-0000-7000-8000-000000000007")
+	_ = c.ReorderRoutes([]string{"019b5a50-0000-7000-8000-000000000007", "019b5a50-0000-7000-8000-000000000002"})
+	if err := collectionRepository.UpdateCollection(ctx, c); err != nil {
+		t.Fatalf("UpdateCollection() error = %v", err)
+	}
+	if err := collectionRepository.SaveCollectionRoutes(ctx, c); err != nil {
+		t.Fatalf("SaveCollectionRoutes() error = %v", err)
+	}
+
+	saved, err := collectionRepository.GetCollectionByID(ctx, c.ID().String())
+	if err != nil {
+		t.Fatalf("GetCollectionByID() error = %v", err)
+	}
+	if saved.Name() != "週末のロングライド" || saved.Description() != "100km以上" || saved.Visibility() != 0 {
+		t.Errorf("saved = %+v", saved)
+	}
+	if !reflect.DeepEqual(saved.RouteIDs(), c.RouteIDs()) {
+		t.Errorf("RouteIDs() = %v, want %v", saved.RouteIDs(), c.RouteIDs())
+	}
+
+	if err := collectionRepository.DeleteCollection(ctx, c.ID().String()); err != nil {
+		t.Fatalf("DeleteCollection() error = %v", err)
+	}
+	if _, err := collectionRepository.GetCollectionByID(ctx, c.ID().String()); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetCollectionByID() error = %v, want not found", err)
+	}
+	if err := collectionRepository.DeleteCollection(ctx, c.ID().String()); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeleteCollection() error = %v, want not found", err)
+	}
+}
@@ -0,0 +1,372 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/event"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/paulmach/orb"
+)
+
+type eventRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// イベントリポジトリの実装
+func NewEventRepository(queries *dbgen.Queries) event.IEventRepository {
+	return &eventRepositoryImpl{queries: queries}
+}
+
+func (r *eventRepositoryImpl) GetEventByID(ctx context.Context, id string) (*event.Event, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, domainerror.New("event not found", domainerror.ErrNotFound)
+	}
+
+	row, err := r.queries.GetEventByID(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("event not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	return eventFromRow(row)
+}
+
+func (r *eventRepositoryImpl) GetUpcomingEvents(ctx context.Context, criteria *event.UpcomingEventsCriteria) ([]*event.Event, error) {
+	// location/radius が nil の場合はセンチネル値を使用して範囲検索をスキップ
+	radiusM := float64(-1)
+	location := dbgen.OrbGeometry{Geometry: orb.Point{}}
+	if criteria.Location() != nil && criteria.Radius() != nil {
+		radiusM = *criteria.Radius()
+		location = dbgen.OrbGeometry{Geometry: *criteria.Location()}
+	}
+
+	rows, err := r.queries.ListUpcomingEvents(ctx, dbgen.ListUpcomingEventsParams{
+		StartsFrom: criteria.From(),
+		RadiusM:    radiusM,
+		Location:   location,
+		LimitCount: criteria.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*event.Event, 0, len(rows))
+	for _, row := range rows {
+		e, err := eventFromRow(dbgen.GetEventByIDRow(row))
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+func (r *eventRepositoryImpl) SaveEvent(ctx context.Context, e *event.Event) error {
+	eventID, err := uuid.Parse(e.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid event id: %w", err)
+	}
+	userID, err := uuid.Parse(e.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	routeID, err := uuid.Parse(e.RouteID())
+	if err != nil {
+		return domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	clubID, err := toNullUUID(e.ClubID())
+	if err != nil {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+
+	err = r.queries.CreateEvent(ctx, dbgen.CreateEventParams{
+		ID:               eventID,
+		UserID:           userID,
+		RouteID:          routeID,
+		Title:            e.Title(),
+		Description:      e.Description(),
+		StartsAt:         e.StartsAt(),
+		MeetingPoint:     dbgen.OrbGeometry{Geometry: e.MeetingPoint()},
+		MeetingPointName: e.MeetingPointName(),
+		Capacity:         e.Capacity(),
+		Visibility:       e.Visibility(),
+		ClubID:           clubID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create event: %w", err)
+	}
+	return nil
+}
+
+func (r *eventRepositoryImpl) UpdateEvent(ctx context.Context, e *event.Event) error {
+	eventID, err := uuid.Parse(e.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid event id: %w", err)
+	}
+	routeID, err := uuid.Parse(e.RouteID())
+	if err != nil {
+		return domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	clubID, err := toNullUUID(e.ClubID())
+	if err != nil {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.UpdateEvent(ctx, dbgen.UpdateEventParams{
+		RouteID:          routeID,
+		Title:            e.Title(),
+		Description:      e.Description(),
+		StartsAt:         e.StartsAt(),
+		MeetingPoint:     dbgen.OrbGeometry{Geometry: e.MeetingPoint()},
+		MeetingPointName: e.MeetingPointName(),
+		Capacity:         e.Capacity(),
+		Visibility:       e.Visibility(),
+		ClubID:           clubID,
+		CancelledAt:      e.CancelledAt(),
+		CancelReason:     e.CancelReason(),
+		ID:               eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update event: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("event not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *eventRepositoryImpl) GetRSVP(ctx context.Context, eventID string, userID string) (*event.RSVP, error) {
+	eid, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, domainerror.New("rsvp not found", domainerror.ErrNotFound)
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	row, err := r.queries.GetEventRSVP(ctx, dbgen.GetEventRSVPParams{EventID: eid, UserID: uid})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("rsvp not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	return event.ReconstructRSVP(
+		row.EventID.String(),
+		row.UserID.String(),
+		row.UserName,
+		event.RSVPStatus(row.Status),
+		row.UpdatedAt.Format(time.RFC3339),
+	), nil
+}
+
+func (r *eventRepositoryImpl) GetRSVPs(ctx context.Context, eventID string) ([]*event.RSVP, error) {
+	eid, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, domainerror.New("event not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.ListEventRSVPs(ctx, eid)
+	if err != nil {
+		return nil, err
+	}
+	rsvps := make([]*event.RSVP, 0, len(rows))
+	for _, row := range rows {
+		rsvps = append(rsvps, event.ReconstructRSVP(
+			row.EventID.String(),
+			row.UserID.String(),
+			row.UserName,
+			event.RSVPStatus(row.Status),
+			row.UpdatedAt.Format(time.RFC3339),
+		))
+	}
+	return rsvps, nil
+}
+
+// SaveRSVP は返事を保存する。トランザクション内で呼び出す
+// 参加する場合は、イベントを排他ロックしてから他のユーザーの参加者数を数え直し、上限を超えないようにする
+func (r *eventRepositoryImpl) SaveRSVP(ctx context.Context, rsvp *event.RSVP) error {
+	eventID, err := uuid.Parse(rsvp.EventID())
+	if err != nil {
+		return domainerror.New("event not found", domainerror.ErrNotFound)
+	}
+	userID, err := uuid.Parse(rsvp.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	capacity, err := r.queries.LockEventCapacity(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainerror.New("event not found", domainerror.ErrNotFound)
+		}
+		return fmt.Errorf("failed to lock event: %w", err)
+	}
+	if rsvp.Status() == event.RSVPGoing && capacity != nil {
+		going, err := r.queries.CountGoingEventRSVPs(ctx, dbgen.CountGoingEventRSVPsParams{
+			EventID:       eventID,
+			ExcludeUserID: userID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count attendees: %w", err)
+		}
+		if going >= int64(*capacity) {
+			return domainerror.New("event is full", domainerror.ErrConflict)
+		}
+	}
+
+	err = r.queries.UpsertEventRSVP(ctx, dbgen.UpsertEventRSVPParams{
+		EventID: eventID,
+		UserID:  userID,
+		Status:  string(rsvp.Status()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save rsvp: %w", err)
+	}
+	return nil
+}
+
+func (r *eventRepositoryImpl) GetComment(ctx context.Context, id string) (*event.Comment, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, domainerror.New("comment not found", domainerror.ErrNotFound)
+	}
+
+	row, err := r.queries.GetEventComment(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("comment not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	return event.ReconstructComment(
+		row.ID.String(),
+		row.EventID.String(),
+		row.UserID.String(),
+		row.UserName,
+		row.Body,
+		row.CreatedAt.Format(time.RFC3339),
+	), nil
+}
+
+// GetComments はコメントを古い順に limit+1 件まで取得し、ページにする
+func (r *eventRepositoryImpl) GetComments(ctx context.Context, criteria *event.CommentCriteria) (*event.CommentPage, error) {
+	eid, err := uuid.Parse(criteria.EventID())
+	if err != nil {
+		return nil, domainerror.New("event not found", domainerror.ErrNotFound)
+	}
+	hasCursor, cursorSortKey, cursorID, err := cursorParams(criteria.After())
+	if err != nil {
+		return nil, err
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	rows, err := r.queries.ListEventComments(ctx, dbgen.ListEventCommentsParams{
+		EventID:       eid,
+		HasCursor:     hasCursor,
+		CursorSortKey: cursorSortKey,
+		CursorID:      cursorID,
+		LimitCount:    criteria.Limit() + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	page := &event.CommentPage{Items: make([]*event.Comment, 0, len(rows))}
+	for i, row := range rows {
+		if int32(i) == criteria.Limit() {
+			last := rows[i-1]
+			page.Next, err = pagination.NewCursor(last.SortKey, last.ID.String())
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		page.Items = append(page.Items, event.ReconstructComment(
+			row.ID.String(),
+			row.EventID.String(),
+			row.UserID.String(),
+			row.UserName,
+			row.Body,
+			row.CreatedAt.Format(time.RFC3339),
+		))
+	}
+	return page, nil
+}
+
+func (r *eventRepositoryImpl) SaveComment(ctx context.Context, c *event.Comment) error {
+	commentID, err := uuid.Parse(c.ID())
+	if err != nil {
+		return fmt.Errorf("invalid comment id: %w", err)
+	}
+	eventID, err := uuid.Parse(c.EventID())
+	if err != nil {
+		return domainerror.New("event not found", domainerror.ErrNotFound)
+	}
+	userID, err := uuid.Parse(c.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	err = r.queries.CreateEventComment(ctx, dbgen.CreateEventCommentParams{
+		ID:      commentID,
+		EventID: eventID,
+		UserID:  userID,
+		Body:    c.Body(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+	return nil
+}
+
+func (r *eventRepositoryImpl) DeleteComment(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return domainerror.New("comment not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteEventComment(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("comment not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func eventFromRow(row dbgen.GetEventByIDRow) (*event.Event, error) {
+	meetingPoint, ok := row.MeetingPoint.Geometry.(orb.Point)
+	if !ok {
+		return nil, fmt.Errorf("event %s has invalid meeting point", row.ID)
+	}
+	return event.ReconstructEvent(
+		row.ID.String(),
+		row.UserID.String(),
+		event.Details{
+			RouteID:          row.RouteID.String(),
+			Title:            row.Title,
+			Description:      row.Description,
+			StartsAt:         row.StartsAt,
+			MeetingPoint:     meetingPoint,
+			MeetingPointName: row.MeetingPointName,
+			Capacity:         row.Capacity,
+			Visibility:       row.Visibility,
+			ClubID:           fromNullUUID(row.ClubID),
+		},
+		row.CancelledAt,
+		row.CancelReason,
+		row.GoingCount,
+		row.CreatedAt.Format(time.RFC3339),
+		row.UpdatedAt.Format(time.RFC3339),
+	), nil
+}
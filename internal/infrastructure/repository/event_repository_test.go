@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/event"
+	"github.com/paulmach/orb"
+)
+
+const fixtureEventID = "019b5a65-0000-7000-8000-000000000001"
+
+func TestEventRepository_GetEventByID(t *testing.T) {
+	q := GetTestQueries()
+	eventRepository := NewEventRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	e, err := eventRepository.GetEventByID(ctx, fixtureEventID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.Title() != "多摩川朝練" || e.MeetingPointName() != "二子玉川駅" || e.IsCancelled() {
+		t.Errorf("event = %+v", e)
+	}
+	if e.Capacity() == nil || *e.Capacity() != 2 || e.GoingCount() != 1 {
+		t.Errorf("Capacity() = %v, GoingCount() = %d", e.Capacity(), e.GoingCount())
+	}
+	if e.MeetingPoint() != (orb.Point{139.6267, 35.6115}) {
+		t.Errorf("MeetingPoint() = %v", e.MeetingPoint())
+	}
+
+	if _, err := eventRepository.GetEventByID(ctx, "invalid"); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetEventByID() error = %v, want not found", err)
+	}
+}
+
+func TestEventRepository_GetUpcomingEvents(t *testing.T) {
+	q := GetTestQueries()
+	eventRepository := NewEventRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	from := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		location *orb.Point
+		radius   *float64
+		from     time.Time
+		want     []string
+	}{
+		{name: "中止したイベントは含まない", from: from, want: []string{fixtureEventID}},
+		{name: "集合場所の近く", location: &orb.Point{139.63, 35.61}, radius: new(5000.0), from: from, want: []string{fixtureEventID}},
+		{name: "集合場所から遠い", location: &orb.Point{135.5, 34.7}, radius: new(5000.0), from: from, want: []string{}},
+		{name: "開始後のイベントは含まない", from: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC), want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := event.NewUpcomingEventsCriteria(tt.location, tt.radius, tt.from, 20)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := eventRepository.GetUpcomingEvents(ctx, criteria)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids := make([]string, 0, len(got))
+			for _, e := range got {
+				ids = append(ids, e.ID().String())
+			}
+			if len(ids) != len(tt.want) || (len(ids) > 0 && ids[0] != tt.want[0]) {
+				t.Errorf("ids = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventRepository_SaveAndUpdateEvent(t *testing.T) {
+	q := GetTestQueries()
+	eventRepository := NewEventRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	e, err := event.NewEvent(fixtureUserID, event.Details{
+		RouteID:          "019b5a50-0000-7000-8000-000000000001",
+		Title:            "皇居ナイトラン",
+		StartsAt:         time.Date(2030, 7, 1, 19, 0, 0, 0, time.UTC),
+		MeetingPoint:     orb.Point{139.7501, 35.68},
+		MeetingPointName: "桜田門",
+		Visibility:       1,
+	}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := eventRepository.SaveEvent(ctx, e); err != nil {
+		t.Fatalf("SaveEvent() error = %v", err)
+	}
+
+	if err := e.Cancel("台風のため", now); err != nil {
+		t.Fatal(err)
+	}
+	if err := eventRepository.UpdateEvent(ctx, e); err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+
+	saved, err := eventRepository.GetEventByID(ctx, e.ID().String())
+	if err != nil {
+		t.Fatalf("GetEventByID() error = %v", err)
+	}
+	if !saved.IsCancelled() || saved.CancelReason() != "台風のため" || saved.Capacity() != nil {
+		t.Errorf("saved = %+v", saved)
+	}
+}
+
+func TestEventRepository_SaveRSVP(t *testing.T) {
+	q := GetTestQueries()
+	eventRepository := NewEventRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	// 未定から参加に変えると上限の2人に達する
+	rsvp, _ := event.NewRSVP(fixtureEventID, "019b5a46-1e77-7b9d-ac62-b438a0fc89cb", event.RSVPGoing)
+	if err := eventRepository.SaveRSVP(ctx, rsvp); err != nil {
+		t.Fatalf("SaveRSVP() error = %v", err)
+	}
+	full, _ := event.NewRSVP(fixtureEventID, "019b5a46-a03e-7ea3-af09-86f74ff39aa2", event.RSVPGoing)
+	if err := eventRepository.SaveRSVP(ctx, full); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("SaveRSVP() error = %v, want conflict", err)
+	}
+	// 上限に達していても未定の返事はできる
+	maybe, _ := event.NewRSVP(fixtureEventID, "019b5a46-a03e-7ea3-af09-86f74ff39aa2", event.RSVPMaybe)
+	if err := eventRepository.SaveRSVP(ctx, maybe); err != nil {
+		t.Fatalf("SaveRSVP() error = %v", err)
+	}
+
+	rsvps, err := eventRepository.GetRSVPs(ctx, fixtureEventID)
+	if err != nil {
+		t.Fatalf("GetRSVPs() error = %v", err)
+	}
+	if len(rsvps) != 3 {
+		t.Fatalf("len(rsvps) = %d, want 3", len(rsvps))
+	}
+	got, err := eventRepository.GetRSVP(ctx, fixtureEventID, "019b5a46-1e77-7b9d-ac62-b438a0fc89cb")
+	if err != nil || got.Status() != event.RSVPGoing || got.UserName() == "" {
+		t.Errorf("GetRSVP() = %+v, %v", got, err)
+	}
+	if _, err := eventRepository.GetRSVP(ctx, "019b5a65-0000-7000-8000-000000000002", fixtureUserID); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetRSVP() error = %v, want not found", err)
+	}
+}
+
+func TestEventRepository_Comments(t *testing.T) {
+	q := GetTestQueries()
+	eventRepository := NewEventRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	c, err := event.NewComment(fixtureEventID, fixtureUserID, "小雨なら決行します")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := eventRepository.SaveComment(ctx, c); err != nil {
+		t.Fatalf("SaveComment() error = %v", err)
+	}
+
+	criteria, err := event.NewCommentCriteria(fixtureEventID, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := eventRepository.GetComments(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Body() != "雨の場合は中止ですか？" || page.Items[1].ID() != c.ID() || page.Next != nil {
+		t.Errorf("page = %+v", page)
+	}
+
+	// 古い順に1件ずつ取得し、カーソルの後から続ける
+	criteria, err = event.NewCommentCriteria(fixtureEventID, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err = eventRepository.GetComments(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Body() != "雨の場合は中止ですか？" || page.Next == nil {
+		t.Fatalf("first page = %+v", page)
+	}
+	criteria, err = event.NewCommentCriteria(fixtureEventID, 1, page.Next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err = eventRepository.GetComments(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID() != c.ID() || page.Next != nil {
+		t.Errorf("second page = %+v", page)
+	}
+
+	if err := eventRepository.DeleteComment(ctx, c.ID()); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+	if _, err := eventRepository.GetComment(ctx, c.ID()); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetComment() error = %v, want not found", err)
+	}
+	if err := eventRepository.DeleteComment(ctx, c.ID()); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeleteComment() error = %v, want not found", err)
+	}
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/feed"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+)
+
+type feedRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// フィードリポジトリの実装
+// 出来事は行為者ごとに1行だけ記録し、フィードは閲覧時にフォローしているユーザーの出来事を集めて組み立てる
+func NewFeedRepository(queries *dbgen.Queries) feed.IFeedRepository {
+	return &feedRepositoryImpl{queries: queries}
+}
+
+func (r *feedRepositoryImpl) SaveEvent(ctx context.Context, e *feed.Event) error {
+	id, err := uuid.Parse(e.ID())
+	if err != nil {
+		return fmt.Errorf("invalid feed event id: %w", err)
+	}
+	actorID, err := uuid.Parse(e.ActorID())
+	if err != nil {
+		return fmt.Errorf("invalid actor id: %w", err)
+	}
+	subjectID, err := uuid.Parse(e.SubjectID())
+	if err != nil {
+		return fmt.Errorf("invalid subject id: %w", err)
+	}
+
+	err = r.queries.CreateFeedEvent(ctx, dbgen.CreateFeedEventParams{
+		ID:        id,
+		ActorID:   actorID,
+		EventType: string(e.EventType()),
+		SubjectID: subjectID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create feed event: %w", err)
+	}
+	return nil
+}
+
+func (r *feedRepositoryImpl) DeleteEvents(ctx context.Context, actorID string, eventType feed.EventType, subjectID string) error {
+	aid, err := uuid.Parse(actorID)
+	if err != nil {
+		return fmt.Errorf("invalid actor id: %w", err)
+	}
+	sid, err := uuid.Parse(subjectID)
+	if err != nil {
+		return fmt.Errorf("invalid subject id: %w", err)
+	}
+
+	err = r.queries.DeleteFeedEvents(ctx, dbgen.DeleteFeedEventsParams{
+		ActorID:   aid,
+		EventType: string(eventType),
+		SubjectID: sid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete feed events: %w", err)
+	}
+	return nil
+}
+
+func (r *feedRepositoryImpl) GetFeed(ctx context.Context, criteria *feed.FeedCriteria) (*feed.FeedPage, error) {
+	uid, err := uuid.Parse(criteria.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+	hasCursor, cursorSortKey, cursorID, err := cursorParams(criteria.After())
+	if err != nil {
+		return nil, err
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	rows, err := r.queries.ListFeedEvents(ctx, dbgen.ListFeedEventsParams{
+		FollowerID:    uid,
+		HasCursor:     hasCursor,
+		CursorSortKey: cursorSortKey,
+		CursorID:      cursorID,
+		LimitCount:    criteria.Limit() + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &feed.FeedPage{Items: []*feed.Event{}}
+	for i, row := range rows {
+		if int32(i) == criteria.Limit() {
+			last := rows[i-1]
+			page.Next, err = pagination.NewCursor(last.SortKey, last.ID.String())
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		page.Items = append(page.Items, feed.ReconstructEvent(
+			row.ID.String(),
+			row.ActorID.String(),
+			row.ActorName,
+			feed.EventType(row.EventType),
+			row.SubjectID.String(),
+			row.CreatedAt.Format(time.RFC3339),
+		))
+	}
+	return page, nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/feed"
+)
+
+func TestFeedRepository_GetFeed(t *testing.T) {
+	q := GetTestQueries()
+	feedRepository := NewFeedRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	// フォローしている2人の出来事だけを新しい順に取得し、自分の出来事は含まない
+	criteria, _ := feed.NewFeedCriteria(fixtureUserID, 3, nil)
+	page, err := feedRepository.GetFeed(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+	if len(page.Items) != 3 || page.Next == nil {
+		t.Fatalf("page = %+v", page)
+	}
+	first := page.Items[0]
+	if first.ID() != "019b5a67-0000-7000-8000-000000000004" || first.EventType() != feed.EventTypeRouteCommented ||
+		first.SubjectID() != "019b5a67-0000-7000-8000-000000000101" || first.ActorName() == "" {
+		t.Errorf("first = %+v", first)
+	}
+
+	criteria, _ = feed.NewFeedCriteria(fixtureUserID, 3, page.Next)
+	page, err = feedRepository.GetFeed(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID() != "019b5a67-0000-7000-8000-000000000001" || page.Next != nil {
+		t.Errorf("page = %+v", page)
+	}
+}
+
+func TestFeedRepository_SaveAndDeleteEvents(t *testing.T) {
+	q := GetTestQueries()
+	feedRepository := NewFeedRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	// フォロワーのフィードの先頭に表示されること
+	const followerID = "019b5a46-1e77-7b9d-ac62-b438a0fc89cb"
+	e, err := feed.NewEvent(feed.EventTypeRouteLiked, fixtureUserID, "019b5a50-0000-7000-8000-000000000004")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := feedRepository.SaveEvent(ctx, e); err != nil {
+		t.Fatalf("SaveEvent() error = %v", err)
+	}
+	criteria, _ := feed.NewFeedCriteria(followerID, 10, nil)
+	page, err := feedRepository.GetFeed(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].ID() != e.ID() {
+		t.Fatalf("page = %+v", page)
+	}
+
+	if err := feedRepository.DeleteEvents(ctx, fixtureUserID, feed.EventTypeRouteLiked, e.SubjectID()); err != nil {
+		t.Fatalf("DeleteEvents() error = %v", err)
+	}
+	page, err = feedRepository.GetFeed(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].EventType() != feed.EventTypeTripUploaded {
+		t.Errorf("page = %+v", page)
+	}
+}
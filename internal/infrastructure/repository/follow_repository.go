@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+)
+
+type followRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// フォローリポジトリの実装
+func NewFollowRepository(queries *dbgen.Queries) user.IFollowRepository {
+	return &followRepositoryImpl{queries: queries}
+}
+
+func (r *followRepositoryImpl) SaveFollow(ctx context.Context, f *user.Follow) error {
+	followerID, err := uuid.Parse(f.FollowerID())
+	if err != nil {
+		return fmt.Errorf("invalid follower id: %w", err)
+	}
+	followeeID, err := uuid.Parse(f.FolloweeID())
+	if err != nil {
+		return domainerror.New("user not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.CreateUserFollow(ctx, dbgen.CreateUserFollowParams{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create follow: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("user already followed", domainerror.ErrConflict)
+	}
+	return nil
+}
+
+func (r *followRepositoryImpl) DeleteFollow(ctx context.Context, followerID string, followeeID string) error {
+	fid, err := uuid.Parse(followerID)
+	if err != nil {
+		return fmt.Errorf("invalid follower id: %w", err)
+	}
+	tid, err := uuid.Parse(followeeID)
+	if err != nil {
+		return domainerror.New("follow not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteUserFollow(ctx, dbgen.DeleteUserFollowParams{FollowerID: fid, FolloweeID: tid})
+	if err != nil {
+		return fmt.Errorf("failed to delete follow: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("follow not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+)
+
+func TestFollowRepository(t *testing.T) {
+	q := GetTestQueries()
+	followRepository := NewFollowRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	const followerID = "019b5a46-a03e-7ea3-af09-86f74ff39aa2"
+	f, err := user.NewFollow(followerID, fixtureUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := followRepository.SaveFollow(ctx, f); err != nil {
+		t.Fatalf("SaveFollow() error = %v", err)
+	}
+	if err := followRepository.SaveFollow(ctx, f); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("SaveFollow() error = %v, want conflict", err)
+	}
+
+	if err := followRepository.DeleteFollow(ctx, followerID, fixtureUserID); err != nil {
+		t.Fatalf("DeleteFollow() error = %v", err)
+	}
+	if err := followRepository.DeleteFollow(ctx, followerID, fixtureUserID); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeleteFollow() error = %v, want not found", err)
+	}
+}
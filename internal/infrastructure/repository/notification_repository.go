@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type notificationRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// 通知リポジトリの実装
+// 受け取る通知の設定はusersテーブルのmuted_notification_typesに保存する
+func NewNotificationRepository(queries *dbgen.Queries) notification.INotificationRepository {
+	return &notificationRepositoryImpl{queries: queries}
+}
+
+func (r *notificationRepositoryImpl) SaveNotification(ctx context.Context, n *notification.Notification) error {
+	id, err := uuid.Parse(n.ID())
+	if err != nil {
+		return fmt.Errorf("invalid notification id: %w", err)
+	}
+	userID, err := uuid.Parse(n.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	actorID, err := uuid.Parse(n.ActorID())
+	if err != nil {
+		return fmt.Errorf("invalid actor id: %w", err)
+	}
+	// フォローとイベントの通知はルートを持たない
+	var routeID pgtype.UUID
+	if n.RouteID() != "" {
+		rid, err := uuid.Parse(n.RouteID())
+		if err != nil {
+			return fmt.Errorf("invalid route id: %w", err)
+		}
+		routeID = pgtype.UUID{Bytes: rid, Valid: true}
+	}
+	var eventID pgtype.UUID
+	if n.EventID() != "" {
+		eid, err := uuid.Parse(n.EventID())
+		if err != nil {
+			return fmt.Errorf("invalid event id: %w", err)
+		}
+		eventID = pgtype.UUID{Bytes: eid, Valid: true}
+	}
+
+	err = r.queries.CreateNotification(ctx, dbgen.CreateNotificationParams{
+		ID:      id,
+		UserID:  userID,
+		ActorID: actorID,
+		Type:    string(n.Type()),
+		RouteID: routeID,
+		EventID: eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepositoryImpl) GetNotifications(ctx context.Context, criteria *notification.NotificationCriteria) (*notification.NotificationPage, error) {
+	uid, err := uuid.Parse(criteria.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+	hasCursor, cursorSortKey, cursorID, err := cursorParams(criteria.After())
+	if err != nil {
+		return nil, err
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	rows, err := r.queries.ListNotifications(ctx, dbgen.ListNotificationsParams{
+		UserID:        uid,
+		UnreadOnly:    criteria.UnreadOnly(),
+		HasCursor:     hasCursor,
+		CursorSortKey: cursorSortKey,
+		CursorID:      cursorID,
+		LimitCount:    criteria.Limit() + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &notification.NotificationPage{Items: []*notification.Notification{}}
+	for i, row := range rows {
+		if int32(i) == criteria.Limit() {
+			last := rows[i-1]
+			page.Next, err = pagination.NewCursor(last.SortKey, last.ID.String())
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		var routeID, eventID string
+		if row.RouteID.Valid {
+			routeID = uuid.UUID(row.RouteID.Bytes).String()
+		}
+		if row.EventID.Valid {
+			eventID = uuid.UUID(row.EventID.Bytes).String()
+		}
+		page.Items = append(page.Items, notification.ReconstructNotification(
+			row.ID.String(),
+			row.UserID.String(),
+			row.ActorID.String(),
+			row.ActorName,
+			notification.Type(row.Type),
+			routeID,
+			fromNullString(row.RouteName),
+			eventID,
+			fromNullString(row.EventTitle),
+			formatNullTime(row.ReadAt),
+			row.CreatedAt.Format(time.RFC3339),
+		))
+	}
+	return page, nil
+}
+
+func (r *notificationRepositoryImpl) CountUnread(ctx context.Context, userID string) (int64, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user id: %w", err)
+	}
+	return r.queries.CountUnreadNotifications(ctx, uid)
+}
+
+func (r *notificationRepositoryImpl) MarkRead(ctx context.Context, userID string, notificationID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	nid, err := uuid.Parse(notificationID)
+	if err != nil {
+		return domainerror.New("notification not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.MarkNotificationRead(ctx, dbgen.MarkNotificationReadParams{ID: nid, UserID: uid})
+	if err != nil {
+		return fmt.Errorf("failed to mark notification read: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("notification not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *notificationRepositoryImpl) MarkAllRead(ctx context.Context, userID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	if err := r.queries.MarkAllNotificationsRead(ctx, uid); err != nil {
+		return fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepositoryImpl) GetPreferences(ctx context.Context, userID string) (*notification.Preferences, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainerror.New("user not found", domainerror.ErrNotFound)
+	}
+
+	muted, err := r.queries.GetMutedNotificationTypes(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("user not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	// 廃止した種類が残っていても設定を読めるよう、未知の種類は除く
+	types := make([]notification.Type, 0, len(muted))
+	for _, m := range muted {
+		if t := notification.Type(m); t.IsValid() {
+			types = append(types, t)
+		}
+	}
+	return notification.NewPreferences(types)
+}
+
+func (r *notificationRepositoryImpl) SavePreferences(ctx context.Context, userID string, preferences *notification.Preferences) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return domainerror.New("user not found", domainerror.ErrNotFound)
+	}
+
+	muted := make([]string, 0, len(preferences.Muted()))
+	for _, t := range preferences.Muted() {
+		muted = append(muted, string(t))
+	}
+	rows, err := r.queries.UpdateMutedNotificationTypes(ctx, dbgen.UpdateMutedNotificationTypesParams{
+		ID:                     uid,
+		MutedNotificationTypes: muted,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update notification preferences: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("user not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
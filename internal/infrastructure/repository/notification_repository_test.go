@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+)
+
+const fixtureCyclingFanUserID = "019b5a46-1e77-7b9d-ac62-b438a0fc89cb"
+
+func TestNotificationRepository_GetNotifications(t *testing.T) {
+	q := GetTestQueries()
+	notificationRepository := NewNotificationRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	// 自分の通知だけを新しい順に取得する
+	criteria, _ := notification.NewNotificationCriteria(fixtureUserID, false, 2, nil)
+	page, err := notificationRepository.GetNotifications(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetNotifications() error = %v", err)
+	}
+	if len(page.Items) != 2 || page.Next == nil {
+		t.Fatalf("page = %+v", page)
+	}
+	first := page.Items[0]
+	if first.ID() != "019b5a68-0000-7000-8000-000000000003" || first.Type() != notification.TypeRouteLiked ||
+		first.RouteID() != fixtureTamagawaRouteID || first.RouteName() == "" || first.ActorName() == "" || first.IsRead() {
+		t.Errorf("first = %+v", first)
+	}
+	if !page.Items[1].IsRead() {
+		t.Errorf("second = %+v, want read", page.Items[1])
+	}
+
+	criteria, _ = notification.NewNotificationCriteria(fixtureUserID, false, 2, page.Next)
+	page, err = notificationRepository.GetNotifications(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetNotifications() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Type() != notification.TypeUserFollowed || page.Items[0].RouteID() != "" || page.Next != nil {
+		t.Errorf("page = %+v", page)
+	}
+
+	// 未読だけに絞り込める
+	criteria, _ = notification.NewNotificationCriteria(fixtureUserID, true, 20, nil)
+	page, err = notificationRepository.GetNotifications(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetNotifications() error = %v", err)
+	}
+	if len(page.Items) != 2 {
+		t.Errorf("unread page = %+v", page)
+	}
+}
+
+func TestNotificationRepository_SaveAndMarkRead(t *testing.T) {
+	q := GetTestQueries()
+	notificationRepository := NewNotificationRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	n, err := notification.NewNotification(notification.NewRouteActivity(notification.TypeRouteSaved, fixtureCyclingFanUserID, fixtureUserID, fixtureTamagawaRouteID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := notificationRepository.SaveNotification(ctx, n); err != nil {
+		t.Fatalf("SaveNotification() error = %v", err)
+	}
+	count, err := notificationRepository.CountUnread(ctx, fixtureUserID)
+	if err != nil {
+		t.Fatalf("CountUnread() error = %v", err)
+	}
+	if count != 3 {
+		t.Errorf("CountUnread() = %d, want 3", count)
+	}
+
+	if err := notificationRepository.MarkRead(ctx, fixtureUserID, n.ID()); err != nil {
+		t.Fatalf("MarkRead() error = %v", err)
+	}
+	// 既読の通知を既読にしてもエラーにしない
+	if err := notificationRepository.MarkRead(ctx, fixtureUserID, n.ID()); err != nil {
+		t.Errorf("MarkRead() again error = %v", err)
+	}
+	// 他のユーザーの通知は既読にできない
+	if err := notificationRepository.MarkRead(ctx, fixtureUserID, "019b5a68-0000-7000-8000-000000000004"); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("MarkRead() for another user's notification error = %v, want not found", err)
+	}
+
+	if err := notificationRepository.MarkAllRead(ctx, fixtureUserID); err != nil {
+		t.Fatalf("MarkAllRead() error = %v", err)
+	}
+	if count, _ := notificationRepository.CountUnread(ctx, fixtureUserID); count != 0 {
+		t.Errorf("CountUnread() after MarkAllRead = %d, want 0", count)
+	}
+	if count, _ := notificationRepository.CountUnread(ctx, fixtureCyclingFanUserID); count != 1 {
+		t.Errorf("CountUnread() for another user = %d, want 1", count)
+	}
+}
+
+func TestNotificationRepository_SaveEventCancelled(t *testing.T) {
+	q := GetTestQueries()
+	notificationRepository := NewNotificationRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	n, err := notification.NewNotification(notification.NewEventCancelledActivity(fixtureUserID, fixtureCyclingFanUserID, fixtureEventID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := notificationRepository.SaveNotification(ctx, n); err != nil {
+		t.Fatalf("SaveNotification() error = %v", err)
+	}
+
+	criteria, err := notification.NewNotificationCriteria(fixtureCyclingFanUserID, false, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := notificationRepository.GetNotifications(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetNotifications() error = %v", err)
+	}
+	if len(page.Items) != 1 {
+		t.Fatalf("GetNotifications() returned %d notifications, want 1", len(page.Items))
+	}
+	got := page.Items[0]
+	if got.ID() != n.ID() || got.Type() != notification.TypeEventCancelled || got.EventID() != fixtureEventID || got.EventTitle() != "多摩川朝練" || got.RouteID() != "" {
+		t.Errorf("GetNotifications() first = %+v", got)
+	}
+}
+
+func TestNotificationRepository_Preferences(t *testing.T) {
+	q := GetTestQueries()
+	notificationRepository := NewNotificationRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	prefs, err := notificationRepository.GetPreferences(ctx, fixtureCyclingFanUserID)
+	if err != nil {
+		t.Fatalf("GetPreferences() error = %v", err)
+	}
+	if got := prefs.Muted(); !slices.Equal(got, []notification.Type{notification.TypeRouteLiked, notification.TypeUserFollowed}) {
+		t.Errorf("Muted() = %v", got)
+	}
+
+	prefs.Set(notification.TypeRouteLiked, true)
+	if err := notificationRepository.SavePreferences(ctx, fixtureCyclingFanUserID, prefs); err != nil {
+		t.Fatalf("SavePreferences() error = %v", err)
+	}
+	prefs, err = notificationRepository.GetPreferences(ctx, fixtureCyclingFanUserID)
+	if err != nil {
+		t.Fatalf("GetPreferences() error = %v", err)
+	}
+	if got := prefs.Muted(); !slices.Equal(got, []notification.Type{notification.TypeUserFollowed}) {
+		t.Errorf("Muted() after save = %v", got)
+	}
+
+	// 既定ではすべての種類を受け取る
+	prefs, err = notificationRepository.GetPreferences(ctx, fixtureUserID)
+	if err != nil {
+		t.Fatalf("GetPreferences() error = %v", err)
+	}
+	if len(prefs.Muted()) != 0 {
+		t.Errorf("default Muted() = %v, want empty", prefs.Muted())
+	}
+
+	if _, err := notificationRepository.GetPreferences(ctx, "019b5a46-0000-7000-8000-000000000000"); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetPreferences() for unknown user error = %v, want not found", err)
+	}
+}
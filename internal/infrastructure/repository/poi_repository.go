@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/poi"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkt"
+)
+
+// 1回のINSERTで保存するPOIの数
+const poiBatchSize = 1000
+
+type poiRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// POIリポジトリの実装
+func NewPOIRepository(queries *dbgen.Queries) poi.IPOIRepository {
+	return &poiRepositoryImpl{queries: queries}
+}
+
+// ListPOIsAlongRoute はルートから一定距離内のPOIを経路上の位置の順に limit 件まで返す
+func (r *poiRepositoryImpl) ListPOIsAlongRoute(ctx context.Context, criteria *poi.AlongRouteCriteria) (*poi.RoutePOIPage, error) {
+	routeID, err := uuid.Parse(criteria.RouteID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid route id: %w", err)
+	}
+	categories := []string{}
+	for _, c := range criteria.Categories() {
+		categories = append(categories, string(c))
+	}
+	params := dbgen.ListPOIsAlongRouteParams{
+		BufferM:    criteria.BufferM(),
+		RouteID:    routeID,
+		Categories: categories,
+		// 次のページの有無を判定するため1件多く取得する
+		LimitCount: criteria.Limit() + 1,
+	}
+	if after := criteria.After(); after != nil {
+		params.HasCursor = true
+		params.CursorCumDistM = after.CumDistM()
+		params.CursorID = after.ID()
+	}
+
+	rows, err := r.queries.ListPOIsAlongRoute(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &poi.RoutePOIPage{Items: make([]*poi.RoutePOI, 0, len(rows))}
+	for i, row := range rows {
+		if int32(i) == criteria.Limit() {
+			last := rows[i-1]
+			page.Next = poi.NewAlongRouteCursor(last.CumDistM, last.ID)
+			break
+		}
+		p, err := toPOI(row.ID, row.OsmType, row.OsmID, row.Category, row.Name, row.Location)
+		if err != nil {
+			return nil, err
+		}
+		page.Items = append(page.Items, &poi.RoutePOI{POI: p, CumDistM: row.CumDistM, OffsetM: row.OffsetM})
+	}
+	return page, nil
+}
+
+func (r *poiRepositoryImpl) GetPOIByID(ctx context.Context, id int64) (*poi.POI, error) {
+	row, err := r.queries.GetPOIByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("poi not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	return toPOI(row.ID, row.OsmType, row.OsmID, row.Category, row.Name, row.Location)
+}
+
+// ReplacePOIs は保存済みのPOIをすべて削除し、pois で置き換える
+// 途中で失敗したときにPOIが空にならないよう、トランザクション内で呼び出すこと
+func (r *poiRepositoryImpl) ReplacePOIs(ctx context.Context, pois []*poi.POI) error {
+	if err := r.queries.DeletePOIs(ctx); err != nil {
+		return err
+	}
+	for start := 0; start < len(pois); start += poiBatchSize {
+		batch := pois[start:min(start+poiBatchSize, len(pois))]
+		if err := r.queries.InsertPOIs(ctx, toInsertPOIsParams(batch)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toPOI(id int64, osmType string, osmID int64, category string, name *string, location dbgen.OrbGeometry) (*poi.POI, error) {
+	point, ok := location.Geometry.(orb.Point)
+	if !ok {
+		return nil, fmt.Errorf("poi %d has invalid location", id)
+	}
+	return poi.ReconstructPOI(id, osmType, osmID, poi.Category(category), fromNullString(name), point), nil
+}
+
+// toInsertPOIsParams はPOIを列ごとの配列にまとめる
+func toInsertPOIsParams(pois []*poi.POI) dbgen.InsertPOIsParams {
+	p := dbgen.InsertPOIsParams{
+		OsmTypes:   make([]string, len(pois)),
+		OsmIds:     make([]int64, len(pois)),
+		Categories: make([]string, len(pois)),
+		Names:      make([]string, len(pois)),
+		Locations:  make([]string, len(pois)),
+	}
+	for i, x := range pois {
+		p.OsmTypes[i] = x.OSMType()
+		p.OsmIds[i] = x.OSMID()
+		p.Categories[i] = string(x.Category())
+		p.Names[i] = x.Name()
+		p.Locations[i] = wkt.MarshalString(x.Location())
+	}
+	return p
+}
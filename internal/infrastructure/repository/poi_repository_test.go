@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/poi"
+	"github.com/paulmach/orb"
+)
+
+// 多摩川サイクリングロード
+const fixtureTamagawaRouteID = "019b5a50-0000-7000-8000-000000000002"
+
+func TestPOIRepository_ListPOIsAlongRoute(t *testing.T) {
+	q := GetTestQueries()
+	poiRepository := NewPOIRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	tests := []struct {
+		name       string
+		bufferM    float64
+		categories []poi.Category
+		wantIDs    []int64
+	}{
+		// コンビニは経路から約330m、展望台は範囲外
+		{name: "200m以内のPOIを経路上の位置の順に返す", bufferM: 200, wantIDs: []int64{2, 1}},
+		{name: "範囲を広げるとコンビニも含む", bufferM: 500, wantIDs: []int64{2, 1, 3}},
+		{name: "種類で絞り込む", bufferM: 500, categories: []poi.Category{poi.CategoryCafe, poi.CategoryConvenience}, wantIDs: []int64{1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := poi.NewAlongRouteCriteria(fixtureTamagawaRouteID, tt.bufferM, tt.categories, 20, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			page, err := poiRepository.ListPOIsAlongRoute(ctx, criteria)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			pois := page.Items
+			if page.Next != nil {
+				t.Errorf("Next = %+v, want nil", page.Next)
+			}
+			if len(pois) != len(tt.wantIDs) {
+				t.Fatalf("len(pois) = %d, want %d", len(pois), len(tt.wantIDs))
+			}
+			for i, p := range pois {
+				if p.POI.ID() != tt.wantIDs[i] {
+					t.Errorf("pois[%d].ID = %d, want %d", i, p.POI.ID(), tt.wantIDs[i])
+				}
+				if i > 0 && p.CumDistM < pois[i-1].CumDistM {
+					t.Errorf("pois are not ordered by cum_dist_m: %v", pois)
+				}
+			}
+		})
+	}
+
+	criteria, _ := poi.NewAlongRouteCriteria(fixtureTamagawaRouteID, 200, nil, 20, nil)
+	page, _ := poiRepository.ListPOIsAlongRoute(ctx, criteria)
+	pois := page.Items
+	// 水飲み場は2つ目の頂点（始点から約800m）のすぐ北、カフェは3つ目の頂点の約55m北
+	water, cafe := pois[0], pois[1]
+	if water.CumDistM < 700 || water.CumDistM > 900 || water.OffsetM > 20 || water.POI.Name() != "" {
+		t.Errorf("water = %+v, cum_dist_m %v, offset_m %v", water.POI, water.CumDistM, water.OffsetM)
+	}
+	if cafe.OffsetM < 30 || cafe.OffsetM > 80 || cafe.POI.Category() != poi.CategoryCafe {
+		t.Errorf("cafe = %+v, offset_m %v", cafe.POI, cafe.OffsetM)
+	}
+
+	// 経路上の位置とIDをカーソルにして続きを取得する
+	var ids []int64
+	var after *poi.AlongRouteCursor
+	for range 3 {
+		criteria, _ := poi.NewAlongRouteCriteria(fixtureTamagawaRouteID, 500, nil, 2, after)
+		page, err := poiRepository.ListPOIsAlongRoute(ctx, criteria)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, p := range page.Items {
+			ids = append(ids, p.POI.ID())
+		}
+		if page.Next == nil {
+			break
+		}
+		after = page.Next
+	}
+	if !reflect.DeepEqual(ids, []int64{2, 1, 3}) {
+		t.Errorf("paged ids = %v, want [2 1 3]", ids)
+	}
+}
+
+func TestPOIRepository_GetPOIByID(t *testing.T) {
+	q := GetTestQueries()
+	poiRepository := NewPOIRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	p, err := poiRepository.GetPOIByID(ctx, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.OSMType() != poi.OSMTypeWay || p.OSMID() != 6001 || p.Name() != "コンビニ丸子橋店" || !p.Location().Equal(orb.Point{139.665, 35.602}) {
+		t.Errorf("GetPOIByID() = %+v", p)
+	}
+
+	if _, err := poiRepository.GetPOIByID(ctx, 999); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetPOIByID() error = %v, want not found", err)
+	}
+}
+
+func TestPOIRepository_ReplacePOIs(t *testing.T) {
+	q := GetTestQueries()
+	poiRepository := NewPOIRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	shop, _ := poi.NewPOI(poi.OSMTypeNode, 7001, poi.CategoryBicycleShop, "サイクルショップ", orb.Point{139.6351, 35.6082})
+	if err := poiRepository.ReplacePOIs(ctx, []*poi.POI{shop}); err != nil {
+		t.Fatalf("ReplacePOIs() error = %v", err)
+	}
+
+	criteria, _ := poi.NewAlongRouteCriteria(fixtureTamagawaRouteID, 2000, nil, 20, nil)
+	page, err := poiRepository.ListPOIsAlongRoute(ctx, criteria)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pois := page.Items
+	if len(pois) != 1 || pois[0].POI.OSMID() != 7001 || pois[0].POI.Category() != poi.CategoryBicycleShop {
+		t.Fatalf("pois = %+v, want replaced", pois)
+	}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+type privacyZoneRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// プライバシーゾーンリポジトリの実装
+func NewPrivacyZoneRepository(queries *dbgen.Queries) user.IPrivacyZoneRepository {
+	return &privacyZoneRepositoryImpl{queries: queries}
+}
+
+func (r *privacyZoneRepositoryImpl) ListPrivacyZones(ctx context.Context, userID string) ([]*user.PrivacyZone, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	rows, err := r.queries.ListPrivacyZonesByUserID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	zones := make([]*user.PrivacyZone, 0, len(rows))
+	for _, row := range rows {
+		center, ok := row.Center.Geometry.(orb.Point)
+		if !ok {
+			return nil, fmt.Errorf("privacy zone %s has invalid center", row.ID)
+		}
+		zones = append(zones, user.ReconstructPrivacyZone(
+			row.ID.String(),
+			row.UserID.String(),
+			row.Name,
+			center,
+			row.RadiusM,
+			row.CreatedAt.Format(time.RFC3339),
+		))
+	}
+	return zones, nil
+}
+
+func (r *privacyZoneRepositoryImpl) SavePrivacyZone(ctx context.Context, zone *user.PrivacyZone) error {
+	zoneID, err := uuid.Parse(zone.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid privacy zone id: %w", err)
+	}
+	userID, err := uuid.Parse(zone.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	err = r.queries.CreatePrivacyZone(ctx, dbgen.CreatePrivacyZoneParams{
+		ID:      zoneID,
+		UserID:  userID,
+		Name:    zone.Name(),
+		Center:  dbgen.OrbGeometry{Geometry: zone.Center()},
+		RadiusM: zone.RadiusM(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create privacy zone: %w", err)
+	}
+	return nil
+}
+
+// DeletePrivacyZone は本人のプライバシーゾーンを削除する
+// 他のユーザーのゾーンは存在しないものとして扱う
+func (r *privacyZoneRepositoryImpl) DeletePrivacyZone(ctx context.Context, userID string, id string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	zoneID, err := uuid.Parse(id)
+	if err != nil {
+		return domainerror.New("privacy zone not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeletePrivacyZone(ctx, dbgen.DeletePrivacyZoneParams{ID: zoneID, UserID: uid})
+	if err != nil {
+		return fmt.Errorf("failed to delete privacy zone: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("privacy zone not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
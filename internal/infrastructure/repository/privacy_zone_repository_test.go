@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+)
+
+func TestPrivacyZoneRepository(t *testing.T) {
+	q := GetTestQueries()
+	privacyZoneRepository := NewPrivacyZoneRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	zones, err := privacyZoneRepository.ListPrivacyZones(ctx, fixtureUserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(zones) != 1 || zones[0].Name() != "自宅" || zones[0].RadiusM() != 150 {
+		t.Fatalf("zones = %+v", zones)
+	}
+	if !zones[0].Contains(orb.Point{139.7501, 35.68005}) {
+		t.Errorf("Center() = %v", zones[0].Center())
+	}
+
+	office, _ := user.NewPrivacyZone(fixtureUserID, "職場", orb.Point{139.767, 35.681}, 300)
+	if err := privacyZoneRepository.SavePrivacyZone(ctx, office); err != nil {
+		t.Fatalf("SavePrivacyZone() error = %v", err)
+	}
+	zones, _ = privacyZoneRepository.ListPrivacyZones(ctx, fixtureUserID)
+	if len(zones) != 2 || zones[1].ID() != office.ID() || zones[1].Center() != office.Center() {
+		t.Fatalf("zones = %+v", zones)
+	}
+
+	// 他のユーザーのゾーンは削除できない
+	err = privacyZoneRepository.DeletePrivacyZone(ctx, "00000000-0000-0000-0000-000000000000", office.ID().String())
+	if !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeletePrivacyZone() error = %v, want not found", err)
+	}
+	if err := privacyZoneRepository.DeletePrivacyZone(ctx, fixtureUserID, office.ID().String()); err != nil {
+		t.Fatalf("DeletePrivacyZone() error = %v", err)
+	}
+	zones, _ = privacyZoneRepository.ListPrivacyZones(ctx, fixtureUserID)
+	if len(zones) != 1 {
+		t.Errorf("zones = %+v, want 1", zones)
+	}
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/roadgraph"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkt"
+)
+
+// 1回のINSERTで保存する区間の数
+const roadEdgeBatchSize = 1000
+
+// 1回の探索で読み込む区間の数の上限。道路の多い都市部で範囲が広い場合のメモリ使用量を抑える
+const maxRoadEdgesInBound = 200000
+
+// RoadEdgeRepository はルート探索用の道路網（road_edges）を読み書きする
+type RoadEdgeRepository struct {
+	queries  *dbgen.Queries
+	maxEdges int32 // ListRoadEdgesInBoundで読み込む区間の数の上限
+}
+
+func NewRoadEdgeRepository(queries *dbgen.Queries) *RoadEdgeRepository {
+	return &RoadEdgeRepository{queries: queries, maxEdges: maxRoadEdgesInBound}
+}
+
+// ReplaceRoadEdges は保存済みの道路網をすべて削除し、edges で置き換える
+// 途中で失敗したときに道路網が空にならないよう、トランザクション内で呼び出すこと
+func (r *RoadEdgeRepository) ReplaceRoadEdges(ctx context.Context, edges []roadgraph.Edge) error {
+	if err := r.queries.DeleteRoadEdges(ctx); err != nil {
+		return err
+	}
+	for start := 0; start < len(edges); start += roadEdgeBatchSize {
+		batch := edges[start:min(start+roadEdgeBatchSize, len(edges))]
+		if err := r.queries.InsertRoadEdges(ctx, toInsertRoadEdgesParams(batch)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListRoadEdgesInBound は範囲と交わる区間を返す
+// 区間の数が上限を超える場合は、一部の道路網で探索しないよう検証エラーにする
+func (r *RoadEdgeRepository) ListRoadEdgesInBound(ctx context.Context, bound orb.Bound) ([]roadgraph.Edge, error) {
+	// 上限を超えたかを判定するため1件多く取得する
+	rows, err := r.queries.ListRoadEdgesInBBox(ctx, dbgen.ListRoadEdgesInBBoxParams{
+		MinLon:     bound.Min.Lon(),
+		MinLat:     bound.Min.Lat(),
+		MaxLon:     bound.Max.Lon(),
+		MaxLat:     bound.Max.Lat(),
+		LimitCount: r.maxEdges + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if int32(len(rows)) > r.maxEdges {
+		return nil, domainerror.New("too many roads in the area to search. place the points closer together", domainerror.ErrValidation)
+	}
+
+	edges := make([]roadgraph.Edge, 0, len(rows))
+	for _, row := range rows {
+		geom, _ := row.Geom.Geometry.(orb.LineString)
+		edges = append(edges, roadgraph.Edge{
+			ID:            row.ID,
+			OSMWayID:      row.OsmWayID,
+			Source:        row.SourceNodeID,
+			Target:        row.TargetNodeID,
+			Name:          fromNullString(row.Name),
+			Highway:       row.Highway,
+			Surface:       fromNullString(row.Surface),
+			BikeLane:      row.BikeLane,
+			Oneway:        row.Oneway,
+			Length:        row.LengthM,
+			ElevationGain: row.ElevationGain,
+			ElevationLoss: row.ElevationLoss,
+			Geometry:      geom,
+		})
+	}
+	return edges, nil
+}
+
+// toInsertRoadEdgesParams は区間を列ごとの配列にまとめる
+func toInsertRoadEdgesParams(edges []roadgraph.Edge) dbgen.InsertRoadEdgesParams {
+	p := dbgen.InsertRoadEdgesParams{
+		OsmWayIds:       make([]int64, len(edges)),
+		SourceNodeIds:   make([]int64, len(edges)),
+		TargetNodeIds:   make([]int64, len(edges)),
+		Names:           make([]string, len(edges)),
+		Highways:        make([]string, len(edges)),
+		Surfaces:        make([]string, len(edges)),
+		BikeLanes:       make([]bool, len(edges)),
+		Oneways:         make([]bool, len(edges)),
+		LengthsM:        make([]float64, len(edges)),
+		ElevationGains:  make([]float64, len(edges)),
+		ElevationLosses: make([]float64, len(edges)),
+		Geoms:           make([]string, len(edges)),
+	}
+	for i, e := range edges {
+		p.OsmWayIds[i] = e.OSMWayID
+		p.SourceNodeIds[i] = e.Source
+		p.TargetNodeIds[i] = e.Target
+		p.Names[i] = e.Name
+		p.Highways[i] = e.Highway
+		p.Surfaces[i] = e.Surface
+		p.BikeLanes[i] = e.BikeLane
+		p.Oneways[i] = e.Oneway
+		p.LengthsM[i] = e.Length
+		p.ElevationGains[i] = e.ElevationGain
+		p.ElevationLosses[i] = e.ElevationLoss
+		p.Geoms[i] = wkt.MarshalString(e.Geometry)
+	}
+	return p
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/roadgraph"
+	"github.com/paulmach/orb"
+)
+
+// 皇居周辺
+var imperialPalaceBound = orb.Bound{Min: orb.Point{139.74, 35.67}, Max: orb.Point{139.76, 35.69}}
+
+func TestRoadEdgeRepository_ListRoadEdgesInBound(t *testing.T) {
+	q := GetTestQueries()
+	roadEdgeRepository := NewRoadEdgeRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	edges, err := roadEdgeRepository.ListRoadEdgesInBound(ctx, imperialPalaceBound)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(edges) != 2 {
+		t.Fatalf("len(edges) = %d, want 2", len(edges))
+	}
+
+	e := edges[0]
+	if e.ID != 1 || e.Source != 1 || e.Target != 2 || e.Name != "内堀通り" || !e.BikeLane || e.Oneway {
+		t.Errorf("edges[0] = %+v", e)
+	}
+	if len(e.Geometry) != 2 || !e.Geometry[1].Equal(orb.Point{139.752, 35.68}) {
+		t.Errorf("edges[0].Geometry = %v", e.Geometry)
+	}
+	// 名前・舗装が未設定の区間は空文字になる
+	if edges[1].Name != "" || edges[1].Surface != "" || !edges[1].Oneway || edges[1].ElevationGain != 5 {
+		t.Errorf("edges[1] = %+v", edges[1])
+	}
+
+	// 区間の数が上限を超える場合は検証エラーにする
+	roadEdgeRepository.maxEdges = 1
+	if _, err := roadEdgeRepository.ListRoadEdgesInBound(ctx, imperialPalaceBound); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("ListRoadEdgesInBound() over the limit error = %v, want %v", err, domainerror.ErrValidation)
+	}
+}
+
+func TestRoadEdgeRepository_ReplaceRoadEdges(t *testing.T) {
+	q := GetTestQueries()
+	roadEdgeRepository := NewRoadEdgeRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	err := roadEdgeRepository.ReplaceRoadEdges(ctx, []roadgraph.Edge{
+		{
+			OSMWayID: 2001,
+			Source:   10,
+			Target:   11,
+			Name:     "日比谷通り",
+			Highway:  "primary",
+			Surface:  "asphalt",
+			Length:   150,
+			Geometry: orb.LineString{{139.755, 35.675}, {139.756, 35.676}},
+		},
+		{
+			OSMWayID:      2002,
+			Source:        11,
+			Target:        12,
+			Highway:       "track",
+			Length:        90,
+			ElevationGain: 3,
+			Geometry:      orb.LineString{{139.756, 35.676}, {139.757, 35.676}, {139.757, 35.677}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	edges, err := roadEdgeRepository.ListRoadEdgesInBound(ctx, imperialPalaceBound)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 既存の区間は削除される
+	if len(edges) != 2 || edges[0].OSMWayID != 2001 || edges[1].OSMWayID != 2002 {
+		t.Fatalf("edges = %+v, want ways 2001, 2002", edges)
+	}
+	if edges[0].Name != "日比谷通り" || edges[1].Surface != "" || len(edges[1].Geometry) != 3 || edges[1].ElevationGain != 3 {
+		t.Errorf("edges = %+v", edges)
+	}
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+)
+
+// SaveRouteImages はルートに写真を追加する
+// 同じ保存先の写真が既に別のルートにある場合は追加しない
+func (r *routeRepositoryImpl) SaveRouteImages(ctx context.Context, routeID string, images []*route.RouteImage) error {
+	rid, err := uuid.Parse(routeID)
+	if err != nil {
+		return fmt.Errorf("invalid route id: %w", err)
+	}
+
+	for _, img := range images {
+		imageID, err := uuid.Parse(img.ID())
+		if err != nil {
+			return fmt.Errorf("invalid route image id: %w", err)
+		}
+		err = r.queries.CreateRouteImage(ctx, dbgen.CreateRouteImageParams{
+			ID:         imageID,
+			RouteID:    rid,
+			S3Key:      img.S3Key(),
+			Width:      img.Width(),
+			Height:     img.Height(),
+			Size:       img.Size(),
+			Type:       img.Type(),
+			Visibility: img.Visibility(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create route image: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+)
+
+func TestRouteRepository_SaveRouteImages(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	width, height := int32(800), int32(600)
+	photo, _ := route.NewRouteImage("routes/019b5a50-0000-7000-8000-000000000002/top.jpg", &width, &height, nil, "jpg", 1)
+	// 既に別のルートにある写真は追加しない
+	duplicate, _ := route.NewRouteImage("routes/019b5a50-0000-7000-8000-000000000001/cover.jpg", nil, nil, nil, "jpg", 1)
+
+	err := routeRepository.SaveRouteImages(ctx, "019b5a50-0000-7000-8000-000000000002", []*route.RouteImage{photo, duplicate})
+	if err != nil {
+		t.Fatalf("SaveRouteImages() error = %v", err)
+	}
+	// 同じ写真をもう一度追加してもエラーにならない
+	err = routeRepository.SaveRouteImages(ctx, "019b5a50-0000-7000-8000-000000000002", []*route.RouteImage{photo})
+	if err != nil {
+		t.Errorf("SaveRouteImages() error = %v", err)
+	}
+
+	if err := routeRepository.SaveRouteImages(ctx, "invalid", []*route.RouteImage{photo}); err == nil {
+		t.Error("SaveRouteImages() should fail for invalid route id")
+	}
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+)
+
+// route_placesのkind
+const (
+	routePlaceKindStart = "start"
+	routePlaceKindEnd   = "end"
+)
+
+// SaveRoutePlaces はルートの出発地点と目的地の地名だけを保存し直す
+// boundary-importで行政区域を取り込み直したときに使う
+func (r *routeRepositoryImpl) SaveRoutePlaces(ctx context.Context, rt *route.Route) error {
+	uid, err := uuid.Parse(rt.ID())
+	if err != nil {
+		return fmt.Errorf("invalid route id: %w", err)
+	}
+	return r.saveRoutePlaces(ctx, uid, rt)
+}
+
+func (r *routeRepositoryImpl) saveRoutePlaces(ctx context.Context, routeID uuid.UUID, rt *route.Route) error {
+	if err := r.queries.DeleteRoutePlaces(ctx, routeID); err != nil {
+		return fmt.Errorf("failed to delete route places: %w", err)
+	}
+	places := []struct {
+		kind  string
+		place *place.Place
+	}{
+		{kind: routePlaceKindStart, place: rt.StartPlace()},
+		{kind: routePlaceKindEnd, place: rt.EndPlace()},
+	}
+	for _, p := range places {
+		// 地名が分からない地点は保存しない
+		if p.place == nil {
+			continue
+		}
+		err := r.queries.CreateRoutePlace(ctx, dbgen.CreateRoutePlaceParams{
+			RouteID:            routeID,
+			Kind:               p.kind,
+			Locality:           p.place.Locality(),
+			AdministrativeArea: p.place.AdministrativeArea(),
+			CountryCode:        p.place.CountryCode(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create route place: %w", err)
+		}
+	}
+	return nil
+}
+
+// getRoutePlaces は出発地点と目的地の地名を取得する。分からない地点はnilを返す
+func (r *routeRepositoryImpl) getRoutePlaces(ctx context.Context, routeID uuid.UUID) (*place.Place, *place.Place, error) {
+	rows, err := r.queries.GetRoutePlacesByRouteID(ctx, routeID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get route places: %w", err)
+	}
+
+	var start, end *place.Place
+	for _, row := range rows {
+		p := place.ReconstructPlace(row.Locality, row.AdministrativeArea, row.CountryCode)
+		switch row.Kind {
+		case routePlaceKindStart:
+			start = p
+		case routePlaceKindEnd:
+			end = p
+		}
+	}
+	return start, end, nil
+}
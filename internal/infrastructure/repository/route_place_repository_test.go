@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+)
+
+func TestRouteRepository_RoutePlaces(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	t.Run("保存済みの地名を取得できること", func(t *testing.T) {
+		got, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000002")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.StartPlace() == nil || got.StartPlace().Locality() != "大田区" {
+			t.Errorf("StartPlace() = %+v, want 大田区", got.StartPlace())
+		}
+		if got.EndPlace() == nil || got.EndPlace().AdministrativeArea() != "神奈川県" {
+			t.Errorf("EndPlace() = %+v, want 神奈川県", got.EndPlace())
+		}
+	})
+
+	t.Run("地名が分からない地点はnilになること", func(t *testing.T) {
+		got, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000004")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.StartPlace() == nil || got.EndPlace() != nil {
+			t.Errorf("StartPlace()/EndPlace() = %+v/%+v, want 秦野市/nil", got.StartPlace(), got.EndPlace())
+		}
+	})
+
+	t.Run("地名を保存し直せること", func(t *testing.T) {
+		rt, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000004")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		rt.SetPlaces(nil, place.ReconstructPlace("伊勢原市", "神奈川県", "JP"))
+		if err := routeRepository.SaveRoutePlaces(ctx, rt); err != nil {
+			t.Fatalf("SaveRoutePlaces() error = %v", err)
+		}
+
+		saved, err := routeRepository.GetRouteByID(ctx, rt.ID())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if saved.StartPlace() != nil {
+			t.Errorf("StartPlace() = %+v, want nil", saved.StartPlace())
+		}
+		if saved.EndPlace() == nil || saved.EndPlace().Locality() != "伊勢原市" {
+			t.Errorf("EndPlace() = %+v, want 伊勢原市", saved.EndPlace())
+		}
+	})
+}
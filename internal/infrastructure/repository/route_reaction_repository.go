@@ -0,0 +1,316 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *routeRepositoryImpl) LikeRoute(ctx context.Context, userID string, routeID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	rid, err := uuid.Parse(routeID)
+	if err != nil {
+		return domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	likeID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.queries.CreateRouteLike(ctx, dbgen.CreateRouteLikeParams{
+		ID:      likeID,
+		UserID:  uid,
+		RouteID: rid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create like: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("route already liked", domainerror.ErrConflict)
+	}
+	return nil
+}
+
+func (r *routeRepositoryImpl) UnlikeRoute(ctx context.Context, userID string, routeID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	rid, err := uuid.Parse(routeID)
+	if err != nil {
+		return domainerror.New("like not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteRouteLike(ctx, dbgen.DeleteRouteLikeParams{UserID: uid, RouteID: rid})
+	if err != nil {
+		return fmt.Errorf("failed to delete like: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("like not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *routeRepositoryImpl) AddSavedRoute(ctx context.Context, userID string, routeID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	rid, err := uuid.Parse(routeID)
+	if err != nil {
+		return domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	saveID, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.queries.CreateRouteSave(ctx, dbgen.CreateRouteSaveParams{
+		ID:      saveID,
+		UserID:  uid,
+		RouteID: rid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save route: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("route already saved", domainerror.ErrConflict)
+	}
+	return nil
+}
+
+func (r *routeRepositoryImpl) RemoveSavedRoute(ctx context.Context, userID string, routeID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	rid, err := uuid.Parse(routeID)
+	if err != nil {
+		return domainerror.New("saved route not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteRouteSave(ctx, dbgen.DeleteRouteSaveParams{UserID: uid, RouteID: rid})
+	if err != nil {
+		return fmt.Errorf("failed to unsave route: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("saved route not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+// GetSavedRoutes は保存したルートを保存した日時の新しい順に limit+1 件まで取得し、ページにする
+func (r *routeRepositoryImpl) GetSavedRoutes(ctx context.Context, criteria *route.SavedRouteCriteria) (*route.RoutePage, error) {
+	uid, err := uuid.Parse(criteria.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+	hasCursor, cursorSortKey, cursorID, err := cursorParams(criteria.After())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.ListSavedRoutes(ctx, dbgen.ListSavedRoutesParams{
+		UserID:        uid,
+		HasCursor:     hasCursor,
+		CursorSortKey: cursorSortKey,
+		CursorID:      cursorID,
+		LimitCount:    criteria.Limit() + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*route.ExploreRouteResult, 0, len(rows))
+	for _, rd := range rows {
+		routeModel, err := route.ReconstructRoute(
+			rd.ID.String(),
+			rd.UserID.String(),
+			rd.Name,
+			rd.Description,
+			rd.HighlightedPhotoID,
+			rd.Distance,
+			rd.Duration,
+			rd.ElevationGain,
+			rd.ElevationLoss,
+			route.Geometry{Geometry: rd.PathGeom.Geometry},
+			route.Geometry{Geometry: rd.Bbox.Geometry},
+			route.Geometry{Geometry: rd.FirstPoint.Geometry},
+			route.Geometry{Geometry: rd.LastPoint.Geometry},
+			rd.Polyline,
+			rd.Visibility,
+			rd.Version,
+			fromNullUUID(rd.ForkedFromRouteID),
+			rd.CreatedAt.Format(time.RFC3339),
+			rd.UpdatedAt.Format(time.RFC3339),
+		)
+		if err != nil {
+			return nil, err
+		}
+		routeModel.SetDifficulty(fromNullDifficulty(rd.Difficulty))
+		routeModel.SetClubID(fromNullUUID(rd.ClubID))
+		res, err := route.ReconstructExploreRouteResult(routeModel, rd.UserName, rd.SortKey)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	page, err := route.NewRoutePage(results, criteria.Limit())
+	if err != nil {
+		return nil, err
+	}
+	if err := r.attachRouteTags(ctx, resultRoutes(page.Items)...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// GetComments はコメントを古い順に limit+1 件まで取得し、ページにする
+func (r *routeRepositoryImpl) GetComments(ctx context.Context, criteria *route.CommentCriteria) (*route.CommentPage, error) {
+	rid, err := uuid.Parse(criteria.RouteID())
+	if err != nil {
+		return nil, domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	hasCursor, cursorSortKey, cursorID, err := cursorParams(criteria.After())
+	if err != nil {
+		return nil, err
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	rows, err := r.queries.ListRouteComments(ctx, dbgen.ListRouteCommentsParams{
+		RouteID:       rid,
+		HasCursor:     hasCursor,
+		CursorSortKey: cursorSortKey,
+		CursorID:      cursorID,
+		LimitCount:    criteria.Limit() + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	page := &route.CommentPage{Items: make([]*route.Comment, 0, len(rows))}
+	for i, row := range rows {
+		if int32(i) == criteria.Limit() {
+			last := rows[i-1]
+			page.Next, err = pagination.NewCursor(last.SortKey, last.ID.String())
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		page.Items = append(page.Items, route.ReconstructComment(
+			row.ID.String(),
+			row.RouteID.String(),
+			row.UserID.String(),
+			row.UserName,
+			row.Content,
+			row.CreatedAt.Format(time.RFC3339),
+		))
+	}
+	return page, nil
+}
+
+func (r *routeRepositoryImpl) GetComment(ctx context.Context, id string) (*route.Comment, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, domainerror.New("comment not found", domainerror.ErrNotFound)
+	}
+
+	row, err := r.queries.GetRouteComment(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("comment not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	return route.ReconstructComment(
+		row.ID.String(),
+		row.RouteID.String(),
+		row.UserID.String(),
+		row.UserName,
+		row.Content,
+		row.CreatedAt.Format(time.RFC3339),
+	), nil
+}
+
+// GetCommentsByIDs はIDで指定したコメントをまとめて取得する。存在しないIDは無視し、順序は保証しない
+func (r *routeRepositoryImpl) GetCommentsByIDs(ctx context.Context, ids []string) ([]*route.Comment, error) {
+	uids := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			continue
+		}
+		uids = append(uids, uid)
+	}
+
+	rows, err := r.queries.ListRouteCommentsByIDs(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]*route.Comment, 0, len(rows))
+	for _, row := range rows {
+		comments = append(comments, route.ReconstructComment(
+			row.ID.String(),
+			row.RouteID.String(),
+			row.UserID.String(),
+			row.UserName,
+			row.Content,
+			row.CreatedAt.Format(time.RFC3339),
+		))
+	}
+	return comments, nil
+}
+
+func (r *routeRepositoryImpl) SaveComment(ctx context.Context, c *route.Comment) error {
+	commentID, err := uuid.Parse(c.ID())
+	if err != nil {
+		return fmt.Errorf("invalid comment id: %w", err)
+	}
+	routeID, err := uuid.Parse(c.RouteID())
+	if err != nil {
+		return domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	userID, err := uuid.Parse(c.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	err = r.queries.CreateRouteComment(ctx, dbgen.CreateRouteCommentParams{
+		ID:      commentID,
+		UserID:  userID,
+		RouteID: routeID,
+		Content: c.Content(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+	return nil
+}
+
+// DeleteComment はコメントを論理削除する。返信のツリーを保つため行は残す
+func (r *routeRepositoryImpl) DeleteComment(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return domainerror.New("comment not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteRouteComment(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("comment not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
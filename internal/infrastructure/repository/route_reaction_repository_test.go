@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+)
+
+func TestRouteRepository_Likes(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	const yabitsuRouteID = "019b5a50-0000-7000-8000-000000000004"
+	if err := routeRepository.LikeRoute(ctx, fixtureUserID, yabitsuRouteID); err != nil {
+		t.Fatalf("LikeRoute() error = %v", err)
+	}
+	if err := routeRepository.LikeRoute(ctx, fixtureUserID, yabitsuRouteID); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("LikeRoute() error = %v, want conflict", err)
+	}
+
+	if err := routeRepository.UnlikeRoute(ctx, fixtureUserID, yabitsuRouteID); err != nil {
+		t.Fatalf("UnlikeRoute() error = %v", err)
+	}
+	if err := routeRepository.UnlikeRoute(ctx, fixtureUserID, yabitsuRouteID); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("UnlikeRoute() error = %v, want not found", err)
+	}
+}
+
+func TestRouteRepository_SavedRoutes(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	const yabitsuRouteID = "019b5a50-0000-7000-8000-000000000004"
+	if err := routeRepository.AddSavedRoute(ctx, fixtureUserID, yabitsuRouteID); err != nil {
+		t.Fatalf("AddSavedRoute() error = %v", err)
+	}
+	if err := routeRepository.AddSavedRoute(ctx, fixtureUserID, yabitsuRouteID); !errors.Is(err, domainerror.ErrConflict) {
+		t.Errorf("AddSavedRoute() error = %v, want conflict", err)
+	}
+
+	if err := routeRepository.RemoveSavedRoute(ctx, fixtureUserID, yabitsuRouteID); err != nil {
+		t.Fatalf("RemoveSavedRoute() error = %v", err)
+	}
+	if err := routeRepository.RemoveSavedRoute(ctx, fixtureUserID, yabitsuRouteID); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("RemoveSavedRoute() error = %v, want not found", err)
+	}
+
+	// 保存を取り消したルートは保存し直せる
+	if err := routeRepository.AddSavedRoute(ctx, fixtureUserID, yabitsuRouteID); err != nil {
+		t.Errorf("AddSavedRoute() after remove error = %v", err)
+	}
+
+	// 保存した日時の新しい順に取得し、次のページのカーソルを返す
+	if err := routeRepository.AddSavedRoute(ctx, fixtureUserID, fixtureTamagawaRouteID); err != nil {
+		t.Fatalf("AddSavedRoute() error = %v", err)
+	}
+	criteria, err := route.NewSavedRouteCriteria(fixtureUserID, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := routeRepository.GetSavedRoutes(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetSavedRoutes() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Route.ID() != fixtureTamagawaRouteID || page.Next == nil {
+		t.Fatalf("GetSavedRoutes() first page = %+v, want %s with next cursor", page, fixtureTamagawaRouteID)
+	}
+	criteria, err = route.NewSavedRouteCriteria(fixtureUserID, 1, page.Next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err = routeRepository.GetSavedRoutes(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetSavedRoutes() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Route.ID() != yabitsuRouteID || page.Next != nil {
+		t.Errorf("GetSavedRoutes() second page = %+v, want only %s", page, yabitsuRouteID)
+	}
+}
+
+func TestRouteRepository_Comments(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	const fixtureCommentID = "019b5a67-0000-7000-8000-000000000101"
+	c, err := route.NewComment(fixtureTamagawaRouteID, fixtureUserID, "午前中は追い風になります")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := routeRepository.SaveComment(ctx, c); err != nil {
+		t.Fatalf("SaveComment() error = %v", err)
+	}
+
+	criteria, err := route.NewCommentCriteria(fixtureTamagawaRouteID, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := routeRepository.GetComments(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].ID() != fixtureCommentID || page.Items[1].ID() != c.ID() || page.Items[1].UserName() == "" || page.Next != nil {
+		t.Errorf("page = %+v", page)
+	}
+
+	// 古い順に1件ずつ取得し、カーソルの後から続ける
+	criteria, err = route.NewCommentCriteria(fixtureTamagawaRouteID, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err = routeRepository.GetComments(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID() != fixtureCommentID || page.Next == nil {
+		t.Fatalf("first page = %+v", page)
+	}
+	criteria, err = route.NewCommentCriteria(fixtureTamagawaRouteID, 1, page.Next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err = routeRepository.GetComments(ctx, criteria)
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID() != c.ID() || page.Next != nil {
+		t.Errorf("second page = %+v", page)
+	}
+
+	byIDs, err := routeRepository.GetCommentsByIDs(ctx, []string{fixtureCommentID, "invalid"})
+	if err != nil {
+		t.Fatalf("GetCommentsByIDs() error = %v", err)
+	}
+	if len(byIDs) != 1 || byIDs[0].Content() != "河口側は向かい風が強いですね" {
+		t.Errorf("byIDs = %+v", byIDs)
+	}
+
+	// 削除したコメントは取得できない
+	if err := routeRepository.DeleteComment(ctx, c.ID()); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+	if _, err := routeRepository.GetComment(ctx, c.ID()); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetComment() error = %v, want not found", err)
+	}
+	if err := routeRepository.DeleteComment(ctx, c.ID()); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeleteComment() error = %v, want not found", err)
+	}
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
 	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
 	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/textsearch"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type routeRepositoryImpl struct {
@@ -22,15 +27,16 @@ func NewRouteRepository(queries *dbgen.Queries) route.IRouteRepository {
 }
 
 func (r *routeRepositoryImpl) GetRouteByID(ctx context.Context, id string) (*route.Route, error) {
+	// IDの形式が不正な場合も存在しないルートとして扱う
 	uid, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid route id: %w", err)
+		return nil, domainerror.New("route not found", domainerror.ErrNotFound)
 	}
 
 	rd, err := r.queries.GetRouteByID(ctx, uid)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("route not found")
+			return nil, domainerror.New("route not found", domainerror.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -51,6 +57,8 @@ func (r *routeRepositoryImpl) GetRouteByID(ctx context.Context, id string) (*rou
 		route.Geometry{Geometry: rd.LastPoint.Geometry},
 		rd.Polyline,
 		rd.Visibility,
+		rd.Version,
+		fromNullUUID(rd.ForkedFromRouteID),
 		rd.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		rd.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	)
@@ -110,6 +118,27 @@ func (r *routeRepositoryImpl) GetRouteByID(ctx context.Context, id string) (*rou
 	}
 	routeModel.SetWaypoints(waypoints)
 
+	// 路面の内訳を取得
+	breakdown, err := r.getSurfaceBreakdown(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	routeModel.SetSurfaceBreakdown(breakdown)
+	routeModel.SetDifficulty(fromNullDifficulty(rd.Difficulty))
+	routeModel.SetClubID(fromNullUUID(rd.ClubID))
+
+	// 出発地点と目的地の地名を取得
+	start, end, err := r.getRoutePlaces(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	routeModel.SetPlaces(start, end)
+
+	// タグを取得
+	if err := r.attachRouteTags(ctx, routeModel); err != nil {
+		return nil, err
+	}
+
 	return routeModel, nil
 }
 
@@ -140,18 +169,22 @@ func (r *routeRepositoryImpl) GetRoutesByUserID(ctx context.Context, userID stri
 			route.Geometry{Geometry: rd.LastPoint.Geometry},
 			rd.Polyline,
 			rd.Visibility,
+			rd.Version,
+			fromNullUUID(rd.ForkedFromRouteID),
 			rd.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			rd.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		)
 		if err != nil {
 			return nil, err
 		}
+		routeModel.SetDifficulty(fromNullDifficulty(rd.Difficulty))
+		routeModel.SetClubID(fromNullUUID(rd.ClubID))
 		result = append(result, routeModel)
 	}
 	return result, nil
 }
 
-func (r *routeRepositoryImpl) SearchRoutesByUserID(ctx context.Context, criteria *route.RouteSearchCriteria) ([]*route.Route, error) {
+func (r *routeRepositoryImpl) SearchRoutesByUserID(ctx context.Context, criteria *route.RouteSearchCriteria) (*route.RoutePage, error) {
 	uid, err := uuid.Parse(criteria.UserID())
 	if err != nil {
 		return nil, fmt.Errorf("invalid user id: %w", err)
@@ -177,17 +210,60 @@ func (r *routeRepositoryImpl) SearchRoutesByUserID(ctx context.Context, criteria
 		maxDistance = *d
 	}
 
+	hasCursor, cursorSortKey, cursorID, err := cursorParams(criteria.After())
+	if err != nil {
+		return nil, err
+	}
+
+	var collectionID pgtype.UUID
+	if id := criteria.CollectionID(); id != "" {
+		collectionID, err = toNullUUID(&id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid collection id: %w", err)
+		}
+	}
+	clubIDs := criteria.ViewerClubIDs()
+	viewerClubIDs := make([]uuid.UUID, 0, len(clubIDs))
+	for _, id := range clubIDs {
+		clubID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid club id: %w", err)
+		}
+		viewerClubIDs = append(viewerClubIDs, clubID)
+	}
+
+	filter := criteria.Filter()
+	// 次のページの有無を判定するため1件多く取得する
 	rows, err := r.queries.SearchRoutesByUserID(ctx, dbgen.SearchRoutesByUserIDParams{
-		UserID:       uid,
-		NameKeywords: nameKeywords,
-		Visibility:   visibility,
-		MinDistance:  minDistance,
-		MaxDistance:  maxDistance,
+		Sort:                 string(criteria.Sort()),
+		UserID:               uid,
+		ViewerClubIds:        viewerClubIDs,
+		SearchQuery:          textsearch.Query(keywords...),
+		Keyword:              strings.Join(keywords, " "),
+		NameKeywords:         nameKeywords,
+		Visibility:           visibility,
+		MinDistance:          minDistance,
+		MaxDistance:          maxDistance,
+		MinElevationGain:     floatOrSentinel(filter.MinElevationGain()),
+		MaxElevationGain:     floatOrSentinel(filter.MaxElevationGain()),
+		MinDuration:          floatOrSentinel(filter.MinDuration()),
+		MaxDuration:          floatOrSentinel(filter.MaxDuration()),
+		Author:               authorPattern(filter.Author()),
+		MinClimbingRatio:     floatOrSentinel(filter.MinClimbingRatio()),
+		MaxClimbingRatio:     floatOrSentinel(filter.MaxClimbingRatio()),
+		MaxUnpavedPercentage: floatOrSentinel(filter.MaxUnpavedPercentage()),
+		Area:                 areaPattern(filter.Area()),
+		CollectionID:         collectionID,
+		Tag:                  criteria.Tag(),
+		HasCursor:            hasCursor,
+		CursorSortKey:        cursorSortKey,
+		CursorID:             cursorID,
+		LimitCount:           criteria.Limit() + 1,
 	})
 	if err != nil {
 		return nil, err
 	}
-	result := make([]*route.Route, 0, len(rows))
+	result := make([]*route.ExploreRouteResult, 0, len(rows))
 	for _, rd := range rows {
 		routeModel, err := route.ReconstructRoute(
 			rd.ID.String(),
@@ -205,18 +281,29 @@ func (r *routeRepositoryImpl) SearchRoutesByUserID(ctx context.Context, criteria
 			route.Geometry{Geometry: rd.LastPoint.Geometry},
 			rd.Polyline,
 			rd.Visibility,
+			rd.Version,
+			fromNullUUID(rd.ForkedFromRouteID),
 			rd.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			rd.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		)
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, routeModel)
+		routeModel.SetDifficulty(fromNullDifficulty(rd.Difficulty))
+		routeModel.SetClubID(fromNullUUID(rd.ClubID))
+		searchResult, err := route.ReconstructExploreRouteResult(routeModel, rd.UserName, rd.SortKey)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, searchResult)
 	}
-	return result, nil
+	if err := r.attachRouteTags(ctx, resultRoutes(result)...); err != nil {
+		return nil, err
+	}
+	return route.NewRoutePage(result, criteria.Limit())
 }
 
-func (r *routeRepositoryImpl) ExploreRoutes(ctx context.Context, criteria *route.ExploreRoutesCriteria) ([]*route.ExploreRouteResult, error) {
+func (r *routeRepositoryImpl) ExploreRoutes(ctx context.Context, criteria *route.ExploreRoutesCriteria) (*route.RoutePage, error) {
 	// LIKE検索用に変換
 	keywords := criteria.Keywords()
 	nameKeywords := make([]string, len(keywords))
@@ -241,14 +328,37 @@ func (r *routeRepositoryImpl) ExploreRoutes(ctx context.Context, criteria *route
 		location = dbgen.OrbGeometry{Geometry: criteria.Location().Geometry}
 	}
 
+	hasCursor, cursorSortKey, cursorID, err := cursorParams(criteria.After())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := criteria.Filter()
+	// 次のページの有無を判定するため1件多く取得する
 	rows, err := r.queries.ExploreRoutes(ctx, dbgen.ExploreRoutesParams{
-		Location:     location,
-		RadiusM:      radiusM,
-		NameKeywords: nameKeywords,
-		MinDistance:  minDistance,
-		MaxDistance:  maxDistance,
-		LimitCount:   criteria.Limit(),
-		OffsetCount:  criteria.Offset(),
+		Sort:                 string(criteria.Sort()),
+		Location:             location,
+		SearchQuery:          textsearch.Query(keywords...),
+		Keyword:              strings.Join(keywords, " "),
+		RadiusM:              radiusM,
+		NameKeywords:         nameKeywords,
+		MinDistance:          minDistance,
+		MaxDistance:          maxDistance,
+		MinElevationGain:     floatOrSentinel(filter.MinElevationGain()),
+		MaxElevationGain:     floatOrSentinel(filter.MaxElevationGain()),
+		MinDuration:          floatOrSentinel(filter.MinDuration()),
+		MaxDuration:          floatOrSentinel(filter.MaxDuration()),
+		Author:               authorPattern(filter.Author()),
+		MinClimbingRatio:     floatOrSentinel(filter.MinClimbingRatio()),
+		MaxClimbingRatio:     floatOrSentinel(filter.MaxClimbingRatio()),
+		MaxUnpavedPercentage: floatOrSentinel(filter.MaxUnpavedPercentage()),
+		Area:                 areaPattern(filter.Area()),
+		MinDifficulty:        int16(criteria.MinDifficulty()),
+		MaxDifficulty:        int16(criteria.MaxDifficulty()),
+		HasCursor:            hasCursor,
+		CursorSortKey:        cursorSortKey,
+		CursorID:             cursorID,
+		LimitCount:           criteria.Limit() + 1,
 	})
 	if err != nil {
 		return nil, err
@@ -271,18 +381,182 @@ func (r *routeRepositoryImpl) ExploreRoutes(ctx context.Context, criteria *route
 			route.Geometry{Geometry: rd.LastPoint.Geometry},
 			rd.Polyline,
 			rd.Visibility,
+			rd.Version,
+			fromNullUUID(rd.ForkedFromRouteID),
 			rd.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			rd.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		)
 		if err != nil {
 			return nil, err
 		}
-		exploreRouteResult, err := route.ReconstructExploreRouteResult(routeModel, rd.UserName, rd.TotalCount)
+		routeModel.SetDifficulty(fromNullDifficulty(rd.Difficulty))
+		exploreRouteResult, err := route.ReconstructExploreRouteResult(routeModel, rd.UserName, rd.SortKey)
 		if err != nil {
 			return nil, err
 		}
 		result = append(result, exploreRouteResult)
 	}
+	if err := r.attachRouteTags(ctx, resultRoutes(result)...); err != nil {
+		return nil, err
+	}
+	return route.NewRoutePage(result, criteria.Limit())
+}
+
+func (r *routeRepositoryImpl) FindSimilarRouteCandidates(ctx context.Context, criteria *route.SimilarRouteCandidatesCriteria) ([]*route.ExploreRouteResult, error) {
+	uid, err := uuid.Parse(criteria.RouteID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid route id: %w", err)
+	}
+
+	// bboxの重なりで候補を絞り込む（routes_bbox_idx を使用）
+	rows, err := r.queries.FindSimilarRouteCandidates(ctx, dbgen.FindSimilarRouteCandidatesParams{
+		RouteID:     uid,
+		Bbox:        CalculateBbox(criteria.PathGeom().Geometry),
+		PaddingDeg:  MetersToDegrees(criteria.PaddingM()),
+		MinDistance: criteria.MinDistance(),
+		MaxDistance: criteria.MaxDistance(),
+		FirstPoint:  dbgen.OrbGeometry{Geometry: criteria.FirstPoint().Geometry},
+		LimitCount:  criteria.Limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*route.ExploreRouteResult, 0, len(rows))
+	for _, rd := range rows {
+		routeModel, err := route.ReconstructRoute(
+			rd.ID.String(),
+			rd.UserID.String(),
+			rd.Name,
+			rd.Description,
+			rd.HighlightedPhotoID,
+			rd.Distance,
+			rd.Duration,
+			rd.ElevationGain,
+			rd.ElevationLoss,
+			route.Geometry{Geometry: rd.PathGeom.Geometry},
+			route.Geometry{Geometry: rd.Bbox.Geometry},
+			route.Geometry{Geometry: rd.FirstPoint.Geometry},
+			route.Geometry{Geometry: rd.LastPoint.Geometry},
+			rd.Polyline,
+			rd.Visibility,
+			rd.Version,
+			fromNullUUID(rd.ForkedFromRouteID),
+			rd.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			rd.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		)
+		if err != nil {
+			return nil, err
+		}
+		routeModel.SetDifficulty(fromNullDifficulty(rd.Difficulty))
+		candidate, err := route.ReconstructExploreRouteResult(routeModel, rd.UserName, 0)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, candidate)
+	}
+	return result, nil
+}
+
+// GetRoutesInCollection はコレクション内のルートを並び順に取得する
+func (r *routeRepositoryImpl) GetRoutesInCollection(ctx context.Context, collectionID string) ([]*route.ExploreRouteResult, error) {
+	uid, err := uuid.Parse(collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid collection id: %w", err)
+	}
+
+	rows, err := r.queries.GetRoutesInCollection(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*route.ExploreRouteResult, 0, len(rows))
+	for _, rd := range rows {
+		routeModel, err := route.ReconstructRoute(
+			rd.ID.String(),
+			rd.UserID.String(),
+			rd.Name,
+			rd.Description,
+			rd.HighlightedPhotoID,
+			rd.Distance,
+			rd.Duration,
+			rd.ElevationGain,
+			rd.ElevationLoss,
+			route.Geometry{Geometry: rd.PathGeom.Geometry},
+			route.Geometry{Geometry: rd.Bbox.Geometry},
+			route.Geometry{Geometry: rd.FirstPoint.Geometry},
+			route.Geometry{Geometry: rd.LastPoint.Geometry},
+			rd.Polyline,
+			rd.Visibility,
+			rd.Version,
+			fromNullUUID(rd.ForkedFromRouteID),
+			rd.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			rd.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		)
+		if err != nil {
+			return nil, err
+		}
+		routeModel.SetDifficulty(fromNullDifficulty(rd.Difficulty))
+		routeModel.SetClubID(fromNullUUID(rd.ClubID))
+		res, err := route.ReconstructExploreRouteResult(routeModel, rd.UserName, 0)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, res)
+	}
+	if err := r.attachRouteTags(ctx, resultRoutes(result)...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetRoutesByIDs はIDで指定したルートをまとめて取得する。存在しないIDは無視し、順序は保証しない
+func (r *routeRepositoryImpl) GetRoutesByIDs(ctx context.Context, ids []string) ([]*route.ExploreRouteResult, error) {
+	uids := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			continue
+		}
+		uids = append(uids, uid)
+	}
+
+	rows, err := r.queries.ListRoutesByIDs(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*route.ExploreRouteResult, 0, len(rows))
+	for _, rd := range rows {
+		routeModel, err := route.ReconstructRoute(
+			rd.ID.String(),
+			rd.UserID.String(),
+			rd.Name,
+			rd.Description,
+			rd.HighlightedPhotoID,
+			rd.Distance,
+			rd.Duration,
+			rd.ElevationGain,
+			rd.ElevationLoss,
+			route.Geometry{Geometry: rd.PathGeom.Geometry},
+			route.Geometry{Geometry: rd.Bbox.Geometry},
+			route.Geometry{Geometry: rd.FirstPoint.Geometry},
+			route.Geometry{Geometry: rd.LastPoint.Geometry},
+			rd.Polyline,
+			rd.Visibility,
+			rd.Version,
+			fromNullUUID(rd.ForkedFromRouteID),
+			rd.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			rd.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		)
+		if err != nil {
+			return nil, err
+		}
+		routeModel.SetDifficulty(fromNullDifficulty(rd.Difficulty))
+		routeModel.SetClubID(fromNullUUID(rd.ClubID))
+		res, err := route.ReconstructExploreRouteResult(routeModel, rd.UserName, 0)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, res)
+	}
 	return result, nil
 }
 
@@ -298,6 +572,20 @@ func (r *routeRepositoryImpl) CountRoutesByUserID(ctx context.Context, userID st
 	return count, nil
 }
 
+func (r *routeRepositoryImpl) CountForks(ctx context.Context, routeID string) (int64, error) {
+	uid, err := uuid.Parse(routeID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid route id: %w", err)
+	}
+
+	count, err := r.queries.CountRouteForks(ctx, pgtype.UUID{Bytes: uid, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count forks: %w", err)
+	}
+
+	return count, nil
+}
+
 func (r *routeRepositoryImpl) SaveRoute(ctx context.Context, rt *route.Route) error {
 	// ルートIDとユーザーIDをUUIDに変換
 	routeID, err := uuid.Parse(rt.ID())
@@ -309,6 +597,15 @@ func (r *routeRepositoryImpl) SaveRoute(ctx context.Context, rt *route.Route) er
 		return fmt.Errorf("invalid user id: %w", err)
 	}
 
+	forkedFromRouteID, err := toNullUUID(rt.ForkedFromRouteID())
+	if err != nil {
+		return fmt.Errorf("invalid forked from route id: %w", err)
+	}
+	clubID, err := toNullUUID(rt.ClubID())
+	if err != nil {
+		return fmt.Errorf("invalid club id: %w", err)
+	}
+
 	// path_geomからbboxを自動計算
 	bbox := CalculateBbox(rt.PathGeom().Geometry)
 
@@ -328,6 +625,8 @@ func (r *routeRepositoryImpl) SaveRoute(ctx context.Context, rt *route.Route) er
 		FirstPoint:         dbgen.OrbGeometry{Geometry: rt.FirstPoint().Geometry},
 		LastPoint:          dbgen.OrbGeometry{Geometry: rt.LastPoint().Geometry},
 		Visibility:         rt.Visibility(),
+		ForkedFromRouteID:  forkedFromRouteID,
+		ClubID:             clubID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create route: %w", err)
@@ -382,7 +681,21 @@ func (r *routeRepositoryImpl) SaveRoute(ctx context.Context, rt *route.Route) er
 		}
 	}
 
-	return nil
+	// 全文検索用ドキュメントを保存
+	err = r.queries.UpsertRouteSearchDocument(ctx, dbgen.UpsertRouteSearchDocumentParams{
+		RouteID:    routeID,
+		SearchText: searchDocument(rt.Name(), rt.Description(), rt.CoursePoints()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save search document: %w", err)
+	}
+
+	if err := r.saveRoutePlaces(ctx, routeID, rt); err != nil {
+		return err
+	}
+
+	// 道路網と照合して路面の内訳を作成
+	return r.refreshRouteSurfaces(ctx, routeID)
 }
 
 func (r *routeRepositoryImpl) DeleteRoute(ctx context.Context, id string) error {
@@ -395,7 +708,7 @@ func (r *routeRepositoryImpl) DeleteRoute(ctx context.Context, id string) error
 	_, err = r.queries.DeleteRoute(ctx, uid)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return fmt.Errorf("route not found")
+			return domainerror.New("route not found", domainerror.ErrNotFound)
 		}
 		return fmt.Errorf("failed to delete route: %w", err)
 	}
@@ -410,11 +723,16 @@ func (r *routeRepositoryImpl) UpdateRoute(ctx context.Context, rt *route.Route)
 		return fmt.Errorf("invalid route id: %w", err)
 	}
 
+	clubID, err := toNullUUID(rt.ClubID())
+	if err != nil {
+		return fmt.Errorf("invalid club id: %w", err)
+	}
+
 	// path_geomからbboxを自動計算
 	bbox := CalculateBbox(rt.PathGeom().Geometry)
 
-	// ルート本体を更新
-	err = r.queries.UpdateRoute(ctx, dbgen.UpdateRouteParams{
+	// ルート本体を更新（読み込んだ時点のバージョンと一致する場合のみ）
+	rows, err := r.queries.UpdateRoute(ctx, dbgen.UpdateRouteParams{
 		ID:                 routeID,
 		Name:               rt.Name(),
 		Description:        rt.Description(),
@@ -428,10 +746,15 @@ func (r *routeRepositoryImpl) UpdateRoute(ctx context.Context, rt *route.Route)
 		FirstPoint:         dbgen.OrbGeometry{Geometry: rt.FirstPoint().Geometry},
 		LastPoint:          dbgen.OrbGeometry{Geometry: rt.LastPoint().Geometry},
 		Visibility:         rt.Visibility(),
+		ClubID:             clubID,
+		Version:            rt.Version(),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update route: %w", err)
 	}
+	if rows == 0 {
+		return domainerror.New("route has been modified by another request", domainerror.ErrConflict)
+	}
 
 	// 既存のコースポイントとウェイポイントを削除
 	err = r.queries.DeleteCoursePointsByRouteID(ctx, routeID)
@@ -493,5 +816,99 @@ func (r *routeRepositoryImpl) UpdateRoute(ctx context.Context, rt *route.Route)
 		}
 	}
 
-	return nil
-}
\ No newline at end of file
+	// 全文検索用ドキュメントを保存
+	err = r.queries.UpsertRouteSearchDocument(ctx, dbgen.UpsertRouteSearchDocumentParams{
+		RouteID:    routeID,
+		SearchText: searchDocument(rt.Name(), rt.Description(), rt.CoursePoints()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save search document: %w", err)
+	}
+
+	if err := r.saveRoutePlaces(ctx, routeID, rt); err != nil {
+		return err
+	}
+
+	// 経路が変わった場合に備え、路面の内訳を作り直す
+	return r.refreshRouteSurfaces(ctx, routeID)
+}
+
+// toNullUUID はNULL許容のUUID列に保存する値に変換する
+func toNullUUID(id *string) (pgtype.UUID, error) {
+	if id == nil {
+		return pgtype.UUID{}, nil
+	}
+	uid, err := uuid.Parse(*id)
+	if err != nil {
+		return pgtype.UUID{}, err
+	}
+	return pgtype.UUID{Bytes: uid, Valid: true}, nil
+}
+
+func fromNullUUID(id pgtype.UUID) *string {
+	if !id.Valid {
+		return nil
+	}
+	s := uuid.UUID(id.Bytes).String()
+	return &s
+}
+
+// fromNullString はNULL許容の文字列列を、NULLを空文字とする値に変換する
+func fromNullString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// floatOrSentinel は未指定(nil)の数値条件をSQL側で無視させるためのセンチネル値(-1)に変換する
+func floatOrSentinel(v *float64) float64 {
+	if v == nil {
+		return -1
+	}
+	return *v
+}
+
+// authorPattern は作成者名の部分一致検索用にILIKEのパターンへ変換する
+// 未指定の場合は空文字を返し、SQL側で条件を無視させる
+func authorPattern(author string) string {
+	if author == "" {
+		return ""
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return "%" + replacer.Replace(author) + "%"
+}
+
+// areaPattern は地域の前方一致検索用にLIKEのパターンへ変換する
+// 未指定の場合は空文字を返し、SQL側で条件を無視させる
+func areaPattern(area string) string {
+	if area == "" {
+		return ""
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return replacer.Replace(area) + "%"
+}
+
+// cursorParams はカーソルをSQLのキーセット条件のパラメータに変換する
+// カーソルがない場合は has_cursor=false として条件を無視させる
+func cursorParams(after *pagination.Cursor) (bool, float64, uuid.UUID, error) {
+	if after == nil {
+		return false, 0, uuid.Nil, nil
+	}
+	id, err := uuid.Parse(after.ID())
+	if err != nil {
+		return false, 0, uuid.Nil, fmt.Errorf("invalid cursor id: %w", err)
+	}
+	return true, after.SortKey(), id, nil
+}
+
+// searchDocument はルートの名前・説明・コースポイントの道路名から全文検索用のトークン列を作成する
+func searchDocument(name, description string, coursePoints []*route.CoursePoint) string {
+	texts := []string{name, description}
+	for _, cp := range coursePoints {
+		if cp.RoadName() != nil {
+			texts = append(texts, *cp.RoadName())
+		}
+	}
+	return textsearch.Document(texts...)
+}
@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
 	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
 	"github.com/paulmach/orb"
 )
@@ -55,6 +58,11 @@ func TestRouteRepository_GetRouteByID(t *testing.T) {
 			routeID: "00000000-0000-0000-0000-000000000000",
 			wantErr: true,
 		},
+		{
+			name:    "形式が不正なIDの場合はエラー",
+			routeID: "not-a-route-id",
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -62,8 +70,9 @@ func TestRouteRepository_GetRouteByID(t *testing.T) {
 			got, err := routeRepository.GetRouteByID(ctx, tt.routeID)
 
 			if tt.wantErr {
-				if err == nil {
-					t.Error("expected error but got nil")
+				// 見つからないルートは404で返せるようErrNotFoundにする
+				if !errors.Is(err, domainerror.ErrNotFound) {
+					t.Errorf("expected not found error but got %v", err)
 				}
 				return
 			}
@@ -158,14 +167,19 @@ func TestRouteRepository_SearchRoutesByUserID(t *testing.T) {
 	resetTestData(t)
 
 	tests := []struct {
-		name        string
-		userID      string
-		keywords    []string
-		visibility  *int16
-		minDistance *float64
-		maxDistance *float64
-		wantCount   int
-		wantErr     bool
+		name         string
+		userID       string
+		keywords     []string
+		visibility   *int16
+		minDistance  *float64
+		maxDistance  *float64
+		filter       routeDomain.RouteFilter
+		collectionID string
+		tag          string
+		sort         routeDomain.RouteSort
+		wantCount    int
+		wantFirstID  string
+		wantErr      bool
 	}{
 		{
 			name:        "キーワード、公開範囲、距離の指定が無い場合対象ユーザーの全ルートが検索できる",
@@ -205,6 +219,27 @@ func TestRouteRepository_SearchRoutesByUserID(t *testing.T) {
 			wantCount: 1, // Tokyo Cycling Route
 			wantErr:   false,
 		},
+		{
+			name:      "説明文に含まれるキーワードで検索できる",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{"絶景"},
+			wantCount: 1, // しまなみ海道
+			wantErr:   false,
+		},
+		{
+			name:      "コースポイントの道路名で検索できる",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{"内堀通り"},
+			wantCount: 1, // 皇居一周ルート
+			wantErr:   false,
+		},
+		{
+			name:      "綴りを間違えてもルート名のあいまい一致で検索できる",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{"cycing"},
+			wantCount: 1, // Tokyo Cycling Route
+			wantErr:   false,
+		},
 		{
 			name:      "キーワードに一致するルートがない場合は空配列を返す",
 			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
@@ -304,11 +339,125 @@ func TestRouteRepository_SearchRoutesByUserID(t *testing.T) {
 			wantCount: 0,
 			wantErr:   false,
 		},
+		// ---- 獲得標高・所要時間・作成者・登坂率 ----
+		{
+			name:      "獲得標高の下限を指定してルートが検索できる",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, new(40.0), nil, nil, nil, "", nil, nil, nil, ""),
+			wantCount: 2, // 多摩川サイクリングロード(50)、多摩川-都民の森ルート(500)
+		},
+		{
+			name:      "所要時間の上限を指定してルートが検索できる",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, new(1800.0), "", nil, nil, nil, ""),
+			wantCount: 3, // 皇居一周ルート(900)、しまなみ海道(1800)、Tokyo Cycling Route(1800)
+		},
+		{
+			name:      "作成者名の部分一致で検索できる",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "TEST", nil, nil, nil, ""),
+			wantCount: 5,
+		},
+		{
+			name:      "作成者名が一致しない場合は空配列を返す",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "cyclingfan", nil, nil, nil, ""),
+			wantCount: 0,
+		},
+		{
+			name:      "作成者名の % はワイルドカードとして扱わない",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "%", nil, nil, nil, ""),
+			wantCount: 0,
+		},
+		{
+			name:         "コレクション内の他のユーザーのルートを作成者名で絞り込める",
+			userID:       "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:     []string{},
+			filter:       mustRouteFilter(t, nil, nil, nil, nil, "pro", nil, nil, nil, ""),
+			collectionID: "019b5a63-0000-7000-8000-000000000001",
+			wantCount:    1, // ヤビツ峠チャレンジ(pro_racer)
+		},
+		{
+			name:      "登坂率の下限を指定してルートが検索できる",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "", new(5.0), nil, nil, ""),
+			wantCount: 1, // 多摩川-都民の森ルート(10m/km)
+		},
+		// ---- コレクション・タグ ----
+		{
+			name:         "コレクション内のルートに絞り込める",
+			userID:       "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:     []string{},
+			collectionID: "019b5a63-0000-7000-8000-000000000001",
+			wantCount:    3,                                      // 他のユーザーの公開ルート（ヤビツ峠チャレンジ）も含む
+			wantFirstID:  "019b5a50-0000-7000-8000-000000000006", // 並び順未指定の場合はコレクション内の並び順
+		},
+		{
+			name:         "コレクション内の並び順を指定できる",
+			userID:       "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:     []string{},
+			collectionID: "019b5a63-0000-7000-8000-000000000001",
+			sort:         routeDomain.RouteSortPosition,
+			wantCount:    3,
+			wantFirstID:  "019b5a50-0000-7000-8000-000000000006", // Tokyo Cycling Route(position 0)
+		},
+		{
+			name:         "コレクション内の閲覧できない他のユーザーのルートは含まない",
+			userID:       "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:     []string{},
+			collectionID: "019b5a63-0000-7000-8000-000000000003",
+			wantCount:    0, // 湘南海岸サイクリングは友達限定
+		},
+		{
+			name:         "コレクション内の自分のルートは公開範囲に関わらず含む",
+			userID:       "019b5a46-1e77-7b9d-ac62-b438a0fc89cb",
+			keywords:     []string{},
+			collectionID: "019b5a63-0000-7000-8000-000000000003",
+			wantCount:    1, // 湘南海岸サイクリング
+		},
+		{
+			name:      "タグで絞り込める",
+			userID:    "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:  []string{},
+			tag:       "#東京",
+			wantCount: 2, // 皇居一周ルート、Tokyo Cycling Route
+		},
+		{
+			name:         "コレクションとタグを組み合わせて絞り込める",
+			userID:       "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:     []string{},
+			collectionID: "019b5a63-0000-7000-8000-000000000001",
+			tag:          "景色",
+			wantCount:    1, // 皇居一周ルート
+		},
+		// ---- 並び順 ----
+		{
+			name:        "距離の長い順に並べられる",
+			userID:      "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:    []string{},
+			sort:        routeDomain.RouteSortLongest,
+			wantCount:   5,
+			wantFirstID: "019b5a50-0000-7000-8000-000000000007", // 多摩川-都民の森ルート(50000)
+		},
+		{
+			name:        "並び順未指定の場合は新しい順",
+			userID:      "70d6037a-b67b-4aa8-b5a3-da393b514f24",
+			keywords:    []string{},
+			wantCount:   5,
+			wantFirstID: "019b5a50-0000-7000-8000-000000000007", // 多摩川-都民の森ルート(2024-05-01)
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			criteria, err := routeDomain.NewRouteSearchCriteria(tt.userID, tt.keywords, tt.visibility, tt.minDistance, tt.maxDistance)
+			criteria, err := routeDomain.NewRouteSearchCriteria(routeDomain.NewViewer(tt.userID, nil), tt.keywords, tt.visibility, tt.minDistance, tt.maxDistance, tt.filter, tt.collectionID, tt.tag, tt.sort, 10, nil)
 			if err != nil {
 				t.Fatalf("failed to create search criteria: %v", err)
 				return
@@ -327,8 +476,11 @@ func TestRouteRepository_SearchRoutesByUserID(t *testing.T) {
 				t.Fatalf("unexpected error: %v", err)
 			}
 
-			if len(got) != tt.wantCount {
-				t.Errorf("count mismatch: want %d, got %d", tt.wantCount, len(got))
+			if len(got.Items) != tt.wantCount {
+				t.Errorf("count mismatch: want %d, got %d", tt.wantCount, len(got.Items))
+			}
+			if tt.wantFirstID != "" && len(got.Items) > 0 && got.Items[0].Route.ID() != tt.wantFirstID {
+				t.Errorf("first route mismatch: want %s, got %s", tt.wantFirstID, got.Items[0].Route.ID())
 			}
 		})
 	}
@@ -352,16 +504,20 @@ func TestRouteRepository_ExploreRoutes(t *testing.T) {
 	resetTestData(t)
 
 	tests := []struct {
-		name        string
-		keywords    []string
-		location    *routeDomain.Geometry
-		radius      *float64
-		minDistance *float64
-		maxDistance *float64
-		offset      int32
-		limit       int32
-		wantCount   int
-		wantErr     bool
+		name          string
+		keywords      []string
+		location      *routeDomain.Geometry
+		radius        *float64
+		minDistance   *float64
+		maxDistance   *float64
+		filter        routeDomain.RouteFilter
+		minDifficulty routeDomain.Difficulty
+		maxDifficulty routeDomain.Difficulty
+		sort          routeDomain.RouteSort
+		limit         int32
+		wantCount     int
+		wantFirstID   string
+		wantErr       bool
 	}{
 		// ---- キーワード検索 ----
 		{
@@ -496,11 +652,134 @@ func TestRouteRepository_ExploreRoutes(t *testing.T) {
 			limit:     2,
 			wantCount: 2,
 		},
+		// ---- 獲得標高・所要時間・作成者・登坂率 ----
+		{
+			name:      "獲得標高の下限を指定して検索できる",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, new(100.0), nil, nil, nil, "", nil, nil, nil, ""),
+			limit:     10,
+			wantCount: 1, // ヤビツ峠(760)
+		},
+		{
+			name:      "所要時間の上限を指定して検索できる",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, new(1800.0), "", nil, nil, nil, ""),
+			limit:     10,
+			wantCount: 2, // 皇居(900) + Tokyo Cycling Route(1800)
+		},
+		{
+			name:      "作成者名の部分一致で検索できる",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "pro", nil, nil, nil, ""),
+			limit:     10,
+			wantCount: 1, // ヤビツ峠(pro_racer)
+		},
+		{
+			name:      "作成者名の _ はワイルドカードとして扱わない",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "_", nil, nil, nil, ""),
+			limit:     10,
+			wantCount: 1, // ヤビツ峠(pro_racer)
+		},
+		{
+			name:      "登坂率の下限を指定して検索できる",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "", new(3.0), nil, nil, ""),
+			limit:     10,
+			wantCount: 3, // 皇居(4m/km) + 多摩川(3.3m/km) + ヤビツ峠(19m/km)
+		},
+		{
+			name:      "未舗装の割合の上限を指定して検索できる",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "", nil, nil, new(10.0), ""),
+			limit:     10,
+			wantCount: 2, // 皇居(0%) + 多摩川(5%)。路面の内訳がないTokyo Cycling Routeは含めない
+		},
+		{
+			name:      "都道府県名の前方一致で検索できる",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "", nil, nil, nil, "神奈川"),
+			limit:     10,
+			wantCount: 2, // 多摩川(目的地が川崎市) + ヤビツ峠(秦野市)
+		},
+		{
+			name:      "市区町村名で検索できる",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "", nil, nil, nil, "千代田区"),
+			limit:     10,
+			wantCount: 1, // 皇居
+		},
+		{
+			name:      "地名のワイルドカードはエスケープされる",
+			keywords:  []string{},
+			filter:    mustRouteFilter(t, nil, nil, nil, nil, "", nil, nil, nil, "%"),
+			limit:     10,
+			wantCount: 0,
+		},
+		// ---- 難易度 ----
+		{
+			name:          "難易度の下限を指定して検索できる",
+			keywords:      []string{},
+			minDifficulty: routeDomain.DifficultyModerate,
+			limit:         10,
+			wantCount:     1, // ヤビツ峠(moderate)
+		},
+		{
+			name:          "難易度の上限を指定して検索できる",
+			keywords:      []string{},
+			maxDifficulty: routeDomain.DifficultyEasy,
+			limit:         10,
+			wantCount:     2, // 皇居 + 多摩川。難易度を求めていないTokyo Cycling Routeは含めない
+		},
+		// ---- 並び順 ----
+		{
+			name:        "基準点を指定した場合は近い順に並ぶ",
+			keywords:    []string{},
+			location:    tokyoStation(),
+			radius:      new(100000.0),
+			limit:       10,
+			wantCount:   4,
+			wantFirstID: "019b5a50-0000-7000-8000-000000000001", // 皇居(約1.1km)
+		},
+		{
+			name:        "新しい順に並べられる",
+			keywords:    []string{},
+			sort:        routeDomain.RouteSortNewest,
+			limit:       10,
+			wantCount:   4,
+			wantFirstID: "019b5a50-0000-7000-8000-000000000006", // Tokyo Cycling Route(2024-04-01)
+		},
+		{
+			name:        "距離の長い順に並べられる",
+			keywords:    []string{},
+			sort:        routeDomain.RouteSortLongest,
+			limit:       10,
+			wantCount:   4,
+			wantFirstID: "019b5a50-0000-7000-8000-000000000004", // ヤビツ峠(40000)
+		},
+		{
+			name:        "登坂率の大きい順に並べられる",
+			keywords:    []string{},
+			location:    tokyoStation(),
+			radius:      new(100000.0),
+			sort:        routeDomain.RouteSortHilliest,
+			limit:       10,
+			wantCount:   4,
+			wantFirstID: "019b5a50-0000-7000-8000-000000000004", // ヤビツ峠(19m/km)
+		},
+		{
+			name:        "いいねがない場合はIDの降順で並ぶ",
+			keywords:    []string{},
+			sort:        routeDomain.RouteSortMostLiked,
+			limit:       10,
+			wantCount:   4,
+			wantFirstID: "019b5a50-0000-7000-8000-000000000006",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			criteria, err := routeDomain.NewExploreRoutesCriteria(tt.keywords, tt.location, tt.radius, tt.minDistance, tt.maxDistance, tt.limit, tt.offset)
+			criteria, err := routeDomain.NewExploreRoutesCriteria(tt.keywords, tt.location, tt.radius, tt.minDistance, tt.maxDistance, tt.filter, tt.minDifficulty, tt.maxDifficulty, tt.sort, tt.limit, nil)
 			if err != nil {
 				t.Fatalf("failed to create search criteria: %v", err)
 				return
@@ -519,8 +798,64 @@ func TestRouteRepository_ExploreRoutes(t *testing.T) {
 				t.Fatalf("unexpected error: %v", err)
 			}
 
-			if len(got) != tt.wantCount {
-				t.Errorf("count mismatch: want %d, got %d", tt.wantCount, len(got))
+			if len(got.Items) != tt.wantCount {
+				t.Errorf("count mismatch: want %d, got %d", tt.wantCount, len(got.Items))
+			}
+			if tt.wantFirstID != "" && len(got.Items) > 0 && got.Items[0].Route.ID() != tt.wantFirstID {
+				t.Errorf("first route mismatch: want %s, got %s", tt.wantFirstID, got.Items[0].Route.ID())
+			}
+		})
+	}
+}
+
+func TestRouteRepository_ExploreRoutes_Pagination(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	sorts := []routeDomain.RouteSort{
+		routeDomain.RouteSortNewest,
+		routeDomain.RouteSortLongest,
+		routeDomain.RouteSortHilliest,
+		routeDomain.RouteSortMostLiked,
+		routeDomain.RouteSortNearest,
+	}
+	for _, sort := range sorts {
+		t.Run(string(sort), func(t *testing.T) {
+			var location *routeDomain.Geometry
+			var radius *float64
+			if sort == routeDomain.RouteSortNearest {
+				location = tokyoStation()
+				radius = new(100000.0)
+			}
+
+			// limit=1 で最後のページまでたどり、全件が重複・欠落なく取得できることを確認する
+			seen := map[string]bool{}
+			var after *pagination.Cursor
+			for page := 0; page < 10; page++ {
+				criteria, err := routeDomain.NewExploreRoutesCriteria(nil, location, radius, nil, nil, routeDomain.RouteFilter{}, routeDomain.DifficultyUnknown, routeDomain.DifficultyUnknown, sort, 1, after)
+				if err != nil {
+					t.Fatalf("failed to create search criteria: %v", err)
+				}
+				got, err := routeRepository.ExploreRoutes(ctx, criteria)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				for _, item := range got.Items {
+					if seen[item.Route.ID()] {
+						t.Errorf("route %s returned twice", item.Route.ID())
+					}
+					seen[item.Route.ID()] = true
+				}
+				if got.Next == nil {
+					break
+				}
+				after = got.Next
+			}
+
+			if len(seen) != 4 {
+				t.Errorf("count mismatch: want 4, got %d", len(seen))
 			}
 		})
 	}
@@ -793,6 +1128,8 @@ func TestRouteRepository_UpdateRoute(t *testing.T) {
 		lastPoint,
 		"gvxxE_n~sYvQo_@~WoK", //SELECT ST_AsEncodedPolyline(ST_SimplifyPreserveTopology(GeomFromEWKT('SRID=4326;LINESTRING(139.7528 35.6850,139.7580 35.6820,139.7600 35.6780)'), 0.0001));
 		2,
+		existingRoute.Version(), // 読み込んだ時点のバージョンを使用
+		nil,
 		existingRoute.CreatedAt(), // 既存の作成日時を使用
 		existingRoute.UpdatedAt(), // 既存の更新日時を使用
 	)
@@ -879,6 +1216,64 @@ func TestRouteRepository_UpdateRoute(t *testing.T) {
 			if len(updated.CoursePoints()) != len(tt.route.CoursePoints()) {
 				t.Errorf("CoursePoints count mismatch: want %d, got %d", len(tt.route.CoursePoints()), len(updated.CoursePoints()))
 			}
+			if updated.Version() != tt.route.Version()+1 {
+				t.Errorf("Version mismatch: want %d, got %d", tt.route.Version()+1, updated.Version())
+			}
 		})
 	}
+
+	t.Run("読み込み後に更新されたルートは上書きしないこと", func(t *testing.T) {
+		// updatedRouteは更新前のバージョンを保持している
+		err := routeRepository.UpdateRoute(ctx, updatedRoute)
+		if !errors.Is(err, domainerror.ErrConflict) {
+			t.Errorf("error = %v, want ErrConflict", err)
+		}
+	})
+}
+
+func mustRouteFilter(t *testing.T, minElevationGain, maxElevationGain, minDuration, maxDuration *float64, author string, minClimbingRatio, maxClimbingRatio, maxUnpavedPercentage *float64, area string) routeDomain.RouteFilter {
+	t.Helper()
+	filter, err := routeDomain.NewRouteFilter(minElevationGain, maxElevationGain, minDuration, maxDuration, author, minClimbingRatio, maxClimbingRatio, maxUnpavedPercentage, area)
+	if err != nil {
+		t.Fatalf("failed to create route filter: %v", err)
+	}
+	return filter
+}
+
+func TestRouteRepository_CountForks(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	original, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000001")
+	if err != nil {
+		t.Fatalf("failed to get route: %v", err)
+	}
+	forked, err := original.Fork(routeDomain.NewViewer(original.UserID(), nil))
+	if err != nil {
+		t.Fatalf("failed to fork route: %v", err)
+	}
+	if err := routeRepository.SaveRoute(ctx, forked); err != nil {
+		t.Fatalf("failed to save forked route: %v", err)
+	}
+
+	saved, err := routeRepository.GetRouteByID(ctx, forked.ID())
+	if err != nil {
+		t.Fatalf("failed to get forked route: %v", err)
+	}
+	if saved.ForkedFromRouteID() == nil || *saved.ForkedFromRouteID() != original.ID() {
+		t.Errorf("ForkedFromRouteID = %v, want %s", saved.ForkedFromRouteID(), original.ID())
+	}
+	if len(saved.CoursePoints()) != len(original.CoursePoints()) {
+		t.Errorf("CoursePoints count mismatch: want %d, got %d", len(original.CoursePoints()), len(saved.CoursePoints()))
+	}
+
+	count, err := routeRepository.CountForks(ctx, original.ID())
+	if err != nil {
+		t.Fatalf("CountForks() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("CountForks() = %d, want 1", count)
+	}
 }
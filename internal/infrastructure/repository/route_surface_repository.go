@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+)
+
+// route_surfacesのkind
+const (
+	routeSurfaceKindSurface   = "surface"
+	routeSurfaceKindRoadClass = "road_class"
+)
+
+// RefreshSurfaceBreakdown は保存済みの経路を道路網と照合し、路面の内訳を作り直す
+// 道路網を取り込んでいない場合は、全区間がunknownになる
+func (r *routeRepositoryImpl) RefreshSurfaceBreakdown(ctx context.Context, routeID string) error {
+	uid, err := uuid.Parse(routeID)
+	if err != nil {
+		return fmt.Errorf("invalid route id: %w", err)
+	}
+	return r.refreshRouteSurfaces(ctx, uid)
+}
+
+func (r *routeRepositoryImpl) refreshRouteSurfaces(ctx context.Context, routeID uuid.UUID) error {
+	if err := r.queries.DeleteRouteSurfaces(ctx, routeID); err != nil {
+		return fmt.Errorf("failed to delete route surfaces: %w", err)
+	}
+	err := r.queries.CreateRouteSurfaces(ctx, dbgen.CreateRouteSurfacesParams{
+		RouteID:         routeID,
+		PavedSurfaces:   route.SurfaceTags(route.SurfaceTypePaved),
+		GravelSurfaces:  route.SurfaceTags(route.SurfaceTypeGravel),
+		DirtSurfaces:    route.SurfaceTags(route.SurfaceTypeDirt),
+		UnpavedHighways: route.UnpavedHighways(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create route surfaces: %w", err)
+	}
+	return nil
+}
+
+// GetSurfaceBreakdown は保存済みの路面の内訳を取得する。まだ作成していない場合はnilを返す
+func (r *routeRepositoryImpl) GetSurfaceBreakdown(ctx context.Context, routeID string) (*route.SurfaceBreakdown, error) {
+	uid, err := uuid.Parse(routeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route id: %w", err)
+	}
+	return r.getSurfaceBreakdown(ctx, uid)
+}
+
+// GetRouteGrades は保存済みの経路を道路網と照合し、始点から順に勾配のプロファイルを返す
+// 近くに道路がない区間はGradientがnilになる
+func (r *routeRepositoryImpl) GetRouteGrades(ctx context.Context, routeID string) ([]route.GradeSample, error) {
+	uid, err := uuid.Parse(routeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route id: %w", err)
+	}
+	rows, err := r.queries.ListRouteGrades(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list route grades: %w", err)
+	}
+	grades := make([]route.GradeSample, len(rows))
+	for i, row := range rows {
+		grades[i] = route.GradeSample{Distance: row.Length, Gradient: row.Gradient}
+	}
+	return grades, nil
+}
+
+// SaveRouteDifficulty はルートの難易度を保存する
+func (r *routeRepositoryImpl) SaveRouteDifficulty(ctx context.Context, rt *route.Route) error {
+	uid, err := uuid.Parse(rt.ID())
+	if err != nil {
+		return fmt.Errorf("invalid route id: %w", err)
+	}
+	err = r.queries.UpdateRouteDifficulty(ctx, dbgen.UpdateRouteDifficultyParams{
+		Difficulty: int16(rt.Difficulty()),
+		ID:         uid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update route difficulty: %w", err)
+	}
+	return nil
+}
+
+// fromNullDifficulty は難易度の列の値を変換する。まだ求めていない場合はDifficultyUnknownを返す
+func fromNullDifficulty(d *int16) route.Difficulty {
+	if d == nil {
+		return route.DifficultyUnknown
+	}
+	return route.Difficulty(*d)
+}
+
+// getSurfaceBreakdown は路面の内訳を取得する。まだ作成していない場合はnilを返す
+func (r *routeRepositoryImpl) getSurfaceBreakdown(ctx context.Context, routeID uuid.UUID) (*route.SurfaceBreakdown, error) {
+	rows, err := r.queries.GetRouteSurfacesByRouteID(ctx, routeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get route surfaces: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	surfaces := map[string]float64{}
+	roadClasses := map[string]float64{}
+	for _, row := range rows {
+		switch row.Kind {
+		case routeSurfaceKindSurface:
+			surfaces[row.Category] = row.Distance
+		case routeSurfaceKindRoadClass:
+			roadClasses[row.Category] = row.Distance
+		}
+	}
+	return route.NewSurfaceBreakdown(surfaces, roadClasses), nil
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/paulmach/orb"
+)
+
+func TestRouteRepository_SurfaceBreakdown(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	t.Run("保存済みの路面の内訳を取得できること", func(t *testing.T) {
+		got, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000004")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		b := got.SurfaceBreakdown()
+		if b == nil {
+			t.Fatal("SurfaceBreakdown() = nil")
+		}
+		surfaces := b.Surfaces()
+		if len(surfaces) != 2 || surfaces[0].Category != "paved" || surfaces[0].Percentage != 70 {
+			t.Errorf("Surfaces() = %+v", surfaces)
+		}
+		if b.UnpavedPercentage() != 30 {
+			t.Errorf("UnpavedPercentage() = %v, want 30", b.UnpavedPercentage())
+		}
+	})
+
+	t.Run("路面の内訳がないルートはnilになること", func(t *testing.T) {
+		got, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000006")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.SurfaceBreakdown() != nil {
+			t.Errorf("SurfaceBreakdown() = %+v, want nil", got.SurfaceBreakdown())
+		}
+	})
+
+	t.Run("保存したルートを道路網と照合して路面の内訳を作ること", func(t *testing.T) {
+		// 内堀通り(secondary, asphalt)→住宅街(residential, surfaceタグなし)→道路のない区間
+		path := orb.LineString{{139.7500, 35.6800}, {139.7520, 35.6800}, {139.7520, 35.6820}, {139.7520, 35.6840}}
+		rt, err := routeDomain.NewRoute(
+			"70d6037a-b67b-4aa8-b5a3-da393b514f24", "路面の確認", "", nil, 625, 120, 0, 0,
+			routeDomain.Geometry{Geometry: path},
+			routeDomain.Geometry{Geometry: path[0]},
+			routeDomain.Geometry{Geometry: path[len(path)-1]},
+			1,
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := routeRepository.SaveRoute(ctx, rt); err != nil {
+			t.Fatalf("SaveRoute() error = %v", err)
+		}
+
+		saved, err := routeRepository.GetRouteByID(ctx, rt.ID())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		b := saved.SurfaceBreakdown()
+		if b == nil {
+			t.Fatal("SurfaceBreakdown() = nil")
+		}
+		shares := map[string]float64{}
+		for _, s := range b.Surfaces() {
+			shares[s.Category] = s.Percentage
+		}
+		// 道路のない区間のうち、住宅街の終点から20m以内の部分は住宅街に含まれる
+		if math.Abs(shares["paved"]-68) > 5 || math.Abs(shares["unknown"]-32) > 5 || b.UnpavedPercentage() != 0 {
+			t.Errorf("Surfaces() = %+v", b.Surfaces())
+		}
+		roadClasses := map[string]float64{}
+		for _, s := range b.RoadClasses() {
+			roadClasses[s.Category] = s.Distance
+		}
+		if math.Abs(roadClasses["secondary"]-181) > 30 || math.Abs(roadClasses["residential"]-222) > 50 {
+			t.Errorf("RoadClasses() = %+v", b.RoadClasses())
+		}
+
+		// 勾配は内堀通りが0%、住宅街が5m/222m、道路のない区間は不明
+		grades, err := routeRepository.GetRouteGrades(ctx, rt.ID())
+		if err != nil {
+			t.Fatalf("GetRouteGrades() error = %v", err)
+		}
+		byGradient := map[string]float64{}
+		for _, g := range grades {
+			switch {
+			case g.Gradient == nil:
+				byGradient["unknown"] += g.Distance
+			case math.Abs(*g.Gradient) < 1e-9:
+				byGradient["flat"] += g.Distance
+			case math.Abs(*g.Gradient-5.0/222*100) < 1e-9:
+				byGradient["residential"] += g.Distance
+			default:
+				t.Errorf("unexpected gradient %v", *g.Gradient)
+			}
+		}
+		if math.Abs(byGradient["flat"]-181) > 30 || math.Abs(byGradient["residential"]-222) > 50 || math.Abs(byGradient["unknown"]-200) > 50 {
+			t.Errorf("GetRouteGrades() distances = %+v", byGradient)
+		}
+		if grades[0].Gradient == nil || *grades[0].Gradient != 0 || grades[len(grades)-1].Gradient != nil {
+			t.Errorf("GetRouteGrades() is not ordered from the start: first = %+v, last = %+v", grades[0], grades[len(grades)-1])
+		}
+	})
+
+	t.Run("道路網の向きと逆に進む区間の勾配は符号が逆になること", func(t *testing.T) {
+		// 住宅街を北から南へ下る
+		path := orb.LineString{{139.7520, 35.6820}, {139.7520, 35.6800}}
+		rt, err := routeDomain.NewRoute(
+			"70d6037a-b67b-4aa8-b5a3-da393b514f24", "下り", "", nil, 222, 0, 0, 5,
+			routeDomain.Geometry{Geometry: path},
+			routeDomain.Geometry{Geometry: path[0]},
+			routeDomain.Geometry{Geometry: path[len(path)-1]},
+			1,
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := routeRepository.SaveRoute(ctx, rt); err != nil {
+			t.Fatalf("SaveRoute() error = %v", err)
+		}
+		grades, err := routeRepository.GetRouteGrades(ctx, rt.ID())
+		if err != nil {
+			t.Fatalf("GetRouteGrades() error = %v", err)
+		}
+		if len(grades) == 0 {
+			t.Fatal("GetRouteGrades() returned no samples")
+		}
+		for _, g := range grades {
+			if g.Gradient == nil || math.Abs(*g.Gradient+5.0/222*100) > 1e-9 {
+				t.Errorf("Gradient = %v, want %v", g.Gradient, -5.0/222*100)
+			}
+		}
+	})
+
+	t.Run("保存済みの難易度を取得できること", func(t *testing.T) {
+		got, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000004")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Difficulty() != routeDomain.DifficultyModerate {
+			t.Errorf("Difficulty() = %s, want moderate", got.Difficulty())
+		}
+	})
+
+	t.Run("求め直した難易度を保存できること", func(t *testing.T) {
+		// Tokyo Cycling Routeは路面の内訳も難易度もまだない
+		id := "019b5a50-0000-7000-8000-000000000006"
+		if err := routeRepository.RefreshSurfaceBreakdown(ctx, id); err != nil {
+			t.Fatalf("RefreshSurfaceBreakdown() error = %v", err)
+		}
+		rt, err := routeRepository.GetRouteByID(ctx, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		breakdown, err := routeRepository.GetSurfaceBreakdown(ctx, id)
+		if err != nil {
+			t.Fatalf("GetSurfaceBreakdown() error = %v", err)
+		}
+		grades, err := routeRepository.GetRouteGrades(ctx, id)
+		if err != nil {
+			t.Fatalf("GetRouteGrades() error = %v", err)
+		}
+		rt.RefreshDifficulty(breakdown, grades)
+		if err := routeRepository.SaveRouteDifficulty(ctx, rt); err != nil {
+			t.Fatalf("SaveRouteDifficulty() error = %v", err)
+		}
+
+		got, err := routeRepository.GetRouteByID(ctx, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Difficulty() != routeDomain.DifficultyEasy {
+			t.Errorf("Difficulty() = %s, want easy", got.Difficulty())
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+)
+
+// SaveRouteTags はルートのタグを付けた順に保存し直す
+func (r *routeRepositoryImpl) SaveRouteTags(ctx context.Context, rt *route.Route) error {
+	uid, err := uuid.Parse(rt.ID())
+	if err != nil {
+		return fmt.Errorf("invalid route id: %w", err)
+	}
+
+	if err := r.queries.DeleteRouteTags(ctx, uid); err != nil {
+		return fmt.Errorf("failed to delete route tags: %w", err)
+	}
+	for i, tag := range rt.Tags() {
+		err := r.queries.CreateRouteTag(ctx, dbgen.CreateRouteTagParams{
+			RouteID:  uid,
+			Tag:      tag,
+			Position: int32(i),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create route tag: %w", err)
+		}
+	}
+	return nil
+}
+
+// attachRouteTags は複数のルートのタグをまとめて取得して設定する
+func (r *routeRepositoryImpl) attachRouteTags(ctx context.Context, routes ...*route.Route) error {
+	if len(routes) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(routes))
+	for i, rt := range routes {
+		uid, err := uuid.Parse(rt.ID())
+		if err != nil {
+			return fmt.Errorf("invalid route id: %w", err)
+		}
+		ids[i] = uid
+	}
+
+	rows, err := r.queries.ListRouteTagsByRouteIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get route tags: %w", err)
+	}
+	tags := make(map[string][]string, len(routes))
+	for _, row := range rows {
+		id := row.RouteID.String()
+		tags[id] = append(tags[id], row.Tag)
+	}
+	for _, rt := range routes {
+		rt.SetTags(tags[rt.ID()])
+	}
+	return nil
+}
+
+func resultRoutes(results []*route.ExploreRouteResult) []*route.Route {
+	routes := make([]*route.Route, len(results))
+	for i, res := range results {
+		routes[i] = res.Route
+	}
+	return routes
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestRouteRepository_RouteTags(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	t.Run("保存済みのタグを付けた順に取得できること", func(t *testing.T) {
+		got, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000001")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := []string{"東京", "景色"}; !reflect.DeepEqual(got.Tags(), want) {
+			t.Errorf("Tags() = %v, want %v", got.Tags(), want)
+		}
+	})
+
+	t.Run("タグを保存し直せること", func(t *testing.T) {
+		rt, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000004")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rt.Tags()) != 0 {
+			t.Fatalf("Tags() = %v, want empty", rt.Tags())
+		}
+		if err := rt.UpdateTags([]string{"峠", "ヒルクライム"}); err != nil {
+			t.Fatalf("UpdateTags() error = %v", err)
+		}
+		if err := routeRepository.SaveRouteTags(ctx, rt); err != nil {
+			t.Fatalf("SaveRouteTags() error = %v", err)
+		}
+
+		saved, err := routeRepository.GetRouteByID(ctx, rt.ID())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := []string{"峠", "ヒルクライム"}; !reflect.DeepEqual(saved.Tags(), want) {
+			t.Errorf("Tags() = %v, want %v", saved.Tags(), want)
+		}
+	})
+
+	t.Run("コレクション内のルートを並び順に取得できること", func(t *testing.T) {
+		got, err := routeRepository.GetRoutesInCollection(ctx, "019b5a63-0000-7000-8000-000000000001")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var ids []string
+		for _, r := range got {
+			ids = append(ids, r.Route.ID())
+		}
+		want := []string{
+			"019b5a50-0000-7000-8000-000000000006",
+			"019b5a50-0000-7000-8000-000000000004",
+			"019b5a50-0000-7000-8000-000000000001",
+		}
+		if !reflect.DeepEqual(ids, want) {
+			t.Errorf("route ids = %v, want %v", ids, want)
+		}
+		if got[1].UserName == "" || len(got[2].Route.Tags()) != 2 {
+			t.Errorf("UserName = %q, Tags() = %v", got[1].UserName, got[2].Route.Tags())
+		}
+	})
+}
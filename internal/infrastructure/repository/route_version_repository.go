@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/paulmach/orb"
+)
+
+// coursePointSnapshot は route_versions.course_points に保存するコースポイント
+type coursePointSnapshot struct {
+	ID            string     `json:"id"`
+	StepOrder     int32      `json:"step_order"`
+	SegDistM      *float64   `json:"seg_dist_m,omitempty"`
+	CumDistM      *float64   `json:"cum_dist_m,omitempty"`
+	Duration      *float64   `json:"duration,omitempty"`
+	Instruction   *string    `json:"instruction,omitempty"`
+	RoadName      *string    `json:"road_name,omitempty"`
+	ManeuverType  *string    `json:"maneuver_type,omitempty"`
+	Modifier      *string    `json:"modifier,omitempty"`
+	Location      *orb.Point `json:"location,omitempty"` // [経度, 緯度]
+	BearingBefore *int32     `json:"bearing_before,omitempty"`
+	BearingAfter  *int32     `json:"bearing_after,omitempty"`
+}
+
+// waypointSnapshot は route_versions.waypoints に保存するウェイポイント
+type waypointSnapshot struct {
+	ID       string     `json:"id"`
+	Location *orb.Point `json:"location,omitempty"` // [経度, 緯度]
+}
+
+func (r *routeRepositoryImpl) SaveRouteVersion(ctx context.Context, v *route.RouteVersion) error {
+	versionID, err := uuid.Parse(v.ID())
+	if err != nil {
+		return fmt.Errorf("invalid route version id: %w", err)
+	}
+	routeID, err := uuid.Parse(v.RouteID())
+	if err != nil {
+		return fmt.Errorf("invalid route id: %w", err)
+	}
+	editorID, err := uuid.Parse(v.EditorID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	coursePoints, err := marshalCoursePoints(v.CoursePoints())
+	if err != nil {
+		return err
+	}
+	waypoints, err := marshalWaypoints(v.Waypoints())
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.CreateRouteVersion(ctx, dbgen.CreateRouteVersionParams{
+		ID:                 versionID,
+		RouteID:            routeID,
+		UserID:             editorID,
+		Name:               v.Name(),
+		Description:        v.Description(),
+		HighlightedPhotoID: v.HighlightedPhotoID(),
+		Distance:           v.Distance(),
+		Duration:           v.Duration(),
+		ElevationGain:      v.ElevationGain(),
+		ElevationLoss:      v.ElevationLoss(),
+		PathGeom:           dbgen.OrbGeometry{Geometry: v.PathGeom().Geometry},
+		FirstPoint:         dbgen.OrbGeometry{Geometry: v.FirstPoint().Geometry},
+		LastPoint:          dbgen.OrbGeometry{Geometry: v.LastPoint().Geometry},
+		Visibility:         v.Visibility(),
+		CoursePoints:       coursePoints,
+		Waypoints:          waypoints,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create route version: %w", err)
+	}
+
+	return nil
+}
+
+func (r *routeRepositoryImpl) GetRouteVersions(ctx context.Context, routeID string) ([]*route.RouteVersion, error) {
+	uid, err := uuid.Parse(routeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route id: %w", err)
+	}
+
+	rows, err := r.queries.GetRouteVersionsByRouteID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*route.RouteVersion, 0, len(rows))
+	for _, row := range rows {
+		v, err := toRouteVersion(row)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	return versions, nil
+}
+
+func (r *routeRepositoryImpl) GetRouteVersion(ctx context.Context, routeID string, versionNumber int32) (*route.RouteVersion, error) {
+	uid, err := uuid.Parse(routeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route id: %w", err)
+	}
+
+	row, err := r.queries.GetRouteVersion(ctx, dbgen.GetRouteVersionParams{
+		RouteID:       uid,
+		VersionNumber: versionNumber,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("route version not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return toRouteVersion(row)
+}
+
+func toRouteVersion(row dbgen.RouteVersion) (*route.RouteVersion, error) {
+	routeID := row.RouteID.String()
+
+	var cps []coursePointSnapshot
+	if err := json.Unmarshal(row.CoursePoints, &cps); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal course points: %w", err)
+	}
+	coursePoints := make([]*route.CoursePoint, len(cps))
+	for i, cp := range cps {
+		var location *route.Geometry
+		if cp.Location != nil {
+			location = &route.Geometry{Geometry: *cp.Location}
+		}
+		coursePoints[i] = route.ReconstructCoursePoint(
+			cp.ID,
+			routeID,
+			cp.StepOrder,
+			cp.SegDistM,
+			cp.CumDistM,
+			cp.Duration,
+			cp.Instruction,
+			cp.RoadName,
+			cp.ManeuverType,
+			cp.Modifier,
+			location,
+			cp.BearingBefore,
+			cp.BearingAfter,
+		)
+	}
+
+	var wps []waypointSnapshot
+	if err := json.Unmarshal(row.Waypoints, &wps); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal waypoints: %w", err)
+	}
+	waypoints := make([]*route.Waypoint, len(wps))
+	for i, wp := range wps {
+		var location route.Geometry
+		if wp.Location != nil {
+			location = route.Geometry{Geometry: *wp.Location}
+		}
+		waypoints[i] = route.ReconstructWaypoint(wp.ID, routeID, location)
+	}
+
+	return route.ReconstructRouteVersion(
+		row.ID.String(),
+		routeID,
+		row.VersionNumber,
+		row.UserID.String(),
+		row.Name,
+		row.Description,
+		row.HighlightedPhotoID,
+		row.Distance,
+		row.Duration,
+		row.ElevationGain,
+		row.ElevationLoss,
+		route.Geometry{Geometry: row.PathGeom.Geometry},
+		route.Geometry{Geometry: row.FirstPoint.Geometry},
+		route.Geometry{Geometry: row.LastPoint.Geometry},
+		row.Visibility,
+		coursePoints,
+		waypoints,
+		row.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	), nil
+}
+
+func marshalCoursePoints(coursePoints []*route.CoursePoint) ([]byte, error) {
+	snapshots := make([]coursePointSnapshot, len(coursePoints))
+	for i, cp := range coursePoints {
+		var location *orb.Point
+		if cp.Location() != nil {
+			p, ok := cp.Location().Geometry.(orb.Point)
+			if !ok {
+				return nil, errors.New("course point location must be a Point")
+			}
+			location = &p
+		}
+		snapshots[i] = coursePointSnapshot{
+			ID:            cp.ID(),
+			StepOrder:     cp.StepOrder(),
+			SegDistM:      cp.SegDistM(),
+			CumDistM:      cp.CumDistM(),
+			Duration:      cp.Duration(),
+			Instruction:   cp.Instruction(),
+			RoadName:      cp.RoadName(),
+			ManeuverType:  cp.ManeuverType(),
+			Modifier:      cp.Modifier(),
+			Location:      location,
+			BearingBefore: cp.BearingBefore(),
+			BearingAfter:  cp.BearingAfter(),
+		}
+	}
+	b, err := json.Marshal(snapshots)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal course points: %w", err)
+	}
+	return b, nil
+}
+
+func marshalWaypoints(waypoints []*route.Waypoint) ([]byte, error) {
+	snapshots := make([]waypointSnapshot, len(waypoints))
+	for i, wp := range waypoints {
+		var location *orb.Point
+		if wp.Location().Geometry != nil {
+			p, ok := wp.Location().Geometry.(orb.Point)
+			if !ok {
+				return nil, errors.New("waypoint location must be a Point")
+			}
+			location = &p
+		}
+		snapshots[i] = waypointSnapshot{ID: wp.ID(), Location: location}
+	}
+	b, err := json.Marshal(snapshots)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal waypoints: %w", err)
+	}
+	return b, nil
+}
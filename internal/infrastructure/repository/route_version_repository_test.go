@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/paulmach/orb"
+)
+
+func TestRouteRepository_GetRouteVersion(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	t.Run("fixtureの版を取得できること", func(t *testing.T) {
+		v, err := routeRepository.GetRouteVersion(ctx, "019b5a50-0000-7000-8000-000000000001", 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v.Name() != "皇居ルート" || v.Distance() != 4800.0 {
+			t.Errorf("Name/Distance = %s/%v, want 皇居ルート/4800", v.Name(), v.Distance())
+		}
+		cps := v.CoursePoints()
+		if len(cps) != 1 || *cps[0].RoadName() != "内堀通り" {
+			t.Errorf("CoursePoints = %v, want 1 course point on 内堀通り", cps)
+		}
+		if p, ok := cps[0].Location().Geometry.(orb.Point); !ok || !p.Equal(orb.Point{139.7528, 35.685}) {
+			t.Errorf("CoursePoint location = %v", cps[0].Location().Geometry)
+		}
+		if len(v.Waypoints()) != 1 {
+			t.Errorf("len(Waypoints) = %d, want 1", len(v.Waypoints()))
+		}
+	})
+
+	t.Run("存在しない版はErrNotFoundになること", func(t *testing.T) {
+		_, err := routeRepository.GetRouteVersion(ctx, "019b5a50-0000-7000-8000-000000000001", 99)
+		if !errors.Is(err, domainerror.ErrNotFound) {
+			t.Errorf("error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestRouteRepository_SaveRouteVersion(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	route, err := routeRepository.GetRouteByID(ctx, "019b5a50-0000-7000-8000-000000000001")
+	if err != nil {
+		t.Fatalf("failed to get route: %v", err)
+	}
+	v, err := routeDomain.NewRouteVersion(route, route.UserID())
+	if err != nil {
+		t.Fatalf("failed to create route version: %v", err)
+	}
+
+	if err := routeRepository.SaveRouteVersion(ctx, v); err != nil {
+		t.Fatalf("SaveRouteVersion() error = %v", err)
+	}
+
+	versions, err := routeRepository.GetRouteVersions(ctx, route.ID())
+	if err != nil {
+		t.Fatalf("GetRouteVersions() error = %v", err)
+	}
+	// 新しい順に並び、fixtureの版の次の番号が採番される
+	if len(versions) != 2 {
+		t.Fatalf("len(versions) = %d, want 2", len(versions))
+	}
+	if versions[0].VersionNumber() != 2 || versions[0].Name() != route.Name() {
+		t.Errorf("versions[0] = %d/%s, want 2/%s", versions[0].VersionNumber(), versions[0].Name(), route.Name())
+	}
+	if len(versions[0].CoursePoints()) != len(route.CoursePoints()) {
+		t.Errorf("len(CoursePoints) = %d, want %d", len(versions[0].CoursePoints()), len(route.CoursePoints()))
+	}
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/tour"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type tourRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// ツアーリポジトリの実装
+func NewTourRepository(queries *dbgen.Queries) tour.ITourRepository {
+	return &tourRepositoryImpl{queries: queries}
+}
+
+func (r *tourRepositoryImpl) GetTourByID(ctx context.Context, id string) (*tour.Tour, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, domainerror.New("tour not found", domainerror.ErrNotFound)
+	}
+
+	row, err := r.queries.GetTourByID(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("tour not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	tours, err := r.withStages(ctx, []dbgen.Tour{row})
+	if err != nil {
+		return nil, err
+	}
+	return tours[0], nil
+}
+
+func (r *tourRepositoryImpl) GetToursByUserID(ctx context.Context, userID string) ([]*tour.Tour, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	rows, err := r.queries.ListToursByUserID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return r.withStages(ctx, rows)
+}
+
+func (r *tourRepositoryImpl) SaveTour(ctx context.Context, t *tour.Tour) error {
+	tourID, err := uuid.Parse(t.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid tour id: %w", err)
+	}
+	userID, err := uuid.Parse(t.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	clubID, err := toNullUUID(t.ClubID())
+	if err != nil {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+
+	err = r.queries.CreateTour(ctx, dbgen.CreateTourParams{
+		ID:          tourID,
+		UserID:      userID,
+		Name:        t.Name(),
+		Description: t.Description(),
+		Visibility:  t.Visibility(),
+		ClubID:      clubID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create tour: %w", err)
+	}
+	return r.saveStages(ctx, tourID, t.Stages())
+}
+
+func (r *tourRepositoryImpl) UpdateTour(ctx context.Context, t *tour.Tour) error {
+	tourID, err := uuid.Parse(t.ID().String())
+	if err != nil {
+		return fmt.Errorf("invalid tour id: %w", err)
+	}
+	clubID, err := toNullUUID(t.ClubID())
+	if err != nil {
+		return domainerror.New("club not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.UpdateTour(ctx, dbgen.UpdateTourParams{
+		Name:        t.Name(),
+		Description: t.Description(),
+		Visibility:  t.Visibility(),
+		ClubID:      clubID,
+		ID:          tourID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update tour: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("tour not found", domainerror.ErrNotFound)
+	}
+	return r.saveStages(ctx, tourID, t.Stages())
+}
+
+func (r *tourRepositoryImpl) DeleteTour(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return domainerror.New("tour not found", domainerror.ErrNotFound)
+	}
+
+	rows, err := r.queries.DeleteTour(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("failed to delete tour: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("tour not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+// saveStages はステージを日程の順に保存し直す
+func (r *tourRepositoryImpl) saveStages(ctx context.Context, tourID uuid.UUID, stages []tour.Stage) error {
+	if err := r.queries.DeleteTourStages(ctx, tourID); err != nil {
+		return fmt.Errorf("failed to delete tour stages: %w", err)
+	}
+	for i, s := range stages {
+		routeID, err := uuid.Parse(s.RouteID())
+		if err != nil {
+			return domainerror.New("route not found", domainerror.ErrNotFound)
+		}
+		err = r.queries.CreateTourStage(ctx, dbgen.CreateTourStageParams{
+			TourID:        tourID,
+			Position:      int32(i),
+			RouteID:       routeID,
+			OvernightStop: s.OvernightStop(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create tour stage: %w", err)
+		}
+	}
+	return nil
+}
+
+// withStages はツアーのステージをまとめて取得して復元する
+func (r *tourRepositoryImpl) withStages(ctx context.Context, rows []dbgen.Tour) ([]*tour.Tour, error) {
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	stageRows, err := r.queries.ListTourStagesByTourIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tour stages: %w", err)
+	}
+	stages := make(map[uuid.UUID][]tour.Stage, len(rows))
+	for _, sr := range stageRows {
+		stages[sr.TourID] = append(stages[sr.TourID], tour.ReconstructStage(sr.RouteID.String(), sr.OvernightStop))
+	}
+
+	tours := make([]*tour.Tour, 0, len(rows))
+	for _, row := range rows {
+		tours = append(tours, tour.ReconstructTour(
+			row.ID.String(),
+			row.UserID.String(),
+			row.Name,
+			row.Description,
+			row.Visibility,
+			fromNullUUID(row.ClubID),
+			stages[row.ID],
+			row.CreatedAt.Format(time.RFC3339),
+			row.UpdatedAt.Format(time.RFC3339),
+		))
+	}
+	return tours, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/tour"
+)
+
+func TestTourRepository(t *testing.T) {
+	q := GetTestQueries()
+	tourRepository := NewTourRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	tours, err := tourRepository.GetToursByUserID(ctx, fixtureUserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tours) != 1 || tours[0].Name() != "奥多摩1泊2日" {
+		t.Fatalf("tours = %+v", tours)
+	}
+	stages := tours[0].Stages()
+	if len(stages) != 2 || stages[0].RouteID() != "019b5a50-0000-7000-8000-000000000007" || stages[0].OvernightStop() != "檜原村の民宿" {
+		t.Errorf("Stages() = %+v", stages)
+	}
+
+	// 同じルートを往復で2日使う
+	owner := route.NewViewer(fixtureUserID, []string{fixtureClubID})
+	tr, err := tour.NewTour(owner, "しまなみ往復", "", 0, nil, []tour.Stage{
+		tour.ReconstructStage("019b5a50-0000-7000-8000-000000000005", "今治のホテル"),
+		tour.ReconstructStage("019b5a50-0000-7000-8000-000000000005", ""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tourRepository.SaveTour(ctx, tr); err != nil {
+		t.Fatalf("SaveTour() error = %v", err)
+	}
+
+	_ = tr.Update(owner, "しまなみ2泊3日", "尾道から今治まで", route.VisibilityClub, new(fixtureClubID), []tour.Stage{
+		tour.ReconstructStage("019b5a50-0000-7000-8000-000000000005", "今治のホテル"),
+		tour.ReconstructStage("019b5a50-0000-7000-8000-000000000006", "尾道のゲストハウス"),
+		tour.ReconstructStage("019b5a50-0000-7000-8000-000000000005", ""),
+	})
+	if err := tourRepository.UpdateTour(ctx, tr); err != nil {
+		t.Fatalf("UpdateTour() error = %v", err)
+	}
+
+	saved, err := tourRepository.GetTourByID(ctx, tr.ID().String())
+	if err != nil {
+		t.Fatalf("GetTourByID() error = %v", err)
+	}
+	if saved.Name() != "しまなみ2泊3日" || saved.Description() != "尾道から今治まで" || saved.Visibility() != route.VisibilityClub || saved.ClubID() == nil || *saved.ClubID() != fixtureClubID {
+		t.Errorf("saved = %+v", saved)
+	}
+	if !reflect.DeepEqual(saved.Stages(), tr.Stages()) {
+		t.Errorf("Stages() = %+v, want %+v", saved.Stages(), tr.Stages())
+	}
+
+	if err := tourRepository.DeleteTour(ctx, tr.ID().String()); err != nil {
+		t.Fatalf("DeleteTour() error = %v", err)
+	}
+	if _, err := tourRepository.GetTourByID(ctx, tr.ID().String()); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("GetTourByID() error = %v, want not found", err)
+	}
+	if err := tourRepository.DeleteTour(ctx, tr.ID().String()); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeleteTour() error = %v, want not found", err)
+	}
+}
+
+func TestRouteRepository_GetRoutesByIDs(t *testing.T) {
+	q := GetTestQueries()
+	routeRepository := NewRouteRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	got, err := routeRepository.GetRoutesByIDs(ctx, []string{
+		"019b5a50-0000-7000-8000-000000000004",
+		"019b5a50-0000-7000-8000-000000000001",
+		"019b5a50-0000-7000-8000-000000000001",
+		"019b5a50-0000-7000-8000-0000000000ff", // 存在しない
+		"invalid",
+	})
+	if err != nil {
+		t.Fatalf("GetRoutesByIDs() error = %v", err)
+	}
+	names := map[string]string{}
+	for _, r := range got {
+		names[r.Route.ID()] = r.Route.Name()
+		if r.Route.PathGeom().Geometry == nil {
+			t.Errorf("route %s has no path", r.Route.ID())
+		}
+	}
+	if len(got) != 2 || names["019b5a50-0000-7000-8000-000000000004"] == "" || names["019b5a50-0000-7000-8000-000000000001"] == "" {
+		t.Errorf("GetRoutesByIDs() = %v", names)
+	}
+}
@@ -0,0 +1,435 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type tripRepositoryImpl struct {
+	queries *dbgen.Queries
+}
+
+// トリップリポジトリの実装
+// 削除したトリップ（deleted_atが設定されたもの）は取得できない
+func NewTripRepository(queries *dbgen.Queries) trip.ITripRepository {
+	return &tripRepositoryImpl{queries: queries}
+}
+
+func (r *tripRepositoryImpl) GetTripByID(ctx context.Context, id string) (*trip.Trip, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trip id: %w", err)
+	}
+
+	row, err := r.queries.GetTripByID(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domainerror.New("trip not found", domainerror.ErrNotFound)
+		}
+		return nil, err
+	}
+	return toTripDomain(row), nil
+}
+
+// GetTripsByIDs はIDで指定したトリップをまとめて取得する。存在しないIDは無視し、順序は保証しない
+func (r *tripRepositoryImpl) GetTripsByIDs(ctx context.Context, ids []string) ([]*trip.Trip, error) {
+	uids := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			continue
+		}
+		uids = append(uids, uid)
+	}
+
+	rows, err := r.queries.ListTripsByIDs(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+	trips := make([]*trip.Trip, 0, len(rows))
+	for _, row := range rows {
+		trips = append(trips, toTripDomain(row))
+	}
+	return trips, nil
+}
+
+func (r *tripRepositoryImpl) GetTripByKratosID(ctx context.Context, kratosID string) ([]*trip.Trip, error) {
+	uid, err := uuid.Parse(kratosID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kratos id: %w", err)
+	}
+
+	rows, err := r.queries.GetTripsByKratosID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	trips := make([]*trip.Trip, 0, len(rows))
+	for _, row := range rows {
+		trips = append(trips, toTripDomain(row))
+	}
+	return trips, nil
+}
+
+func (r *tripRepositoryImpl) GetTripsByUserID(ctx context.Context, criteria *trip.TripListCriteria) (*trip.TripPage, error) {
+	uid, err := uuid.Parse(criteria.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+	hasCursor, cursorSortKey, cursorID, err := cursorParams(criteria.After())
+	if err != nil {
+		return nil, err
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	rows, err := r.queries.ListTripsByUserID(ctx, dbgen.ListTripsByUserIDParams{
+		UserID:        uid,
+		HasCursor:     hasCursor,
+		CursorSortKey: cursorSortKey,
+		CursorID:      cursorID,
+		LimitCount:    criteria.Limit() + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page := &trip.TripPage{Items: []*trip.Trip{}}
+	for i, row := range rows {
+		if int32(i) == criteria.Limit() {
+			last := rows[i-1]
+			page.Next, err = pagination.NewCursor(last.SortKey, last.ID.String())
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		page.Items = append(page.Items, toTripDomain(dbgen.Trip{
+			ID:                 row.ID,
+			UserID:             row.UserID,
+			Name:               row.Name,
+			Description:        row.Description,
+			Visibility:         row.Visibility,
+			HighlightedPhotoID: row.HighlightedPhotoID,
+			PathGeom:           row.PathGeom,
+			FirstPoint:         row.FirstPoint,
+			LastPoint:          row.LastPoint,
+			BboxGeom:           row.BboxGeom,
+			Distance:           row.Distance,
+			Duration:           row.Duration,
+			MovingTime:         row.MovingTime,
+			ElevationGain:      row.ElevationGain,
+			ElevationLoss:      row.ElevationLoss,
+			AvgSpeed:           row.AvgSpeed,
+			MaxSpeed:           row.MaxSpeed,
+			AvgCad:             row.AvgCad,
+			MaxCad:             row.MaxCad,
+			MinCad:             row.MinCad,
+			MaxHr:              row.MaxHr,
+			MinHr:              row.MinHr,
+			AvgWatts:           row.AvgWatts,
+			MaxWatts:           row.MaxWatts,
+			MinWatts:           row.MinWatts,
+			AvgWattsEstimated:  row.AvgWattsEstimated,
+			AvgPowerEstimated:  row.AvgPowerEstimated,
+			Calories:           row.Calories,
+			IsGps:              row.IsGps,
+			IsStationary:       row.IsStationary,
+			Processed:          row.Processed,
+			CreatedAt:          row.CreatedAt,
+			UpdatedAt:          row.UpdatedAt,
+			DeletedAt:          row.DeletedAt,
+			DepartedAt:         row.DepartedAt,
+			TimeZone:           row.TimeZone,
+			UtcOffset:          row.UtcOffset,
+			ActivityTypeID:     row.ActivityTypeID,
+			Pace:               row.Pace,
+			MovingPace:         row.MovingPace,
+		}))
+	}
+	return page, nil
+}
+
+func (r *tripRepositoryImpl) CountTripsByUserID(ctx context.Context, userID string) (int64, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	count, err := r.queries.CountTripsByUserID(ctx, uid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count trips: %w", err)
+	}
+	return count, nil
+}
+
+func (r *tripRepositoryImpl) GetRideStatsByUserID(ctx context.Context, userID string, limit int32) ([]trip.RideStats, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	rows, err := r.queries.ListRideStatsByUserID(ctx, dbgen.ListRideStatsByUserIDParams{
+		UserID:     uid,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ride stats: %w", err)
+	}
+	stats := make([]trip.RideStats, len(rows))
+	for i, row := range rows {
+		stats[i] = trip.RideStats{
+			Distance:      row.Distance,
+			MovingTime:    float64(row.MovingTime),
+			ElevationGain: row.ElevationGain,
+			ElevationLoss: row.ElevationLoss,
+		}
+	}
+	return stats, nil
+}
+
+func (r *tripRepositoryImpl) SaveTrip(ctx context.Context, t *trip.Trip) error {
+	tripID, err := uuid.Parse(t.ID())
+	if err != nil {
+		return fmt.Errorf("invalid trip id: %w", err)
+	}
+	userID, err := uuid.Parse(t.UserID())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	departedAt, err := parseNullTime(t.DepartedAt())
+	if err != nil {
+		return fmt.Errorf("invalid departed at: %w", err)
+	}
+
+	err = r.queries.CreateTrip(ctx, dbgen.CreateTripParams{
+		ID:                 tripID,
+		UserID:             userID,
+		Name:               t.Name(),
+		Description:        t.Description(),
+		Visibility:         t.Visibility(),
+		HighlightedPhotoID: t.HighlightedPhotoID(),
+		PathGeom:           toNullGeometry(t.PathGeom()),
+		FirstPoint:         toNullGeometry(t.FirstPoint()),
+		LastPoint:          toNullGeometry(t.LastPoint()),
+		BboxGeom:           toNullGeometry(t.BboxGeom()),
+		Distance:           t.Distance(),
+		Duration:           t.Duration(),
+		MovingTime:         t.MovingTime(),
+		ElevationGain:      t.ElevationGain(),
+		ElevationLoss:      t.ElevationLoss(),
+		AvgSpeed:           t.AvgSpeed(),
+		MaxSpeed:           t.MaxSpeed(),
+		AvgCad:             t.AvgCad(),
+		MaxCad:             t.MaxCad(),
+		MinCad:             t.MinCad(),
+		MaxHr:              t.MaxHr(),
+		MinHr:              t.MinHr(),
+		AvgWatts:           t.AvgWatts(),
+		MaxWatts:           t.MaxWatts(),
+		MinWatts:           t.MinWatts(),
+		AvgWattsEstimated:  t.AvgWattsEstimated(),
+		AvgPowerEstimated:  t.AvgPowerEstimated(),
+		Calories:           t.Calories(),
+		IsGps:              t.IsGPS(),
+		IsStationary:       t.IsStationary(),
+		Processed:          t.Processed(),
+		DepartedAt:         departedAt,
+		TimeZone:           t.TimeZone(),
+		UtcOffset:          t.UtcOffset(),
+		ActivityTypeID:     t.ActivityTypeID(),
+		Pace:               t.Pace(),
+		MovingPace:         t.MovingPace(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create trip: %w", err)
+	}
+	return nil
+}
+
+func (r *tripRepositoryImpl) UpdateTrip(ctx context.Context, t *trip.Trip) error {
+	tripID, err := uuid.Parse(t.ID())
+	if err != nil {
+		return fmt.Errorf("invalid trip id: %w", err)
+	}
+	departedAt, err := parseNullTime(t.DepartedAt())
+	if err != nil {
+		return fmt.Errorf("invalid departed at: %w", err)
+	}
+
+	rows, err := r.queries.UpdateTrip(ctx, dbgen.UpdateTripParams{
+		Name:               t.Name(),
+		Description:        t.Description(),
+		Visibility:         t.Visibility(),
+		HighlightedPhotoID: t.HighlightedPhotoID(),
+		PathGeom:           toNullGeometry(t.PathGeom()),
+		FirstPoint:         toNullGeometry(t.FirstPoint()),
+		LastPoint:          toNullGeometry(t.LastPoint()),
+		BboxGeom:           toNullGeometry(t.BboxGeom()),
+		Distance:           t.Distance(),
+		Duration:           t.Duration(),
+		MovingTime:         t.MovingTime(),
+		ElevationGain:      t.ElevationGain(),
+		ElevationLoss:      t.ElevationLoss(),
+		AvgSpeed:           t.AvgSpeed(),
+		MaxSpeed:           t.MaxSpeed(),
+		AvgCad:             t.AvgCad(),
+		MaxCad:             t.MaxCad(),
+		MinCad:             t.MinCad(),
+		MaxHr:              t.MaxHr(),
+		MinHr:              t.MinHr(),
+		AvgWatts:           t.AvgWatts(),
+		MaxWatts:           t.MaxWatts(),
+		MinWatts:           t.MinWatts(),
+		AvgWattsEstimated:  t.AvgWattsEstimated(),
+		AvgPowerEstimated:  t.AvgPowerEstimated(),
+		Calories:           t.Calories(),
+		IsGps:              t.IsGPS(),
+		IsStationary:       t.IsStationary(),
+		Processed:          t.Processed(),
+		DepartedAt:         departedAt,
+		TimeZone:           t.TimeZone(),
+		UtcOffset:          t.UtcOffset(),
+		ActivityTypeID:     t.ActivityTypeID(),
+		Pace:               t.Pace(),
+		MovingPace:         t.MovingPace(),
+		ID:                 tripID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update trip: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("trip not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+// DeleteTrip はトリップを論理削除する
+func (r *tripRepositoryImpl) DeleteTrip(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid trip id: %w", err)
+	}
+
+	rows, err := r.queries.DeleteTrip(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("failed to delete trip: %w", err)
+	}
+	if rows == 0 {
+		return domainerror.New("trip not found", domainerror.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *tripRepositoryImpl) GetTripImages(ctx context.Context, tripID string) ([]*trip.TripImage, error) {
+	uid, err := uuid.Parse(tripID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trip id: %w", err)
+	}
+
+	rows, err := r.queries.GetTripImagesByTripID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	images := make([]*trip.TripImage, 0, len(rows))
+	for _, row := range rows {
+		images = append(images, trip.ReconstructTripImage(
+			row.ID.String(),
+			row.TripID.String(),
+			row.S3Key,
+			row.Width,
+			row.Height,
+			row.Size,
+			row.Type,
+			row.Visibility,
+			row.CreatedAt.Format(time.RFC3339),
+		))
+	}
+	return images, nil
+}
+
+func toTripDomain(row dbgen.Trip) *trip.Trip {
+	return trip.ReconstructTrip(
+		row.ID.String(),
+		row.UserID.String(),
+		row.Name,
+		row.Description,
+		row.Visibility,
+		row.HighlightedPhotoID,
+		fromNullGeometry(row.PathGeom),
+		fromNullGeometry(row.FirstPoint),
+		fromNullGeometry(row.LastPoint),
+		fromNullGeometry(row.BboxGeom),
+		row.Distance,
+		row.Duration,
+		row.MovingTime,
+		row.ElevationGain,
+		row.ElevationLoss,
+		row.AvgSpeed,
+		row.MaxSpeed,
+		row.AvgCad,
+		row.MaxCad,
+		row.MinCad,
+		row.MaxHr,
+		row.MinHr,
+		row.AvgWatts,
+		row.MaxWatts,
+		row.MinWatts,
+		row.AvgWattsEstimated,
+		row.AvgPowerEstimated,
+		row.Calories,
+		row.IsGps,
+		row.IsStationary,
+		row.Processed,
+		row.CreatedAt.Format(time.RFC3339),
+		row.UpdatedAt.Format(time.RFC3339),
+		formatNullTime(row.DeletedAt),
+		formatNullTime(row.DepartedAt),
+		row.TimeZone,
+		row.UtcOffset,
+		row.ActivityTypeID,
+		row.Pace,
+		row.MovingPace,
+	)
+}
+
+func toNullGeometry(g *trip.Geometry) *dbgen.OrbGeometry {
+	if g == nil || g.Geometry == nil {
+		return nil
+	}
+	return &dbgen.OrbGeometry{Geometry: g.Geometry}
+}
+
+func fromNullGeometry(g *dbgen.OrbGeometry) *trip.Geometry {
+	if g == nil || g.Geometry == nil {
+		return nil
+	}
+	return &trip.Geometry{Geometry: g.Geometry}
+}
+
+func formatNullTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
+}
+
+func parseNullTime(s *string) (*time.Time, error) {
+	if s == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	"github.com/paulmach/orb"
+)
+
+const fixtureUserID = "70d6037a-b67b-4aa8-b5a3-da393b514f24"
+
+func TestTripRepository_GetTripByID(t *testing.T) {
+	q := GetTestQueries()
+	tripRepository := NewTripRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	tests := []struct {
+		name      string
+		tripID    string
+		wantName  string
+		wantPath  bool
+		wantErrNF bool
+	}{
+		{
+			name:     "GPSで記録したトリップは軌跡とともに取得できること",
+			tripID:   "019b5a60-0000-7000-8000-000000000001",
+			wantName: "朝の皇居ラン",
+			wantPath: true,
+		},
+		{
+			name:     "室内トレーニングは軌跡なしで取得できること",
+			tripID:   "019b5a60-0000-7000-8000-000000000002",
+			wantName: "ローラー台",
+		},
+		{
+			name:      "削除済みのトリップは取得できないこと",
+			tripID:    "019b5a60-0000-7000-8000-000000000003",
+			wantErrNF: true,
+		},
+		{
+			name:      "存在しないIDの場合はエラー",
+			tripID:    "00000000-0000-0000-0000-000000000000",
+			wantErrNF: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tripRepository.GetTripByID(ctx, tt.tripID)
+			if tt.wantErrNF {
+				if !errors.Is(err, domainerror.ErrNotFound) {
+					t.Fatalf("error = %v, want not found", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Name() != tt.wantName || got.UserID() != fixtureUserID {
+				t.Errorf("trip = %s (%s)", got.Name(), got.UserID())
+			}
+			if (got.PathGeom() != nil) != tt.wantPath {
+				t.Errorf("PathGeom() = %v", got.PathGeom())
+			}
+			if !tt.wantPath {
+				return
+			}
+			path, ok := got.PathGeom().Geometry.(orb.LineString)
+			if !ok || len(path) != 5 {
+				t.Errorf("PathGeom() = %v, want 5 points", got.PathGeom().Geometry)
+			}
+			if got.Duration() == nil || *got.Duration() != 120 || got.DepartedAt() == nil {
+				t.Errorf("Duration() = %v, DepartedAt() = %v", got.Duration(), got.DepartedAt())
+			}
+		})
+	}
+}
+
+func TestTripRepository_GetTripsByUserID(t *testing.T) {
+	q := GetTestQueries()
+	tripRepository := NewTripRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	criteria, _ := trip.NewTripListCriteria(fixtureUserID, 1, nil)
+	page, err := tripRepository.GetTripsByUserID(ctx, criteria)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 作成日時の新しい順に並ぶ
+	if len(page.Items) != 1 || page.Items[0].Name() != "ローラー台" || page.Next == nil {
+		t.Fatalf("page = %+v", page)
+	}
+
+	criteria, _ = trip.NewTripListCriteria(fixtureUserID, 1, page.Next)
+	page, err = tripRepository.GetTripsByUserID(ctx, criteria)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Name() != "朝の皇居ラン" || page.Next != nil {
+		t.Fatalf("page = %+v", page)
+	}
+
+	count, err := tripRepository.CountTripsByUserID(ctx, fixtureUserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("CountTripsByUserID() = %d, want 2", count)
+	}
+}
+
+func TestTripRepository_GetRideStatsByUserID(t *testing.T) {
+	q := GetTestQueries()
+	tripRepository := NewTripRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	stats, err := tripRepository.GetRideStatsByUserID(ctx, fixtureUserID, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 室内トレーニングと削除済みのトリップは含まない
+	want := []trip.RideStats{{Distance: 390, MovingTime: 110, ElevationGain: 5, ElevationLoss: 0}}
+	if len(stats) != len(want) || stats[0] != want[0] {
+		t.Errorf("GetRideStatsByUserID() = %+v, want %+v", stats, want)
+	}
+}
+
+func TestTripRepository_SaveUpdateDeleteTrip(t *testing.T) {
+	q := GetTestQueries()
+	tripRepository := NewTripRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	newTrip, err := trip.NewTrip(fixtureUserID, "夕方のライド", "", 1, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	distance := 1200.0
+	departedAt := "2024-03-01T17:00:00+09:00"
+	err = newTrip.SetMetrics(
+		&trip.Geometry{Geometry: orb.LineString{{139.75, 35.68}, {139.752, 35.68}}},
+		&trip.Geometry{Geometry: orb.Point{139.75, 35.68}},
+		&trip.Geometry{Geometry: orb.Point{139.752, 35.68}},
+		nil, &distance, nil, nil, nil, nil, nil, nil, &departedAt, nil, nil, nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tripRepository.SaveTrip(ctx, newTrip); err != nil {
+		t.Fatalf("SaveTrip() error = %v", err)
+	}
+
+	saved, err := tripRepository.GetTripByID(ctx, newTrip.ID())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if saved.Distance() == nil || *saved.Distance() != distance || !saved.IsGPS() {
+		t.Errorf("saved trip = %+v", saved)
+	}
+	if saved.DepartedAt() == nil {
+		t.Error("DepartedAt() should be saved")
+	}
+
+	_ = saved.UpdateBasicInfo("夕方のライド（改）", "説明", 2, 0)
+	if err := tripRepository.UpdateTrip(ctx, saved); err != nil {
+		t.Fatalf("UpdateTrip() error = %v", err)
+	}
+	updated, _ := tripRepository.GetTripByID(ctx, newTrip.ID())
+	if updated.Name() != "夕方のライド（改）" || updated.Visibility() != 2 {
+		t.Errorf("updated trip = %s, %d", updated.Name(), updated.Visibility())
+	}
+
+	if err := tripRepository.DeleteTrip(ctx, newTrip.ID()); err != nil {
+		t.Fatalf("DeleteTrip() error = %v", err)
+	}
+	if _, err := tripRepository.GetTripByID(ctx, newTrip.ID()); err == nil {
+		t.Error("deleted trip should not be found")
+	}
+	// 2回目の削除は対象がない
+	err = tripRepository.DeleteTrip(ctx, newTrip.ID())
+	if !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeleteTrip() error = %v, want not found", err)
+	}
+}
+
+func TestTripRepository_GetTripImages(t *testing.T) {
+	q := GetTestQueries()
+	tripRepository := NewTripRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	images, err := tripRepository.GetTripImages(ctx, "019b5a60-0000-7000-8000-000000000001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 撮影順に並ぶ
+	if len(images) != 2 || images[0].Type() != "jpg" || images[1].Type() != "png" {
+		t.Fatalf("images = %+v", images)
+	}
+	if images[0].Width() == nil || *images[0].Width() != 4032 || images[1].Width() != nil {
+		t.Errorf("Width() = %v, %v", images[0].Width(), images[1].Width())
+	}
+
+	images, err = tripRepository.GetTripImages(ctx, "019b5a60-0000-7000-8000-000000000002")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(images) != 0 {
+		t.Errorf("images = %+v, want empty", images)
+	}
+}
+
+func TestTripRepository_GetTripsByIDs(t *testing.T) {
+	q := GetTestQueries()
+	tripRepository := NewTripRepository(q)
+	ctx := context.Background()
+	resetTestData(t)
+
+	trips, err := tripRepository.GetTripsByIDs(ctx, []string{
+		"019b5a60-0000-7000-8000-000000000001",
+		"019b5a60-0000-7000-8000-000000000003", // 削除済み
+		"00000000-0000-0000-0000-000000000000",
+		"invalid",
+	})
+	if err != nil {
+		t.Fatalf("GetTripsByIDs() error = %v", err)
+	}
+	if len(trips) != 1 || trips[0].Name() != "朝の皇居ラン" {
+		t.Errorf("trips = %+v", trips)
+	}
+}
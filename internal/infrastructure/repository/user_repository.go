@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
 	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
 	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
 	"github.com/google/uuid"
@@ -24,13 +25,13 @@ func (r *userRepositoryImpl) GetUserByID(ctx context.Context, id string) (*user.
 	// UUIDに変換
 	uuid, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user id: %w", err)
+		return nil, domainerror.New("user not found", domainerror.ErrNotFound)
 	}
 
 	u, err := r.queries.GetUserByID(ctx, uuid)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("user not found")
+			return nil, domainerror.New("user not found", domainerror.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -0,0 +1,91 @@
+package routing
+
+import (
+	"context"
+	"math"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+const (
+	fakeSpeedMps     = 20.0 * 1000 / 3600 // 所要時間の見積もりに使う速度(20km/h)
+	fakeMinTurnAngle = 30.0               // これ以上向きが変わる経由地を曲がり角にする(度)
+)
+
+// FakeRouter は経由地を直線で結ぶだけのルーター
+// 同じ経由地なら常に同じ経路を返すため、テストやOSRMを起動しない開発環境で使う
+type FakeRouter struct{}
+
+func NewFakeRouter() *FakeRouter {
+	return &FakeRouter{}
+}
+
+func (r *FakeRouter) Plan(ctx context.Context, waypoints []orb.Point, profile route.RoutingProfile) (*route.PlannedRoute, error) {
+	if err := route.ValidatePlanWaypoints(waypoints); err != nil {
+		return nil, err
+	}
+
+	path := orb.LineString{}
+	for _, p := range waypoints {
+		if len(path) == 0 || path[len(path)-1] != p {
+			path = append(path, p)
+		}
+	}
+	if len(path) < 2 {
+		return nil, route.ValidatePlanWaypoints(path)
+	}
+
+	maneuvers := []route.PlannedManeuver{}
+	cumDist := 0.0
+	for i, p := range path {
+		m := route.PlannedManeuver{
+			ManeuverType: "turn",
+			Location:     p,
+			CumDistM:     cumDist,
+			CumDuration:  cumDist / fakeSpeedMps,
+		}
+		if i > 0 {
+			m.BearingBefore = bearing(path[i-1], p)
+		}
+		if i < len(path)-1 {
+			m.BearingAfter = bearing(p, path[i+1])
+			cumDist += geo.Distance(p, path[i+1])
+		}
+
+		switch {
+		case i == 0:
+			m.ManeuverType = "depart"
+		case i == len(path)-1:
+			m.ManeuverType = "arrive"
+		default:
+			m.Modifier = fakeModifier(*m.BearingBefore, *m.BearingAfter)
+		}
+		maneuvers = append(maneuvers, m)
+	}
+
+	return &route.PlannedRoute{
+		Path:      path,
+		Distance:  cumDist,
+		Duration:  cumDist / fakeSpeedMps,
+		Maneuvers: maneuvers,
+	}, nil
+}
+
+func bearing(from, to orb.Point) *int32 {
+	return roundBearing(math.Mod(geo.Bearing(from, to)+360, 360))
+}
+
+// fakeModifier は経由地での向きの変化を左右と直進に分ける
+func fakeModifier(before, after int32) *string {
+	angle := math.Mod(float64(after-before)+540, 360) - 180
+	modifier := "straight"
+	switch {
+	case angle >= fakeMinTurnAngle:
+		modifier = "right"
+	case angle <= -fakeMinTurnAngle:
+		modifier = "left"
+	}
+	return &modifier
+}
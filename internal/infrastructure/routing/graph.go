@@ -0,0 +1,163 @@
+package routing
+
+import (
+	"context"
+	"math"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/roadgraph"
+	"github.com/paulmach/orb"
+)
+
+const (
+	graphSearchPadding   = 0.02  // 経由地を囲む範囲の外側に読み込む道路網の幅(度、約2km)
+	graphMaxSnapDistance = 500.0 // 経由地からこれ以上離れた道路には寄せない(m)
+	graphMinTurnAngle    = 30.0  // これ以上向きが変わる交差点を曲がり角にする(度)
+	graphMatchPadding    = 0.005 // 軌跡を囲む範囲の外側に読み込む道路網の幅(度、約500m)
+)
+
+// RoadEdgeSource は探索に使う道路網を範囲を指定して読み込む
+type RoadEdgeSource interface {
+	ListRoadEdgesInBound(ctx context.Context, bound orb.Bound) ([]roadgraph.Edge, error)
+}
+
+// GraphRouter は取り込み済みのOpenStreetMapの道路網をアプリ内で探索するルーター
+// 外部のルーティングエンジンが不要なため、ネットワークにつながらない開発環境や結合テストで使う
+type GraphRouter struct {
+	source RoadEdgeSource
+}
+
+func NewGraphRouter(source RoadEdgeSource) *GraphRouter {
+	return &GraphRouter{source: source}
+}
+
+func (r *GraphRouter) Plan(ctx context.Context, waypoints []orb.Point, profile route.RoutingProfile) (*route.PlannedRoute, error) {
+	if err := route.ValidatePlanWaypoints(waypoints); err != nil {
+		return nil, err
+	}
+
+	edges, err := r.source.ListRoadEdgesInBound(ctx, orb.MultiPoint(waypoints).Bound().Pad(graphSearchPadding))
+	if err != nil {
+		return nil, err
+	}
+	g := roadgraph.NewGraph(edges)
+
+	nodes := make([]int64, 0, len(waypoints))
+	for _, p := range waypoints {
+		id, dist, ok := g.NearestNode(p)
+		if !ok || dist > graphMaxSnapDistance {
+			return nil, domainerror.New("no road found near the waypoint", domainerror.ErrValidation)
+		}
+		if len(nodes) == 0 || nodes[len(nodes)-1] != id {
+			nodes = append(nodes, id)
+		}
+	}
+	if len(nodes) < 2 {
+		return nil, domainerror.New("waypoints are too close to each other", domainerror.ErrValidation)
+	}
+
+	cost := roadgraph.BicycleCost(profile)
+	segments := []roadgraph.Segment{}
+	for i := 1; i < len(nodes); i++ {
+		leg, ok := g.ShortestPath(nodes[i-1], nodes[i], cost)
+		if !ok {
+			return nil, domainerror.New("no route found between the waypoints", domainerror.ErrValidation)
+		}
+		for _, s := range leg {
+			segments = append(segments, s.Segment())
+		}
+	}
+	return toGraphPlannedRoute(segments), nil
+}
+
+// toGraphPlannedRoute は区間をつないだ経路と、交差点で曲がる案内を作る
+func toGraphPlannedRoute(segments []roadgraph.Segment) *route.PlannedRoute {
+	planned := &route.PlannedRoute{Path: orb.LineString{}}
+	var prev orb.LineString
+	for i, s := range segments {
+		geom := s.Geometry
+		switch {
+		case i == 0:
+			planned.Maneuvers = append(planned.Maneuvers, route.PlannedManeuver{
+				ManeuverType: "depart",
+				RoadName:     optionalString(s.Edge.Name),
+				Location:     geom[0],
+				BearingAfter: bearing(geom[0], geom[1]),
+			})
+			planned.Path = append(planned.Path, geom[0])
+		default:
+			before := bearing(prev[len(prev)-2], prev[len(prev)-1])
+			after := bearing(geom[0], geom[1])
+			angle := math.Mod(float64(*after-*before)+540, 360) - 180
+			if math.Abs(angle) >= graphMinTurnAngle {
+				modifier := route.TurnModifier(angle)
+				planned.Maneuvers = append(planned.Maneuvers, route.PlannedManeuver{
+					ManeuverType:  "turn",
+					Modifier:      &modifier,
+					RoadName:      optionalString(s.Edge.Name),
+					Location:      geom[0],
+					BearingBefore: before,
+					BearingAfter:  after,
+					CumDistM:      planned.Distance,
+					CumDuration:   planned.Duration,
+				})
+			}
+		}
+
+		planned.Path = append(planned.Path, geom[1:]...)
+		planned.Distance += s.Length
+		planned.Duration += s.Duration()
+		prev = geom
+	}
+
+	last := planned.Path[len(planned.Path)-1]
+	planned.Maneuvers = append(planned.Maneuvers, route.PlannedManeuver{
+		ManeuverType:  "arrive",
+		Location:      last,
+		BearingBefore: bearing(prev[len(prev)-2], last),
+		CumDistM:      planned.Distance,
+		CumDuration:   planned.Duration,
+	})
+	return planned
+}
+
+// GraphMatcher は取り込み済みのOpenStreetMapの道路網に軌跡を照合する
+type GraphMatcher struct {
+	source RoadEdgeSource
+}
+
+func NewGraphMatcher(source RoadEdgeSource) *GraphMatcher {
+	return &GraphMatcher{source: source}
+}
+
+func (m *GraphMatcher) Match(ctx context.Context, track orb.LineString) (*route.MatchedRoute, error) {
+	if err := route.ValidateMatchTrack(track); err != nil {
+		return nil, err
+	}
+
+	edges, err := m.source.ListRoadEdgesInBound(ctx, track.Bound().Pad(graphMatchPadding))
+	if err != nil {
+		return nil, err
+	}
+	result, ok := roadgraph.NewMatcher(roadgraph.NewGraph(edges)).Match(track)
+	if !ok || len(result.Segments) == 0 {
+		return nil, domainerror.New("no road found near the track", domainerror.ErrValidation)
+	}
+
+	matched := &route.MatchedRoute{
+		PlannedRoute:  *toGraphPlannedRoute(result.Segments),
+		MatchedPoints: result.MatchedPoints,
+		TotalPoints:   result.TotalPoints,
+	}
+	for _, s := range result.Segments {
+		matched.Segments = append(matched.Segments, route.MatchedSegment{
+			RoadName: optionalString(s.Edge.Name),
+			Highway:  s.Edge.Highway,
+			Surface:  optionalString(s.Edge.Surface),
+			Distance: s.Length,
+			Path:     s.Geometry,
+		})
+	}
+	return matched, nil
+}
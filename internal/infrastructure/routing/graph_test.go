@@ -0,0 +1,175 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/roadgraph"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// memoryEdgeSource は抽出データから作った区間をそのまま返す
+type memoryEdgeSource []roadgraph.Edge
+
+func (s memoryEdgeSource) ListRoadEdgesInBound(ctx context.Context, bound orb.Bound) ([]roadgraph.Edge, error) {
+	edges := []roadgraph.Edge{}
+	for _, e := range s {
+		if e.Geometry.Bound().Intersects(bound) {
+			edges = append(edges, e)
+		}
+	}
+	return edges, nil
+}
+
+// near は抽出データの座標の丸め誤差を無視して同じ地点かどうかを判定する
+func near(a, b orb.Point) bool {
+	return geo.Distance(a, b) < 0.1
+}
+
+func newFixtureGraphRouter(t *testing.T) *GraphRouter {
+	t.Helper()
+	// 3x3の格子状の道路網。南西(1)と北東(9)を未舗装の斜めの道が結び、北東の方が30m高い
+	edges, err := roadgraph.LoadFile("../fixtures/osm/grid.osm.pbf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewGraphRouter(memoryEdgeSource(edges))
+}
+
+func TestGraphRouter_Plan(t *testing.T) {
+	router := newFixtureGraphRouter(t)
+	ctx := context.Background()
+
+	t.Run("格子を通って曲がり角で案内すること", func(t *testing.T) {
+		// 北東(9)の近くから南西(1)の近くへ
+		planned, err := router.Plan(ctx, []orb.Point{{139.7041, 35.6841}, {139.6999, 35.6799}}, route.RoutingProfileRoad)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !near(planned.Path[0], orb.Point{139.704, 35.684}) || !near(planned.Path[len(planned.Path)-1], orb.Point{139.7, 35.68}) {
+			t.Errorf("Path = %v, want from node 9 to node 1", planned.Path)
+		}
+		if planned.Distance < 800 || planned.Distance > 810 {
+			t.Errorf("Distance = %v, want about 806", planned.Distance)
+		}
+		if planned.Duration <= 0 {
+			t.Errorf("Duration = %v", planned.Duration)
+		}
+
+		ms := planned.Maneuvers
+		if ms[0].ManeuverType != "depart" || ms[len(ms)-1].ManeuverType != "arrive" {
+			t.Fatalf("Maneuvers = %+v", ms)
+		}
+		turns := planned.CueManeuvers()[1 : len(planned.CueManeuvers())-1]
+		if len(turns) == 0 {
+			t.Fatal("expected at least one turn")
+		}
+		for _, m := range turns {
+			if m.ManeuverType != "turn" || m.Modifier == nil || m.RoadName == nil || m.CumDistM <= 0 {
+				t.Errorf("turn = %+v", m)
+			}
+		}
+	})
+
+	t.Run("gravelでは未舗装の斜めの道を下ること", func(t *testing.T) {
+		planned, err := router.Plan(ctx, []orb.Point{{139.704, 35.684}, {139.7, 35.68}}, route.RoutingProfileGravel)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(planned.Path) != 3 || planned.Distance > 600 {
+			t.Errorf("Path = %v, Distance = %v, want the diagonal track", planned.Path, planned.Distance)
+		}
+	})
+
+	t.Run("経由地を順に通ること", func(t *testing.T) {
+		// 南西(1)から南東(3)を経由して北東(9)へ
+		planned, err := router.Plan(ctx, []orb.Point{{139.7, 35.68}, {139.704, 35.68}, {139.704, 35.684}}, route.RoutingProfileGravel)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		found := false
+		for _, p := range planned.Path {
+			if near(p, orb.Point{139.704, 35.68}) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Path = %v, should pass node 3", planned.Path)
+		}
+	})
+
+	t.Run("道路から離れた経由地はErrValidationになること", func(t *testing.T) {
+		_, err := router.Plan(ctx, []orb.Point{{139.7, 35.68}, {139.8, 35.7}}, route.RoutingProfileRoad)
+		if !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("error = %v, want ErrValidation", err)
+		}
+	})
+
+	t.Run("同じ交差点に寄る経由地だけではErrValidationになること", func(t *testing.T) {
+		_, err := router.Plan(ctx, []orb.Point{{139.7, 35.68}, {139.7001, 35.6801}}, route.RoutingProfileRoad)
+		if !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("error = %v, want ErrValidation", err)
+		}
+	})
+}
+
+func TestGraphMatcher_Match(t *testing.T) {
+	edges, err := roadgraph.LoadFile("../fixtures/osm/grid.osm.pbf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	matcher := NewGraphMatcher(memoryEdgeSource(edges))
+	ctx := context.Background()
+
+	t.Run("南の道を東へ進み、北へ曲がる軌跡を照合すること", func(t *testing.T) {
+		// 南西(1)から南東(3)を経由して北東(9)へ。道路から数mずれた点を含む
+		track := orb.LineString{
+			{139.7, 35.68}, {139.701, 35.68003}, {139.702, 35.67997}, {139.703, 35.68004},
+			{139.70397, 35.681}, {139.70404, 35.682}, {139.70396, 35.683}, {139.704, 35.684},
+		}
+		matched, err := matcher.Match(ctx, track)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if matched.MatchedPoints != len(track) || matched.TotalPoints != len(track) {
+			t.Errorf("MatchedPoints = %d/%d", matched.MatchedPoints, matched.TotalPoints)
+		}
+		if !near(matched.Path[0], track[0]) || !near(matched.Path[len(matched.Path)-1], track[len(track)-1]) {
+			t.Errorf("Path = %v", matched.Path)
+		}
+		if matched.Distance < 800 || matched.Distance > 810 {
+			t.Errorf("Distance = %v, want about 806", matched.Distance)
+		}
+		// 照合した経路は道路の上を通る
+		for _, p := range matched.Path {
+			if !near(orb.Point{p.Lon(), 35.68}, p) && !near(orb.Point{139.704, p.Lat()}, p) {
+				t.Errorf("point %v is off the road", p)
+			}
+		}
+		if len(matched.Segments) == 0 || matched.Segments[0].Highway == "" {
+			t.Fatalf("Segments = %+v", matched.Segments)
+		}
+		turns := matched.CueManeuvers()
+		if len(turns) != 3 || turns[1].Modifier == nil || *turns[1].Modifier != "left" {
+			t.Errorf("CueManeuvers() = %+v, want depart, turn left, arrive", turns)
+		}
+	})
+
+	t.Run("道路から離れた軌跡はErrValidationになること", func(t *testing.T) {
+		_, err := matcher.Match(ctx, orb.LineString{{139.8, 35.7}, {139.801, 35.7}})
+		if !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("error = %v, want ErrValidation", err)
+		}
+	})
+
+	t.Run("点が1つしかない軌跡はErrValidationになること", func(t *testing.T) {
+		_, err := matcher.Match(ctx, orb.LineString{{139.7, 35.68}})
+		if !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("error = %v, want ErrValidation", err)
+		}
+	})
+}
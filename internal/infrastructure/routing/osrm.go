@@ -0,0 +1,172 @@
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/paulmach/orb"
+)
+
+// OSRMRouter はOSRMのHTTP APIで経路を探索する
+// OSRMは起動時に読み込んだプロファイルでしか探索できないため、条件ごとに別のサーバーを指定できる
+type OSRMRouter struct {
+	client      *http.Client
+	baseURL     string
+	profileURLs map[route.RoutingProfile]string
+}
+
+// NewOSRMRouter はOSRMのルーターを作成する
+// profileURLs に指定がない条件は baseURL のサーバーで探索する
+func NewOSRMRouter(client *http.Client, baseURL string, profileURLs map[route.RoutingProfile]string) *OSRMRouter {
+	return &OSRMRouter{
+		client:      client,
+		baseURL:     baseURL,
+		profileURLs: profileURLs,
+	}
+}
+
+type osrmResponse struct {
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Routes  []osrmRoute `json:"routes"`
+}
+
+type osrmRoute struct {
+	Distance float64 `json:"distance"`
+	Duration float64 `json:"duration"`
+	Geometry struct {
+		Coordinates [][2]float64 `json:"coordinates"`
+	} `json:"geometry"`
+	Legs []struct {
+		Steps []osrmStep `json:"steps"`
+	} `json:"legs"`
+}
+
+type osrmStep struct {
+	Distance float64 `json:"distance"`
+	Duration float64 `json:"duration"`
+	Name     string  `json:"name"`
+	Maneuver struct {
+		Type          string     `json:"type"`
+		Modifier      string     `json:"modifier"`
+		Location      [2]float64 `json:"location"`
+		BearingBefore float64    `json:"bearing_before"`
+		BearingAfter  float64    `json:"bearing_after"`
+	} `json:"maneuver"`
+}
+
+func (r *OSRMRouter) Plan(ctx context.Context, waypoints []orb.Point, profile route.RoutingProfile) (*route.PlannedRoute, error) {
+	coords := make([]string, len(waypoints))
+	for i, p := range waypoints {
+		coords[i] = strconv.FormatFloat(p.Lon(), 'f', 6, 64) + "," + strconv.FormatFloat(p.Lat(), 'f', 6, 64)
+	}
+	url := fmt.Sprintf("%s/route/v1/bike/%s?overview=full&geometries=geojson&steps=true",
+		strings.TrimRight(r.urlFor(profile), "/"), strings.Join(coords, ";"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("osrm: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 経路が見つからない場合などもステータス400でcodeを返す
+	var body osrmResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("osrm: unexpected response (status %d): %w", resp.StatusCode, err)
+	}
+	switch body.Code {
+	case "Ok":
+	case "NoRoute", "NoSegment":
+		return nil, domainerror.New("no route found between the waypoints", domainerror.ErrValidation)
+	case "InvalidValue", "InvalidQuery", "TooBig":
+		return nil, domainerror.New(body.Message, domainerror.ErrValidation)
+	default:
+		return nil, fmt.Errorf("osrm: %s: %s", body.Code, body.Message)
+	}
+	if len(body.Routes) == 0 {
+		return nil, domainerror.New("no route found between the waypoints", domainerror.ErrValidation)
+	}
+
+	return toPlannedRoute(body.Routes[0]), nil
+}
+
+func (r *OSRMRouter) urlFor(profile route.RoutingProfile) string {
+	if url, ok := r.profileURLs[profile]; ok && url != "" {
+		return url
+	}
+	return r.baseURL
+}
+
+// toPlannedRoute はOSRMの経路を変換する
+// 経由地ごとの区間（leg）に分かれている操作をつなげ、経由地での到着・出発は除く
+func toPlannedRoute(osrm osrmRoute) *route.PlannedRoute {
+	path := make(orb.LineString, len(osrm.Geometry.Coordinates))
+	for i, c := range osrm.Geometry.Coordinates {
+		path[i] = orb.Point{c[0], c[1]}
+	}
+
+	maneuvers := []route.PlannedManeuver{}
+	cumDist, cumDuration := 0.0, 0.0
+	for i, leg := range osrm.Legs {
+		for _, step := range leg.Steps {
+			m := step.Maneuver
+			isFirstDepart := m.Type == "depart" && i == 0
+			isLastArrive := m.Type == "arrive" && i == len(osrm.Legs)-1
+			if (m.Type == "depart" || m.Type == "arrive") && !isFirstDepart && !isLastArrive {
+				cumDist += step.Distance
+				cumDuration += step.Duration
+				continue
+			}
+
+			maneuver := route.PlannedManeuver{
+				ManeuverType: m.Type,
+				Modifier:     optionalString(m.Modifier),
+				RoadName:     optionalString(step.Name),
+				Location:     orb.Point{m.Location[0], m.Location[1]},
+				CumDistM:     cumDist,
+				CumDuration:  cumDuration,
+			}
+			// 出発前・到着後の方位角は0が返るため設定しない
+			if !isFirstDepart {
+				maneuver.BearingBefore = roundBearing(m.BearingBefore)
+			}
+			if !isLastArrive {
+				maneuver.BearingAfter = roundBearing(m.BearingAfter)
+			}
+			maneuvers = append(maneuvers, maneuver)
+
+			cumDist += step.Distance
+			cumDuration += step.Duration
+		}
+	}
+
+	return &route.PlannedRoute{
+		Path:      path,
+		Distance:  osrm.Distance,
+		Duration:  osrm.Duration,
+		Maneuvers: maneuvers,
+	}
+}
+
+func roundBearing(bearing float64) *int32 {
+	b := int32(math.Round(bearing)) % 360
+	return &b
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,123 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/paulmach/orb"
+)
+
+// 経由地を1つ挟んだ2区間の経路
+const osrmTwoLegsResponse = `{
+  "code": "Ok",
+  "routes": [{
+    "distance": 1000, "duration": 200,
+    "geometry": {"type": "LineString", "coordinates": [[139.70, 35.68], [139.705, 35.68], [139.705, 35.685]]},
+    "legs": [
+      {"steps": [
+        {"distance": 450, "duration": 90, "name": "靖国通り", "maneuver": {"type": "depart", "location": [139.70, 35.68], "bearing_before": 0, "bearing_after": 90}},
+        {"distance": 0, "duration": 0, "name": "", "maneuver": {"type": "arrive", "location": [139.705, 35.68], "bearing_before": 90, "bearing_after": 0}}
+      ]},
+      {"steps": [
+        {"distance": 200, "duration": 40, "name": "", "maneuver": {"type": "depart", "location": [139.705, 35.68], "bearing_before": 0, "bearing_after": 90}},
+        {"distance": 350, "duration": 70, "name": "明治通り", "maneuver": {"type": "turn", "modifier": "left", "location": [139.707, 35.68], "bearing_before": 90, "bearing_after": 0}},
+        {"distance": 0, "duration": 0, "name": "明治通り", "maneuver": {"type": "arrive", "location": [139.705, 35.685], "bearing_before": 0, "bearing_after": 0}}
+      ]}
+    ]
+  }]
+}`
+
+func TestOSRMRouter_Plan(t *testing.T) {
+	var gotPath, gotQuery string
+	road := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotPath, gotQuery = req.URL.Path, req.URL.RawQuery
+		w.Write([]byte(osrmTwoLegsResponse))
+	}))
+	defer road.Close()
+	gravel := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code": "NoRoute", "message": "Impossible route between points"}`))
+	}))
+	defer gravel.Close()
+
+	router := NewOSRMRouter(http.DefaultClient, road.URL, map[route.RoutingProfile]string{
+		route.RoutingProfileGravel: gravel.URL,
+	})
+	waypoints := []orb.Point{{139.70, 35.68}, {139.705, 35.68}, {139.705, 35.685}}
+
+	t.Run("正常系: 区間をつなげて経由地での到着・出発を除く", func(t *testing.T) {
+		planned, err := router.Plan(context.Background(), waypoints, route.RoutingProfileRoad)
+		if err != nil {
+			t.Fatalf("Plan() error = %v", err)
+		}
+		if gotPath != "/route/v1/bike/139.700000,35.680000;139.705000,35.680000;139.705000,35.685000" {
+			t.Errorf("path = %s", gotPath)
+		}
+		if gotQuery != "overview=full&geometries=geojson&steps=true" {
+			t.Errorf("query = %s", gotQuery)
+		}
+		if len(planned.Path) != 3 || planned.Distance != 1000 || planned.Duration != 200 {
+			t.Errorf("Plan() = %v, %v m, %v s", planned.Path, planned.Distance, planned.Duration)
+		}
+
+		want := []struct {
+			maneuverType string
+			cumDistM     float64
+		}{{"depart", 0}, {"turn", 650}, {"arrive", 1000}}
+		if len(planned.Maneuvers) != len(want) {
+			t.Fatalf("len(Maneuvers) = %d, want %d", len(planned.Maneuvers), len(want))
+		}
+		for i, m := range planned.Maneuvers {
+			if m.ManeuverType != want[i].maneuverType || m.CumDistM != want[i].cumDistM {
+				t.Errorf("Maneuvers[%d] = %s at %v m, want %s at %v m", i, m.ManeuverType, m.CumDistM, want[i].maneuverType, want[i].cumDistM)
+			}
+		}
+		if depart := planned.Maneuvers[0]; depart.BearingBefore != nil || *depart.BearingAfter != 90 || *depart.RoadName != "靖国通り" {
+			t.Errorf("depart = %+v", depart)
+		}
+		if arrive := planned.Maneuvers[2]; arrive.BearingAfter != nil {
+			t.Errorf("arrive.BearingAfter = %v, want nil", *arrive.BearingAfter)
+		}
+	})
+
+	t.Run("異常系: 経路が見つからない", func(t *testing.T) {
+		_, err := router.Plan(context.Background(), waypoints, route.RoutingProfileGravel)
+		if !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("Plan() error = %v, want ErrValidation", err)
+		}
+	})
+}
+
+func TestFakeRouter_Plan(t *testing.T) {
+	router := NewFakeRouter()
+	// 東へ進んで経由地で北へ曲がる
+	waypoints := []orb.Point{{139.70, 35.68}, {139.705, 35.68}, {139.705, 35.685}}
+
+	planned, err := router.Plan(context.Background(), waypoints, route.RoutingProfileRoad)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if len(planned.Path) != 3 || len(planned.Maneuvers) != 3 {
+		t.Fatalf("Plan() = %d points, %d maneuvers", len(planned.Path), len(planned.Maneuvers))
+	}
+	if m := planned.Maneuvers[1]; m.ManeuverType != "turn" || *m.Modifier != "left" {
+		t.Errorf("Maneuvers[1] = %s %v, want turn left", m.ManeuverType, m.Modifier)
+	}
+	if last := planned.Maneuvers[2]; last.CumDistM != planned.Distance {
+		t.Errorf("arrive CumDistM = %v, want %v", last.CumDistM, planned.Distance)
+	}
+
+	again, _ := router.Plan(context.Background(), waypoints, route.RoutingProfileRoad)
+	if again.Distance != planned.Distance {
+		t.Error("FakeRouter should return the same route for the same waypoints")
+	}
+
+	if _, err := router.Plan(context.Background(), waypoints[:1], route.RoutingProfileRoad); !errors.Is(err, domainerror.ErrValidation) {
+		t.Errorf("Plan() error = %v, want ErrValidation", err)
+	}
+}
@@ -0,0 +1,64 @@
+// Package adminboundary は行政区域のGeoJSONから逆ジオコーディングに使う範囲を取り出す
+// 各フィーチャーのプロパティには name、admin_level（OpenStreetMapと同じ階層。4:都道府県, 7:市区町村）、country_code が必要
+// 範囲（Polygon、MultiPolygon）以外のフィーチャーは、地名の表示位置などとして含まれることがあるため読み飛ばす
+package adminboundary
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+// LoadFile はGeoJSONのFeatureCollectionから行政区域を取り出す
+func LoadFile(path string) ([]*place.AdminBoundary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fc, err := geojson.UnmarshalFeatureCollection(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geojson: %w", err)
+	}
+
+	boundaries := make([]*place.AdminBoundary, 0, len(fc.Features))
+	for i, f := range fc.Features {
+		var geom orb.MultiPolygon
+		switch g := f.Geometry.(type) {
+		case orb.Polygon:
+			geom = orb.MultiPolygon{g}
+		case orb.MultiPolygon:
+			geom = g
+		default:
+			continue
+		}
+		level, err := adminLevel(f.Properties["admin_level"])
+		if err != nil {
+			return nil, fmt.Errorf("feature %d: %w", i, err)
+		}
+		b, err := place.NewAdminBoundary(f.Properties.MustString("name", ""), level, f.Properties.MustString("country_code", ""), geom)
+		if err != nil {
+			return nil, fmt.Errorf("feature %d: %w", i, err)
+		}
+		boundaries = append(boundaries, b)
+	}
+	return boundaries, nil
+}
+
+// adminLevel はadmin_levelを数値に変換する。OpenStreetMapから書き出したデータでは文字列になっている
+func adminLevel(v any) (int, error) {
+	switch l := v.(type) {
+	case float64:
+		return int(l), nil
+	case string:
+		n, err := strconv.Atoi(l)
+		if err != nil {
+			return 0, fmt.Errorf("invalid admin_level: %q", l)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("admin_level is required")
+}
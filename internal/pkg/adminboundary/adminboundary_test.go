@@ -0,0 +1,44 @@
+package adminboundary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// 京都府・大阪府と、京都市（中京区）・亀岡市を長方形で表した行政区域
+const fixturePath = "../../infrastructure/fixtures/boundaries/kyoto.geojson"
+
+func TestLoadFile(t *testing.T) {
+	boundaries, err := LoadFile(fixturePath)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+
+	// 地名の表示位置（Point）は含めない
+	if len(boundaries) != 5 {
+		t.Fatalf("LoadFile() = %d boundaries, want 5", len(boundaries))
+	}
+	kyoto := boundaries[2]
+	if kyoto.Name != "京都市" || kyoto.AdminLevel != 7 || len(kyoto.Geom) != 1 {
+		t.Errorf("boundaries[2] = %+v", kyoto.AdminArea)
+	}
+	// 文字列のadmin_levelと小文字の国コードも読み込む
+	kameoka := boundaries[4]
+	if kameoka.Name != "亀岡市" || kameoka.AdminLevel != 7 || kameoka.CountryCode != "JP" {
+		t.Errorf("boundaries[4] = %+v", kameoka.AdminArea)
+	}
+}
+
+func TestLoadFile_InvalidAdminLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "invalid.geojson")
+	data := `{"type": "FeatureCollection", "features": [{"type": "Feature", "properties": {"name": "京都市", "country_code": "JP"},
+		"geometry": {"type": "Polygon", "coordinates": [[[135.5, 34.8], [135.9, 34.8], [135.9, 35.3], [135.5, 34.8]]]}}]}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadFile(path); err == nil {
+		t.Error("LoadFile() error = nil, want error for missing admin_level")
+	}
+}
@@ -0,0 +1,230 @@
+package cuesheet
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/paulmach/orb"
+)
+
+// Excelで開いたときに文字化けしないようにUTF-8のBOMを付ける
+const utf8BOM = "\ufeff"
+
+// CSVの列
+const (
+	colStep = iota
+	colTurn
+	colCumDist
+	colSegDist
+	colInstruction
+	colRoadName
+	colManeuverType
+	colModifier
+	colLat
+	colLon
+	colBearingBefore
+	colBearingAfter
+	numColumns
+)
+
+// 取り込み時に使う列の見出し。人が読む列は言語ごとに見出しが変わる
+var fixedHeaders = map[int]string{
+	colManeuverType:  "maneuver_type",
+	colModifier:      "modifier",
+	colLat:           "lat",
+	colLon:           "lon",
+	colBearingBefore: "bearing_before",
+	colBearingAfter:  "bearing_after",
+}
+
+func csvHeader(l labels, unit Unit) []string {
+	header := make([]string, numColumns)
+	header[colStep] = l.step
+	header[colTurn] = l.turn
+	header[colCumDist] = fmt.Sprintf(l.cumDist, unit)
+	header[colSegDist] = fmt.Sprintf(l.segDist, unit)
+	header[colInstruction] = l.instruction
+	header[colRoadName] = l.roadName
+	for col, h := range fixedHeaders {
+		header[col] = h
+	}
+	return header
+}
+
+// WriteCSV はキューシートをCSVで書き出す
+// 位置・操作タイプ・方位角も出力し、ParseCSV でコースポイントに戻せるようにする
+func WriteCSV(w io.Writer, s *Sheet) error {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader(labelsFor(s.Lang), s.Unit)); err != nil {
+		return err
+	}
+	for _, row := range s.Rows {
+		record := make([]string, numColumns)
+		record[colStep] = strconv.Itoa(int(row.StepOrder) + 1)
+		record[colTurn] = row.Arrow
+		record[colCumDist] = strconv.FormatFloat(row.CumDist, 'f', 3, 64)
+		record[colSegDist] = strconv.FormatFloat(row.SegDist, 'f', 3, 64)
+		record[colInstruction] = row.Instruction
+		record[colRoadName] = row.RoadName
+		record[colManeuverType] = row.ManeuverType
+		record[colModifier] = row.Modifier
+		record[colLat] = strconv.FormatFloat(row.Location.Lat(), 'f', 6, 64)
+		record[colLon] = strconv.FormatFloat(row.Location.Lon(), 'f', 6, 64)
+		record[colBearingBefore] = formatBearing(row.BearingBefore)
+		record[colBearingAfter] = formatBearing(row.BearingAfter)
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// headerColumn は見出しが表す列と距離の単位
+type headerColumn struct {
+	col  int
+	unit Unit
+}
+
+// knownHeaders は見出しから列を引く表。どの言語・単位で書き出したCSVも取り込めるようにする
+func knownHeaders() map[string]headerColumn {
+	known := map[string]headerColumn{}
+	for _, l := range sheetLabels {
+		for _, unit := range []Unit{UnitKm, UnitMi} {
+			for col, h := range csvHeader(l, unit) {
+				known[h] = headerColumn{col: col, unit: unit}
+			}
+		}
+	}
+	return known
+}
+
+// ParseCSV は WriteCSV で書き出したCSVをキューシートの行として読み込む
+// 列は見出しで判別するため並べ替えてもよい。距離と位置の列は必須で、方向・区間距離の列は読み込まない
+func ParseCSV(r io.Reader) ([]route.CueSheetEntry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("cue sheet is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid cue sheet: %w", err)
+	}
+
+	known := knownHeaders()
+	index := map[int]int{}
+	unit := UnitKm
+	for i, h := range header {
+		if i == 0 {
+			h = strings.TrimPrefix(h, utf8BOM)
+		}
+		k, ok := known[strings.TrimSpace(h)]
+		if !ok {
+			continue
+		}
+		index[k.col] = i
+		if k.col == colCumDist {
+			unit = k.unit
+		}
+	}
+	for _, col := range []int{colCumDist, colLat, colLon} {
+		if _, ok := index[col]; !ok {
+			return nil, errors.New("cue sheet must have distance, lat and lon columns")
+		}
+	}
+
+	entries := []route.CueSheetEntry{}
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid cue sheet at line %d: %w", line, err)
+		}
+
+		field := func(col int) string {
+			i, ok := index[col]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		// ParseFloatはNaNやInfも受け付けるため、有限の値かを別に確かめる
+		cumDist, err := strconv.ParseFloat(field(colCumDist), 64)
+		if err != nil || !isFinite(cumDist) {
+			return nil, fmt.Errorf("invalid distance at line %d", line)
+		}
+		lat, errLat := strconv.ParseFloat(field(colLat), 64)
+		lon, errLon := strconv.ParseFloat(field(colLon), 64)
+		if errLat != nil || errLon != nil || !isFinite(lat) || !isFinite(lon) {
+			return nil, fmt.Errorf("invalid location at line %d", line)
+		}
+		if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+			return nil, fmt.Errorf("location is out of range at line %d", line)
+		}
+		bearingBefore, err := parseBearing(field(colBearingBefore))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bearing_before at line %d", line)
+		}
+		bearingAfter, err := parseBearing(field(colBearingAfter))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bearing_after at line %d", line)
+		}
+
+		entries = append(entries, route.CueSheetEntry{
+			CumDistM:      unit.toMeters(cumDist),
+			Instruction:   optionalString(field(colInstruction)),
+			RoadName:      optionalString(field(colRoadName)),
+			ManeuverType:  optionalString(field(colManeuverType)),
+			Modifier:      optionalString(field(colModifier)),
+			Location:      route.Geometry{Geometry: orb.Point{lon, lat}},
+			BearingBefore: bearingBefore,
+			BearingAfter:  bearingAfter,
+		})
+	}
+	return entries, nil
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+func formatBearing(b *int32) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*b))
+}
+
+func parseBearing(s string) (*int32, error) {
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || v < 0 || v >= 360 {
+		return nil, errors.New("bearing must be between 0 and 359")
+	}
+	b := int32(v)
+	return &b, nil
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,192 @@
+package cuesheet
+
+import (
+	"fmt"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/paulmach/orb"
+)
+
+// Unit はキューシートの距離の単位
+type Unit string
+
+const (
+	UnitKm Unit = "km"
+	UnitMi Unit = "mi"
+
+	metersPerKm   = 1000.0
+	metersPerMile = 1609.344
+)
+
+// ParseUnit は距離の単位を解釈する。空の場合はkmにする
+func ParseUnit(s string) (Unit, error) {
+	switch Unit(s) {
+	case "", UnitKm:
+		return UnitKm, nil
+	case UnitMi:
+		return UnitMi, nil
+	}
+	return "", fmt.Errorf("unit must be %q or %q", UnitKm, UnitMi)
+}
+
+func (u Unit) fromMeters(m float64) float64 {
+	if u == UnitMi {
+		return m / metersPerMile
+	}
+	return m / metersPerKm
+}
+
+func (u Unit) toMeters(v float64) float64 {
+	if u == UnitMi {
+		return v * metersPerMile
+	}
+	return v * metersPerKm
+}
+
+// Row はキューシートの1行
+type Row struct {
+	StepOrder     int32
+	CumDist       float64 // 始点からの距離（Sheet.Unitの単位）
+	SegDist       float64 // 次のキューまでの距離（Sheet.Unitの単位）
+	Arrow         string
+	Instruction   string
+	RoadName      string
+	ManeuverType  string
+	Modifier      string
+	Location      orb.Point
+	BearingBefore *int32
+	BearingAfter  *int32
+}
+
+// Sheet は印刷用のキューシート
+type Sheet struct {
+	RouteName string
+	Distance  float64 // 総距離（Unitの単位）
+	Unit      Unit
+	Lang      route.CueLanguage
+	Rows      []Row
+}
+
+// FromRoute はルートのコースポイントからキューシートを作る
+// 案内文がないコースポイントは操作タイプと修飾子から lang の案内文を補う
+func FromRoute(r *route.Route, lang route.CueLanguage, unit Unit) *Sheet {
+	cps := r.CoursePoints()
+	rows := make([]Row, len(cps))
+	cum := 0.0
+	for i, cp := range cps {
+		if cp.CumDistM() != nil {
+			cum = *cp.CumDistM()
+		}
+		seg := 0.0
+		if cp.SegDistM() != nil {
+			seg = *cp.SegDistM()
+		}
+
+		maneuverType := stringValue(cp.ManeuverType())
+		instruction := stringValue(cp.Instruction())
+		if instruction == "" && maneuverType != "" {
+			instruction = route.CueInstruction(maneuverType, cp.Modifier(), lang)
+		}
+		var location orb.Point
+		if cp.Location() != nil {
+			location, _ = cp.Location().Geometry.(orb.Point)
+		}
+
+		rows[i] = Row{
+			StepOrder:     cp.StepOrder(),
+			CumDist:       unit.fromMeters(cum),
+			SegDist:       unit.fromMeters(seg),
+			Arrow:         turnArrow(maneuverType, stringValue(cp.Modifier())),
+			Instruction:   instruction,
+			RoadName:      stringValue(cp.RoadName()),
+			ManeuverType:  maneuverType,
+			Modifier:      stringValue(cp.Modifier()),
+			Location:      location,
+			BearingBefore: cp.BearingBefore(),
+			BearingAfter:  cp.BearingAfter(),
+		}
+		cum += seg
+	}
+
+	return &Sheet{
+		RouteName: r.Name(),
+		Distance:  unit.fromMeters(r.Distance()),
+		Unit:      unit,
+		Lang:      lang,
+		Rows:      rows,
+	}
+}
+
+// 修飾子ごとの曲がる向きの矢印
+var turnArrows = map[string]string{
+	"straight":     "↑",
+	"slight right": "↗",
+	"right":        "→",
+	"sharp right":  "↘",
+	"uturn":        "↓",
+	"sharp left":   "↙",
+	"left":         "←",
+	"slight left":  "↖",
+}
+
+func turnArrow(maneuverType, modifier string) string {
+	switch maneuverType {
+	case "depart":
+		return "●"
+	case "arrive":
+		return "◎"
+	}
+	return turnArrows[modifier]
+}
+
+// labels はキューシートの見出し
+type labels struct {
+	title       string
+	totalDist   string
+	step        string
+	turn        string
+	cumDist     string // 単位を埋め込む書式
+	segDist     string // 単位を埋め込む書式
+	instruction string
+	roadName    string
+	page        string
+}
+
+var sheetLabels = map[route.CueLanguage]labels{
+	route.CueLanguageJa: {
+		title:       "キューシート",
+		totalDist:   "総距離",
+		step:        "No.",
+		turn:        "方向",
+		cumDist:     "累積距離(%s)",
+		segDist:     "区間距離(%s)",
+		instruction: "案内",
+		roadName:    "道路名",
+		page:        "%d / %d ページ",
+	},
+	route.CueLanguageEn: {
+		title:       "Cue Sheet",
+		totalDist:   "Total distance",
+		step:        "No.",
+		turn:        "Turn",
+		cumDist:     "Total (%s)",
+		segDist:     "Leg (%s)",
+		instruction: "Instruction",
+		roadName:    "Road",
+		page:        "Page %d of %d",
+	},
+}
+
+func labelsFor(lang route.CueLanguage) labels {
+	if l, ok := sheetLabels[lang]; ok {
+		return l
+	}
+	return sheetLabels[route.CueLanguageJa]
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
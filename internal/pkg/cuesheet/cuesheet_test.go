@@ -0,0 +1,172 @@
+package cuesheet
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/paulmach/orb"
+)
+
+func newTestRoute(t *testing.T) *route.Route {
+	t.Helper()
+	path := orb.LineString{{139.70, 35.68}, {139.705, 35.68}, {139.705, 35.685}}
+	r, err := route.NewRoute("019b5a8d-16a7-700a-be92-9ae11e7e5b9a", "皇居一周", "", nil, 1000, 300, 0, 0,
+		route.Geometry{Geometry: path}, route.Geometry{Geometry: path[0]}, route.Geometry{Geometry: path[2]}, 1)
+	if err != nil {
+		t.Fatalf("NewRoute() error = %v", err)
+	}
+	if err := route.NewDefaultCueGenerator().GenerateCoursePoints(r, route.CueLanguageJa); err != nil {
+		t.Fatalf("GenerateCoursePoints() error = %v", err)
+	}
+	return r
+}
+
+func TestParseUnit(t *testing.T) {
+	for _, s := range []string{"", "km"} {
+		if u, err := ParseUnit(s); err != nil || u != UnitKm {
+			t.Errorf("ParseUnit(%q) = %s, %v, want km", s, u, err)
+		}
+	}
+	if u, err := ParseUnit("mi"); err != nil || u != UnitMi {
+		t.Errorf("ParseUnit(mi) = %s, %v, want mi", u, err)
+	}
+	if _, err := ParseUnit("m"); err == nil {
+		t.Error("ParseUnit(m) should return error")
+	}
+}
+
+func TestFromRoute(t *testing.T) {
+	r := newTestRoute(t)
+	sheet := FromRoute(r, route.CueLanguageEn, UnitMi)
+
+	if len(sheet.Rows) != 3 {
+		t.Fatalf("len(Rows) = %d, want 3", len(sheet.Rows))
+	}
+	if sheet.Rows[1].Arrow != "←" || sheet.Rows[0].Arrow != "●" || sheet.Rows[2].Arrow != "◎" {
+		t.Errorf("arrows = %s %s %s", sheet.Rows[0].Arrow, sheet.Rows[1].Arrow, sheet.Rows[2].Arrow)
+	}
+	// 生成済みの案内文はそのまま使う
+	if sheet.Rows[1].Instruction != "左折です" {
+		t.Errorf("Instruction = %s, want 左折です", sheet.Rows[1].Instruction)
+	}
+	cum := *r.CoursePoints()[1].CumDistM()
+	if math.Abs(sheet.Rows[1].CumDist-cum/metersPerMile) > 1e-9 {
+		t.Errorf("CumDist = %v mi, want %v", sheet.Rows[1].CumDist, cum/metersPerMile)
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	for _, unit := range []Unit{UnitKm, UnitMi} {
+		for _, lang := range []route.CueLanguage{route.CueLanguageJa, route.CueLanguageEn} {
+			t.Run(string(lang)+"/"+string(unit), func(t *testing.T) {
+				r := newTestRoute(t)
+				var buf bytes.Buffer
+				if err := WriteCSV(&buf, FromRoute(r, lang, unit)); err != nil {
+					t.Fatalf("WriteCSV() error = %v", err)
+				}
+
+				entries, err := ParseCSV(&buf)
+				if err != nil {
+					t.Fatalf("ParseCSV() error = %v", err)
+				}
+				cps := r.CoursePoints()
+				if len(entries) != len(cps) {
+					t.Fatalf("len(entries) = %d, want %d", len(entries), len(cps))
+				}
+				for i, e := range entries {
+					cp := cps[i]
+					if math.Abs(e.CumDistM-*cp.CumDistM()) > 2 {
+						t.Errorf("entries[%d].CumDistM = %v, want %v", i, e.CumDistM, *cp.CumDistM())
+					}
+					if stringValue(e.ManeuverType) != stringValue(cp.ManeuverType()) || stringValue(e.Modifier) != stringValue(cp.Modifier()) {
+						t.Errorf("entries[%d] maneuver = %v/%v", i, e.ManeuverType, e.Modifier)
+					}
+					if stringValue(e.Instruction) != stringValue(cp.Instruction()) {
+						t.Errorf("entries[%d].Instruction = %v, want %v", i, stringValue(e.Instruction), stringValue(cp.Instruction()))
+					}
+					if e.Location.Geometry.(orb.Point) != cp.Location().Geometry.(orb.Point) {
+						t.Errorf("entries[%d].Location = %v, want %v", i, e.Location.Geometry, cp.Location().Geometry)
+					}
+					if (e.BearingAfter == nil) != (cp.BearingAfter() == nil) || (e.BearingAfter != nil && *e.BearingAfter != *cp.BearingAfter()) {
+						t.Errorf("entries[%d].BearingAfter = %v, want %v", i, e.BearingAfter, cp.BearingAfter())
+					}
+				}
+
+				if err := r.ImportCueSheet(entries); err != nil {
+					t.Fatalf("ImportCueSheet() error = %v", err)
+				}
+				if len(r.CoursePoints()) != len(cps) {
+					t.Errorf("len(CoursePoints) = %d after import, want %d", len(r.CoursePoints()), len(cps))
+				}
+			})
+		}
+	}
+}
+
+func TestParseCSV_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		csv      string
+		wantLine int // 0の場合は行番号を確かめない
+	}{
+		{name: "空のファイル", csv: ""},
+		{name: "位置の列がない", csv: "No.,Total (km)\n1,0.000\n"},
+		{name: "距離が数値ではない", csv: "Total (km),lat,lon\nabc,35.68,139.70\n", wantLine: 2},
+		{name: "方位角が範囲外", csv: "Total (km),lat,lon,bearing_after\n0,35.68,139.70,360\n", wantLine: 2},
+		{name: "距離がNaN", csv: "Total (km),lat,lon\n0,35.68,139.70\nNaN,35.68,139.70\n", wantLine: 3},
+		{name: "距離が無限大", csv: "Total (km),lat,lon\n0,35.68,139.70\n+Inf,35.68,139.70\n", wantLine: 3},
+		{name: "緯度がNaN", csv: "Total (km),lat,lon\n0,35.68,139.70\n1,NaN,139.70\n", wantLine: 3},
+		{name: "経度が無限大", csv: "Total (km),lat,lon\n0,35.68,139.70\n1,35.68,-Inf\n", wantLine: 3},
+		{name: "緯度が範囲外", csv: "Total (km),lat,lon\n0,35.68,139.70\n1,90.5,139.70\n", wantLine: 3},
+		{name: "経度が範囲外", csv: "Total (km),lat,lon\n0,35.68,139.70\n0.5,35.68,139.70\n1,35.68,180.1\n", wantLine: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSV(strings.NewReader(tt.csv))
+			if err == nil {
+				t.Fatal("ParseCSV() should return error")
+			}
+			if want := fmt.Sprintf("at line %d", tt.wantLine); tt.wantLine != 0 && !strings.Contains(err.Error(), want) {
+				t.Errorf("ParseCSV() error = %q, want %q", err, want)
+			}
+		})
+	}
+}
+
+func TestWritePDF(t *testing.T) {
+	r := newTestRoute(t)
+	sheet := FromRoute(r, route.CueLanguageJa, UnitKm)
+	// 改ページを確かめるため行を増やす
+	for len(sheet.Rows) < 100 {
+		sheet.Rows = append(sheet.Rows, sheet.Rows[1])
+	}
+
+	var buf bytes.Buffer
+	if err := WritePDF(&buf, sheet); err != nil {
+		t.Fatalf("WritePDF() error = %v", err)
+	}
+	pdf := buf.String()
+	if !strings.HasPrefix(pdf, "%PDF-1.4") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Error("output is not a PDF document")
+	}
+	if !strings.Contains(pdf, "/Count 3") {
+		t.Error("100 rows should be split into 3 pages")
+	}
+	// 「左折です」がUTF-16BEで書き出されている
+	if !strings.Contains(pdf, encodeUCS2("左折です")) {
+		t.Error("instruction is not written")
+	}
+}
+
+func TestTruncateToWidth(t *testing.T) {
+	if got := truncateToWidth("abcdef", 10, 30); got != "abcdef" {
+		t.Errorf("truncateToWidth() = %s, want abcdef", got)
+	}
+	if got := truncateToWidth("あいうえお", 10, 30); got != "あい…" {
+		t.Errorf("truncateToWidth() = %s, want あい…", got)
+	}
+}
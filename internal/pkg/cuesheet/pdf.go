@@ -0,0 +1,189 @@
+package cuesheet
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// PDFのレイアウト（単位はpt、A4縦）
+const (
+	pageWidth    = 595.0
+	pageHeight   = 842.0
+	pageMargin   = 40.0
+	titleSize    = 14.0
+	fontSize     = 10.0
+	lineHeight   = 16.0
+	headerTop    = pageHeight - pageMargin
+	tableTop     = headerTop - 48
+	footerBottom = pageMargin - 16
+)
+
+// 表の列の左端の位置と幅
+var pdfColumns = []struct {
+	x     float64
+	width float64
+}{
+	{x: pageMargin, width: 28},        // No.
+	{x: pageMargin + 30, width: 28},   // 方向
+	{x: pageMargin + 60, width: 70},   // 累積距離
+	{x: pageMargin + 132, width: 70},  // 区間距離
+	{x: pageMargin + 204, width: 200}, // 案内
+	{x: pageMargin + 408, width: 107}, // 道路名
+}
+
+// 日本語を表示できるよう、ビューアが持つ和文フォントを埋め込まずに参照する
+// 半角文字（CID 231〜）は幅500、それ以外は全角幅1000として扱う
+var pdfFontObjects = []string{
+	"<< /Type /Font /Subtype /Type0 /BaseFont /HeiseiKakuGo-W5 /Encoding /UniJIS-UCS2-H /DescendantFonts [4 0 R] >>",
+	"<< /Type /Font /Subtype /CIDFontType0 /BaseFont /HeiseiKakuGo-W5 /CIDSystemInfo << /Registry (Adobe) /Ordering (Japan1) /Supplement 2 >> /FontDescriptor 5 0 R /DW 1000 /W [231 389 500] >>",
+	"<< /Type /FontDescriptor /FontName /HeiseiKakuGo-W5 /Flags 4 /FontBBox [-92 -250 1010 922] /ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 737 /StemV 114 >>",
+}
+
+// WritePDF はキューシートを印刷用のPDFで書き出す
+// 1ページに収まらない場合は表の見出しを繰り返して改ページする
+func WritePDF(w io.Writer, s *Sheet) error {
+	l := labelsFor(s.Lang)
+	// 表の見出しとページ番号の行を除いた行数
+	rowsPerPage := int(math.Floor((tableTop-footerBottom)/lineHeight)) - 2
+
+	pages := [][]Row{}
+	for start := 0; start < len(s.Rows); start += rowsPerPage {
+		pages = append(pages, s.Rows[start:min(start+rowsPerPage, len(s.Rows))])
+	}
+	if len(pages) == 0 {
+		pages = append(pages, nil)
+	}
+
+	// オブジェクト番号: 1=Catalog, 2=Pages, 3〜5=フォント, 6以降=各ページとその内容
+	const firstPageObj = 6
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+	}
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObj+i*2)
+	}
+	objects = append(objects, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	objects = append(objects, pdfFontObjects...)
+
+	for i, rows := range pages {
+		content := pageContent(s, l, rows, i+1, len(pages))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %g %g] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+				pageWidth, pageHeight, firstPageObj+i*2+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// pageContent は1ページ分の描画命令を作る
+func pageContent(s *Sheet, l labels, rows []Row, page, totalPages int) string {
+	var b strings.Builder
+
+	text(&b, pageMargin, headerTop-titleSize, titleSize, fmt.Sprintf("%s  %s", l.title, s.RouteName), pageWidth-pageMargin*2)
+	text(&b, pageMargin, headerTop-titleSize-20, fontSize,
+		fmt.Sprintf("%s: %s %s", l.totalDist, strconv.FormatFloat(s.Distance, 'f', 2, 64), s.Unit), pageWidth-pageMargin*2)
+
+	headers := []string{l.step, l.turn, fmt.Sprintf(l.cumDist, s.Unit), fmt.Sprintf(l.segDist, s.Unit), l.instruction, l.roadName}
+	y := tableTop
+	for i, h := range headers {
+		text(&b, pdfColumns[i].x, y, fontSize, h, pdfColumns[i].width)
+	}
+	fmt.Fprintf(&b, "0.5 w %g %g m %g %g l S\n", pageMargin, y-4, pageWidth-pageMargin, y-4)
+
+	for _, row := range rows {
+		y -= lineHeight
+		cells := []string{
+			strconv.Itoa(int(row.StepOrder) + 1),
+			row.Arrow,
+			strconv.FormatFloat(row.CumDist, 'f', 2, 64),
+			strconv.FormatFloat(row.SegDist, 'f', 2, 64),
+			row.Instruction,
+			row.RoadName,
+		}
+		for i, cell := range cells {
+			text(&b, pdfColumns[i].x, y, fontSize, cell, pdfColumns[i].width)
+		}
+		fmt.Fprintf(&b, "0.2 w %g %g m %g %g l S\n", pageMargin, y-4, pageWidth-pageMargin, y-4)
+	}
+
+	footer := fmt.Sprintf(l.page, page, totalPages)
+	text(&b, pageWidth-pageMargin-textWidth(footer, fontSize), footerBottom, fontSize, footer, pageWidth)
+	return b.String()
+}
+
+// text は (x, y) に文字列を描画する。maxWidth に収まらない部分は省略する
+func text(b *strings.Builder, x, y, size float64, s string, maxWidth float64) {
+	s = truncateToWidth(s, size, maxWidth)
+	if s == "" {
+		return
+	}
+	fmt.Fprintf(b, "BT /F1 %g Tf %g %g Td <%s> Tj ET\n", size, x, y, encodeUCS2(s))
+}
+
+// textWidth は文字列の幅を半角を0.5em、それ以外を1emとして見積もる
+func textWidth(s string, size float64) float64 {
+	width := 0.0
+	for _, r := range s {
+		width += runeWidth(r) * size
+	}
+	return width
+}
+
+func runeWidth(r rune) float64 {
+	if r < 0x80 {
+		return 0.5
+	}
+	return 1
+}
+
+func truncateToWidth(s string, size, maxWidth float64) string {
+	if textWidth(s, size) <= maxWidth {
+		return s
+	}
+	ellipsis := "…"
+	limit := maxWidth - textWidth(ellipsis, size)
+	width := 0.0
+	for i, r := range s {
+		width += runeWidth(r) * size
+		if width > limit {
+			return s[:i] + ellipsis
+		}
+	}
+	return s
+}
+
+// encodeUCS2 はUniJIS-UCS2-Hで表示できるよう文字列をUTF-16BEの16進数にする
+// UCS-2で表せない文字は「?」に置き換える
+func encodeUCS2(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r > 0xFFFF || utf16.IsSurrogate(r) {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return b.String()
+}
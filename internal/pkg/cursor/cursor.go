@@ -0,0 +1,39 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// payload はカーソルトークンの中身
+// クライアントには不透明な文字列として扱わせるため、フィールド名は短くしている
+type payload struct {
+	Sort    string  `json:"s"`
+	SortKey float64 `json:"k"`
+	ID      string  `json:"i"`
+}
+
+// Encode は並び順と (ソートキー, ID) をURLセーフなトークンに変換する
+func Encode(sort string, sortKey float64, id string) string {
+	b, _ := json.Marshal(payload{Sort: sort, SortKey: sortKey, ID: id})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Decode はトークンから並び順と (ソートキー, ID) を取り出す
+func Decode(token string) (sort string, sortKey float64, id string, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", 0, "", ErrInvalidCursor
+	}
+	var p payload
+	if err := json.Unmarshal(b, &p); err != nil {
+		return "", 0, "", ErrInvalidCursor
+	}
+	if p.ID == "" {
+		return "", 0, "", ErrInvalidCursor
+	}
+	return p.Sort, p.SortKey, p.ID, nil
+}
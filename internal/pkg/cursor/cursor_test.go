@@ -0,0 +1,50 @@
+package cursor
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    string
+		sortKey float64
+		id      string
+	}{
+		{name: "正常系: 作成日時(エポック秒)", sort: "newest", sortKey: 1713949200.123456, id: "019b5a50-0000-7000-8000-000000000001"},
+		{name: "正常系: 負のソートキー", sort: "nearest", sortKey: -1234.5678, id: "019b5a50-0000-7000-8000-000000000002"},
+		{name: "正常系: 最小の正の値", sort: "hilliest", sortKey: math.SmallestNonzeroFloat64, id: "019b5a50-0000-7000-8000-000000000003"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := Encode(tt.sort, tt.sortKey, tt.id)
+			sort, sortKey, id, err := Decode(token)
+			if err != nil {
+				t.Fatalf("Decode() unexpected error: %v", err)
+			}
+			// ソートキーはDB側で比較するため、誤差なく復元できる必要がある
+			if sort != tt.sort || sortKey != tt.sortKey || id != tt.id {
+				t.Errorf("Decode() = (%q, %v, %q), want (%q, %v, %q)", sort, sortKey, id, tt.sort, tt.sortKey, tt.id)
+			}
+		})
+	}
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "異常系: base64ではない", token: "%%%"},
+		{name: "異常系: JSONではない", token: "bm90LWpzb24"},
+		{name: "異常系: IDがない", token: Encode("newest", 1, "")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := Decode(tt.token); err != ErrInvalidCursor {
+				t.Errorf("Decode() error = %v, want %v", err, ErrInvalidCursor)
+			}
+		})
+	}
+}
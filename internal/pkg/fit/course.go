@@ -0,0 +1,152 @@
+// Package fit はルートをFITのコースファイル（Garminなどのサイクルコンピュータ用）に書き出す
+package fit
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// Course はFITのコース。ステージごとに1つのラップにする
+type Course struct {
+	Name        string
+	TimeCreated time.Time
+	Stages      []Stage
+}
+
+// Stage はコースの1区間（複数日のツアーの1日分など）
+type Stage struct {
+	Path          orb.LineString
+	Duration      float64 // 所要時間(s)。記録の時刻を距離に比例して割り振る
+	ElevationGain float64
+	ElevationLoss float64
+	EndName       string // 終点に置くコースポイントの名前（宿泊地など）。空の場合は置かない
+}
+
+// グローバルメッセージ番号
+const (
+	mesgFileID      uint16 = 0
+	mesgLap         uint16 = 19
+	mesgRecord      uint16 = 20
+	mesgEvent       uint16 = 21
+	mesgCourse      uint16 = 31
+	mesgCoursePoint uint16 = 32
+)
+
+const (
+	fileTypeCourse          uint8  = 6
+	manufacturerDevelopment uint16 = 255
+	sportCycling            uint8  = 2
+	eventTimer              uint8  = 0
+	eventTypeStart          uint8  = 0
+	eventTypeStopAll        uint8  = 4
+	coursePointGeneric      uint8  = 0
+)
+
+// 端末で表示できるコース名・コースポイント名の長さに合わせる
+const (
+	maxCourseNameBytes = 64
+	maxPointNameBytes  = 32
+)
+
+// ステージの間の時刻の間隔。日ごとのステージを1日ずつずらして記録する
+const stageInterval = 24 * time.Hour
+
+// WriteCourse はコースをFITファイルとして書き出す
+// 記録の時刻はTimeCreatedからステージごとに1日ずつずらし、所要時間を距離に比例して割り振る
+func WriteCourse(w io.Writer, c *Course) error {
+	if len(c.Stages) == 0 {
+		return errors.New("course has no stages")
+	}
+	for _, s := range c.Stages {
+		if len(s.Path) < 2 {
+			return errors.New("stage path must have at least 2 points")
+		}
+	}
+
+	e := newEncoder()
+	e.write(mesgFileID, []field{
+		{num: 0, baseType: baseEnum, value: fileTypeCourse},
+		{num: 1, baseType: baseUint16, value: manufacturerDevelopment},
+		{num: 2, baseType: baseUint16, value: uint16(0)},
+		{num: 4, baseType: baseUint32, value: fitTime(c.TimeCreated)},
+	})
+	e.write(mesgCourse, []field{
+		{num: 4, baseType: baseEnum, value: sportCycling},
+		stringField(5, c.Name, maxCourseNameBytes),
+	})
+
+	// ラップはコースの先頭にまとめて書く
+	for i, s := range c.Stages {
+		start := c.TimeCreated.Add(time.Duration(i) * stageInterval)
+		distance := geo.LengthHaversine(s.Path)
+		first, last := s.Path[0], s.Path[len(s.Path)-1]
+		e.write(mesgLap, []field{
+			{num: 253, baseType: baseUint32, value: fitTime(start)},
+			{num: 2, baseType: baseUint32, value: fitTime(start)},
+			{num: 3, baseType: baseSint32, value: semicircles(first.Lat())},
+			{num: 4, baseType: baseSint32, value: semicircles(first.Lon())},
+			{num: 5, baseType: baseSint32, value: semicircles(last.Lat())},
+			{num: 6, baseType: baseSint32, value: semicircles(last.Lon())},
+			{num: 7, baseType: baseUint32, value: uint32(s.Duration * 1000)},
+			{num: 8, baseType: baseUint32, value: uint32(s.Duration * 1000)},
+			{num: 9, baseType: baseUint32, value: uint32(distance * 100)},
+			{num: 21, baseType: baseUint16, value: uint16(s.ElevationGain)},
+			{num: 22, baseType: baseUint16, value: uint16(s.ElevationLoss)},
+		})
+	}
+
+	e.write(mesgEvent, []field{
+		{num: 253, baseType: baseUint32, value: fitTime(c.TimeCreated)},
+		{num: 0, baseType: baseEnum, value: eventTimer},
+		{num: 1, baseType: baseEnum, value: eventTypeStart},
+	})
+
+	// 距離はコース全体の始点からの累積にする
+	var cumulative float64
+	var end time.Time
+	for i, s := range c.Stages {
+		start := c.TimeCreated.Add(time.Duration(i) * stageInterval)
+		total := geo.LengthHaversine(s.Path)
+		var along float64
+		for j, p := range s.Path {
+			if j > 0 {
+				along += geo.DistanceHaversine(s.Path[j-1], p)
+			}
+			var elapsed float64
+			if total > 0 {
+				elapsed = s.Duration * along / total
+			}
+			end = start.Add(time.Duration(elapsed * float64(time.Second)))
+			e.write(mesgRecord, []field{
+				{num: 253, baseType: baseUint32, value: fitTime(end)},
+				{num: 0, baseType: baseSint32, value: semicircles(p.Lat())},
+				{num: 1, baseType: baseSint32, value: semicircles(p.Lon())},
+				{num: 5, baseType: baseUint32, value: uint32((cumulative + along) * 100)},
+			})
+		}
+		cumulative += total
+
+		if s.EndName != "" {
+			last := s.Path[len(s.Path)-1]
+			e.write(mesgCoursePoint, []field{
+				{num: 1, baseType: baseUint32, value: fitTime(end)},
+				{num: 2, baseType: baseSint32, value: semicircles(last.Lat())},
+				{num: 3, baseType: baseSint32, value: semicircles(last.Lon())},
+				{num: 4, baseType: baseUint32, value: uint32(cumulative * 100)},
+				{num: 5, baseType: baseEnum, value: coursePointGeneric},
+				stringField(6, s.EndName, maxPointNameBytes),
+			})
+		}
+	}
+
+	e.write(mesgEvent, []field{
+		{num: 253, baseType: baseUint32, value: fitTime(end)},
+		{num: 0, baseType: baseEnum, value: eventTimer},
+		{num: 1, baseType: baseEnum, value: eventTypeStopAll},
+	})
+	return e.writeTo(w)
+}
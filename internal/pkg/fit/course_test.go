@@ -0,0 +1,116 @@
+package fit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paulmach/orb"
+)
+
+// decodeMessages はテスト用にFITファイルを読み、グローバルメッセージ番号ごとのデータメッセージを返す
+func decodeMessages(t *testing.T, data []byte) map[uint16][][]byte {
+	t.Helper()
+	type definition struct {
+		global uint16
+		sizes  []int
+	}
+	defs := map[byte]definition{}
+	msgs := map[uint16][][]byte{}
+
+	body := data[headerSize : len(data)-2]
+	for i := 0; i < len(body); {
+		h := body[i]
+		local := h & 0x0F
+		i++
+		if h&0x40 != 0 {
+			d := definition{global: binary.LittleEndian.Uint16(body[i+2:])}
+			n := int(body[i+4])
+			i += 5
+			for range n {
+				d.sizes = append(d.sizes, int(body[i+1]))
+				i += 3
+			}
+			defs[local] = d
+			continue
+		}
+		d, ok := defs[local]
+		if !ok {
+			t.Fatalf("data message for undefined local type %d", local)
+		}
+		size := 0
+		for _, s := range d.sizes {
+			size += s
+		}
+		msgs[d.global] = append(msgs[d.global], body[i:i+size])
+		i += size
+	}
+	return msgs
+}
+
+func TestWriteCourse(t *testing.T) {
+	course := &Course{
+		Name:        "しまなみ海道 2日間",
+		TimeCreated: time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC),
+		Stages: []Stage{
+			{Path: orb.LineString{{133.00, 34.40}, {133.01, 34.40}, {133.02, 34.40}}, Duration: 3600, ElevationGain: 120, EndName: "大三島の宿"},
+			{Path: orb.LineString{{133.02, 34.40}, {133.02, 34.41}}, Duration: 1800},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCourse(&buf, course); err != nil {
+		t.Fatalf("WriteCourse() error = %v", err)
+	}
+	data := buf.Bytes()
+
+	if string(data[8:12]) != ".FIT" {
+		t.Fatalf("data type = %q, want .FIT", data[8:12])
+	}
+	if got := binary.LittleEndian.Uint32(data[4:]); int(got) != len(data)-headerSize-2 {
+		t.Errorf("data size = %d, want %d", got, len(data)-headerSize-2)
+	}
+	// CRCを含めて計算すると0になる
+	if crc16(0, data) != 0 {
+		t.Errorf("file CRC mismatch")
+	}
+
+	msgs := decodeMessages(t, data)
+	if len(msgs[mesgLap]) != 2 || len(msgs[mesgRecord]) != 5 || len(msgs[mesgCoursePoint]) != 1 || len(msgs[mesgEvent]) != 2 {
+		t.Errorf("laps, records, course points, events = %d, %d, %d, %d, want 2, 5, 1, 2",
+			len(msgs[mesgLap]), len(msgs[mesgRecord]), len(msgs[mesgCoursePoint]), len(msgs[mesgEvent]))
+	}
+	if name := string(msgs[mesgCourse][0][1:]); !strings.HasPrefix(name, course.Name) {
+		t.Errorf("course name = %q, want %q", name, course.Name)
+	}
+
+	// 2日目の最初の記録は1日目の距離から続き、時刻は1日後になる
+	day2 := msgs[mesgRecord][3]
+	if got := fitTime(course.TimeCreated.Add(stageInterval)); binary.LittleEndian.Uint32(day2[0:]) != got {
+		t.Errorf("day 2 timestamp = %d, want %d", binary.LittleEndian.Uint32(day2[0:]), got)
+	}
+	day1End := msgs[mesgRecord][2]
+	if d1, d2 := binary.LittleEndian.Uint32(day1End[12:]), binary.LittleEndian.Uint32(day2[12:]); d1 != d2 || d1 == 0 {
+		t.Errorf("cumulative distance = %d, %d, want equal and positive", d1, d2)
+	}
+}
+
+func TestWriteCourse_Invalid(t *testing.T) {
+	for _, c := range []*Course{
+		{Name: "empty"},
+		{Name: "short", Stages: []Stage{{Path: orb.LineString{{133, 34}}}}},
+	} {
+		if err := WriteCourse(&bytes.Buffer{}, c); err == nil {
+			t.Errorf("WriteCourse(%q) error = nil, want error", c.Name)
+		}
+	}
+}
+
+func TestStringField(t *testing.T) {
+	f := stringField(5, strings.Repeat("あ", 30), 16)
+	if s := f.value.(string); s != strings.Repeat("あ", 5) || f.size != 16 {
+		t.Errorf("stringField() = %q (%d bytes), want 5 characters in 16 bytes", s, f.size)
+	}
+}
@@ -0,0 +1,152 @@
+package fit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// FITファイルの基本型
+const (
+	baseEnum   byte = 0x00
+	baseUint16 byte = 0x84
+	baseSint32 byte = 0x85
+	baseUint32 byte = 0x86
+	baseString byte = 0x07
+)
+
+// FITのタイムスタンプの起点（1989-12-31T00:00:00Z）
+var fitEpoch = time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// プロトコル2.0、プロファイル21.00として書き出す
+const (
+	protocolVersion = 0x20
+	profileVersion  = 2100
+	headerSize      = 14
+)
+
+type field struct {
+	num      byte
+	baseType byte
+	value    any // uint8, uint16, int32, uint32, string
+	size     int // stringの場合のバイト数（終端を含む）
+}
+
+// encoder はメッセージを順に書き、最後にヘッダーとCRCを付けてFITファイルにする
+// メッセージの種類ごとにローカルメッセージ番号を割り当て、定義が変わったときだけ定義メッセージを書く
+type encoder struct {
+	buf     bytes.Buffer
+	locals  map[uint16]byte
+	defined map[byte]string
+}
+
+func newEncoder() *encoder {
+	return &encoder{locals: map[uint16]byte{}, defined: map[byte]string{}}
+}
+
+func (e *encoder) write(global uint16, fields []field) {
+	local, ok := e.locals[global]
+	if !ok {
+		local = byte(len(e.locals) % 16)
+		e.locals[global] = local
+	}
+
+	var def bytes.Buffer
+	def.WriteByte(0x40 | local)
+	def.WriteByte(0) // reserved
+	def.WriteByte(0) // リトルエンディアン
+	binary.Write(&def, binary.LittleEndian, global)
+	def.WriteByte(byte(len(fields)))
+	for _, f := range fields {
+		def.WriteByte(f.num)
+		def.WriteByte(byte(fieldSize(f)))
+		def.WriteByte(f.baseType)
+	}
+	if e.defined[local] != def.String() {
+		e.buf.Write(def.Bytes())
+		e.defined[local] = def.String()
+	}
+
+	e.buf.WriteByte(local)
+	for _, f := range fields {
+		switch v := f.value.(type) {
+		case uint8:
+			e.buf.WriteByte(v)
+		case uint16, int32, uint32:
+			binary.Write(&e.buf, binary.LittleEndian, v)
+		case string:
+			b := make([]byte, f.size)
+			copy(b, v)
+			e.buf.Write(b)
+		}
+	}
+}
+
+func (e *encoder) writeTo(w io.Writer) error {
+	header := make([]byte, headerSize)
+	header[0] = headerSize
+	header[1] = protocolVersion
+	binary.LittleEndian.PutUint16(header[2:], profileVersion)
+	binary.LittleEndian.PutUint32(header[4:], uint32(e.buf.Len()))
+	copy(header[8:], ".FIT")
+	binary.LittleEndian.PutUint16(header[12:], crc16(0, header[:12]))
+
+	crc := crc16(crc16(0, header), e.buf.Bytes())
+	trailer := binary.LittleEndian.AppendUint16(nil, crc)
+	for _, b := range [][]byte{header, e.buf.Bytes(), trailer} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fieldSize(f field) int {
+	switch f.value.(type) {
+	case uint8:
+		return 1
+	case uint16:
+		return 2
+	case string:
+		return f.size
+	}
+	return 4
+}
+
+var crcTable = [16]uint16{
+	0x0000, 0xCC01, 0xD801, 0x1400, 0xF001, 0x3C00, 0x2800, 0xE401,
+	0xA001, 0x6C00, 0x7800, 0xB401, 0x5000, 0x9C01, 0x8801, 0x4400,
+}
+
+// crc16 はFITファイルのCRCを求める
+func crc16(crc uint16, data []byte) uint16 {
+	for _, b := range data {
+		tmp := crcTable[crc&0xF]
+		crc = (crc >> 4) & 0x0FFF
+		crc = crc ^ tmp ^ crcTable[b&0xF]
+		tmp = crcTable[crc&0xF]
+		crc = (crc >> 4) & 0x0FFF
+		crc = crc ^ tmp ^ crcTable[(b>>4)&0xF]
+	}
+	return crc
+}
+
+// fitTime は時刻をFITのタイムスタンプに変換する
+func fitTime(t time.Time) uint32 {
+	return uint32(t.Sub(fitEpoch) / time.Second)
+}
+
+// semicircles は緯度・経度をFITの単位に変換する
+func semicircles(deg float64) int32 {
+	return int32(deg * (1 << 31) / 180)
+}
+
+// stringField は終端のNULを含めてmaxBytesに収まるよう、文字の途中で切らずに文字列を切り詰める
+func stringField(num byte, s string, maxBytes int) field {
+	for len(s) > maxBytes-1 {
+		r := []rune(s)
+		s = string(r[:len(r)-1])
+	}
+	return field{num: num, baseType: baseString, value: s, size: len(s) + 1}
+}
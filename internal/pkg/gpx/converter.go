@@ -2,6 +2,7 @@ package gpx
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
 	"github.com/paulmach/orb"
@@ -9,10 +10,23 @@ import (
 	"github.com/tkrajina/gpxgo/gpx"
 )
 
-func RouteToGPX(r *route.Route) (*gpx.GPX, error) {
+// Attribution はフォークしたルートのフォーク元の情報
+type Attribution struct {
+    RouteName  string
+    AuthorName string
+}
+
+// RouteToGPX はルートをGPXに変換する。attributionを指定した場合はメタデータにフォーク元を記載する
+func RouteToGPX(r *route.Route, authorName string, attribution *Attribution) (*gpx.GPX, error) {
     g := gpx.GPX{
-        Version: "1.1",
-        Creator: "rideline",
+        Version:    "1.1",
+        Creator:    "rideline",
+        Name:       r.Name(),
+        AuthorName: authorName,
+    }
+    if attribution != nil {
+        g.Description = fmt.Sprintf("Forked from \"%s\" by %s", attribution.RouteName, attribution.AuthorName)
+        g.Copyright = attribution.AuthorName
     }
 
     rte := gpx.GPXRoute{
@@ -0,0 +1,55 @@
+package gpx
+
+import (
+	"errors"
+
+	"github.com/paulmach/orb"
+	"github.com/tkrajina/gpxgo/gpx"
+)
+
+// TourStage は複数日のツアーの1日分のルート
+type TourStage struct {
+	Name          string
+	Description   string
+	Path          orb.LineString
+	OvernightStop string // 宿泊地。空の場合はウェイポイントを置かない
+}
+
+// TourToGPX はツアーをGPXに変換する。ステージごとに1つのトラックにし、宿泊地はステージの終点のウェイポイントにする
+func TourToGPX(name string, description string, authorName string, stages []TourStage) (*gpx.GPX, error) {
+	g := gpx.GPX{
+		Version:     "1.1",
+		Creator:     "rideline",
+		Name:        name,
+		Description: description,
+		AuthorName:  authorName,
+	}
+
+	for _, s := range stages {
+		if len(s.Path) == 0 {
+			return nil, errors.New("invalid path geometry")
+		}
+		seg := gpx.GPXTrackSegment{}
+		for _, p := range s.Path {
+			seg.Points = append(seg.Points, gpx.GPXPoint{
+				Point: gpx.Point{Latitude: p.Lat(), Longitude: p.Lon()},
+			})
+		}
+		g.Tracks = append(g.Tracks, gpx.GPXTrack{
+			Name:        s.Name,
+			Description: s.Description,
+			Type:        "cycling",
+			Segments:    []gpx.GPXTrackSegment{seg},
+		})
+
+		if s.OvernightStop != "" {
+			last := s.Path[len(s.Path)-1]
+			g.Waypoints = append(g.Waypoints, gpx.GPXPoint{
+				Point:  gpx.Point{Latitude: last.Lat(), Longitude: last.Lon()},
+				Name:   s.OvernightStop,
+				Symbol: "Lodging",
+			})
+		}
+	}
+	return &g, nil
+}
@@ -0,0 +1,68 @@
+package gpx
+
+import (
+	"errors"
+	"time"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+	"github.com/tkrajina/gpxgo/gpx"
+)
+
+// Track はアップロードされたGPXのトラックから求めた経路と計測値
+// 時刻・標高が記録されていないGPXでは、それを使う計測値はnilになる
+type Track struct {
+	Name          string // GPXまたは最初のトラックの名前
+	Path          orb.LineString
+	Distance      float64    // 距離(m)
+	Duration      *int32     // 最初の点から最後の点までの時間(秒)
+	MovingTime    *int32     // 停止していた時間を除いた時間(秒)
+	AvgSpeed      *float64   // 移動中の平均速度(m/s)
+	MaxSpeed      *float64   // 最高速度(m/s)
+	ElevationGain *float64   // 獲得標高(m)
+	ElevationLoss *float64   // 下りの標高(m)
+	DepartedAt    *time.Time // 最初の点の時刻
+}
+
+// ParseTrack はGPXのトラックを読み込む。複数のトラック・セグメントは記録順につなげて1つの経路にする
+func ParseTrack(data []byte) (*Track, error) {
+	g, err := gpx.ParseBytes(data)
+	if err != nil {
+		return nil, errors.New("invalid gpx")
+	}
+
+	t := &Track{Name: g.Name}
+	var hasElevation bool
+	for _, trk := range g.Tracks {
+		if t.Name == "" {
+			t.Name = trk.Name
+		}
+		for _, seg := range trk.Segments {
+			for _, p := range seg.Points {
+				t.Path = append(t.Path, orb.Point{p.Longitude, p.Latitude})
+				hasElevation = hasElevation || p.Elevation.NotNull()
+			}
+		}
+	}
+	if len(t.Path) < 2 {
+		return nil, errors.New("gpx must contain a track with at least 2 points")
+	}
+	t.Distance = geo.Length(t.Path)
+
+	if hasElevation {
+		ud := g.UphillDownhill()
+		t.ElevationGain, t.ElevationLoss = &ud.Uphill, &ud.Downhill
+	}
+
+	if bounds := g.TimeBounds(); !bounds.StartTime.IsZero() && !bounds.EndTime.IsZero() {
+		moving := g.MovingData()
+		t.DepartedAt = &bounds.StartTime
+		t.Duration = new(int32(bounds.EndTime.Sub(bounds.StartTime).Seconds()))
+		t.MovingTime = new(int32(moving.MovingTime))
+		t.MaxSpeed = &moving.MaxSpeed
+		if moving.MovingTime > 0 {
+			t.AvgSpeed = new(moving.MovingDistance / moving.MovingTime)
+		}
+	}
+	return t, nil
+}
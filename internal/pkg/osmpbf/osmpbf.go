@@ -0,0 +1,413 @@
+// Package osmpbf はOpenStreetMapのPBF形式の抽出データを読み込む
+// 道路網の取り込みに必要なノードとウェイだけを扱い、リレーションやメタデータは読み飛ばす
+package osmpbf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ブロックの大きさの上限（PBFの仕様で定められた値）
+const (
+	maxBlobHeaderSize = 64 * 1024
+	maxBlobSize       = 32 * 1024 * 1024
+)
+
+// 対応している必須機能。これ以外を要求するファイルは読み込めない
+var supportedFeatures = map[string]bool{
+	"OsmSchema-V0.6": true,
+	"DenseNodes":     true,
+}
+
+type Node struct {
+	ID   int64
+	Lat  float64
+	Lon  float64
+	Tags map[string]string
+}
+
+type Way struct {
+	ID      int64
+	NodeIDs []int64
+	Tags    map[string]string
+}
+
+// Handler は読み込んだ要素を受け取る。nil の要素は読み飛ばす
+type Handler struct {
+	Node func(Node) error
+	Way  func(Way) error
+}
+
+// Scan はPBFを先頭から読み、要素をファイル内の順に Handler へ渡す
+func Scan(r io.Reader, h Handler) error {
+	for {
+		blobType, blob, err := readBlob(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch blobType {
+		case "OSMHeader":
+			if err := checkHeader(blob); err != nil {
+				return err
+			}
+		case "OSMData":
+			if err := scanPrimitiveBlock(blob, h); err != nil {
+				return err
+			}
+		}
+		// 未知のブロックは仕様に従って読み飛ばす
+	}
+}
+
+// readBlob は長さ・BlobHeader・Blobの組を1つ読み、展開したデータを返す
+func readBlob(r io.Reader) (string, []byte, error) {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		if err == io.EOF {
+			return "", nil, io.EOF
+		}
+		return "", nil, fmt.Errorf("osmpbf: read blob header size: %w", err)
+	}
+	if size > maxBlobHeaderSize {
+		return "", nil, errors.New("osmpbf: blob header is too large")
+	}
+	header := make([]byte, size)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return "", nil, fmt.Errorf("osmpbf: read blob header: %w", err)
+	}
+
+	var blobType string
+	var dataSize int64
+	err := eachField(header, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case 1:
+			blobType = string(v)
+		case 3:
+			dataSize = int64(n)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if dataSize <= 0 || dataSize > maxBlobSize {
+		return "", nil, errors.New("osmpbf: invalid blob size")
+	}
+
+	raw := make([]byte, dataSize)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return "", nil, fmt.Errorf("osmpbf: read blob: %w", err)
+	}
+
+	var data []byte
+	err = eachField(raw, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case 1: // raw
+			data = v
+		case 3: // zlib_data
+			zr, err := zlib.NewReader(bytes.NewReader(v))
+			if err != nil {
+				return fmt.Errorf("osmpbf: %w", err)
+			}
+			defer zr.Close()
+			data, err = io.ReadAll(io.LimitReader(zr, maxBlobSize+1))
+			if err != nil {
+				return fmt.Errorf("osmpbf: %w", err)
+			}
+			if len(data) > maxBlobSize {
+				return errors.New("osmpbf: blob is too large")
+			}
+		case 4, 5, 6, 7: // lzma, bzip2, lz4, zstd
+			return errors.New("osmpbf: unsupported blob compression")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return blobType, data, nil
+}
+
+func checkHeader(data []byte) error {
+	return eachField(data, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		if num == 4 && !supportedFeatures[string(v)] { // required_features
+			return fmt.Errorf("osmpbf: unsupported required feature %q", v)
+		}
+		return nil
+	})
+}
+
+// primitiveBlock はノードの座標を求めるための文字列表と座標の換算値
+type primitiveBlock struct {
+	strings     []string
+	granularity int64
+	latOffset   int64
+	lonOffset   int64
+}
+
+func (b *primitiveBlock) coord(offset, v int64) float64 {
+	return 1e-9 * float64(offset+b.granularity*v)
+}
+
+func (b *primitiveBlock) str(i uint64) (string, error) {
+	if i >= uint64(len(b.strings)) {
+		return "", errors.New("osmpbf: string index out of range")
+	}
+	return b.strings[i], nil
+}
+
+func scanPrimitiveBlock(data []byte, h Handler) error {
+	block := &primitiveBlock{granularity: 100}
+	var groups [][]byte
+	err := eachField(data, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case 1: // stringtable
+			return eachField(v, func(num protowire.Number, typ protowire.Type, s []byte, n uint64) error {
+				if num == 1 {
+					block.strings = append(block.strings, string(s))
+				}
+				return nil
+			})
+		case 2:
+			groups = append(groups, v)
+		case 17:
+			block.granularity = int64(n)
+		case 19:
+			block.latOffset = int64(n)
+		case 20:
+			block.lonOffset = int64(n)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 文字列表と座標の換算値がグループより後ろにあってもよいよう、ブロック全体を読んでから処理する
+	for _, group := range groups {
+		err := eachField(group, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+			switch {
+			case num == 1 && h.Node != nil:
+				return block.scanNode(v, h.Node)
+			case num == 2 && h.Node != nil:
+				return block.scanDenseNodes(v, h.Node)
+			case num == 3 && h.Way != nil:
+				return block.scanWay(v, h.Way)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *primitiveBlock) scanNode(data []byte, fn func(Node) error) error {
+	var id, lat, lon int64
+	var keys, vals []uint64
+	err := eachField(data, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		var err error
+		switch num {
+		case 1:
+			id = protowire.DecodeZigZag(n)
+		case 2:
+			keys, err = appendVarints(keys, typ, v, n)
+		case 3:
+			vals, err = appendVarints(vals, typ, v, n)
+		case 8:
+			lat = protowire.DecodeZigZag(n)
+		case 9:
+			lon = protowire.DecodeZigZag(n)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	tags, err := b.tags(keys, vals)
+	if err != nil {
+		return err
+	}
+	return fn(Node{ID: id, Lat: b.coord(b.latOffset, lat), Lon: b.coord(b.lonOffset, lon), Tags: tags})
+}
+
+func (b *primitiveBlock) scanDenseNodes(data []byte, fn func(Node) error) error {
+	var ids, lats, lons, keysVals []uint64
+	err := eachField(data, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		var err error
+		switch num {
+		case 1:
+			ids, err = appendVarints(ids, typ, v, n)
+		case 8:
+			lats, err = appendVarints(lats, typ, v, n)
+		case 9:
+			lons, err = appendVarints(lons, typ, v, n)
+		case 10:
+			keysVals, err = appendVarints(keysVals, typ, v, n)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if len(lats) != len(ids) || len(lons) != len(ids) {
+		return errors.New("osmpbf: dense nodes have mismatched lengths")
+	}
+
+	// ID・緯度・経度は前のノードとの差分で格納されている
+	var id, lat, lon int64
+	kv := 0
+	for i := range ids {
+		id += protowire.DecodeZigZag(ids[i])
+		lat += protowire.DecodeZigZag(lats[i])
+		lon += protowire.DecodeZigZag(lons[i])
+
+		// タグはノードごとにキー・値を並べ、0で区切る
+		var tags map[string]string
+		for kv < len(keysVals) && keysVals[kv] != 0 {
+			if kv+1 >= len(keysVals) {
+				return errors.New("osmpbf: dense node tags are truncated")
+			}
+			k, err := b.str(keysVals[kv])
+			if err != nil {
+				return err
+			}
+			v, err := b.str(keysVals[kv+1])
+			if err != nil {
+				return err
+			}
+			if tags == nil {
+				tags = map[string]string{}
+			}
+			tags[k] = v
+			kv += 2
+		}
+		kv++
+
+		if err := fn(Node{ID: id, Lat: b.coord(b.latOffset, lat), Lon: b.coord(b.lonOffset, lon), Tags: tags}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *primitiveBlock) scanWay(data []byte, fn func(Way) error) error {
+	var id int64
+	var keys, vals, refs []uint64
+	err := eachField(data, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		var err error
+		switch num {
+		case 1:
+			id = int64(n)
+		case 2:
+			keys, err = appendVarints(keys, typ, v, n)
+		case 3:
+			vals, err = appendVarints(vals, typ, v, n)
+		case 8:
+			refs, err = appendVarints(refs, typ, v, n)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	tags, err := b.tags(keys, vals)
+	if err != nil {
+		return err
+	}
+
+	nodeIDs := make([]int64, len(refs))
+	var ref int64
+	for i, delta := range refs {
+		ref += protowire.DecodeZigZag(delta)
+		nodeIDs[i] = ref
+	}
+	return fn(Way{ID: id, NodeIDs: nodeIDs, Tags: tags})
+}
+
+func (b *primitiveBlock) tags(keys, vals []uint64) (map[string]string, error) {
+	if len(keys) != len(vals) {
+		return nil, errors.New("osmpbf: tags have mismatched lengths")
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	tags := make(map[string]string, len(keys))
+	for i := range keys {
+		k, err := b.str(keys[i])
+		if err != nil {
+			return nil, err
+		}
+		v, err := b.str(vals[i])
+		if err != nil {
+			return nil, err
+		}
+		tags[k] = v
+	}
+	return tags, nil
+}
+
+// eachField はメッセージのフィールドを順に渡す
+// 可変長整数と固定長のフィールドは n に、長さ付きのフィールドは v に値が入る
+func eachField(data []byte, fn func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error) error {
+	for len(data) > 0 {
+		num, typ, l := protowire.ConsumeTag(data)
+		if l < 0 {
+			return fmt.Errorf("osmpbf: %w", protowire.ParseError(l))
+		}
+		data = data[l:]
+
+		var v []byte
+		var n uint64
+		switch typ {
+		case protowire.VarintType:
+			n, l = protowire.ConsumeVarint(data)
+		case protowire.Fixed32Type:
+			var n32 uint32
+			n32, l = protowire.ConsumeFixed32(data)
+			n = uint64(n32)
+		case protowire.Fixed64Type:
+			n, l = protowire.ConsumeFixed64(data)
+		case protowire.BytesType:
+			v, l = protowire.ConsumeBytes(data)
+		default:
+			l = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if l < 0 {
+			return fmt.Errorf("osmpbf: %w", protowire.ParseError(l))
+		}
+		data = data[l:]
+
+		if err := fn(num, typ, v, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendVarints は繰り返しの整数フィールドを読む。packedでもそうでなくてもよい
+func appendVarints(dst []uint64, typ protowire.Type, v []byte, n uint64) ([]uint64, error) {
+	if typ == protowire.VarintType {
+		return append(dst, n), nil
+	}
+	for len(v) > 0 {
+		x, l := protowire.ConsumeVarint(v)
+		if l < 0 {
+			return nil, fmt.Errorf("osmpbf: %w", protowire.ParseError(l))
+		}
+		dst = append(dst, x)
+		v = v[l:]
+	}
+	return dst, nil
+}
@@ -0,0 +1,84 @@
+package osmpbf
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"testing"
+)
+
+// 3x3の格子状の道路網（infrastructure/fixtures/osm）
+const fixturePath = "../../infrastructure/fixtures/osm/grid.osm.pbf"
+
+func TestScan(t *testing.T) {
+	f, err := os.Open(fixturePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	nodes := map[int64]Node{}
+	ways := map[int64]Way{}
+	err = Scan(f, Handler{
+		Node: func(n Node) error {
+			nodes[n.ID] = n
+			return nil
+		},
+		Way: func(w Way) error {
+			ways[w.ID] = w
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	if len(nodes) != 11 || len(ways) != 9 {
+		t.Fatalf("Scan() = %d nodes, %d ways, want 11, 9", len(nodes), len(ways))
+	}
+	n9 := nodes[9]
+	if math.Abs(n9.Lat-35.684) > 1e-7 || math.Abs(n9.Lon-139.704) > 1e-7 || n9.Tags["ele"] != "40" {
+		t.Errorf("nodes[9] = %+v", n9)
+	}
+	if nodes[5].Tags != nil {
+		t.Errorf("nodes[5].Tags = %v, want nil", nodes[5].Tags)
+	}
+	w := ways[102]
+	if len(w.NodeIDs) != 3 || w.NodeIDs[0] != 4 || w.NodeIDs[2] != 6 || w.Tags["name"] != "靖国通り" {
+		t.Errorf("ways[102] = %+v", w)
+	}
+}
+
+func TestScan_SkipNodes(t *testing.T) {
+	data, err := os.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count := 0
+	err = Scan(bytes.NewReader(data), Handler{
+		Way: func(w Way) error {
+			count++
+			return nil
+		},
+	})
+	if err != nil || count != 9 {
+		t.Errorf("Scan() = %d ways, %v, want 9", count, err)
+	}
+}
+
+func TestScan_Invalid(t *testing.T) {
+	data, err := os.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 途中で切れたファイル
+	if err := Scan(bytes.NewReader(data[:len(data)-10]), Handler{}); err == nil {
+		t.Error("Scan() should return error for truncated file")
+	}
+	// 空のファイルは要素がないだけ
+	if err := Scan(bytes.NewReader(nil), Handler{}); err != nil {
+		t.Errorf("Scan() error = %v for empty file", err)
+	}
+}
@@ -0,0 +1,119 @@
+// Package osmpoi はOpenStreetMapの抽出データから経路沿いで立ち寄れるPOIを取り出す
+// 点（ノード）で描かれたPOIはその位置を、建物などの範囲（ウェイ）で描かれたPOIはその中心を位置とする
+package osmpoi
+
+import (
+	"os"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/poi"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/osmpbf"
+	"github.com/paulmach/orb"
+)
+
+// LoadFile はPBFの抽出データからPOIを取り出す
+// ノードをすべてメモリに載せないよう、1回目にウェイ、2回目にノードを読む
+func LoadFile(path string) ([]*poi.POI, error) {
+	e := NewExtractor()
+	if err := scanFile(path, osmpbf.Handler{Way: e.AddWay}); err != nil {
+		return nil, err
+	}
+	if err := scanFile(path, osmpbf.Handler{Node: e.AddNode}); err != nil {
+		return nil, err
+	}
+	return e.POIs()
+}
+
+func scanFile(path string, h osmpbf.Handler) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return osmpbf.Scan(f, h)
+}
+
+// Extractor はウェイとノードを受け取り、POIを取り出す
+// ノードより先にすべてのウェイを渡す必要がある
+type Extractor struct {
+	nodePOIs []*poi.POI
+	ways     []osmpbf.Way
+	wanted   map[int64]bool      // POIのウェイが使うノード
+	points   map[int64]orb.Point // wanted のノードの位置
+}
+
+func NewExtractor() *Extractor {
+	return &Extractor{
+		wanted: map[int64]bool{},
+		points: map[int64]orb.Point{},
+	}
+}
+
+// AddWay はPOIのタグを持つウェイだけを残す
+func (e *Extractor) AddWay(w osmpbf.Way) error {
+	if len(w.NodeIDs) == 0 {
+		return nil
+	}
+	if _, ok := poi.CategoryFromTags(w.Tags); !ok {
+		return nil
+	}
+	e.ways = append(e.ways, w)
+	for _, id := range w.NodeIDs {
+		e.wanted[id] = true
+	}
+	return nil
+}
+
+// AddNode はPOIのタグを持つノードと、残したウェイが使うノードの位置を記録する
+func (e *Extractor) AddNode(n osmpbf.Node) error {
+	p := orb.Point{n.Lon, n.Lat}
+	if e.wanted[n.ID] {
+		e.points[n.ID] = p
+	}
+	category, ok := poi.CategoryFromTags(n.Tags)
+	if !ok {
+		return nil
+	}
+	created, err := poi.NewPOI(poi.OSMTypeNode, n.ID, category, n.Tags["name"], p)
+	if err != nil {
+		return err
+	}
+	e.nodePOIs = append(e.nodePOIs, created)
+	return nil
+}
+
+// POIs は取り出したPOIを返す
+// 抽出範囲の外にあって位置が分からないノードは、ウェイの中心を求めるときに使わない
+func (e *Extractor) POIs() ([]*poi.POI, error) {
+	pois := append([]*poi.POI{}, e.nodePOIs...)
+	for _, w := range e.ways {
+		bound, ok := e.bound(w.NodeIDs)
+		if !ok {
+			continue
+		}
+		category, _ := poi.CategoryFromTags(w.Tags)
+		created, err := poi.NewPOI(poi.OSMTypeWay, w.ID, category, w.Tags["name"], bound.Center())
+		if err != nil {
+			return nil, err
+		}
+		pois = append(pois, created)
+	}
+	return pois, nil
+}
+
+func (e *Extractor) bound(nodeIDs []int64) (orb.Bound, bool) {
+	var bound orb.Bound
+	found := false
+	for _, id := range nodeIDs {
+		p, ok := e.points[id]
+		if !ok {
+			continue
+		}
+		if !found {
+			bound = p.Bound()
+			found = true
+			continue
+		}
+		bound = bound.Extend(p)
+	}
+	return bound, found
+}
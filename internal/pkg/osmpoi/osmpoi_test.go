@@ -0,0 +1,67 @@
+package osmpoi
+
+import (
+	"math"
+	"testing"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/poi"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/osmpbf"
+	"github.com/paulmach/orb"
+)
+
+// 格子状の道路網（grid.osm.pbf）の周辺にあるPOI
+// カフェ・コンビニ・水飲み場・展望地のノードと、建物で描かれた自転車店がある
+const fixturePath = "../../infrastructure/fixtures/osm/pois.osm.pbf"
+
+func TestLoadFile(t *testing.T) {
+	pois, err := LoadFile(fixturePath)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+
+	// 対象外のレストランと関係者以外使えないトイレは含めない
+	byID := map[int64]*poi.POI{}
+	for _, p := range pois {
+		byID[p.OSMID()] = p
+	}
+	if len(pois) != 5 || byID[204] != nil || byID[206] != nil {
+		t.Fatalf("LoadFile() = %d pois, want 5 without 204, 206", len(pois))
+	}
+
+	cafe := byID[201]
+	if cafe.Category() != poi.CategoryCafe || cafe.Name() != "喫茶ひなた" || cafe.OSMType() != poi.OSMTypeNode {
+		t.Errorf("pois[201] = %+v", cafe)
+	}
+	if math.Abs(cafe.Location().Lat()-35.6821) > 1e-7 || math.Abs(cafe.Location().Lon()-139.7001) > 1e-7 {
+		t.Errorf("pois[201] location = %v", cafe.Location())
+	}
+
+	// 建物の範囲で描かれたPOIは中心を位置にする
+	shop := byID[301]
+	if shop == nil || shop.Category() != poi.CategoryBicycleShop || shop.OSMType() != poi.OSMTypeWay {
+		t.Fatalf("pois[301] = %+v", shop)
+	}
+	if math.Abs(shop.Location().Lat()-35.6804) > 1e-7 || math.Abs(shop.Location().Lon()-139.7010) > 1e-7 {
+		t.Errorf("pois[301] location = %v, want center of building", shop.Location())
+	}
+}
+
+func TestExtractor_MissingNodes(t *testing.T) {
+	e := NewExtractor()
+	_ = e.AddWay(osmpbf.Way{ID: 1, NodeIDs: []int64{1, 2, 3}, Tags: map[string]string{"amenity": "cafe"}})
+	_ = e.AddWay(osmpbf.Way{ID: 2, NodeIDs: []int64{4, 5}, Tags: map[string]string{"shop": "bicycle"}})
+	_ = e.AddNode(osmpbf.Node{ID: 1, Lat: 35.680, Lon: 139.700})
+	_ = e.AddNode(osmpbf.Node{ID: 3, Lat: 35.682, Lon: 139.702})
+
+	pois, err := e.POIs()
+	if err != nil {
+		t.Fatalf("POIs() error = %v", err)
+	}
+	// 位置の分かるノードだけで中心を求め、1つも分からないウェイは含めない
+	if len(pois) != 1 || pois[0].OSMID() != 1 {
+		t.Fatalf("POIs() = %+v, want way 1 only", pois)
+	}
+	if got := pois[0].Location(); math.Abs(got.Lat()-35.681) > 1e-9 || math.Abs(got.Lon()-139.701) > 1e-9 {
+		t.Errorf("location = %v, want %v", got, orb.Point{139.701, 35.681})
+	}
+}
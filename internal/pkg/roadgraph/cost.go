@@ -0,0 +1,126 @@
+package roadgraph
+
+import (
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+)
+
+// CostFunc は区間を通るコスト（平地の舗装路に換算した距離(m)）を返す
+// 上りと下りで変わるため、Target→Sourceの向きに通るときは reverse が true になる
+type CostFunc func(e *Edge, reverse bool) float64
+
+// コストの係数
+const (
+	bikeLaneFactor    = 0.85 // 自転車レーンのある道路は走りやすい
+	unpavedRoadFactor = 3.0  // 舗装路を優先する条件での未舗装路
+	gravelFactor      = 0.9  // gravelの条件では未舗装路を少し優先する
+	roughFactor       = 1.5  // 石畳などの荒れた舗装
+	climbPenalty      = 10.0 // 上り1mを平地の何mに換算するか
+)
+
+// 道路の種類ごとの係数。ここにない種類（高速道路や階段など）は自転車で通れないものとして取り込まない
+var highwayFactors = map[string]float64{
+	"cycleway":       0.8,
+	"residential":    1.0,
+	"living_street":  1.0,
+	"unclassified":   1.0,
+	"tertiary":       1.0,
+	"tertiary_link":  1.0,
+	"secondary":      1.1,
+	"secondary_link": 1.1,
+	"primary":        1.3,
+	"primary_link":   1.3,
+	"trunk":          2.0,
+	"trunk_link":     2.0,
+	"service":        1.2,
+	"road":           1.2,
+	"track":          1.2,
+	"path":           1.2,
+	"footway":        2.5, // 押して歩く
+	"pedestrian":     2.5,
+}
+
+// avoid_highwaysの条件で幹線道路に追加でかける係数
+var arterialFactors = map[string]float64{
+	"trunk":          3.0,
+	"trunk_link":     3.0,
+	"primary":        2.5,
+	"primary_link":   2.5,
+	"secondary":      1.5,
+	"secondary_link": 1.5,
+}
+
+// 舗装の種類。surfaceタグがない場合は道路の種類から推測する
+var unpavedSurfaces = map[string]bool{
+	"unpaved": true, "gravel": true, "fine_gravel": true, "compacted": true, "dirt": true, "earth": true,
+	"ground": true, "grass": true, "sand": true, "mud": true, "pebblestone": true, "rock": true, "woodchips": true,
+}
+
+var roughSurfaces = map[string]bool{
+	"sett": true, "cobblestone": true, "unhewn_cobblestone": true, "grass_paver": true,
+}
+
+// IsUnpaved は未舗装の区間かどうかを判定する
+func (e *Edge) IsUnpaved() bool {
+	if e.Surface == "" {
+		return e.Highway == "track" || e.Highway == "path"
+	}
+	return unpavedSurfaces[e.Surface]
+}
+
+// Climb は区間を通るときの上り(m)を返す
+func (e *Edge) Climb(reverse bool) float64 {
+	if reverse {
+		return e.ElevationLoss
+	}
+	return e.ElevationGain
+}
+
+// BicycleCost はルート探索の条件ごとのコストを返す
+// 道路の種類・舗装・自転車レーンの有無で距離に係数をかけ、上りの分を足す
+func BicycleCost(profile route.RoutingProfile) CostFunc {
+	return func(e *Edge, reverse bool) float64 {
+		factor := highwayFactors[e.Highway]
+		if factor == 0 {
+			factor = 1
+		}
+		if profile == route.RoutingProfileAvoidHighways {
+			if f, ok := arterialFactors[e.Highway]; ok {
+				factor *= f
+			}
+		}
+
+		switch {
+		case e.IsUnpaved() && profile == route.RoutingProfileGravel:
+			factor *= gravelFactor
+		case e.IsUnpaved():
+			factor *= unpavedRoadFactor
+		case roughSurfaces[e.Surface]:
+			factor *= roughFactor
+		}
+		if e.BikeLane && e.Highway != "cycleway" {
+			factor *= bikeLaneFactor
+		}
+
+		return e.Length*factor + e.Climb(reverse)*climbPenalty
+	}
+}
+
+// 所要時間の見積もりに使う速度(m/s)
+const (
+	pavedSpeed   = 20.0 * 1000 / 3600
+	unpavedSpeed = 14.0 * 1000 / 3600
+	walkSpeed    = 5.0 * 1000 / 3600 // 歩道を押して歩く
+	climbSeconds = 6.0               // 上り1mにかかる秒数
+)
+
+// Duration は区間を通る所要時間(秒)を見積もる
+func (e *Edge) Duration(reverse bool) float64 {
+	speed := pavedSpeed
+	switch {
+	case e.Highway == "footway" || e.Highway == "pedestrian":
+		speed = walkSpeed
+	case e.IsUnpaved():
+		speed = unpavedSpeed
+	}
+	return e.Length/speed + e.Climb(reverse)*climbSeconds
+}
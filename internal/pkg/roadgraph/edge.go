@@ -0,0 +1,282 @@
+// Package roadgraph はOpenStreetMapの道路網から自転車用の経路を探索する
+// 抽出データを交差点で区切った区間（Edge）に分け、区間をつないだグラフをA*で探索する
+package roadgraph
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/osmpbf"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// Edge は交差点と交差点（または行き止まり）を結ぶ道路の区間
+type Edge struct {
+	ID            int64 // 保存後のID。取り込み前は0
+	OSMWayID      int64
+	Source        int64 // 始点のOSMノードID
+	Target        int64 // 終点のOSMノードID
+	Name          string
+	Highway       string
+	Surface       string
+	BikeLane      bool
+	Oneway        bool    // Source→Targetの向きにしか通れない
+	Length        float64 // 長さ(m)
+	ElevationGain float64 // Source→Targetの向きに進んだときの上り(m)
+	ElevationLoss float64 // Source→Targetの向きに進んだときの下り(m)
+	Geometry      orb.LineString
+}
+
+// 標高がタグで分からず、inclineに向きだけが書かれている場合の勾配
+const defaultIncline = 0.05
+
+// 自転車レーンとみなすcyclewayタグの値
+var bikeLaneValues = map[string]bool{
+	"lane":           true,
+	"track":          true,
+	"shared_lane":    true,
+	"share_busway":   true,
+	"opposite_lane":  true,
+	"opposite_track": true,
+}
+
+// LoadFile はPBFの抽出データから自転車で通れる道路の区間を作る
+// ノードをすべてメモリに載せないよう、1回目にウェイ、2回目に使われているノードだけを読む
+func LoadFile(path string) ([]Edge, error) {
+	b := NewBuilder()
+	if err := scanFile(path, osmpbf.Handler{Way: b.AddWay}); err != nil {
+		return nil, err
+	}
+	if err := scanFile(path, osmpbf.Handler{Node: b.AddNode}); err != nil {
+		return nil, err
+	}
+	return b.Edges(), nil
+}
+
+func scanFile(path string, h osmpbf.Handler) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return osmpbf.Scan(f, h)
+}
+
+// Builder はウェイとノードを受け取り、交差点で区切った区間を作る
+// ノードより先にすべてのウェイを渡す必要がある
+type Builder struct {
+	ways     []osmpbf.Way
+	useCount map[int64]int // ノードを使っているウェイの数
+	nodes    map[int64]builderNode
+}
+
+type builderNode struct {
+	point     orb.Point
+	elevation *float64
+}
+
+func NewBuilder() *Builder {
+	return &Builder{
+		useCount: map[int64]int{},
+		nodes:    map[int64]builderNode{},
+	}
+}
+
+// AddWay は自転車で通れる道路のウェイだけを残す
+func (b *Builder) AddWay(w osmpbf.Way) error {
+	if len(w.NodeIDs) < 2 || !isRoutable(w.Tags) {
+		return nil
+	}
+	// 逆向きの一方通行は向きをそろえて保存する
+	if onewayDirection(w.Tags) < 0 {
+		reversed := make([]int64, len(w.NodeIDs))
+		for i, id := range w.NodeIDs {
+			reversed[len(reversed)-1-i] = id
+		}
+		w.NodeIDs = reversed
+	}
+
+	b.ways = append(b.ways, w)
+	for i, id := range w.NodeIDs {
+		b.useCount[id]++
+		// 端点は他のウェイとつながっていなくても区切る
+		if i == 0 || i == len(w.NodeIDs)-1 {
+			b.useCount[id]++
+		}
+	}
+	return nil
+}
+
+// AddNode は残したウェイが使うノードの位置と標高を記録する
+func (b *Builder) AddNode(n osmpbf.Node) error {
+	if b.useCount[n.ID] == 0 {
+		return nil
+	}
+	b.nodes[n.ID] = builderNode{point: orb.Point{n.Lon, n.Lat}, elevation: parseElevation(n.Tags["ele"])}
+	return nil
+}
+
+// Edges はウェイを交差点で区切った区間を返す
+// 抽出範囲の外にあって位置が分からないノードの前後では区間を切る
+func (b *Builder) Edges() []Edge {
+	edges := []Edge{}
+	for _, w := range b.ways {
+		start := -1
+		for i, id := range w.NodeIDs {
+			if _, ok := b.nodes[id]; !ok {
+				if start >= 0 && i-1 > start {
+					edges = b.appendEdge(edges, w, w.NodeIDs[start:i])
+				}
+				start = -1
+				continue
+			}
+			if start < 0 {
+				start = i
+				continue
+			}
+			if i == len(w.NodeIDs)-1 || b.useCount[id] >= 2 {
+				edges = b.appendEdge(edges, w, w.NodeIDs[start:i+1])
+				start = i
+			}
+		}
+	}
+	return edges
+}
+
+func (b *Builder) appendEdge(edges []Edge, w osmpbf.Way, nodeIDs []int64) []Edge {
+	geom := make(orb.LineString, len(nodeIDs))
+	for i, id := range nodeIDs {
+		geom[i] = b.nodes[id].point
+	}
+	e := Edge{
+		OSMWayID: w.ID,
+		Source:   nodeIDs[0],
+		Target:   nodeIDs[len(nodeIDs)-1],
+		Name:     w.Tags["name"],
+		Highway:  w.Tags["highway"],
+		Surface:  w.Tags["surface"],
+		BikeLane: hasBikeLane(w.Tags),
+		Oneway:   onewayDirection(w.Tags) != 0,
+		Length:   geo.Length(geom),
+		Geometry: geom,
+	}
+	e.ElevationGain, e.ElevationLoss = b.elevationChange(nodeIDs, w.Tags, e.Length)
+	return append(edges, e)
+}
+
+// elevationChange はノードの標高（eleタグ）から区間の上り下りを求める
+// 標高が分からない場合はウェイのinclineタグの勾配から見積もる
+func (b *Builder) elevationChange(nodeIDs []int64, tags map[string]string, length float64) (gain, loss float64) {
+	var prev *float64
+	known := 0
+	for _, id := range nodeIDs {
+		ele := b.nodes[id].elevation
+		if ele == nil {
+			continue
+		}
+		if prev != nil {
+			if d := *ele - *prev; d > 0 {
+				gain += d
+			} else {
+				loss -= d
+			}
+		}
+		prev = ele
+		known++
+	}
+	if known >= 2 {
+		return gain, loss
+	}
+
+	grade := parseIncline(tags["incline"])
+	if onewayDirection(tags) < 0 {
+		grade = -grade
+	}
+	if grade > 0 {
+		return grade * length, 0
+	}
+	return 0, -grade * length
+}
+
+// isRoutable は自転車で通れる道路かどうかを判定する
+func isRoutable(tags map[string]string) bool {
+	if _, ok := highwayFactors[tags["highway"]]; !ok {
+		return false
+	}
+	if tags["area"] == "yes" {
+		return false
+	}
+	switch tags["bicycle"] {
+	case "no", "use_sidepath":
+		return false
+	case "yes", "designated", "permissive", "dismount":
+		return true
+	}
+	switch tags["access"] {
+	case "no", "private":
+		return false
+	}
+	return true
+}
+
+// onewayDirection はウェイの向きに一方通行なら1、逆向きなら-1、両方向に通れるなら0を返す
+func onewayDirection(tags map[string]string) int {
+	if tags["oneway:bicycle"] == "no" || strings.HasPrefix(tags["cycleway"], "opposite") {
+		return 0
+	}
+	switch tags["oneway"] {
+	case "yes", "1", "true":
+		return 1
+	case "-1", "reverse":
+		return -1
+	case "no":
+		return 0
+	}
+	if tags["junction"] == "roundabout" {
+		return 1
+	}
+	return 0
+}
+
+func hasBikeLane(tags map[string]string) bool {
+	if tags["highway"] == "cycleway" || tags["bicycle"] == "designated" {
+		return true
+	}
+	for _, key := range []string{"cycleway", "cycleway:left", "cycleway:right", "cycleway:both"} {
+		if bikeLaneValues[tags[key]] {
+			return true
+		}
+	}
+	return false
+}
+
+// parseElevation はeleタグ（"12"、"12.5 m"など）を解釈する
+func parseElevation(s string) *float64 {
+	s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "m"))
+	if s == "" {
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+// parseIncline はinclineタグ（"5%"、"-8%"、"up"、"down"）を勾配にする
+func parseIncline(s string) float64 {
+	switch s = strings.TrimSpace(s); s {
+	case "up":
+		return defaultIncline
+	case "down":
+		return -defaultIncline
+	}
+	if v, ok := strings.CutSuffix(s, "%"); ok {
+		if grade, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return grade / 100
+		}
+	}
+	return 0
+}
@@ -0,0 +1,170 @@
+package roadgraph
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// Graph は区間を交差点でつないだ有向グラフ
+type Graph struct {
+	edges []Edge
+	nodes map[int64]orb.Point
+	arcs  map[int64][]arc // ノードから出ていく向き
+}
+
+type arc struct {
+	edge    int
+	reverse bool
+	to      int64
+}
+
+// Step は経路の1区間と通る向き
+type Step struct {
+	Edge    *Edge
+	Reverse bool // Target→Sourceの向きに通る
+}
+
+// Geometry は通る向きにそろえた区間の形状を返す
+func (s Step) Geometry() orb.LineString {
+	if !s.Reverse {
+		return s.Edge.Geometry
+	}
+	reversed := make(orb.LineString, len(s.Edge.Geometry))
+	for i, p := range s.Edge.Geometry {
+		reversed[len(reversed)-1-i] = p
+	}
+	return reversed
+}
+
+func NewGraph(edges []Edge) *Graph {
+	g := &Graph{
+		edges: edges,
+		nodes: map[int64]orb.Point{},
+		arcs:  map[int64][]arc{},
+	}
+	for i, e := range edges {
+		if len(e.Geometry) < 2 {
+			continue
+		}
+		g.nodes[e.Source] = e.Geometry[0]
+		g.nodes[e.Target] = e.Geometry[len(e.Geometry)-1]
+		g.arcs[e.Source] = append(g.arcs[e.Source], arc{edge: i, to: e.Target})
+		if !e.Oneway {
+			g.arcs[e.Target] = append(g.arcs[e.Target], arc{edge: i, reverse: true, to: e.Source})
+		}
+	}
+	return g
+}
+
+// NearestNode は p に最も近いノードとその距離(m)を返す
+func (g *Graph) NearestNode(p orb.Point) (int64, float64, bool) {
+	var nearest int64
+	best := math.Inf(1)
+	for id, q := range g.nodes {
+		// 同じ距離のノードがあっても結果が変わらないよう、IDの小さい方を選ぶ
+		if d := geo.Distance(p, q); d < best || (d == best && id < nearest) {
+			nearest, best = id, d
+		}
+	}
+	return nearest, best, !math.IsInf(best, 1)
+}
+
+// ShortestPath は from から to までのコストが最小の経路をA*で探索する
+// 見つからない場合はfalseを返す
+func (g *Graph) ShortestPath(from, to int64, cost CostFunc) ([]Step, bool) {
+	goal, ok := g.nodes[to]
+	if !ok {
+		return nil, false
+	}
+	if _, ok := g.nodes[from]; !ok {
+		return nil, false
+	}
+
+	// 直線距離に最小の係数をかけたものを残りのコストの下限にする
+	minFactor := g.minCostFactor(cost)
+	heuristic := func(id int64) float64 {
+		return geo.Distance(g.nodes[id], goal) * minFactor
+	}
+
+	best := map[int64]float64{from: 0}
+	prev := map[int64]arc{}
+	open := &nodeQueue{{id: from, priority: heuristic(from)}}
+	for open.Len() > 0 {
+		current := heap.Pop(open).(queueItem)
+		if current.id == to {
+			break
+		}
+		if current.priority > best[current.id]+heuristic(current.id) {
+			continue // 既により安い経路で訪れている
+		}
+		for _, a := range g.arcs[current.id] {
+			c := best[current.id] + cost(&g.edges[a.edge], a.reverse)
+			if known, ok := best[a.to]; ok && known <= c {
+				continue
+			}
+			best[a.to] = c
+			prev[a.to] = a
+			heap.Push(open, queueItem{id: a.to, priority: c + heuristic(a.to)})
+		}
+	}
+
+	if _, ok := best[to]; !ok {
+		return nil, false
+	}
+	steps := []Step{}
+	for id := to; id != from; {
+		a := prev[id]
+		e := &g.edges[a.edge]
+		steps = append(steps, Step{Edge: e, Reverse: a.reverse})
+		if a.reverse {
+			id = e.Target
+		} else {
+			id = e.Source
+		}
+	}
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+	return steps, true
+}
+
+// minCostFactor は長さあたりのコストの最小値を求める
+func (g *Graph) minCostFactor(cost CostFunc) float64 {
+	minFactor := math.Inf(1)
+	for i := range g.edges {
+		e := &g.edges[i]
+		if e.Length <= 0 {
+			continue
+		}
+		minFactor = min(minFactor, cost(e, false)/e.Length)
+		if !e.Oneway {
+			minFactor = min(minFactor, cost(e, true)/e.Length)
+		}
+	}
+	if math.IsInf(minFactor, 1) {
+		return 0
+	}
+	return minFactor
+}
+
+type queueItem struct {
+	id       int64
+	priority float64
+}
+
+// nodeQueue は優先度の低い順に取り出すヒープ
+type nodeQueue []queueItem
+
+func (q nodeQueue) Len() int           { return len(q) }
+func (q nodeQueue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q nodeQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *nodeQueue) Push(x any)        { *q = append(*q, x.(queueItem)) }
+func (q *nodeQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,476 @@
+package roadgraph
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// 地図照合（隠れマルコフモデル、Newson & Krumm 2009）のパラメータ
+const (
+	matchSearchRadius     = 50.0  // GPSの点からこの距離(m)以内の道路を候補にする
+	matchMaxCandidates    = 8     // 1点あたりの候補の数
+	matchGPSSigma         = 10.0  // スマートフォンのGPSの誤差の標準偏差(m)
+	matchBeta             = 10.0  // 直線距離と道のりの差をどこまで許すか(m)
+	matchMaxDetour        = 500.0 // 直線距離の2倍にこれを足した道のりより遠回りの経路は探さない(m)
+	matchMaxSkips         = 5     // 前の点からつながらない点をこの数まで外れ値として読み飛ばす
+	matchIndexCellSize    = 0.005 // 候補を探すための格子の大きさ(度)
+	matchMinSegmentLength = 0.1   // これより短い部分は経路に含めない(m)
+)
+
+// Segment は区間の一部または全体を通る経路の一部分
+type Segment struct {
+	Edge     *Edge
+	Reverse  bool           // Target→Sourceの向きに通る
+	Geometry orb.LineString // 通る向きにそろえた形状
+	Length   float64        // 長さ(m)
+}
+
+// Segment は区間の全体を通る部分にする
+func (s Step) Segment() Segment {
+	return Segment{Edge: s.Edge, Reverse: s.Reverse, Geometry: s.Geometry(), Length: s.Edge.Length}
+}
+
+// Duration は通る部分の長さで区間の所要時間を按分する
+func (s Segment) Duration() float64 {
+	if s.Edge.Length <= 0 {
+		return 0
+	}
+	return s.Edge.Duration(s.Reverse) * s.Length / s.Edge.Length
+}
+
+// MatchResult は軌跡を道路網に照合した結果
+type MatchResult struct {
+	Segments      []Segment
+	MatchedPoints int // 道路に照合できたGPSの点の数（間引いた後）
+	TotalPoints   int
+}
+
+// Geometry は照合した経路の形状を返す
+// 途中で道路網からつながらなくなった箇所は、前後の照合結果を直線で結ぶ
+func (r *MatchResult) Geometry() orb.LineString {
+	path := orb.LineString{}
+	for _, s := range r.Segments {
+		for _, p := range s.Geometry {
+			if len(path) == 0 || path[len(path)-1] != p {
+				path = append(path, p)
+			}
+		}
+	}
+	return path
+}
+
+// Matcher はGPSの軌跡を道路網に照合する
+type Matcher struct {
+	graph *Graph
+	cells map[[2]int][]int // 格子ごとの区間
+}
+
+func NewMatcher(g *Graph) *Matcher {
+	m := &Matcher{graph: g, cells: map[[2]int][]int{}}
+	for i, e := range g.edges {
+		b := e.Geometry.Bound()
+		minCell, maxCell := cellOf(b.Min), cellOf(b.Max)
+		for x := minCell[0]; x <= maxCell[0]; x++ {
+			for y := minCell[1]; y <= maxCell[1]; y++ {
+				m.cells[[2]int{x, y}] = append(m.cells[[2]int{x, y}], i)
+			}
+		}
+	}
+	return m
+}
+
+func cellOf(p orb.Point) [2]int {
+	return [2]int{int(math.Floor(p.Lon() / matchIndexCellSize)), int(math.Floor(p.Lat() / matchIndexCellSize))}
+}
+
+// candidate はGPSの点を区間に射影した位置
+type candidate struct {
+	edge   int
+	offset float64 // Sourceからの距離(m)
+	point  orb.Point
+	dist   float64 // GPSの点からの距離(m)
+}
+
+// matchState はビタビアルゴリズムで1点ごとに保持する状態
+type matchState struct {
+	candidates []candidate
+	scores     []float64 // 対数尤度
+	back       []int     // 直前の点で選んだ候補。新しく照合を始めた点では-1
+}
+
+// Match は軌跡を最も尤もらしい道路上の経路に照合する
+// 道路の近くを通っていない場合はfalseを返す
+func (m *Matcher) Match(track orb.LineString) (*MatchResult, bool) {
+	points := thinTrack(track)
+	result := &MatchResult{TotalPoints: len(points)}
+
+	states := []matchState{}
+	var prevPoint orb.Point
+	skipped := 0
+	for _, p := range points {
+		candidates := m.candidates(p)
+		if len(candidates) == 0 {
+			continue
+		}
+		state := matchState{
+			candidates: candidates,
+			scores:     make([]float64, len(candidates)),
+			back:       make([]int, len(candidates)),
+		}
+		for j, c := range candidates {
+			state.scores[j] = emission(c.dist)
+			state.back[j] = -1
+		}
+
+		if len(states) > 0 {
+			prev := states[len(states)-1]
+			if m.transition(prev, &state, geo.Distance(prevPoint, p)) {
+				skipped = 0
+			} else if skipped < matchMaxSkips {
+				// 前の点からつながらない点は外れ値として読み飛ばす
+				skipped++
+				continue
+			} else {
+				// 読み飛ばし続けても戻らない場合は、ここから照合をやり直す
+				skipped = 0
+				for j, c := range candidates {
+					state.scores[j] = emission(c.dist)
+					state.back[j] = -1
+				}
+			}
+		}
+		states = append(states, state)
+		prevPoint = p
+	}
+	if len(states) == 0 {
+		return nil, false
+	}
+	result.MatchedPoints = len(states)
+
+	// 最後の点から尤度が最大の候補をたどる
+	chosen := make([]candidate, len(states))
+	restart := make([]bool, len(states))
+	best := argmax(states[len(states)-1].scores)
+	for i := len(states) - 1; i >= 0; i-- {
+		chosen[i] = states[i].candidates[best]
+		back := states[i].back[best]
+		if back < 0 {
+			restart[i] = true
+			if i > 0 {
+				back = argmax(states[i-1].scores)
+			}
+		}
+		best = back
+	}
+
+	for i := range chosen {
+		if i == 0 || restart[i] {
+			result.Segments = appendSegment(result.Segments, m.graph.partial(chosen[i].edge, chosen[i].offset, chosen[i].offset))
+			continue
+		}
+		for _, s := range m.connect(chosen[i-1], chosen[i]) {
+			result.Segments = appendSegment(result.Segments, s)
+		}
+	}
+	return result, true
+}
+
+// thinTrack は停車中などで密集した点を間引く
+// 誤差の範囲内で前後する点は、道路上で逆走したように見えて照合を乱すため除く
+func thinTrack(track orb.LineString) []orb.Point {
+	points := []orb.Point{}
+	for i, p := range track {
+		if len(points) > 0 && i < len(track)-1 && geo.Distance(points[len(points)-1], p) < 2*matchGPSSigma {
+			continue
+		}
+		points = append(points, p)
+	}
+	return points
+}
+
+// candidates はGPSの点の近くにある区間に射影した位置を、近い順に返す
+func (m *Matcher) candidates(p orb.Point) []candidate {
+	padLat := matchSearchRadius / metersPerDegree
+	padLon := padLat / math.Cos(p.Lat()*math.Pi/180)
+	minCell := cellOf(orb.Point{p.Lon() - padLon, p.Lat() - padLat})
+	maxCell := cellOf(orb.Point{p.Lon() + padLon, p.Lat() + padLat})
+
+	seen := map[int]bool{}
+	candidates := []candidate{}
+	for x := minCell[0]; x <= maxCell[0]; x++ {
+		for y := minCell[1]; y <= maxCell[1]; y++ {
+			for _, i := range m.cells[[2]int{x, y}] {
+				if seen[i] {
+					continue
+				}
+				seen[i] = true
+				c := project(&m.graph.edges[i], p)
+				if c.dist <= matchSearchRadius {
+					c.edge = i
+					candidates = append(candidates, c)
+				}
+			}
+		}
+	}
+
+	// 近い順に並べ、候補の数を制限する
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].dist != candidates[j].dist {
+			return candidates[i].dist < candidates[j].dist
+		}
+		return candidates[i].edge < candidates[j].edge
+	})
+	if len(candidates) > matchMaxCandidates {
+		candidates = candidates[:matchMaxCandidates]
+	}
+	return candidates
+}
+
+// 緯度1度あたりの距離(m)
+const metersPerDegree = 111320.0
+
+// project は点から最も近い区間上の位置を求める
+// 短い線分の上では経緯度を平面とみなして射影する
+func project(e *Edge, p orb.Point) candidate {
+	best := candidate{dist: math.Inf(1)}
+	scale := math.Cos(p.Lat() * math.Pi / 180)
+	offset := 0.0
+	for i := 1; i < len(e.Geometry); i++ {
+		a, b := e.Geometry[i-1], e.Geometry[i]
+		dx, dy := (b.Lon()-a.Lon())*scale, b.Lat()-a.Lat()
+		t := 0.0
+		if l2 := dx*dx + dy*dy; l2 > 0 {
+			t = ((p.Lon()-a.Lon())*scale*dx + (p.Lat()-a.Lat())*dy) / l2
+			t = math.Max(0, math.Min(1, t))
+		}
+		q := orb.Point{a.Lon() + (b.Lon()-a.Lon())*t, a.Lat() + (b.Lat()-a.Lat())*t}
+		if d := geo.Distance(p, q); d < best.dist {
+			best = candidate{offset: offset + geo.Distance(a, q), point: q, dist: d}
+		}
+		offset += geo.Distance(a, b)
+	}
+	return best
+}
+
+// emission はGPSの点が候補の位置で観測される対数尤度
+func emission(dist float64) float64 {
+	return -0.5 * (dist / matchGPSSigma) * (dist / matchGPSSigma)
+}
+
+// transition は直前の点の候補から各候補への遷移を計算し、つながる候補があればtrueを返す
+func (m *Matcher) transition(prev matchState, state *matchState, straight float64) bool {
+	limit := 2*straight + matchMaxDetour
+	for j := range state.scores {
+		state.scores[j] = math.Inf(-1)
+	}
+	for i, from := range prev.candidates {
+		if math.IsInf(prev.scores[i], -1) {
+			continue
+		}
+		dist, _ := m.graph.distancesFrom(m.exits(from), limit)
+		for j, to := range state.candidates {
+			d, ok := m.routeDistance(from, to, dist)
+			if !ok {
+				continue
+			}
+			score := prev.scores[i] - math.Abs(d-straight)/matchBeta + emission(to.dist)
+			if score > state.scores[j] {
+				state.scores[j] = score
+				state.back[j] = i
+			}
+		}
+	}
+	for _, s := range state.scores {
+		if !math.IsInf(s, -1) {
+			return true
+		}
+	}
+	return false
+}
+
+// exits は候補の位置から区間の端のノードまでの距離を返す
+func (m *Matcher) exits(c candidate) map[int64]float64 {
+	e := &m.graph.edges[c.edge]
+	exits := map[int64]float64{e.Target: e.Length - c.offset}
+	if !e.Oneway {
+		if d, ok := exits[e.Source]; !ok || c.offset < d {
+			exits[e.Source] = c.offset
+		}
+	}
+	return exits
+}
+
+// routeDistance は2つの候補の間の道のりを求める
+// dist は from の区間の端から各ノードまでの道のり
+func (m *Matcher) routeDistance(from, to candidate, dist map[int64]float64) (float64, bool) {
+	best := math.Inf(1)
+	if from.edge == to.edge {
+		switch {
+		case to.offset >= from.offset:
+			best = to.offset - from.offset
+		case !m.graph.edges[from.edge].Oneway:
+			best = from.offset - to.offset
+		}
+	}
+	e := &m.graph.edges[to.edge]
+	if d, ok := dist[e.Source]; ok {
+		best = math.Min(best, d+to.offset)
+	}
+	if d, ok := dist[e.Target]; ok && !e.Oneway {
+		best = math.Min(best, d+e.Length-to.offset)
+	}
+	return best, !math.IsInf(best, 1)
+}
+
+// connect は2つの候補の間を道路に沿って結ぶ
+func (m *Matcher) connect(from, to candidate) []Segment {
+	fromEdge := &m.graph.edges[from.edge]
+	if from.edge == to.edge && (to.offset >= from.offset || !fromEdge.Oneway) {
+		return []Segment{m.graph.partial(from.edge, from.offset, to.offset)}
+	}
+
+	dist, prev := m.graph.distancesFrom(m.exits(from), math.Inf(1))
+	toEdge := &m.graph.edges[to.edge]
+	entry, entryOffset := toEdge.Source, 0.0
+	entryDist := math.Inf(1)
+	if d, ok := dist[toEdge.Source]; ok {
+		entryDist = d + to.offset
+	}
+	if d, ok := dist[toEdge.Target]; ok && !toEdge.Oneway && d+toEdge.Length-to.offset < entryDist {
+		entry, entryOffset = toEdge.Target, toEdge.Length
+	}
+	if _, ok := dist[entry]; !ok {
+		return nil
+	}
+
+	// 入口のノードから出口のノードまで逆にたどる
+	steps := []Step{}
+	node := entry
+	for {
+		a, ok := prev[node]
+		if !ok {
+			break
+		}
+		e := &m.graph.edges[a.edge]
+		steps = append(steps, Step{Edge: e, Reverse: a.reverse})
+		if a.reverse {
+			node = e.Target
+		} else {
+			node = e.Source
+		}
+	}
+
+	exitOffset := fromEdge.Length
+	if node != fromEdge.Target {
+		exitOffset = 0
+	}
+	segments := []Segment{m.graph.partial(from.edge, from.offset, exitOffset)}
+	for i := len(steps) - 1; i >= 0; i-- {
+		segments = append(segments, steps[i].Segment())
+	}
+	return append(segments, m.graph.partial(to.edge, entryOffset, to.offset))
+}
+
+// appendSegment は同じ区間を同じ向きに続けて通る部分をまとめる
+// 交差点の上の点から照合を始めたときなどにできる、長さがほぼ0の部分は除く
+func appendSegment(segments []Segment, s Segment) []Segment {
+	if len(segments) == 0 {
+		return append(segments, s)
+	}
+	last := &segments[len(segments)-1]
+	switch {
+	case last.Edge == s.Edge && last.Reverse == s.Reverse:
+		last.Geometry = append(last.Geometry, s.Geometry[1:]...)
+		last.Length += s.Length
+	case s.Length < matchMinSegmentLength:
+	case last.Length < matchMinSegmentLength:
+		// 照合を始めた点だけの部分は、続く部分に置き換える
+		*last = s
+	default:
+		segments = append(segments, s)
+	}
+	return segments
+}
+
+// partial は区間のSourceから from(m) の位置から to(m) の位置までを通る部分を返す
+// from > to の場合は逆向きに通る
+func (g *Graph) partial(edge int, from, to float64) Segment {
+	e := &g.edges[edge]
+	reverse := from > to
+	lo, hi := min(from, to), max(from, to)
+
+	geom := orb.LineString{interpolate(e.Geometry, lo)}
+	offset := 0.0
+	for i := 1; i < len(e.Geometry); i++ {
+		offset += geo.Distance(e.Geometry[i-1], e.Geometry[i])
+		if offset > lo && offset < hi {
+			geom = append(geom, e.Geometry[i])
+		}
+	}
+	geom = append(geom, interpolate(e.Geometry, hi))
+
+	if reverse {
+		for i, j := 0, len(geom)-1; i < j; i, j = i+1, j-1 {
+			geom[i], geom[j] = geom[j], geom[i]
+		}
+	}
+	return Segment{Edge: e, Reverse: reverse, Geometry: geom, Length: hi - lo}
+}
+
+// interpolate は形状の始点から offset(m) の位置を返す
+func interpolate(ls orb.LineString, offset float64) orb.Point {
+	for i := 1; i < len(ls); i++ {
+		d := geo.Distance(ls[i-1], ls[i])
+		if offset <= d && d > 0 {
+			t := offset / d
+			return orb.Point{ls[i-1].Lon() + (ls[i].Lon()-ls[i-1].Lon())*t, ls[i-1].Lat() + (ls[i].Lat()-ls[i-1].Lat())*t}
+		}
+		offset -= d
+	}
+	return ls[len(ls)-1]
+}
+
+// distancesFrom は複数の始点から各ノードまでの最短の道のりを求める
+// limit より遠いノードは探さない
+func (g *Graph) distancesFrom(starts map[int64]float64, limit float64) (map[int64]float64, map[int64]arc) {
+	dist := map[int64]float64{}
+	prev := map[int64]arc{}
+	open := &nodeQueue{}
+	for id, d := range starts {
+		dist[id] = d
+		heap.Push(open, queueItem{id: id, priority: d})
+	}
+	for open.Len() > 0 {
+		current := heap.Pop(open).(queueItem)
+		if current.priority > dist[current.id] {
+			continue
+		}
+		for _, a := range g.arcs[current.id] {
+			d := current.priority + g.edges[a.edge].Length
+			if d > limit {
+				continue
+			}
+			if known, ok := dist[a.to]; ok && known <= d {
+				continue
+			}
+			dist[a.to] = d
+			prev[a.to] = a
+			heap.Push(open, queueItem{id: a.to, priority: d})
+		}
+	}
+	return dist, prev
+}
+
+func argmax(values []float64) int {
+	best := 0
+	for i, v := range values {
+		if v > values[best] {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,146 @@
+package roadgraph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// noisyTrack は地点を順に結ぶ線上に、左右に揺れるGPSの点を約30mおきに並べる
+// 始点と終点は揺らさない
+func noisyTrack(noise float64, points ...orb.Point) orb.LineString {
+	track := orb.LineString{points[0]}
+	for i := 1; i < len(points); i++ {
+		a, b := points[i-1], points[i]
+		n := int(math.Ceil(geo.Distance(a, b) / 30))
+		for k := 1; k <= n; k++ {
+			if i == len(points)-1 && k == n {
+				break
+			}
+			t := float64(k) / float64(n)
+			// 進行方向と垂直に、交互に noise(m) ずらす
+			sign := 1.0
+			if len(track)%2 == 1 {
+				sign = -1
+			}
+			dLon, dLat := b.Lat()-a.Lat(), -(b.Lon() - a.Lon())
+			l := math.Hypot(dLon, dLat) * metersPerDegree
+			track = append(track, orb.Point{
+				a.Lon() + (b.Lon()-a.Lon())*t + sign*noise*dLon/l,
+				a.Lat() + (b.Lat()-a.Lat())*t + sign*noise*dLat/l,
+			})
+		}
+	}
+	return append(track, points[len(points)-1])
+}
+
+// 格子のノードの位置
+func gridNode(id int) orb.Point {
+	r, c := (id-1)/3, (id-1)%3
+	return orb.Point{139.700 + 0.002*float64(c), 35.680 + 0.002*float64(r)}
+}
+
+func TestMatcher_Match(t *testing.T) {
+	m := NewMatcher(NewGraph(loadFixture(t)))
+
+	t.Run("揺れる軌跡を道路に沿った経路にすること", func(t *testing.T) {
+		// 南西(1)から西通りを北へ進み、北通りを東へ(9)
+		track := noisyTrack(8, gridNode(1), gridNode(7), gridNode(9))
+		result, ok := m.Match(track)
+		if !ok {
+			t.Fatal("Match() failed")
+		}
+
+		names := []string{}
+		total := 0.0
+		for _, s := range result.Segments {
+			names = append(names, s.Edge.Name)
+			total += s.Length
+			if s.Reverse {
+				t.Errorf("segment on way %d should be forward", s.Edge.OSMWayID)
+			}
+		}
+		want := []string{"西通り", "西通り", "北通り", "北通り"}
+		if len(names) != len(want) {
+			t.Fatalf("segments = %v, want %v", names, want)
+		}
+		for i := range want {
+			if names[i] != want[i] {
+				t.Errorf("segments = %v, want %v", names, want)
+				break
+			}
+		}
+		if math.Abs(total-806) > 5 {
+			t.Errorf("total length = %v, want about 806", total)
+		}
+
+		// 照合後の形状は道路の上にある
+		geom := result.Geometry()
+		if geo.Distance(geom[0], gridNode(1)) > 1 || geo.Distance(geom[len(geom)-1], gridNode(9)) > 1 {
+			t.Errorf("geometry = %v, want from node 1 to node 9", geom)
+		}
+		for _, p := range geom {
+			if math.Abs(p.Lon()-139.700) > 1e-6 && math.Abs(p.Lat()-35.684) > 1e-6 {
+				t.Errorf("point %v is not on the roads", p)
+			}
+		}
+		if result.MatchedPoints != result.TotalPoints {
+			t.Errorf("MatchedPoints = %d, TotalPoints = %d", result.MatchedPoints, result.TotalPoints)
+		}
+	})
+
+	t.Run("区間の途中から始まる軌跡は途中から照合すること", func(t *testing.T) {
+		// 西通りの1-4の中ほどから4を通って靖国通りへ
+		start := orb.Point{139.700, 35.681}
+		result, ok := m.Match(noisyTrack(5, start, gridNode(4), gridNode(5)))
+		if !ok {
+			t.Fatal("Match() failed")
+		}
+		first := result.Segments[0]
+		if first.Edge.OSMWayID != 104 || math.Abs(first.Length-111) > 5 {
+			t.Errorf("first segment = way %d, %v m, want way 104 about 111 m", first.Edge.OSMWayID, first.Length)
+		}
+		if last := result.Segments[len(result.Segments)-1]; last.Edge.Name != "靖国通り" {
+			t.Errorf("last segment = %s, want 靖国通り", last.Edge.Name)
+		}
+	})
+
+	t.Run("道路から離れた点は読み飛ばすこと", func(t *testing.T) {
+		track := noisyTrack(5, gridNode(7), gridNode(9))
+		// 途中に1km離れた点が混ざる
+		track = append(track[:3], append(orb.LineString{{139.71, 35.69}}, track[3:]...)...)
+		result, ok := m.Match(track)
+		if !ok {
+			t.Fatal("Match() failed")
+		}
+		if len(result.Segments) != 2 || result.MatchedPoints != result.TotalPoints-1 {
+			t.Errorf("segments = %d, matched = %d/%d", len(result.Segments), result.MatchedPoints, result.TotalPoints)
+		}
+	})
+
+	t.Run("道路の近くを通らない軌跡は照合できないこと", func(t *testing.T) {
+		if _, ok := m.Match(orb.LineString{{139.8, 35.7}, {139.801, 35.7}}); ok {
+			t.Error("Match() should fail")
+		}
+	})
+}
+
+func TestGraph_Partial(t *testing.T) {
+	g := NewGraph([]Edge{{Geometry: orb.LineString{{139.700, 35.68}, {139.701, 35.68}, {139.702, 35.68}}}})
+	g.edges[0].Length = geo.Length(g.edges[0].Geometry)
+
+	s := g.partial(0, 50, 150)
+	if len(s.Geometry) != 3 || math.Abs(s.Length-100) > 1e-9 || s.Reverse {
+		t.Errorf("partial(50, 150) = %+v", s)
+	}
+	if d := geo.Distance(s.Geometry[0], g.edges[0].Geometry[0]); math.Abs(d-50) > 0.1 {
+		t.Errorf("partial start is %v m from source, want 50", d)
+	}
+
+	r := g.partial(0, 150, 50)
+	if !r.Reverse || r.Geometry[0] != s.Geometry[2] || r.Geometry[2] != s.Geometry[0] {
+		t.Errorf("partial(150, 50) = %+v", r)
+	}
+}
@@ -0,0 +1,236 @@
+package roadgraph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/osmpbf"
+	"github.com/paulmach/orb"
+)
+
+// 3x3の格子状の道路網（infrastructure/fixtures/osm）
+// ノード1（南西）からノード9（北東）へ未舗装の斜めの道があり、9の方が30m高い
+const fixturePath = "../../infrastructure/fixtures/osm/grid.osm.pbf"
+
+func loadFixture(t *testing.T) []Edge {
+	t.Helper()
+	edges, err := LoadFile(fixturePath)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	return edges
+}
+
+func findEdge(edges []Edge, source, target int64) *Edge {
+	for i := range edges {
+		if edges[i].Source == source && edges[i].Target == target {
+			return &edges[i]
+		}
+	}
+	return nil
+}
+
+func wayIDs(steps []Step) []int64 {
+	ids := make([]int64, len(steps))
+	for i, s := range steps {
+		ids[i] = s.Edge.OSMWayID
+	}
+	return ids
+}
+
+func TestLoadFile(t *testing.T) {
+	edges := loadFixture(t)
+
+	// 9本のウェイのうち高速道路と自転車通行止めの歩道を除き、交差点で区切ると13区間になる
+	if len(edges) != 13 {
+		t.Fatalf("LoadFile() = %d edges, want 13", len(edges))
+	}
+	for _, e := range edges {
+		if e.OSMWayID == 108 || e.OSMWayID == 109 {
+			t.Errorf("way %d should be excluded", e.OSMWayID)
+		}
+	}
+
+	// 交差点のない途中のノードでは区切らない
+	diagonal := findEdge(edges, 1, 9)
+	if diagonal == nil {
+		t.Fatal("diagonal edge 1-9 not found")
+	}
+	if len(diagonal.Geometry) != 3 || diagonal.Surface != "gravel" || !diagonal.IsUnpaved() {
+		t.Errorf("diagonal = %+v", diagonal)
+	}
+	if diagonal.ElevationGain != 30 || diagonal.ElevationLoss != 0 {
+		t.Errorf("diagonal elevation = +%v/-%v, want +30/-0", diagonal.ElevationGain, diagonal.ElevationLoss)
+	}
+
+	// 交差点で区切る
+	e := findEdge(edges, 4, 5)
+	if e == nil {
+		t.Fatal("edge 4-5 not found")
+	}
+	if e.Name != "靖国通り" || e.Highway != "primary" || !e.BikeLane || e.Oneway {
+		t.Errorf("edge 4-5 = %+v", e)
+	}
+	if math.Abs(e.Length-181) > 2 {
+		t.Errorf("edge 4-5 length = %v, want about 181", e.Length)
+	}
+	if e := findEdge(edges, 6, 9); e == nil || !e.Oneway {
+		t.Errorf("edge 6-9 = %+v, want oneway", e)
+	}
+}
+
+func TestBuilder_OnewayReverse(t *testing.T) {
+	b := NewBuilder()
+	_ = b.AddWay(osmpbf.Way{ID: 1, NodeIDs: []int64{1, 2}, Tags: map[string]string{"highway": "residential", "oneway": "-1", "incline": "10%"}})
+	_ = b.AddNode(osmpbf.Node{ID: 1, Lat: 35.680, Lon: 139.700})
+	_ = b.AddNode(osmpbf.Node{ID: 2, Lat: 35.681, Lon: 139.700})
+
+	edges := b.Edges()
+	if len(edges) != 1 {
+		t.Fatalf("Edges() = %d edges, want 1", len(edges))
+	}
+	// ウェイと逆向きの一方通行は、通れる向きにそろえる。上り坂も逆向きにする
+	e := edges[0]
+	if e.Source != 2 || e.Target != 1 || !e.Oneway {
+		t.Errorf("edge = %+v, want 2->1 oneway", e)
+	}
+	if e.ElevationGain != 0 || math.Abs(e.ElevationLoss-e.Length*0.1) > 1e-9 {
+		t.Errorf("edge elevation = +%v/-%v", e.ElevationGain, e.ElevationLoss)
+	}
+}
+
+func TestGraph_ShortestPath(t *testing.T) {
+	g := NewGraph(loadFixture(t))
+
+	tests := []struct {
+		name     string
+		from, to int64
+		profile  route.RoutingProfile
+		check    func(t *testing.T, ways []int64)
+	}{
+		{
+			name: "舗装路を優先する条件では未舗装の斜めの道を避ける",
+			from: 9, to: 1,
+			profile: route.RoutingProfileRoad,
+			check: func(t *testing.T, ways []int64) {
+				if len(ways) != 4 {
+					t.Errorf("ways = %v, want 4 grid edges", ways)
+				}
+			},
+		},
+		{
+			name: "gravelの条件では下りの未舗装路を通る",
+			from: 9, to: 1,
+			profile: route.RoutingProfileGravel,
+			check: func(t *testing.T, ways []int64) {
+				if len(ways) != 1 || ways[0] != 107 {
+					t.Errorf("ways = %v, want [107]", ways)
+				}
+			},
+		},
+		{
+			name: "上りは標高差のコストがかかるためgravelでも格子を通る",
+			from: 1, to: 9,
+			profile: route.RoutingProfileGravel,
+			check: func(t *testing.T, ways []int64) {
+				if len(ways) != 4 {
+					t.Errorf("ways = %v, want 4 grid edges", ways)
+				}
+			},
+		},
+		{
+			name: "一方通行は逆向きに通らない",
+			from: 9, to: 3,
+			profile: route.RoutingProfileRoad,
+			check: func(t *testing.T, ways []int64) {
+				for _, id := range ways {
+					if id == 105 {
+						t.Errorf("ways = %v, should not use oneway 105", ways)
+					}
+				}
+			},
+		},
+		{
+			name: "一方通行は順向きなら通る",
+			from: 3, to: 9,
+			profile: route.RoutingProfileRoad,
+			check: func(t *testing.T, ways []int64) {
+				if len(ways) != 2 || ways[0] != 105 || ways[1] != 105 {
+					t.Errorf("ways = %v, want [105 105]", ways)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps, ok := g.ShortestPath(tt.from, tt.to, BicycleCost(tt.profile))
+			if !ok {
+				t.Fatal("ShortestPath() found no path")
+			}
+			// 区間が途切れずにつながっている
+			prev := tt.from
+			for _, s := range steps {
+				source, target := s.Edge.Source, s.Edge.Target
+				if s.Reverse {
+					source, target = target, source
+				}
+				if source != prev {
+					t.Fatalf("steps are not connected at node %d", prev)
+				}
+				prev = target
+			}
+			if prev != tt.to {
+				t.Fatalf("path ends at %d, want %d", prev, tt.to)
+			}
+			tt.check(t, wayIDs(steps))
+		})
+	}
+}
+
+func TestGraph_ShortestPath_AvoidHighways(t *testing.T) {
+	g := NewGraph(loadFixture(t))
+
+	// 西から東へ。自転車レーンのある幹線道路を通るのが最短だが、avoid_highwaysでは住宅街を通る
+	road, _ := g.ShortestPath(4, 6, BicycleCost(route.RoutingProfileRoad))
+	if ways := wayIDs(road); len(ways) != 2 || ways[0] != 102 {
+		t.Errorf("road ways = %v, want [102 102]", ways)
+	}
+	avoid, _ := g.ShortestPath(4, 6, BicycleCost(route.RoutingProfileAvoidHighways))
+	for _, id := range wayIDs(avoid) {
+		if id == 102 {
+			t.Errorf("avoid_highways ways = %v, should not use primary 102", wayIDs(avoid))
+		}
+	}
+}
+
+func TestGraph_ShortestPath_NotFound(t *testing.T) {
+	g := NewGraph(loadFixture(t))
+	if _, ok := g.ShortestPath(1, 108, BicycleCost(route.RoutingProfileRoad)); ok {
+		t.Error("ShortestPath() should fail for unknown node")
+	}
+}
+
+func TestGraph_NearestNode(t *testing.T) {
+	g := NewGraph(loadFixture(t))
+
+	id, dist, ok := g.NearestNode(orb.Point{139.7041, 35.6841})
+	if !ok || id != 9 || dist > 20 {
+		t.Errorf("NearestNode() = %d, %v, %v, want 9", id, dist, ok)
+	}
+	// 歩道・木のノードは道路網に含まれない
+	if _, ok := g.nodes[11]; ok {
+		t.Error("node 11 should not be in the graph")
+	}
+}
+
+func TestStep_Geometry(t *testing.T) {
+	e := &Edge{Geometry: orb.LineString{{0, 0}, {1, 0}, {2, 0}}}
+	got := Step{Edge: e, Reverse: true}.Geometry()
+	if got[0] != (orb.Point{2, 0}) || got[2] != (orb.Point{0, 0}) {
+		t.Errorf("Geometry() = %v", got)
+	}
+	if e.Geometry[0] != (orb.Point{0, 0}) {
+		t.Error("Geometry() should not modify edge")
+	}
+}
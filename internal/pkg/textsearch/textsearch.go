@@ -0,0 +1,182 @@
+// Package textsearch はルートの全文検索に使うトークン化とスニペット生成を提供する
+//
+// PostgreSQLの標準パーサは日本語を単語に分割できないため、保存時と検索時の両方で
+// このパッケージでトークン化した文字列を to_tsvector('simple', ...) / plainto_tsquery('simple', ...) に渡す。
+// 英数字は単語単位、漢字・ひらがな・カタカナは2文字ずつ(bi-gram)に分割する。
+package textsearch
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize は全角英数字や半角カナを揃え、小文字にする
+func Normalize(s string) string {
+	return strings.ToLower(norm.NFKC.String(s))
+}
+
+// Tokenize は文字列を検索用のトークンに分割する
+// 日本語の連続部分は bi-gram に分割し、1文字だけの場合はその文字をトークンにする
+func Tokenize(s string) []string {
+	var tokens []string
+	var word, cjk []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 1:
+			tokens = append(tokens, string(cjk))
+		case len(cjk) > 1:
+			for i := 0; i+1 < len(cjk); i++ {
+				tokens = append(tokens, string(cjk[i:i+2]))
+			}
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range Normalize(s) {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+// Document は複数のテキストから重複を除いたトークンを空白区切りで連結する
+// 保存する検索用ドキュメントの作成に使う
+func Document(texts ...string) string {
+	seen := make(map[string]struct{})
+	var tokens []string
+	for _, text := range texts {
+		for _, t := range Tokenize(text) {
+			if _, ok := seen[t]; ok {
+				continue
+			}
+			seen[t] = struct{}{}
+			tokens = append(tokens, t)
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// Query は検索キーワードをトークン化して空白区切りで連結する
+// plainto_tsquery に渡すと全トークンのAND検索になる
+func Query(keywords ...string) string {
+	return Document(keywords...)
+}
+
+// Snippet はテキスト中で最初にキーワードが現れる位置の周辺を切り出し、一致部分を<mark>で囲む
+// テキストはHTMLエスケープする。キーワードが見つからない場合は空文字を返す
+func Snippet(text string, keywords []string, maxRunes int) string {
+	normalized := []rune(Normalize(text))
+	// 正規化で文字数が変わる場合は位置が対応しないため、正規化後のテキストを表示に使う
+	original := []rune(text)
+	if len(original) != len(normalized) {
+		original = normalized
+	}
+
+	var needles [][]rune
+	for _, k := range keywords {
+		if n := []rune(Normalize(k)); len(n) > 0 {
+			needles = append(needles, n)
+		}
+	}
+
+	matches := findMatches(normalized, needles)
+	if len(matches) == 0 {
+		return ""
+	}
+
+	start, end := 0, len(original)
+	if maxRunes > 0 && end > maxRunes {
+		// 最初の一致が切り出し範囲の1/4あたりに来るようにする
+		start = max(matches[0].start-maxRunes/4, 0)
+		end = min(start+maxRunes, len(original))
+		start = max(end-maxRunes, 0)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, m := range matches {
+		if m.end <= start || m.start >= end {
+			continue
+		}
+		ms, me := max(m.start, pos), min(m.end, end)
+		if ms >= me {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(original[pos:ms])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(original[ms:me])))
+		b.WriteString("</mark>")
+		pos = me
+	}
+	b.WriteString(html.EscapeString(string(original[pos:end])))
+	if end < len(original) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+type match struct {
+	start, end int
+}
+
+// findMatches はキーワードの一致範囲を先頭から順に返す。重なる範囲は結合する
+func findMatches(text []rune, needles [][]rune) []match {
+	var matches []match
+	for i := 0; i < len(text); i++ {
+		longest := 0
+		for _, n := range needles {
+			if len(n) > longest && hasPrefixAt(text, n, i) {
+				longest = len(n)
+			}
+		}
+		if longest == 0 {
+			continue
+		}
+		if last := len(matches) - 1; last >= 0 && matches[last].end >= i {
+			matches[last].end = max(matches[last].end, i+longest)
+		} else {
+			matches = append(matches, match{start: i, end: i + longest})
+		}
+	}
+	return matches
+}
+
+func hasPrefixAt(text, needle []rune, i int) bool {
+	if i+len(needle) > len(text) {
+		return false
+	}
+	for j, r := range needle {
+		if text[i+j] != r {
+			return false
+		}
+	}
+	return true
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー' || r == '々'
+}
@@ -0,0 +1,86 @@
+package textsearch
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{name: "正常系: 英単語は小文字の単語単位", in: "Tokyo Bay Ride", want: []string{"tokyo", "bay", "ride"}},
+		{name: "正常系: 漢字はbi-gram", in: "東京湾", want: []string{"東京", "京湾"}},
+		{name: "正常系: 1文字だけの日本語はそのまま", in: "湾", want: []string{"湾"}},
+		{name: "正常系: 日本語と英数字の混在", in: "荒川CR 50km", want: []string{"荒川", "cr", "50km"}},
+		{name: "正常系: 全角英数字と半角カナを正規化", in: "ＡＢＣ ｻｲｸﾘﾝｸﾞ", want: []string{"abc", "サイ", "イク", "クリ", "リン", "ング"}},
+		{name: "正常系: 記号は区切りとして扱う", in: "富士山・一周!", want: []string{"富士", "士山", "一周"}},
+		{name: "正常系: 空文字", in: "", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocument(t *testing.T) {
+	got := Document("東京ライド", "東京から", "Tokyo")
+	want := "東京 京ラ ライ イド 京か から tokyo"
+	if got != want {
+		t.Errorf("Document() = %q, want %q", got, want)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		keywords []string
+		maxRunes int
+		want     string
+	}{
+		{
+			name:     "正常系: 一致部分を囲む",
+			text:     "荒川サイクリングロードを走る",
+			keywords: []string{"サイクリング"},
+			want:     "荒川<mark>サイクリング</mark>ロードを走る",
+		},
+		{
+			name:     "正常系: 大文字小文字を区別しない",
+			text:     "Ride around Tokyo Bay",
+			keywords: []string{"tokyo"},
+			want:     "Ride around <mark>Tokyo</mark> Bay",
+		},
+		{
+			name:     "正常系: 長いテキストは一致箇所の周辺を切り出す",
+			text:     "あいうえおかきくけこ東京さしすせそたちつてと",
+			keywords: []string{"東京"},
+			maxRunes: 8,
+			want:     "…けこ<mark>東京</mark>さしすせ…",
+		},
+		{
+			name:     "正常系: HTMLをエスケープする",
+			text:     "<b>坂</b>の多いルート",
+			keywords: []string{"坂"},
+			want:     "&lt;b&gt;<mark>坂</mark>&lt;/b&gt;の多いルート",
+		},
+		{
+			name:     "正常系: 一致しない場合は空文字",
+			text:     "海沿いのルート",
+			keywords: []string{"山"},
+			want:     "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Snippet(tt.text, tt.keywords, tt.maxRunes); got != tt.want {
+				t.Errorf("Snippet() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,594 @@
+package club
+
+import (
+	"errors"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/response"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/validator"
+	clubUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/club"
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	clubUsecase clubUsecase.IClubUsecase
+}
+
+func NewHandler(clubUsecase clubUsecase.IClubUsecase) *Handler {
+	return &Handler{clubUsecase: clubUsecase}
+}
+
+// ListMyClubs godoc
+//
+//	@Summary	所属するクラブの一覧を取得する
+//	@Tags		clubs
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Success	200	{object}	ClubListResponse
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/clubs [get]
+func (h *Handler) ListMyClubs(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dtos, err := h.clubUsecase.ListMyClubs(c.Request.Context(), kratosID)
+	if err != nil {
+		response.ReturnStatusInternalServerError(c, err)
+		return
+	}
+
+	clubs := make([]ClubResponseModel, len(dtos))
+	for i := range dtos {
+		clubs[i] = clubResponseModel(&dtos[i])
+	}
+	response.ReturnStatusOK(c, ClubListResponse{Clubs: clubs})
+}
+
+// GetClub godoc
+//
+//	@Summary		クラブを取得する
+//	@Description	メンバーでなくても取得でき、参加を申請できる
+//	@Tags			clubs
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			club_id	path		string	true	"Club ID"
+//	@Success		200		{object}	ClubResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id} [get]
+func (h *Handler) GetClub(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.clubUsecase.GetClub(c.Request.Context(), kratosID, c.Param("club_id"))
+	if err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, ClubResponse{Club: clubResponseModel(dto)})
+}
+
+// CreateClub godoc
+//
+//	@Summary		クラブを作成する
+//	@Description	作成したユーザーがオーナーになる
+//	@Tags			clubs
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			request	body		ClubRequest	true	"Create Club Request"
+//	@Success		201		{object}	ClubResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/clubs [post]
+func (h *Handler) CreateClub(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	req, ok := bindClubRequest(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.clubUsecase.CreateClub(c.Request.Context(), kratosID, clubUsecase.ClubInputDto{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusCreated(c, ClubResponse{Club: clubResponseModel(dto)})
+}
+
+// UpdateClub godoc
+//
+//	@Summary		クラブの名前と説明を更新する
+//	@Description	オーナーと管理者のみ
+//	@Tags			clubs
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			club_id	path		string		true	"Club ID"
+//	@Param			request	body		ClubRequest	true	"Update Club Request"
+//	@Success		200		{object}	ClubResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		403		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id} [put]
+func (h *Handler) UpdateClub(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	req, ok := bindClubRequest(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.clubUsecase.UpdateClub(c.Request.Context(), kratosID, c.Param("club_id"), clubUsecase.ClubInputDto{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, ClubResponse{Club: clubResponseModel(dto)})
+}
+
+// DeleteClub godoc
+//
+//	@Summary		クラブを削除する
+//	@Description	オーナーのみ。クラブのメンバーのみに公開していたルートとイベントは、所有者だけが閲覧できるようになる
+//	@Tags			clubs
+//	@Security		CookieAuth
+//	@Param			club_id	path	string	true	"Club ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id} [delete]
+func (h *Handler) DeleteClub(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.clubUsecase.DeleteClub(c.Request.Context(), kratosID, c.Param("club_id")); err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// ListMembers godoc
+//
+//	@Summary		クラブのメンバーの一覧を取得する
+//	@Description	メンバーのみ。参加した順に返す
+//	@Tags			clubs
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			club_id	path		string	true	"Club ID"
+//	@Success		200		{object}	MemberListResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		403		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/members [get]
+func (h *Handler) ListMembers(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dtos, err := h.clubUsecase.ListMembers(c.Request.Context(), kratosID, c.Param("club_id"))
+	if err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	members := make([]MemberResponseModel, len(dtos))
+	for i := range dtos {
+		members[i] = memberResponseModel(&dtos[i])
+	}
+	response.ReturnStatusOK(c, MemberListResponse{Members: members})
+}
+
+// ChangeMemberRole godoc
+//
+//	@Summary		メンバーの役割を変更する
+//	@Description	オーナーのみ。オーナーの交代はできない
+//	@Tags			clubs
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			club_id	path		string					true	"Club ID"
+//	@Param			user_id	path		string					true	"User ID"
+//	@Param			request	body		ChangeMemberRoleRequest	true	"Change Member Role Request"
+//	@Success		200		{object}	MemberResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		403		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/members/{user_id} [put]
+func (h *Handler) ChangeMemberRole(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req ChangeMemberRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	dto, err := h.clubUsecase.ChangeMemberRole(c.Request.Context(), kratosID, c.Param("club_id"), c.Param("user_id"), req.Role)
+	if err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, MemberResponse{Member: memberResponseModel(dto)})
+}
+
+// RemoveMember godoc
+//
+//	@Summary		メンバーをクラブから外す
+//	@Description	自分を指定した場合はクラブを抜ける。オーナーは他のメンバーを、管理者は一般のメンバーを外せる。オーナーは抜けられない
+//	@Tags			clubs
+//	@Security		CookieAuth
+//	@Param			club_id	path	string	true	"Club ID"
+//	@Param			user_id	path	string	true	"User ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/members/{user_id} [delete]
+func (h *Handler) RemoveMember(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.clubUsecase.RemoveMember(c.Request.Context(), kratosID, c.Param("club_id"), c.Param("user_id")); err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// RequestToJoin godoc
+//
+//	@Summary		クラブへの参加を申請する
+//	@Description	オーナーか管理者が承認するとメンバーになる。申請済みの場合は何もしない
+//	@Tags			clubs
+//	@Accept			json
+//	@Security		CookieAuth
+//	@Param			club_id	path	string			true	"Club ID"
+//	@Param			request	body	JoinClubRequest	true	"Join Club Request"
+//	@Success		204
+//	@Failure		400	{object}	response.ErrorResponse
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		409	{object}	response.ErrorResponse	"すでにメンバー"
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/join-requests [post]
+func (h *Handler) RequestToJoin(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req JoinClubRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	if err := h.clubUsecase.RequestToJoin(c.Request.Context(), kratosID, c.Param("club_id"), req.Message); err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// ListJoinRequests godoc
+//
+//	@Summary		クラブへの参加申請の一覧を取得する
+//	@Description	オーナーと管理者のみ。古い順に返す
+//	@Tags			clubs
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			club_id	path		string	true	"Club ID"
+//	@Success		200		{object}	JoinRequestListResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		403		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/join-requests [get]
+func (h *Handler) ListJoinRequests(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dtos, err := h.clubUsecase.ListJoinRequests(c.Request.Context(), kratosID, c.Param("club_id"))
+	if err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	requests := make([]JoinRequestResponseModel, len(dtos))
+	for i, r := range dtos {
+		requests[i] = JoinRequestResponseModel{
+			UserID:    r.UserID,
+			UserName:  r.UserName,
+			Message:   r.Message,
+			CreatedAt: r.CreatedAt,
+		}
+	}
+	response.ReturnStatusOK(c, JoinRequestListResponse{JoinRequests: requests})
+}
+
+// ApproveJoinRequest godoc
+//
+//	@Summary		クラブへの参加申請を承認する
+//	@Description	オーナーと管理者のみ。申請したユーザーは一般のメンバーになる
+//	@Tags			clubs
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			club_id	path		string	true	"Club ID"
+//	@Param			user_id	path		string	true	"申請したユーザーのID"
+//	@Success		200		{object}	MemberResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		403		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/join-requests/{user_id}/approve [post]
+func (h *Handler) ApproveJoinRequest(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.clubUsecase.ApproveJoinRequest(c.Request.Context(), kratosID, c.Param("club_id"), c.Param("user_id"))
+	if err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, MemberResponse{Member: memberResponseModel(dto)})
+}
+
+// RejectJoinRequest godoc
+//
+//	@Summary		クラブへの参加申請を断る
+//	@Description	オーナーと管理者のみ
+//	@Tags			clubs
+//	@Security		CookieAuth
+//	@Param			club_id	path	string	true	"Club ID"
+//	@Param			user_id	path	string	true	"申請したユーザーのID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/join-requests/{user_id} [delete]
+func (h *Handler) RejectJoinRequest(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.clubUsecase.RejectJoinRequest(c.Request.Context(), kratosID, c.Param("club_id"), c.Param("user_id")); err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// ListLibraryRoutes godoc
+//
+//	@Summary		共有ルートライブラリのルートの一覧を取得する
+//	@Description	メンバーのみ。追加した新しい順に、閲覧できるルートだけを返す
+//	@Tags			clubs
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			club_id	path		string	true	"Club ID"
+//	@Success		200		{object}	LibraryRouteListResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		403		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/routes [get]
+func (h *Handler) ListLibraryRoutes(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dtos, err := h.clubUsecase.ListLibraryRoutes(c.Request.Context(), kratosID, c.Param("club_id"))
+	if err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	routes := make([]LibraryRouteResponse, len(dtos))
+	for i, r := range dtos {
+		routes[i] = LibraryRouteResponse{
+			ID:            r.ID,
+			UserID:        r.UserID,
+			UserName:      r.UserName,
+			Name:          r.Name,
+			Distance:      r.Distance,
+			Duration:      r.Duration,
+			ElevationGain: r.ElevationGain,
+			Visibility:    r.Visibility,
+			Polyline:      r.Polyline,
+			Difficulty:    r.Difficulty,
+		}
+	}
+	response.ReturnStatusOK(c, LibraryRouteListResponse{Routes: routes})
+}
+
+// AddLibraryRoute godoc
+//
+//	@Summary		共有ルートライブラリにルートを追加する
+//	@Description	オーナーと管理者のみ。公開ルートか、このクラブのメンバーのみに公開されたルートを追加できる
+//	@Tags			clubs
+//	@Accept			json
+//	@Security		CookieAuth
+//	@Param			club_id	path	string					true	"Club ID"
+//	@Param			request	body	AddLibraryRouteRequest	true	"Add Library Route Request"
+//	@Success		204
+//	@Failure		400	{object}	response.ErrorResponse	"他のメンバーが閲覧できないルート"
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		409	{object}	response.ErrorResponse	"追加済みのルート"
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/routes [post]
+func (h *Handler) AddLibraryRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req AddLibraryRouteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	if err := h.clubUsecase.AddLibraryRoute(c.Request.Context(), kratosID, c.Param("club_id"), req.RouteID); err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// RemoveLibraryRoute godoc
+//
+//	@Summary		共有ルートライブラリからルートを取り除く
+//	@Description	オーナーと管理者のみ。ルート自体は削除しない
+//	@Tags			clubs
+//	@Security		CookieAuth
+//	@Param			club_id		path	string	true	"Club ID"
+//	@Param			route_id	path	string	true	"Route ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/clubs/{club_id}/routes/{route_id} [delete]
+func (h *Handler) RemoveLibraryRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.clubUsecase.RemoveLibraryRoute(c.Request.Context(), kratosID, c.Param("club_id"), c.Param("route_id")); err != nil {
+		returnClubDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+func bindClubRequest(c *gin.Context) (*ClubRequest, bool) {
+	var req ClubRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return nil, false
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return nil, false
+	}
+	return &req, true
+}
+
+// kratosIDFromContext は認証ミドルウェアが設定したKratosIDを取得する。取得できない場合はエラーレスポンスを返す
+func kratosIDFromContext(c *gin.Context) (string, bool) {
+	kratosIDValue, exists := c.Get("kratos_id")
+	if !exists {
+		response.ReturnStatusUnauthorized(c, errors.New("user not authenticated"))
+		return "", false
+	}
+	kratosID, ok := kratosIDValue.(string)
+	if !ok {
+		response.ReturnStatusInternalServerError(c, errors.New("invalid kratos_id type"))
+		return "", false
+	}
+	return kratosID, true
+}
+
+// returnClubDomainError はドメインエラーの種類に応じたステータスコードを返す
+func returnClubDomainError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainerror.ErrValidation):
+		response.ReturnStatusBadRequest(c, err)
+	case errors.Is(err, domainerror.ErrUnauthorized):
+		response.ReturnStatusForbidden(c, err)
+	case errors.Is(err, domainerror.ErrNotFound):
+		response.ReturnStatusNotFound(c, err)
+	case errors.Is(err, domainerror.ErrConflict):
+		response.ReturnStatusConflict(c, err)
+	default:
+		response.ReturnStatusInternalServerError(c, err)
+	}
+}
+
+func clubResponseModel(dto *clubUsecase.ClubOutputDto) ClubResponseModel {
+	return ClubResponseModel{
+		ID:          dto.ID,
+		Name:        dto.Name,
+		Description: dto.Description,
+		MemberCount: dto.MemberCount,
+		MyRole:      dto.MyRole,
+		CreatedAt:   dto.CreatedAt,
+		UpdatedAt:   dto.UpdatedAt,
+	}
+}
+
+func memberResponseModel(dto *clubUsecase.MemberOutputDto) MemberResponseModel {
+	return MemberResponseModel{
+		UserID:   dto.UserID,
+		UserName: dto.UserName,
+		Role:     dto.Role,
+		JoinedAt: dto.JoinedAt,
+	}
+}
@@ -0,0 +1,22 @@
+package club
+
+// ClubRequest はクラブ作成・更新のリクエスト
+type ClubRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description"`
+}
+
+// JoinClubRequest はクラブへの参加申請のリクエスト。メッセージはオーナーと管理者に表示する
+type JoinClubRequest struct {
+	Message string `json:"message"`
+}
+
+// ChangeMemberRoleRequest はメンバーの役割を変更するリクエスト
+type ChangeMemberRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=admin member"`
+}
+
+// AddLibraryRouteRequest は共有ルートライブラリにルートを追加するリクエスト
+type AddLibraryRouteRequest struct {
+	RouteID string `json:"route_id" validate:"required"`
+}
@@ -0,0 +1,62 @@
+package club
+
+type ClubListResponse struct {
+	Clubs []ClubResponseModel `json:"clubs"`
+}
+
+type ClubResponse struct {
+	Club ClubResponseModel `json:"club"`
+}
+
+type ClubResponseModel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MemberCount int32  `json:"member_count"`
+	MyRole      string `json:"my_role,omitempty"` // owner, admin, member。メンバーでない場合は省略
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type MemberListResponse struct {
+	Members []MemberResponseModel `json:"members"`
+}
+
+type MemberResponse struct {
+	Member MemberResponseModel `json:"member"`
+}
+
+type MemberResponseModel struct {
+	UserID   string `json:"user_id"`
+	UserName string `json:"user_name"`
+	Role     string `json:"role"` // owner, admin, member
+	JoinedAt string `json:"joined_at"`
+}
+
+type JoinRequestListResponse struct {
+	JoinRequests []JoinRequestResponseModel `json:"join_requests"`
+}
+
+type JoinRequestResponseModel struct {
+	UserID    string `json:"user_id"`
+	UserName  string `json:"user_name"`
+	Message   string `json:"message"`
+	CreatedAt string `json:"created_at"`
+}
+
+type LibraryRouteListResponse struct {
+	Routes []LibraryRouteResponse `json:"routes"`
+}
+
+type LibraryRouteResponse struct {
+	ID            string  `json:"id"`
+	UserID        string  `json:"user_id"`
+	UserName      string  `json:"user_name"`
+	Name          string  `json:"name"`
+	Distance      float64 `json:"distance"`
+	Duration      float64 `json:"duration"`
+	ElevationGain float64 `json:"elevation_gain"`
+	Visibility    int16   `json:"visibility"`
+	Polyline      string  `json:"polyline"`
+	Difficulty    string  `json:"difficulty,omitempty"` // easy, moderate, hard, expert。まだ求めていない場合は省略
+}
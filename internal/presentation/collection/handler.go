@@ -0,0 +1,367 @@
+package collection
+
+import (
+	"errors"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/response"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/validator"
+	collectionUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/collection"
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	collectionUsecase collectionUsecase.ICollectionUsecase
+}
+
+func NewHandler(collectionUsecase collectionUsecase.ICollectionUsecase) *Handler {
+	return &Handler{collectionUsecase: collectionUsecase}
+}
+
+// ListMyCollections godoc
+//
+//	@Summary	自分のコレクションの一覧を取得する
+//	@Tags		collections
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Success	200	{object}	CollectionListResponse
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/collections [get]
+func (h *Handler) ListMyCollections(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dtos, err := h.collectionUsecase.ListMyCollections(c.Request.Context(), kratosID)
+	if err != nil {
+		response.ReturnStatusInternalServerError(c, err)
+		return
+	}
+
+	collections := make([]CollectionResponseModel, len(dtos))
+	for i := range dtos {
+		collections[i] = collectionResponseModel(&dtos[i])
+	}
+	response.ReturnStatusOK(c, CollectionListResponse{Collections: collections})
+}
+
+// GetCollection godoc
+//
+//	@Summary		コレクションを取得する
+//	@Description	公開のコレクションは未ログインでも取得できる。中のルートは閲覧できるものだけを返す
+//	@Tags			collections
+//	@Produce		json
+//	@Param			collection_id	path		string	true	"Collection ID"
+//	@Success		200				{object}	CollectionResponse
+//	@Failure		404				{object}	response.ErrorResponse
+//	@Failure		500				{object}	response.ErrorResponse
+//	@Router			/collections/{collection_id} [get]
+func (h *Handler) GetCollection(c *gin.Context) {
+	var kratosID string
+	if kratosIDValue, exists := c.Get("kratos_id"); exists {
+		kratosID, _ = kratosIDValue.(string)
+	}
+
+	dto, err := h.collectionUsecase.GetCollection(c.Request.Context(), c.Param("collection_id"), kratosID)
+	if err != nil {
+		returnCollectionDomainError(c, err)
+		return
+	}
+
+	res := collectionResponseModel(&dto.CollectionOutputDto)
+	res.Routes = make([]CollectionRouteResponse, len(dto.Routes))
+	for i, r := range dto.Routes {
+		res.Routes[i] = CollectionRouteResponse{
+			ID:            r.ID,
+			UserID:        r.UserID,
+			UserName:      r.UserName,
+			Name:          r.Name,
+			Distance:      r.Distance,
+			Duration:      r.Duration,
+			ElevationGain: r.ElevationGain,
+			ElevationLoss: r.ElevationLoss,
+			Visibility:    r.Visibility,
+			Polyline:      r.Polyline,
+			Difficulty:    r.Difficulty,
+			Tags:          r.Tags,
+		}
+	}
+	response.ReturnStatusOK(c, CollectionResponse{Collection: res})
+}
+
+// CreateCollection godoc
+//
+//	@Summary	コレクションを作成する
+//	@Tags		collections
+//	@Accept		json
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Param		request	body		CollectionRequest	true	"Create Collection Request"
+//	@Success	201		{object}	CollectionResponse
+//	@Failure	400		{object}	response.ErrorResponse
+//	@Failure	401		{object}	response.ErrorResponse
+//	@Failure	500		{object}	response.ErrorResponse
+//	@Router		/collections [post]
+func (h *Handler) CreateCollection(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	req, ok := bindCollectionRequest(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.collectionUsecase.CreateCollection(c.Request.Context(), kratosID, collectionUsecase.CollectionInputDto{
+		Name:        req.Name,
+		Description: req.Description,
+		Visibility:  req.Visibility,
+	})
+	if err != nil {
+		returnCollectionDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusCreated(c, CollectionResponse{Collection: collectionResponseModel(dto)})
+}
+
+// UpdateCollection godoc
+//
+//	@Summary	コレクションの名前・説明・公開範囲を更新する
+//	@Tags		collections
+//	@Accept		json
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Param		collection_id	path		string				true	"Collection ID"
+//	@Param		request			body		CollectionRequest	true	"Update Collection Request"
+//	@Success	200				{object}	CollectionResponse
+//	@Failure	400				{object}	response.ErrorResponse
+//	@Failure	401				{object}	response.ErrorResponse
+//	@Failure	403				{object}	response.ErrorResponse
+//	@Failure	404				{object}	response.ErrorResponse
+//	@Failure	500				{object}	response.ErrorResponse
+//	@Router		/collections/{collection_id} [put]
+func (h *Handler) UpdateCollection(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	req, ok := bindCollectionRequest(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.collectionUsecase.UpdateCollection(c.Request.Context(), kratosID, c.Param("collection_id"), collectionUsecase.CollectionInputDto{
+		Name:        req.Name,
+		Description: req.Description,
+		Visibility:  req.Visibility,
+	})
+	if err != nil {
+		returnCollectionDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, CollectionResponse{Collection: collectionResponseModel(dto)})
+}
+
+// DeleteCollection godoc
+//
+//	@Summary		コレクションを削除する
+//	@Description	中のルートは削除しない
+//	@Tags			collections
+//	@Security		CookieAuth
+//	@Param			collection_id	path	string	true	"Collection ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/collections/{collection_id} [delete]
+func (h *Handler) DeleteCollection(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.collectionUsecase.DeleteCollection(c.Request.Context(), kratosID, c.Param("collection_id")); err != nil {
+		returnCollectionDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// AddRoute godoc
+//
+//	@Summary		コレクションの末尾にルートを追加する
+//	@Description	自分のルートのほか、他のユーザーの公開ルートも追加できる
+//	@Tags			collections
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			collection_id	path		string						true	"Collection ID"
+//	@Param			request			body		AddCollectionRouteRequest	true	"Add Route Request"
+//	@Success		200				{object}	CollectionResponse
+//	@Failure		400				{object}	response.ErrorResponse
+//	@Failure		401				{object}	response.ErrorResponse
+//	@Failure		403				{object}	response.ErrorResponse
+//	@Failure		404				{object}	response.ErrorResponse
+//	@Failure		409				{object}	response.ErrorResponse	"追加済みのルート"
+//	@Failure		500				{object}	response.ErrorResponse
+//	@Router			/collections/{collection_id}/routes [post]
+func (h *Handler) AddRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req AddCollectionRouteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	dto, err := h.collectionUsecase.AddRoute(c.Request.Context(), kratosID, c.Param("collection_id"), req.RouteID)
+	if err != nil {
+		returnCollectionDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, CollectionResponse{Collection: collectionResponseModel(dto)})
+}
+
+// ReorderRoutes godoc
+//
+//	@Summary		コレクション内のルートを並べ替える
+//	@Description	コレクション内のすべてのルートを過不足なく、並べたい順に指定する
+//	@Tags			collections
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			collection_id	path		string							true	"Collection ID"
+//	@Param			request			body		ReorderCollectionRoutesRequest	true	"Reorder Routes Request"
+//	@Success		200				{object}	CollectionResponse
+//	@Failure		400				{object}	response.ErrorResponse
+//	@Failure		401				{object}	response.ErrorResponse
+//	@Failure		403				{object}	response.ErrorResponse
+//	@Failure		404				{object}	response.ErrorResponse
+//	@Failure		500				{object}	response.ErrorResponse
+//	@Router			/collections/{collection_id}/routes [put]
+func (h *Handler) ReorderRoutes(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req ReorderCollectionRoutesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	dto, err := h.collectionUsecase.ReorderRoutes(c.Request.Context(), kratosID, c.Param("collection_id"), req.RouteIDs)
+	if err != nil {
+		returnCollectionDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, CollectionResponse{Collection: collectionResponseModel(dto)})
+}
+
+// RemoveRoute godoc
+//
+//	@Summary	コレクションからルートを取り除く
+//	@Tags		collections
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Param		collection_id	path		string	true	"Collection ID"
+//	@Param		route_id		path		string	true	"Route ID"
+//	@Success	200				{object}	CollectionResponse
+//	@Failure	401				{object}	response.ErrorResponse
+//	@Failure	403				{object}	response.ErrorResponse
+//	@Failure	404				{object}	response.ErrorResponse
+//	@Failure	500				{object}	response.ErrorResponse
+//	@Router		/collections/{collection_id}/routes/{route_id} [delete]
+func (h *Handler) RemoveRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.collectionUsecase.RemoveRoute(c.Request.Context(), kratosID, c.Param("collection_id"), c.Param("route_id"))
+	if err != nil {
+		returnCollectionDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, CollectionResponse{Collection: collectionResponseModel(dto)})
+}
+
+func bindCollectionRequest(c *gin.Context) (*CollectionRequest, bool) {
+	var req CollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return nil, false
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return nil, false
+	}
+	return &req, true
+}
+
+// kratosIDFromContext は認証ミドルウェアが設定したKratosIDを取得する。取得できない場合はエラーレスポンスを返す
+func kratosIDFromContext(c *gin.Context) (string, bool) {
+	kratosIDValue, exists := c.Get("kratos_id")
+	if !exists {
+		response.ReturnStatusUnauthorized(c, errors.New("user not authenticated"))
+		return "", false
+	}
+	kratosID, ok := kratosIDValue.(string)
+	if !ok {
+		response.ReturnStatusInternalServerError(c, errors.New("invalid kratos_id type"))
+		return "", false
+	}
+	return kratosID, true
+}
+
+// returnCollectionDomainError はドメインエラーの種類に応じたステータスコードを返す
+func returnCollectionDomainError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainerror.ErrValidation):
+		response.ReturnStatusBadRequest(c, err)
+	case errors.Is(err, domainerror.ErrUnauthorized):
+		response.ReturnStatusForbidden(c, err)
+	case errors.Is(err, domainerror.ErrNotFound):
+		response.ReturnStatusNotFound(c, err)
+	case errors.Is(err, domainerror.ErrConflict):
+		response.ReturnStatusConflict(c, err)
+	default:
+		response.ReturnStatusInternalServerError(c, err)
+	}
+}
+
+func collectionResponseModel(dto *collectionUsecase.CollectionOutputDto) CollectionResponseModel {
+	return CollectionResponseModel{
+		ID:          dto.ID,
+		UserID:      dto.UserID,
+		Name:        dto.Name,
+		Description: dto.Description,
+		Visibility:  dto.Visibility,
+		RouteIDs:    dto.RouteIDs,
+		CreatedAt:   dto.CreatedAt,
+		UpdatedAt:   dto.UpdatedAt,
+	}
+}
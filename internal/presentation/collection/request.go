@@ -0,0 +1,18 @@
+package collection
+
+// CollectionRequest はコレクション作成・更新のリクエスト
+type CollectionRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description"`
+	Visibility  int16  `json:"visibility" validate:"min=0,max=2"` // 0: 非公開, 1: 公開, 2: 友達のみ
+}
+
+// AddCollectionRouteRequest はコレクションにルートを追加するリクエスト
+type AddCollectionRouteRequest struct {
+	RouteID string `json:"route_id" validate:"required"`
+}
+
+// ReorderCollectionRoutesRequest はコレクション内のルートをすべて、並べたい順に指定する
+type ReorderCollectionRoutesRequest struct {
+	RouteIDs []string `json:"route_ids" validate:"required"`
+}
@@ -0,0 +1,36 @@
+package collection
+
+type CollectionListResponse struct {
+	Collections []CollectionResponseModel `json:"collections"`
+}
+
+type CollectionResponse struct {
+	Collection CollectionResponseModel `json:"collection"`
+}
+
+type CollectionResponseModel struct {
+	ID          string                    `json:"id"`
+	UserID      string                    `json:"user_id"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Visibility  int16                     `json:"visibility"`
+	RouteIDs    []string                  `json:"route_ids"` // 並び順
+	CreatedAt   string                    `json:"created_at"`
+	UpdatedAt   string                    `json:"updated_at"`
+	Routes      []CollectionRouteResponse `json:"routes,omitempty"` // コレクション詳細のみ。閲覧できるルートを並び順に返す
+}
+
+type CollectionRouteResponse struct {
+	ID            string   `json:"id"`
+	UserID        string   `json:"user_id"`
+	UserName      string   `json:"user_name"`
+	Name          string   `json:"name"`
+	Distance      float64  `json:"distance"`
+	Duration      float64  `json:"duration"`
+	ElevationGain float64  `json:"elevation_gain"`
+	ElevationLoss float64  `json:"elevation_loss"`
+	Visibility    int16    `json:"visibility"`
+	Polyline      string   `json:"polyline"`
+	Difficulty    string   `json:"difficulty,omitempty"` // easy, moderate, hard, expert。まだ求めていない場合は省略
+	Tags          []string `json:"tags,omitempty"`
+}
@@ -0,0 +1,551 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	pkgGeojson "github.com/YukiAminaka/cycle-route-backend/internal/pkg/geojson"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/geometry"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/response"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/validator"
+	eventUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/event"
+	routeUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/route"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultEventLimit = 20
+	maxEventLimit     = 100
+)
+
+type Handler struct {
+	eventUsecase     eventUsecase.IEventUsecase
+	exportGPXUsecase routeUsecase.IExportGPXUsecase
+}
+
+func NewHandler(eventUsecase eventUsecase.IEventUsecase, exportGPXUsecase routeUsecase.IExportGPXUsecase) *Handler {
+	return &Handler{
+		eventUsecase:     eventUsecase,
+		exportGPXUsecase: exportGPXUsecase,
+	}
+}
+
+// ListUpcomingEvents godoc
+//
+//	@Summary		開催予定のイベントの一覧を取得する
+//	@Description	公開のイベントを開始日時の早い順に返す。中止したイベントは含まない。lat・lng・rを指定すると集合場所がその範囲内のイベントに絞り込む
+//	@Tags			events
+//	@Produce		json
+//	@Param			lat		query		number	false	"Latitude of the reference point"
+//	@Param			lng		query		number	false	"Longitude of the reference point"
+//	@Param			r		query		number	false	"Search radius from the meeting point (meters)"
+//	@Param			limit	query		integer	false	"Page size (default 20, max 100)"
+//	@Success		200		{object}	EventListResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/events [get]
+func (h *Handler) ListUpcomingEvents(c *gin.Context) {
+	dto := eventUsecase.UpcomingEventsInputDto{Limit: defaultEventLimit}
+	for _, q := range []struct {
+		name string
+		dst  **float64
+	}{
+		{"lat", &dto.Lat},
+		{"lng", &dto.Lng},
+		{"r", &dto.Radius},
+	} {
+		value := c.Query(q.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			response.ReturnBadRequest(c, fmt.Errorf("invalid %s", q.name))
+			return
+		}
+		*q.dst = &parsed
+	}
+	// 半径を省略した場合は位置で絞り込まない
+	if dto.Radius == nil {
+		dto.Lat, dto.Lng = nil, nil
+	}
+	if limit := c.Query("limit"); limit != "" {
+		parsed, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil || parsed <= 0 || parsed > maxEventLimit {
+			response.ReturnBadRequest(c, errors.New("invalid limit"))
+			return
+		}
+		dto.Limit = int32(parsed)
+	}
+
+	dtos, err := h.eventUsecase.ListUpcomingEvents(c.Request.Context(), dto)
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	events := make([]EventResponseModel, len(dtos))
+	for i := range dtos {
+		events[i] = eventResponseModel(&dtos[i])
+	}
+	response.ReturnStatusOK(c, EventListResponse{Events: events})
+}
+
+// GetEvent godoc
+//
+//	@Summary		イベントを取得する
+//	@Description	公開のイベントは未ログインでも取得できる。ログインしている場合は自分の返事も返す
+//	@Tags			events
+//	@Produce		json
+//	@Param			event_id	path		string	true	"Event ID"
+//	@Success		200			{object}	EventResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/events/{event_id} [get]
+func (h *Handler) GetEvent(c *gin.Context) {
+	dto, err := h.eventUsecase.GetEvent(c.Request.Context(), c.Param("event_id"), optionalKratosID(c))
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, EventResponse{Event: eventResponseModel(dto)})
+}
+
+// CreateEvent godoc
+//
+//	@Summary		イベントを作成する
+//	@Description	ルートには自分のルートのほか、他のユーザーの公開ルートも使える。公開のイベントには公開のルートが必要
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			request	body		EventRequest	true	"Create Event Request"
+//	@Success		201		{object}	EventResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse	"閲覧できないルート"
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/events [post]
+func (h *Handler) CreateEvent(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindEventRequest(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.eventUsecase.CreateEvent(c.Request.Context(), kratosID, *input)
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusCreated(c, EventResponse{Event: eventResponseModel(dto)})
+}
+
+// UpdateEvent godoc
+//
+//	@Summary		イベントを更新する
+//	@Description	すべての項目をまとめて置き換える。中止したイベントは変更できず、上限は参加者数より少なくできない
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			event_id	path		string			true	"Event ID"
+//	@Param			request		body		EventRequest	true	"Update Event Request"
+//	@Success		200			{object}	EventResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		403			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		409			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/events/{event_id} [put]
+func (h *Handler) UpdateEvent(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindEventRequest(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.eventUsecase.UpdateEvent(c.Request.Context(), kratosID, c.Param("event_id"), *input)
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, EventResponse{Event: eventResponseModel(dto)})
+}
+
+// CancelEvent godoc
+//
+//	@Summary		イベントを中止する
+//	@Description	参加する・未定と返事したユーザーに中止を知らせる。開始後のイベントは中止できない
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			event_id	path		string				true	"Event ID"
+//	@Param			request		body		CancelEventRequest	true	"Cancel Event Request"
+//	@Success		200			{object}	EventResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		403			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		409			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/events/{event_id}/cancel [post]
+func (h *Handler) CancelEvent(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req CancelEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	dto, err := h.eventUsecase.CancelEvent(c.Request.Context(), kratosID, c.Param("event_id"), req.Reason)
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, EventResponse{Event: eventResponseModel(dto)})
+}
+
+// RespondToEvent godoc
+//
+//	@Summary		イベントに参加の返事をする
+//	@Description	返事し直すと上書きする。上限に達している場合は参加するとは返事できない
+//	@Tags			events
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			event_id	path		string		true	"Event ID"
+//	@Param			request		body		RSVPRequest	true	"RSVP Request"
+//	@Success		200			{object}	EventResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		409			{object}	response.ErrorResponse	"中止・開始後のイベント、または上限に達している"
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/events/{event_id}/rsvp [put]
+func (h *Handler) RespondToEvent(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req RSVPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	dto, err := h.eventUsecase.RespondToEvent(c.Request.Context(), kratosID, c.Param("event_id"), req.Status)
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, EventResponse{Event: eventResponseModel(dto)})
+}
+
+// ListRSVPs godoc
+//
+//	@Summary	イベントへの返事の一覧を取得する
+//	@Tags		events
+//	@Produce	json
+//	@Param		event_id	path		string	true	"Event ID"
+//	@Success	200			{object}	RSVPListResponse
+//	@Failure	404			{object}	response.ErrorResponse
+//	@Failure	500			{object}	response.ErrorResponse
+//	@Router		/events/{event_id}/rsvps [get]
+func (h *Handler) ListRSVPs(c *gin.Context) {
+	dtos, err := h.eventUsecase.ListRSVPs(c.Request.Context(), c.Param("event_id"), optionalKratosID(c))
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	rsvps := make([]RSVPResponseModel, len(dtos))
+	for i, r := range dtos {
+		rsvps[i] = RSVPResponseModel{
+			UserID:    r.UserID,
+			UserName:  r.UserName,
+			Status:    r.Status,
+			UpdatedAt: r.UpdatedAt,
+		}
+	}
+	response.ReturnStatusOK(c, RSVPListResponse{RSVPs: rsvps})
+}
+
+// ListComments godoc
+//
+//	@Summary	イベントへのコメントの一覧を取得する
+//	@Tags		events
+//	@Produce	json
+//	@Param		event_id	path		string	true	"Event ID"
+//	@Param		limit		query		integer	false	"Page size (default 20, max 100)"
+//	@Param		cursor		query		string	false	"Cursor returned as next_cursor in the previous page"
+//	@Success	200			{object}	CommentListResponse
+//	@Failure	400			{object}	response.ErrorResponse
+//	@Failure	404			{object}	response.ErrorResponse
+//	@Failure	500			{object}	response.ErrorResponse
+//	@Router		/events/{event_id}/comments [get]
+func (h *Handler) ListComments(c *gin.Context) {
+	var limit int32
+	if v := c.Query("limit"); v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			response.ReturnBadRequest(c, errors.New("invalid limit"))
+			return
+		}
+		limit = int32(parsed)
+	}
+
+	dto, err := h.eventUsecase.ListComments(c.Request.Context(), c.Param("event_id"), optionalKratosID(c), eventUsecase.ListCommentsInputDto{
+		Limit:  limit,
+		Cursor: c.Query("cursor"),
+	})
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	comments := make([]CommentResponseModel, len(dto.Comments))
+	for i := range dto.Comments {
+		comments[i] = commentResponseModel(&dto.Comments[i])
+	}
+	res := CommentListResponse{Comments: comments}
+	if dto.NextCursor != "" {
+		res.NextCursor = &dto.NextCursor
+	}
+	response.ReturnStatusOK(c, res)
+}
+
+// AddComment godoc
+//
+//	@Summary	イベントにコメントする
+//	@Tags		events
+//	@Accept		json
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Param		event_id	path		string			true	"Event ID"
+//	@Param		request		body		CommentRequest	true	"Comment Request"
+//	@Success	201			{object}	CommentResponse
+//	@Failure	400			{object}	response.ErrorResponse
+//	@Failure	401			{object}	response.ErrorResponse
+//	@Failure	404			{object}	response.ErrorResponse
+//	@Failure	500			{object}	response.ErrorResponse
+//	@Router		/events/{event_id}/comments [post]
+func (h *Handler) AddComment(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req CommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	dto, err := h.eventUsecase.AddComment(c.Request.Context(), kratosID, c.Param("event_id"), req.Body)
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusCreated(c, CommentResponse{Comment: commentResponseModel(dto)})
+}
+
+// DeleteComment godoc
+//
+//	@Summary		イベントへのコメントを削除する
+//	@Description	削除できるのはコメントした本人とイベントの主催者
+//	@Tags			events
+//	@Security		CookieAuth
+//	@Param			event_id	path	string	true	"Event ID"
+//	@Param			comment_id	path	string	true	"Comment ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/events/{event_id}/comments/{comment_id} [delete]
+func (h *Handler) DeleteComment(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.eventUsecase.DeleteComment(c.Request.Context(), kratosID, c.Param("event_id"), c.Param("comment_id")); err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// ExportEventGPX godoc
+//
+//	@Summary		イベントのルートをGPXで出力する
+//	@Description	ルートのGPX出力と同じ内容を返す。イベントとルートの両方を閲覧できる場合に限る
+//	@Tags			events
+//	@Produce		application/gpx+xml
+//	@Param			event_id	path		string	true	"Event ID"
+//	@Success		200			{string}	string	"GPX XML"
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/events/{event_id}/gpx [get]
+func (h *Handler) ExportEventGPX(c *gin.Context) {
+	routeID, err := h.eventUsecase.GetEventRouteID(c.Request.Context(), c.Param("event_id"), optionalKratosID(c))
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	xmlBytes, err := h.exportGPXUsecase.ExportGPX(c.Request.Context(), routeID, optionalKratosID(c))
+	if err != nil {
+		returnEventDomainError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="route-%s.gpx"`, routeID))
+	c.Data(http.StatusOK, "application/gpx+xml", xmlBytes)
+}
+
+func bindEventRequest(c *gin.Context) (*eventUsecase.EventInputDto, bool) {
+	var req EventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return nil, false
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return nil, false
+	}
+
+	meetingPoint, err := pkgGeojson.ParseToPoint(req.MeetingPoint)
+	if err != nil {
+		response.ReturnBadRequest(c, errors.New("invalid meeting_point GeoJSON: "+err.Error()))
+		return nil, false
+	}
+
+	return &eventUsecase.EventInputDto{
+		RouteID:          req.RouteID,
+		Title:            req.Title,
+		Description:      req.Description,
+		StartsAt:         req.StartsAt,
+		MeetingPoint:     meetingPoint,
+		MeetingPointName: req.MeetingPointName,
+		Capacity:         req.Capacity,
+		Visibility:       req.Visibility,
+		ClubID:           req.ClubID,
+	}, true
+}
+
+// optionalKratosID はログインしている場合にKratosIDを返す。未ログインの場合は空文字
+func optionalKratosID(c *gin.Context) string {
+	var kratosID string
+	if kratosIDValue, exists := c.Get("kratos_id"); exists {
+		kratosID, _ = kratosIDValue.(string)
+	}
+	return kratosID
+}
+
+// kratosIDFromContext は認証ミドルウェアが設定したKratosIDを取得する。取得できない場合はエラーレスポンスを返す
+func kratosIDFromContext(c *gin.Context) (string, bool) {
+	kratosIDValue, exists := c.Get("kratos_id")
+	if !exists {
+		response.ReturnStatusUnauthorized(c, errors.New("user not authenticated"))
+		return "", false
+	}
+	kratosID, ok := kratosIDValue.(string)
+	if !ok {
+		response.ReturnStatusInternalServerError(c, errors.New("invalid kratos_id type"))
+		return "", false
+	}
+	return kratosID, true
+}
+
+// returnEventDomainError はドメインエラーの種類に応じたステータスコードを返す
+func returnEventDomainError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainerror.ErrValidation):
+		response.ReturnStatusBadRequest(c, err)
+	case errors.Is(err, domainerror.ErrUnauthorized):
+		response.ReturnStatusForbidden(c, err)
+	case errors.Is(err, domainerror.ErrNotFound):
+		response.ReturnStatusNotFound(c, err)
+	case errors.Is(err, domainerror.ErrConflict):
+		response.ReturnStatusConflict(c, err)
+	default:
+		response.ReturnStatusInternalServerError(c, err)
+	}
+}
+
+func eventResponseModel(dto *eventUsecase.EventOutputDto) EventResponseModel {
+	model := EventResponseModel{
+		ID:               dto.ID,
+		OrganizerID:      dto.OrganizerID,
+		Title:            dto.Title,
+		Description:      dto.Description,
+		StartsAt:         dto.StartsAt,
+		MeetingPoint:     geometry.GeometryToGeoJSON(dto.MeetingPoint),
+		MeetingPointName: dto.MeetingPointName,
+		Capacity:         dto.Capacity,
+		GoingCount:       dto.GoingCount,
+		Visibility:       dto.Visibility,
+		ClubID:           dto.ClubID,
+		CancelledAt:      dto.CancelledAt,
+		CancelReason:     dto.CancelReason,
+		MyRSVP:           dto.MyRSVP,
+		CreatedAt:        dto.CreatedAt,
+		UpdatedAt:        dto.UpdatedAt,
+	}
+	if r := dto.Route; r != nil {
+		model.Route = &EventRouteResponse{
+			ID:            r.ID,
+			Name:          r.Name,
+			UserName:      r.UserName,
+			Distance:      r.Distance,
+			ElevationGain: r.ElevationGain,
+			Polyline:      r.Polyline,
+		}
+	}
+	return model
+}
+
+func commentResponseModel(dto *eventUsecase.CommentOutputDto) CommentResponseModel {
+	return CommentResponseModel{
+		ID:        dto.ID,
+		UserID:    dto.UserID,
+		UserName:  dto.UserName,
+		Body:      dto.Body,
+		CreatedAt: dto.CreatedAt,
+	}
+}
@@ -0,0 +1,29 @@
+package event
+
+import "time"
+
+// EventRequest はイベント作成・更新のリクエスト。更新ではすべての項目を置き換える
+type EventRequest struct {
+	RouteID          string    `json:"route_id" validate:"required"`
+	Title            string    `json:"title" validate:"required"`
+	Description      string    `json:"description"`
+	StartsAt         time.Time `json:"starts_at" validate:"required"`     // RFC3339
+	MeetingPoint     string    `json:"meeting_point" validate:"required"` // 集合場所。GeoJSONのPoint
+	MeetingPointName string    `json:"meeting_point_name"`
+	Capacity         *int32    `json:"capacity" validate:"omitempty,min=1"` // 参加者の上限。省略すると上限なし
+	Visibility       int16     `json:"visibility" validate:"min=0,max=3"`   // 0: 非公開, 1: 公開, 2: 友達のみ, 3: クラブのメンバーのみ
+	ClubID           *string   `json:"club_id,omitempty"`                   // 公開範囲がクラブのメンバーのみの場合の共有先
+}
+
+// CancelEventRequest はイベント中止のリクエスト。理由は参加者への通知に含める
+type CancelEventRequest struct {
+	Reason string `json:"reason"`
+}
+
+type RSVPRequest struct {
+	Status string `json:"status" validate:"required,oneof=going maybe not_going"`
+}
+
+type CommentRequest struct {
+	Body string `json:"body" validate:"required"`
+}
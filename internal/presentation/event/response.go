@@ -0,0 +1,68 @@
+package event
+
+import "time"
+
+type EventListResponse struct {
+	Events []EventResponseModel `json:"events"`
+}
+
+type EventResponse struct {
+	Event EventResponseModel `json:"event"`
+}
+
+type EventResponseModel struct {
+	ID               string              `json:"id"`
+	OrganizerID      string              `json:"organizer_id"`
+	Title            string              `json:"title"`
+	Description      string              `json:"description"`
+	StartsAt         time.Time           `json:"starts_at"`
+	MeetingPoint     *string             `json:"meeting_point"` // GeoJSONのPoint
+	MeetingPointName string              `json:"meeting_point_name"`
+	Capacity         *int32              `json:"capacity"` // 上限なしの場合はnull
+	GoingCount       int32               `json:"going_count"`
+	Visibility       int16               `json:"visibility"`
+	ClubID           *string             `json:"club_id,omitempty"` // 公開範囲がクラブのメンバーのみの場合の共有先
+	CancelledAt      *time.Time          `json:"cancelled_at"`      // 中止していない場合はnull
+	CancelReason     string              `json:"cancel_reason,omitempty"`
+	Route            *EventRouteResponse `json:"route"`             // 閲覧できないルートの場合はnull
+	MyRSVP           string              `json:"my_rsvp,omitempty"` // going, maybe, not_going。返事していない場合は省略
+	CreatedAt        string              `json:"created_at"`
+	UpdatedAt        string              `json:"updated_at"`
+}
+
+type EventRouteResponse struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	UserName      string  `json:"user_name"`
+	Distance      float64 `json:"distance"`
+	ElevationGain float64 `json:"elevation_gain"`
+	Polyline      string  `json:"polyline"`
+}
+
+type RSVPListResponse struct {
+	RSVPs []RSVPResponseModel `json:"rsvps"`
+}
+
+type RSVPResponseModel struct {
+	UserID    string `json:"user_id"`
+	UserName  string `json:"user_name"`
+	Status    string `json:"status"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type CommentListResponse struct {
+	Comments   []CommentResponseModel `json:"comments"`
+	NextCursor *string                `json:"next_cursor"` // 次のページがない場合はnull
+}
+
+type CommentResponse struct {
+	Comment CommentResponseModel `json:"comment"`
+}
+
+type CommentResponseModel struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	UserName  string `json:"user_name"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+}
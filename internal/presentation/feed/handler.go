@@ -0,0 +1,126 @@
+package feed
+
+import (
+	"errors"
+	"strconv"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/response"
+	feedUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/feed"
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	feedUsecase feedUsecase.IFeedUsecase
+}
+
+func NewHandler(feedUsecase feedUsecase.IFeedUsecase) *Handler {
+	return &Handler{feedUsecase: feedUsecase}
+}
+
+// GetFeed godoc
+//
+//	@Summary		フォローしているユーザーのアクティビティフィードを取得する
+//	@Description	ルートの作成・トリップのアップロード・ルートへのいいね・コメントを新しい順に返す。閲覧できないルートやトリップの出来事は除くため、次のページがあっても件数がlimitより少ないことがある
+//	@Tags			feed
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			limit	query		integer	false	"Page size (default 20, max 100)"
+//	@Param			cursor	query		string	false	"Cursor returned as next_cursor in the previous page"
+//	@Success		200		{object}	FeedResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/feed [get]
+func (h *Handler) GetFeed(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var limit int32
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			response.ReturnStatusBadRequest(c, errors.New("invalid limit"))
+			return
+		}
+		limit = int32(l)
+	}
+
+	dto, err := h.feedUsecase.GetFeed(c.Request.Context(), kratosID, feedUsecase.FeedInputDto{
+		Limit:  limit,
+		Cursor: c.Query("cursor"),
+	})
+	if err != nil {
+		if errors.Is(err, domainerror.ErrValidation) {
+			response.ReturnStatusBadRequest(c, err)
+			return
+		}
+		response.ReturnStatusInternalServerError(c, err)
+		return
+	}
+
+	items := make([]FeedItemResponseModel, 0, len(dto.Items))
+	for i := range dto.Items {
+		items = append(items, feedItemResponseModel(&dto.Items[i]))
+	}
+
+	res := FeedResponse{Items: items}
+	if dto.NextCursor != "" {
+		res.NextCursor = &dto.NextCursor
+	}
+	response.ReturnStatusOK(c, res)
+}
+
+func kratosIDFromContext(c *gin.Context) (string, bool) {
+	kratosIDValue, exists := c.Get("kratos_id")
+	if !exists {
+		response.ReturnStatusUnauthorized(c, errors.New("user not authenticated"))
+		return "", false
+	}
+	kratosID, ok := kratosIDValue.(string)
+	if !ok {
+		response.ReturnStatusInternalServerError(c, errors.New("invalid kratos_id type"))
+		return "", false
+	}
+	return kratosID, true
+}
+
+func feedItemResponseModel(dto *feedUsecase.FeedItemOutputDto) FeedItemResponseModel {
+	item := FeedItemResponseModel{
+		ID:        dto.ID,
+		Type:      dto.Type,
+		ActorID:   dto.ActorID,
+		ActorName: dto.ActorName,
+		CreatedAt: dto.CreatedAt,
+	}
+	if r := dto.Route; r != nil {
+		item.Route = &FeedRouteResponseModel{
+			ID:            r.ID,
+			UserID:        r.UserID,
+			UserName:      r.UserName,
+			Name:          r.Name,
+			Distance:      r.Distance,
+			ElevationGain: r.ElevationGain,
+			Polyline:      r.Polyline,
+		}
+	}
+	if t := dto.Trip; t != nil {
+		item.Trip = &FeedTripResponseModel{
+			ID:            t.ID,
+			Name:          t.Name,
+			Distance:      t.Distance,
+			MovingTime:    t.MovingTime,
+			ElevationGain: t.ElevationGain,
+			DepartedAt:    t.DepartedAt,
+		}
+	}
+	if cm := dto.Comment; cm != nil {
+		item.Comment = &FeedCommentResponseModel{
+			ID:      cm.ID,
+			Content: cm.Content,
+		}
+	}
+	return item
+}
@@ -0,0 +1,41 @@
+package feed
+
+type FeedResponse struct {
+	Items      []FeedItemResponseModel `json:"items"`
+	NextCursor *string                 `json:"next_cursor"` // 次のページがない場合はnull
+}
+
+type FeedItemResponseModel struct {
+	ID        string                    `json:"id"`
+	Type      string                    `json:"type"` // route_created, trip_uploaded, route_liked, route_commented
+	ActorID   string                    `json:"actor_id"`
+	ActorName string                    `json:"actor_name"`
+	CreatedAt string                    `json:"created_at"`
+	Route     *FeedRouteResponseModel   `json:"route,omitempty"`   // ルートの作成・いいね・コメントの対象
+	Trip      *FeedTripResponseModel    `json:"trip,omitempty"`    // アップロードしたトリップ
+	Comment   *FeedCommentResponseModel `json:"comment,omitempty"` // ルートへのコメント
+}
+
+type FeedRouteResponseModel struct {
+	ID            string  `json:"id"`
+	UserID        string  `json:"user_id"`
+	UserName      string  `json:"user_name"`
+	Name          string  `json:"name"`
+	Distance      float64 `json:"distance"`
+	ElevationGain float64 `json:"elevation_gain"`
+	Polyline      string  `json:"polyline"`
+}
+
+type FeedTripResponseModel struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Distance      *float64 `json:"distance"`
+	MovingTime    *int32   `json:"moving_time"`
+	ElevationGain *float64 `json:"elevation_gain"`
+	DepartedAt    *string  `json:"departed_at"`
+}
+
+type FeedCommentResponseModel struct {
+	ID      string `json:"id"`
+	Content string `json:"content"`
+}
@@ -52,6 +52,18 @@ func (k *KratosMiddleware) Session() gin.HandlerFunc {
 	}
 }
 
+// OptionalSession はログインしていなくてもリクエストを通す。有効なセッションがある場合のみコンテキストに保存する
+func (k *KratosMiddleware) OptionalSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, err := k.validateSession(c.Request)
+		if err == nil && session.Active != nil && *session.Active {
+			c.Set("session", session)
+			c.Set("kratos_id", session.Identity.Id)
+		}
+		c.Next()
+	}
+}
+
 func (k *KratosMiddleware) validateSession(r *http.Request) (*ory.Session, error) {
 	cookie, err := r.Cookie("ory_kratos_session")
 	if err != nil {
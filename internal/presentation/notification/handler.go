@@ -0,0 +1,269 @@
+package notification
+
+import (
+	"errors"
+	"strconv"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/response"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/validator"
+	notificationUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/notification"
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	notificationUsecase notificationUsecase.INotificationUsecase
+}
+
+func NewHandler(notificationUsecase notificationUsecase.INotificationUsecase) *Handler {
+	return &Handler{notificationUsecase: notificationUsecase}
+}
+
+// ListNotifications godoc
+//
+//	@Summary		自分への通知を取得する
+//	@Description	自分のルートへのいいね・コメント・フォーク・保存と、自分へのフォローの通知を新しい順に返す
+//	@Tags			notifications
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			limit		query		integer	false	"Page size (default 20, max 100)"
+//	@Param			cursor		query		string	false	"Cursor returned as next_cursor in the previous page"
+//	@Param			unread_only	query		boolean	false	"未読の通知だけを返す"
+//	@Success		200			{object}	NotificationListResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/notifications [get]
+func (h *Handler) ListNotifications(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var limit int32
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			response.ReturnStatusBadRequest(c, errors.New("invalid limit"))
+			return
+		}
+		limit = int32(l)
+	}
+	var unreadOnly bool
+	if v := c.Query("unread_only"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			response.ReturnStatusBadRequest(c, errors.New("invalid unread_only"))
+			return
+		}
+		unreadOnly = b
+	}
+
+	dto, err := h.notificationUsecase.ListNotifications(c.Request.Context(), kratosID, notificationUsecase.ListNotificationsInputDto{
+		UnreadOnly: unreadOnly,
+		Limit:      limit,
+		Cursor:     c.Query("cursor"),
+	})
+	if err != nil {
+		returnNotificationDomainError(c, err)
+		return
+	}
+
+	notifications := make([]NotificationResponseModel, 0, len(dto.Notifications))
+	for _, n := range dto.Notifications {
+		notifications = append(notifications, NotificationResponseModel{
+			ID:         n.ID,
+			Type:       n.Type,
+			ActorID:    n.ActorID,
+			ActorName:  n.ActorName,
+			RouteID:    n.RouteID,
+			RouteName:  n.RouteName,
+			EventID:    n.EventID,
+			EventTitle: n.EventTitle,
+			Read:       n.Read,
+			CreatedAt:  n.CreatedAt,
+		})
+	}
+
+	res := NotificationListResponse{Notifications: notifications}
+	if dto.NextCursor != "" {
+		res.NextCursor = &dto.NextCursor
+	}
+	response.ReturnStatusOK(c, res)
+}
+
+// GetUnreadCount godoc
+//
+//	@Summary	自分への未読の通知の件数を取得する
+//	@Tags		notifications
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Success	200	{object}	UnreadCountResponse
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/notifications/unread-count [get]
+func (h *Handler) GetUnreadCount(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	count, err := h.notificationUsecase.GetUnreadCount(c.Request.Context(), kratosID)
+	if err != nil {
+		returnNotificationDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, UnreadCountResponse{Count: count})
+}
+
+// MarkRead godoc
+//
+//	@Summary		通知を既読にする
+//	@Description	既読の通知を指定してもエラーにしない
+//	@Tags			notifications
+//	@Security		CookieAuth
+//	@Param			notification_id	path	string	true	"Notification ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/notifications/{notification_id}/read [post]
+func (h *Handler) MarkRead(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.notificationUsecase.MarkRead(c.Request.Context(), kratosID, c.Param("notification_id")); err != nil {
+		returnNotificationDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// MarkAllRead godoc
+//
+//	@Summary	自分への通知をすべて既読にする
+//	@Tags		notifications
+//	@Security	CookieAuth
+//	@Success	204
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/notifications/read-all [post]
+func (h *Handler) MarkAllRead(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.notificationUsecase.MarkAllRead(c.Request.Context(), kratosID); err != nil {
+		returnNotificationDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// GetPreferences godoc
+//
+//	@Summary		受け取る通知の設定を取得する
+//	@Description	すべての種類の設定を返す。既定ではすべての種類を受け取る
+//	@Tags			notifications
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Success		200	{object}	PreferencesResponse
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/notifications/preferences [get]
+func (h *Handler) GetPreferences(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dtos, err := h.notificationUsecase.GetPreferences(c.Request.Context(), kratosID)
+	if err != nil {
+		returnNotificationDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, preferencesResponse(dtos))
+}
+
+// UpdatePreferences godoc
+//
+//	@Summary		受け取る通知の設定を変更する
+//	@Description	指定した種類の設定だけを変更し、変更後のすべての種類の設定を返す。受け取らない種類の通知は作成しない
+//	@Tags			notifications
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			request	body		UpdatePreferencesRequest	true	"Update Preferences Request"
+//	@Success		200		{object}	PreferencesResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/notifications/preferences [put]
+func (h *Handler) UpdatePreferences(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req UpdatePreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	preferences := make([]notificationUsecase.PreferenceDto, 0, len(req.Preferences))
+	for _, p := range req.Preferences {
+		preferences = append(preferences, notificationUsecase.PreferenceDto{Type: p.Type, Enabled: p.Enabled})
+	}
+	dtos, err := h.notificationUsecase.UpdatePreferences(c.Request.Context(), kratosID, preferences)
+	if err != nil {
+		returnNotificationDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, preferencesResponse(dtos))
+}
+
+func kratosIDFromContext(c *gin.Context) (string, bool) {
+	kratosIDValue, exists := c.Get("kratos_id")
+	if !exists {
+		response.ReturnStatusUnauthorized(c, errors.New("user not authenticated"))
+		return "", false
+	}
+	kratosID, ok := kratosIDValue.(string)
+	if !ok {
+		response.ReturnStatusInternalServerError(c, errors.New("invalid kratos_id type"))
+		return "", false
+	}
+	return kratosID, true
+}
+
+func returnNotificationDomainError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainerror.ErrValidation):
+		response.ReturnStatusBadRequest(c, err)
+	case errors.Is(err, domainerror.ErrNotFound):
+		response.ReturnStatusNotFound(c, err)
+	default:
+		response.ReturnStatusInternalServerError(c, err)
+	}
+}
+
+func preferencesResponse(dtos []notificationUsecase.PreferenceDto) PreferencesResponse {
+	preferences := make([]PreferenceResponseModel, 0, len(dtos))
+	for _, p := range dtos {
+		preferences = append(preferences, PreferenceResponseModel{Type: p.Type, Enabled: p.Enabled})
+	}
+	return PreferencesResponse{Preferences: preferences}
+}
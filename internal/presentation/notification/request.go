@@ -0,0 +1,11 @@
+package notification
+
+// UpdatePreferencesRequest は受け取る通知の設定の変更リクエスト。指定しなかった種類の設定は変更しない
+type UpdatePreferencesRequest struct {
+	Preferences []PreferenceRequest `json:"preferences" validate:"required,dive"`
+}
+
+type PreferenceRequest struct {
+	Type    string `json:"type" validate:"required,oneof=route_liked route_commented route_forked route_saved user_followed event_cancelled"`
+	Enabled bool   `json:"enabled"`
+}
@@ -0,0 +1,32 @@
+package notification
+
+type NotificationListResponse struct {
+	Notifications []NotificationResponseModel `json:"notifications"`
+	NextCursor    *string                     `json:"next_cursor"` // 次のページがない場合はnull
+}
+
+type NotificationResponseModel struct {
+	ID         string  `json:"id"`
+	Type       string  `json:"type"` // route_liked, route_commented, route_forked, route_saved, user_followed, event_cancelled
+	ActorID    string  `json:"actor_id"`
+	ActorName  string  `json:"actor_name"`
+	RouteID    *string `json:"route_id"` // フォロー・イベントの通知ではnull
+	RouteName  *string `json:"route_name"`
+	EventID    *string `json:"event_id"` // イベントの中止の通知のみ
+	EventTitle *string `json:"event_title"`
+	Read       bool    `json:"read"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+type UnreadCountResponse struct {
+	Count int64 `json:"count"`
+}
+
+type PreferencesResponse struct {
+	Preferences []PreferenceResponseModel `json:"preferences"`
+}
+
+type PreferenceResponseModel struct {
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
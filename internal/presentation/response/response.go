@@ -56,6 +56,18 @@ func ReturnNotFound(ctx *gin.Context, err error) {
 	ReturnStatusNotFound(ctx, err)
 }
 
+func ReturnStatusConflict(ctx *gin.Context, err error) {
+	returnAbortWith(ctx, http.StatusConflict, err)
+}
+
+func ReturnStatusPreconditionFailed(ctx *gin.Context, err error) {
+	returnAbortWith(ctx, http.StatusPreconditionFailed, err)
+}
+
+func ReturnStatusPreconditionRequired(ctx *gin.Context, err error) {
+	returnAbortWith(ctx, http.StatusPreconditionRequired, err)
+}
+
 func ReturnStatusInternalServerError(ctx *gin.Context, err error) {
 	returnAbortWith(ctx, http.StatusInternalServerError, err)
 }
@@ -3,24 +3,41 @@ package route
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
 	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/geojson"
 	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/geometry"
 	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/response"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/validator"
 	routeUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/route"
 	"github.com/gin-gonic/gin"
 	"github.com/paulmach/orb"
 )
 
+// 類似ルート取得件数の上限
+const maxSimilarRoutesLimit = 50
+
 type Handler struct {
-	createRouteUsecase routeUsecase.ICreateRouteUsecase
-	getRouteUsecase    routeUsecase.IGetRouteUsecase
-	updateRouteUsecase routeUsecase.IUpdateRouteUsecase
-	deleteRouteUsecase routeUsecase.IDeleteRouteUsecase
-	exportGPXUsecase   routeUsecase.IExportGPXUsecase
+	createRouteUsecase  routeUsecase.ICreateRouteUsecase
+	getRouteUsecase     routeUsecase.IGetRouteUsecase
+	updateRouteUsecase  routeUsecase.IUpdateRouteUsecase
+	deleteRouteUsecase  routeUsecase.IDeleteRouteUsecase
+	exportGPXUsecase    routeUsecase.IExportGPXUsecase
+	routeVersionUsecase routeUsecase.IRouteVersionUsecase
+	forkRouteUsecase    routeUsecase.IForkRouteUsecase
+	editGeometryUsecase routeUsecase.IEditRouteGeometryUsecase
+	generateCuesUsecase routeUsecase.IGenerateCuesUsecase
+	cueSheetUsecase     routeUsecase.ICueSheetUsecase
+	planRouteUsecase    routeUsecase.IPlanRouteUsecase
+	matchTripUsecase    routeUsecase.IMatchTripUsecase
+	convertTripUsecase  routeUsecase.IConvertTripUsecase
+	routePOIUsecase     routeUsecase.IRoutePOIUsecase
+	routeTagUsecase     routeUsecase.IRouteTagUsecase
+	reactionUsecase     routeUsecase.IRouteReactionUsecase
 }
 
 func NewHandler(
@@ -29,13 +46,35 @@ func NewHandler(
 	updateRouteUsecase routeUsecase.IUpdateRouteUsecase,
 	deleteRouteUsecase routeUsecase.IDeleteRouteUsecase,
 	exportGPXUsecase routeUsecase.IExportGPXUsecase,
+	routeVersionUsecase routeUsecase.IRouteVersionUsecase,
+	forkRouteUsecase routeUsecase.IForkRouteUsecase,
+	editGeometryUsecase routeUsecase.IEditRouteGeometryUsecase,
+	generateCuesUsecase routeUsecase.IGenerateCuesUsecase,
+	cueSheetUsecase routeUsecase.ICueSheetUsecase,
+	planRouteUsecase routeUsecase.IPlanRouteUsecase,
+	matchTripUsecase routeUsecase.IMatchTripUsecase,
+	convertTripUsecase routeUsecase.IConvertTripUsecase,
+	routePOIUsecase routeUsecase.IRoutePOIUsecase,
+	routeTagUsecase routeUsecase.IRouteTagUsecase,
+	reactionUsecase routeUsecase.IRouteReactionUsecase,
 ) *Handler {
 	return &Handler{
-		createRouteUsecase: createRouteUsecase,
-		getRouteUsecase:    getRouteUsecase,
-		updateRouteUsecase: updateRouteUsecase,
-		deleteRouteUsecase: deleteRouteUsecase,
-		exportGPXUsecase:   exportGPXUsecase,
+		createRouteUsecase:  createRouteUsecase,
+		getRouteUsecase:     getRouteUsecase,
+		updateRouteUsecase:  updateRouteUsecase,
+		deleteRouteUsecase:  deleteRouteUsecase,
+		exportGPXUsecase:    exportGPXUsecase,
+		routeVersionUsecase: routeVersionUsecase,
+		forkRouteUsecase:    forkRouteUsecase,
+		editGeometryUsecase: editGeometryUsecase,
+		generateCuesUsecase: generateCuesUsecase,
+		cueSheetUsecase:     cueSheetUsecase,
+		planRouteUsecase:    planRouteUsecase,
+		matchTripUsecase:    matchTripUsecase,
+		convertTripUsecase:  convertTripUsecase,
+		routePOIUsecase:     routePOIUsecase,
+		routeTagUsecase:     routeTagUsecase,
+		reactionUsecase:     reactionUsecase,
 	}
 }
 
@@ -50,6 +89,7 @@ func NewHandler(
 //	@Success	201		{object}	RouteResponse
 //	@Failure	400		{object}	response.ErrorResponse
 //	@Failure	401		{object}	response.ErrorResponse
+//	@Failure	403		{object}	response.ErrorResponse	"共有先のクラブに所属していない"
 //	@Failure	500		{object}	response.ErrorResponse
 //	@Router		/routes [post]
 func (h *Handler) CreateRoute(c *gin.Context) {
@@ -139,112 +179,84 @@ func (h *Handler) CreateRoute(c *gin.Context) {
 		FirstPoint:         firstPoint,
 		LastPoint:          lastPoint,
 		Visibility:         req.Visibility,
+		ClubID:             req.ClubID,
 		CoursePoints:       coursePoints,
 		Waypoints:          waypoints,
 	}
 
 	dto, err := h.createRouteUsecase.CreateRoute(c.Request.Context(), input)
 	if err != nil {
-		response.ReturnStatusInternalServerError(c, err)
+		returnRouteDomainError(c, err)
 		return
 	}
 
-	res := RouteResponse{
-		Route: RouteResponseModel{
-			ID:                 dto.ID,
-			UserID:             dto.UserID,
-			Name:               dto.Name,
-			Description:        dto.Description,
-			HighlightedPhotoID: dto.HighlightedPhotoID,
-			Distance:           dto.Distance,
-			Duration:           dto.Duration,
-			ElevationGain:      dto.ElevationGain,
-			ElevationLoss:      dto.ElevationLoss,
-			PathGeom:           geometry.GeometryToGeoJSON(dto.PathGeom),
-			FirstPoint:         geometry.GeometryToGeoJSON(dto.FirstPoint),
-			LastPoint:          geometry.GeometryToGeoJSON(dto.LastPoint),
-			Polyline:           dto.Polyline,
-			Visibility:         dto.Visibility,
-		},
-	}
-
-	response.ReturnStatusCreated(c, res)
+	response.ReturnStatusCreated(c, RouteResponse{Route: createdRouteResponseModel(dto)})
 }
 
 // GetRouteByID godoc
 //
-//	@Summary	ルートを取得する
-//	@Tags		routes
-//	@Accept		json
-//	@Produce	json
-//	@Param		route_id	path		string	true	"Route ID"
-//	@Success	200			{object}	RouteResponse
-//	@Failure	400			{object}	response.ErrorResponse
-//	@Failure	404			{object}	response.ErrorResponse
-//	@Failure	500			{object}	response.ErrorResponse
-//	@Router		/routes/{route_id} [get]
+//	@Summary		ルートを取得する
+//	@Description	ログインしている場合は、過去のトリップから推定した所要時間（estimated_duration_for_me）も返す。閲覧できないルートは見つからないものとして扱う
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Success		200			{object}	RouteResponse
+//	@Header			200			{string}	ETag	"ルートのバージョン。更新時にIf-Matchヘッダーに指定する"
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id} [get]
 func (h *Handler) GetRouteByID(c *gin.Context) {
 	id := c.Param("route_id")
 
-	dto, err := h.getRouteUsecase.GetRouteByID(c.Request.Context(), id)
-	if err != nil {
-		response.ReturnStatusInternalServerError(c, err)
-		return
-	}
-
-	// CoursePointsの変換
-	coursePoints := make([]CoursePointResponse, len(dto.CoursePoints))
-	for i, cp := range dto.CoursePoints {
-		coursePoints[i] = CoursePointResponse{
-			ID:            cp.ID,
-			StepOrder:     cp.StepOrder,
-			SegDistM:      cp.SegDistM,
-			CumDistM:      cp.CumDistM,
-			Duration:      cp.Duration,
-			Instruction:   cp.Instruction,
-			RoadName:      cp.RoadName,
-			ManeuverType:  cp.ManeuverType,
-			Modifier:      cp.Modifier,
-			Location:      geometry.GeometryToGeoJSON(cp.Location),
-			BearingBefore: cp.BearingBefore,
-			BearingAfter:  cp.BearingAfter,
-		}
+	// 未ログインでも取得できる。ログインしている場合のみ推定所要時間を求める
+	var kratosID string
+	if kratosIDValue, exists := c.Get("kratos_id"); exists {
+		kratosID, _ = kratosIDValue.(string)
 	}
 
-	// Waypointsの変換
-	waypoints := make([]WaypointResponse, len(dto.Waypoints))
-	for i, wp := range dto.Waypoints {
-		waypoints[i] = WaypointResponse{
-			ID:       wp.ID,
-			Location: geometry.GeometryToGeoJSON(wp.Location),
-		}
+	dto, err := h.getRouteUsecase.GetRouteByID(c.Request.Context(), id, kratosID)
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
 	}
 
 	res := RouteResponse{
 		Route: RouteResponseModel{
-			ID:                 dto.ID,
-			Name:               dto.Name,
-			UserID:             dto.UserID,
-			UserName:           dto.UserName,
-			Description:        dto.Description,
-			HighlightedPhotoID: dto.HighlightedPhotoID,
-			Distance:           dto.Distance,
-			Duration:           dto.Duration,
-			ElevationGain:      dto.ElevationGain,
-			ElevationLoss:      dto.ElevationLoss,
-			PathGeom:           geometry.GeometryToGeoJSON(dto.PathGeom),
-			Bbox:               geometry.GeometryToGeoJSON(dto.Bbox),
-			FirstPoint:         geometry.GeometryToGeoJSON(dto.FirstPoint),
-			LastPoint:          geometry.GeometryToGeoJSON(dto.LastPoint),
-			Polyline:           dto.Polyline,
-			Visibility:         dto.Visibility,
-			CreatedAt:          dto.CreatedAt,
-			UpdatedAt:          dto.UpdatedAt,
-			CoursePoints:       coursePoints,
-			Waypoints:          waypoints,
+			ID:                     dto.ID,
+			Name:                   dto.Name,
+			UserID:                 dto.UserID,
+			UserName:               dto.UserName,
+			Description:            dto.Description,
+			HighlightedPhotoID:     dto.HighlightedPhotoID,
+			Distance:               dto.Distance,
+			Duration:               dto.Duration,
+			ElevationGain:          dto.ElevationGain,
+			ElevationLoss:          dto.ElevationLoss,
+			PathGeom:               geometry.GeometryToGeoJSON(dto.PathGeom),
+			Bbox:                   geometry.GeometryToGeoJSON(dto.Bbox),
+			FirstPoint:             geometry.GeometryToGeoJSON(dto.FirstPoint),
+			LastPoint:              geometry.GeometryToGeoJSON(dto.LastPoint),
+			Polyline:               dto.Polyline,
+			Visibility:             dto.Visibility,
+			ClubID:                 dto.ClubID,
+			ForkedFromRouteID:      dto.ForkedFromRouteID,
+			ForkCount:              &dto.ForkCount,
+			CreatedAt:              dto.CreatedAt,
+			UpdatedAt:              dto.UpdatedAt,
+			CoursePoints:           coursePointResponses(dto.CoursePoints),
+			Waypoints:              waypointResponses(dto.Waypoints),
+			SurfaceBreakdown:       surfaceBreakdownResponse(dto.SurfaceBreakdown),
+			StartPlace:             placeResponse(dto.StartPlace),
+			EndPlace:               placeResponse(dto.EndPlace),
+			Difficulty:             dto.Difficulty,
+			EstimatedDurationForMe: dto.EstimatedDurationForMe,
+			Tags:                   dto.Tags,
 		},
 	}
 
+	c.Header("ETag", routeETag(dto.Version))
 	response.ReturnStatusOK(c, res)
 }
 
@@ -256,16 +268,26 @@ func (h *Handler) GetRouteByID(c *gin.Context) {
 //	@Produce	json
 //	@Security	CookieAuth
 //	@Param		route_id	path	string				true	"Route ID"
+//	@Param		If-Match	header	string				true	"ルート取得時のETag"
 //	@Param		request		body	UpdateRouteRequest	true	"Update Route Request"
 //	@Success	204
 //	@Failure	400	{object}	response.ErrorResponse
 //	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	403	{object}	response.ErrorResponse	"共有先のクラブに所属していない"
 //	@Failure	404	{object}	response.ErrorResponse
+//	@Failure	412	{object}	response.ErrorResponse	"取得後に別のリクエストで更新されている"
+//	@Failure	428	{object}	response.ErrorResponse	"If-Matchヘッダーがない"
 //	@Failure	500	{object}	response.ErrorResponse
 //	@Router		/routes/{route_id} [put]
 func (h *Handler) UpdateRoute(c *gin.Context) {
 	routeID := c.Param("route_id")
 
+	// 同じルートを複数のタブで編集したときに上書きしないよう、取得時のETagを必須にする
+	expectedVersion, ok := expectedRouteVersion(c)
+	if !ok {
+		return
+	}
+
 	// 認証ミドルウェアからKratosIDを取得
 	kratosIDValue, exists := c.Get("kratos_id")
 	if !exists {
@@ -352,12 +374,18 @@ func (h *Handler) UpdateRoute(c *gin.Context) {
 		FirstPoint:         firstPoint,
 		LastPoint:          lastPoint,
 		Visibility:         req.Visibility,
+		ClubID:             req.ClubID,
 		CoursePoints:       coursePoints,
 		Waypoints:          waypoints,
+		ExpectedVersion:    expectedVersion,
 	}
 
 	if err := h.updateRouteUsecase.UpdateRoute(c.Request.Context(), input); err != nil {
-		response.ReturnStatusInternalServerError(c, err)
+		if errors.Is(err, domainerror.ErrConflict) {
+			response.ReturnStatusPreconditionFailed(c, err)
+			return
+		}
+		returnRouteDomainError(c, err)
 		return
 	}
 
@@ -394,7 +422,7 @@ func (h *Handler) DeleteRoute(c *gin.Context) {
 	}
 
 	if err := h.deleteRouteUsecase.DeleteRoute(c.Request.Context(), routeID, kratosID); err != nil {
-		response.ReturnStatusInternalServerError(c, err)
+		returnRouteDomainError(c, err)
 		return
 	}
 
@@ -407,23 +435,44 @@ func (h *Handler) DeleteRoute(c *gin.Context) {
 //	@Tags		routes
 //	@Accept		json
 //	@Produce	json
-//	@Param		keyword			query		string	false	"Keyword to search in route names"
+//	@Param		keyword			query		string	false	"Keyword to search in route names, descriptions and road names"
 //	@Param		min_distance	query		string	false	"Minimum distance filter"
 //	@Param		max_distance	query		string	false	"Maximum distance filter"
-//	@Param		min_elevation	query		string	false	"Minimum elevation gain filter"
-//	@Param		max_elevation	query		string	false	"Maximum elevation gain filter"
-//	@Param		visibility		query		string	false	"Visibility filter"
-//	@Param		author			query		string	false	"Author filter"
+//	@Param		min_elevation		query		string	false	"Minimum elevation gain filter (meters)"
+//	@Param		max_elevation		query		string	false	"Maximum elevation gain filter (meters)"
+//	@Param		min_duration		query		string	false	"Minimum duration filter (seconds)"
+//	@Param		max_duration		query		string	false	"Maximum duration filter (seconds)"
+//	@Param		min_climbing_ratio	query		string	false	"Minimum climbing ratio filter (elevation gain m per km)"
+//	@Param		max_climbing_ratio	query		string	false	"Maximum climbing ratio filter (elevation gain m per km)"
+//	@Param		max_unpaved_percentage	query		string	false	"Maximum unpaved (gravel and dirt) percentage filter (0-100)"
+//	@Param		area				query		string	false	"Place name filter matching the start or end locality / administrative area by prefix"
+//	@Param		visibility			query		string	false	"Visibility filter"
+//	@Param		author				query		string	false	"Author name filter (partial match). Only narrows results with collection_id, since other searches return the caller's own routes"
+//	@Param		collection_id		query		string	false	"Collection ID filter (includes other users' routes in the collection that the caller can view)"
+//	@Param		tag					query		string	false	"Tag filter"
+//	@Param		sort				query		string	false	"Sort order (default: relevance when keyword given, position when collection_id given, otherwise newest)"	Enums(newest, most_liked, longest, hilliest, relevance, position)
+//	@Param		limit				query		integer	false	"Page size (default 20, max 100)"
+//	@Param		cursor				query		string	false	"Cursor returned as next_cursor in the previous page"
 //	@Success	200				{object}	RouteListResponse
 //	@Failure	400				{object}	response.ErrorResponse
 //	@Failure	401				{object}	response.ErrorResponse
+//	@Failure	404				{object}	response.ErrorResponse	"コレクションが存在しないか閲覧できない"
 //	@Failure	500				{object}	response.ErrorResponse
 //	@Router		/routes [get]
 func (h *Handler) GetRoutesByUserID(c *gin.Context) {
 	keyword := c.Query("keyword")
-	// min_elevation := c.Query("min_elevation")
-	// max_elevation := c.Query("max_elevation")
-	// author := c.Query("author")
+
+	filter, err := parseRouteFilter(c)
+	if err != nil {
+		response.ReturnBadRequest(c, err)
+		return
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		response.ReturnBadRequest(c, err)
+		return
+	}
 
 	var visibilityPtr *int16
 	if v := c.Query("visibility"); v != "" {
@@ -469,11 +518,17 @@ func (h *Handler) GetRoutesByUserID(c *gin.Context) {
 	}
 
 	input := routeUsecase.SearchRoutesInputDto{
-		KratosID:    kratosID,
-		Keyword:     keyword,
-		Visibility:  visibilityPtr,
-		MinDistance: minDistancePtr,
-		MaxDistance: maxDistancePtr,
+		KratosID:     kratosID,
+		Keyword:      keyword,
+		Visibility:   visibilityPtr,
+		MinDistance:  minDistancePtr,
+		MaxDistance:  maxDistancePtr,
+		Filter:       filter,
+		CollectionID: c.Query("collection_id"),
+		Tag:          c.Query("tag"),
+		Sort:         c.Query("sort"),
+		Limit:        limit,
+		Cursor:       c.Query("cursor"),
 	}
 
 	dtos, err := h.getRouteUsecase.GetRoutesByUserID(c.Request.Context(), input)
@@ -482,12 +537,16 @@ func (h *Handler) GetRoutesByUserID(c *gin.Context) {
 			response.ReturnBadRequest(c, err)
 			return
 		}
+		if errors.Is(err, domainerror.ErrNotFound) {
+			response.ReturnStatusNotFound(c, err)
+			return
+		}
 		response.ReturnStatusInternalServerError(c, err)
 		return
 	}
 
-	routes := make([]RouteResponseModel, len(dtos))
-	for i, dto := range dtos {
+	routes := make([]RouteResponseModel, len(dtos.Items))
+	for i, dto := range dtos.Items {
 		routes[i] = RouteResponseModel{
 			ID:                 dto.ID,
 			UserID:             dto.UserID,
@@ -503,11 +562,15 @@ func (h *Handler) GetRoutesByUserID(c *gin.Context) {
 			Polyline:           dto.Polyline,
 			CreatedAt:          dto.CreatedAt,
 			UpdatedAt:          dto.UpdatedAt,
+			Difficulty:         dto.Difficulty,
+			Tags:               dto.Tags,
+			Highlight:          highlightResponse(dto.Highlight),
 		}
 	}
 
 	res := RouteListResponse{
-		Routes: routes,
+		Routes:     routes,
+		NextCursor: nextCursorResponse(dtos.NextCursor),
 	}
 
 	response.ReturnStatusOK(c, res)
@@ -520,13 +583,27 @@ func (h *Handler) GetRoutesByUserID(c *gin.Context) {
 //	@Accept		json
 //	@Produce	json
 //	@Security	CookieAuth
-//	@Param		q				query		string	false	"Keyword to search in route names"
+//	@Param		q				query		string	false	"Keyword to search in route names, descriptions and road names"
 //	@Param		lat				query		number	false	"Latitude of the reference point"
 //	@Param		lng				query		number	false	"Longitude of the reference point"
 //	@Param		r				query		integer	false	"Search radius (meters)"
 //	@Param		min_distance	query		number	false	"Minimum distance filter (kilometers)"
 //	@Param		max_distance	query		number	false	"Maximum distance filter (kilometers)"
-//	@Param		offset			query		integer	false	"Pagination offset"
+//	@Param		min_elevation		query		number	false	"Minimum elevation gain filter (meters)"
+//	@Param		max_elevation		query		number	false	"Maximum elevation gain filter (meters)"
+//	@Param		min_duration		query		number	false	"Minimum duration filter (seconds)"
+//	@Param		max_duration		query		number	false	"Maximum duration filter (seconds)"
+//	@Param		min_climbing_ratio	query		number	false	"Minimum climbing ratio filter (elevation gain m per km)"
+//	@Param		max_climbing_ratio	query		number	false	"Maximum climbing ratio filter (elevation gain m per km)"
+//	@Param		max_unpaved_percentage	query		number	false	"Maximum unpaved (gravel and dirt) percentage filter (0-100)"
+//	@Param		area				query		string	false	"Place name filter matching the start or end locality / administrative area by prefix"
+//	@Param		min_difficulty		query		string	false	"Minimum difficulty filter"	Enums(easy, moderate, hard, expert)
+//	@Param		max_difficulty		query		string	false	"Maximum difficulty filter"	Enums(easy, moderate, hard, expert)
+//	@Param		author				query		string	false	"Author name filter"
+//	@Param		sort				query		string	false	"Sort order (default: nearest when lat/lng given, relevance when q given, otherwise newest)"	Enums(nearest, newest, most_liked, longest, hilliest, relevance)
+//	@Param		limit			query		integer	false	"Page size (default 20, max 100)"
+//	@Param		cursor			query		string	false	"Cursor returned as next_cursor in the previous page"
+//	@Param		collapse		query		boolean	false	"Collapse near-duplicate routes into one within the page. The page may then have fewer routes than limit even if next_cursor is set"
 //	@Success	200				{object}	RouteListResponse
 //	@Failure	400				{object}	response.ErrorResponse
 //	@Failure	401				{object}	response.ErrorResponse
@@ -539,7 +616,7 @@ func (h *Handler) ExploreRoutes(c *gin.Context) {
 	radius := c.Query("r")
 	min_distance := c.Query("min_distance")
 	max_distance := c.Query("max_distance")
-	offsetStr := c.Query("offset")
+	collapseStr := c.Query("collapse")
 
 	var latitudePtr, longitudePtr *float64
 	var radiusPtr *int32
@@ -599,18 +676,26 @@ func (h *Handler) ExploreRoutes(c *gin.Context) {
 		maxDistancePtr = &maxDistance
 	}
 
-	var offset int32
-	if offsetStr != "" {
-		o, err := strconv.ParseInt(offsetStr, 10, 32)
+	limit, err := parseLimit(c)
+	if err != nil {
+		response.ReturnBadRequest(c, err)
+		return
+	}
+
+	filter, err := parseRouteFilter(c)
+	if err != nil {
+		response.ReturnBadRequest(c, err)
+		return
+	}
+
+	var collapse bool
+	if collapseStr != "" {
+		b, err := strconv.ParseBool(collapseStr)
 		if err != nil {
-			response.ReturnBadRequest(c, errors.New("invalid offset"))
-			return
-		}
-		if o < 0 {
-			response.ReturnBadRequest(c, errors.New("offset must be non-negative"))
+			response.ReturnBadRequest(c, errors.New("invalid collapse"))
 			return
 		}
-		offset = int32(o)
+		collapse = b
 	}
 
 	_, exists := c.Get("kratos_id")
@@ -620,12 +705,18 @@ func (h *Handler) ExploreRoutes(c *gin.Context) {
 	}
 
 	input := routeUsecase.ExploreRoutesInputDto{
-		Keyword:     keyword,
-		Location:    location,
-		Radius:      radiusPtr,
-		MinDistance: minDistancePtr,
-		MaxDistance: maxDistancePtr,
-		Offset:      offset,
+		Keyword:            keyword,
+		Location:           location,
+		Radius:             radiusPtr,
+		MinDistance:        minDistancePtr,
+		MaxDistance:        maxDistancePtr,
+		Filter:             filter,
+		MinDifficulty:      c.Query("min_difficulty"),
+		MaxDifficulty:      c.Query("max_difficulty"),
+		Sort:               c.Query("sort"),
+		Limit:              limit,
+		Cursor:             c.Query("cursor"),
+		CollapseDuplicates: collapse,
 	}
 
 	dtos, err := h.getRouteUsecase.ExploreRoutes(c.Request.Context(), input)
@@ -655,17 +746,148 @@ func (h *Handler) ExploreRoutes(c *gin.Context) {
 			Polyline:           dto.Polyline,
 			CreatedAt:          dto.CreatedAt,
 			UpdatedAt:          dto.UpdatedAt,
+			Difficulty:         dto.Difficulty,
+			Tags:               dto.Tags,
+			Highlight:          highlightResponse(dto.Highlight),
 		}
 	}
 
 	res := RouteListResponse{
 		Routes:     routes,
-		TotalCount: dtos.TotalCount,
+		NextCursor: nextCursorResponse(dtos.NextCursor),
 	}
 
 	response.ReturnStatusOK(c, res)
 }
 
+// GetSimilarRoutes godoc
+//
+//	@Summary		類似ルートを取得する
+//	@Description	未ログインでも取得できる。閲覧できないルートは見つからないものとして扱い、候補は公開のルートだけから探す
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Param			limit		query		integer	false	"Maximum number of candidates (default 10, max 50)"
+//	@Success		200			{object}	SimilarRouteListResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/similar [get]
+func (h *Handler) GetSimilarRoutes(c *gin.Context) {
+	routeID := c.Param("route_id")
+	if routeID == "" {
+		response.ReturnBadRequest(c, errors.New("route_id is required"))
+		return
+	}
+
+	var limit int32
+	if limitStr := c.Query("limit"); limitStr != "" {
+		l, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil {
+			response.ReturnBadRequest(c, errors.New("invalid limit"))
+			return
+		}
+		if l <= 0 || l > maxSimilarRoutesLimit {
+			response.ReturnBadRequest(c, fmt.Errorf("limit must be between 1 and %d", maxSimilarRoutesLimit))
+			return
+		}
+		limit = int32(l)
+	}
+
+	// 未ログインでも取得できる。候補は公開のルートだけから探す
+	var kratosID string
+	if kratosIDValue, exists := c.Get("kratos_id"); exists {
+		kratosID, _ = kratosIDValue.(string)
+	}
+
+	dtos, err := h.getRouteUsecase.GetSimilarRoutes(c.Request.Context(), routeID, kratosID, limit)
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	routes := make([]SimilarRouteResponseModel, len(dtos))
+	for i, dto := range dtos {
+		routes[i] = SimilarRouteResponseModel{
+			RouteResponseModel: RouteResponseModel{
+				ID:                 dto.ID,
+				UserID:             dto.UserID,
+				UserName:           dto.UserName,
+				Name:               dto.Name,
+				Description:        dto.Description,
+				HighlightedPhotoID: dto.HighlightedPhotoID,
+				Distance:           dto.Distance,
+				Duration:           dto.Duration,
+				ElevationGain:      dto.ElevationGain,
+				ElevationLoss:      dto.ElevationLoss,
+				Visibility:         dto.Visibility,
+				Polyline:           dto.Polyline,
+				CreatedAt:          dto.CreatedAt,
+				UpdatedAt:          dto.UpdatedAt,
+				Difficulty:         dto.Difficulty,
+			},
+			HausdorffDistance: dto.HausdorffDistance,
+			FrechetDistance:   dto.FrechetDistance,
+			IsDuplicate:       dto.IsDuplicate,
+		}
+	}
+
+	response.ReturnStatusOK(c, SimilarRouteListResponse{Routes: routes})
+}
+
+// parseRouteFilter は検索・探索で共通の絞り込み条件をクエリパラメータから取得する
+func parseRouteFilter(c *gin.Context) (routeUsecase.RouteFilterInputDto, error) {
+	var filter routeUsecase.RouteFilterInputDto
+	params := []struct {
+		name string
+		dst  **float64
+	}{
+		{"min_elevation", &filter.MinElevationGain},
+		{"max_elevation", &filter.MaxElevationGain},
+		{"min_duration", &filter.MinDuration},
+		{"max_duration", &filter.MaxDuration},
+		{"min_climbing_ratio", &filter.MinClimbingRatio},
+		{"max_climbing_ratio", &filter.MaxClimbingRatio},
+		{"max_unpaved_percentage", &filter.MaxUnpavedPercentage},
+	}
+	for _, p := range params {
+		v := c.Query(p.name)
+		if v == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return filter, fmt.Errorf("invalid %s", p.name)
+		}
+		*p.dst = &f
+	}
+	filter.Author = c.Query("author")
+	filter.Area = c.Query("area")
+	return filter, nil
+}
+
+// parseLimit はページサイズをクエリパラメータから取得する。未指定の場合は0を返す
+func parseLimit(c *gin.Context) (int32, error) {
+	v := c.Query("limit")
+	if v == "" {
+		return 0, nil
+	}
+	limit, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0, errors.New("invalid limit")
+	}
+	return int32(limit), nil
+}
+
+// nextCursorResponse は次のページがない場合にnullを返すよう変換する
+func nextCursorResponse(nextCursor string) *string {
+	if nextCursor == "" {
+		return nil
+	}
+	return &nextCursor
+}
+
 // ExportRouteGPX godoc
 //
 //	@Summary	ルートをGPX形式でエクスポートする
@@ -686,12 +908,1329 @@ func (h *Handler) ExportRouteGPX(c *gin.Context) {
 		response.ReturnBadRequest(c, errors.New("route_id is required"))
 		return
 	}
-	xmlBytes, err := h.exportGPXUsecase.ExportGPX(c.Request.Context(), routeID)
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	xmlBytes, err := h.exportGPXUsecase.ExportGPX(c.Request.Context(), routeID, kratosID)
 	if err != nil {
-		response.ReturnStatusInternalServerError(c, err)
+		returnRouteDomainError(c, err)
 		return
 	}
 
 	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="route-%s.gpx"`, routeID))
 	c.Data(http.StatusOK, "application/gpx+xml", xmlBytes)
 }
+
+// routeETag はルートのバージョンからETagを作る
+func routeETag(version int32) string {
+	return strconv.Quote(strconv.FormatInt(int64(version), 10))
+}
+
+// expectedRouteVersion はIf-Matchヘッダーから更新対象のバージョンを取り出す
+// ヘッダーがない場合は428、解釈できない場合は412を返してfalseを返す
+func expectedRouteVersion(c *gin.Context) (int32, bool) {
+	ifMatch := c.GetHeader("If-Match")
+	if ifMatch == "" {
+		response.ReturnStatusPreconditionRequired(c, errors.New("If-Match header is required"))
+		return 0, false
+	}
+	expectedVersion, ok := parseRouteETag(ifMatch)
+	if !ok {
+		response.ReturnStatusPreconditionFailed(c, errors.New("If-Match does not match the current route"))
+		return 0, false
+	}
+	return expectedVersion, true
+}
+
+// parseRouteETag はIf-Matchヘッダーからルートのバージョンを取り出す
+// 弱いETagや複数指定は一致しないものとして扱う
+func parseRouteETag(ifMatch string) (int32, bool) {
+	tag := strings.TrimSpace(ifMatch)
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(v), true
+}
+
+// highlightResponse はキーワード検索時のスニペットをレスポンスに変換する
+func highlightResponse(h *routeUsecase.RouteHighlightDto) *RouteHighlightResponse {
+	if h == nil {
+		return nil
+	}
+	return &RouteHighlightResponse{Name: h.Name, Description: h.Description}
+}
+
+// ForkRoute godoc
+//
+//	@Summary		ルートをフォークする
+//	@Description	閲覧できるルートをコースポイント・ウェイポイントごと複製し、ログインユーザーの非公開ルートとして作成する
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Success		201			{object}	RouteResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/fork [post]
+func (h *Handler) ForkRoute(c *gin.Context) {
+	routeID := c.Param("route_id")
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.forkRouteUsecase.ForkRoute(c.Request.Context(), routeID, kratosID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrNotFound) {
+			response.ReturnStatusNotFound(c, err)
+			return
+		}
+		response.ReturnStatusInternalServerError(c, err)
+		return
+	}
+
+	route := createdRouteResponseModel(&dto.CreateRouteUseCaseOutputDto)
+	route.ForkedFromRouteID = &dto.ForkedFromRouteID
+
+	response.ReturnStatusCreated(c, RouteResponse{Route: route})
+}
+
+// createdRouteResponseModel は作成したルートのレスポンスを作る
+func createdRouteResponseModel(dto *routeUsecase.CreateRouteUseCaseOutputDto) RouteResponseModel {
+	return RouteResponseModel{
+		ID:                 dto.ID,
+		UserID:             dto.UserID,
+		Name:               dto.Name,
+		Description:        dto.Description,
+		HighlightedPhotoID: dto.HighlightedPhotoID,
+		Distance:           dto.Distance,
+		Duration:           dto.Duration,
+		ElevationGain:      dto.ElevationGain,
+		ElevationLoss:      dto.ElevationLoss,
+		PathGeom:           geometry.GeometryToGeoJSON(dto.PathGeom),
+		FirstPoint:         geometry.GeometryToGeoJSON(dto.FirstPoint),
+		LastPoint:          geometry.GeometryToGeoJSON(dto.LastPoint),
+		Polyline:           dto.Polyline,
+		Visibility:         dto.Visibility,
+		ClubID:             dto.ClubID,
+	}
+}
+
+// ListRouteVersions godoc
+//
+//	@Summary		ルートの更新履歴を取得する
+//	@Description	更新のたびに保存された更新前の状態を新しい順に返す。差分は「その版の値 - 現在の値」
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Success		200			{object}	RouteVersionListResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		403			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/versions [get]
+func (h *Handler) ListRouteVersions(c *gin.Context) {
+	routeID := c.Param("route_id")
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dtos, err := h.routeVersionUsecase.ListRouteVersions(c.Request.Context(), routeID, kratosID)
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	versions := make([]RouteVersionResponseModel, len(dtos))
+	for i, dto := range dtos {
+		versions[i] = routeVersionResponseModel(dto)
+	}
+	response.ReturnStatusOK(c, RouteVersionListResponse{Versions: versions})
+}
+
+// GetRouteVersion godoc
+//
+//	@Summary	ルートの特定の版を形状付きで取得する
+//	@Tags		routes
+//	@Accept		json
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Param		route_id	path		string	true	"Route ID"
+//	@Param		version		path		int		true	"版番号"
+//	@Success	200			{object}	RouteVersionResponse
+//	@Failure	400			{object}	response.ErrorResponse
+//	@Failure	401			{object}	response.ErrorResponse
+//	@Failure	403			{object}	response.ErrorResponse
+//	@Failure	404			{object}	response.ErrorResponse
+//	@Failure	500			{object}	response.ErrorResponse
+//	@Router		/routes/{route_id}/versions/{version} [get]
+func (h *Handler) GetRouteVersion(c *gin.Context) {
+	routeID := c.Param("route_id")
+	versionNumber, err := parseVersionNumber(c)
+	if err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.routeVersionUsecase.GetRouteVersion(c.Request.Context(), routeID, kratosID, versionNumber)
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	res := RouteVersionResponse{
+		Version: RouteVersionDetailResponseModel{
+			RouteVersionResponseModel: routeVersionResponseModel(&dto.RouteVersionSummaryDto),
+			RouteID:                   dto.RouteID,
+			Description:               dto.Description,
+			HighlightedPhotoID:        dto.HighlightedPhotoID,
+			Visibility:                dto.Visibility,
+			PathGeom:                  geometry.GeometryToGeoJSON(dto.PathGeom),
+			FirstPoint:                geometry.GeometryToGeoJSON(dto.FirstPoint),
+			LastPoint:                 geometry.GeometryToGeoJSON(dto.LastPoint),
+			CoursePoints:              coursePointResponses(dto.CoursePoints),
+			Waypoints:                 waypointResponses(dto.Waypoints),
+		},
+	}
+	response.ReturnStatusOK(c, res)
+}
+
+// RestoreRouteVersion godoc
+//
+//	@Summary		ルートを特定の版の状態に戻す
+//	@Description	復元前の状態も新しい版として履歴に残る
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path	string	true	"Route ID"
+//	@Param			version		path	int		true	"版番号"
+//	@Success		204
+//	@Failure		400	{object}	response.ErrorResponse
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/versions/{version}/restore [post]
+func (h *Handler) RestoreRouteVersion(c *gin.Context) {
+	routeID := c.Param("route_id")
+	versionNumber, err := parseVersionNumber(c)
+	if err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.routeVersionUsecase.RestoreRouteVersion(c.Request.Context(), routeID, kratosID, versionNumber); err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// kratosIDFromContext は認証ミドルウェアが設定したKratosIDを取得する。取得できない場合はエラーレスポンスを返す
+func kratosIDFromContext(c *gin.Context) (string, bool) {
+	kratosIDValue, exists := c.Get("kratos_id")
+	if !exists {
+		response.ReturnStatusUnauthorized(c, errors.New("user not authenticated"))
+		return "", false
+	}
+	kratosID, ok := kratosIDValue.(string)
+	if !ok {
+		response.ReturnStatusInternalServerError(c, errors.New("invalid kratos_id type"))
+		return "", false
+	}
+	return kratosID, true
+}
+
+func parseVersionNumber(c *gin.Context) (int32, error) {
+	v, err := strconv.ParseInt(c.Param("version"), 10, 32)
+	if err != nil || v < 1 {
+		return 0, errors.New("version must be a positive integer")
+	}
+	return int32(v), nil
+}
+
+// returnRouteDomainError はドメインエラーの種類に応じたステータスコードを返す
+func returnRouteDomainError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainerror.ErrValidation):
+		response.ReturnStatusBadRequest(c, err)
+	case errors.Is(err, domainerror.ErrUnauthorized):
+		response.ReturnStatusForbidden(c, err)
+	case errors.Is(err, domainerror.ErrNotFound):
+		response.ReturnStatusNotFound(c, err)
+	case errors.Is(err, domainerror.ErrConflict):
+		response.ReturnStatusConflict(c, err)
+	default:
+		response.ReturnStatusInternalServerError(c, err)
+	}
+}
+
+func routeVersionResponseModel(dto *routeUsecase.RouteVersionSummaryDto) RouteVersionResponseModel {
+	return RouteVersionResponseModel{
+		VersionNumber: dto.VersionNumber,
+		EditorID:      dto.EditorID,
+		Name:          dto.Name,
+		Distance:      dto.Distance,
+		Duration:      dto.Duration,
+		ElevationGain: dto.ElevationGain,
+		ElevationLoss: dto.ElevationLoss,
+		CreatedAt:     dto.CreatedAt,
+		Diff: RouteVersionDiffResponse{
+			Distance:      dto.DistanceChange,
+			Duration:      dto.DurationChange,
+			ElevationGain: dto.ElevationGainChange,
+			ElevationLoss: dto.ElevationLossChange,
+		},
+	}
+}
+
+func coursePointResponses(cps []routeUsecase.CoursePointOutput) []CoursePointResponse {
+	coursePoints := make([]CoursePointResponse, len(cps))
+	for i, cp := range cps {
+		coursePoints[i] = CoursePointResponse{
+			ID:            cp.ID,
+			StepOrder:     cp.StepOrder,
+			SegDistM:      cp.SegDistM,
+			CumDistM:      cp.CumDistM,
+			Duration:      cp.Duration,
+			Instruction:   cp.Instruction,
+			RoadName:      cp.RoadName,
+			ManeuverType:  cp.ManeuverType,
+			Modifier:      cp.Modifier,
+			Location:      geometry.GeometryToGeoJSON(cp.Location),
+			BearingBefore: cp.BearingBefore,
+			BearingAfter:  cp.BearingAfter,
+		}
+	}
+	return coursePoints
+}
+
+func waypointResponses(wps []routeUsecase.WaypointOutput) []WaypointResponse {
+	waypoints := make([]WaypointResponse, len(wps))
+	for i, wp := range wps {
+		waypoints[i] = WaypointResponse{
+			ID:       wp.ID,
+			Location: geometry.GeometryToGeoJSON(wp.Location),
+		}
+	}
+	return waypoints
+}
+
+func surfaceBreakdownResponse(b *routeUsecase.SurfaceBreakdownOutput) *SurfaceBreakdownResponse {
+	if b == nil {
+		return nil
+	}
+	toShares := func(shares []routeUsecase.SurfaceShareOutput) []SurfaceShareResponse {
+		res := make([]SurfaceShareResponse, len(shares))
+		for i, s := range shares {
+			res[i] = SurfaceShareResponse{Category: s.Category, Distance: s.Distance, Percentage: s.Percentage}
+		}
+		return res
+	}
+	return &SurfaceBreakdownResponse{
+		Surfaces:          toShares(b.Surfaces),
+		RoadClasses:       toShares(b.RoadClasses),
+		UnpavedPercentage: b.UnpavedPercentage,
+	}
+}
+
+func placeResponse(p *routeUsecase.PlaceOutput) *PlaceResponse {
+	if p == nil {
+		return nil
+	}
+	return &PlaceResponse{
+		Locality:           p.Locality,
+		AdministrativeArea: p.AdministrativeArea,
+		CountryCode:        p.CountryCode,
+	}
+}
+
+// ReverseRoute godoc
+//
+//	@Summary		ルートの進行方向を反転する
+//	@Description	コースポイントは逆順になり、方位角と左右の曲がる向きも反転する。編集前の状態は版として残る
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path	string	true	"Route ID"
+//	@Success		204
+//	@Failure		400	{object}	response.ErrorResponse
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		409	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/reverse [post]
+func (h *Handler) ReverseRoute(c *gin.Context) {
+	routeID := c.Param("route_id")
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.editGeometryUsecase.ReverseRoute(c.Request.Context(), routeID, kratosID); err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// TrimRoute godoc
+//
+//	@Summary		ルートを指定した距離の区間に切り詰める
+//	@Description	始点からの距離(m)で残す区間を指定する。区間外のコースポイントは削除され、距離と累積距離は再計算される
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path	string				true	"Route ID"
+//	@Param			request		body	TrimRouteRequest	true	"Trim Route Request"
+//	@Success		204
+//	@Failure		400	{object}	response.ErrorResponse
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		409	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/trim [post]
+func (h *Handler) TrimRoute(c *gin.Context) {
+	routeID := c.Param("route_id")
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req TrimRouteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	err := h.editGeometryUsecase.TrimRoute(c.Request.Context(), routeUsecase.TrimRouteUseCaseInputDto{
+		RouteID:       routeID,
+		KratosID:      kratosID,
+		StartDistance: req.StartDistance,
+		EndDistance:   req.EndDistance,
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// SplitRoute godoc
+//
+//	@Summary		ルートを2つに分割する
+//	@Description	指定した地点に最も近い経路上の地点で分割し、元のルートを前半、後半を新しいルートとして作成する
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path		string				true	"Route ID"
+//	@Param			request		body		SplitRouteRequest	true	"Split Route Request"
+//	@Success		201			{object}	RouteResponse		"後半の新しいルート"
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		403			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		409			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/split [post]
+func (h *Handler) SplitRoute(c *gin.Context) {
+	routeID := c.Param("route_id")
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req SplitRouteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	point, err := geojson.ParseToPoint(req.Point)
+	if err != nil {
+		response.ReturnStatusBadRequest(c, fmt.Errorf("invalid point: %w", err))
+		return
+	}
+
+	dto, err := h.editGeometryUsecase.SplitRoute(c.Request.Context(), routeUsecase.SplitRouteUseCaseInputDto{
+		RouteID:  routeID,
+		KratosID: kratosID,
+		Point:    point,
+		Name:     req.Name,
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusCreated(c, RouteResponse{Route: createdRouteResponseModel(dto)})
+}
+
+// JoinRoutes godoc
+//
+//	@Summary		別のルートを終点の後ろにつなげる
+//	@Description	どちらも自分のルートである必要がある。終点と始点が離れている場合は直線で結ぶ。つなげたルートはそのまま残る
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path	string				true	"Route ID"
+//	@Param			request		body	JoinRoutesRequest	true	"Join Routes Request"
+//	@Success		204
+//	@Failure		400	{object}	response.ErrorResponse
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		409	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/join [post]
+func (h *Handler) JoinRoutes(c *gin.Context) {
+	routeID := c.Param("route_id")
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req JoinRoutesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if req.RouteID == "" {
+		response.ReturnStatusBadRequest(c, errors.New("route_id is required"))
+		return
+	}
+
+	err := h.editGeometryUsecase.JoinRoutes(c.Request.Context(), routeUsecase.JoinRoutesUseCaseInputDto{
+		RouteID:      routeID,
+		KratosID:     kratosID,
+		OtherRouteID: req.RouteID,
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// GenerateCues godoc
+//
+//	@Summary		ルートの形状からキューシートを生成する
+//	@Description	path_geomの向きの変化から曲がり角を検出し、コースポイントを置き換える。置き換え前の状態は版として残る
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Param			locale		query		string	false	"案内文の言語（ja, en）。省略時はユーザーのロケール"
+//	@Success		200			{object}	CueSheetResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		403			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		409			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/cues [post]
+func (h *Handler) GenerateCues(c *gin.Context) {
+	routeID := c.Param("route_id")
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	cps, err := h.generateCuesUsecase.GenerateCues(c.Request.Context(), routeID, kratosID, c.Query("locale"))
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, CueSheetResponse{CoursePoints: coursePointResponses(cps)})
+}
+
+// ExportCueSheetCSV godoc
+//
+//	@Summary		キューシートをCSVで出力する
+//	@Description	見出しと補う案内文はユーザーのロケールの言語になる。出力したCSVは編集してPUTで取り込める
+//	@Tags			routes
+//	@Produce		text/csv
+//	@Security		CookieAuth
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Param			unit		query		string	false	"距離の単位（km, mi）。省略時はkm"
+//	@Success		200			{string}	string	"CSV"
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/cuesheet.csv [get]
+func (h *Handler) ExportCueSheetCSV(c *gin.Context) {
+	h.exportCueSheet(c, routeUsecase.CueSheetFormatCSV, "text/csv; charset=utf-8")
+}
+
+// ExportCueSheetPDF godoc
+//
+//	@Summary		キューシートを印刷用のPDFで出力する
+//	@Description	見出しと補う案内文はユーザーのロケールの言語になる
+//	@Tags			routes
+//	@Produce		application/pdf
+//	@Security		CookieAuth
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Param			unit		query		string	false	"距離の単位（km, mi）。省略時はkm"
+//	@Success		200			{string}	string	"PDF"
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/cuesheet.pdf [get]
+func (h *Handler) ExportCueSheetPDF(c *gin.Context) {
+	h.exportCueSheet(c, routeUsecase.CueSheetFormatPDF, "application/pdf")
+}
+
+func (h *Handler) exportCueSheet(c *gin.Context, format routeUsecase.CueSheetFormat, contentType string) {
+	routeID := c.Param("route_id")
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	data, err := h.cueSheetUsecase.ExportCueSheet(c.Request.Context(), routeUsecase.ExportCueSheetInputDto{
+		RouteID:  routeID,
+		KratosID: kratosID,
+		Format:   format,
+		Unit:     c.Query("unit"),
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="route-%s-cuesheet.%s"`, routeID, format))
+	c.Data(http.StatusOK, contentType, data)
+}
+
+// ImportCueSheetCSV godoc
+//
+//	@Summary		キューシートのCSVでコースポイントを置き換える
+//	@Description	cuesheet.csvで出力したCSVを取り込む。列は見出しで判別し、累積距離・lat・lonの列が必須。置き換え前の状態は版として残る
+//	@Tags			routes
+//	@Accept			text/csv
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Param			If-Match	header		string	true	"ルート取得時のETag"
+//	@Param			request		body		string	true	"キューシートのCSV"
+//	@Success		200			{object}	CueSheetResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		403			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		412			{object}	response.ErrorResponse	"取得後に別のリクエストで更新されている"
+//	@Failure		428			{object}	response.ErrorResponse	"If-Matchヘッダーがない"
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/cuesheet.csv [put]
+func (h *Handler) ImportCueSheetCSV(c *gin.Context) {
+	routeID := c.Param("route_id")
+
+	expectedVersion, ok := expectedRouteVersion(c)
+	if !ok {
+		return
+	}
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	cps, err := h.cueSheetUsecase.ImportCueSheet(c.Request.Context(), routeUsecase.ImportCueSheetInputDto{
+		RouteID:         routeID,
+		KratosID:        kratosID,
+		ExpectedVersion: expectedVersion,
+		CSV:             c.Request.Body,
+	})
+	if err != nil {
+		if errors.Is(err, domainerror.ErrConflict) {
+			response.ReturnStatusPreconditionFailed(c, err)
+			return
+		}
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, CueSheetResponse{CoursePoints: coursePointResponses(cps)})
+}
+
+// PlanRoute godoc
+//
+//	@Summary		経由地を通るルートを探索する
+//	@Description	ルーティングエンジンで経由地を順に通る経路とコースポイントを探索する。結果は保存しないため、そのままルート作成に使う
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			locale		query		string				false	"案内文の言語（ja, en）。省略時はユーザーのロケール"
+//	@Param			request		body		PlanRouteRequest	true	"Plan Route Request"
+//	@Success		200			{object}	PlanRouteResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/plan [post]
+func (h *Handler) PlanRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req PlanRouteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	waypoints := make([]orb.Point, len(req.Waypoints))
+	for i, wp := range req.Waypoints {
+		location, err := geojson.ParseToPoint(wp.Location)
+		if err != nil {
+			response.ReturnStatusBadRequest(c, fmt.Errorf("invalid waypoint location: %w", err))
+			return
+		}
+		waypoints[i] = location
+	}
+
+	dto, err := h.planRouteUsecase.PlanRoute(c.Request.Context(), routeUsecase.PlanRouteUseCaseInputDto{
+		KratosID:  kratosID,
+		Waypoints: waypoints,
+		Profile:   req.Profile,
+		Locale:    c.Query("locale"),
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, PlanRouteResponse{
+		Profile:      dto.Profile,
+		Distance:     dto.Distance,
+		Duration:     dto.Duration,
+		PathGeom:     geometry.GeometryToGeoJSON(dto.PathGeom),
+		FirstPoint:   geometry.GeometryToGeoJSON(dto.FirstPoint),
+		LastPoint:    geometry.GeometryToGeoJSON(dto.LastPoint),
+		CoursePoints: coursePointResponses(dto.CoursePoints),
+	})
+}
+
+// MatchTrip godoc
+//
+//	@Summary		トリップの軌跡を道路網に照合する
+//	@Description	記録したトリップのGPSの軌跡を道路網に照合し、実際に通った道路に沿う経路とコースポイントを返す。結果は保存しないため、そのままルート作成に使う
+//	@Tags			trips
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			trip_id	path		string	true	"Trip ID"
+//	@Param			locale	query		string	false	"案内文の言語（ja, en）。省略時はユーザーのロケール"
+//	@Success		200		{object}	MatchTripResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		403		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/trips/{trip_id}/match [post]
+func (h *Handler) MatchTrip(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.matchTripUsecase.MatchTrip(c.Request.Context(), routeUsecase.MatchTripUseCaseInputDto{
+		TripID:   c.Param("trip_id"),
+		KratosID: kratosID,
+		Locale:   c.Query("locale"),
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	segments := make([]MatchedSegmentResponse, len(dto.Segments))
+	for i, s := range dto.Segments {
+		segments[i] = MatchedSegmentResponse{
+			RoadName: s.RoadName,
+			Highway:  s.Highway,
+			Surface:  s.Surface,
+			Distance: s.Distance,
+			PathGeom: geometry.GeometryToGeoJSON(s.Path),
+		}
+	}
+	response.ReturnStatusOK(c, MatchTripResponse{
+		Distance:      dto.Distance,
+		Duration:      dto.Duration,
+		PathGeom:      geometry.GeometryToGeoJSON(dto.PathGeom),
+		FirstPoint:    geometry.GeometryToGeoJSON(dto.FirstPoint),
+		LastPoint:     geometry.GeometryToGeoJSON(dto.LastPoint),
+		MatchedPoints: dto.MatchedPoints,
+		TotalPoints:   dto.TotalPoints,
+		Segments:      segments,
+		CoursePoints:  coursePointResponses(dto.CoursePoints),
+	})
+}
+
+// ConvertTripToRoute godoc
+//
+//	@Summary		トリップからルートを作成する
+//	@Description	記録したトリップの軌跡を簡略化し、距離・獲得標高と写真を引き継いだルートを作成する。記録時刻やセンサーの値は含めず、プライバシーゾーン内の始点・終点側の軌跡は取り除く
+//	@Tags			trips
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			trip_id	path		string				true	"Trip ID"
+//	@Param			request	body		ConvertTripRequest	false	"Convert Trip Request"
+//	@Success		201		{object}	RouteResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		403		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/trips/{trip_id}/to-route [post]
+func (h *Handler) ConvertTripToRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	// ボディは省略できる
+	var req ConvertTripRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	dto, err := h.convertTripUsecase.ConvertTripToRoute(c.Request.Context(), routeUsecase.ConvertTripToRouteInputDto{
+		TripID:      c.Param("trip_id"),
+		KratosID:    kratosID,
+		Name:        req.Name,
+		Description: req.Description,
+		Visibility:  req.Visibility,
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusCreated(c, RouteResponse{Route: createdRouteResponseModel(dto)})
+}
+
+// ListRoutePOIs godoc
+//
+//	@Summary		ルート沿いのPOIを取得する
+//	@Description	経路から指定した距離内にあるカフェ・コンビニ・水飲み場・自転車店・トイレ・展望地を、経路上の位置（cum_dist_m）の順に返す。続きはnext_cursorで取得する
+//	@Tags			routes
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Param			buffer		query		number	false	"経路からの距離(m)。省略時は200、最大2000"
+//	@Param			category	query		string	false	"POIの種類（cafe, convenience, drinking_water, bicycle_shop, toilets, viewpoint）。カンマ区切りで複数指定できる"
+//	@Param			limit		query		integer	false	"Page size (default 20, max 100)"
+//	@Param			cursor		query		string	false	"Cursor returned as next_cursor in the previous page"
+//	@Success		200			{object}	RoutePOIListResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/pois [get]
+func (h *Handler) ListRoutePOIs(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var bufferM float64
+	if v := c.Query("buffer"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			response.ReturnStatusBadRequest(c, errors.New("invalid buffer"))
+			return
+		}
+		bufferM = f
+	}
+	var categories []string
+	if v := c.Query("category"); v != "" {
+		categories = strings.Split(v, ",")
+	}
+	limit, err := parseLimit(c)
+	if err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	dtos, err := h.routePOIUsecase.ListRoutePOIs(c.Request.Context(), routeUsecase.ListRoutePOIsInputDto{
+		RouteID:    c.Param("route_id"),
+		KratosID:   kratosID,
+		BufferM:    bufferM,
+		Categories: categories,
+		Limit:      limit,
+		Cursor:     c.Query("cursor"),
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	pois := make([]RoutePOIResponse, len(dtos.POIs))
+	for i, dto := range dtos.POIs {
+		pois[i] = RoutePOIResponse{
+			ID:       dto.ID,
+			Category: dto.Category,
+			Name:     dto.Name,
+			Location: geometry.GeometryToGeoJSON(dto.Location),
+			CumDistM: dto.CumDistM,
+			OffsetM:  dto.OffsetM,
+		}
+	}
+	response.ReturnStatusOK(c, RoutePOIListResponse{
+		POIs:       pois,
+		NextCursor: nextCursorResponse(dtos.NextCursor),
+	})
+}
+
+// PromotePOI godoc
+//
+//	@Summary		ルート沿いのPOIをウェイポイントまたはコースポイントにする
+//	@Description	ウェイポイントは経路に沿った順序の位置に入れ、経路は変更しない。コースポイントは経路に射影した位置に入れ、操作タイプをpoi、修飾子をPOIの種類にする。追加前の状態は版として残る
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path	string				true	"Route ID"
+//	@Param			poi_id		path	int					true	"POI ID"
+//	@Param			If-Match	header	string				true	"ルート取得時のETag"
+//	@Param			request		body	PromotePOIRequest	true	"Promote POI Request"
+//	@Success		204
+//	@Failure		400	{object}	response.ErrorResponse
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		412	{object}	response.ErrorResponse	"取得後に別のリクエストで更新されている"
+//	@Failure		428	{object}	response.ErrorResponse	"If-Matchヘッダーがない"
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/pois/{poi_id}/promote [post]
+func (h *Handler) PromotePOI(c *gin.Context) {
+	poiID, err := strconv.ParseInt(c.Param("poi_id"), 10, 64)
+	if err != nil {
+		response.ReturnStatusBadRequest(c, errors.New("invalid poi_id"))
+		return
+	}
+
+	expectedVersion, ok := expectedRouteVersion(c)
+	if !ok {
+		return
+	}
+
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req PromotePOIRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	err = h.routePOIUsecase.PromotePOI(c.Request.Context(), routeUsecase.PromotePOIInputDto{
+		RouteID:         c.Param("route_id"),
+		KratosID:        kratosID,
+		ExpectedVersion: expectedVersion,
+		POIID:           poiID,
+		Target:          routeUsecase.POIPromoteTarget(req.As),
+	})
+	if err != nil {
+		if errors.Is(err, domainerror.ErrConflict) {
+			response.ReturnStatusPreconditionFailed(c, err)
+			return
+		}
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// UpdateRouteTags godoc
+//
+//	@Summary		ルートのタグを置き換える
+//	@Description	タグは前後の空白と先頭の#を取り除き、英字を小文字にして重複を除く。経路は変わらないため、If-Matchヘッダーは不要で版も作らない
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path		string					true	"Route ID"
+//	@Param			request		body		UpdateRouteTagsRequest	true	"Update Route Tags Request"
+//	@Success		200			{object}	RouteTagsResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		403			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/tags [put]
+func (h *Handler) UpdateRouteTags(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateRouteTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	tags, err := h.routeTagUsecase.UpdateRouteTags(c.Request.Context(), routeUsecase.UpdateRouteTagsInputDto{
+		RouteID:  c.Param("route_id"),
+		KratosID: kratosID,
+		Tags:     req.Tags,
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, RouteTagsResponse{Tags: tags})
+}
+
+// LikeRoute godoc
+//
+//	@Summary		ルートにいいねする
+//	@Description	閲覧できないルートは見つからないものとして扱う。いいねはフォロワーのフィードに表示する
+//	@Tags			routes
+//	@Security		CookieAuth
+//	@Param			route_id	path	string	true	"Route ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		409	{object}	response.ErrorResponse	"すでにいいねしている"
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/like [put]
+func (h *Handler) LikeRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.reactionUsecase.LikeRoute(c.Request.Context(), kratosID, c.Param("route_id")); err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// UnlikeRoute godoc
+//
+//	@Summary	ルートへのいいねを取り消す
+//	@Tags		routes
+//	@Security	CookieAuth
+//	@Param		route_id	path	string	true	"Route ID"
+//	@Success	204
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	404	{object}	response.ErrorResponse	"いいねしていない"
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/routes/{route_id}/like [delete]
+func (h *Handler) UnlikeRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.reactionUsecase.UnlikeRoute(c.Request.Context(), kratosID, c.Param("route_id")); err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// SaveRoute godoc
+//
+//	@Summary		ルートを保存する
+//	@Description	閲覧できないルートは見つからないものとして扱う。保存したことをルートの所有者に通知する
+//	@Tags			routes
+//	@Security		CookieAuth
+//	@Param			route_id	path	string	true	"Route ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		409	{object}	response.ErrorResponse	"すでに保存している"
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/save [put]
+func (h *Handler) SaveRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.reactionUsecase.SaveRoute(c.Request.Context(), kratosID, c.Param("route_id")); err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// UnsaveRoute godoc
+//
+//	@Summary	ルートの保存を取り消す
+//	@Tags		routes
+//	@Security	CookieAuth
+//	@Param		route_id	path	string	true	"Route ID"
+//	@Success	204
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	404	{object}	response.ErrorResponse	"保存していない"
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/routes/{route_id}/save [delete]
+func (h *Handler) UnsaveRoute(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.reactionUsecase.UnsaveRoute(c.Request.Context(), kratosID, c.Param("route_id")); err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// GetSavedRoutes godoc
+//
+//	@Summary		保存したルートの一覧を取得する
+//	@Description	保存した日時の新しい順に並ぶ。保存した後に閲覧できなくなったルートは含まないため、1ページの件数がlimitより少ないことがある
+//	@Tags			routes
+//	@Security		CookieAuth
+//	@Produce		json
+//	@Param			limit	query		integer	false	"Page size (default 20, max 100)"
+//	@Param			cursor	query		string	false	"Cursor returned as next_cursor in the previous page"
+//	@Success		200		{object}	RouteListResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/routes/saved [get]
+func (h *Handler) GetSavedRoutes(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+	limit, err := parseLimit(c)
+	if err != nil {
+		response.ReturnBadRequest(c, err)
+		return
+	}
+
+	dtos, err := h.getRouteUsecase.GetSavedRoutes(c.Request.Context(), routeUsecase.SavedRoutesInputDto{
+		KratosID: kratosID,
+		Limit:    limit,
+		Cursor:   c.Query("cursor"),
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	routes := make([]RouteResponseModel, len(dtos.Items))
+	for i, dto := range dtos.Items {
+		routes[i] = RouteResponseModel{
+			ID:                 dto.ID,
+			UserID:             dto.UserID,
+			UserName:           dto.UserName,
+			Name:               dto.Name,
+			Description:        dto.Description,
+			HighlightedPhotoID: dto.HighlightedPhotoID,
+			Distance:           dto.Distance,
+			Duration:           dto.Duration,
+			ElevationGain:      dto.ElevationGain,
+			ElevationLoss:      dto.ElevationLoss,
+			Visibility:         dto.Visibility,
+			Polyline:           dto.Polyline,
+			CreatedAt:          dto.CreatedAt,
+			UpdatedAt:          dto.UpdatedAt,
+			Difficulty:         dto.Difficulty,
+			Tags:               dto.Tags,
+		}
+	}
+
+	response.ReturnStatusOK(c, RouteListResponse{
+		Routes:     routes,
+		NextCursor: nextCursorResponse(dtos.NextCursor),
+	})
+}
+
+// ListRouteComments godoc
+//
+//	@Summary		ルートへのコメントを取得する
+//	@Description	未ログインでも取得できる。閲覧できないルートは見つからないものとして扱う。古い順に並ぶ
+//	@Tags			routes
+//	@Produce		json
+//	@Param			route_id	path		string	true	"Route ID"
+//	@Param			limit		query		integer	false	"Page size (default 20, max 100)"
+//	@Param			cursor		query		string	false	"Cursor returned as next_cursor in the previous page"
+//	@Success		200			{object}	RouteCommentListResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/comments [get]
+func (h *Handler) ListRouteComments(c *gin.Context) {
+	var kratosID string
+	if kratosIDValue, exists := c.Get("kratos_id"); exists {
+		kratosID, _ = kratosIDValue.(string)
+	}
+
+	limit, err := parseLimit(c)
+	if err != nil {
+		response.ReturnBadRequest(c, err)
+		return
+	}
+
+	dto, err := h.reactionUsecase.ListComments(c.Request.Context(), c.Param("route_id"), kratosID, routeUsecase.ListRouteCommentsInputDto{
+		Limit:  limit,
+		Cursor: c.Query("cursor"),
+	})
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	comments := make([]RouteCommentResponseModel, 0, len(dto.Comments))
+	for i := range dto.Comments {
+		comments = append(comments, routeCommentResponseModel(&dto.Comments[i]))
+	}
+	response.ReturnStatusOK(c, RouteCommentListResponse{
+		Comments:   comments,
+		NextCursor: nextCursorResponse(dto.NextCursor),
+	})
+}
+
+// AddRouteComment godoc
+//
+//	@Summary		ルートにコメントする
+//	@Description	コメントはフォロワーのフィードに表示する
+//	@Tags			routes
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			route_id	path		string				true	"Route ID"
+//	@Param			request		body		RouteCommentRequest	true	"Route Comment Request"
+//	@Success		201			{object}	RouteCommentResponse
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		404			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/comments [post]
+func (h *Handler) AddRouteComment(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req RouteCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	dto, err := h.reactionUsecase.AddComment(c.Request.Context(), kratosID, c.Param("route_id"), req.Content)
+	if err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusCreated(c, RouteCommentResponse{Comment: routeCommentResponseModel(dto)})
+}
+
+// DeleteRouteComment godoc
+//
+//	@Summary		ルートへのコメントを削除する
+//	@Description	削除できるのはコメントした本人とルートの所有者
+//	@Tags			routes
+//	@Security		CookieAuth
+//	@Param			route_id	path	string	true	"Route ID"
+//	@Param			comment_id	path	string	true	"Comment ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/routes/{route_id}/comments/{comment_id} [delete]
+func (h *Handler) DeleteRouteComment(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.reactionUsecase.DeleteComment(c.Request.Context(), kratosID, c.Param("route_id"), c.Param("comment_id")); err != nil {
+		returnRouteDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+func routeCommentResponseModel(dto *routeUsecase.RouteCommentOutputDto) RouteCommentResponseModel {
+	return RouteCommentResponseModel{
+		ID:        dto.ID,
+		UserID:    dto.UserID,
+		UserName:  dto.UserName,
+		Content:   dto.Content,
+		CreatedAt: dto.CreatedAt,
+	}
+}
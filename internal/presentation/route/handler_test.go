@@ -0,0 +1,127 @@
+package route
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	collectionDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/collection"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	tripDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	routeUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/route"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/gin-gonic/gin"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserID     = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+	testKratosID   = "2eb50f70-3a23-4067-99f6-9fd645686880"
+	missingRouteID = "019b5a50-0000-7000-8000-0000000000ff"
+)
+
+type handlerTestMocks struct {
+	userRepo  *userDomain.MockIUserRepository
+	routeRepo *routeDomain.MockIRouteRepository
+	handler   *Handler
+}
+
+// setupHandlerMocks はリポジトリだけをモックにしたユースケースでハンドラーを作成する
+func setupHandlerMocks(t *testing.T) *handlerTestMocks {
+	ctrl := gomock.NewController(t)
+	m := &handlerTestMocks{
+		userRepo:  userDomain.NewMockIUserRepository(ctrl),
+		routeRepo: routeDomain.NewMockIRouteRepository(ctrl),
+	}
+	user, _ := userDomain.ReconstructUser(
+		userDomain.UserID(testUserID),
+		testKratosID,
+		"Test User",
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false,
+	)
+	m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(user, nil).AnyTimes()
+
+	clubs := routeDomain.NewMockClubMembershipReader(ctrl)
+	clubs.EXPECT().GetClubIDsByUserID(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	txManager := transactionApp.NewMockTransactionManager(ctrl)
+	publisher := notificationDomain.NewMockPublisher(ctrl)
+
+	m.handler = &Handler{
+		getRouteUsecase:    routeUsecase.NewGetRouteUsecase(m.routeRepo, m.userRepo, tripDomain.NewMockITripRepository(ctrl), collectionDomain.NewMockICollectionRepository(ctrl), clubs),
+		exportGPXUsecase:   routeUsecase.NewExportGPXUsecase(m.routeRepo, m.userRepo, clubs),
+		deleteRouteUsecase: routeUsecase.NewDeleteRouteUsecase(m.userRepo, txManager, m.routeRepo),
+		forkRouteUsecase:   routeUsecase.NewForkRouteUsecase(m.userRepo, txManager, m.routeRepo, clubs, publisher),
+		reactionUsecase:    routeUsecase.NewRouteReactionUsecase(m.userRepo, txManager, m.routeRepo, clubs, publisher),
+	}
+	return m
+}
+
+func TestHandler_MissingRoute(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		method  string
+		pattern string
+		handle  func(h *Handler) gin.HandlerFunc
+	}{
+		{name: "ルートの取得", method: http.MethodGet, pattern: "/routes/:route_id", handle: func(h *Handler) gin.HandlerFunc { return h.GetRouteByID }},
+		{name: "GPXの出力", method: http.MethodGet, pattern: "/routes/:route_id/gpx", handle: func(h *Handler) gin.HandlerFunc { return h.ExportRouteGPX }},
+		{name: "類似ルート", method: http.MethodGet, pattern: "/routes/:route_id/similar", handle: func(h *Handler) gin.HandlerFunc { return h.GetSimilarRoutes }},
+		{name: "ルートの削除", method: http.MethodDelete, pattern: "/routes/:route_id", handle: func(h *Handler) gin.HandlerFunc { return h.DeleteRoute }},
+		{name: "ルートのフォーク", method: http.MethodPost, pattern: "/routes/:route_id/fork", handle: func(h *Handler) gin.HandlerFunc { return h.ForkRoute }},
+		{name: "ルートへのいいね", method: http.MethodPut, pattern: "/routes/:route_id/like", handle: func(h *Handler) gin.HandlerFunc { return h.LikeRoute }},
+		{name: "ルートへのコメント", method: http.MethodGet, pattern: "/routes/:route_id/comments", handle: func(h *Handler) gin.HandlerFunc { return h.ListRouteComments }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupHandlerMocks(t)
+			m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), missingRouteID).Return(nil, domainerror.New("route not found", domainerror.ErrNotFound))
+
+			r := gin.New()
+			r.Handle(tt.method, tt.pattern, func(c *gin.Context) {
+				c.Set("kratos_id", testKratosID)
+			}, tt.handle(m.handler))
+
+			w := httptest.NewRecorder()
+			path := "/routes/" + missingRouteID + tt.pattern[len("/routes/:route_id"):]
+			r.ServeHTTP(w, httptest.NewRequest(tt.method, path, nil))
+			if w.Code != http.StatusNotFound {
+				t.Errorf("status = %d, want %d: %s", w.Code, http.StatusNotFound, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_GetRouteByID_PrivateRoute(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	m := setupHandlerMocks(t)
+
+	// 未ログインの閲覧ユーザーには他のユーザーの非公開ルートの存在を明かさない
+	path := orb.LineString{{139.0, 35.0}, {139.1, 35.1}}
+	private, err := routeDomain.ReconstructRoute(
+		missingRouteID, "019b5a8d-0000-7000-8000-0000000000ff", "Private Route", "", nil, 1000, 300, 0, 0,
+		routeDomain.Geometry{Geometry: path}, routeDomain.Geometry{Geometry: path.Bound().ToPolygon()},
+		routeDomain.Geometry{Geometry: path[0]}, routeDomain.Geometry{Geometry: path[1]},
+		"", routeDomain.VisibilityPrivate, 1, nil, "", "",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), missingRouteID).Return(private, nil)
+
+	r := gin.New()
+	r.GET("/routes/:route_id", m.handler.GetRouteByID)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/routes/"+missingRouteID, nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d: %s", w.Code, http.StatusNotFound, w.Body.String())
+	}
+}
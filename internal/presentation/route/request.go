@@ -12,7 +12,8 @@ type CreateRouteRequest struct {
 	PathGeom           string               `json:"path_geom" validate:"required"`
 	FirstPoint         string               `json:"first_point" validate:"required"`
 	LastPoint          string               `json:"last_point" validate:"required"`
-	Visibility         int16                `json:"visibility" validate:"required,min=0,max=2"`
+	Visibility         int16                `json:"visibility" validate:"required,min=0,max=3"`
+	ClubID             *string              `json:"club_id,omitempty"` // 公開範囲がクラブのメンバーのみ(3)の場合の共有先
 	CoursePoints       []CoursePointRequest `json:"course_points"`
 	Waypoints          []WaypointRequest    `json:"waypoints"`
 }
@@ -28,7 +29,8 @@ type UpdateRouteRequest struct {
 	PathGeom           string               `json:"path_geom" validate:"required"`
 	FirstPoint         string               `json:"first_point" validate:"required"`
 	LastPoint          string               `json:"last_point" validate:"required"`
-	Visibility         int16                `json:"visibility" validate:"required,min=0,max=2"`
+	Visibility         int16                `json:"visibility" validate:"required,min=0,max=3"`
+	ClubID             *string              `json:"club_id,omitempty"` // 公開範囲がクラブのメンバーのみ(3)の場合の共有先
 	CoursePoints       []CoursePointRequest `json:"course_points"`
 	Waypoints          []WaypointRequest    `json:"waypoints"`
 }
@@ -48,4 +50,47 @@ type CoursePointRequest struct {
 
 type WaypointRequest struct {
 	Location string `json:"location" validate:"required"`
-}
\ No newline at end of file
+}
+
+// TrimRouteRequest は残す区間を始点からの距離(m)で指定する
+type TrimRouteRequest struct {
+	StartDistance float64 `json:"start_distance" validate:"min=0"`
+	EndDistance   float64 `json:"end_distance" validate:"required,gt=0"`
+}
+
+type SplitRouteRequest struct {
+	Point string `json:"point" validate:"required"` // 分割する地点（GeoJSON Point）
+	Name  string `json:"name" validate:"max=255"`   // 後半のルートの名前。省略時は元の名前に「(2)」を付ける
+}
+
+type JoinRoutesRequest struct {
+	RouteID string `json:"route_id" validate:"required"` // 終点の後ろにつなげるルート
+}
+
+// ConvertTripRequest は作成するルートの項目。省略した項目はトリップの値を使う
+type ConvertTripRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,max=255"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Visibility  *int16  `json:"visibility,omitempty" validate:"omitempty,min=0,max=2"`
+}
+
+// PlanRouteRequest は経由地を通る順に指定する（始点・終点を含む）
+type PlanRouteRequest struct {
+	Waypoints []WaypointRequest `json:"waypoints" validate:"required,min=2,max=25"`
+	Profile   string            `json:"profile" validate:"omitempty,oneof=road gravel avoid_highways"` // 省略時はroad
+}
+
+// PromotePOIRequest はルート沿いのPOIの追加先を指定する
+type PromotePOIRequest struct {
+	As string `json:"as" validate:"required,oneof=waypoint course_point"`
+}
+
+// UpdateRouteTagsRequest はルートに付けるタグのすべてを指定する。空の配列の場合はタグを外す
+type UpdateRouteTagsRequest struct {
+	Tags []string `json:"tags" validate:"required"`
+}
+
+// RouteCommentRequest はルートへのコメント。1000文字まで
+type RouteCommentRequest struct {
+	Content string `json:"content" validate:"required"`
+}
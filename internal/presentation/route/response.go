@@ -5,31 +5,78 @@ type RouteResponse struct {
 }
 
 type RouteListResponse struct {
-	Routes []RouteResponseModel `json:"routes"`
-	TotalCount int64            `json:"total_count"`
+	Routes     []RouteResponseModel `json:"routes"`
+	NextCursor *string              `json:"next_cursor"` // 次のページがない場合はnull
 }
 
 type RouteResponseModel struct {
-	ID                 string                `json:"id"`
-	UserID             string                `json:"user_id"`
-	UserName           string                `json:"user_name"`
-	Name               string                `json:"name"`
-	Description        string                `json:"description"`
-	HighlightedPhotoID *int64                `json:"highlighted_photo_id"`
-	Distance           float64               `json:"distance"`
-	Duration           float64               `json:"duration"`
-	ElevationGain      float64               `json:"elevation_gain"`
-	ElevationLoss      float64               `json:"elevation_loss"`
-	Visibility         int16                 `json:"visibility"`
-	CreatedAt          string                `json:"created_at"`
-	UpdatedAt          string                `json:"updated_at"`
-	PathGeom           *string               `json:"path_geom,omitempty"`
-	Bbox               *string               `json:"bbox,omitempty"`
-	FirstPoint         *string               `json:"first_point,omitempty"`
-	LastPoint          *string               `json:"last_point,omitempty"`
-	Polyline           string                `json:"polyline"`
-	CoursePoints       []CoursePointResponse `json:"course_points,omitempty"`
-	Waypoints          []WaypointResponse    `json:"waypoints,omitempty"`
+	ID                     string                    `json:"id"`
+	UserID                 string                    `json:"user_id"`
+	UserName               string                    `json:"user_name"`
+	Name                   string                    `json:"name"`
+	Description            string                    `json:"description"`
+	HighlightedPhotoID     *int64                    `json:"highlighted_photo_id"`
+	Distance               float64                   `json:"distance"`
+	Duration               float64                   `json:"duration"`
+	ElevationGain          float64                   `json:"elevation_gain"`
+	ElevationLoss          float64                   `json:"elevation_loss"`
+	Visibility             int16                     `json:"visibility"`
+	ClubID                 *string                   `json:"club_id,omitempty"`              // 公開範囲がクラブのメンバーのみの場合の共有先
+	ForkedFromRouteID      *string                   `json:"forked_from_route_id,omitempty"` // フォーク元のルートID
+	ForkCount              *int64                    `json:"fork_count,omitempty"`           // ルート詳細のみ
+	CreatedAt              string                    `json:"created_at"`
+	UpdatedAt              string                    `json:"updated_at"`
+	PathGeom               *string                   `json:"path_geom,omitempty"`
+	Bbox                   *string                   `json:"bbox,omitempty"`
+	FirstPoint             *string                   `json:"first_point,omitempty"`
+	LastPoint              *string                   `json:"last_point,omitempty"`
+	Polyline               string                    `json:"polyline"`
+	CoursePoints           []CoursePointResponse     `json:"course_points,omitempty"`
+	Waypoints              []WaypointResponse        `json:"waypoints,omitempty"`
+	SurfaceBreakdown       *SurfaceBreakdownResponse `json:"surface_breakdown,omitempty"`         // ルート詳細のみ。未作成の場合は省略
+	StartPlace             *PlaceResponse            `json:"start_place,omitempty"`               // ルート詳細のみ。地名が分からない場合は省略
+	EndPlace               *PlaceResponse            `json:"end_place,omitempty"`                 // ルート詳細のみ。地名が分からない場合は省略
+	Difficulty             string                    `json:"difficulty,omitempty"`                // easy, moderate, hard, expert。まだ求めていない場合は省略
+	EstimatedDurationForMe *float64                  `json:"estimated_duration_for_me,omitempty"` // ルート詳細のみ。ログインユーザーの過去のトリップから推定した所要時間(s)。未ログインの場合は省略
+	Tags                   []string                  `json:"tags,omitempty"`                      // タグがない場合は省略
+	Highlight              *RouteHighlightResponse   `json:"highlight,omitempty"`                 // キーワード検索時のみ
+}
+
+// SurfaceBreakdownResponse はルートの路面と道路の種類の内訳
+type SurfaceBreakdownResponse struct {
+	Surfaces          []SurfaceShareResponse `json:"surfaces"`     // paved, gravel, dirt, unknown
+	RoadClasses       []SurfaceShareResponse `json:"road_classes"` // OSMのhighwayタグ（residential, cyclewayなど）
+	UnpavedPercentage float64                `json:"unpaved_percentage"`
+}
+
+// PlaceResponse は行政区域から求めた地名
+type PlaceResponse struct {
+	Locality           string `json:"locality"`            // 市区町村。分からない場合は空文字
+	AdministrativeArea string `json:"administrative_area"` // 都道府県。分からない場合は空文字
+	CountryCode        string `json:"country_code"`
+}
+
+type SurfaceShareResponse struct {
+	Category   string  `json:"category"`
+	Distance   float64 `json:"distance"`   // 距離(m)
+	Percentage float64 `json:"percentage"` // 割合(%)
+}
+
+// RouteHighlightResponse はキーワードに一致した箇所を<mark>で囲んだスニペット（HTMLエスケープ済み）
+type RouteHighlightResponse struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type SimilarRouteListResponse struct {
+	Routes []SimilarRouteResponseModel `json:"routes"`
+}
+
+type SimilarRouteResponseModel struct {
+	RouteResponseModel
+	HausdorffDistance float64 `json:"hausdorff_distance"` // 形状の近さ(m)
+	FrechetDistance   float64 `json:"frechet_distance"`   // 進行方向を考慮した形状の近さ(m)
+	IsDuplicate       bool    `json:"is_duplicate"`       // ほぼ同一のルートかどうか
 }
 
 type CoursePointResponse struct {
@@ -47,7 +94,123 @@ type CoursePointResponse struct {
 	BearingAfter  *int32   `json:"bearing_after,omitempty"`
 }
 
+// CueSheetResponse は生成したキュー（コースポイント）の一覧
+type CueSheetResponse struct {
+	CoursePoints []CoursePointResponse `json:"course_points"`
+}
+
+// PlanRouteResponse は探索した経路。保存前のためコースポイントのIDは空になる
+type PlanRouteResponse struct {
+	Profile      string                `json:"profile"`
+	Distance     float64               `json:"distance"`
+	Duration     float64               `json:"duration"`
+	PathGeom     *string               `json:"path_geom"`
+	FirstPoint   *string               `json:"first_point"`
+	LastPoint    *string               `json:"last_point"`
+	CoursePoints []CoursePointResponse `json:"course_points"`
+}
+
+// MatchTripResponse はトリップの軌跡を道路網に照合した経路。保存前のためコースポイントのIDは空になる
+type MatchTripResponse struct {
+	Distance      float64                  `json:"distance"`
+	Duration      float64                  `json:"duration"`
+	PathGeom      *string                  `json:"path_geom"`
+	FirstPoint    *string                  `json:"first_point"`
+	LastPoint     *string                  `json:"last_point"`
+	MatchedPoints int                      `json:"matched_points"` // 道路に照合できたGPSの点の数
+	TotalPoints   int                      `json:"total_points"`
+	Segments      []MatchedSegmentResponse `json:"segments"`
+	CoursePoints  []CoursePointResponse    `json:"course_points"`
+}
+
+// MatchedSegmentResponse は照合した経路のうち、1つの道路を通る区間
+type MatchedSegmentResponse struct {
+	RoadName *string `json:"road_name,omitempty"`
+	Highway  string  `json:"highway"`
+	Surface  *string `json:"surface,omitempty"`
+	Distance float64 `json:"distance"`
+	PathGeom *string `json:"path_geom"`
+}
+
 type WaypointResponse struct {
 	ID       string  `json:"id"`
 	Location *string `json:"location"`
 }
+
+type RouteVersionListResponse struct {
+	Versions []RouteVersionResponseModel `json:"versions"`
+}
+
+type RouteVersionResponse struct {
+	Version RouteVersionDetailResponseModel `json:"version"`
+}
+
+type RouteVersionResponseModel struct {
+	VersionNumber int32                    `json:"version_number"`
+	EditorID      string                   `json:"editor_id"` // この版を置き換える更新を行ったユーザー
+	Name          string                   `json:"name"`
+	Distance      float64                  `json:"distance"`
+	Duration      float64                  `json:"duration"`
+	ElevationGain float64                  `json:"elevation_gain"`
+	ElevationLoss float64                  `json:"elevation_loss"`
+	CreatedAt     string                   `json:"created_at"`
+	Diff          RouteVersionDiffResponse `json:"diff"`
+}
+
+// RouteVersionDiffResponse は版と現在のルートの差分（版の値 - 現在の値）
+type RouteVersionDiffResponse struct {
+	Distance      float64 `json:"distance"`
+	Duration      float64 `json:"duration"`
+	ElevationGain float64 `json:"elevation_gain"`
+	ElevationLoss float64 `json:"elevation_loss"`
+}
+
+type RouteVersionDetailResponseModel struct {
+	RouteVersionResponseModel
+	RouteID            string                `json:"route_id"`
+	Description        string                `json:"description"`
+	HighlightedPhotoID *int64                `json:"highlighted_photo_id"`
+	Visibility         int16                 `json:"visibility"`
+	PathGeom           *string               `json:"path_geom,omitempty"`
+	FirstPoint         *string               `json:"first_point,omitempty"`
+	LastPoint          *string               `json:"last_point,omitempty"`
+	CoursePoints       []CoursePointResponse `json:"course_points"`
+	Waypoints          []WaypointResponse    `json:"waypoints"`
+}
+
+// RoutePOIListResponse はルート沿いのPOI。経路上の位置の順に並ぶ
+type RoutePOIListResponse struct {
+	POIs       []RoutePOIResponse `json:"pois"`
+	NextCursor *string            `json:"next_cursor"` // 次のページがない場合はnull
+}
+
+type RoutePOIResponse struct {
+	ID       int64   `json:"id"`
+	Category string  `json:"category"` // cafe, convenience, drinking_water, bicycle_shop, toilets, viewpoint
+	Name     string  `json:"name"`
+	Location *string `json:"location"`
+	CumDistM float64 `json:"cum_dist_m"` // 経路上の位置（始点からの距離(m)）
+	OffsetM  float64 `json:"offset_m"`   // 経路からの距離(m)
+}
+
+// RouteTagsResponse は表記を揃えたルートのタグ
+type RouteTagsResponse struct {
+	Tags []string `json:"tags"`
+}
+
+type RouteCommentListResponse struct {
+	Comments   []RouteCommentResponseModel `json:"comments"`
+	NextCursor *string                     `json:"next_cursor"` // 次のページがない場合はnull
+}
+
+type RouteCommentResponse struct {
+	Comment RouteCommentResponseModel `json:"comment"`
+}
+
+type RouteCommentResponseModel struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	UserName  string `json:"user_name"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+}
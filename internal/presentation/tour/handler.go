@@ -0,0 +1,316 @@
+package tour
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/response"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/validator"
+	tourUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/tour"
+	"github.com/gin-gonic/gin"
+)
+
+type Handler struct {
+	tourUsecase tourUsecase.ITourUsecase
+}
+
+func NewHandler(tourUsecase tourUsecase.ITourUsecase) *Handler {
+	return &Handler{tourUsecase: tourUsecase}
+}
+
+// ListMyTours godoc
+//
+//	@Summary	自分のツアーの一覧を取得する
+//	@Tags		tours
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Success	200	{object}	TourListResponse
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/tours [get]
+func (h *Handler) ListMyTours(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dtos, err := h.tourUsecase.ListMyTours(c.Request.Context(), kratosID)
+	if err != nil {
+		response.ReturnStatusInternalServerError(c, err)
+		return
+	}
+
+	tours := make([]TourResponseModel, len(dtos))
+	for i := range dtos {
+		tours[i] = tourResponseModel(&dtos[i])
+	}
+	response.ReturnStatusOK(c, TourListResponse{Tours: tours})
+}
+
+// GetTour godoc
+//
+//	@Summary		ツアーを取得する
+//	@Description	公開のツアーは未ログインでも取得できる。ステージと合計は閲覧できるルートのみを対象にする
+//	@Tags			tours
+//	@Produce		json
+//	@Param			tour_id	path		string	true	"Tour ID"
+//	@Success		200		{object}	TourResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/tours/{tour_id} [get]
+func (h *Handler) GetTour(c *gin.Context) {
+	dto, err := h.tourUsecase.GetTour(c.Request.Context(), c.Param("tour_id"), optionalKratosID(c))
+	if err != nil {
+		returnTourDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, TourResponse{Tour: tourResponseModel(dto)})
+}
+
+// CreateTour godoc
+//
+//	@Summary		ツアーを作成する
+//	@Description	ステージには自分のルートのほか、他のユーザーの公開ルートも使える。同じルートを複数日に使ってもよい
+//	@Tags			tours
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			request	body		TourRequest	true	"Create Tour Request"
+//	@Success		201		{object}	TourResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse	"閲覧できないルート"
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/tours [post]
+func (h *Handler) CreateTour(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	req, ok := bindTourRequest(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.tourUsecase.CreateTour(c.Request.Context(), kratosID, tourInputDto(req))
+	if err != nil {
+		returnTourDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusCreated(c, TourResponse{Tour: tourResponseModel(dto)})
+}
+
+// UpdateTour godoc
+//
+//	@Summary		ツアーを更新する
+//	@Description	名前・説明・公開範囲とステージをまとめて置き換える
+//	@Tags			tours
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			tour_id	path		string		true	"Tour ID"
+//	@Param			request	body		TourRequest	true	"Update Tour Request"
+//	@Success		200		{object}	TourResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		403		{object}	response.ErrorResponse
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/tours/{tour_id} [put]
+func (h *Handler) UpdateTour(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	req, ok := bindTourRequest(c)
+	if !ok {
+		return
+	}
+
+	dto, err := h.tourUsecase.UpdateTour(c.Request.Context(), kratosID, c.Param("tour_id"), tourInputDto(req))
+	if err != nil {
+		returnTourDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusOK(c, TourResponse{Tour: tourResponseModel(dto)})
+}
+
+// DeleteTour godoc
+//
+//	@Summary		ツアーを削除する
+//	@Description	ステージのルートは削除しない
+//	@Tags			tours
+//	@Security		CookieAuth
+//	@Param			tour_id	path	string	true	"Tour ID"
+//	@Success		204
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		403	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/tours/{tour_id} [delete]
+func (h *Handler) DeleteTour(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.tourUsecase.DeleteTour(c.Request.Context(), kratosID, c.Param("tour_id")); err != nil {
+		returnTourDomainError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// ExportTourGPX godoc
+//
+//	@Summary		ツアーをGPXで出力する
+//	@Description	ステージごとに1つのトラックにし、宿泊地はステージの終点のウェイポイントにする。閲覧できるステージのみを含める
+//	@Tags			tours
+//	@Produce		application/gpx+xml
+//	@Param			tour_id	path		string	true	"Tour ID"
+//	@Success		200		{string}	string	"GPX"
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/tours/{tour_id}/gpx [get]
+func (h *Handler) ExportTourGPX(c *gin.Context) {
+	h.exportTour(c, tourUsecase.ExportFormatGPX, "application/gpx+xml")
+}
+
+// ExportTourFIT godoc
+//
+//	@Summary		ツアーをFITのコースで出力する
+//	@Description	ステージごとに1つのラップにし、宿泊地はステージの終点のコースポイントにする。閲覧できるステージのみを含める
+//	@Tags			tours
+//	@Produce		application/vnd.ant.fit
+//	@Param			tour_id	path		string	true	"Tour ID"
+//	@Success		200		{string}	string	"FIT"
+//	@Failure		404		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/tours/{tour_id}/fit [get]
+func (h *Handler) ExportTourFIT(c *gin.Context) {
+	h.exportTour(c, tourUsecase.ExportFormatFIT, "application/vnd.ant.fit")
+}
+
+func (h *Handler) exportTour(c *gin.Context, format tourUsecase.ExportFormat, contentType string) {
+	tourID := c.Param("tour_id")
+
+	data, err := h.tourUsecase.ExportTour(c.Request.Context(), tourUsecase.ExportTourInputDto{
+		TourID:   tourID,
+		KratosID: optionalKratosID(c),
+		Format:   format,
+	})
+	if err != nil {
+		returnTourDomainError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="tour-%s.%s"`, tourID, format))
+	c.Data(http.StatusOK, contentType, data)
+}
+
+func bindTourRequest(c *gin.Context) (*TourRequest, bool) {
+	var req TourRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return nil, false
+	}
+	if err := validator.GetValidator().Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return nil, false
+	}
+	return &req, true
+}
+
+func tourInputDto(req *TourRequest) tourUsecase.TourInputDto {
+	stages := make([]tourUsecase.TourStageInputDto, len(req.Stages))
+	for i, s := range req.Stages {
+		stages[i] = tourUsecase.TourStageInputDto{RouteID: s.RouteID, OvernightStop: s.OvernightStop}
+	}
+	return tourUsecase.TourInputDto{
+		Name:        req.Name,
+		Description: req.Description,
+		Visibility:  req.Visibility,
+		ClubID:      req.ClubID,
+		Stages:      stages,
+	}
+}
+
+// optionalKratosID はログインしている場合にKratosIDを返す。未ログインの場合は空文字
+func optionalKratosID(c *gin.Context) string {
+	var kratosID string
+	if kratosIDValue, exists := c.Get("kratos_id"); exists {
+		kratosID, _ = kratosIDValue.(string)
+	}
+	return kratosID
+}
+
+// kratosIDFromContext は認証ミドルウェアが設定したKratosIDを取得する。取得できない場合はエラーレスポンスを返す
+func kratosIDFromContext(c *gin.Context) (string, bool) {
+	kratosIDValue, exists := c.Get("kratos_id")
+	if !exists {
+		response.ReturnStatusUnauthorized(c, errors.New("user not authenticated"))
+		return "", false
+	}
+	kratosID, ok := kratosIDValue.(string)
+	if !ok {
+		response.ReturnStatusInternalServerError(c, errors.New("invalid kratos_id type"))
+		return "", false
+	}
+	return kratosID, true
+}
+
+// returnTourDomainError はドメインエラーの種類に応じたステータスコードを返す
+func returnTourDomainError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainerror.ErrValidation):
+		response.ReturnStatusBadRequest(c, err)
+	case errors.Is(err, domainerror.ErrUnauthorized):
+		response.ReturnStatusForbidden(c, err)
+	case errors.Is(err, domainerror.ErrNotFound):
+		response.ReturnStatusNotFound(c, err)
+	default:
+		response.ReturnStatusInternalServerError(c, err)
+	}
+}
+
+func tourResponseModel(dto *tourUsecase.TourOutputDto) TourResponseModel {
+	stages := make([]TourStageResponse, len(dto.Stages))
+	for i, s := range dto.Stages {
+		stages[i] = TourStageResponse{
+			Day:           s.Day,
+			OvernightStop: s.OvernightStop,
+			RouteID:       s.RouteID,
+			RouteName:     s.RouteName,
+			UserName:      s.UserName,
+			Distance:      s.Distance,
+			Duration:      s.Duration,
+			ElevationGain: s.ElevationGain,
+			ElevationLoss: s.ElevationLoss,
+			Polyline:      s.Polyline,
+			Difficulty:    s.Difficulty,
+		}
+	}
+	return TourResponseModel{
+		ID:            dto.ID,
+		UserID:        dto.UserID,
+		Name:          dto.Name,
+		Description:   dto.Description,
+		Visibility:    dto.Visibility,
+		ClubID:        dto.ClubID,
+		Stages:        stages,
+		Distance:      dto.Distance,
+		Duration:      dto.Duration,
+		ElevationGain: dto.ElevationGain,
+		ElevationLoss: dto.ElevationLoss,
+		CreatedAt:     dto.CreatedAt,
+		UpdatedAt:     dto.UpdatedAt,
+	}
+}
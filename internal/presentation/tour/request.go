@@ -0,0 +1,16 @@
+package tour
+
+// TourRequest はツアー作成・更新のリクエスト。更新ではステージをすべて置き換える
+type TourRequest struct {
+	Name        string             `json:"name" validate:"required"`
+	Description string             `json:"description"`
+	Visibility  int16              `json:"visibility" validate:"min=0,max=3"`     // 0: 非公開, 1: 公開, 2: 友達のみ, 3: クラブのメンバーのみ
+	ClubID      *string            `json:"club_id,omitempty"`                     // 公開範囲がクラブのメンバーのみの場合の共有先
+	Stages      []TourStageRequest `json:"stages" validate:"required,min=1,dive"` // 日程の順
+}
+
+// TourStageRequest はツアーの1日分。最終日など宿泊しない場合は overnight_stop を省略する
+type TourStageRequest struct {
+	RouteID       string `json:"route_id" validate:"required"`
+	OvernightStop string `json:"overnight_stop"`
+}
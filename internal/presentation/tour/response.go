@@ -0,0 +1,40 @@
+package tour
+
+type TourListResponse struct {
+	Tours []TourResponseModel `json:"tours"`
+}
+
+type TourResponse struct {
+	Tour TourResponseModel `json:"tour"`
+}
+
+// TourResponseModel のステージと合計は閲覧できるルートのみを対象にする
+type TourResponseModel struct {
+	ID            string              `json:"id"`
+	UserID        string              `json:"user_id"`
+	Name          string              `json:"name"`
+	Description   string              `json:"description"`
+	Visibility    int16               `json:"visibility"`
+	ClubID        *string             `json:"club_id,omitempty"` // 公開範囲がクラブのメンバーのみの場合の共有先
+	Stages        []TourStageResponse `json:"stages"`            // 日程の順
+	Distance      float64             `json:"distance"`
+	Duration      float64             `json:"duration"`
+	ElevationGain float64             `json:"elevation_gain"`
+	ElevationLoss float64             `json:"elevation_loss"`
+	CreatedAt     string              `json:"created_at"`
+	UpdatedAt     string              `json:"updated_at"`
+}
+
+type TourStageResponse struct {
+	Day           int     `json:"day"` // 1日目が1
+	OvernightStop string  `json:"overnight_stop,omitempty"`
+	RouteID       string  `json:"route_id"`
+	RouteName     string  `json:"route_name"`
+	UserName      string  `json:"user_name"`
+	Distance      float64 `json:"distance"`
+	Duration      float64 `json:"duration"`
+	ElevationGain float64 `json:"elevation_gain"`
+	ElevationLoss float64 `json:"elevation_loss"`
+	Polyline      string  `json:"polyline"`
+	Difficulty    string  `json:"difficulty,omitempty"` // easy, moderate, hard, expert。まだ求めていない場合は省略
+}
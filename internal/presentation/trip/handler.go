@@ -0,0 +1,168 @@
+package trip
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/response"
+	tripUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/trip"
+	"github.com/gin-gonic/gin"
+)
+
+// maxGPXSize はアップロードできるGPXの大きさの上限(byte)
+const maxGPXSize = 20 << 20
+
+type Handler struct {
+	tripUsecase tripUsecase.ITripUsecase
+}
+
+func NewHandler(tripUsecase tripUsecase.ITripUsecase) *Handler {
+	return &Handler{tripUsecase: tripUsecase}
+}
+
+// ListTrips godoc
+//
+//	@Summary		自分のトリップの一覧を取得する
+//	@Description	作成日時の新しい順に返す
+//	@Tags			trips
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			limit	query		integer	false	"Page size (default 20, max 100)"
+//	@Param			cursor	query		string	false	"Cursor returned as next_cursor in the previous page"
+//	@Success		200		{object}	TripListResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/trips [get]
+func (h *Handler) ListTrips(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var limit int32
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			response.ReturnStatusBadRequest(c, errors.New("invalid limit"))
+			return
+		}
+		limit = int32(l)
+	}
+
+	dto, err := h.tripUsecase.ListTrips(c.Request.Context(), kratosID, tripUsecase.ListTripsInputDto{
+		Limit:  limit,
+		Cursor: c.Query("cursor"),
+	})
+	if err != nil {
+		returnTripDomainError(c, err)
+		return
+	}
+
+	trips := make([]TripResponseModel, 0, len(dto.Trips))
+	for _, t := range dto.Trips {
+		trips = append(trips, toTripResponse(t))
+	}
+
+	res := TripListResponse{Trips: trips}
+	if dto.NextCursor != "" {
+		res.NextCursor = &dto.NextCursor
+	}
+	response.ReturnStatusOK(c, res)
+}
+
+// UploadTrip godoc
+//
+//	@Summary		GPXをアップロードしてトリップを作成する
+//	@Description	GPXのトラックから経路と距離・時間・獲得標高を求めて保存し、フォロワーのフィードに表示する。複数のトラックは記録順につなげる
+//	@Tags			trips
+//	@Accept			application/gpx+xml
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			name		query		string	false	"トリップの名前。省略時はGPXに記録された名前"
+//	@Param			description	query		string	false	"説明"
+//	@Param			visibility	query		integer	false	"公開範囲（0: 非公開, 1: 公開, 2: 友達のみ）。省略時は非公開"
+//	@Param			request		body		string	true	"GPX"
+//	@Success		201			{object}	TripResponseModel
+//	@Failure		400			{object}	response.ErrorResponse
+//	@Failure		401			{object}	response.ErrorResponse
+//	@Failure		500			{object}	response.ErrorResponse
+//	@Router			/trips [post]
+func (h *Handler) UploadTrip(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var visibility int16
+	if v := c.Query("visibility"); v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 16)
+		if err != nil {
+			response.ReturnStatusBadRequest(c, errors.New("invalid visibility"))
+			return
+		}
+		visibility = int16(parsed)
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxGPXSize))
+	if err != nil {
+		response.ReturnStatusBadRequest(c, errors.New("gpx is too large"))
+		return
+	}
+
+	dto, err := h.tripUsecase.UploadTrip(c.Request.Context(), kratosID, tripUsecase.UploadTripInputDto{
+		GPX:         data,
+		Name:        c.Query("name"),
+		Description: c.Query("description"),
+		Visibility:  visibility,
+	})
+	if err != nil {
+		returnTripDomainError(c, err)
+		return
+	}
+	response.ReturnStatusCreated(c, toTripResponse(*dto))
+}
+
+func toTripResponse(t tripUsecase.TripOutputDto) TripResponseModel {
+	return TripResponseModel{
+		ID:            t.ID,
+		Name:          t.Name,
+		Description:   t.Description,
+		Visibility:    t.Visibility,
+		Distance:      t.Distance,
+		Duration:      t.Duration,
+		MovingTime:    t.MovingTime,
+		ElevationGain: t.ElevationGain,
+		ElevationLoss: t.ElevationLoss,
+		DepartedAt:    t.DepartedAt,
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+func kratosIDFromContext(c *gin.Context) (string, bool) {
+	kratosIDValue, exists := c.Get("kratos_id")
+	if !exists {
+		response.ReturnStatusUnauthorized(c, errors.New("user not authenticated"))
+		return "", false
+	}
+	kratosID, ok := kratosIDValue.(string)
+	if !ok {
+		response.ReturnStatusInternalServerError(c, errors.New("invalid kratos_id type"))
+		return "", false
+	}
+	return kratosID, true
+}
+
+func returnTripDomainError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainerror.ErrValidation):
+		response.ReturnStatusBadRequest(c, err)
+	case errors.Is(err, domainerror.ErrNotFound):
+		response.ReturnStatusNotFound(c, err)
+	default:
+		response.ReturnStatusInternalServerError(c, err)
+	}
+}
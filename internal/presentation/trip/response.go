@@ -0,0 +1,20 @@
+package trip
+
+type TripListResponse struct {
+	Trips      []TripResponseModel `json:"trips"`
+	NextCursor *string             `json:"next_cursor"` // 次のページがない場合はnull
+}
+
+type TripResponseModel struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	Visibility    int16    `json:"visibility"`
+	Distance      *float64 `json:"distance"` // 計測値を求めていない場合はnull
+	Duration      *int32   `json:"duration"`
+	MovingTime    *int32   `json:"moving_time"`
+	ElevationGain *float64 `json:"elevation_gain"`
+	ElevationLoss *float64 `json:"elevation_loss"`
+	DepartedAt    *string  `json:"departed_at"`
+	CreatedAt     string   `json:"created_at"`
+}
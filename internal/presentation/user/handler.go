@@ -19,6 +19,8 @@ type Handler struct {
 	createUserUsecase userUsecase.ICreateUserUsecase
 	getUserUsecase    userUsecase.IGetUserByIDUsecase
 	updateUserUsecase userUsecase.IUpdateUserUsecase
+	privacyZoneUsecase userUsecase.IPrivacyZoneUsecase
+	followUsecase     userUsecase.IFollowUsecase
 }
 
 // NewHandler はHandlerを作成する
@@ -26,11 +28,15 @@ func NewHandler(
 	createUserUsecase userUsecase.ICreateUserUsecase,
 	getUserUsecase userUsecase.IGetUserByIDUsecase,
 	updateUserUsecase userUsecase.IUpdateUserUsecase,
+	privacyZoneUsecase userUsecase.IPrivacyZoneUsecase,
+	followUsecase userUsecase.IFollowUsecase,
 ) *Handler {
 	return &Handler{
-		createUserUsecase: createUserUsecase,
-		getUserUsecase:    getUserUsecase,
-		updateUserUsecase: updateUserUsecase,
+		createUserUsecase:  createUserUsecase,
+		getUserUsecase:     getUserUsecase,
+		updateUserUsecase:  updateUserUsecase,
+		privacyZoneUsecase: privacyZoneUsecase,
+		followUsecase:      followUsecase,
 	}
 }
 
@@ -238,6 +244,7 @@ func (h *Handler) UpdateUserProfile(c *gin.Context) {
 
 // UpdateUserLocation godoc
 //	@Summary	ユーザーの位置情報を更新する
+//	@Description	地域・行政区・国コードは位置（geom）を含む行政区域から求める。求められなかった項目にはリクエストの値を使う
 //	@Tags		users
 //	@Accept		json
 //	@Produce	json
@@ -297,3 +304,201 @@ func (h *Handler) UpdateUserLocation(c *gin.Context) {
 
 	response.ReturnStatusNoContent(c)
 }
+
+// ListPrivacyZones godoc
+//	@Summary	プライバシーゾーンの一覧を取得する
+//	@Tags		users
+//	@Produce	json
+//	@Security	CookieAuth
+//	@Success	200	{object}	PrivacyZoneListResponse
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/users/settings/privacy-zones [get]
+func (h *Handler) ListPrivacyZones(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	dtos, err := h.privacyZoneUsecase.ListPrivacyZones(c.Request.Context(), kratosID)
+	if err != nil {
+		response.ReturnStatusInternalServerError(c, err)
+		return
+	}
+
+	zones := make([]PrivacyZoneResponseModel, len(dtos))
+	for i := range dtos {
+		zones[i] = privacyZoneResponseModel(&dtos[i])
+	}
+	response.ReturnStatusOK(c, PrivacyZoneListResponse{PrivacyZones: zones})
+}
+
+// CreatePrivacyZone godoc
+//	@Summary		プライバシーゾーンを追加する
+//	@Description	範囲内にある軌跡の始点・終点側は、トリップからルートを作るときに取り除かれる
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Security		CookieAuth
+//	@Param			request	body		CreatePrivacyZoneRequest	true	"Create Privacy Zone Request"
+//	@Success		201		{object}	PrivacyZoneResponse
+//	@Failure		400		{object}	response.ErrorResponse
+//	@Failure		401		{object}	response.ErrorResponse
+//	@Failure		500		{object}	response.ErrorResponse
+//	@Router			/users/settings/privacy-zones [post]
+func (h *Handler) CreatePrivacyZone(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req CreatePrivacyZoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ReturnBadRequest(c, err)
+		return
+	}
+
+	validate := validator.GetValidator()
+	if err := validate.Struct(req); err != nil {
+		response.ReturnStatusBadRequest(c, err)
+		return
+	}
+
+	center, err := pkgGeojson.ParseToPoint(req.Center)
+	if err != nil {
+		response.ReturnBadRequest(c, errors.New("invalid center GeoJSON: "+err.Error()))
+		return
+	}
+
+	dto, err := h.privacyZoneUsecase.CreatePrivacyZone(c.Request.Context(), kratosID, userUsecase.CreatePrivacyZoneInputDto{
+		Name:    req.Name,
+		Center:  center,
+		RadiusM: req.RadiusM,
+	})
+	if err != nil {
+		if errors.Is(err, domainerror.ErrValidation) {
+			response.ReturnBadRequest(c, err)
+			return
+		}
+		response.ReturnStatusInternalServerError(c, err)
+		return
+	}
+
+	response.ReturnStatusCreated(c, PrivacyZoneResponse{PrivacyZone: privacyZoneResponseModel(dto)})
+}
+
+// DeletePrivacyZone godoc
+//	@Summary	プライバシーゾーンを削除する
+//	@Tags		users
+//	@Security	CookieAuth
+//	@Param		zone_id	path	string	true	"Privacy Zone ID"
+//	@Success	204
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	404	{object}	response.ErrorResponse
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/users/settings/privacy-zones/{zone_id} [delete]
+func (h *Handler) DeletePrivacyZone(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.privacyZoneUsecase.DeletePrivacyZone(c.Request.Context(), kratosID, c.Param("zone_id")); err != nil {
+		if errors.Is(err, domainerror.ErrNotFound) {
+			response.ReturnStatusNotFound(c, err)
+			return
+		}
+		response.ReturnStatusInternalServerError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// FollowUser godoc
+//	@Summary		ユーザーをフォローする
+//	@Description	フォローしたユーザーのルート作成・トリップ・いいね・コメントがフィードに表示される
+//	@Tags			users
+//	@Security		CookieAuth
+//	@Param			id	path	string	true	"User ID"
+//	@Success		204
+//	@Failure		400	{object}	response.ErrorResponse	"自分自身はフォローできない"
+//	@Failure		401	{object}	response.ErrorResponse
+//	@Failure		404	{object}	response.ErrorResponse
+//	@Failure		409	{object}	response.ErrorResponse	"すでにフォローしている"
+//	@Failure		500	{object}	response.ErrorResponse
+//	@Router			/users/{id}/follow [put]
+func (h *Handler) FollowUser(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.followUsecase.FollowUser(c.Request.Context(), kratosID, c.Param("id")); err != nil {
+		switch {
+		case errors.Is(err, domainerror.ErrValidation):
+			response.ReturnStatusBadRequest(c, err)
+		case errors.Is(err, domainerror.ErrNotFound):
+			response.ReturnStatusNotFound(c, err)
+		case errors.Is(err, domainerror.ErrConflict):
+			response.ReturnStatusConflict(c, err)
+		default:
+			response.ReturnStatusInternalServerError(c, err)
+		}
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+// UnfollowUser godoc
+//	@Summary	ユーザーのフォローを解除する
+//	@Tags		users
+//	@Security	CookieAuth
+//	@Param		id	path	string	true	"User ID"
+//	@Success	204
+//	@Failure	401	{object}	response.ErrorResponse
+//	@Failure	404	{object}	response.ErrorResponse	"フォローしていない"
+//	@Failure	500	{object}	response.ErrorResponse
+//	@Router		/users/{id}/follow [delete]
+func (h *Handler) UnfollowUser(c *gin.Context) {
+	kratosID, ok := kratosIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.followUsecase.UnfollowUser(c.Request.Context(), kratosID, c.Param("id")); err != nil {
+		if errors.Is(err, domainerror.ErrNotFound) {
+			response.ReturnStatusNotFound(c, err)
+			return
+		}
+		response.ReturnStatusInternalServerError(c, err)
+		return
+	}
+
+	response.ReturnStatusNoContent(c)
+}
+
+func kratosIDFromContext(c *gin.Context) (string, bool) {
+	kratosIDValue, exists := c.Get("kratos_id")
+	if !exists {
+		response.ReturnStatusUnauthorized(c, errors.New("user not authenticated"))
+		return "", false
+	}
+	kratosID, ok := kratosIDValue.(string)
+	if !ok {
+		response.ReturnStatusInternalServerError(c, errors.New("invalid kratos_id type"))
+		return "", false
+	}
+	return kratosID, true
+}
+
+func privacyZoneResponseModel(dto *userUsecase.PrivacyZoneOutputDto) PrivacyZoneResponseModel {
+	return PrivacyZoneResponseModel{
+		ID:        dto.ID,
+		Name:      dto.Name,
+		Center:    geometry.GeometryToGeoJSON(dto.Center),
+		RadiusM:   dto.RadiusM,
+		CreatedAt: dto.CreatedAt,
+	}
+}
@@ -18,10 +18,18 @@ type UpdateUserProfileRequest struct {
 }
 
 // UpdateUserLocationRequest はユーザー位置情報更新のリクエスト
+// 地域・行政区・国コードは位置から求め、行政区域のデータがない地点でのみ指定した値を使う
 type UpdateUserLocationRequest struct {
-	Locality           string `json:"locality" validate:"required"`
-	AdministrativeArea string `json:"administrative_area" validate:"required"`
-	CountryCode        string `json:"country_code" validate:"required"`
+	Locality           string `json:"locality"`
+	AdministrativeArea string `json:"administrative_area"`
+	CountryCode        string `json:"country_code"`
 	PostalCode         string `json:"postal_code" validate:"required"`
 	Geom               string `json:"geom" validate:"required"`
 }
+
+// CreatePrivacyZoneRequest はプライバシーゾーン追加のリクエスト
+type CreatePrivacyZoneRequest struct {
+	Name    string  `json:"name"`
+	Center  string  `json:"center" validate:"required"`   // GeoJSONのPoint
+	RadiusM float64 `json:"radius_m" validate:"required"` // 半径(m)。100〜2000
+}
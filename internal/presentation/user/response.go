@@ -36,3 +36,19 @@ type UserResponseModel struct {
 	AdministrativeArea *string `json:"administrative_area,omitempty"`
 	CountryCode        *string `json:"country_code,omitempty"`
 }
+
+type PrivacyZoneListResponse struct {
+	PrivacyZones []PrivacyZoneResponseModel `json:"privacy_zones"`
+}
+
+type PrivacyZoneResponse struct {
+	PrivacyZone PrivacyZoneResponseModel `json:"privacy_zone"`
+}
+
+type PrivacyZoneResponseModel struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Center    *string `json:"center"`
+	RadiusM   float64 `json:"radius_m"`
+	CreatedAt string  `json:"created_at"`
+}
@@ -1,13 +1,33 @@
 package route
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/YukiAminaka/cycle-route-backend/config"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
 	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/geocoding"
 	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/routing"
+	clubPre "github.com/YukiAminaka/cycle-route-backend/internal/presentation/club"
+	collectionPre "github.com/YukiAminaka/cycle-route-backend/internal/presentation/collection"
+	eventPre "github.com/YukiAminaka/cycle-route-backend/internal/presentation/event"
+	feedPre "github.com/YukiAminaka/cycle-route-backend/internal/presentation/feed"
 	"github.com/YukiAminaka/cycle-route-backend/internal/presentation/middleware"
+	notificationPre "github.com/YukiAminaka/cycle-route-backend/internal/presentation/notification"
 	routePre "github.com/YukiAminaka/cycle-route-backend/internal/presentation/route"
+	tourPre "github.com/YukiAminaka/cycle-route-backend/internal/presentation/tour"
+	tripPre "github.com/YukiAminaka/cycle-route-backend/internal/presentation/trip"
 	userPre "github.com/YukiAminaka/cycle-route-backend/internal/presentation/user"
+	clubUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/club"
+	collectionUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/collection"
+	eventUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/event"
+	feedUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/feed"
+	notificationUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/notification"
 	routeUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/route"
+	tourUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/tour"
+	tripUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/trip"
 	userUsecase "github.com/YukiAminaka/cycle-route-backend/internal/usecase/user"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -25,16 +45,27 @@ func InitRoute(conf *config.Config, api *gin.Engine, q *dbgen.Queries, pool *pgx
 
 	{
 		userRoute(v1, q, k)
-		routeRoute(v1, q, pool, k)
+		routeRoute(v1, conf, q, pool, k)
+		collectionRoute(v1, q, pool, k)
+		tourRoute(v1, q, pool, k)
+		eventRoute(v1, q, pool, k)
+		clubRoute(v1, q, pool, k)
+		feedRoute(v1, q, k)
+		notificationRoute(v1, q, k)
+		tripRoute(v1, q, pool, k)
 	}
 }
 
 func userRoute(r *gin.RouterGroup, q *dbgen.Queries, k *middleware.KratosMiddleware) {
 	userRepository := repository.NewUserRepository(q)
+	geocoder := geocoding.NewBoundaryGeocoder(repository.NewAdminBoundaryRepository(q))
+	publisher := notificationUsecase.NewPublisher(repository.NewNotificationRepository(q))
 	h := userPre.NewHandler(
 		userUsecase.NewCreateUserUsecase(userRepository),
 		userUsecase.NewGetUserByIDUsecase(userRepository),
-		userUsecase.NewUpdateUserUsecase(userRepository),
+		userUsecase.NewUpdateUserUsecase(userRepository, geocoder),
+		userUsecase.NewPrivacyZoneUsecase(userRepository, repository.NewPrivacyZoneRepository(q)),
+		userUsecase.NewFollowUsecase(userRepository, repository.NewFollowRepository(q), publisher),
 	)
 	
 	group := r.Group("/users")
@@ -42,28 +73,228 @@ func userRoute(r *gin.RouterGroup, q *dbgen.Queries, k *middleware.KratosMiddlew
 	group.GET("/:id", h.GetUserByID)
 	group.PUT("/settings/profile", k.Session(), h.UpdateUserProfile)
 	group.PUT("/settings/location", k.Session(), h.UpdateUserLocation)
+	group.GET("/settings/privacy-zones", k.Session(), h.ListPrivacyZones)
+	group.POST("/settings/privacy-zones", k.Session(), h.CreatePrivacyZone)
+	group.DELETE("/settings/privacy-zones/:zone_id", k.Session(), h.DeletePrivacyZone)
+	group.PUT("/:id/follow", k.Session(), h.FollowUser)
+	group.DELETE("/:id/follow", k.Session(), h.UnfollowUser)
 	group.POST("", h.CreateUser)
 }
 
-func routeRoute(r *gin.RouterGroup, q *dbgen.Queries, pool *pgxpool.Pool, k *middleware.KratosMiddleware) {
+func routeRoute(r *gin.RouterGroup, conf *config.Config, q *dbgen.Queries, pool *pgxpool.Pool, k *middleware.KratosMiddleware) {
 	routeRepository := repository.NewRouteRepository(q)
 	userRepository := repository.NewUserRepository(q)
+	tripRepository := repository.NewTripRepository(q)
 	txManager := repository.NewTransactionManager(q, pool)
+	geocoder := geocoding.NewBoundaryGeocoder(repository.NewAdminBoundaryRepository(q))
+	clubRepository := repository.NewClubRepository(q)
+	publisher := notificationUsecase.NewPublisher(repository.NewNotificationRepository(q))
+
+	createRouteUsecase := routeUsecase.NewCreateRouteUsecase(userRepository, txManager, geocoder, clubRepository)
 
 	h := routePre.NewHandler(
-		routeUsecase.NewCreateRouteUsecase(userRepository, txManager),
-		routeUsecase.NewGetRouteUsecase(routeRepository, userRepository),
-		routeUsecase.NewUpdateRouteUsecase(userRepository, txManager, routeRepository),
+		createRouteUsecase,
+		routeUsecase.NewGetRouteUsecase(routeRepository, userRepository, tripRepository, repository.NewCollectionRepository(q), clubRepository),
+		routeUsecase.NewUpdateRouteUsecase(userRepository, txManager, routeRepository, geocoder, clubRepository),
 		routeUsecase.NewDeleteRouteUsecase(userRepository, txManager, routeRepository),
-		routeUsecase.NewExportGPXUsecase(routeRepository),
+		routeUsecase.NewExportGPXUsecase(routeRepository, userRepository, clubRepository),
+		routeUsecase.NewRouteVersionUsecase(userRepository, txManager, routeRepository, geocoder),
+		routeUsecase.NewForkRouteUsecase(userRepository, txManager, routeRepository, clubRepository, publisher),
+		routeUsecase.NewEditRouteGeometryUsecase(userRepository, txManager, routeRepository, geocoder),
+		routeUsecase.NewGenerateCuesUsecase(userRepository, txManager, routeRepository),
+		routeUsecase.NewCueSheetUsecase(userRepository, txManager, routeRepository, clubRepository),
+		routeUsecase.NewPlanRouteUsecase(userRepository, newRouter(conf.Routing, q)),
+		routeUsecase.NewMatchTripUsecase(userRepository, tripRepository, routing.NewGraphMatcher(repository.NewRoadEdgeRepository(q))),
+		routeUsecase.NewConvertTripUsecase(userRepository, tripRepository, repository.NewPrivacyZoneRepository(q), createRouteUsecase),
+		routeUsecase.NewRoutePOIUsecase(userRepository, txManager, routeRepository, repository.NewPOIRepository(q), clubRepository),
+		routeUsecase.NewRouteTagUsecase(userRepository, txManager, routeRepository),
+		routeUsecase.NewRouteReactionUsecase(userRepository, txManager, routeRepository, clubRepository, publisher),
 	)
 
 	group := r.Group("/routes")
 	group.POST("", k.Session(), h.CreateRoute)
 	group.GET("", k.Session(), h.GetRoutesByUserID) // 認証ユーザーのルート一覧
-	group.GET("/:route_id", h.GetRouteByID)
+	group.GET("/saved", k.Session(), h.GetSavedRoutes) // 認証ユーザーが保存したルートの一覧
+	group.POST("/plan", k.Session(), h.PlanRoute)
+	group.GET("/:route_id", k.OptionalSession(), h.GetRouteByID) // ログイン時は推定所要時間も返す
 	group.PUT("/:route_id", k.Session(), h.UpdateRoute)
 	group.DELETE("/:route_id", k.Session(), h.DeleteRoute)
 	group.GET("/:route_id/gpx", k.Session(), h.ExportRouteGPX)
+	group.GET("/:route_id/similar", k.OptionalSession(), h.GetSimilarRoutes) // 公開のルートは未ログインでも取得できる
+	group.POST("/:route_id/fork", k.Session(), h.ForkRoute)
+	group.POST("/:route_id/reverse", k.Session(), h.ReverseRoute)
+	group.POST("/:route_id/trim", k.Session(), h.TrimRoute)
+	group.POST("/:route_id/split", k.Session(), h.SplitRoute)
+	group.POST("/:route_id/join", k.Session(), h.JoinRoutes)
+	group.POST("/:route_id/cues", k.Session(), h.GenerateCues)
+	group.GET("/:route_id/cuesheet.csv", k.Session(), h.ExportCueSheetCSV)
+	group.GET("/:route_id/cuesheet.pdf", k.Session(), h.ExportCueSheetPDF)
+	group.PUT("/:route_id/cuesheet.csv", k.Session(), h.ImportCueSheetCSV)
+	group.GET("/:route_id/pois", k.Session(), h.ListRoutePOIs)
+	group.POST("/:route_id/pois/:poi_id/promote", k.Session(), h.PromotePOI)
+	group.PUT("/:route_id/tags", k.Session(), h.UpdateRouteTags)
+	group.PUT("/:route_id/like", k.Session(), h.LikeRoute)
+	group.DELETE("/:route_id/like", k.Session(), h.UnlikeRoute)
+	group.PUT("/:route_id/save", k.Session(), h.SaveRoute)
+	group.DELETE("/:route_id/save", k.Session(), h.UnsaveRoute)
+	group.GET("/:route_id/comments", k.OptionalSession(), h.ListRouteComments) // 公開のルートは未ログインでも取得できる
+	group.POST("/:route_id/comments", k.Session(), h.AddRouteComment)
+	group.DELETE("/:route_id/comments/:comment_id", k.Session(), h.DeleteRouteComment)
+	group.GET("/:route_id/versions", k.Session(), h.ListRouteVersions)
+	group.GET("/:route_id/versions/:version", k.Session(), h.GetRouteVersion)
+	group.POST("/:route_id/versions/:version/restore", k.Session(), h.RestoreRouteVersion)
 	group.GET("/explore",k.Session(), h.ExploreRoutes)
+
+	tripGroup := r.Group("/trips")
+	tripGroup.POST("/:trip_id/match", k.Session(), h.MatchTrip)
+	tripGroup.POST("/:trip_id/to-route", k.Session(), h.ConvertTripToRoute)
+}
+
+func collectionRoute(r *gin.RouterGroup, q *dbgen.Queries, pool *pgxpool.Pool, k *middleware.KratosMiddleware) {
+	h := collectionPre.NewHandler(collectionUsecase.NewCollectionUsecase(
+		repository.NewUserRepository(q),
+		repository.NewTransactionManager(q, pool),
+		repository.NewCollectionRepository(q),
+		repository.NewRouteRepository(q),
+		repository.NewClubRepository(q),
+	))
+
+	group := r.Group("/collections")
+	group.GET("", k.Session(), h.ListMyCollections) // 認証ユーザーのコレクション一覧
+	group.POST("", k.Session(), h.CreateCollection)
+	group.GET("/:collection_id", k.OptionalSession(), h.GetCollection) // 公開のコレクションは未ログインでも取得できる
+	group.PUT("/:collection_id", k.Session(), h.UpdateCollection)
+	group.DELETE("/:collection_id", k.Session(), h.DeleteCollection)
+	group.POST("/:collection_id/routes", k.Session(), h.AddRoute)
+	group.PUT("/:collection_id/routes", k.Session(), h.ReorderRoutes)
+	group.DELETE("/:collection_id/routes/:route_id", k.Session(), h.RemoveRoute)
+}
+
+func tourRoute(r *gin.RouterGroup, q *dbgen.Queries, pool *pgxpool.Pool, k *middleware.KratosMiddleware) {
+	h := tourPre.NewHandler(tourUsecase.NewTourUsecase(
+		repository.NewUserRepository(q),
+		repository.NewTransactionManager(q, pool),
+		repository.NewTourRepository(q),
+		repository.NewRouteRepository(q),
+		repository.NewClubRepository(q),
+	))
+
+	group := r.Group("/tours")
+	group.GET("", k.Session(), h.ListMyTours) // 認証ユーザーのツアー一覧
+	group.POST("", k.Session(), h.CreateTour)
+	group.GET("/:tour_id", k.OptionalSession(), h.GetTour) // 公開のツアーは未ログインでも取得・出力できる
+	group.PUT("/:tour_id", k.Session(), h.UpdateTour)
+	group.DELETE("/:tour_id", k.Session(), h.DeleteTour)
+	group.GET("/:tour_id/gpx", k.OptionalSession(), h.ExportTourGPX)
+	group.GET("/:tour_id/fit", k.OptionalSession(), h.ExportTourFIT)
+}
+
+func eventRoute(r *gin.RouterGroup, q *dbgen.Queries, pool *pgxpool.Pool, k *middleware.KratosMiddleware) {
+	userRepository := repository.NewUserRepository(q)
+	routeRepository := repository.NewRouteRepository(q)
+	clubRepository := repository.NewClubRepository(q)
+	h := eventPre.NewHandler(
+		eventUsecase.NewEventUsecase(
+			userRepository,
+			repository.NewTransactionManager(q, pool),
+			repository.NewEventRepository(q),
+			routeRepository,
+			clubRepository,
+			notificationUsecase.NewPublisher(repository.NewNotificationRepository(q)),
+		),
+		routeUsecase.NewExportGPXUsecase(routeRepository, userRepository, clubRepository),
+	)
+
+	group := r.Group("/events")
+	group.GET("", h.ListUpcomingEvents) // 開催予定の公開イベント
+	group.POST("", k.Session(), h.CreateEvent)
+	group.GET("/:event_id", k.OptionalSession(), h.GetEvent) // 公開のイベントは未ログインでも取得・出力できる
+	group.PUT("/:event_id", k.Session(), h.UpdateEvent)
+	group.POST("/:event_id/cancel", k.Session(), h.CancelEvent)
+	group.PUT("/:event_id/rsvp", k.Session(), h.RespondToEvent)
+	group.GET("/:event_id/rsvps", k.OptionalSession(), h.ListRSVPs)
+	group.GET("/:event_id/comments", k.OptionalSession(), h.ListComments)
+	group.POST("/:event_id/comments", k.Session(), h.AddComment)
+	group.DELETE("/:event_id/comments/:comment_id", k.Session(), h.DeleteComment)
+	group.GET("/:event_id/gpx", k.OptionalSession(), h.ExportEventGPX)
+}
+
+func clubRoute(r *gin.RouterGroup, q *dbgen.Queries, pool *pgxpool.Pool, k *middleware.KratosMiddleware) {
+	h := clubPre.NewHandler(clubUsecase.NewClubUsecase(
+		repository.NewUserRepository(q),
+		repository.NewTransactionManager(q, pool),
+		repository.NewClubRepository(q),
+		repository.NewRouteRepository(q),
+	))
+
+	group := r.Group("/clubs")
+	group.GET("", k.Session(), h.ListMyClubs) // 認証ユーザーが所属するクラブの一覧
+	group.POST("", k.Session(), h.CreateClub)
+	group.GET("/:club_id", k.Session(), h.GetClub)
+	group.PUT("/:club_id", k.Session(), h.UpdateClub)
+	group.DELETE("/:club_id", k.Session(), h.DeleteClub)
+	group.GET("/:club_id/members", k.Session(), h.ListMembers)
+	group.PUT("/:club_id/members/:user_id", k.Session(), h.ChangeMemberRole)
+	group.DELETE("/:club_id/members/:user_id", k.Session(), h.RemoveMember)
+	group.GET("/:club_id/join-requests", k.Session(), h.ListJoinRequests)
+	group.POST("/:club_id/join-requests", k.Session(), h.RequestToJoin)
+	group.POST("/:club_id/join-requests/:user_id/approve", k.Session(), h.ApproveJoinRequest)
+	group.DELETE("/:club_id/join-requests/:user_id", k.Session(), h.RejectJoinRequest)
+	group.GET("/:club_id/routes", k.Session(), h.ListLibraryRoutes)
+	group.POST("/:club_id/routes", k.Session(), h.AddLibraryRoute)
+	group.DELETE("/:club_id/routes/:route_id", k.Session(), h.RemoveLibraryRoute)
+}
+
+func feedRoute(r *gin.RouterGroup, q *dbgen.Queries, k *middleware.KratosMiddleware) {
+	h := feedPre.NewHandler(feedUsecase.NewFeedUsecase(
+		repository.NewUserRepository(q),
+		repository.NewFeedRepository(q),
+		repository.NewRouteRepository(q),
+		repository.NewTripRepository(q),
+		repository.NewClubRepository(q),
+	))
+
+	r.GET("/feed", k.Session(), h.GetFeed)
+}
+
+func notificationRoute(r *gin.RouterGroup, q *dbgen.Queries, k *middleware.KratosMiddleware) {
+	h := notificationPre.NewHandler(notificationUsecase.NewNotificationUsecase(
+		repository.NewUserRepository(q),
+		repository.NewNotificationRepository(q),
+	))
+
+	group := r.Group("/notifications")
+	group.GET("", k.Session(), h.ListNotifications)
+	group.GET("/unread-count", k.Session(), h.GetUnreadCount)
+	group.POST("/read-all", k.Session(), h.MarkAllRead)
+	group.GET("/preferences", k.Session(), h.GetPreferences)
+	group.PUT("/preferences", k.Session(), h.UpdatePreferences)
+	group.POST("/:notification_id/read", k.Session(), h.MarkRead)
+}
+
+func tripRoute(r *gin.RouterGroup, q *dbgen.Queries, pool *pgxpool.Pool, k *middleware.KratosMiddleware) {
+	h := tripPre.NewHandler(tripUsecase.NewTripUsecase(
+		repository.NewUserRepository(q),
+		repository.NewTripRepository(q),
+		repository.NewTransactionManager(q, pool),
+	))
+
+	// マッチングとルートへの変換はrouteRouteで登録している
+	group := r.Group("/trips")
+	group.GET("", k.Session(), h.ListTrips)   // 認証ユーザーのトリップ一覧
+	group.POST("", k.Session(), h.UploadTrip) // GPXのアップロード
+}
+
+// newRouter は設定に応じたルーティングエンジンを作成する
+func newRouter(conf config.Routing, q *dbgen.Queries) routeDomain.Router {
+	switch conf.Engine {
+	case "fake":
+		return routing.NewFakeRouter()
+	case "graph":
+		return routing.NewGraphRouter(repository.NewRoadEdgeRepository(q))
+	}
+	return routing.NewOSRMRouter(&http.Client{Timeout: 30 * time.Second}, conf.OSRMUrl, map[routeDomain.RoutingProfile]string{
+		routeDomain.RoutingProfileGravel:        conf.OSRMGravelUrl,
+		routeDomain.RoutingProfileAvoidHighways: conf.OSRMAvoidHighwaysUrl,
+	})
 }
@@ -24,6 +24,8 @@ func Run(ctx context.Context, conf *config.Config, q *dbgen.Queries, pool *pgxpo
 	config := cors.DefaultConfig()
 	config.AllowOrigins = []string{conf.Server.FrontendOrigin} // Next.jsのオリジン
 	config.AllowCredentials = true                             // クッキーを許可
+	config.AddAllowHeaders("If-Match")                         // ルート更新時の楽観的排他制御
+	config.AddExposeHeaders("ETag")
 
 	router.Use(cors.New(config))
 	// Recovery ミドルウェアは panic が発生しても 500 エラーを返してくれる
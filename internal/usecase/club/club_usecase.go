@@ -0,0 +1,488 @@
+package club
+
+import (
+	"context"
+	"errors"
+
+	clubDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/club"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+)
+
+// IClubUsecase はクラブとメンバー・参加申請・共有ルートライブラリを管理する
+// クラブの情報はログインユーザーなら誰でも閲覧でき、参加を申請できる
+// メンバーの一覧と共有ルートライブラリはメンバーのみが閲覧でき、管理はオーナーと管理者が行う
+type IClubUsecase interface {
+	ListMyClubs(ctx context.Context, kratosID string) ([]ClubOutputDto, error)
+	GetClub(ctx context.Context, kratosID string, clubID string) (*ClubOutputDto, error)
+	CreateClub(ctx context.Context, kratosID string, dto ClubInputDto) (*ClubOutputDto, error)
+	UpdateClub(ctx context.Context, kratosID string, clubID string, dto ClubInputDto) (*ClubOutputDto, error)
+	DeleteClub(ctx context.Context, kratosID string, clubID string) error
+
+	ListMembers(ctx context.Context, kratosID string, clubID string) ([]MemberOutputDto, error)
+	ChangeMemberRole(ctx context.Context, kratosID string, clubID string, userID string, role string) (*MemberOutputDto, error)
+	// 自分を指定した場合はクラブを抜ける
+	RemoveMember(ctx context.Context, kratosID string, clubID string, userID string) error
+
+	RequestToJoin(ctx context.Context, kratosID string, clubID string, message string) error
+	ListJoinRequests(ctx context.Context, kratosID string, clubID string) ([]JoinRequestOutputDto, error)
+	ApproveJoinRequest(ctx context.Context, kratosID string, clubID string, userID string) (*MemberOutputDto, error)
+	RejectJoinRequest(ctx context.Context, kratosID string, clubID string, userID string) error
+
+	ListLibraryRoutes(ctx context.Context, kratosID string, clubID string) ([]LibraryRouteOutputDto, error)
+	AddLibraryRoute(ctx context.Context, kratosID string, clubID string, routeID string) error
+	RemoveLibraryRoute(ctx context.Context, kratosID string, clubID string, routeID string) error
+}
+
+type clubUsecase struct {
+	userRepo  userDomain.IUserRepository
+	txManager transaction.TransactionManager
+	clubRepo  clubDomain.IClubRepository
+	routeRepo routeDomain.IRouteRepository
+}
+
+func NewClubUsecase(userRepo userDomain.IUserRepository, txManager transaction.TransactionManager, clubRepo clubDomain.IClubRepository, routeRepo routeDomain.IRouteRepository) IClubUsecase {
+	return &clubUsecase{
+		userRepo:  userRepo,
+		txManager: txManager,
+		clubRepo:  clubRepo,
+		routeRepo: routeRepo,
+	}
+}
+
+type ClubInputDto struct {
+	Name        string
+	Description string
+}
+
+type ClubOutputDto struct {
+	ID          string
+	Name        string
+	Description string
+	MemberCount int32
+	MyRole      string // owner, admin, member。メンバーでない場合は空文字
+	CreatedAt   string
+	UpdatedAt   string
+}
+
+type MemberOutputDto struct {
+	UserID   string
+	UserName string
+	Role     string
+	JoinedAt string
+}
+
+type JoinRequestOutputDto struct {
+	UserID    string
+	UserName  string
+	Message   string
+	CreatedAt string
+}
+
+type LibraryRouteOutputDto struct {
+	ID            string
+	UserID        string
+	UserName      string
+	Name          string
+	Distance      float64
+	Duration      float64
+	ElevationGain float64
+	Visibility    int16
+	Polyline      string
+	Difficulty    string // easy, moderate, hard, expert。まだ求めていない場合は空文字
+}
+
+func (u *clubUsecase) ListMyClubs(ctx context.Context, kratosID string) ([]ClubOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	clubs, err := u.clubRepo.GetClubsByUserID(ctx, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]ClubOutputDto, len(clubs))
+	for i, c := range clubs {
+		m, err := u.clubRepo.GetMembership(ctx, c.ID().String(), userEntity.ID().String())
+		if err != nil {
+			return nil, err
+		}
+		outputs[i] = toClubOutputDto(c, m)
+	}
+	return outputs, nil
+}
+
+func (u *clubUsecase) GetClub(ctx context.Context, kratosID string, clubID string) (*ClubOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := u.clubRepo.GetClubByID(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	m, err := u.findMembership(ctx, clubID, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	output := toClubOutputDto(c, m)
+	return &output, nil
+}
+
+// CreateClub はクラブを作成し、作成したユーザーをオーナーにする
+func (u *clubUsecase) CreateClub(ctx context.Context, kratosID string, dto ClubInputDto) (*ClubOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := clubDomain.NewClub(dto.Name, dto.Description)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := clubDomain.NewMembership(c.ID().String(), userEntity.ID().String(), clubDomain.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		clubRepo := repository.NewClubRepository(q)
+		if err := clubRepo.SaveClub(ctx, c); err != nil {
+			return err
+		}
+		return clubRepo.SaveMembership(ctx, owner)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := toClubOutputDto(c, owner)
+	return &output, nil
+}
+
+func (u *clubUsecase) UpdateClub(ctx context.Context, kratosID string, clubID string, dto ClubInputDto) (*ClubOutputDto, error) {
+	actor, err := u.getManager(ctx, kratosID, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := u.clubRepo.GetClubByID(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Update(dto.Name, dto.Description); err != nil {
+		return nil, err
+	}
+	if err := u.clubRepo.UpdateClub(ctx, c); err != nil {
+		return nil, err
+	}
+
+	output := toClubOutputDto(c, actor)
+	return &output, nil
+}
+
+// DeleteClub はクラブを削除する。クラブのメンバーのみに公開していたルートは共有先がなくなり、所有者だけが閲覧できる
+func (u *clubUsecase) DeleteClub(ctx context.Context, kratosID string, clubID string) error {
+	actor, err := u.getMember(ctx, kratosID, clubID)
+	if err != nil {
+		return err
+	}
+	if actor.Role() != clubDomain.RoleOwner {
+		return domainerror.New("only the owner can delete the club", domainerror.ErrUnauthorized)
+	}
+	return u.clubRepo.DeleteClub(ctx, clubID)
+}
+
+func (u *clubUsecase) ListMembers(ctx context.Context, kratosID string, clubID string) ([]MemberOutputDto, error) {
+	if _, err := u.getMember(ctx, kratosID, clubID); err != nil {
+		return nil, err
+	}
+
+	members, err := u.clubRepo.GetMemberships(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]MemberOutputDto, len(members))
+	for i, m := range members {
+		outputs[i] = toMemberOutputDto(m)
+	}
+	return outputs, nil
+}
+
+// ChangeMemberRole はオーナーがメンバーを管理者にしたり、一般のメンバーに戻したりする
+func (u *clubUsecase) ChangeMemberRole(ctx context.Context, kratosID string, clubID string, userID string, role string) (*MemberOutputDto, error) {
+	r, err := clubDomain.ParseRole(role)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := u.getMember(ctx, kratosID, clubID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := u.clubRepo.GetMembership(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := target.ChangeRole(actor, r); err != nil {
+		return nil, err
+	}
+	if err := u.clubRepo.SaveMembership(ctx, target); err != nil {
+		return nil, err
+	}
+
+	output := toMemberOutputDto(target)
+	return &output, nil
+}
+
+func (u *clubUsecase) RemoveMember(ctx context.Context, kratosID string, clubID string, userID string) error {
+	actor, err := u.getMember(ctx, kratosID, clubID)
+	if err != nil {
+		return err
+	}
+
+	target, err := u.clubRepo.GetMembership(ctx, clubID, userID)
+	if err != nil {
+		return err
+	}
+	if !actor.CanRemove(target) {
+		return domainerror.New("user cannot remove the member", domainerror.ErrUnauthorized)
+	}
+	return u.clubRepo.DeleteMembership(ctx, clubID, userID)
+}
+
+// RequestToJoin はクラブへの参加を申請する。オーナーか管理者が承認するとメンバーになる
+func (u *clubUsecase) RequestToJoin(ctx context.Context, kratosID string, clubID string, message string) error {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.clubRepo.GetClubByID(ctx, clubID); err != nil {
+		return err
+	}
+	m, err := u.findMembership(ctx, clubID, userEntity.ID().String())
+	if err != nil {
+		return err
+	}
+	if m != nil {
+		return domainerror.New("user is already a member of the club", domainerror.ErrConflict)
+	}
+
+	req, err := clubDomain.NewJoinRequest(clubID, userEntity.ID().String(), message)
+	if err != nil {
+		return err
+	}
+	return u.clubRepo.SaveJoinRequest(ctx, req)
+}
+
+func (u *clubUsecase) ListJoinRequests(ctx context.Context, kratosID string, clubID string) ([]JoinRequestOutputDto, error) {
+	if _, err := u.getManager(ctx, kratosID, clubID); err != nil {
+		return nil, err
+	}
+
+	requests, err := u.clubRepo.GetJoinRequests(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]JoinRequestOutputDto, len(requests))
+	for i, r := range requests {
+		outputs[i] = JoinRequestOutputDto{
+			UserID:    r.UserID(),
+			UserName:  r.UserName(),
+			Message:   r.Message(),
+			CreatedAt: r.CreatedAt(),
+		}
+	}
+	return outputs, nil
+}
+
+// ApproveJoinRequest は参加申請を承認し、申請したユーザーを一般のメンバーにする
+func (u *clubUsecase) ApproveJoinRequest(ctx context.Context, kratosID string, clubID string, userID string) (*MemberOutputDto, error) {
+	if _, err := u.getManager(ctx, kratosID, clubID); err != nil {
+		return nil, err
+	}
+
+	req, err := u.clubRepo.GetJoinRequest(ctx, clubID, userID)
+	if err != nil {
+		return nil, err
+	}
+	m, err := req.Approve()
+	if err != nil {
+		return nil, err
+	}
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		clubRepo := repository.NewClubRepository(q)
+		if err := clubRepo.SaveMembership(ctx, m); err != nil {
+			return err
+		}
+		return clubRepo.DeleteJoinRequest(ctx, clubID, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := toMemberOutputDto(clubDomain.ReconstructMembership(clubID, userID, req.UserName(), m.Role(), m.JoinedAt()))
+	return &output, nil
+}
+
+func (u *clubUsecase) RejectJoinRequest(ctx context.Context, kratosID string, clubID string, userID string) error {
+	if _, err := u.getManager(ctx, kratosID, clubID); err != nil {
+		return err
+	}
+	return u.clubRepo.DeleteJoinRequest(ctx, clubID, userID)
+}
+
+// ListLibraryRoutes は共有ルートライブラリのルートを追加した新しい順に返す
+// 追加した後に非公開になったルートなど、閲覧ユーザーが見られないものは結果から除く
+func (u *clubUsecase) ListLibraryRoutes(ctx context.Context, kratosID string, clubID string) ([]LibraryRouteOutputDto, error) {
+	member, err := u.getMember(ctx, kratosID, clubID)
+	if err != nil {
+		return nil, err
+	}
+	viewer, err := routeDomain.ResolveViewer(ctx, u.clubRepo, member.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	routeIDs, err := u.clubRepo.GetLibraryRouteIDs(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	results, err := u.routeRepo.GetRoutesByIDs(ctx, routeIDs)
+	if err != nil {
+		return nil, err
+	}
+	routes := make(map[string]*routeDomain.ExploreRouteResult, len(results))
+	for _, r := range results {
+		routes[r.Route.ID()] = r
+	}
+
+	outputs := make([]LibraryRouteOutputDto, 0, len(routeIDs))
+	for _, id := range routeIDs {
+		r, ok := routes[id]
+		if !ok || !r.Route.IsVisibleTo(viewer) {
+			continue
+		}
+		outputs = append(outputs, LibraryRouteOutputDto{
+			ID:            r.Route.ID(),
+			UserID:        r.Route.UserID(),
+			UserName:      r.UserName,
+			Name:          r.Route.Name(),
+			Distance:      r.Route.Distance(),
+			Duration:      r.Route.Duration(),
+			ElevationGain: r.Route.ElevationGain(),
+			Visibility:    r.Route.Visibility(),
+			Polyline:      r.Route.Polyline(),
+			Difficulty:    r.Route.Difficulty().String(),
+		})
+	}
+	return outputs, nil
+}
+
+// AddLibraryRoute はオーナーか管理者がルートを共有ルートライブラリに追加する
+func (u *clubUsecase) AddLibraryRoute(ctx context.Context, kratosID string, clubID string, routeID string) error {
+	actor, err := u.getManager(ctx, kratosID, clubID)
+	if err != nil {
+		return err
+	}
+	admin, err := routeDomain.ResolveViewer(ctx, u.clubRepo, actor.UserID())
+	if err != nil {
+		return err
+	}
+
+	c, err := u.clubRepo.GetClubByID(ctx, clubID)
+	if err != nil {
+		return err
+	}
+	r, err := u.routeRepo.GetRouteByID(ctx, routeID)
+	if err != nil {
+		return err
+	}
+	if err := c.CheckLibraryRoute(admin, r); err != nil {
+		return err
+	}
+	return u.clubRepo.AddLibraryRoute(ctx, clubID, r.ID(), actor.UserID())
+}
+
+func (u *clubUsecase) RemoveLibraryRoute(ctx context.Context, kratosID string, clubID string, routeID string) error {
+	if _, err := u.getManager(ctx, kratosID, clubID); err != nil {
+		return err
+	}
+	return u.clubRepo.RemoveLibraryRoute(ctx, clubID, routeID)
+}
+
+// findMembership はユーザーの所属を取得する。メンバーでない場合はnilを返す
+func (u *clubUsecase) findMembership(ctx context.Context, clubID string, userID string) (*clubDomain.Membership, error) {
+	m, err := u.clubRepo.GetMembership(ctx, clubID, userID)
+	if errors.Is(err, domainerror.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// getMember はログインユーザーのクラブへの所属を取得する。メンバーでない場合はErrUnauthorizedを返す
+func (u *clubUsecase) getMember(ctx context.Context, kratosID string, clubID string) (*clubDomain.Membership, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.clubRepo.GetClubByID(ctx, clubID); err != nil {
+		return nil, err
+	}
+	m, err := u.findMembership(ctx, clubID, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, domainerror.New("user is not a member of the club", domainerror.ErrUnauthorized)
+	}
+	return m, nil
+}
+
+// getManager はクラブを管理できる（オーナーか管理者である）ログインユーザーの所属を取得する
+func (u *clubUsecase) getManager(ctx context.Context, kratosID string, clubID string) (*clubDomain.Membership, error) {
+	m, err := u.getMember(ctx, kratosID, clubID)
+	if err != nil {
+		return nil, err
+	}
+	if !m.CanManage() {
+		return nil, domainerror.New("user cannot manage the club", domainerror.ErrUnauthorized)
+	}
+	return m, nil
+}
+
+// toClubOutputDto はクラブを出力用に変換する。mはログインユーザーの所属で、メンバーでない場合はnil
+func toClubOutputDto(c *clubDomain.Club, m *clubDomain.Membership) ClubOutputDto {
+	output := ClubOutputDto{
+		ID:          c.ID().String(),
+		Name:        c.Name(),
+		Description: c.Description(),
+		MemberCount: c.MemberCount(),
+		CreatedAt:   c.CreatedAt(),
+		UpdatedAt:   c.UpdatedAt(),
+	}
+	if m != nil {
+		output.MyRole = string(m.Role())
+	}
+	return output
+}
+
+func toMemberOutputDto(m *clubDomain.Membership) MemberOutputDto {
+	return MemberOutputDto{
+		UserID:   m.UserID(),
+		UserName: m.UserName(),
+		Role:     string(m.Role()),
+		JoinedAt: m.JoinedAt(),
+	}
+}
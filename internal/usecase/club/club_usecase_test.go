@@ -0,0 +1,325 @@
+package club
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	clubDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/club"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserID      = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+	testKratosID    = "2eb50f70-3a23-4067-99f6-9fd645686880"
+	testOtherUserID = "019b5a8d-0000-7000-8000-0000000000ff"
+	testClubID      = "019b5a66-0000-7000-8000-000000000001"
+	testOtherClubID = "019b5a66-0000-7000-8000-000000000002"
+	testRouteID1    = "019b5a50-0000-7000-8000-000000000001"
+	testRouteID2    = "019b5a50-0000-7000-8000-000000000002"
+	testRouteID3    = "019b5a50-0000-7000-8000-000000000003"
+)
+
+func createTestUser() *userDomain.User {
+	user, _ := userDomain.ReconstructUser(
+		userDomain.UserID(testUserID),
+		testKratosID,
+		"Test User",
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false,
+	)
+	return user
+}
+
+func createTestClub() *clubDomain.Club {
+	return clubDomain.ReconstructClub(testClubID, "多摩川サイクリング部", "", 3, "", "")
+}
+
+func createTestMembership(userID string, role clubDomain.Role) *clubDomain.Membership {
+	return clubDomain.ReconstructMembership(testClubID, userID, "", role, "")
+}
+
+func createTestRoute(id string, userID string, visibility int16, clubID *string) *routeDomain.Route {
+	route, _ := routeDomain.ReconstructRoute(
+		id, userID, "ルート", "", nil, 30000, 6000, 300, 300,
+		routeDomain.Geometry{}, routeDomain.Geometry{}, routeDomain.Geometry{}, routeDomain.Geometry{},
+		"", visibility, 1, nil, "", "",
+	)
+	route.SetClubID(clubID)
+	return route
+}
+
+type clubTestMocks struct {
+	userRepo  *userDomain.MockIUserRepository
+	clubRepo  *clubDomain.MockIClubRepository
+	routeRepo *routeDomain.MockIRouteRepository
+	txManager *transactionApp.MockTransactionManager
+	usecase   IClubUsecase
+}
+
+func setupClubMocks(t *testing.T) *clubTestMocks {
+	ctrl := gomock.NewController(t)
+	m := &clubTestMocks{
+		userRepo:  userDomain.NewMockIUserRepository(ctrl),
+		clubRepo:  clubDomain.NewMockIClubRepository(ctrl),
+		routeRepo: routeDomain.NewMockIRouteRepository(ctrl),
+		txManager: transactionApp.NewMockTransactionManager(ctrl),
+	}
+	m.usecase = NewClubUsecase(m.userRepo, m.txManager, m.clubRepo, m.routeRepo)
+	return m
+}
+
+// expectMember はログインユーザーがroleの役割でクラブに所属しているものとする。roleが空の場合はメンバーでない
+func (m *clubTestMocks) expectMember(role clubDomain.Role) {
+	m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+	m.clubRepo.EXPECT().GetClubByID(gomock.Any(), testClubID).Return(createTestClub(), nil).AnyTimes()
+	if role == "" {
+		m.clubRepo.EXPECT().GetMembership(gomock.Any(), testClubID, testUserID).Return(nil, domainerror.New("membership not found", domainerror.ErrNotFound))
+		return
+	}
+	m.clubRepo.EXPECT().GetMembership(gomock.Any(), testClubID, testUserID).Return(createTestMembership(testUserID, role), nil)
+}
+
+func Test_clubUsecase_CreateClub(t *testing.T) {
+	t.Parallel()
+	m := setupClubMocks(t)
+	m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+	m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+	got, err := m.usecase.CreateClub(context.Background(), testKratosID, ClubInputDto{Name: "多摩川サイクリング部"})
+	if err != nil {
+		t.Fatalf("CreateClub() error = %v", err)
+	}
+	if got.MyRole != string(clubDomain.RoleOwner) || got.MemberCount != 1 {
+		t.Errorf("MyRole, MemberCount = %q, %d, want owner, 1", got.MyRole, got.MemberCount)
+	}
+}
+
+func Test_clubUsecase_RequestToJoin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		role    clubDomain.Role
+		wantErr error
+	}{
+		{
+			name: "正常系: メンバーでないユーザーが参加を申請する",
+		},
+		{
+			name:    "異常系: すでにメンバー",
+			role:    clubDomain.RoleMember,
+			wantErr: domainerror.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupClubMocks(t)
+			m.expectMember(tt.role)
+			if tt.wantErr == nil {
+				m.clubRepo.EXPECT().SaveJoinRequest(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *clubDomain.JoinRequest) error {
+					if req.UserID() != testUserID || req.Message() != "よろしくお願いします" {
+						t.Errorf("join request = %+v", req)
+					}
+					return nil
+				})
+			}
+
+			err := m.usecase.RequestToJoin(context.Background(), testKratosID, testClubID, "よろしくお願いします")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RequestToJoin() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_clubUsecase_ApproveJoinRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		role    clubDomain.Role
+		wantErr error
+	}{
+		{
+			name: "正常系: 管理者が承認すると一般のメンバーになる",
+			role: clubDomain.RoleAdmin,
+		},
+		{
+			name:    "異常系: 一般のメンバーは承認できない",
+			role:    clubDomain.RoleMember,
+			wantErr: domainerror.ErrUnauthorized,
+		},
+		{
+			name:    "異常系: メンバーでない",
+			wantErr: domainerror.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupClubMocks(t)
+			m.expectMember(tt.role)
+			if tt.wantErr == nil {
+				m.clubRepo.EXPECT().GetJoinRequest(gomock.Any(), testClubID, testOtherUserID).
+					Return(clubDomain.ReconstructJoinRequest(testClubID, testOtherUserID, "other", "", ""), nil)
+				m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			got, err := m.usecase.ApproveJoinRequest(context.Background(), testKratosID, testClubID, testOtherUserID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ApproveJoinRequest() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApproveJoinRequest() error = %v", err)
+			}
+			if got.UserID != testOtherUserID || got.UserName != "other" || got.Role != string(clubDomain.RoleMember) {
+				t.Errorf("member = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_clubUsecase_RemoveMember(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		role       clubDomain.Role
+		targetID   string
+		targetRole clubDomain.Role
+		wantErr    error
+	}{
+		{
+			name:       "正常系: 管理者が一般のメンバーを外す",
+			role:       clubDomain.RoleAdmin,
+			targetID:   testOtherUserID,
+			targetRole: clubDomain.RoleMember,
+		},
+		{
+			name:       "正常系: 一般のメンバーが自分で抜ける",
+			role:       clubDomain.RoleMember,
+			targetID:   testUserID,
+			targetRole: clubDomain.RoleMember,
+		},
+		{
+			name:       "異常系: 管理者は他の管理者を外せない",
+			role:       clubDomain.RoleAdmin,
+			targetID:   testOtherUserID,
+			targetRole: clubDomain.RoleAdmin,
+			wantErr:    domainerror.ErrUnauthorized,
+		},
+		{
+			name:       "異常系: オーナーは抜けられない",
+			role:       clubDomain.RoleOwner,
+			targetID:   testUserID,
+			targetRole: clubDomain.RoleOwner,
+			wantErr:    domainerror.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupClubMocks(t)
+			m.expectMember(tt.role)
+			m.clubRepo.EXPECT().GetMembership(gomock.Any(), testClubID, tt.targetID).Return(createTestMembership(tt.targetID, tt.targetRole), nil).AnyTimes()
+			if tt.wantErr == nil {
+				m.clubRepo.EXPECT().DeleteMembership(gomock.Any(), testClubID, tt.targetID).Return(nil)
+			}
+
+			err := m.usecase.RemoveMember(context.Background(), testKratosID, testClubID, tt.targetID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RemoveMember() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_clubUsecase_AddLibraryRoute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		route   *routeDomain.Route
+		wantErr error
+	}{
+		{
+			name:  "正常系: 他のユーザーの公開ルート",
+			route: createTestRoute(testRouteID1, testOtherUserID, routeDomain.VisibilityPublic, nil),
+		},
+		{
+			name:  "正常系: このクラブのメンバーのみのルート",
+			route: createTestRoute(testRouteID1, testOtherUserID, routeDomain.VisibilityClub, new(testClubID)),
+		},
+		{
+			name:    "異常系: 自分の非公開ルートは他のメンバーが閲覧できない",
+			route:   createTestRoute(testRouteID1, testUserID, routeDomain.VisibilityPrivate, nil),
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name:    "異常系: 別のクラブのメンバーのみのルート",
+			route:   createTestRoute(testRouteID1, testUserID, routeDomain.VisibilityClub, new(testOtherClubID)),
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name:    "異常系: 管理者が閲覧できないルート",
+			route:   createTestRoute(testRouteID1, testOtherUserID, routeDomain.VisibilityPrivate, nil),
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupClubMocks(t)
+			m.expectMember(clubDomain.RoleAdmin)
+			m.clubRepo.EXPECT().GetClubIDsByUserID(gomock.Any(), testUserID).Return([]string{testClubID, testOtherClubID}, nil)
+			m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID1).Return(tt.route, nil)
+			if tt.wantErr == nil {
+				m.clubRepo.EXPECT().AddLibraryRoute(gomock.Any(), testClubID, testRouteID1, testUserID).Return(nil)
+			}
+
+			err := m.usecase.AddLibraryRoute(context.Background(), testKratosID, testClubID, testRouteID1)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AddLibraryRoute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_clubUsecase_ListLibraryRoutes(t *testing.T) {
+	t.Parallel()
+	m := setupClubMocks(t)
+	m.expectMember(clubDomain.RoleMember)
+	m.clubRepo.EXPECT().GetClubIDsByUserID(gomock.Any(), testUserID).Return([]string{testClubID}, nil)
+	m.clubRepo.EXPECT().GetLibraryRouteIDs(gomock.Any(), testClubID).Return([]string{testRouteID3, testRouteID2, testRouteID1}, nil)
+	m.routeRepo.EXPECT().GetRoutesByIDs(gomock.Any(), []string{testRouteID3, testRouteID2, testRouteID1}).Return([]*routeDomain.ExploreRouteResult{
+		{Route: createTestRoute(testRouteID1, testOtherUserID, routeDomain.VisibilityPublic, nil), UserName: "other"},
+		{Route: createTestRoute(testRouteID2, testOtherUserID, routeDomain.VisibilityClub, new(testClubID)), UserName: "other"},
+		// 追加した後に非公開になったルート
+		{Route: createTestRoute(testRouteID3, testOtherUserID, routeDomain.VisibilityPrivate, nil), UserName: "other"},
+	}, nil)
+
+	got, err := m.usecase.ListLibraryRoutes(context.Background(), testKratosID, testClubID)
+	if err != nil {
+		t.Fatalf("ListLibraryRoutes() error = %v", err)
+	}
+	var ids []string
+	for _, r := range got {
+		ids = append(ids, r.ID)
+	}
+	if want := []string{testRouteID2, testRouteID1}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("route ids = %v, want %v", ids, want)
+	}
+}
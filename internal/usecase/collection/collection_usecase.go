@@ -0,0 +1,290 @@
+package collection
+
+import (
+	"context"
+
+	collectionDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/collection"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+)
+
+// ICollectionUsecase はルートを整理するコレクションを管理する
+// 変更できるのは所有者だけで、公開のコレクションは誰でも閲覧できる
+type ICollectionUsecase interface {
+	ListMyCollections(ctx context.Context, kratosID string) ([]CollectionOutputDto, error)
+	// kratosIDが空の場合は未ログインのユーザーとして扱う
+	GetCollection(ctx context.Context, collectionID string, kratosID string) (*CollectionDetailOutputDto, error)
+	CreateCollection(ctx context.Context, kratosID string, dto CollectionInputDto) (*CollectionOutputDto, error)
+	UpdateCollection(ctx context.Context, kratosID string, collectionID string, dto CollectionInputDto) (*CollectionOutputDto, error)
+	DeleteCollection(ctx context.Context, kratosID string, collectionID string) error
+	AddRoute(ctx context.Context, kratosID string, collectionID string, routeID string) (*CollectionOutputDto, error)
+	RemoveRoute(ctx context.Context, kratosID string, collectionID string, routeID string) (*CollectionOutputDto, error)
+	ReorderRoutes(ctx context.Context, kratosID string, collectionID string, routeIDs []string) (*CollectionOutputDto, error)
+}
+
+type collectionUsecase struct {
+	userRepo       userDomain.IUserRepository
+	txManager      transaction.TransactionManager
+	collectionRepo collectionDomain.ICollectionRepository
+	routeRepo      routeDomain.IRouteRepository
+	clubs          routeDomain.ClubMembershipReader
+}
+
+func NewCollectionUsecase(userRepo userDomain.IUserRepository, txManager transaction.TransactionManager, collectionRepo collectionDomain.ICollectionRepository, routeRepo routeDomain.IRouteRepository, clubs routeDomain.ClubMembershipReader) ICollectionUsecase {
+	return &collectionUsecase{
+		userRepo:       userRepo,
+		txManager:      txManager,
+		collectionRepo: collectionRepo,
+		routeRepo:      routeRepo,
+		clubs:          clubs,
+	}
+}
+
+type CollectionInputDto struct {
+	Name        string
+	Description string
+	Visibility  int16
+}
+
+type CollectionOutputDto struct {
+	ID          string
+	UserID      string
+	Name        string
+	Description string
+	Visibility  int16
+	RouteIDs    []string // 並び順
+	CreatedAt   string
+	UpdatedAt   string
+}
+
+type CollectionDetailOutputDto struct {
+	CollectionOutputDto
+	Routes []CollectionRouteOutputDto // 閲覧ユーザーが見られるルートのみ
+}
+
+type CollectionRouteOutputDto struct {
+	ID            string
+	UserID        string
+	UserName      string
+	Name          string
+	Distance      float64
+	Duration      float64
+	ElevationGain float64
+	ElevationLoss float64
+	Visibility    int16
+	Polyline      string
+	Difficulty    string // easy, moderate, hard, expert。まだ求めていない場合は空文字
+	Tags          []string
+}
+
+func (u *collectionUsecase) ListMyCollections(ctx context.Context, kratosID string) ([]CollectionOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := u.collectionRepo.GetCollectionsByUserID(ctx, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]CollectionOutputDto, len(collections))
+	for i, c := range collections {
+		outputs[i] = toCollectionOutputDto(c)
+	}
+	return outputs, nil
+}
+
+// GetCollection はコレクションと、その中で閲覧ユーザーが見られるルートを並び順に返す
+// 他のユーザーのルートが非公開になった場合などは、コレクションに入ったまま結果からは除く
+func (u *collectionUsecase) GetCollection(ctx context.Context, collectionID string, kratosID string) (*CollectionDetailOutputDto, error) {
+	var viewerID string
+	if kratosID != "" {
+		viewer, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+		if err != nil {
+			return nil, err
+		}
+		viewerID = viewer.ID().String()
+	}
+
+	viewer, err := routeDomain.ResolveViewer(ctx, u.clubs, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := u.collectionRepo.GetCollectionByID(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	// 閲覧できないコレクションは存在しないものとして扱う
+	if !c.IsVisibleTo(viewer) {
+		return nil, domainerror.New("collection not found", domainerror.ErrNotFound)
+	}
+	routes, err := u.routeRepo.GetRoutesInCollection(ctx, c.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]CollectionRouteOutputDto, 0, len(routes))
+	for _, r := range routes {
+		if !r.Route.IsVisibleTo(viewer) {
+			continue
+		}
+		outputs = append(outputs, CollectionRouteOutputDto{
+			ID:            r.Route.ID(),
+			UserID:        r.Route.UserID(),
+			UserName:      r.UserName,
+			Name:          r.Route.Name(),
+			Distance:      r.Route.Distance(),
+			Duration:      r.Route.Duration(),
+			ElevationGain: r.Route.ElevationGain(),
+			ElevationLoss: r.Route.ElevationLoss(),
+			Visibility:    r.Route.Visibility(),
+			Polyline:      r.Route.Polyline(),
+			Difficulty:    r.Route.Difficulty().String(),
+			Tags:          r.Route.Tags(),
+		})
+	}
+
+	return &CollectionDetailOutputDto{
+		CollectionOutputDto: toCollectionOutputDto(c),
+		Routes:              outputs,
+	}, nil
+}
+
+func (u *collectionUsecase) CreateCollection(ctx context.Context, kratosID string, dto CollectionInputDto) (*CollectionOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := collectionDomain.NewCollection(userEntity.ID().String(), dto.Name, dto.Description, dto.Visibility)
+	if err != nil {
+		return nil, err
+	}
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewCollectionRepository(q).SaveCollection(ctx, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := toCollectionOutputDto(c)
+	return &output, nil
+}
+
+func (u *collectionUsecase) UpdateCollection(ctx context.Context, kratosID string, collectionID string, dto CollectionInputDto) (*CollectionOutputDto, error) {
+	c, err := u.getOwnedCollection(ctx, kratosID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Update(dto.Name, dto.Description, dto.Visibility); err != nil {
+		return nil, err
+	}
+	if err := u.collectionRepo.UpdateCollection(ctx, c); err != nil {
+		return nil, err
+	}
+
+	output := toCollectionOutputDto(c)
+	return &output, nil
+}
+
+// DeleteCollection はコレクションを削除する。中のルートは削除しない
+func (u *collectionUsecase) DeleteCollection(ctx context.Context, kratosID string, collectionID string) error {
+	c, err := u.getOwnedCollection(ctx, kratosID, collectionID)
+	if err != nil {
+		return err
+	}
+	return u.collectionRepo.DeleteCollection(ctx, c.ID().String())
+}
+
+// AddRoute はルートをコレクションの末尾に追加する
+// 自分のルートのほか、他のユーザーの公開ルートや所属するクラブのルートも追加できる
+func (u *collectionUsecase) AddRoute(ctx context.Context, kratosID string, collectionID string, routeID string) (*CollectionOutputDto, error) {
+	c, err := u.getOwnedCollection(ctx, kratosID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := routeDomain.ResolveViewer(ctx, u.clubs, c.UserID())
+	if err != nil {
+		return nil, err
+	}
+	route, err := u.routeRepo.GetRouteByID(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	if !route.IsVisibleTo(owner) {
+		return nil, domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	if err := c.AddRoute(route.ID()); err != nil {
+		return nil, err
+	}
+	return u.saveRoutes(ctx, c)
+}
+
+func (u *collectionUsecase) RemoveRoute(ctx context.Context, kratosID string, collectionID string, routeID string) (*CollectionOutputDto, error) {
+	c, err := u.getOwnedCollection(ctx, kratosID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.RemoveRoute(routeID); err != nil {
+		return nil, err
+	}
+	return u.saveRoutes(ctx, c)
+}
+
+func (u *collectionUsecase) ReorderRoutes(ctx context.Context, kratosID string, collectionID string, routeIDs []string) (*CollectionOutputDto, error) {
+	c, err := u.getOwnedCollection(ctx, kratosID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.ReorderRoutes(routeIDs); err != nil {
+		return nil, err
+	}
+	return u.saveRoutes(ctx, c)
+}
+
+// getOwnedCollection はユーザーが所有するコレクションを取得する
+func (u *collectionUsecase) getOwnedCollection(ctx context.Context, kratosID string, collectionID string) (*collectionDomain.Collection, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := u.collectionRepo.GetCollectionByID(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserID() != userEntity.ID().String() {
+		return nil, domainerror.New("user does not own the collection", domainerror.ErrUnauthorized)
+	}
+	return c, nil
+}
+
+func (u *collectionUsecase) saveRoutes(ctx context.Context, c *collectionDomain.Collection) (*CollectionOutputDto, error) {
+	err := u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewCollectionRepository(q).SaveCollectionRoutes(ctx, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := toCollectionOutputDto(c)
+	return &output, nil
+}
+
+func toCollectionOutputDto(c *collectionDomain.Collection) CollectionOutputDto {
+	return CollectionOutputDto{
+		ID:          c.ID().String(),
+		UserID:      c.UserID(),
+		Name:        c.Name(),
+		Description: c.Description(),
+		Visibility:  c.Visibility(),
+		RouteIDs:    c.RouteIDs(),
+		CreatedAt:   c.CreatedAt(),
+		UpdatedAt:   c.UpdatedAt(),
+	}
+}
@@ -0,0 +1,275 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	collectionDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/collection"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserID       = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+	testKratosID     = "2eb50f70-3a23-4067-99f6-9fd645686880"
+	testOtherUserID  = "019b5a8d-0000-7000-8000-0000000000ff"
+	testCollectionID = "019b5a63-0000-7000-8000-000000000001"
+	testRouteID1     = "019b5a50-0000-7000-8000-000000000001"
+	testRouteID2     = "019b5a50-0000-7000-8000-000000000002"
+	testRouteID3     = "019b5a50-0000-7000-8000-000000000003"
+)
+
+func createTestUser() *userDomain.User {
+	user, _ := userDomain.ReconstructUser(
+		userDomain.UserID(testUserID),
+		testKratosID,
+		"Test User",
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false,
+	)
+	return user
+}
+
+func createTestRoute(id string, userID string, visibility int16) *routeDomain.Route {
+	route, _ := routeDomain.ReconstructRoute(
+		id, userID, "ルート", "", nil, 10000, 1800, 100, 100,
+		routeDomain.Geometry{}, routeDomain.Geometry{}, routeDomain.Geometry{}, routeDomain.Geometry{},
+		"", visibility, 1, nil, "", "",
+	)
+	return route
+}
+
+func createTestCollection(userID string, visibility int16, routeIDs ...string) *collectionDomain.Collection {
+	return collectionDomain.ReconstructCollection(testCollectionID, userID, "峠", "", visibility, routeIDs, "", "")
+}
+
+type collectionTestMocks struct {
+	userRepo       *userDomain.MockIUserRepository
+	collectionRepo *collectionDomain.MockICollectionRepository
+	routeRepo      *routeDomain.MockIRouteRepository
+	clubs          *routeDomain.MockClubMembershipReader
+	txManager      *transactionApp.MockTransactionManager
+	usecase        ICollectionUsecase
+}
+
+func setupCollectionMocks(t *testing.T) *collectionTestMocks {
+	ctrl := gomock.NewController(t)
+	m := &collectionTestMocks{
+		userRepo:       userDomain.NewMockIUserRepository(ctrl),
+		collectionRepo: collectionDomain.NewMockICollectionRepository(ctrl),
+		routeRepo:      routeDomain.NewMockIRouteRepository(ctrl),
+		clubs:          routeDomain.NewMockClubMembershipReader(ctrl),
+		txManager:      transactionApp.NewMockTransactionManager(ctrl),
+	}
+	m.clubs.EXPECT().GetClubIDsByUserID(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	m.usecase = NewCollectionUsecase(m.userRepo, m.txManager, m.collectionRepo, m.routeRepo, m.clubs)
+	return m
+}
+
+func Test_collectionUsecase_GetCollection(t *testing.T) {
+	t.Parallel()
+
+	routes := []*routeDomain.ExploreRouteResult{
+		{Route: createTestRoute(testRouteID1, testOtherUserID, routeDomain.VisibilityPublic), UserName: "other"},
+		{Route: createTestRoute(testRouteID2, testUserID, routeDomain.VisibilityPrivate), UserName: "Test User"},
+		{Route: createTestRoute(testRouteID3, testOtherUserID, routeDomain.VisibilityPrivate), UserName: "other"},
+	}
+
+	tests := []struct {
+		name         string
+		kratosID     string
+		collection   *collectionDomain.Collection
+		wantRouteIDs []string
+		wantErr      error
+	}{
+		{
+			name:         "正常系: 所有者には自分の非公開ルートも返す",
+			kratosID:     testKratosID,
+			collection:   createTestCollection(testUserID, routeDomain.VisibilityPrivate),
+			wantRouteIDs: []string{testRouteID1, testRouteID2},
+		},
+		{
+			name:         "正常系: 未ログインでも公開のコレクションは閲覧でき、公開ルートのみ返す",
+			collection:   createTestCollection(testUserID, routeDomain.VisibilityPublic),
+			wantRouteIDs: []string{testRouteID1},
+		},
+		{
+			name:       "異常系: 他のユーザーの非公開コレクション",
+			collection: createTestCollection(testUserID, routeDomain.VisibilityPrivate),
+			wantErr:    domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupCollectionMocks(t)
+			if tt.kratosID != "" {
+				m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), tt.kratosID).Return(createTestUser(), nil)
+			}
+			m.collectionRepo.EXPECT().GetCollectionByID(gomock.Any(), testCollectionID).Return(tt.collection, nil)
+			if tt.wantErr == nil {
+				m.routeRepo.EXPECT().GetRoutesInCollection(gomock.Any(), testCollectionID).Return(routes, nil)
+			}
+
+			got, err := m.usecase.GetCollection(context.Background(), testCollectionID, tt.kratosID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("GetCollection() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetCollection() error = %v", err)
+			}
+			var ids []string
+			for _, r := range got.Routes {
+				ids = append(ids, r.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantRouteIDs) {
+				t.Errorf("route ids = %v, want %v", ids, tt.wantRouteIDs)
+			}
+		})
+	}
+}
+
+func Test_collectionUsecase_AddRoute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		collection *collectionDomain.Collection
+		route      *routeDomain.Route
+		want       []string
+		wantErr    error
+	}{
+		{
+			name:       "正常系: 他のユーザーの公開ルートを末尾に追加する",
+			collection: createTestCollection(testUserID, routeDomain.VisibilityPublic, testRouteID2),
+			route:      createTestRoute(testRouteID1, testOtherUserID, routeDomain.VisibilityPublic),
+			want:       []string{testRouteID2, testRouteID1},
+		},
+		{
+			name:       "異常系: 他のユーザーの非公開ルート",
+			collection: createTestCollection(testUserID, routeDomain.VisibilityPublic),
+			route:      createTestRoute(testRouteID1, testOtherUserID, routeDomain.VisibilityPrivate),
+			wantErr:    domainerror.ErrNotFound,
+		},
+		{
+			name:       "異常系: 追加済みのルート",
+			collection: createTestCollection(testUserID, routeDomain.VisibilityPublic, testRouteID1),
+			route:      createTestRoute(testRouteID1, testUserID, routeDomain.VisibilityPrivate),
+			wantErr:    domainerror.ErrConflict,
+		},
+		{
+			name:       "異常系: 他のユーザーのコレクション",
+			collection: createTestCollection(testOtherUserID, routeDomain.VisibilityPublic),
+			wantErr:    domainerror.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupCollectionMocks(t)
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			m.collectionRepo.EXPECT().GetCollectionByID(gomock.Any(), testCollectionID).Return(tt.collection, nil)
+			if tt.route != nil {
+				m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID1).Return(tt.route, nil)
+			}
+			if tt.wantErr == nil {
+				m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			got, err := m.usecase.AddRoute(context.Background(), testKratosID, testCollectionID, testRouteID1)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("AddRoute() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddRoute() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.RouteIDs, tt.want) {
+				t.Errorf("RouteIDs = %v, want %v", got.RouteIDs, tt.want)
+			}
+		})
+	}
+}
+
+func Test_collectionUsecase_ReorderRoutes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 指定した順に並べ替える", func(t *testing.T) {
+		t.Parallel()
+		m := setupCollectionMocks(t)
+		m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.collectionRepo.EXPECT().GetCollectionByID(gomock.Any(), testCollectionID).
+			Return(createTestCollection(testUserID, routeDomain.VisibilityPrivate, testRouteID1, testRouteID2), nil)
+		m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+		got, err := m.usecase.ReorderRoutes(context.Background(), testKratosID, testCollectionID, []string{testRouteID2, testRouteID1})
+		if err != nil {
+			t.Fatalf("ReorderRoutes() error = %v", err)
+		}
+		if want := []string{testRouteID2, testRouteID1}; !reflect.DeepEqual(got.RouteIDs, want) {
+			t.Errorf("RouteIDs = %v, want %v", got.RouteIDs, want)
+		}
+	})
+
+	t.Run("異常系: コレクション内のルートが揃っていない", func(t *testing.T) {
+		t.Parallel()
+		m := setupCollectionMocks(t)
+		m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.collectionRepo.EXPECT().GetCollectionByID(gomock.Any(), testCollectionID).
+			Return(createTestCollection(testUserID, routeDomain.VisibilityPrivate, testRouteID1, testRouteID2), nil)
+
+		_, err := m.usecase.ReorderRoutes(context.Background(), testKratosID, testCollectionID, []string{testRouteID2})
+		if !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("ReorderRoutes() error = %v, want ErrValidation", err)
+		}
+	})
+}
+
+func Test_collectionUsecase_CreateCollection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   CollectionInputDto
+		wantErr error
+	}{
+		{name: "正常系: コレクションを作成する", input: CollectionInputDto{Name: " 峠 ", Visibility: routeDomain.VisibilityPublic}},
+		{name: "異常系: 名前が空", input: CollectionInputDto{Name: ""}, wantErr: domainerror.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupCollectionMocks(t)
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			if tt.wantErr == nil {
+				m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			got, err := m.usecase.CreateCollection(context.Background(), testKratosID, tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("CreateCollection() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateCollection() error = %v", err)
+			}
+			if got.ID == "" || got.UserID != testUserID || got.Name != "峠" || len(got.RouteIDs) != 0 {
+				t.Errorf("CreateCollection() = %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,573 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	eventDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/event"
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+)
+
+// IEventUsecase はルートを走るグループライドのイベントを管理する
+// 公開範囲はルートと同じ判定を使い、変更・中止できるのは主催者だけ
+type IEventUsecase interface {
+	// ListUpcomingEvents は開催予定の公開イベントを開始日時の早い順に返す
+	ListUpcomingEvents(ctx context.Context, dto UpcomingEventsInputDto) ([]EventOutputDto, error)
+	// kratosIDが空の場合は未ログインのユーザーとして扱う
+	GetEvent(ctx context.Context, eventID string, kratosID string) (*EventOutputDto, error)
+	CreateEvent(ctx context.Context, kratosID string, dto EventInputDto) (*EventOutputDto, error)
+	UpdateEvent(ctx context.Context, kratosID string, eventID string, dto EventInputDto) (*EventOutputDto, error)
+	CancelEvent(ctx context.Context, kratosID string, eventID string, reason string) (*EventOutputDto, error)
+	RespondToEvent(ctx context.Context, kratosID string, eventID string, status string) (*EventOutputDto, error)
+	ListRSVPs(ctx context.Context, eventID string, kratosID string) ([]RSVPOutputDto, error)
+	// ListComments はコメントを古い順に返す
+	ListComments(ctx context.Context, eventID string, kratosID string, dto ListCommentsInputDto) (*CommentListOutputDto, error)
+	AddComment(ctx context.Context, kratosID string, eventID string, body string) (*CommentOutputDto, error)
+	DeleteComment(ctx context.Context, kratosID string, eventID string, commentID string) error
+	// GetEventRouteID はGPXの書き出しに使うルートのIDを返す
+	// イベントとルートの両方を閲覧できない場合は見つからないものとして扱う
+	GetEventRouteID(ctx context.Context, eventID string, kratosID string) (string, error)
+}
+
+type eventUsecase struct {
+	userRepo  userDomain.IUserRepository
+	txManager transaction.TransactionManager
+	eventRepo eventDomain.IEventRepository
+	routeRepo routeDomain.IRouteRepository
+	clubs     routeDomain.ClubMembershipReader
+	publisher notificationDomain.Publisher
+}
+
+func NewEventUsecase(userRepo userDomain.IUserRepository, txManager transaction.TransactionManager, eventRepo eventDomain.IEventRepository, routeRepo routeDomain.IRouteRepository, clubs routeDomain.ClubMembershipReader, publisher notificationDomain.Publisher) IEventUsecase {
+	return &eventUsecase{
+		userRepo:  userRepo,
+		txManager: txManager,
+		eventRepo: eventRepo,
+		routeRepo: routeRepo,
+		clubs:     clubs,
+		publisher: publisher,
+	}
+}
+
+type UpcomingEventsInputDto struct {
+	Lat    *float64
+	Lng    *float64
+	Radius *float64 // 集合場所までの距離(m)
+	Limit  int32
+}
+
+type EventInputDto struct {
+	RouteID          string
+	Title            string
+	Description      string
+	StartsAt         time.Time
+	MeetingPoint     orb.Point // 集合場所
+	MeetingPointName string
+	Capacity         *int32
+	Visibility       int16
+	ClubID           *string // 公開範囲がクラブのメンバーのみの場合の共有先
+}
+
+type EventOutputDto struct {
+	ID               string
+	OrganizerID      string
+	Title            string
+	Description      string
+	StartsAt         time.Time
+	MeetingPoint     orb.Point
+	MeetingPointName string
+	Capacity         *int32
+	GoingCount       int32
+	Visibility       int16
+	ClubID           *string
+	CancelledAt      *time.Time
+	CancelReason     string
+	Route            *EventRouteOutputDto // 閲覧ユーザーが見られない場合はnil
+	MyRSVP           string               // 閲覧ユーザーの返事。返事していない場合は空文字
+	CreatedAt        string
+	UpdatedAt        string
+}
+
+type EventRouteOutputDto struct {
+	ID            string
+	Name          string
+	UserName      string
+	Distance      float64
+	ElevationGain float64
+	Polyline      string
+}
+
+type RSVPOutputDto struct {
+	UserID    string
+	UserName  string
+	Status    string
+	UpdatedAt string
+}
+
+// commentCursorSort はコメント一覧のカーソルに入れる並び順。コメントは古い順だけ
+const commentCursorSort = "oldest"
+
+type ListCommentsInputDto struct {
+	Limit  int32  // 0の場合は既定値
+	Cursor string // 前のページのNextCursor。空の場合は先頭から
+}
+
+type CommentListOutputDto struct {
+	Comments   []CommentOutputDto
+	NextCursor string // 次のページがない場合は空文字
+}
+
+type CommentOutputDto struct {
+	ID        string
+	UserID    string
+	UserName  string
+	Body      string
+	CreatedAt string
+}
+
+func (u *eventUsecase) ListUpcomingEvents(ctx context.Context, dto UpcomingEventsInputDto) ([]EventOutputDto, error) {
+	if (dto.Lat == nil) != (dto.Lng == nil) {
+		return nil, domainerror.New("lat and lng must be provided together", domainerror.ErrValidation)
+	}
+	var location *orb.Point
+	if dto.Lat != nil {
+		location = &orb.Point{*dto.Lng, *dto.Lat}
+	}
+	criteria, err := eventDomain.NewUpcomingEventsCriteria(location, dto.Radius, time.Now(), dto.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := u.eventRepo.GetUpcomingEvents(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	routeIDs := make([]string, len(events))
+	for i, e := range events {
+		routeIDs[i] = e.RouteID()
+	}
+	routes, err := u.getRoutes(ctx, routeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]EventOutputDto, len(events))
+	for i, e := range events {
+		outputs[i] = toEventOutputDto(e, routes, routeDomain.Viewer{}, nil)
+	}
+	return outputs, nil
+}
+
+// GetEvent はイベントを、閲覧ユーザーの返事とともに返す
+func (u *eventUsecase) GetEvent(ctx context.Context, eventID string, kratosID string) (*EventOutputDto, error) {
+	e, viewer, err := u.getVisibleEvent(ctx, eventID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	var rsvp *eventDomain.RSVP
+	if viewer.UserID() != "" {
+		if rsvp, err = u.getRSVP(ctx, e.ID().String(), viewer.UserID()); err != nil {
+			return nil, err
+		}
+	}
+	return u.toOutput(ctx, e, viewer, rsvp)
+}
+
+// CreateEvent はイベントを作成する。ルートには自分のルートのほか、他のユーザーの閲覧できるルートも使える
+func (u *eventUsecase) CreateEvent(ctx context.Context, kratosID string, dto EventInputDto) (*EventOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	organizer, err := routeDomain.ResolveViewer(ctx, u.clubs, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := eventDomain.NewEvent(userEntity.ID().String(), toDetails(dto), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkRoute(ctx, organizer, e); err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewEventRepository(q).SaveEvent(ctx, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.toOutput(ctx, e, organizer, nil)
+}
+
+// UpdateEvent はイベントの内容をまとめて置き換える。中止したイベントは変更できない
+func (u *eventUsecase) UpdateEvent(ctx context.Context, kratosID string, eventID string, dto EventInputDto) (*EventOutputDto, error) {
+	e, organizer, err := u.getOrganizedEvent(ctx, kratosID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.Update(toDetails(dto), time.Now()); err != nil {
+		return nil, err
+	}
+	if err := u.checkRoute(ctx, organizer, e); err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewEventRepository(q).UpdateEvent(ctx, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.toOutput(ctx, e, organizer, nil)
+}
+
+// CancelEvent はイベントを中止し、参加する・未定と返事したユーザーに知らせる
+// 通知に失敗しても中止は取り消さない
+func (u *eventUsecase) CancelEvent(ctx context.Context, kratosID string, eventID string, reason string) (*EventOutputDto, error) {
+	e, organizer, err := u.getOrganizedEvent(ctx, kratosID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.Cancel(reason, time.Now()); err != nil {
+		return nil, err
+	}
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewEventRepository(q).UpdateEvent(ctx, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rsvps, err := u.eventRepo.GetRSVPs(ctx, e.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rsvps {
+		if !r.IsAttending() || r.UserID() == e.UserID() {
+			continue
+		}
+		activity := notificationDomain.NewEventCancelledActivity(e.UserID(), r.UserID(), e.ID().String())
+		if err := u.publisher.Publish(ctx, activity); err != nil {
+			log.Printf("failed to publish %s notification to %s: %v\n", activity.Type(), activity.RecipientID(), err)
+		}
+	}
+	return u.toOutput(ctx, e, organizer, nil)
+}
+
+// RespondToEvent は閲覧ユーザーの参加の返事を保存する。同じユーザーが返事し直すと上書きする
+func (u *eventUsecase) RespondToEvent(ctx context.Context, kratosID string, eventID string, status string) (*EventOutputDto, error) {
+	parsed, err := eventDomain.ParseRSVPStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	e, viewer, err := u.getVisibleEvent(ctx, eventID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := u.getRSVP(ctx, e.ID().String(), viewer.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	rsvp, err := e.Respond(viewer.UserID(), parsed, current, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewEventRepository(q).SaveRSVP(ctx, rsvp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.toOutput(ctx, e, viewer, rsvp)
+}
+
+func (u *eventUsecase) ListRSVPs(ctx context.Context, eventID string, kratosID string) ([]RSVPOutputDto, error) {
+	e, _, err := u.getVisibleEvent(ctx, eventID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsvps, err := u.eventRepo.GetRSVPs(ctx, e.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]RSVPOutputDto, len(rsvps))
+	for i, r := range rsvps {
+		outputs[i] = RSVPOutputDto{
+			UserID:    r.UserID(),
+			UserName:  r.UserName(),
+			Status:    string(r.Status()),
+			UpdatedAt: r.UpdatedAt(),
+		}
+	}
+	return outputs, nil
+}
+
+func (u *eventUsecase) ListComments(ctx context.Context, eventID string, kratosID string, dto ListCommentsInputDto) (*CommentListOutputDto, error) {
+	limit, err := pagination.NormalizeLimit(dto.Limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeCommentCursor(dto.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	e, _, err := u.getVisibleEvent(ctx, eventID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := eventDomain.NewCommentCriteria(e.ID().String(), limit, after)
+	if err != nil {
+		return nil, err
+	}
+	page, err := u.eventRepo.GetComments(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	output := &CommentListOutputDto{Comments: make([]CommentOutputDto, len(page.Items))}
+	for i, c := range page.Items {
+		output.Comments[i] = toCommentOutputDto(c)
+	}
+	if page.Next != nil {
+		output.NextCursor = cursor.Encode(commentCursorSort, page.Next.SortKey(), page.Next.ID())
+	}
+	return output, nil
+}
+
+// AddComment はイベントにコメントする。閲覧できるイベントであれば、中止した後でもコメントできる
+func (u *eventUsecase) AddComment(ctx context.Context, kratosID string, eventID string, body string) (*CommentOutputDto, error) {
+	e, viewer, err := u.getVisibleEvent(ctx, eventID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := eventDomain.NewComment(e.ID().String(), viewer.UserID(), body)
+	if err != nil {
+		return nil, err
+	}
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewEventRepository(q).SaveComment(ctx, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	saved, err := u.eventRepo.GetComment(ctx, c.ID())
+	if err != nil {
+		return nil, err
+	}
+	output := toCommentOutputDto(saved)
+	return &output, nil
+}
+
+// DeleteComment はコメントを削除する。削除できるのはコメントした本人とイベントの主催者
+func (u *eventUsecase) DeleteComment(ctx context.Context, kratosID string, eventID string, commentID string) error {
+	e, viewer, err := u.getVisibleEvent(ctx, eventID, kratosID)
+	if err != nil {
+		return err
+	}
+
+	c, err := u.eventRepo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if c.EventID() != e.ID().String() {
+		return domainerror.New("comment not found", domainerror.ErrNotFound)
+	}
+	if !c.CanBeDeletedBy(viewer.UserID(), e) {
+		return domainerror.New("user cannot delete the comment", domainerror.ErrUnauthorized)
+	}
+	return u.eventRepo.DeleteComment(ctx, c.ID())
+}
+
+func (u *eventUsecase) GetEventRouteID(ctx context.Context, eventID string, kratosID string) (string, error) {
+	e, viewer, err := u.getVisibleEvent(ctx, eventID, kratosID)
+	if err != nil {
+		return "", err
+	}
+
+	r, err := u.routeRepo.GetRouteByID(ctx, e.RouteID())
+	if err != nil {
+		return "", err
+	}
+	if !r.IsVisibleTo(viewer) {
+		return "", domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	return r.ID(), nil
+}
+
+// getVisibleEvent はユーザーが閲覧できるイベントと、閲覧ユーザーを返す
+func (u *eventUsecase) getVisibleEvent(ctx context.Context, eventID string, kratosID string) (*eventDomain.Event, routeDomain.Viewer, error) {
+	var viewerID string
+	if kratosID != "" {
+		userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+		if err != nil {
+			return nil, routeDomain.Viewer{}, err
+		}
+		viewerID = userEntity.ID().String()
+	}
+	viewer, err := routeDomain.ResolveViewer(ctx, u.clubs, viewerID)
+	if err != nil {
+		return nil, routeDomain.Viewer{}, err
+	}
+
+	e, err := u.eventRepo.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, routeDomain.Viewer{}, err
+	}
+	// 閲覧できないイベントは存在しないものとして扱う
+	if !e.IsVisibleTo(viewer) {
+		return nil, routeDomain.Viewer{}, domainerror.New("event not found", domainerror.ErrNotFound)
+	}
+	return e, viewer, nil
+}
+
+func decodeCommentCursor(token string) (*pagination.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	sort, sortKey, id, err := cursor.Decode(token)
+	if err != nil || sort != commentCursorSort {
+		return nil, domainerror.New("invalid cursor", domainerror.ErrValidation)
+	}
+	return pagination.NewCursor(sortKey, id)
+}
+
+// getOrganizedEvent はユーザーが主催するイベントと、主催者を閲覧ユーザーとして返す
+func (u *eventUsecase) getOrganizedEvent(ctx context.Context, kratosID string, eventID string) (*eventDomain.Event, routeDomain.Viewer, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, routeDomain.Viewer{}, err
+	}
+
+	e, err := u.eventRepo.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, routeDomain.Viewer{}, err
+	}
+	if e.UserID() != userEntity.ID().String() {
+		return nil, routeDomain.Viewer{}, domainerror.New("user does not organize the event", domainerror.ErrUnauthorized)
+	}
+	organizer, err := routeDomain.ResolveViewer(ctx, u.clubs, e.UserID())
+	if err != nil {
+		return nil, routeDomain.Viewer{}, err
+	}
+	return e, organizer, nil
+}
+
+// getRSVP はユーザーの返事を取得する。まだ返事していない場合はnilを返す
+func (u *eventUsecase) getRSVP(ctx context.Context, eventID string, userID string) (*eventDomain.RSVP, error) {
+	rsvp, err := u.eventRepo.GetRSVP(ctx, eventID, userID)
+	if errors.Is(err, domainerror.ErrNotFound) {
+		return nil, nil
+	}
+	return rsvp, err
+}
+
+// checkRoute はイベントのルートを主催者が閲覧でき、公開範囲が合っていることを確かめる
+func (u *eventUsecase) checkRoute(ctx context.Context, organizer routeDomain.Viewer, e *eventDomain.Event) error {
+	r, err := u.routeRepo.GetRouteByID(ctx, e.RouteID())
+	if err != nil {
+		return err
+	}
+	return e.CheckRoute(organizer, r)
+}
+
+// getRoutes はルートをまとめて取得し、IDで引けるようにする
+func (u *eventUsecase) getRoutes(ctx context.Context, ids []string) (map[string]*routeDomain.ExploreRouteResult, error) {
+	routes := make(map[string]*routeDomain.ExploreRouteResult, len(ids))
+	if len(ids) == 0 {
+		return routes, nil
+	}
+	results, err := u.routeRepo.GetRoutesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		routes[r.Route.ID()] = r
+	}
+	return routes, nil
+}
+
+func (u *eventUsecase) toOutput(ctx context.Context, e *eventDomain.Event, viewer routeDomain.Viewer, rsvp *eventDomain.RSVP) (*EventOutputDto, error) {
+	routes, err := u.getRoutes(ctx, []string{e.RouteID()})
+	if err != nil {
+		return nil, err
+	}
+	output := toEventOutputDto(e, routes, viewer, rsvp)
+	return &output, nil
+}
+
+func toDetails(dto EventInputDto) eventDomain.Details {
+	return eventDomain.Details{
+		RouteID:          dto.RouteID,
+		Title:            dto.Title,
+		Description:      dto.Description,
+		StartsAt:         dto.StartsAt,
+		MeetingPoint:     dto.MeetingPoint,
+		MeetingPointName: dto.MeetingPointName,
+		Capacity:         dto.Capacity,
+		Visibility:       dto.Visibility,
+		ClubID:           dto.ClubID,
+	}
+}
+
+func toEventOutputDto(e *eventDomain.Event, routes map[string]*routeDomain.ExploreRouteResult, viewer routeDomain.Viewer, rsvp *eventDomain.RSVP) EventOutputDto {
+	output := EventOutputDto{
+		ID:               e.ID().String(),
+		OrganizerID:      e.UserID(),
+		Title:            e.Title(),
+		Description:      e.Description(),
+		StartsAt:         e.StartsAt(),
+		MeetingPoint:     e.MeetingPoint(),
+		MeetingPointName: e.MeetingPointName(),
+		Capacity:         e.Capacity(),
+		GoingCount:       e.GoingCount(),
+		Visibility:       e.Visibility(),
+		ClubID:           e.ClubID(),
+		CancelledAt:      e.CancelledAt(),
+		CancelReason:     e.CancelReason(),
+		CreatedAt:        e.CreatedAt(),
+		UpdatedAt:        e.UpdatedAt(),
+	}
+	if r, ok := routes[e.RouteID()]; ok && r.Route.IsVisibleTo(viewer) {
+		output.Route = &EventRouteOutputDto{
+			ID:            r.Route.ID(),
+			Name:          r.Route.Name(),
+			UserName:      r.UserName,
+			Distance:      r.Route.Distance(),
+			ElevationGain: r.Route.ElevationGain(),
+			Polyline:      r.Route.Polyline(),
+		}
+	}
+	if rsvp != nil {
+		output.MyRSVP = string(rsvp.Status())
+	}
+	return output
+}
+
+func toCommentOutputDto(c *eventDomain.Comment) CommentOutputDto {
+	return CommentOutputDto{
+		ID:        c.ID(),
+		UserID:    c.UserID(),
+		UserName:  c.UserName(),
+		Body:      c.Body(),
+		CreatedAt: c.CreatedAt(),
+	}
+}
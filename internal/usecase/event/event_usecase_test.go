@@ -0,0 +1,471 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	eventDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/event"
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserID      = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+	testKratosID    = "2eb50f70-3a23-4067-99f6-9fd645686880"
+	testOtherUserID = "019b5a8d-0000-7000-8000-0000000000ff"
+	testEventID     = "019b5a65-0000-7000-8000-000000000001"
+	testCommentID   = "019b5a65-0000-7000-8000-000000000101"
+	testRouteID     = "019b5a50-0000-7000-8000-000000000001"
+	testClubID      = "019b5a66-0000-7000-8000-000000000001"
+)
+
+func createTestUser() *userDomain.User {
+	user, _ := userDomain.ReconstructUser(
+		userDomain.UserID(testUserID),
+		testKratosID,
+		"Test User",
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false,
+	)
+	return user
+}
+
+func createTestRoute(userID string, visibility int16) *routeDomain.Route {
+	path := orb.LineString{{139.70, 35.60}, {139.75, 35.65}}
+	route, _ := routeDomain.ReconstructRoute(
+		testRouteID, userID, "多摩川", "", nil, 40000, 8000, 400, 200,
+		routeDomain.Geometry{Geometry: path}, routeDomain.Geometry{}, routeDomain.Geometry{}, routeDomain.Geometry{},
+		"", visibility, 1, nil, "", "",
+	)
+	return route
+}
+
+// createTestClubRoute はクラブのメンバーのみに公開したルートを作成する
+func createTestClubRoute(userID string) *routeDomain.Route {
+	route := createTestRoute(userID, routeDomain.VisibilityClub)
+	route.SetClubID(new(testClubID))
+	return route
+}
+
+// createTestEvent は来年に開催するイベントを作成する
+func createTestEvent(organizerID string, visibility int16, capacity *int32, goingCount int32) *eventDomain.Event {
+	return eventDomain.ReconstructEvent(testEventID, organizerID, eventDomain.Details{
+		RouteID:          testRouteID,
+		Title:            "多摩川朝練",
+		StartsAt:         time.Now().AddDate(1, 0, 0),
+		MeetingPoint:     orb.Point{139.6267, 35.6115},
+		MeetingPointName: "二子玉川駅",
+		Capacity:         capacity,
+		Visibility:       visibility,
+	}, nil, "", goingCount, "", "")
+}
+
+type eventTestMocks struct {
+	userRepo  *userDomain.MockIUserRepository
+	eventRepo *eventDomain.MockIEventRepository
+	routeRepo *routeDomain.MockIRouteRepository
+	txManager *transactionApp.MockTransactionManager
+	clubs     *routeDomain.MockClubMembershipReader
+	publisher *notificationDomain.MockPublisher
+	usecase   IEventUsecase
+	clubIDs   []string // 閲覧ユーザーが所属するクラブ
+}
+
+func setupEventMocks(t *testing.T) *eventTestMocks {
+	ctrl := gomock.NewController(t)
+	m := &eventTestMocks{
+		userRepo:  userDomain.NewMockIUserRepository(ctrl),
+		eventRepo: eventDomain.NewMockIEventRepository(ctrl),
+		routeRepo: routeDomain.NewMockIRouteRepository(ctrl),
+		txManager: transactionApp.NewMockTransactionManager(ctrl),
+		clubs:     routeDomain.NewMockClubMembershipReader(ctrl),
+		publisher: notificationDomain.NewMockPublisher(ctrl),
+	}
+	m.clubs.EXPECT().GetClubIDsByUserID(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, string) ([]string, error) {
+		return m.clubIDs, nil
+	}).AnyTimes()
+	m.usecase = NewEventUsecase(m.userRepo, m.txManager, m.eventRepo, m.routeRepo, m.clubs, m.publisher)
+	return m
+}
+
+func (m *eventTestMocks) expectRoutes(route *routeDomain.Route) {
+	m.routeRepo.EXPECT().GetRoutesByIDs(gomock.Any(), []string{testRouteID}).Return([]*routeDomain.ExploreRouteResult{
+		{Route: route, UserName: "Test User"},
+	}, nil)
+}
+
+func Test_eventUsecase_CreateEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		route      *routeDomain.Route
+		visibility int16
+		clubID     *string
+		clubIDs    []string
+		startsAt   time.Time
+		wantErr    error
+	}{
+		{
+			name:       "正常系: 他のユーザーの公開ルートで公開イベントを作る",
+			route:      createTestRoute(testOtherUserID, routeDomain.VisibilityPublic),
+			visibility: routeDomain.VisibilityPublic,
+			startsAt:   time.Now().Add(24 * time.Hour),
+		},
+		{
+			name:       "異常系: 他のユーザーの非公開ルート",
+			route:      createTestRoute(testOtherUserID, routeDomain.VisibilityPrivate),
+			visibility: routeDomain.VisibilityPrivate,
+			startsAt:   time.Now().Add(24 * time.Hour),
+			wantErr:    domainerror.ErrNotFound,
+		},
+		{
+			name:       "異常系: 自分の非公開ルートで公開イベントは作れない",
+			route:      createTestRoute(testUserID, routeDomain.VisibilityPrivate),
+			visibility: routeDomain.VisibilityPublic,
+			startsAt:   time.Now().Add(24 * time.Hour),
+			wantErr:    domainerror.ErrValidation,
+		},
+		{
+			name:       "正常系: クラブのメンバーのみのルートでクラブのイベントを作る",
+			route:      createTestClubRoute(testOtherUserID),
+			visibility: routeDomain.VisibilityClub,
+			clubID:     new(testClubID),
+			clubIDs:    []string{testClubID},
+			startsAt:   time.Now().Add(24 * time.Hour),
+		},
+		{
+			name:       "異常系: 所属していないクラブのイベントは作れない",
+			route:      createTestRoute(testUserID, routeDomain.VisibilityPublic),
+			visibility: routeDomain.VisibilityClub,
+			clubID:     new(testClubID),
+			startsAt:   time.Now().Add(24 * time.Hour),
+			wantErr:    domainerror.ErrUnauthorized,
+		},
+		{
+			name:       "異常系: 過去の日時",
+			visibility: routeDomain.VisibilityPublic,
+			startsAt:   time.Now().Add(-time.Hour),
+			wantErr:    domainerror.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupEventMocks(t)
+			m.clubIDs = tt.clubIDs
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			if tt.route != nil {
+				m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(tt.route, nil)
+			}
+			if tt.wantErr == nil {
+				m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+				m.expectRoutes(tt.route)
+			}
+
+			got, err := m.usecase.CreateEvent(context.Background(), testKratosID, EventInputDto{
+				RouteID:      testRouteID,
+				Title:        "多摩川朝練",
+				StartsAt:     tt.startsAt,
+				MeetingPoint: orb.Point{139.6267, 35.6115},
+				Visibility:   tt.visibility,
+				ClubID:       tt.clubID,
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("CreateEvent() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateEvent() error = %v", err)
+			}
+			if got.OrganizerID != testUserID || got.MeetingPoint.Lat() != 35.6115 || got.Route == nil || got.Route.Name != "多摩川" {
+				t.Errorf("CreateEvent() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_eventUsecase_CancelEvent(t *testing.T) {
+	t.Parallel()
+
+	rsvps := []*eventDomain.RSVP{
+		eventDomain.ReconstructRSVP(testEventID, testUserID, "Test User", eventDomain.RSVPGoing, ""),
+		eventDomain.ReconstructRSVP(testEventID, "019b5a8d-0000-7000-8000-000000000001", "going", eventDomain.RSVPGoing, ""),
+		eventDomain.ReconstructRSVP(testEventID, "019b5a8d-0000-7000-8000-000000000002", "maybe", eventDomain.RSVPMaybe, ""),
+		eventDomain.ReconstructRSVP(testEventID, "019b5a8d-0000-7000-8000-000000000003", "not going", eventDomain.RSVPNotGoing, ""),
+	}
+
+	tests := []struct {
+		name      string
+		notifyErr error
+	}{
+		{name: "正常系: 主催者以外の参加する・未定のユーザーに知らせる"},
+		{name: "正常系: 通知に失敗しても中止する", notifyErr: errors.New("unavailable")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupEventMocks(t)
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			m.eventRepo.EXPECT().GetEventByID(gomock.Any(), testEventID).Return(createTestEvent(testUserID, 1, nil, 2), nil)
+			m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			m.eventRepo.EXPECT().GetRSVPs(gomock.Any(), testEventID).Return(rsvps, nil)
+			for _, attendeeID := range []string{"019b5a8d-0000-7000-8000-000000000001", "019b5a8d-0000-7000-8000-000000000002"} {
+				m.publisher.EXPECT().Publish(gomock.Any(), notificationDomain.NewEventCancelledActivity(testUserID, attendeeID, testEventID)).Return(tt.notifyErr)
+			}
+			m.expectRoutes(createTestRoute(testUserID, routeDomain.VisibilityPublic))
+
+			got, err := m.usecase.CancelEvent(context.Background(), testKratosID, testEventID, "雨天のため")
+			if err != nil {
+				t.Fatalf("CancelEvent() error = %v", err)
+			}
+			if got.CancelledAt == nil || got.CancelReason != "雨天のため" {
+				t.Errorf("CancelEvent() = %+v", got)
+			}
+		})
+	}
+
+	t.Run("異常系: 主催者以外は中止できない", func(t *testing.T) {
+		t.Parallel()
+		m := setupEventMocks(t)
+		m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.eventRepo.EXPECT().GetEventByID(gomock.Any(), testEventID).Return(createTestEvent(testOtherUserID, 1, nil, 0), nil)
+
+		_, err := m.usecase.CancelEvent(context.Background(), testKratosID, testEventID, "")
+		if !errors.Is(err, domainerror.ErrUnauthorized) {
+			t.Errorf("CancelEvent() error = %v, want %v", err, domainerror.ErrUnauthorized)
+		}
+	})
+}
+
+func Test_eventUsecase_RespondToEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		status        string
+		current       *eventDomain.RSVP
+		capacity      *int32
+		goingCount    int32
+		wantGoing     int32
+		wantErr       error
+		wantNoLookups bool
+	}{
+		{
+			name:       "正常系: 初めて参加すると返事する",
+			status:     "going",
+			capacity:   new(int32(2)),
+			goingCount: 1,
+			wantGoing:  2,
+		},
+		{
+			name:       "正常系: 参加から未定に変えると参加者が減る",
+			status:     "maybe",
+			current:    eventDomain.ReconstructRSVP(testEventID, testUserID, "Test User", eventDomain.RSVPGoing, ""),
+			capacity:   new(int32(2)),
+			goingCount: 2,
+			wantGoing:  1,
+		},
+		{
+			name:       "異常系: 上限に達している",
+			status:     "going",
+			current:    eventDomain.ReconstructRSVP(testEventID, testUserID, "Test User", eventDomain.RSVPMaybe, ""),
+			capacity:   new(int32(2)),
+			goingCount: 2,
+			wantErr:    domainerror.ErrConflict,
+		},
+		{
+			name:          "異常系: 不明な返事",
+			status:        "yes",
+			wantErr:       domainerror.ErrValidation,
+			wantNoLookups: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupEventMocks(t)
+			if !tt.wantNoLookups {
+				m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.eventRepo.EXPECT().GetEventByID(gomock.Any(), testEventID).Return(createTestEvent(testOtherUserID, 1, tt.capacity, tt.goingCount), nil)
+				if tt.current != nil {
+					m.eventRepo.EXPECT().GetRSVP(gomock.Any(), testEventID, testUserID).Return(tt.current, nil)
+				} else {
+					m.eventRepo.EXPECT().GetRSVP(gomock.Any(), testEventID, testUserID).Return(nil, domainerror.New("rsvp not found", domainerror.ErrNotFound))
+				}
+			}
+			if tt.wantErr == nil {
+				m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+				m.expectRoutes(createTestRoute(testOtherUserID, routeDomain.VisibilityPublic))
+			}
+
+			got, err := m.usecase.RespondToEvent(context.Background(), testKratosID, testEventID, tt.status)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("RespondToEvent() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RespondToEvent() error = %v", err)
+			}
+			if got.GoingCount != tt.wantGoing || got.MyRSVP != tt.status {
+				t.Errorf("GoingCount, MyRSVP = %d, %q, want %d, %q", got.GoingCount, got.MyRSVP, tt.wantGoing, tt.status)
+			}
+		})
+	}
+}
+
+func Test_eventUsecase_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		organizerID string
+		authorID    string
+		commentOf   string
+		wantErr     error
+	}{
+		{name: "正常系: コメントした本人", organizerID: testOtherUserID, authorID: testUserID, commentOf: testEventID},
+		{name: "正常系: イベントの主催者", organizerID: testUserID, authorID: testOtherUserID, commentOf: testEventID},
+		{name: "異常系: 他のユーザーのコメント", organizerID: testOtherUserID, authorID: testOtherUserID, commentOf: testEventID, wantErr: domainerror.ErrUnauthorized},
+		{name: "異常系: 別のイベントのコメント", organizerID: testUserID, authorID: testUserID, commentOf: "019b5a65-0000-7000-8000-000000000002", wantErr: domainerror.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupEventMocks(t)
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			m.eventRepo.EXPECT().GetEventByID(gomock.Any(), testEventID).Return(createTestEvent(tt.organizerID, 1, nil, 0), nil)
+			m.eventRepo.EXPECT().GetComment(gomock.Any(), testCommentID).Return(
+				eventDomain.ReconstructComment(testCommentID, tt.commentOf, tt.authorID, "", "雨の場合は中止ですか？", ""), nil)
+			if tt.wantErr == nil {
+				m.eventRepo.EXPECT().DeleteComment(gomock.Any(), testCommentID).Return(nil)
+			}
+
+			err := m.usecase.DeleteComment(context.Background(), testKratosID, testEventID, testCommentID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeleteComment() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_eventUsecase_ListComments(t *testing.T) {
+	t.Parallel()
+
+	next, _ := pagination.NewCursor(1720083600, testCommentID)
+	tests := []struct {
+		name           string
+		input          ListCommentsInputDto
+		page           *eventDomain.CommentPage
+		wantAfterID    string
+		wantNextCursor string
+		wantErr        error
+	}{
+		{
+			name:           "正常系: 次のページがある場合はカーソルを返す",
+			page:           &eventDomain.CommentPage{Items: []*eventDomain.Comment{eventDomain.ReconstructComment(testCommentID, testEventID, testUserID, "Test User", "雨の場合は中止ですか？", "")}, Next: next},
+			wantNextCursor: cursor.Encode(commentCursorSort, 1720083600, testCommentID),
+		},
+		{
+			name:        "正常系: 前のページの続きから取得する",
+			input:       ListCommentsInputDto{Cursor: cursor.Encode(commentCursorSort, 1720083600, testCommentID)},
+			page:        &eventDomain.CommentPage{Items: []*eventDomain.Comment{}},
+			wantAfterID: testCommentID,
+		},
+		{name: "異常系: 不正なカーソル", input: ListCommentsInputDto{Cursor: "invalid"}, wantErr: domainerror.ErrValidation},
+		{name: "異常系: 他の一覧のカーソル", input: ListCommentsInputDto{Cursor: cursor.Encode("newest", 1720083600, testCommentID)}, wantErr: domainerror.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupEventMocks(t)
+			if tt.page != nil {
+				m.eventRepo.EXPECT().GetEventByID(gomock.Any(), testEventID).Return(createTestEvent(testOtherUserID, routeDomain.VisibilityPublic, nil, 0), nil)
+				m.eventRepo.EXPECT().GetComments(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *eventDomain.CommentCriteria) (*eventDomain.CommentPage, error) {
+					if c.EventID() != testEventID || c.Limit() != pagination.DefaultLimit {
+						t.Errorf("GetComments() criteria = %+v", c)
+					}
+					if (tt.wantAfterID == "") != (c.After() == nil) || (c.After() != nil && c.After().ID() != tt.wantAfterID) {
+						t.Errorf("GetComments() after = %+v, want %q", c.After(), tt.wantAfterID)
+					}
+					return tt.page, nil
+				})
+			}
+
+			got, err := m.usecase.ListComments(context.Background(), testEventID, "", tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ListComments() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got.Comments) != len(tt.page.Items) || got.NextCursor != tt.wantNextCursor {
+				t.Errorf("ListComments() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_eventUsecase_GetEventRouteID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		event   *eventDomain.Event
+		route   *routeDomain.Route
+		wantErr error
+	}{
+		{
+			name:  "正常系: 公開イベントのルート",
+			event: createTestEvent(testOtherUserID, routeDomain.VisibilityPublic, nil, 0),
+			route: createTestRoute(testOtherUserID, routeDomain.VisibilityPublic),
+		},
+		{
+			name:    "異常系: イベントを作った後にルートが非公開になった",
+			event:   createTestEvent(testOtherUserID, routeDomain.VisibilityPublic, nil, 0),
+			route:   createTestRoute(testOtherUserID, routeDomain.VisibilityPrivate),
+			wantErr: domainerror.ErrNotFound,
+		},
+		{
+			name:    "異常系: 他のユーザーの友達のみのイベント",
+			event:   createTestEvent(testOtherUserID, routeDomain.VisibilityFriends, nil, 0),
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupEventMocks(t)
+			m.eventRepo.EXPECT().GetEventByID(gomock.Any(), testEventID).Return(tt.event, nil)
+			if tt.route != nil {
+				m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(tt.route, nil)
+			}
+
+			got, err := m.usecase.GetEventRouteID(context.Background(), testEventID, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetEventRouteID() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got != testRouteID {
+				t.Errorf("GetEventRouteID() = %q, want %q", got, testRouteID)
+			}
+		})
+	}
+}
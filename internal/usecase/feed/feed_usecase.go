@@ -0,0 +1,284 @@
+package feed
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	feedDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/feed"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	tripDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+)
+
+// feedCursorSort はフィードのカーソルに入れる並び順。フィードは出来事の新しい順だけ
+const feedCursorSort = "newest"
+
+// IFeedUsecase はフォローしているユーザーのルートやトリップの出来事をフィードとして返す
+type IFeedUsecase interface {
+	GetFeed(ctx context.Context, kratosID string, dto FeedInputDto) (*FeedOutputDto, error)
+}
+
+// subjectResolver は出来事の対象をまとめて取得し、閲覧ユーザーが見られる対象だけを対象のIDで引けるようにして返す
+// 対象が削除されたか見られない出来事はフィードから除く
+type subjectResolver func(ctx context.Context, viewer routeDomain.Viewer, subjectIDs []string) (map[string]FeedSubjectDto, error)
+
+type feedUsecase struct {
+	userRepo  userDomain.IUserRepository
+	feedRepo  feedDomain.IFeedRepository
+	routeRepo routeDomain.IRouteRepository
+	tripRepo  tripDomain.ITripRepository
+	clubs     routeDomain.ClubMembershipReader
+	// 出来事の種類ごとの対象の取得方法。種類を追加するときはここに登録する
+	resolvers map[feedDomain.EventType]subjectResolver
+}
+
+func NewFeedUsecase(userRepo userDomain.IUserRepository, feedRepo feedDomain.IFeedRepository, routeRepo routeDomain.IRouteRepository, tripRepo tripDomain.ITripRepository, clubs routeDomain.ClubMembershipReader) IFeedUsecase {
+	u := &feedUsecase{
+		userRepo:  userRepo,
+		feedRepo:  feedRepo,
+		routeRepo: routeRepo,
+		tripRepo:  tripRepo,
+		clubs:     clubs,
+	}
+	u.resolvers = map[feedDomain.EventType]subjectResolver{
+		feedDomain.EventTypeRouteCreated:   u.resolveRoutes,
+		feedDomain.EventTypeRouteLiked:     u.resolveRoutes,
+		feedDomain.EventTypeTripUploaded:   u.resolveTrips,
+		feedDomain.EventTypeRouteCommented: u.resolveComments,
+	}
+	return u
+}
+
+type FeedInputDto struct {
+	Limit  int32  // 0の場合は既定値
+	Cursor string // 前のページのNextCursor。空の場合は先頭から
+}
+
+type FeedOutputDto struct {
+	// 閲覧できない出来事を除くため、次のページがあってもLimitより少ないことがある
+	Items      []FeedItemOutputDto
+	NextCursor string // 次のページがない場合は空文字
+}
+
+type FeedItemOutputDto struct {
+	ID        string
+	Type      string
+	ActorID   string
+	ActorName string
+	CreatedAt string
+	FeedSubjectDto
+}
+
+// FeedSubjectDto は出来事の対象。種類に応じて必要なものだけを設定する
+type FeedSubjectDto struct {
+	Route   *FeedRouteOutputDto   // ルートの作成・いいね・コメント
+	Trip    *FeedTripOutputDto    // トリップのアップロード
+	Comment *FeedCommentOutputDto // コメント
+}
+
+type FeedRouteOutputDto struct {
+	ID            string
+	UserID        string
+	UserName      string
+	Name          string
+	Distance      float64
+	ElevationGain float64
+	Polyline      string
+}
+
+type FeedTripOutputDto struct {
+	ID            string
+	Name          string
+	Distance      *float64
+	MovingTime    *int32
+	ElevationGain *float64
+	DepartedAt    *string
+}
+
+type FeedCommentOutputDto struct {
+	ID      string
+	Content string
+}
+
+// GetFeed はフォローしているユーザーの出来事を新しい順に返す
+// 出来事は閲覧時に集め、対象の公開範囲もこのときに判定する
+func (u *feedUsecase) GetFeed(ctx context.Context, kratosID string, dto FeedInputDto) (*FeedOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	viewer, err := routeDomain.ResolveViewer(ctx, u.clubs, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := pagination.NormalizeLimit(dto.Limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeCursor(dto.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	criteria, err := feedDomain.NewFeedCriteria(viewer.UserID(), limit, after)
+	if err != nil {
+		return nil, err
+	}
+	page, err := u.feedRepo.GetFeed(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	subjects, err := u.resolveSubjects(ctx, viewer, page.Items)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]FeedItemOutputDto, 0, len(page.Items))
+	for _, e := range page.Items {
+		subject, ok := subjects[e.EventType()][e.SubjectID()]
+		if !ok {
+			continue
+		}
+		items = append(items, FeedItemOutputDto{
+			ID:             e.ID(),
+			Type:           string(e.EventType()),
+			ActorID:        e.ActorID(),
+			ActorName:      e.ActorName(),
+			CreatedAt:      e.CreatedAt(),
+			FeedSubjectDto: subject,
+		})
+	}
+
+	output := &FeedOutputDto{Items: items}
+	if page.Next != nil {
+		output.NextCursor = cursor.Encode(feedCursorSort, page.Next.SortKey(), page.Next.ID())
+	}
+	return output, nil
+}
+
+// resolveSubjects は出来事の対象を種類ごとにまとめて取得する。取得方法が登録されていない種類は除く
+func (u *feedUsecase) resolveSubjects(ctx context.Context, viewer routeDomain.Viewer, events []*feedDomain.Event) (map[feedDomain.EventType]map[string]FeedSubjectDto, error) {
+	subjectIDs := make(map[feedDomain.EventType][]string)
+	for _, e := range events {
+		subjectIDs[e.EventType()] = append(subjectIDs[e.EventType()], e.SubjectID())
+	}
+
+	subjects := make(map[feedDomain.EventType]map[string]FeedSubjectDto, len(subjectIDs))
+	for eventType, ids := range subjectIDs {
+		resolve, ok := u.resolvers[eventType]
+		if !ok {
+			continue
+		}
+		resolved, err := resolve(ctx, viewer, ids)
+		if err != nil {
+			return nil, err
+		}
+		subjects[eventType] = resolved
+	}
+	return subjects, nil
+}
+
+func (u *feedUsecase) resolveRoutes(ctx context.Context, viewer routeDomain.Viewer, routeIDs []string) (map[string]FeedSubjectDto, error) {
+	routes, err := u.getVisibleRoutes(ctx, viewer, routeIDs)
+	if err != nil {
+		return nil, err
+	}
+	subjects := make(map[string]FeedSubjectDto, len(routes))
+	for id, r := range routes {
+		subjects[id] = FeedSubjectDto{Route: r}
+	}
+	return subjects, nil
+}
+
+// resolveTrips はトリップを取得する。トリップはクラブに共有できないため、所有者と公開範囲だけで判定する
+func (u *feedUsecase) resolveTrips(ctx context.Context, viewer routeDomain.Viewer, tripIDs []string) (map[string]FeedSubjectDto, error) {
+	trips, err := u.tripRepo.GetTripsByIDs(ctx, tripIDs)
+	if err != nil {
+		return nil, err
+	}
+	subjects := make(map[string]FeedSubjectDto, len(trips))
+	for _, t := range trips {
+		if !viewer.CanView(t.UserID(), t.Visibility(), nil) {
+			continue
+		}
+		subjects[t.ID()] = FeedSubjectDto{Trip: &FeedTripOutputDto{
+			ID:            t.ID(),
+			Name:          t.Name(),
+			Distance:      t.Distance(),
+			MovingTime:    t.MovingTime(),
+			ElevationGain: t.ElevationGain(),
+			DepartedAt:    t.DepartedAt(),
+		}}
+	}
+	return subjects, nil
+}
+
+// resolveComments はコメントをコメント先のルートとともに取得する。ルートを見られないコメントは除く
+func (u *feedUsecase) resolveComments(ctx context.Context, viewer routeDomain.Viewer, commentIDs []string) (map[string]FeedSubjectDto, error) {
+	comments, err := u.routeRepo.GetCommentsByIDs(ctx, commentIDs)
+	if err != nil {
+		return nil, err
+	}
+	routeIDs := make([]string, len(comments))
+	for i, c := range comments {
+		routeIDs[i] = c.RouteID()
+	}
+	routes, err := u.getVisibleRoutes(ctx, viewer, routeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	subjects := make(map[string]FeedSubjectDto, len(comments))
+	for _, c := range comments {
+		r, ok := routes[c.RouteID()]
+		if !ok {
+			continue
+		}
+		subjects[c.ID()] = FeedSubjectDto{
+			Route:   r,
+			Comment: &FeedCommentOutputDto{ID: c.ID(), Content: c.Content()},
+		}
+	}
+	return subjects, nil
+}
+
+// getVisibleRoutes はルートをまとめて取得し、閲覧ユーザーが見られるものだけをIDで引けるようにする
+func (u *feedUsecase) getVisibleRoutes(ctx context.Context, viewer routeDomain.Viewer, ids []string) (map[string]*FeedRouteOutputDto, error) {
+	routes := make(map[string]*FeedRouteOutputDto, len(ids))
+	if len(ids) == 0 {
+		return routes, nil
+	}
+	results, err := u.routeRepo.GetRoutesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		if !r.Route.IsVisibleTo(viewer) {
+			continue
+		}
+		routes[r.Route.ID()] = &FeedRouteOutputDto{
+			ID:            r.Route.ID(),
+			UserID:        r.Route.UserID(),
+			UserName:      r.UserName,
+			Name:          r.Route.Name(),
+			Distance:      r.Route.Distance(),
+			ElevationGain: r.Route.ElevationGain(),
+			Polyline:      r.Route.Polyline(),
+		}
+	}
+	return routes, nil
+}
+
+// decodeCursor はカーソルトークンを取り出す。空の場合は先頭から取得する
+func decodeCursor(token string) (*pagination.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	sort, sortKey, id, err := cursor.Decode(token)
+	if err != nil || sort != feedCursorSort {
+		return nil, domainerror.New("invalid cursor", domainerror.ErrValidation)
+	}
+	return pagination.NewCursor(sortKey, id)
+}
@@ -0,0 +1,181 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	feedDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/feed"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	tripDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserID     = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+	testKratosID   = "2eb50f70-3a23-4067-99f6-9fd645686880"
+	testFolloweeID = "019b5a46-1e77-7b9d-ac62-b438a0fc89cb"
+	testRouteID1   = "019b5a50-0000-7000-8000-000000000001"
+	testRouteID2   = "019b5a50-0000-7000-8000-000000000002"
+	testCommentID  = "019b5a67-0000-7000-8000-000000000101"
+)
+
+func createTestUser() *userDomain.User {
+	user, _ := userDomain.ReconstructUser(
+		userDomain.UserID(testUserID),
+		testKratosID,
+		"Test User",
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false,
+	)
+	return user
+}
+
+func createTestRouteResult(id string, userID string, visibility int16) *routeDomain.ExploreRouteResult {
+	route, _ := routeDomain.ReconstructRoute(
+		id, userID, "ルート", "", nil, 10000, 1800, 100, 100,
+		routeDomain.Geometry{}, routeDomain.Geometry{}, routeDomain.Geometry{}, routeDomain.Geometry{},
+		"", visibility, 1, nil, "", "",
+	)
+	result, _ := routeDomain.ReconstructExploreRouteResult(route, "Followee", 0)
+	return result
+}
+
+func createTestEvent(id string, eventType feedDomain.EventType, subjectID string) *feedDomain.Event {
+	return feedDomain.ReconstructEvent(id, testFolloweeID, "Followee", eventType, subjectID, "2024-07-01T09:00:00Z")
+}
+
+type feedTestMocks struct {
+	userRepo  *userDomain.MockIUserRepository
+	feedRepo  *feedDomain.MockIFeedRepository
+	routeRepo *routeDomain.MockIRouteRepository
+	tripRepo  *tripDomain.MockITripRepository
+	usecase   IFeedUsecase
+}
+
+func setupFeedMocks(t *testing.T) *feedTestMocks {
+	ctrl := gomock.NewController(t)
+	m := &feedTestMocks{
+		userRepo:  userDomain.NewMockIUserRepository(ctrl),
+		feedRepo:  feedDomain.NewMockIFeedRepository(ctrl),
+		routeRepo: routeDomain.NewMockIRouteRepository(ctrl),
+		tripRepo:  tripDomain.NewMockITripRepository(ctrl),
+	}
+	clubs := routeDomain.NewMockClubMembershipReader(ctrl)
+	clubs.EXPECT().GetClubIDsByUserID(gomock.Any(), testUserID).Return(nil, nil).AnyTimes()
+	m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil).AnyTimes()
+	m.usecase = NewFeedUsecase(m.userRepo, m.feedRepo, m.routeRepo, m.tripRepo, clubs)
+	return m
+}
+
+func Test_feedUsecase_GetFeed(t *testing.T) {
+	t.Parallel()
+	m := setupFeedMocks(t)
+
+	publicTrip, _ := tripDomain.NewTrip(testFolloweeID, "朝のライド", "", routeDomain.VisibilityPublic, 0)
+	privateTrip, _ := tripDomain.NewTrip(testFolloweeID, "通勤", "", routeDomain.VisibilityPrivate, 0)
+	next, _ := pagination.NewCursor(1719824400, "019b5a67-0000-7000-8000-000000000001")
+	m.feedRepo.EXPECT().GetFeed(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *feedDomain.FeedCriteria) (*feedDomain.FeedPage, error) {
+		if c.UserID() != testUserID || c.Limit() != pagination.DefaultLimit || c.After() != nil {
+			t.Errorf("GetFeed() criteria = %+v", c)
+		}
+		return &feedDomain.FeedPage{
+			Items: []*feedDomain.Event{
+				createTestEvent("event-1", feedDomain.EventTypeRouteCommented, testCommentID),
+				createTestEvent("event-2", feedDomain.EventTypeTripUploaded, privateTrip.ID()),
+				createTestEvent("event-3", feedDomain.EventTypeTripUploaded, publicTrip.ID()),
+				createTestEvent("event-4", feedDomain.EventTypeRouteLiked, testRouteID1),
+				createTestEvent("event-5", feedDomain.EventTypeRouteCreated, testRouteID2),
+				createTestEvent("event-6", feedDomain.EventType("route_shared"), testRouteID1),
+			},
+			Next: next,
+		}, nil
+	})
+	m.routeRepo.EXPECT().GetCommentsByIDs(gomock.Any(), []string{testCommentID}).Return([]*routeDomain.Comment{
+		routeDomain.ReconstructComment(testCommentID, testRouteID1, testFolloweeID, "Followee", "いい坂でした", ""),
+	}, nil)
+	// 公開のルートと、他人の非公開ルート
+	m.routeRepo.EXPECT().GetRoutesByIDs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ids []string) ([]*routeDomain.ExploreRouteResult, error) {
+		var results []*routeDomain.ExploreRouteResult
+		for _, id := range ids {
+			visibility := routeDomain.VisibilityPublic
+			if id == testRouteID2 {
+				visibility = routeDomain.VisibilityPrivate
+			}
+			results = append(results, createTestRouteResult(id, testFolloweeID, visibility))
+		}
+		return results, nil
+	}).Times(3)
+	m.tripRepo.EXPECT().GetTripsByIDs(gomock.Any(), []string{privateTrip.ID(), publicTrip.ID()}).Return([]*tripDomain.Trip{privateTrip, publicTrip}, nil)
+
+	got, err := m.usecase.GetFeed(context.Background(), testKratosID, FeedInputDto{})
+	if err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+
+	// 非公開のトリップ・ルートと、取得方法のない種類の出来事は除く
+	var ids []string
+	for _, item := range got.Items {
+		ids = append(ids, item.ID)
+	}
+	if want := []string{"event-1", "event-3", "event-4"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("GetFeed() ids = %v, want %v", ids, want)
+	}
+	comment := got.Items[0]
+	if comment.Type != "route_commented" || comment.Comment == nil || comment.Comment.Content != "いい坂でした" || comment.Route == nil || comment.Route.ID != testRouteID1 {
+		t.Errorf("GetFeed() comment item = %+v", comment)
+	}
+	if trip := got.Items[1].Trip; trip == nil || trip.Name != "朝のライド" || got.Items[1].Route != nil {
+		t.Errorf("GetFeed() trip item = %+v", got.Items[1])
+	}
+	if got.NextCursor == "" {
+		t.Error("GetFeed() NextCursor is empty")
+	}
+}
+
+func Test_feedUsecase_GetFeed_Cursor(t *testing.T) {
+	t.Parallel()
+
+	const afterID = "019b5a67-0000-7000-8000-000000000003"
+	tests := []struct {
+		name    string
+		cursor  string
+		wantErr error
+	}{
+		{name: "正常系: 前のページの続きから取得する", cursor: cursor.Encode(feedCursorSort, 1719824400, afterID)},
+		{name: "異常系: 不正なカーソル", cursor: "invalid", wantErr: domainerror.ErrValidation},
+		{name: "異常系: 他の一覧のカーソル", cursor: cursor.Encode("most_liked", 10, afterID), wantErr: domainerror.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupFeedMocks(t)
+			if tt.wantErr == nil {
+				m.feedRepo.EXPECT().GetFeed(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *feedDomain.FeedCriteria) (*feedDomain.FeedPage, error) {
+					if c.After() == nil || c.After().ID() != afterID || c.After().SortKey() != 1719824400 {
+						t.Errorf("GetFeed() after = %+v", c.After())
+					}
+					return &feedDomain.FeedPage{Items: []*feedDomain.Event{}}, nil
+				})
+			}
+
+			got, err := m.usecase.GetFeed(context.Background(), testKratosID, FeedInputDto{Cursor: tt.cursor})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("GetFeed() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetFeed() error = %v", err)
+			}
+			if len(got.Items) != 0 || got.NextCursor != "" {
+				t.Errorf("GetFeed() = %+v", got)
+			}
+		})
+	}
+}
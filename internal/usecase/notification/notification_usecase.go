@@ -0,0 +1,201 @@
+package notification
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+)
+
+// notificationCursorSort は通知一覧のカーソルに入れる並び順。通知は新しい順だけ
+const notificationCursorSort = "newest"
+
+// INotificationUsecase はログインユーザーへの通知と、受け取る通知の設定を扱う
+type INotificationUsecase interface {
+	ListNotifications(ctx context.Context, kratosID string, dto ListNotificationsInputDto) (*NotificationListOutputDto, error)
+	GetUnreadCount(ctx context.Context, kratosID string) (int64, error)
+	MarkRead(ctx context.Context, kratosID string, notificationID string) error
+	MarkAllRead(ctx context.Context, kratosID string) error
+	GetPreferences(ctx context.Context, kratosID string) ([]PreferenceDto, error)
+	// UpdatePreferences は指定した種類の設定だけを変更し、変更後のすべての種類の設定を返す
+	UpdatePreferences(ctx context.Context, kratosID string, preferences []PreferenceDto) ([]PreferenceDto, error)
+}
+
+type notificationUsecase struct {
+	userRepo         userDomain.IUserRepository
+	notificationRepo notificationDomain.INotificationRepository
+}
+
+func NewNotificationUsecase(userRepo userDomain.IUserRepository, notificationRepo notificationDomain.INotificationRepository) INotificationUsecase {
+	return &notificationUsecase{
+		userRepo:         userRepo,
+		notificationRepo: notificationRepo,
+	}
+}
+
+type ListNotificationsInputDto struct {
+	UnreadOnly bool
+	Limit      int32  // 0の場合は既定値
+	Cursor     string // 前のページのNextCursor。空の場合は先頭から
+}
+
+type NotificationListOutputDto struct {
+	Notifications []NotificationOutputDto
+	NextCursor    string // 次のページがない場合は空文字
+}
+
+type NotificationOutputDto struct {
+	ID         string
+	Type       string
+	ActorID    string
+	ActorName  string
+	RouteID    *string // フォロー・イベントの場合はnil
+	RouteName  *string
+	EventID    *string // イベントの中止の場合のみ
+	EventTitle *string
+	Read       bool
+	CreatedAt  string
+}
+
+type PreferenceDto struct {
+	Type    string
+	Enabled bool
+}
+
+// ListNotifications はログインユーザーへの通知を新しい順に返す
+func (u *notificationUsecase) ListNotifications(ctx context.Context, kratosID string, dto ListNotificationsInputDto) (*NotificationListOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := pagination.NormalizeLimit(dto.Limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeCursor(dto.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	criteria, err := notificationDomain.NewNotificationCriteria(userEntity.ID().String(), dto.UnreadOnly, limit, after)
+	if err != nil {
+		return nil, err
+	}
+	page, err := u.notificationRepo.GetNotifications(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]NotificationOutputDto, 0, len(page.Items))
+	for _, n := range page.Items {
+		out := NotificationOutputDto{
+			ID:        n.ID(),
+			Type:      string(n.Type()),
+			ActorID:   n.ActorID(),
+			ActorName: n.ActorName(),
+			Read:      n.IsRead(),
+			CreatedAt: n.CreatedAt(),
+		}
+		if n.RouteID() != "" {
+			routeID, routeName := n.RouteID(), n.RouteName()
+			out.RouteID = &routeID
+			out.RouteName = &routeName
+		}
+		if n.EventID() != "" {
+			eventID, eventTitle := n.EventID(), n.EventTitle()
+			out.EventID = &eventID
+			out.EventTitle = &eventTitle
+		}
+		notifications = append(notifications, out)
+	}
+
+	output := &NotificationListOutputDto{Notifications: notifications}
+	if page.Next != nil {
+		output.NextCursor = cursor.Encode(notificationCursorSort, page.Next.SortKey(), page.Next.ID())
+	}
+	return output, nil
+}
+
+func (u *notificationUsecase) GetUnreadCount(ctx context.Context, kratosID string) (int64, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return 0, err
+	}
+	return u.notificationRepo.CountUnread(ctx, userEntity.ID().String())
+}
+
+func (u *notificationUsecase) MarkRead(ctx context.Context, kratosID string, notificationID string) error {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+	return u.notificationRepo.MarkRead(ctx, userEntity.ID().String(), notificationID)
+}
+
+func (u *notificationUsecase) MarkAllRead(ctx context.Context, kratosID string) error {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+	return u.notificationRepo.MarkAllRead(ctx, userEntity.ID().String())
+}
+
+func (u *notificationUsecase) GetPreferences(ctx context.Context, kratosID string) ([]PreferenceDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	prefs, err := u.notificationRepo.GetPreferences(ctx, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	return toPreferenceDtos(prefs), nil
+}
+
+func (u *notificationUsecase) UpdatePreferences(ctx context.Context, kratosID string, preferences []PreferenceDto) ([]PreferenceDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	userID := userEntity.ID().String()
+
+	prefs, err := u.notificationRepo.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range preferences {
+		t, err := notificationDomain.ParseType(p.Type)
+		if err != nil {
+			return nil, err
+		}
+		prefs.Set(t, p.Enabled)
+	}
+	if err := u.notificationRepo.SavePreferences(ctx, userID, prefs); err != nil {
+		return nil, err
+	}
+	return toPreferenceDtos(prefs), nil
+}
+
+// toPreferenceDtos はすべての種類の設定を種類の一覧の順に返す
+func toPreferenceDtos(prefs *notificationDomain.Preferences) []PreferenceDto {
+	types := notificationDomain.Types()
+	dtos := make([]PreferenceDto, 0, len(types))
+	for _, t := range types {
+		dtos = append(dtos, PreferenceDto{Type: string(t), Enabled: prefs.Allows(t)})
+	}
+	return dtos
+}
+
+func decodeCursor(token string) (*pagination.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	sort, sortKey, id, err := cursor.Decode(token)
+	if err != nil || sort != notificationCursorSort {
+		return nil, domainerror.New("invalid cursor", domainerror.ErrValidation)
+	}
+	return pagination.NewCursor(sortKey, id)
+}
@@ -0,0 +1,253 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserID     = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+	testKratosID   = "2eb50f70-3a23-4067-99f6-9fd645686880"
+	testActorID    = "019b5a46-1e77-7b9d-ac62-b438a0fc89cb"
+	testRouteID    = "019b5a50-0000-7000-8000-000000000002"
+	testNotifyID   = "019b5a68-0000-7000-8000-000000000001"
+	testNotifyTime = "2024-07-01T09:00:00Z"
+)
+
+func createTestUser() *userDomain.User {
+	user, _ := userDomain.ReconstructUser(
+		userDomain.UserID(testUserID),
+		testKratosID,
+		"Test User",
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false,
+	)
+	return user
+}
+
+type notificationTestMocks struct {
+	userRepo         *userDomain.MockIUserRepository
+	notificationRepo *notificationDomain.MockINotificationRepository
+	usecase          INotificationUsecase
+	publisher        notificationDomain.Publisher
+}
+
+func setupNotificationMocks(t *testing.T) *notificationTestMocks {
+	ctrl := gomock.NewController(t)
+	m := &notificationTestMocks{
+		userRepo:         userDomain.NewMockIUserRepository(ctrl),
+		notificationRepo: notificationDomain.NewMockINotificationRepository(ctrl),
+	}
+	m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil).AnyTimes()
+	m.usecase = NewNotificationUsecase(m.userRepo, m.notificationRepo)
+	m.publisher = NewPublisher(m.notificationRepo)
+	return m
+}
+
+func Test_notificationUsecase_ListNotifications(t *testing.T) {
+	t.Parallel()
+	m := setupNotificationMocks(t)
+
+	readAt := "2024-07-02T12:00:00Z"
+	next, _ := pagination.NewCursor(1719824400, testNotifyID)
+	m.notificationRepo.EXPECT().GetNotifications(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *notificationDomain.NotificationCriteria) (*notificationDomain.NotificationPage, error) {
+		if c.UserID() != testUserID || !c.UnreadOnly() || c.Limit() != pagination.DefaultLimit || c.After() != nil {
+			t.Errorf("GetNotifications() criteria = %+v", c)
+		}
+		return &notificationDomain.NotificationPage{
+			Items: []*notificationDomain.Notification{
+				notificationDomain.ReconstructNotification("n-1", testUserID, testActorID, "Actor", notificationDomain.TypeRouteLiked, testRouteID, "多摩川", "", "", nil, testNotifyTime),
+				notificationDomain.ReconstructNotification("n-2", testUserID, testActorID, "Actor", notificationDomain.TypeUserFollowed, "", "", "", "", &readAt, testNotifyTime),
+			},
+			Next: next,
+		}, nil
+	})
+
+	got, err := m.usecase.ListNotifications(context.Background(), testKratosID, ListNotificationsInputDto{UnreadOnly: true})
+	if err != nil {
+		t.Fatalf("ListNotifications() error = %v", err)
+	}
+	if len(got.Notifications) != 2 || got.NextCursor == "" {
+		t.Fatalf("ListNotifications() = %+v", got)
+	}
+	liked := got.Notifications[0]
+	if liked.Type != "route_liked" || liked.RouteID == nil || *liked.RouteID != testRouteID || liked.RouteName == nil || *liked.RouteName != "多摩川" || liked.Read {
+		t.Errorf("ListNotifications() liked = %+v", liked)
+	}
+	// フォローの通知はルートを持たない
+	followed := got.Notifications[1]
+	if followed.Type != "user_followed" || followed.RouteID != nil || !followed.Read {
+		t.Errorf("ListNotifications() followed = %+v", followed)
+	}
+}
+
+func Test_notificationUsecase_ListNotifications_Cursor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cursor  string
+		wantErr error
+	}{
+		{name: "正常系: 前のページの続きから取得する", cursor: cursor.Encode(notificationCursorSort, 1719824400, testNotifyID)},
+		{name: "異常系: 不正なカーソル", cursor: "invalid", wantErr: domainerror.ErrValidation},
+		{name: "異常系: 他の一覧のカーソル", cursor: cursor.Encode("most_liked", 10, testNotifyID), wantErr: domainerror.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupNotificationMocks(t)
+			if tt.wantErr == nil {
+				m.notificationRepo.EXPECT().GetNotifications(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *notificationDomain.NotificationCriteria) (*notificationDomain.NotificationPage, error) {
+					if c.After() == nil || c.After().ID() != testNotifyID {
+						t.Errorf("GetNotifications() after = %+v", c.After())
+					}
+					return &notificationDomain.NotificationPage{Items: []*notificationDomain.Notification{}}, nil
+				})
+			}
+
+			got, err := m.usecase.ListNotifications(context.Background(), testKratosID, ListNotificationsInputDto{Cursor: tt.cursor})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ListNotifications() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListNotifications() error = %v", err)
+			}
+			if len(got.Notifications) != 0 || got.NextCursor != "" {
+				t.Errorf("ListNotifications() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_notificationUsecase_MarkRead(t *testing.T) {
+	t.Parallel()
+	m := setupNotificationMocks(t)
+
+	m.notificationRepo.EXPECT().MarkRead(gomock.Any(), testUserID, testNotifyID).Return(nil)
+	if err := m.usecase.MarkRead(context.Background(), testKratosID, testNotifyID); err != nil {
+		t.Errorf("MarkRead() error = %v", err)
+	}
+
+	m.notificationRepo.EXPECT().MarkAllRead(gomock.Any(), testUserID).Return(nil)
+	if err := m.usecase.MarkAllRead(context.Background(), testKratosID); err != nil {
+		t.Errorf("MarkAllRead() error = %v", err)
+	}
+
+	m.notificationRepo.EXPECT().CountUnread(gomock.Any(), testUserID).Return(int64(3), nil)
+	if got, err := m.usecase.GetUnreadCount(context.Background(), testKratosID); err != nil || got != 3 {
+		t.Errorf("GetUnreadCount() = %d, %v", got, err)
+	}
+}
+
+func Test_notificationUsecase_UpdatePreferences(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     []PreferenceDto
+		wantMuted []notificationDomain.Type
+		wantErr   error
+	}{
+		{
+			name:      "正常系: 指定した種類だけを変更する",
+			input:     []PreferenceDto{{Type: "route_liked", Enabled: true}, {Type: "route_saved", Enabled: false}},
+			wantMuted: []notificationDomain.Type{notificationDomain.TypeRouteSaved, notificationDomain.TypeUserFollowed},
+		},
+		{
+			name:    "異常系: 未知の種類",
+			input:   []PreferenceDto{{Type: "route_shared", Enabled: false}},
+			wantErr: domainerror.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupNotificationMocks(t)
+			current, _ := notificationDomain.NewPreferences([]notificationDomain.Type{notificationDomain.TypeRouteLiked, notificationDomain.TypeUserFollowed})
+			m.notificationRepo.EXPECT().GetPreferences(gomock.Any(), testUserID).Return(current, nil)
+			if tt.wantErr == nil {
+				m.notificationRepo.EXPECT().SavePreferences(gomock.Any(), testUserID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, p *notificationDomain.Preferences) error {
+					if !reflect.DeepEqual(p.Muted(), tt.wantMuted) {
+						t.Errorf("SavePreferences() muted = %v, want %v", p.Muted(), tt.wantMuted)
+					}
+					return nil
+				})
+			}
+
+			got, err := m.usecase.UpdatePreferences(context.Background(), testKratosID, tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("UpdatePreferences() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdatePreferences() error = %v", err)
+			}
+			// すべての種類の設定を返す
+			if len(got) != len(notificationDomain.Types()) || got[0] != (PreferenceDto{Type: "route_liked", Enabled: true}) {
+				t.Errorf("UpdatePreferences() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_publisher_Publish(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		activity notificationDomain.Activity
+		muted    []notificationDomain.Type
+		wantSave bool
+	}{
+		{
+			name:     "正常系: 通知を保存する",
+			activity: notificationDomain.NewRouteActivity(notificationDomain.TypeRouteForked, testActorID, testUserID, testRouteID),
+			wantSave: true,
+		},
+		{
+			name:     "正常系: 受け取らない種類は保存しない",
+			activity: notificationDomain.NewFollowActivity(testActorID, testUserID),
+			muted:    []notificationDomain.Type{notificationDomain.TypeUserFollowed},
+		},
+		{
+			name:     "正常系: 自分のルートへの反応は通知しない",
+			activity: notificationDomain.NewRouteActivity(notificationDomain.TypeRouteLiked, testUserID, testUserID, testRouteID),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupNotificationMocks(t)
+			if !tt.activity.IsSelf() {
+				prefs, _ := notificationDomain.NewPreferences(tt.muted)
+				m.notificationRepo.EXPECT().GetPreferences(gomock.Any(), testUserID).Return(prefs, nil)
+			}
+			if tt.wantSave {
+				m.notificationRepo.EXPECT().SaveNotification(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *notificationDomain.Notification) error {
+					if n.UserID() != testUserID || n.ActorID() != testActorID || n.Type() != tt.activity.Type() || n.RouteID() != testRouteID {
+						t.Errorf("SaveNotification() = %+v", n)
+					}
+					return nil
+				})
+			}
+
+			if err := m.publisher.Publish(context.Background(), tt.activity); err != nil {
+				t.Errorf("Publish() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package notification
+
+import (
+	"context"
+
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+)
+
+type publisher struct {
+	notificationRepo notificationDomain.INotificationRepository
+}
+
+// NewPublisher はActivityを受け取る相手へのアプリ内の通知として保存するPublisherを作成する
+func NewPublisher(notificationRepo notificationDomain.INotificationRepository) notificationDomain.Publisher {
+	return &publisher{notificationRepo: notificationRepo}
+}
+
+// Publish は受け取る相手の設定を確認してから通知を保存する
+func (p *publisher) Publish(ctx context.Context, activity notificationDomain.Activity) error {
+	if activity.IsSelf() {
+		return nil
+	}
+	prefs, err := p.notificationRepo.GetPreferences(ctx, activity.RecipientID())
+	if err != nil {
+		return err
+	}
+	if !prefs.Allows(activity.Type()) {
+		return nil
+	}
+
+	n, err := notificationDomain.NewNotification(activity)
+	if err != nil {
+		return err
+	}
+	return p.notificationRepo.SaveNotification(ctx, n)
+}
@@ -0,0 +1,169 @@
+package route
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+type IConvertTripUsecase interface {
+	ConvertTripToRoute(ctx context.Context, dto ConvertTripToRouteInputDto) (*CreateRouteUseCaseOutputDto, error)
+}
+
+type convertTripUsecase struct {
+	userRepository        user.IUserRepository
+	tripRepository        trip.ITripRepository
+	privacyZoneRepository user.IPrivacyZoneRepository
+	createRouteUsecase    ICreateRouteUsecase
+}
+
+func NewConvertTripUsecase(
+	userRepository user.IUserRepository,
+	tripRepository trip.ITripRepository,
+	privacyZoneRepository user.IPrivacyZoneRepository,
+	createRouteUsecase ICreateRouteUsecase,
+) IConvertTripUsecase {
+	return &convertTripUsecase{
+		userRepository:        userRepository,
+		tripRepository:        tripRepository,
+		privacyZoneRepository: privacyZoneRepository,
+		createRouteUsecase:    createRouteUsecase,
+	}
+}
+
+// 指定がない項目はトリップの値を使う
+type ConvertTripToRouteInputDto struct {
+	TripID      string
+	KratosID    string
+	Name        *string
+	Description *string
+	Visibility  *int16
+}
+
+// ConvertTripToRoute は記録したトリップから、他のユーザーがたどれるルートを作る
+// 軌跡はプライバシーゾーン内の始点・終点側を取り除いてから簡略化する
+// 記録時刻やセンサーの値はルートに含めず、距離・獲得標高と写真だけを引き継ぐ
+func (u *convertTripUsecase) ConvertTripToRoute(ctx context.Context, dto ConvertTripToRouteInputDto) (*CreateRouteUseCaseOutputDto, error) {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, dto.KratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := u.tripRepository.GetTripByID(ctx, dto.TripID)
+	if err != nil {
+		return nil, err
+	}
+	if t.UserID() != userEntity.ID().String() {
+		return nil, domainerror.New("user does not own the trip", domainerror.ErrUnauthorized)
+	}
+	var track orb.LineString
+	if t.PathGeom() != nil {
+		track, _ = t.PathGeom().Geometry.(orb.LineString)
+	}
+	if len(track) < 2 {
+		return nil, domainerror.New("trip has no track", domainerror.ErrNotFound)
+	}
+
+	zones, err := u.privacyZoneRepository.ListPrivacyZones(ctx, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	visible := user.TrimPrivacyZones(track, zones)
+	if len(visible) < 2 {
+		return nil, domainerror.New("trip is entirely within privacy zones", domainerror.ErrValidation)
+	}
+	path := routeDomain.SimplifyTrack(visible, routeDomain.DefaultTrackSimplifyToleranceM)
+
+	metrics := visibleTripMetrics(t, track, visible)
+
+	images, err := u.tripRepository.GetTripImages(ctx, t.ID())
+	if err != nil {
+		return nil, err
+	}
+	imageInputs := make([]RouteImageInput, len(images))
+	for i, img := range images {
+		imageInputs[i] = RouteImageInput{
+			S3Key:      img.S3Key(),
+			Width:      img.Width(),
+			Height:     img.Height(),
+			Size:       img.Size(),
+			Type:       img.Type(),
+			Visibility: img.Visibility(),
+		}
+	}
+
+	input := CreateRouteUseCaseInputDto{
+		KratosID:      dto.KratosID,
+		Name:          t.Name(),
+		Description:   t.Description(),
+		Distance:      metrics.distance,
+		Duration:      metrics.duration,
+		ElevationGain: metrics.elevationGain,
+		ElevationLoss: metrics.elevationLoss,
+		PathGeom:      path,
+		FirstPoint:    path[0],
+		LastPoint:     path[len(path)-1],
+		Visibility:    t.Visibility(),
+		Images:        imageInputs,
+	}
+	if dto.Name != nil {
+		input.Name = *dto.Name
+	}
+	if dto.Description != nil {
+		input.Description = *dto.Description
+	}
+	if dto.Visibility != nil {
+		input.Visibility = *dto.Visibility
+	}
+	// コースポイントは簡略化した経路から生成される
+	return u.createRouteUsecase.CreateRoute(ctx, input)
+}
+
+// tripMetrics はルートに引き継ぐトリップの計測値
+type tripMetrics struct {
+	distance      float64 // 距離(m)
+	duration      float64 // 所要時間(秒)
+	elevationGain float64 // 獲得標高(m)
+	elevationLoss float64 // 下りの標高(m)
+}
+
+// visibleTripMetrics はルートに引き継ぐ計測値を決める。所要時間は停止していた時間を除いた移動時間を使う
+// プライバシーゾーンで軌跡を削った場合は、距離を残った軌跡の長さから求め直す。
+// 軌跡は標高と時刻を持たないため、所要時間と獲得標高・下りの標高は残った軌跡の長さの割合で按分する
+func visibleTripMetrics(t *trip.Trip, track, visible orb.LineString) tripMetrics {
+	m := tripMetrics{
+		elevationGain: valueOrZero(t.ElevationGain()),
+		elevationLoss: valueOrZero(t.ElevationLoss()),
+	}
+	switch {
+	case t.MovingTime() != nil:
+		m.duration = float64(*t.MovingTime())
+	case t.Duration() != nil:
+		m.duration = float64(*t.Duration())
+	}
+
+	if len(visible) == len(track) && t.Distance() != nil {
+		m.distance = *t.Distance()
+		return m
+	}
+	m.distance = geo.Length(visible)
+	if total := geo.Length(track); total > 0 {
+		ratio := m.distance / total
+		m.duration *= ratio
+		m.elevationGain *= ratio
+		m.elevationLoss *= ratio
+	}
+	return m
+}
+
+func valueOrZero(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
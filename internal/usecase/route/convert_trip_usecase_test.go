@@ -0,0 +1,179 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	tripDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+// stubCreateRouteUsecase は作成を依頼された入力を記録する
+type stubCreateRouteUsecase struct {
+	input *CreateRouteUseCaseInputDto
+}
+
+func (s *stubCreateRouteUsecase) CreateRoute(ctx context.Context, dto CreateRouteUseCaseInputDto) (*CreateRouteUseCaseOutputDto, error) {
+	s.input = &dto
+	return &CreateRouteUseCaseOutputDto{
+		ID:         testRouteID,
+		Name:       dto.Name,
+		Distance:   dto.Distance,
+		PathGeom:   dto.PathGeom,
+		FirstPoint: dto.FirstPoint,
+		LastPoint:  dto.LastPoint,
+		Visibility: dto.Visibility,
+	}, nil
+}
+
+// createRecordedTrip は距離・時間・センサーの値を記録したトリップを作る
+func createRecordedTrip(userID string, track orb.LineString) *tripDomain.Trip {
+	t := createTestTrip(userID, nil)
+	distance, gain, loss := 600.0, 12.0, 3.0
+	duration, movingTime := int32(300), int32(240)
+	departedAt := "2024-02-01T06:30:00+09:00"
+	_ = t.SetMetrics(&tripDomain.Geometry{Geometry: track}, nil, nil, nil, &distance, &duration, &movingTime, &gain, &loss, nil, nil, &departedAt, nil, nil, nil, nil)
+	avgHr := int32(140)
+	t.SetSensorData(nil, nil, nil, &avgHr, nil, nil, nil, nil, nil, nil, nil)
+	return t
+}
+
+func Test_convertTripUsecase_ConvertTripToRoute(t *testing.T) {
+	t.Parallel()
+
+	// 自宅から東へ約600m。途中の点は1m以内の揺れ
+	track := orb.LineString{
+		{139.700, 35.680}, {139.701, 35.68001}, {139.702, 35.67999}, {139.703, 35.68001},
+		{139.704, 35.67999}, {139.705, 35.68001}, {139.7066, 35.680},
+	}
+	home := userDomain.ReconstructPrivacyZone("zone-1", testUserID, "自宅", orb.Point{139.700, 35.680}, 150, "")
+	width := int32(1024)
+	photo := tripDomain.ReconstructTripImage("img-1", testTripID, "trips/photo.jpg", &width, nil, nil, "jpg", 1, "")
+	newName := "おすすめの朝ラン"
+
+	tests := []struct {
+		name       string
+		dto        ConvertTripToRouteInputDto
+		setupMocks func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository)
+		check      func(t *testing.T, input *CreateRouteUseCaseInputDto)
+		wantErr    error
+	}{
+		{
+			name: "正常系: 軌跡を簡略化し、距離と獲得標高と写真を引き継ぐ",
+			dto:  ConvertTripToRouteInputDto{TripID: testTripID, KratosID: testKratosID},
+			setupMocks: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetTripByID(gomock.Any(), testTripID).Return(createRecordedTrip(testUserID, track), nil)
+				zoneRepo.EXPECT().ListPrivacyZones(gomock.Any(), testUserID).Return(nil, nil)
+				tripRepo.EXPECT().GetTripImages(gomock.Any(), gomock.Any()).Return([]*tripDomain.TripImage{photo}, nil)
+			},
+			check: func(t *testing.T, input *CreateRouteUseCaseInputDto) {
+				if len(input.PathGeom) != 2 || input.FirstPoint != track[0] || input.LastPoint != track[len(track)-1] {
+					t.Errorf("PathGeom = %v, want simplified to 2 points", input.PathGeom)
+				}
+				if input.Distance != 600 || input.Duration != 240 || input.ElevationGain != 12 || input.ElevationLoss != 3 {
+					t.Errorf("stats = %v, %v, %v, %v", input.Distance, input.Duration, input.ElevationGain, input.ElevationLoss)
+				}
+				if input.Name != "朝のライド" || input.Visibility != 1 {
+					t.Errorf("Name = %q, Visibility = %d", input.Name, input.Visibility)
+				}
+				if len(input.Images) != 1 || input.Images[0].S3Key != "trips/photo.jpg" || *input.Images[0].Width != 1024 {
+					t.Errorf("Images = %+v", input.Images)
+				}
+				// コースポイントは作成時にpath_geomから生成する
+				if len(input.CoursePoints) != 0 || len(input.Waypoints) != 0 {
+					t.Errorf("CoursePoints = %v, Waypoints = %v", input.CoursePoints, input.Waypoints)
+				}
+			},
+		},
+		{
+			name: "正常系: プライバシーゾーン内の始点を取り除き、距離と時間と標高を求め直す",
+			dto:  ConvertTripToRouteInputDto{TripID: testTripID, KratosID: testKratosID, Name: &newName},
+			setupMocks: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetTripByID(gomock.Any(), testTripID).Return(createRecordedTrip(testUserID, track), nil)
+				zoneRepo.EXPECT().ListPrivacyZones(gomock.Any(), testUserID).Return([]*userDomain.PrivacyZone{home}, nil)
+				tripRepo.EXPECT().GetTripImages(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			check: func(t *testing.T, input *CreateRouteUseCaseInputDto) {
+				if !input.FirstPoint.Equal(track[2]) || input.Name != newName {
+					t.Errorf("FirstPoint = %v, Name = %q", input.FirstPoint, input.Name)
+				}
+				if input.Distance > 450 || input.Distance < 400 {
+					t.Errorf("Distance = %v, want about 417", input.Distance)
+				}
+				if math.Abs(input.Duration-240*input.Distance/600) > 10 {
+					t.Errorf("Duration = %v", input.Duration)
+				}
+				// 取り除いた区間の上り下りは含めず、残った軌跡の長さの割合で按分する
+				if math.Abs(input.ElevationGain-12*input.Distance/600) > 0.5 || math.Abs(input.ElevationLoss-3*input.Distance/600) > 0.2 {
+					t.Errorf("ElevationGain = %v, ElevationLoss = %v", input.ElevationGain, input.ElevationLoss)
+				}
+			},
+		},
+		{
+			name: "異常系: 軌跡がすべてプライバシーゾーン内",
+			dto:  ConvertTripToRouteInputDto{TripID: testTripID, KratosID: testKratosID},
+			setupMocks: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetTripByID(gomock.Any(), testTripID).Return(createRecordedTrip(testUserID, track[:2]), nil)
+				zoneRepo.EXPECT().ListPrivacyZones(gomock.Any(), testUserID).Return([]*userDomain.PrivacyZone{home}, nil)
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name: "異常系: 他のユーザーのトリップ",
+			dto:  ConvertTripToRouteInputDto{TripID: testTripID, KratosID: testKratosID},
+			setupMocks: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetTripByID(gomock.Any(), testTripID).Return(createRecordedTrip("other-user-id", track), nil)
+			},
+			wantErr: domainerror.ErrUnauthorized,
+		},
+		{
+			name: "異常系: 軌跡のないトリップ",
+			dto:  ConvertTripToRouteInputDto{TripID: testTripID, KratosID: testKratosID},
+			setupMocks: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetTripByID(gomock.Any(), testTripID).Return(createTestTrip(testUserID, nil), nil)
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			tripRepo := tripDomain.NewMockITripRepository(ctrl)
+			zoneRepo := userDomain.NewMockIPrivacyZoneRepository(ctrl)
+			tt.setupMocks(userRepo, tripRepo, zoneRepo)
+			createRoute := &stubCreateRouteUsecase{}
+
+			uc := NewConvertTripUsecase(userRepo, tripRepo, zoneRepo, createRoute)
+			got, err := uc.ConvertTripToRoute(context.Background(), tt.dto)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ConvertTripToRoute() error = %v, want %v", err, tt.wantErr)
+				}
+				if createRoute.input != nil {
+					t.Error("route should not be created")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConvertTripToRoute() error = %v", err)
+			}
+			if got.ID != testRouteID {
+				t.Errorf("ID = %s", got.ID)
+			}
+			tt.check(t, createRoute.input)
+		})
+	}
+}
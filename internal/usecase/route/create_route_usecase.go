@@ -3,6 +3,8 @@ package route
 import (
 	"context"
 
+	feedDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/feed"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
 	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
 	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
 	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
@@ -18,12 +20,16 @@ type ICreateRouteUsecase interface {
 type createRouteUsecase struct {
 	userRepository user.IUserRepository
 	txManager      transaction.TransactionManager
+	geocoder       place.Geocoder
+	clubs          routeDomain.ClubMembershipReader
 }
 
-func NewCreateRouteUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager) ICreateRouteUsecase {
+func NewCreateRouteUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, geocoder place.Geocoder, clubs routeDomain.ClubMembershipReader) ICreateRouteUsecase {
 	return &createRouteUsecase{
 		userRepository: userRepository,
 		txManager:      txManager,
+		geocoder:       geocoder,
+		clubs:          clubs,
 	}
 }
 
@@ -44,6 +50,16 @@ type WaypointInput struct {
 	Location orb.Point
 }
 
+// RouteImageInput はS3等に保存済みの写真
+type RouteImageInput struct {
+	S3Key      string
+	Width      *int32
+	Height     *int32
+	Size       *int64
+	Type       string
+	Visibility int16
+}
+
 type CreateRouteUseCaseInputDto struct {
 	KratosID           string
 	Name               string
@@ -57,8 +73,10 @@ type CreateRouteUseCaseInputDto struct {
 	FirstPoint         orb.Point
 	LastPoint          orb.Point
 	Visibility         int16
+	ClubID             *string // 公開範囲がクラブのメンバーのみの場合の共有先
 	CoursePoints       []CoursePointInput
 	Waypoints          []WaypointInput
+	Images             []RouteImageInput
 }
 
 type CreateRouteUseCaseOutputDto struct {
@@ -76,6 +94,7 @@ type CreateRouteUseCaseOutputDto struct {
 	LastPoint          orb.Point
 	Polyline           string
 	Visibility         int16
+	ClubID             *string
 }
 
 func (u *createRouteUsecase) CreateRoute(ctx context.Context, dto CreateRouteUseCaseInputDto) (*CreateRouteUseCaseOutputDto, error) {
@@ -104,6 +123,15 @@ func (u *createRouteUsecase) CreateRoute(ctx context.Context, dto CreateRouteUse
 		return nil, err
 	}
 
+	// クラブのメンバーのみにする場合は、作成者が所属するクラブかを確かめる
+	owner, err := routeDomain.ResolveViewer(ctx, u.clubs, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	if err := route.ChangeVisibility(owner, dto.Visibility, dto.ClubID); err != nil {
+		return nil, err
+	}
+
 	// Waypointsの追加
 	for _, wp := range dto.Waypoints {
 		if err := route.AddWaypoint(routeDomain.Geometry{Geometry: wp.Location}); err != nil {
@@ -130,11 +158,44 @@ func (u *createRouteUsecase) CreateRoute(ctx context.Context, dto CreateRouteUse
 		}
 	}
 
+	// コースポイントが送られてこない場合（GPXから取り込んだルートなど）はpath_geomから生成する
+	if len(dto.CoursePoints) == 0 {
+		if err := routeDomain.NewDefaultCueGenerator().GenerateCoursePoints(route, cueLanguage("", userEntity)); err != nil {
+			return nil, err
+		}
+	}
+
+	// 出発地点と目的地の地名
+	if err := route.ResolvePlaces(ctx, u.geocoder); err != nil {
+		return nil, err
+	}
+
+	images := make([]*routeDomain.RouteImage, 0, len(dto.Images))
+	for _, img := range dto.Images {
+		image, err := routeDomain.NewRouteImage(img.S3Key, img.Width, img.Height, img.Size, img.Type, img.Visibility)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, image)
+	}
+
 	// トランザクション内でリポジトリ操作を実行
 	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
 		// トランザクション用のQueriesでリポジトリを作成
 		routeRepo := repository.NewRouteRepository(q)
-		return routeRepo.SaveRoute(ctx, route)
+		if err := routeRepo.SaveRoute(ctx, route); err != nil {
+			return err
+		}
+		if err := refreshRouteDifficulty(ctx, routeRepo, route); err != nil {
+			return err
+		}
+		if err := recordRouteCreated(ctx, q, route); err != nil {
+			return err
+		}
+		if len(images) == 0 {
+			return nil
+		}
+		return routeRepo.SaveRouteImages(ctx, route.ID(), images)
 	})
 
 	if err != nil {
@@ -142,7 +203,38 @@ func (u *createRouteUsecase) CreateRoute(ctx context.Context, dto CreateRouteUse
 	}
 
 	// 出力DTOの作成
-	return &CreateRouteUseCaseOutputDto{
+	output := toCreateRouteOutputDto(route)
+	return &output, nil
+}
+
+// recordRouteCreated はルートの作成をフォロワーのフィードに表示する出来事として記録する
+// 公開範囲は閲覧時に判定するため、非公開のルートも記録しておく
+func recordRouteCreated(ctx context.Context, q *dbgen.Queries, route *routeDomain.Route) error {
+	event, err := feedDomain.NewEvent(feedDomain.EventTypeRouteCreated, route.UserID(), route.ID())
+	if err != nil {
+		return err
+	}
+	return repository.NewFeedRepository(q).SaveEvent(ctx, event)
+}
+
+// refreshRouteDifficulty は保存した経路の路面の内訳と勾配から難易度を求め直して保存する
+// 路面の内訳は保存時にリポジトリが道路網と照合して作るため、SaveRoute・UpdateRouteの後に呼ぶ
+func refreshRouteDifficulty(ctx context.Context, routeRepo routeDomain.IRouteRepository, route *routeDomain.Route) error {
+	breakdown, err := routeRepo.GetSurfaceBreakdown(ctx, route.ID())
+	if err != nil {
+		return err
+	}
+	grades, err := routeRepo.GetRouteGrades(ctx, route.ID())
+	if err != nil {
+		return err
+	}
+	route.RefreshDifficulty(breakdown, grades)
+	return routeRepo.SaveRouteDifficulty(ctx, route)
+}
+
+// toCreateRouteOutputDto は保存したルートから作成結果の出力DTOを作る
+func toCreateRouteOutputDto(route *routeDomain.Route) CreateRouteUseCaseOutputDto {
+	return CreateRouteUseCaseOutputDto{
 		ID:                 route.ID(),
 		UserID:             route.UserID(),
 		Name:               route.Name(),
@@ -157,6 +249,7 @@ func (u *createRouteUsecase) CreateRoute(ctx context.Context, dto CreateRouteUse
 		LastPoint:          route.LastPoint().Geometry.(orb.Point),
 		Polyline:           route.Polyline(),
 		Visibility:         route.Visibility(),
-	}, nil
+		ClubID:             route.ClubID(),
+	}
 }
 
@@ -0,0 +1,138 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cuesheet"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+)
+
+// キューシートの出力形式
+type CueSheetFormat string
+
+const (
+	CueSheetFormatCSV CueSheetFormat = "csv"
+	CueSheetFormatPDF CueSheetFormat = "pdf"
+)
+
+type ICueSheetUsecase interface {
+	ExportCueSheet(ctx context.Context, dto ExportCueSheetInputDto) ([]byte, error)
+	ImportCueSheet(ctx context.Context, dto ImportCueSheetInputDto) ([]CoursePointOutput, error)
+}
+
+type cueSheetUsecase struct {
+	userRepository user.IUserRepository
+	txManager      transaction.TransactionManager
+	routeRepo      routeDomain.IRouteRepository
+	clubs          routeDomain.ClubMembershipReader
+}
+
+func NewCueSheetUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository, clubs routeDomain.ClubMembershipReader) ICueSheetUsecase {
+	return &cueSheetUsecase{
+		userRepository: userRepository,
+		txManager:      txManager,
+		routeRepo:      routeRepo,
+		clubs:          clubs,
+	}
+}
+
+type ExportCueSheetInputDto struct {
+	RouteID  string
+	KratosID string
+	Format   CueSheetFormat
+	Unit     string // km または mi。空の場合はkm
+}
+
+type ImportCueSheetInputDto struct {
+	RouteID         string
+	KratosID        string
+	ExpectedVersion int32     // ルート取得時のバージョン
+	CSV             io.Reader // ExportCueSheet で書き出したCSV
+}
+
+// ExportCueSheet はルートのコースポイントを印刷用のキューシートとして書き出す
+// 見出しと補う案内文はログインユーザーのロケールの言語にする
+func (u *cueSheetUsecase) ExportCueSheet(ctx context.Context, dto ExportCueSheetInputDto) ([]byte, error) {
+	unit, err := cuesheet.ParseUnit(dto.Unit)
+	if err != nil {
+		return nil, domainerror.New(err.Error(), domainerror.ErrValidation)
+	}
+
+	route, viewer, err := getVisibleRoute(ctx, u.userRepository, u.routeRepo, u.clubs, dto.RouteID, dto.KratosID)
+	if err != nil {
+		return nil, err
+	}
+	// 見出しの言語を決めるため、閲覧ユーザーのロケールを使う
+	userEntity, err := u.userRepository.GetUserByID(ctx, viewer.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := cuesheet.FromRoute(route, cueLanguage("", userEntity), unit)
+	var buf bytes.Buffer
+	switch dto.Format {
+	case CueSheetFormatCSV:
+		err = cuesheet.WriteCSV(&buf, sheet)
+	case CueSheetFormatPDF:
+		err = cuesheet.WritePDF(&buf, sheet)
+	default:
+		return nil, domainerror.New("unsupported cue sheet format", domainerror.ErrValidation)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ImportCueSheet は編集したキューシートのCSVでコースポイントを置き換える
+// 置き換え前の状態は版として残す
+func (u *cueSheetUsecase) ImportCueSheet(ctx context.Context, dto ImportCueSheetInputDto) ([]CoursePointOutput, error) {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, dto.KratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := u.routeRepo.GetRouteByID(ctx, dto.RouteID)
+	if err != nil {
+		return nil, err
+	}
+	if route.UserID() != userEntity.ID().String() {
+		return nil, domainerror.New("user does not own the route", domainerror.ErrUnauthorized)
+	}
+	if err := route.CheckVersion(dto.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	entries, err := cuesheet.ParseCSV(dto.CSV)
+	if err != nil {
+		return nil, domainerror.New(err.Error(), domainerror.ErrValidation)
+	}
+
+	current, err := routeDomain.NewRouteVersion(route, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	if err := route.ImportCueSheet(entries); err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		routeRepo := repository.NewRouteRepository(q)
+		if err := routeRepo.SaveRouteVersion(ctx, current); err != nil {
+			return err
+		}
+		return routeRepo.UpdateRoute(ctx, route)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCoursePointOutputs(route.CoursePoints()), nil
+}
@@ -0,0 +1,199 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+// 東へ進んで北へ左折するキュー付きのルート
+func newTestRouteWithCues(t *testing.T) *routeDomain.Route {
+	t.Helper()
+	r := newTestRouteWithPath(t, testRouteID, orb.LineString{{139.70, 35.68}, {139.705, 35.68}, {139.705, 35.685}})
+	if err := routeDomain.NewDefaultCueGenerator().GenerateCoursePoints(r, routeDomain.CueLanguageJa); err != nil {
+		t.Fatalf("GenerateCoursePoints() error = %v", err)
+	}
+	return r
+}
+
+func Test_cueSheetUsecase_ExportCueSheet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		dto        ExportCueSheetInputDto
+		setupMocks func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository)
+		wantPrefix string
+		wantText   string
+		wantErr    error
+	}{
+		{
+			name: "正常系: CSVをマイル表記で出力する",
+			dto:  ExportCueSheetInputDto{RouteID: testRouteID, KratosID: testKratosID, Format: CueSheetFormatCSV, Unit: "mi"},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithCues(t), nil)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), testUserID).Return(createTestUser(), nil)
+			},
+			wantPrefix: "\ufeffNo.",
+			wantText:   "累積距離(mi)",
+		},
+		{
+			name: "正常系: PDFを出力する",
+			dto:  ExportCueSheetInputDto{RouteID: testRouteID, KratosID: testKratosID, Format: CueSheetFormatPDF},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithCues(t), nil)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), testUserID).Return(createTestUser(), nil)
+			},
+			wantPrefix: "%PDF-1.4",
+		},
+		{
+			name: "異常系: 未対応の単位",
+			dto:  ExportCueSheetInputDto{RouteID: testRouteID, KratosID: testKratosID, Format: CueSheetFormatCSV, Unit: "m"},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository) {
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name: "異常系: 他のユーザーの非公開ルート",
+			dto:  ExportCueSheetInputDto{RouteID: testRouteID, KratosID: testKratosID, Format: CueSheetFormatCSV},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestForkSourceRoute(routeDomain.VisibilityPrivate), nil)
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			routeRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			txManager := transactionApp.NewMockTransactionManager(ctrl)
+			tt.setupMocks(t, routeRepo, userRepo)
+
+			uc := NewCueSheetUsecase(userRepo, txManager, routeRepo, newTestClubReader(ctrl))
+			got, err := uc.ExportCueSheet(context.Background(), tt.dto)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ExportCueSheet() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ExportCueSheet() error = %v", err)
+			}
+			if !bytes.HasPrefix(got, []byte(tt.wantPrefix)) {
+				t.Errorf("ExportCueSheet() = %.20q..., want prefix %q", got, tt.wantPrefix)
+			}
+			if !bytes.Contains(got, []byte(tt.wantText)) {
+				t.Errorf("ExportCueSheet() does not contain %q", tt.wantText)
+			}
+		})
+	}
+}
+
+func Test_cueSheetUsecase_ImportCueSheet(t *testing.T) {
+	t.Parallel()
+
+	// 出発・左折・到着の3行。左折の案内文は書き換えてある
+	csv := "No.,方向,累積距離(km),区間距離(km),案内,道路名,maneuver_type,modifier,lat,lon,bearing_before,bearing_after\n" +
+		"1,●,0.000,0.452,出発します,,depart,,35.680000,139.700000,,90\n" +
+		"2,←,0.452,0.556,信号を左折,明治通り,turn,left,35.680000,139.705000,90,0\n" +
+		"3,◎,1.008,0.000,到着です,,arrive,,35.685000,139.705000,0,\n"
+
+	tests := []struct {
+		name            string
+		expectedVersion int32
+		csv             string
+		setupMocks      func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager)
+		wantErr         error
+	}{
+		{
+			name:            "正常系: CSVでコースポイントを置き換える",
+			expectedVersion: 1,
+			csv:             csv,
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithCues(t), nil)
+				txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:            "異常系: バージョンが古い",
+			expectedVersion: 0,
+			csv:             csv,
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithCues(t), nil)
+			},
+			wantErr: domainerror.ErrConflict,
+		},
+		{
+			name:            "異常系: 位置の列がない",
+			expectedVersion: 1,
+			csv:             "No.,累積距離(km)\n1,0.000\n",
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithCues(t), nil)
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name:            "異常系: ルートの所有者ではない",
+			expectedVersion: 1,
+			csv:             csv,
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute("different-user-id"), nil)
+			},
+			wantErr: domainerror.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			routeRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			txManager := transactionApp.NewMockTransactionManager(ctrl)
+			tt.setupMocks(t, routeRepo, userRepo, txManager)
+
+			uc := NewCueSheetUsecase(userRepo, txManager, routeRepo, newTestClubReader(ctrl))
+			got, err := uc.ImportCueSheet(context.Background(), ImportCueSheetInputDto{
+				RouteID:         testRouteID,
+				KratosID:        testKratosID,
+				ExpectedVersion: tt.expectedVersion,
+				CSV:             strings.NewReader(tt.csv),
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ImportCueSheet() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ImportCueSheet() error = %v", err)
+			}
+			if len(got) != 3 {
+				t.Fatalf("len = %d, want 3", len(got))
+			}
+			if *got[1].Instruction != "信号を左折" || *got[1].RoadName != "明治通り" {
+				t.Errorf("got[1] = %s / %s, want 信号を左折 / 明治通り", *got[1].Instruction, *got[1].RoadName)
+			}
+		})
+	}
+}
@@ -52,7 +52,7 @@ func Test_deleteRouteUsecase_DeleteRoute(t *testing.T) {
 					nil, 1000, 3600, 100, 50,
 					routeDomain.Geometry{}, routeDomain.Geometry{},
 					routeDomain.Geometry{}, routeDomain.Geometry{},
-					"", 0, "", "",
+					"", 0, 1, nil, "", "",
 				)
 				mockRouteRepo.EXPECT().
 					GetRouteByID(gomock.Any(), "019b5a50-0000-7000-8000-000000000001").
@@ -133,7 +133,7 @@ func Test_deleteRouteUsecase_DeleteRoute(t *testing.T) {
 					nil, 1000, 3600, 100, 50,
 					routeDomain.Geometry{}, routeDomain.Geometry{},
 					routeDomain.Geometry{}, routeDomain.Geometry{},
-					"", 0, "", "",
+					"", 0, 1, nil, "", "",
 				)
 				mockRouteRepo.EXPECT().
 					GetRouteByID(gomock.Any(), "019b5a50-0000-7000-8000-000000000001").
@@ -168,7 +168,7 @@ func Test_deleteRouteUsecase_DeleteRoute(t *testing.T) {
 					nil, 1000, 3600, 100, 50,
 					routeDomain.Geometry{}, routeDomain.Geometry{},
 					routeDomain.Geometry{}, routeDomain.Geometry{},
-					"", 0, "", "",
+					"", 0, 1, nil, "", "",
 				)
 				mockRouteRepo.EXPECT().
 					GetRouteByID(gomock.Any(), "019b5a50-0000-7000-8000-000000000001").
@@ -0,0 +1,170 @@
+package route
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+)
+
+// IEditRouteGeometryUsecase はルートの形状をサーバー側で編集する
+// 編集前の状態は更新と同じく版として残す
+type IEditRouteGeometryUsecase interface {
+	ReverseRoute(ctx context.Context, routeID string, kratosID string) error
+	TrimRoute(ctx context.Context, dto TrimRouteUseCaseInputDto) error
+	SplitRoute(ctx context.Context, dto SplitRouteUseCaseInputDto) (*CreateRouteUseCaseOutputDto, error)
+	JoinRoutes(ctx context.Context, dto JoinRoutesUseCaseInputDto) error
+}
+
+type editRouteGeometryUsecase struct {
+	userRepository user.IUserRepository
+	txManager      transaction.TransactionManager
+	routeRepo      routeDomain.IRouteRepository
+	geocoder       place.Geocoder
+}
+
+func NewEditRouteGeometryUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository, geocoder place.Geocoder) IEditRouteGeometryUsecase {
+	return &editRouteGeometryUsecase{
+		userRepository: userRepository,
+		txManager:      txManager,
+		routeRepo:      routeRepo,
+		geocoder:       geocoder,
+	}
+}
+
+type TrimRouteUseCaseInputDto struct {
+	RouteID       string
+	KratosID      string
+	StartDistance float64 // 残す区間の始点(m)
+	EndDistance   float64 // 残す区間の終点(m)
+}
+
+type SplitRouteUseCaseInputDto struct {
+	RouteID  string
+	KratosID string
+	Point    orb.Point // 分割する地点。経路上の最も近い地点で分割する
+	Name     string    // 後半のルートの名前。空の場合は元の名前から付ける
+}
+
+type JoinRoutesUseCaseInputDto struct {
+	RouteID      string
+	KratosID     string
+	OtherRouteID string // 終点の後ろにつなげるルート
+}
+
+func (u *editRouteGeometryUsecase) ReverseRoute(ctx context.Context, routeID string, kratosID string) error {
+	_, err := u.editRoute(ctx, routeID, kratosID, func(route *routeDomain.Route) (*routeDomain.Route, error) {
+		return nil, route.Reverse()
+	})
+	return err
+}
+
+func (u *editRouteGeometryUsecase) TrimRoute(ctx context.Context, dto TrimRouteUseCaseInputDto) error {
+	_, err := u.editRoute(ctx, dto.RouteID, dto.KratosID, func(route *routeDomain.Route) (*routeDomain.Route, error) {
+		return nil, route.Trim(dto.StartDistance, dto.EndDistance)
+	})
+	return err
+}
+
+// SplitRoute はルートを2つに分割し、後半を新しいルートとして保存する
+func (u *editRouteGeometryUsecase) SplitRoute(ctx context.Context, dto SplitRouteUseCaseInputDto) (*CreateRouteUseCaseOutputDto, error) {
+	latter, err := u.editRoute(ctx, dto.RouteID, dto.KratosID, func(route *routeDomain.Route) (*routeDomain.Route, error) {
+		return route.SplitAt(routeDomain.Geometry{Geometry: dto.Point}, dto.Name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := toCreateRouteOutputDto(latter)
+	return &output, nil
+}
+
+// JoinRoutes は自分の2つのルートをつなげる。つなげた側のルートはそのまま残す
+func (u *editRouteGeometryUsecase) JoinRoutes(ctx context.Context, dto JoinRoutesUseCaseInputDto) error {
+	_, err := u.editRoute(ctx, dto.RouteID, dto.KratosID, func(route *routeDomain.Route) (*routeDomain.Route, error) {
+		other, err := u.routeRepo.GetRouteByID(ctx, dto.OtherRouteID)
+		if err != nil {
+			return nil, err
+		}
+		if other.UserID() != route.UserID() {
+			return nil, domainerror.New("user does not own the route", domainerror.ErrUnauthorized)
+		}
+		return nil, route.Join(other)
+	})
+	return err
+}
+
+// editRoute は所有者のルートに edit を適用して保存する
+// edit が新しいルートを返した場合は同じトランザクションで保存する
+func (u *editRouteGeometryUsecase) editRoute(
+	ctx context.Context,
+	routeID string,
+	kratosID string,
+	edit func(route *routeDomain.Route) (*routeDomain.Route, error)) (*routeDomain.Route, error) {
+
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := u.routeRepo.GetRouteByID(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	if route.UserID() != userEntity.ID().String() {
+		return nil, domainerror.New("user does not own the route", domainerror.ErrUnauthorized)
+	}
+
+	current, err := routeDomain.NewRouteVersion(route, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	created, err := edit(route)
+	if err != nil {
+		return nil, err
+	}
+
+	// 出発地点・目的地が変わるため地名を求め直す
+	if err := route.ResolvePlaces(ctx, u.geocoder); err != nil {
+		return nil, err
+	}
+	if created != nil {
+		if err := created.ResolvePlaces(ctx, u.geocoder); err != nil {
+			return nil, err
+		}
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		routeRepo := repository.NewRouteRepository(q)
+		if err := routeRepo.SaveRouteVersion(ctx, current); err != nil {
+			return err
+		}
+		if err := routeRepo.UpdateRoute(ctx, route); err != nil {
+			return err
+		}
+		if err := refreshRouteDifficulty(ctx, routeRepo, route); err != nil {
+			return err
+		}
+		if created != nil {
+			// 分割した後半は新しいルートとしてフォロワーのフィードにも表示する
+			if err := routeRepo.SaveRoute(ctx, created); err != nil {
+				return err
+			}
+			if err := refreshRouteDifficulty(ctx, routeRepo, created); err != nil {
+				return err
+			}
+			return recordRouteCreated(ctx, q, created)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
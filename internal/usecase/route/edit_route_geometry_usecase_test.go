@@ -0,0 +1,200 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	placeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+const testOtherRouteID = "019b5a50-0000-7000-8000-000000000002"
+
+var testEditPath = orb.LineString{{139.70, 35.68}, {139.71, 35.68}, {139.72, 35.68}}
+
+type editRouteGeometryTestMocks struct {
+	mockRouteRepo *routeDomain.MockIRouteRepository
+	mockUserRepo  *userDomain.MockIUserRepository
+	mockTxManager *transactionApp.MockTransactionManager
+	mockGeocoder  *placeDomain.MockGeocoder
+	usecase       IEditRouteGeometryUsecase
+}
+
+func setupEditRouteGeometryMocks(t *testing.T) *editRouteGeometryTestMocks {
+	ctrl := gomock.NewController(t)
+	mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+	mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+	mockTxManager := transactionApp.NewMockTransactionManager(ctrl)
+	mockGeocoder := placeDomain.NewMockGeocoder(ctrl)
+
+	return &editRouteGeometryTestMocks{
+		mockRouteRepo: mockRouteRepo,
+		mockUserRepo:  mockUserRepo,
+		mockTxManager: mockTxManager,
+		mockGeocoder:  mockGeocoder,
+		usecase:       NewEditRouteGeometryUsecase(mockUserRepo, mockTxManager, mockRouteRepo, mockGeocoder),
+	}
+}
+
+func Test_editRouteGeometryUsecase_ReverseRoute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setupMocks func(t *testing.T, m *editRouteGeometryTestMocks)
+		wantErr    error
+	}{
+		{
+			name: "正常系: ルートを反転する",
+			setupMocks: func(t *testing.T, m *editRouteGeometryTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithPath(t, testRouteID, testEditPath), nil)
+				m.mockGeocoder.EXPECT().ReverseGeocode(gomock.Any(), gomock.Any()).Return(testKyotoPlace, nil).Times(2)
+				m.mockTxManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "異常系: ルートの所有者ではない",
+			setupMocks: func(t *testing.T, m *editRouteGeometryTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute("different-user-id"), nil)
+			},
+			wantErr: domainerror.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupEditRouteGeometryMocks(t)
+			tt.setupMocks(t, m)
+
+			err := m.usecase.ReverseRoute(context.Background(), testRouteID, testKratosID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("ReverseRoute() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ReverseRoute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_editRouteGeometryUsecase_TrimRoute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("異常系: 範囲が不正な場合は保存しない", func(t *testing.T) {
+		t.Parallel()
+		m := setupEditRouteGeometryMocks(t)
+		m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithPath(t, testRouteID, testEditPath), nil)
+
+		err := m.usecase.TrimRoute(context.Background(), TrimRouteUseCaseInputDto{
+			RouteID:       testRouteID,
+			KratosID:      testKratosID,
+			StartDistance: 1000,
+			EndDistance:   500,
+		})
+		if !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("TrimRoute() error = %v, want ErrValidation", err)
+		}
+	})
+}
+
+func Test_editRouteGeometryUsecase_SplitRoute(t *testing.T) {
+	t.Parallel()
+
+	m := setupEditRouteGeometryMocks(t)
+	m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+	m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithPath(t, testRouteID, testEditPath), nil)
+	m.mockGeocoder.EXPECT().ReverseGeocode(gomock.Any(), gomock.Any()).Return(testKyotoPlace, nil).Times(4)
+	m.mockTxManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+	got, err := m.usecase.SplitRoute(context.Background(), SplitRouteUseCaseInputDto{
+		RouteID:  testRouteID,
+		KratosID: testKratosID,
+		Point:    orb.Point{139.71, 35.6801},
+	})
+	if err != nil {
+		t.Fatalf("SplitRoute() error = %v", err)
+	}
+	if got.ID == testRouteID || got.Name != "Test Route (2)" {
+		t.Errorf("ID/Name = %s/%s, want a new route named Test Route (2)", got.ID, got.Name)
+	}
+	if got.FirstPoint != testEditPath[1] || got.LastPoint != testEditPath[2] {
+		t.Errorf("FirstPoint/LastPoint = %v/%v, want %v/%v", got.FirstPoint, got.LastPoint, testEditPath[1], testEditPath[2])
+	}
+}
+
+func Test_editRouteGeometryUsecase_JoinRoutes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setupMocks func(t *testing.T, m *editRouteGeometryTestMocks)
+		wantErr    error
+	}{
+		{
+			name: "正常系: 2つのルートをつなげる",
+			setupMocks: func(t *testing.T, m *editRouteGeometryTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithPath(t, testRouteID, testEditPath), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testOtherRouteID).
+					Return(newTestRouteWithPath(t, testOtherRouteID, orb.LineString{{139.72, 35.68}, {139.72, 35.69}}), nil)
+				m.mockGeocoder.EXPECT().ReverseGeocode(gomock.Any(), gomock.Any()).Return(testKyotoPlace, nil).Times(2)
+				m.mockTxManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "異常系: つなげるルートの所有者ではない",
+			setupMocks: func(t *testing.T, m *editRouteGeometryTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithPath(t, testRouteID, testEditPath), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testOtherRouteID).Return(createTestRoute("different-user-id"), nil)
+			},
+			wantErr: domainerror.ErrUnauthorized,
+		},
+		{
+			name: "異常系: つなげるルートが見つからない",
+			setupMocks: func(t *testing.T, m *editRouteGeometryTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithPath(t, testRouteID, testEditPath), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testOtherRouteID).
+					Return(nil, domainerror.New("route not found", domainerror.ErrNotFound))
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupEditRouteGeometryMocks(t)
+			tt.setupMocks(t, m)
+
+			err := m.usecase.JoinRoutes(context.Background(), JoinRoutesUseCaseInputDto{
+				RouteID:      testRouteID,
+				KratosID:     testKratosID,
+				OtherRouteID: testOtherRouteID,
+			})
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("JoinRoutes() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("JoinRoutes() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,34 +4,76 @@ import (
 	"context"
 
 	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
 	gpxpkg "github.com/YukiAminaka/cycle-route-backend/internal/pkg/gpx"
 	"github.com/tkrajina/gpxgo/gpx"
 )
 
 type IExportGPXUsecase interface {
-	ExportGPX(vtx context.Context, routeID string) ([]byte, error)
+	// kratosIDが空の場合は未ログインのユーザーとして扱い、公開のルートだけを出力する
+	ExportGPX(ctx context.Context, routeID string, kratosID string) ([]byte, error)
 }
 
 type exportGPXUsecase struct {
 	routeRepo routeDomain.IRouteRepository
+	userRepo  user.IUserRepository
+	clubs     routeDomain.ClubMembershipReader
 }
 
-func NewExportGPXUsecase(routeRepo routeDomain.IRouteRepository) IExportGPXUsecase {
+func NewExportGPXUsecase(routeRepo routeDomain.IRouteRepository, userRepo user.IUserRepository, clubs routeDomain.ClubMembershipReader) IExportGPXUsecase {
 	return &exportGPXUsecase{
 		routeRepo: routeRepo,
+		userRepo:  userRepo,
+		clubs:     clubs,
 	}
 }
 
-func (u *exportGPXUsecase) ExportGPX(ctx context.Context, routeID string) ([]byte, error) {
-	route, err := u.routeRepo.GetRouteByID(ctx, routeID)
+func (u *exportGPXUsecase) ExportGPX(ctx context.Context, routeID string, kratosID string) ([]byte, error) {
+	route, viewer, err := getVisibleRoute(ctx, u.userRepo, u.routeRepo, u.clubs, routeID, kratosID)
 	if err != nil {
 		return nil, err
 	}
 
-	gpxData, err := gpxpkg.RouteToGPX(route)
+	author, err := u.userRepo.GetUserByID(ctx, route.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	attribution, err := u.forkAttribution(ctx, route, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	gpxData, err := gpxpkg.RouteToGPX(route, author.Name(), attribution)
 	if err != nil {
 		return nil, err
 	}
 
 	return gpxData.ToXml(gpx.ToXmlParams{Version: "1.1", Indent: true})
 }
+
+// forkAttribution はフォークしたルートのフォーク元の名前と作成者を取得する
+// フォーク元が削除されている場合は forked_from_route_id がNULLになるため記載しない
+// フォーク元を閲覧ユーザーが見られない場合も、名前と作成者を明かさないよう記載しない
+func (u *exportGPXUsecase) forkAttribution(ctx context.Context, route *routeDomain.Route, viewer routeDomain.Viewer) (*gpxpkg.Attribution, error) {
+	if route.ForkedFromRouteID() == nil {
+		return nil, nil
+	}
+
+	original, err := u.routeRepo.GetRouteByID(ctx, *route.ForkedFromRouteID())
+	if err != nil {
+		return nil, err
+	}
+	if !original.IsVisibleTo(viewer) {
+		return nil, nil
+	}
+	originalAuthor, err := u.userRepo.GetUserByID(ctx, original.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &gpxpkg.Attribution{
+		RouteName:  original.Name(),
+		AuthorName: originalAuthor.Name(),
+	}, nil
+}
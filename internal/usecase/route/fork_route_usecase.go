@@ -0,0 +1,81 @@
+package route
+
+import (
+	"context"
+
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+)
+
+type IForkRouteUsecase interface {
+	ForkRoute(ctx context.Context, routeID string, kratosID string) (*ForkRouteUseCaseOutputDto, error)
+}
+
+type forkRouteUsecase struct {
+	userRepository user.IUserRepository
+	txManager      transaction.TransactionManager
+	routeRepo      routeDomain.IRouteRepository
+	clubs          routeDomain.ClubMembershipReader
+	publisher      notificationDomain.Publisher
+}
+
+func NewForkRouteUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository, clubs routeDomain.ClubMembershipReader, publisher notificationDomain.Publisher) IForkRouteUsecase {
+	return &forkRouteUsecase{
+		userRepository: userRepository,
+		txManager:      txManager,
+		routeRepo:      routeRepo,
+		clubs:          clubs,
+		publisher:      publisher,
+	}
+}
+
+type ForkRouteUseCaseOutputDto struct {
+	CreateRouteUseCaseOutputDto
+	ForkedFromRouteID string
+}
+
+// ForkRoute は閲覧できるルートを複製し、ログインユーザーのルートとして保存する。フォーク元の所有者に通知する
+func (u *forkRouteUsecase) ForkRoute(ctx context.Context, routeID string, kratosID string) (*ForkRouteUseCaseOutputDto, error) {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer, err := routeDomain.ResolveViewer(ctx, u.clubs, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	original, err := u.routeRepo.GetRouteByID(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+
+	forked, err := original.Fork(viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		routeRepo := repository.NewRouteRepository(q)
+		if err := routeRepo.SaveRoute(ctx, forked); err != nil {
+			return err
+		}
+		if err := refreshRouteDifficulty(ctx, routeRepo, forked); err != nil {
+			return err
+		}
+		return recordRouteCreated(ctx, q, forked)
+	})
+	if err != nil {
+		return nil, err
+	}
+	publishActivity(ctx, u.publisher, notificationDomain.NewRouteActivity(notificationDomain.TypeRouteForked, viewer.UserID(), original.UserID(), original.ID()))
+
+	return &ForkRouteUseCaseOutputDto{
+		CreateRouteUseCaseOutputDto: toCreateRouteOutputDto(forked),
+		ForkedFromRouteID:           original.ID(),
+	}, nil
+}
@@ -0,0 +1,139 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+// テスト用の他のユーザーが所有するルート
+func createTestForkSourceRoute(visibility int16) *routeDomain.Route {
+	path := orb.LineString{{139.0, 35.0}, {139.1, 35.1}}
+	route, _ := routeDomain.ReconstructRoute(
+		testRouteID,
+		testSourceOwnerID,
+		testRouteName,
+		testRouteDesc,
+		nil,
+		testDistance,
+		testDuration,
+		testElevationGain,
+		testElevationLoss,
+		routeDomain.Geometry{Geometry: path},
+		routeDomain.Geometry{Geometry: path.Bound().ToPolygon()},
+		routeDomain.Geometry{Geometry: path[0]},
+		routeDomain.Geometry{Geometry: path[1]},
+		testPolyline, visibility, 1, nil, "", "",
+	)
+	return route
+}
+
+const testClubID = "019b5a66-0000-7000-8000-000000000001"
+
+// testSourceOwnerID はフォーク元などの他のユーザーのルートの所有者
+const testSourceOwnerID = "019b5a8d-0000-7000-8000-0000000000ff"
+
+// newTestClubReader は閲覧ユーザーが clubIDs のクラブに所属しているものとして返すモックを作る
+func newTestClubReader(ctrl *gomock.Controller, clubIDs ...string) *routeDomain.MockClubMembershipReader {
+	clubs := routeDomain.NewMockClubMembershipReader(ctrl)
+	clubs.EXPECT().GetClubIDsByUserID(gomock.Any(), gomock.Any()).Return(clubIDs, nil).AnyTimes()
+	return clubs
+}
+
+// テスト用のクラブのメンバーのみに公開されたルート
+func createTestClubForkSourceRoute() *routeDomain.Route {
+	route := createTestForkSourceRoute(routeDomain.VisibilityClub)
+	route.SetClubID(new(testClubID))
+	return route
+}
+
+type forkRouteTestMocks struct {
+	mockRouteRepo *routeDomain.MockIRouteRepository
+	mockUserRepo  *userDomain.MockIUserRepository
+	mockTxManager *transactionApp.MockTransactionManager
+	mockPublisher *notificationDomain.MockPublisher
+}
+
+func Test_forkRouteUsecase_ForkRoute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		clubIDs    []string
+		setupMocks func(m *forkRouteTestMocks)
+		wantErr    error
+	}{
+		{
+			name: "正常系: 公開ルートをフォークする",
+			setupMocks: func(m *forkRouteTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestForkSourceRoute(routeDomain.VisibilityPublic), nil)
+				m.mockTxManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+				m.mockPublisher.EXPECT().Publish(gomock.Any(), notificationDomain.NewRouteActivity(notificationDomain.TypeRouteForked, testUserID, testSourceOwnerID, testRouteID)).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 他のユーザーの非公開ルート",
+			setupMocks: func(m *forkRouteTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestForkSourceRoute(routeDomain.VisibilityPrivate), nil)
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+		{
+			name:    "正常系: 所属するクラブのルートをフォークする",
+			clubIDs: []string{testClubID},
+			setupMocks: func(m *forkRouteTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestClubForkSourceRoute(), nil)
+				m.mockTxManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+				m.mockPublisher.EXPECT().Publish(gomock.Any(), notificationDomain.NewRouteActivity(notificationDomain.TypeRouteForked, testUserID, testSourceOwnerID, testRouteID)).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 所属していないクラブのルート",
+			setupMocks: func(m *forkRouteTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestClubForkSourceRoute(), nil)
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			m := &forkRouteTestMocks{
+				mockRouteRepo: routeDomain.NewMockIRouteRepository(ctrl),
+				mockUserRepo:  userDomain.NewMockIUserRepository(ctrl),
+				mockTxManager: transactionApp.NewMockTransactionManager(ctrl),
+				mockPublisher: notificationDomain.NewMockPublisher(ctrl),
+			}
+			tt.setupMocks(m)
+			uc := NewForkRouteUsecase(m.mockUserRepo, m.mockTxManager, m.mockRouteRepo, newTestClubReader(ctrl, tt.clubIDs...), m.mockPublisher)
+
+			got, err := uc.ForkRoute(context.Background(), testRouteID, testKratosID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ForkRoute() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ForkRoute() error = %v", err)
+			}
+			if got.ID == testRouteID || got.UserID != testUserID || got.ForkedFromRouteID != testRouteID {
+				t.Errorf("ForkRoute() = %+v, want new route owned by %s forked from %s", got, testUserID, testRouteID)
+			}
+		})
+	}
+}
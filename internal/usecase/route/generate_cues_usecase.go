@@ -0,0 +1,78 @@
+package route
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+)
+
+type IGenerateCuesUsecase interface {
+	GenerateCues(ctx context.Context, routeID string, kratosID string, locale string) ([]CoursePointOutput, error)
+}
+
+type generateCuesUsecase struct {
+	userRepository user.IUserRepository
+	txManager      transaction.TransactionManager
+	routeRepo      routeDomain.IRouteRepository
+	cueGenerator   *routeDomain.CueGenerator
+}
+
+func NewGenerateCuesUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository) IGenerateCuesUsecase {
+	return &generateCuesUsecase{
+		userRepository: userRepository,
+		txManager:      txManager,
+		routeRepo:      routeRepo,
+		cueGenerator:   routeDomain.NewDefaultCueGenerator(),
+	}
+}
+
+// GenerateCues はルートのコースポイントをpath_geomから生成したキューで置き換える
+// 案内文の言語は locale で指定し、空の場合はユーザーのロケールを使う。置き換え前の状態は版として残す
+func (u *generateCuesUsecase) GenerateCues(ctx context.Context, routeID string, kratosID string, locale string) ([]CoursePointOutput, error) {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := u.routeRepo.GetRouteByID(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	if route.UserID() != userEntity.ID().String() {
+		return nil, domainerror.New("user does not own the route", domainerror.ErrUnauthorized)
+	}
+
+	current, err := routeDomain.NewRouteVersion(route, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	if err := u.cueGenerator.GenerateCoursePoints(route, cueLanguage(locale, userEntity)); err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		routeRepo := repository.NewRouteRepository(q)
+		if err := routeRepo.SaveRouteVersion(ctx, current); err != nil {
+			return err
+		}
+		return routeRepo.UpdateRoute(ctx, route)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCoursePointOutputs(route.CoursePoints()), nil
+}
+
+// cueLanguage は指定されたロケール、なければユーザーのロケールから案内文の言語を決める
+func cueLanguage(locale string, userEntity *user.User) routeDomain.CueLanguage {
+	if locale == "" && userEntity.Locale() != nil {
+		locale = *userEntity.Locale()
+	}
+	return routeDomain.ParseCueLanguage(locale)
+}
@@ -0,0 +1,86 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_generateCuesUsecase_GenerateCues(t *testing.T) {
+	t.Parallel()
+
+	// 東へ進んで北へ左折するルート
+	path := orb.LineString{{139.70, 35.68}, {139.705, 35.68}, {139.705, 35.685}}
+
+	tests := []struct {
+		name            string
+		locale          string
+		setupMocks      func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager)
+		wantInstruction string
+		wantErr         error
+	}{
+		{
+			name:   "正常系: 指定した言語で案内文を生成する",
+			locale: "en-US",
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithPath(t, testRouteID, path), nil)
+				txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantInstruction: "Turn left",
+		},
+		{
+			name: "正常系: 言語の指定がなければ日本語で生成する",
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithPath(t, testRouteID, path), nil)
+				txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantInstruction: "左折です",
+		},
+		{
+			name: "異常系: ルートの所有者ではない",
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute("different-user-id"), nil)
+			},
+			wantErr: domainerror.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			routeRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			txManager := transactionApp.NewMockTransactionManager(ctrl)
+			tt.setupMocks(t, routeRepo, userRepo, txManager)
+
+			uc := NewGenerateCuesUsecase(userRepo, txManager, routeRepo)
+			got, err := uc.GenerateCues(context.Background(), testRouteID, testKratosID, tt.locale)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("GenerateCues() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GenerateCues() error = %v", err)
+			}
+			if len(got) != 3 {
+				t.Fatalf("len = %d, want 3 (depart, turn, arrive)", len(got))
+			}
+			if *got[1].Instruction != tt.wantInstruction {
+				t.Errorf("Instruction = %s, want %s", *got[1].Instruction, tt.wantInstruction)
+			}
+		})
+	}
+}
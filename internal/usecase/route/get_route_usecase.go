@@ -4,26 +4,50 @@ import (
 	"context"
 	"strings"
 
+	collectionDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/collection"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	placeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
 	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	tripDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
 	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/textsearch"
 	"github.com/paulmach/orb"
 )
 
 type IGetRouteUsecase interface {
-	GetRouteByID(ctx context.Context, routeID string) (*RouteDetaileDto, error)
-	GetRoutesByUserID(ctx context.Context, input SearchRoutesInputDto) ([]*RouteListItemDto, error)
+	// kratosIDが空の場合は未ログインのユーザーとして扱い、公開のルートだけを返す
+	// kratosIDが空でない場合は、そのユーザーの過去のトリップから推定した所要時間も返す
+	GetRouteByID(ctx context.Context, routeID string, kratosID string) (*RouteDetaileDto, error)
+	GetRoutesByUserID(ctx context.Context, input SearchRoutesInputDto) (*RouteListDto, error)
 	ExploreRoutes(ctx context.Context, input ExploreRoutesInputDto) (*RouteListDto, error)
+	// kratosIDが空の場合は未ログインのユーザーとして扱う。閲覧できないルートは見つからないものとして扱う
+	GetSimilarRoutes(ctx context.Context, routeID string, kratosID string, limit int32) ([]*SimilarRouteDto, error)
+	// 保存したルートを保存した日時の新しい順に返す。保存した後に閲覧できなくなったルートは含まない
+	GetSavedRoutes(ctx context.Context, input SavedRoutesInputDto) (*RouteListDto, error)
 }
 
+// savedRoutesCursorSort は保存したルート一覧のカーソルに記録する並び順
+const savedRoutesCursorSort = "saved_at"
+
 type getRouteUsecase struct {
-	routeRepo routeDomain.IRouteRepository
-	userRepo userDomain.IUserRepository
+	routeRepo      routeDomain.IRouteRepository
+	userRepo       userDomain.IUserRepository
+	tripRepo       tripDomain.ITripRepository
+	collectionRepo collectionDomain.ICollectionRepository
+	clubs          routeDomain.ClubMembershipReader
+	similarity     *routeDomain.SimilarityService
 }
 
-func NewGetRouteUsecase(routeRepo routeDomain.IRouteRepository, userRepo userDomain.IUserRepository) IGetRouteUsecase {
+func NewGetRouteUsecase(routeRepo routeDomain.IRouteRepository, userRepo userDomain.IUserRepository, tripRepo tripDomain.ITripRepository, collectionRepo collectionDomain.ICollectionRepository, clubs routeDomain.ClubMembershipReader) IGetRouteUsecase {
 	return &getRouteUsecase{
-		routeRepo: routeRepo,
-		userRepo: userRepo,
+		routeRepo:      routeRepo,
+		userRepo:       userRepo,
+		tripRepo:       tripRepo,
+		collectionRepo: collectionRepo,
+		clubs:          clubs,
+		similarity:     routeDomain.NewDefaultSimilarityService(),
 	}
 }
 
@@ -47,32 +71,63 @@ type WaypointOutput struct {
 	Location orb.Point
 }
 
+// 路面・道路の種類ごとの距離と割合
+type SurfaceShareOutput struct {
+	Category   string
+	Distance   float64 // 距離(m)
+	Percentage float64 // 割合(%)
+}
+
+// 地点が属する行政区域の名前
+type PlaceOutput struct {
+	Locality           string
+	AdministrativeArea string
+	CountryCode        string
+}
+
+type SurfaceBreakdownOutput struct {
+	Surfaces          []SurfaceShareOutput // paved, gravel, dirt, unknown
+	RoadClasses       []SurfaceShareOutput // OSMのhighwayタグ
+	UnpavedPercentage float64
+}
+
 type RouteDetaileDto struct {
-	ID                 string
-	UserID             string
-	UserName		   string
-	Name               string
-	Description        string
-	HighlightedPhotoID *int64
-	Distance           float64
-	Duration           float64
-	ElevationGain      float64
-	ElevationLoss      float64
-	PathGeom           orb.LineString
-	Bbox               orb.Polygon
-	FirstPoint         orb.Point
-	LastPoint          orb.Point
-	Polyline           string
-	Visibility         int16
-	CreatedAt 		   string
-	UpdatedAt 		   string
-	CoursePoints       []CoursePointOutput
-	Waypoints          []WaypointOutput
+	ID                     string
+	UserID                 string
+	UserName               string
+	Name                   string
+	Description            string
+	HighlightedPhotoID     *int64
+	Distance               float64
+	Duration               float64
+	ElevationGain          float64
+	ElevationLoss          float64
+	PathGeom               orb.LineString
+	Bbox                   orb.Polygon
+	FirstPoint             orb.Point
+	LastPoint              orb.Point
+	Polyline               string
+	Visibility             int16
+	ClubID                 *string // 公開範囲がクラブのメンバーのみの場合の共有先
+	Version                int32
+	ForkedFromRouteID      *string
+	ForkCount              int64
+	CreatedAt              string
+	UpdatedAt              string
+	CoursePoints           []CoursePointOutput
+	Waypoints              []WaypointOutput
+	SurfaceBreakdown       *SurfaceBreakdownOutput // 路面の内訳を作成していない場合はnil
+	StartPlace             *PlaceOutput            // 出発地点の地名。分からない場合はnil
+	EndPlace               *PlaceOutput            // 目的地の地名。分からない場合はnil
+	Difficulty             string                  // easy, moderate, hard, expert。まだ求めていない場合は空文字
+	EstimatedDurationForMe *float64                // 閲覧ユーザーの過去のトリップから推定した所要時間(s)。未ログインの場合はnil
+	Tags                   []string
 }
 
 type RouteListDto struct {
+	// ほぼ同一のルートをまとめた場合や閲覧できないルートを除いた場合は、次のページがあってもLimitより少ないことがある
 	Items      []*RouteListItemDto
-	TotalCount int64
+	NextCursor string // 次のページがない場合は空文字
 }
 
 type RouteListItemDto struct {
@@ -90,29 +145,83 @@ type RouteListItemDto struct {
 	Polyline           string
 	CreatedAt          string
 	UpdatedAt          string
+	Difficulty         string // easy, moderate, hard, expert。まだ求めていない場合は空文字
+	Tags               []string
+	Highlight          *RouteHighlightDto // キーワード検索時のみ設定
+}
+
+// キーワードに一致した箇所を<mark>で囲んだスニペット。一致しない項目は空文字
+type RouteHighlightDto struct {
+	Name        string
+	Description string
+}
+
+// 検索・探索で共通の絞り込み条件
+type RouteFilterInputDto struct {
+	MinElevationGain     *float64 // 獲得標高(m)
+	MaxElevationGain     *float64
+	MinDuration          *float64 // 所要時間(s)
+	MaxDuration          *float64
+	Author               string   // 作成者名（部分一致）
+	MinClimbingRatio     *float64 // 登坂率(獲得標高m/距離km)
+	MaxClimbingRatio     *float64
+	MaxUnpavedPercentage *float64 // 未舗装の割合(%)
+	Area                 string   // 出発地点か目的地の市区町村・都道府県名（前方一致）
 }
 
 // ルート検索用の入力DTO
 type SearchRoutesInputDto struct {
-    KratosID    string
-    Keyword     string   // "A B" のような生の検索文字列
-    Visibility  *int16
-    MinDistance *float64
-    MaxDistance *float64
+	KratosID     string
+	Keyword      string // "A B" のような生の検索文字列
+	Visibility   *int16
+	MinDistance  *float64
+	MaxDistance  *float64
+	Filter       RouteFilterInputDto
+	CollectionID string // 指定したコレクション内のルートに絞り込む
+	Tag          string
+	Sort         string // newest, most_liked, longest, hilliest
+	Limit        int32
+	Cursor       string // 前のページのNextCursor。空の場合は先頭から
 }
 
 type ExploreRoutesInputDto struct {
-	Keyword     string
-	Location    *orb.Point
-	Radius      *int32
-	MinDistance *float64
-	MaxDistance *float64
-	Limit       int32
-	Offset      int32
+	Keyword            string
+	Location           *orb.Point
+	Radius             *int32
+	MinDistance        *float64
+	MaxDistance        *float64
+	Filter             RouteFilterInputDto
+	MinDifficulty      string // easy, moderate, hard, expert。空の場合は指定なし
+	MaxDifficulty      string
+	Sort               string // nearest, newest, most_liked, longest, hilliest
+	Limit              int32
+	Cursor             string // 前のページのNextCursor。空の場合は先頭から
+	CollapseDuplicates bool   // ほぼ同一のルートを1件にまとめる。まとめるのはページ内のみ
 }
 
-func (u *getRouteUsecase) GetRouteByID(ctx context.Context, routeID string) (*RouteDetaileDto, error) {
-	route, err := u.routeRepo.GetRouteByID(ctx, routeID)
+// 保存したルート一覧の入力DTO
+type SavedRoutesInputDto struct {
+	KratosID string
+	Limit    int32
+	Cursor   string // 前のページのNextCursor。空の場合は先頭から
+}
+
+// 類似ルートの出力DTO
+type SimilarRouteDto struct {
+	RouteListItemDto
+	HausdorffDistance float64
+	FrechetDistance   float64
+	IsDuplicate       bool
+}
+
+// 類似ルート候補の取得件数の既定値
+const defaultSimilarRoutesLimit = 10
+
+// 検索結果に含める説明文のスニペットの最大文字数
+const descriptionSnippetLength = 120
+
+func (u *getRouteUsecase) GetRouteByID(ctx context.Context, routeID string, kratosID string) (*RouteDetaileDto, error) {
+	route, viewer, err := getVisibleRoute(ctx, u.userRepo, u.routeRepo, u.clubs, routeID, kratosID)
 	if err != nil {
 		return nil, err
 	}
@@ -123,10 +232,36 @@ func (u *getRouteUsecase) GetRouteByID(ctx context.Context, routeID string) (*Ro
 		return nil, err
 	}
 
-	return u.convertToOutputDto(route, user.Name()), nil
+	forkCount, err := u.routeRepo.CountForks(ctx, route.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	dto := u.convertToOutputDto(route, user.Name())
+	dto.ForkCount = forkCount
+
+	if viewer.UserID() != "" {
+		duration, err := u.estimateDurationFor(ctx, route, viewer.UserID())
+		if err != nil {
+			return nil, err
+		}
+		dto.EstimatedDurationForMe = &duration
+	}
+	return dto, nil
+}
+
+// estimateDurationFor はユーザーの直近のトリップから区間ごとの速度を求め、ルートの所要時間を推定する
+// トリップがないユーザーには既定の速度を使う
+func (u *getRouteUsecase) estimateDurationFor(ctx context.Context, route *routeDomain.Route, userID string) (float64, error) {
+	rides, err := u.tripRepo.GetRideStatsByUserID(ctx, userID, tripDomain.SpeedProfileRideLimit)
+	if err != nil {
+		return 0, err
+	}
+	profile := tripDomain.FitSpeedProfile(rides)
+	return profile.EstimateDuration(route.Distance(), route.ElevationGain(), route.ElevationLoss()), nil
 }
 
-func (u *getRouteUsecase) GetRoutesByUserID(ctx context.Context, input SearchRoutesInputDto) ([]*RouteListItemDto, error) {
+func (u *getRouteUsecase) GetRoutesByUserID(ctx context.Context, input SearchRoutesInputDto) (*RouteListDto, error) {
 	// KratosIDからユーザー情報を取得
 	userEntity, err := u.userRepo.GetUserByKratosID(ctx, input.KratosID)
 	if err != nil {
@@ -136,28 +271,63 @@ func (u *getRouteUsecase) GetRoutesByUserID(ctx context.Context, input SearchRou
 	userID := userEntity.ID().String()
 	keywords := strings.Fields(input.Keyword) // "A B" -> ["A", "B"]
 
-	criteria ,err := routeDomain.NewRouteSearchCriteria(userID, keywords, input.Visibility, input.MinDistance, input.MaxDistance)
+	filter, err := newRouteFilter(input.Filter)
+	if err != nil {
+		return nil, err
+	}
+	sort, err := routeDomain.ParseRouteSort(input.Sort)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := pagination.NormalizeLimit(input.Limit)
+	if err != nil {
+		return nil, err
+	}
+	cursorSort, after, err := decodeCursor(input.Cursor)
 	if err != nil {
 		return nil, err
 	}
 
-	routes, err := u.routeRepo.SearchRoutesByUserID(ctx, criteria)
+	// コレクション内のクラブのルートを閲覧できるか判定するため、所属するクラブとともに閲覧ユーザーを作成する
+	viewer, err := routeDomain.ResolveViewer(ctx, u.clubs, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	//ルート作成者のユーザー名を取得
-	user, err := u.userRepo.GetUserByID(ctx, userID)
+	// 閲覧できないコレクションは存在しないものとして扱う
+	if input.CollectionID != "" {
+		c, err := u.collectionRepo.GetCollectionByID(ctx, input.CollectionID)
+		if err != nil {
+			return nil, err
+		}
+		if !c.IsVisibleTo(viewer) {
+			return nil, domainerror.New("collection not found", domainerror.ErrNotFound)
+		}
+	}
+
+	criteria, err := routeDomain.NewRouteSearchCriteria(viewer, keywords, input.Visibility, input.MinDistance, input.MaxDistance, filter, input.CollectionID, input.Tag, sort, limit, after)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCursorSort(cursorSort, after, criteria.Sort()); err != nil {
+		return nil, err
+	}
+
+	page, err := u.routeRepo.SearchRoutesByUserID(ctx, criteria)
 	if err != nil {
 		return nil, err
 	}
 
-	outputs := make([]*RouteListItemDto, len(routes))
-	for i, route := range routes {
-		outputs[i] = u.convertToSummaryOutputDto(route, user.Name())
+	items := make([]*RouteListItemDto, len(page.Items))
+	for i, r := range page.Items {
+		items[i] = u.convertToSummaryOutputDto(r.Route, r.UserName)
+		items[i].Highlight = newRouteHighlight(r.Route, keywords)
 	}
 
-	return outputs, nil
+	return &RouteListDto{
+		Items:      items,
+		NextCursor: encodeNextCursor(criteria.Sort(), page.Next),
+	}, nil
 }
 
 func (u *getRouteUsecase) ExploreRoutes(ctx context.Context, input ExploreRoutesInputDto) (*RouteListDto, error) {
@@ -174,76 +344,220 @@ func (u *getRouteUsecase) ExploreRoutes(ctx context.Context, input ExploreRoutes
 		location = &routeDomain.Geometry{Geometry: *input.Location}
 	}
 
-	limit := input.Limit
-	if limit <= 0 {
-		limit = 20
+	limit, err := pagination.NormalizeLimit(input.Limit)
+	if err != nil {
+		return nil, err
 	}
 
-	criteria, err := routeDomain.NewExploreRoutesCriteria(keywords, location, radius, input.MinDistance, input.MaxDistance, limit, input.Offset)
+	filter, err := newRouteFilter(input.Filter)
 	if err != nil {
 		return nil, err
 	}
+	sort, err := routeDomain.ParseRouteSort(input.Sort)
+	if err != nil {
+		return nil, err
+	}
+	cursorSort, after, err := decodeCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	minDifficulty, err := parseDifficulty(input.MinDifficulty)
+	if err != nil {
+		return nil, err
+	}
+	maxDifficulty, err := parseDifficulty(input.MaxDifficulty)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := routeDomain.NewExploreRoutesCriteria(keywords, location, radius, input.MinDistance, input.MaxDistance, filter, minDifficulty, maxDifficulty, sort, limit, after)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCursorSort(cursorSort, after, criteria.Sort()); err != nil {
+		return nil, err
+	}
 
-	routes, err := u.routeRepo.ExploreRoutes(ctx, criteria)
+	page, err := u.routeRepo.ExploreRoutes(ctx, criteria)
 	if err != nil {
 		return nil, err
 	}
 
-	var totalCount int64
+	// 重複の除去はページ内で行う。カーソルは除去前の最後の要素を指すため次のページに影響しない
+	routes := page.Items
+	if input.CollapseDuplicates {
+		routes = u.similarity.CollapseNearDuplicates(routes)
+	}
+
 	items := make([]*RouteListItemDto, len(routes))
 	for i, r := range routes {
 		items[i] = u.convertToSummaryOutputDto(r.Route, r.UserName)
-		if i == 0 {
-			totalCount = r.TotalCount
-		}
+		items[i].Highlight = newRouteHighlight(r.Route, keywords)
 	}
 
 	return &RouteListDto{
 		Items:      items,
-		TotalCount: totalCount,
+		NextCursor: encodeNextCursor(criteria.Sort(), page.Next),
 	}, nil
 }
 
-func (u *getRouteUsecase) convertToOutputDto(route *routeDomain.Route, userName string) *RouteDetaileDto {
-	// CoursePointsの変換
-	coursePoints := make([]CoursePointOutput, len(route.CoursePoints()))
-	for i, cp := range route.CoursePoints() {
-		var location *orb.Point
-		if cp.Location() != nil {
-			if point, ok := cp.Location().Geometry.(orb.Point); ok {
-				location = &point
-			}
-		}
+func (u *getRouteUsecase) GetSimilarRoutes(ctx context.Context, routeID string, kratosID string, limit int32) ([]*SimilarRouteDto, error) {
+	target, _, err := getVisibleRoute(ctx, u.userRepo, u.routeRepo, u.clubs, routeID, kratosID)
+	if err != nil {
+		return nil, err
+	}
 
-		coursePoints[i] = CoursePointOutput{
-			ID:            cp.ID(),
-			StepOrder:     cp.StepOrder(),
-			SegDistM:      cp.SegDistM(),
-			CumDistM:      cp.CumDistM(),
-			Duration:      cp.Duration(),
-			Instruction:   cp.Instruction(),
-			RoadName:      cp.RoadName(),
-			ManeuverType:  cp.ManeuverType(),
-			Modifier:      cp.Modifier(),
-			Location:      location,
-			BearingBefore: cp.BearingBefore(),
-			BearingAfter:  cp.BearingAfter(),
+	if limit <= 0 {
+		limit = defaultSimilarRoutesLimit
+	}
+
+	// bboxと総距離で候補をDBから絞り込み、ジオメトリの比較はドメインサービスで行う
+	criteria, err := u.similarity.CandidateCriteria(target, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := u.routeRepo.FindSimilarRouteCandidates(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	similarRoutes := u.similarity.RankSimilar(target, candidates)
+	outputs := make([]*SimilarRouteDto, len(similarRoutes))
+	for i, s := range similarRoutes {
+		outputs[i] = &SimilarRouteDto{
+			RouteListItemDto:  *u.convertToSummaryOutputDto(s.Route, s.UserName),
+			HausdorffDistance: s.Similarity.HausdorffDistance,
+			FrechetDistance:   s.Similarity.FrechetDistance,
+			IsDuplicate:       s.Similarity.IsDuplicate,
 		}
 	}
 
-	// Waypointsの変換
-	waypoints := make([]WaypointOutput, len(route.Waypoints()))
-	for i, wp := range route.Waypoints() {
-		waypoints[i] = WaypointOutput{
-			ID:       wp.ID(),
-			Location: wp.Location().Geometry.(orb.Point),
+	return outputs, nil
+}
+
+func (u *getRouteUsecase) GetSavedRoutes(ctx context.Context, input SavedRoutesInputDto) (*RouteListDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, input.KratosID)
+	if err != nil {
+		return nil, err
+	}
+	userID := userEntity.ID().String()
+
+	limit, err := pagination.NormalizeLimit(input.Limit)
+	if err != nil {
+		return nil, err
+	}
+	cursorSort, after, err := decodeCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if after != nil && cursorSort != savedRoutesCursorSort {
+		return nil, domainerror.New("cursor does not match sort", domainerror.ErrValidation)
+	}
+
+	criteria, err := routeDomain.NewSavedRouteCriteria(userID, limit, after)
+	if err != nil {
+		return nil, err
+	}
+	page, err := u.routeRepo.GetSavedRoutes(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// 保存した後に非公開になったルートなどは除く。カーソルは除く前の最後の要素を指すため次のページに影響しない
+	viewer, err := routeDomain.ResolveViewer(ctx, u.clubs, userID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*RouteListItemDto, 0, len(page.Items))
+	for _, r := range page.Items {
+		if !r.Route.IsVisibleTo(viewer) {
+			continue
 		}
+		items = append(items, u.convertToSummaryOutputDto(r.Route, r.UserName))
+	}
+
+	var nextCursor string
+	if page.Next != nil {
+		nextCursor = cursor.Encode(savedRoutesCursorSort, page.Next.SortKey(), page.Next.ID())
+	}
+	return &RouteListDto{
+		Items:      items,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// decodeCursor はクライアントから受け取ったカーソルトークンを並び順とカーソルに変換する
+func decodeCursor(token string) (string, *pagination.Cursor, error) {
+	if token == "" {
+		return "", nil, nil
+	}
+	sort, sortKey, id, err := cursor.Decode(token)
+	if err != nil {
+		return "", nil, domainerror.New("invalid cursor", domainerror.ErrValidation)
+	}
+	after, err := pagination.NewCursor(sortKey, id)
+	if err != nil {
+		return "", nil, err
+	}
+	return sort, after, nil
+}
+
+// validateCursorSort はカーソル作成時と並び順が変わっていないことを確認する
+// 並び順が異なるとソートキーの意味が変わり、ページが正しく続かないため
+func validateCursorSort(cursorSort string, after *pagination.Cursor, sort routeDomain.RouteSort) error {
+	if after != nil && cursorSort != string(sort) {
+		return domainerror.New("cursor does not match sort", domainerror.ErrValidation)
+	}
+	return nil
+}
+
+func encodeNextCursor(sort routeDomain.RouteSort, next *pagination.Cursor) string {
+	if next == nil {
+		return ""
 	}
+	return cursor.Encode(string(sort), next.SortKey(), next.ID())
+}
+
+// newRouteHighlight はキーワードに一致した箇所のスニペットを作成する
+// 道路名だけに一致した場合など、名前・説明のどちらにも一致しない場合はnilを返す
+func newRouteHighlight(route *routeDomain.Route, keywords []string) *RouteHighlightDto {
+	if len(keywords) == 0 {
+		return nil
+	}
+	h := &RouteHighlightDto{
+		Name:        textsearch.Snippet(route.Name(), keywords, 0),
+		Description: textsearch.Snippet(route.Description(), keywords, descriptionSnippetLength),
+	}
+	if h.Name == "" && h.Description == "" {
+		return nil
+	}
+	return h
+}
+
+func newRouteFilter(input RouteFilterInputDto) (routeDomain.RouteFilter, error) {
+	return routeDomain.NewRouteFilter(
+		input.MinElevationGain,
+		input.MaxElevationGain,
+		input.MinDuration,
+		input.MaxDuration,
+		input.Author,
+		input.MinClimbingRatio,
+		input.MaxClimbingRatio,
+		input.MaxUnpavedPercentage,
+		input.Area,
+	)
+}
+
+func (u *getRouteUsecase) convertToOutputDto(route *routeDomain.Route, userName string) *RouteDetaileDto {
+	coursePoints := toCoursePointOutputs(route.CoursePoints())
+	waypoints := toWaypointOutputs(route.Waypoints())
 
 	return &RouteDetaileDto{
 		ID:                 route.ID(),
 		UserID:             route.UserID(),
-		UserName:		   	userName,
+		UserName:           userName,
 		Name:               route.Name(),
 		Description:        route.Description(),
 		HighlightedPhotoID: route.HighlightedPhotoID(),
@@ -251,19 +565,26 @@ func (u *getRouteUsecase) convertToOutputDto(route *routeDomain.Route, userName
 		Duration:           route.Duration(),
 		ElevationGain:      route.ElevationGain(),
 		ElevationLoss:      route.ElevationLoss(),
-		PathGeom:           route.PathGeom().Geometry.(orb.LineString),//DBから取得したデータはドメイン層で定義した型を満たしていると仮定。外部システムからDBに直接書き込む可能性がある場合はバリデーションが必要
+		PathGeom:           route.PathGeom().Geometry.(orb.LineString), //DBから取得したデータはドメイン層で定義した型を満たしていると仮定。外部システムからDBに直接書き込む可能性がある場合はバリデーションが必要
 		Bbox:               route.Bbox().Geometry.(orb.Polygon),
 		FirstPoint:         route.FirstPoint().Geometry.(orb.Point),
 		LastPoint:          route.LastPoint().Geometry.(orb.Point),
 		Visibility:         route.Visibility(),
+		ClubID:             route.ClubID(),
+		Version:            route.Version(),
+		ForkedFromRouteID:  route.ForkedFromRouteID(),
 		CreatedAt:          route.CreatedAt(),
 		UpdatedAt:          route.UpdatedAt(),
 		CoursePoints:       coursePoints,
 		Waypoints:          waypoints,
+		SurfaceBreakdown:   toSurfaceBreakdownOutput(route.SurfaceBreakdown()),
+		StartPlace:         toPlaceOutput(route.StartPlace()),
+		EndPlace:           toPlaceOutput(route.EndPlace()),
+		Difficulty:         route.Difficulty().String(),
+		Tags:               route.Tags(),
 	}
 }
 
-
 func (u *getRouteUsecase) convertToSummaryOutputDto(route *routeDomain.Route, userName string) *RouteListItemDto {
 	return &RouteListItemDto{
 		ID:                 route.ID(),
@@ -280,5 +601,84 @@ func (u *getRouteUsecase) convertToSummaryOutputDto(route *routeDomain.Route, us
 		Visibility:         route.Visibility(),
 		CreatedAt:          route.CreatedAt(),
 		UpdatedAt:          route.UpdatedAt(),
+		Difficulty:         route.Difficulty().String(),
+		Tags:               route.Tags(),
+	}
+}
+
+func toCoursePointOutputs(cps []*routeDomain.CoursePoint) []CoursePointOutput {
+	coursePoints := make([]CoursePointOutput, len(cps))
+	for i, cp := range cps {
+		var location *orb.Point
+		if cp.Location() != nil {
+			if point, ok := cp.Location().Geometry.(orb.Point); ok {
+				location = &point
+			}
+		}
+
+		coursePoints[i] = CoursePointOutput{
+			ID:            cp.ID(),
+			StepOrder:     cp.StepOrder(),
+			SegDistM:      cp.SegDistM(),
+			CumDistM:      cp.CumDistM(),
+			Duration:      cp.Duration(),
+			Instruction:   cp.Instruction(),
+			RoadName:      cp.RoadName(),
+			ManeuverType:  cp.ManeuverType(),
+			Modifier:      cp.Modifier(),
+			Location:      location,
+			BearingBefore: cp.BearingBefore(),
+			BearingAfter:  cp.BearingAfter(),
+		}
+	}
+	return coursePoints
+}
+
+func toSurfaceBreakdownOutput(b *routeDomain.SurfaceBreakdown) *SurfaceBreakdownOutput {
+	if b == nil {
+		return nil
+	}
+	return &SurfaceBreakdownOutput{
+		Surfaces:          toSurfaceShareOutputs(b.Surfaces()),
+		RoadClasses:       toSurfaceShareOutputs(b.RoadClasses()),
+		UnpavedPercentage: b.UnpavedPercentage(),
+	}
+}
+
+// parseDifficulty は難易度の名前を変換する。空の場合は指定なしとする
+func parseDifficulty(s string) (routeDomain.Difficulty, error) {
+	if s == "" {
+		return routeDomain.DifficultyUnknown, nil
+	}
+	return routeDomain.ParseDifficulty(s)
+}
+
+func toPlaceOutput(p *placeDomain.Place) *PlaceOutput {
+	if p == nil {
+		return nil
+	}
+	return &PlaceOutput{
+		Locality:           p.Locality(),
+		AdministrativeArea: p.AdministrativeArea(),
+		CountryCode:        p.CountryCode(),
+	}
+}
+
+func toSurfaceShareOutputs(shares []routeDomain.SurfaceShare) []SurfaceShareOutput {
+	outputs := make([]SurfaceShareOutput, len(shares))
+	for i, s := range shares {
+		outputs[i] = SurfaceShareOutput{Category: s.Category, Distance: s.Distance, Percentage: s.Percentage}
+	}
+	return outputs
+}
+
+func toWaypointOutputs(wps []*routeDomain.Waypoint) []WaypointOutput {
+	waypoints := make([]WaypointOutput, len(wps))
+	for i, wp := range wps {
+		waypoints[i] = WaypointOutput{
+			ID:       wp.ID(),
+			Location: wp.Location().Geometry.(orb.Point),
+		}
 	}
+	return waypoints
 }
@@ -1 +1,681 @@
 package route
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	collectionDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/collection"
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	tripDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestRouteWithPath(t *testing.T, id string, ls orb.LineString) *routeDomain.Route {
+	t.Helper()
+	r, err := routeDomain.ReconstructRoute(
+		id,
+		"019b5a8d-16a7-700a-be92-9ae11e7e5b9a",
+		"Test Route",
+		"Test Description",
+		nil, 1800, 3600, 0, 0,
+		routeDomain.Geometry{Geometry: ls}, routeDomain.Geometry{Geometry: ls.Bound().ToPolygon()},
+		routeDomain.Geometry{Geometry: ls[0]}, routeDomain.Geometry{Geometry: ls[len(ls)-1]},
+		"", 1, 1, nil, "", "",
+	)
+	if err != nil {
+		t.Fatalf("failed to reconstruct route: %v", err)
+	}
+	return r
+}
+
+func Test_getRouteUsecase_GetSimilarRoutes(t *testing.T) {
+	targetID := "019b5a50-0000-7000-8000-000000000001"
+	base := orb.LineString{{139.7600, 35.6800}, {139.7700, 35.6800}, {139.7800, 35.6800}}
+
+	tests := []struct {
+		name     string
+		limit    int32
+		mockFunc func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository)
+		wantIDs  []string
+		wantDup  []bool
+		wantErr  bool
+	}{
+		{
+			name:  "正常系: 近いルートだけが近い順に返る",
+			limit: 0,
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {
+				mockRouteRepo.EXPECT().
+					GetRouteByID(gomock.Any(), targetID).
+					Return(newTestRouteWithPath(t, targetID, base), nil)
+
+				near := newTestRouteWithPath(t, "019b5a50-0000-7000-8000-000000000002",
+					orb.LineString{{139.7600, 35.6820}, {139.7700, 35.6820}, {139.7800, 35.6820}})
+				same := newTestRouteWithPath(t, "019b5a50-0000-7000-8000-000000000003", base)
+				far := newTestRouteWithPath(t, "019b5a50-0000-7000-8000-000000000004",
+					orb.LineString{{139.7600, 35.7000}, {139.7700, 35.7000}, {139.7800, 35.7000}})
+
+				mockRouteRepo.EXPECT().
+					FindSimilarRouteCandidates(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, criteria *routeDomain.SimilarRouteCandidatesCriteria) ([]*routeDomain.ExploreRouteResult, error) {
+						// limit未指定時は既定値で候補を取得する
+						if criteria.Limit() != defaultSimilarRoutesLimit {
+							t.Errorf("criteria.Limit() = %d, want %d", criteria.Limit(), defaultSimilarRoutesLimit)
+						}
+						if criteria.RouteID() != targetID {
+							t.Errorf("criteria.RouteID() = %s, want %s", criteria.RouteID(), targetID)
+						}
+						return []*routeDomain.ExploreRouteResult{
+							{Route: near, UserName: "near"},
+							{Route: far, UserName: "far"},
+							{Route: same, UserName: "same"},
+						}, nil
+					})
+			},
+			wantIDs: []string{"019b5a50-0000-7000-8000-000000000003", "019b5a50-0000-7000-8000-000000000002"},
+			wantDup: []bool{true, false},
+		},
+		{
+			name:  "正常系: 候補がない場合は空で返る",
+			limit: 5,
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {
+				mockRouteRepo.EXPECT().
+					GetRouteByID(gomock.Any(), targetID).
+					Return(newTestRouteWithPath(t, targetID, base), nil)
+				mockRouteRepo.EXPECT().
+					FindSimilarRouteCandidates(gomock.Any(), gomock.Any()).
+					Return([]*routeDomain.ExploreRouteResult{}, nil)
+			},
+			wantIDs: []string{},
+			wantDup: []bool{},
+		},
+		{
+			name:  "異常系: ルートが見つからない",
+			limit: 10,
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {
+				mockRouteRepo.EXPECT().
+					GetRouteByID(gomock.Any(), targetID).
+					Return(nil, domainerror.New("route not found", domainerror.ErrNotFound))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "異常系: 他のユーザーの非公開ルートは見つからない",
+			limit: 10,
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {
+				private := createTestForkSourceRoute(routeDomain.VisibilityPrivate)
+				mockRouteRepo.EXPECT().
+					GetRouteByID(gomock.Any(), targetID).
+					Return(private, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "異常系: 候補の取得に失敗",
+			limit: 10,
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {
+				mockRouteRepo.EXPECT().
+					GetRouteByID(gomock.Any(), targetID).
+					Return(newTestRouteWithPath(t, targetID, base), nil)
+				mockRouteRepo.EXPECT().
+					FindSimilarRouteCandidates(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt := tt
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+			uc := NewGetRouteUsecase(mockRouteRepo, mockUserRepo, tripDomain.NewMockITripRepository(ctrl), collectionDomain.NewMockICollectionRepository(ctrl), newTestClubReader(ctrl))
+
+			tt.mockFunc(t, mockRouteRepo)
+
+			got, err := uc.GetSimilarRoutes(context.Background(), targetID, "", tt.limit)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetSimilarRoutes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("GetSimilarRoutes() returned %d routes, want %d", len(got), len(tt.wantIDs))
+			}
+			for i := range got {
+				if got[i].ID != tt.wantIDs[i] {
+					t.Errorf("got[%d].ID = %s, want %s", i, got[i].ID, tt.wantIDs[i])
+				}
+				if got[i].IsDuplicate != tt.wantDup[i] {
+					t.Errorf("got[%d].IsDuplicate = %v, want %v", i, got[i].IsDuplicate, tt.wantDup[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_getRouteUsecase_ExploreRoutes_Cursor(t *testing.T) {
+	nextID := "019b5a50-0000-7000-8000-000000000002"
+	base := orb.LineString{{139.7600, 35.6800}, {139.7700, 35.6800}, {139.7800, 35.6800}}
+
+	tests := []struct {
+		name           string
+		input          ExploreRoutesInputDto
+		mockFunc       func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository)
+		wantNextCursor string
+		wantErr        bool
+	}{
+		{
+			name:  "正常系: 次のページがある場合はカーソルを返す",
+			input: ExploreRoutesInputDto{Sort: "newest", Limit: 1},
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {
+				next, err := pagination.NewCursor(1700000000, nextID)
+				if err != nil {
+					t.Fatalf("failed to create cursor: %v", err)
+				}
+				mockRouteRepo.EXPECT().
+					ExploreRoutes(gomock.Any(), gomock.Any()).
+					Return(&routeDomain.RoutePage{
+						Items: []*routeDomain.ExploreRouteResult{{Route: newTestRouteWithPath(t, nextID, base), UserName: "user"}},
+						Next:  next,
+					}, nil)
+			},
+			wantNextCursor: cursor.Encode("newest", 1700000000, nextID),
+		},
+		{
+			name:  "正常系: カーソルの位置から取得する",
+			input: ExploreRoutesInputDto{Sort: "newest", Cursor: cursor.Encode("newest", 1700000000, nextID)},
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {
+				mockRouteRepo.EXPECT().
+					ExploreRoutes(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, criteria *routeDomain.ExploreRoutesCriteria) (*routeDomain.RoutePage, error) {
+						if criteria.After() == nil || criteria.After().ID() != nextID || criteria.After().SortKey() != 1700000000 {
+							t.Errorf("criteria.After() = %v, want cursor at %s", criteria.After(), nextID)
+						}
+						return &routeDomain.RoutePage{Items: []*routeDomain.ExploreRouteResult{}}, nil
+					})
+			},
+		},
+		{
+			name:     "異常系: 不正なカーソル",
+			input:    ExploreRoutesInputDto{Cursor: "invalid"},
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {},
+			wantErr:  true,
+		},
+		{
+			name:     "異常系: カーソル作成時と並び順が異なる",
+			input:    ExploreRoutesInputDto{Sort: "longest", Cursor: cursor.Encode("newest", 1700000000, nextID)},
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+			uc := NewGetRouteUsecase(mockRouteRepo, mockUserRepo, tripDomain.NewMockITripRepository(ctrl), collectionDomain.NewMockICollectionRepository(ctrl), newTestClubReader(ctrl))
+
+			tt.mockFunc(t, mockRouteRepo)
+
+			got, err := uc.ExploreRoutes(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExploreRoutes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("ExploreRoutes() error = %v, want validation error", err)
+				}
+				return
+			}
+			if got.NextCursor != tt.wantNextCursor {
+				t.Errorf("NextCursor = %q, want %q", got.NextCursor, tt.wantNextCursor)
+			}
+		})
+	}
+}
+
+func Test_getRouteUsecase_ExploreRoutes_Difficulty(t *testing.T) {
+	routeID := "019b5a50-0000-7000-8000-000000000004"
+	base := orb.LineString{{139.7600, 35.6800}, {139.7700, 35.6800}}
+
+	tests := []struct {
+		name     string
+		input    ExploreRoutesInputDto
+		mockFunc func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository)
+		wantErr  bool
+	}{
+		{
+			name:  "正常系: 難易度の範囲で絞り込む",
+			input: ExploreRoutesInputDto{MinDifficulty: "moderate", MaxDifficulty: "hard"},
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {
+				mockRouteRepo.EXPECT().
+					ExploreRoutes(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, criteria *routeDomain.ExploreRoutesCriteria) (*routeDomain.RoutePage, error) {
+						if criteria.MinDifficulty() != routeDomain.DifficultyModerate || criteria.MaxDifficulty() != routeDomain.DifficultyHard {
+							t.Errorf("criteria difficulty = %v-%v, want moderate-hard", criteria.MinDifficulty(), criteria.MaxDifficulty())
+						}
+						r := newTestRouteWithPath(t, routeID, base)
+						r.SetDifficulty(routeDomain.DifficultyModerate)
+						return &routeDomain.RoutePage{Items: []*routeDomain.ExploreRouteResult{{Route: r, UserName: "user"}}}, nil
+					})
+			},
+		},
+		{
+			name:     "異常系: 未定義の難易度",
+			input:    ExploreRoutesInputDto{MinDifficulty: "extreme"},
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {},
+			wantErr:  true,
+		},
+		{
+			name:     "異常系: 下限が上限より難しい",
+			input:    ExploreRoutesInputDto{MinDifficulty: "expert", MaxDifficulty: "easy"},
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository) {},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+			uc := NewGetRouteUsecase(mockRouteRepo, mockUserRepo, tripDomain.NewMockITripRepository(ctrl), collectionDomain.NewMockICollectionRepository(ctrl), newTestClubReader(ctrl))
+
+			tt.mockFunc(t, mockRouteRepo)
+
+			got, err := uc.ExploreRoutes(context.Background(), tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExploreRoutes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, domainerror.ErrValidation) {
+					t.Errorf("ExploreRoutes() error = %v, want validation error", err)
+				}
+				return
+			}
+			if len(got.Items) != 1 || got.Items[0].Difficulty != "moderate" {
+				t.Errorf("Items = %+v, want one moderate route", got.Items)
+			}
+		})
+	}
+}
+
+func Test_getRouteUsecase_GetRouteByID_EstimatedDuration(t *testing.T) {
+	rides := []tripDomain.RideStats{
+		{Distance: 60000, MovingTime: 7200, ElevationGain: 300, ElevationLoss: 300},
+		{Distance: 40000, MovingTime: 6000, ElevationGain: 800, ElevationLoss: 800},
+	}
+	route := newTestRouteWithPath(t, testRouteID, orb.LineString{{139.7600, 35.6800}, {139.7700, 35.6800}})
+
+	tests := []struct {
+		name     string
+		kratosID string
+		mockFunc func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository)
+		want     *float64
+		wantErr  bool
+	}{
+		{
+			name:     "正常系: 未ログインの場合は推定しない",
+			kratosID: "",
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository) {},
+			want:     nil,
+		},
+		{
+			name:     "正常系: 過去のトリップから推定する",
+			kratosID: testKratosID,
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetRideStatsByUserID(gomock.Any(), testUserID, int32(tripDomain.SpeedProfileRideLimit)).Return(rides, nil)
+			},
+			want: new(tripDomain.FitSpeedProfile(rides).EstimateDuration(route.Distance(), route.ElevationGain(), route.ElevationLoss())),
+		},
+		{
+			name:     "正常系: トリップがない場合は既定のモデルで推定する",
+			kratosID: testKratosID,
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetRideStatsByUserID(gomock.Any(), testUserID, gomock.Any()).Return([]tripDomain.RideStats{}, nil)
+			},
+			want: new(tripDomain.DefaultSpeedProfile().EstimateDuration(route.Distance(), route.ElevationGain(), route.ElevationLoss())),
+		},
+		{
+			name:     "異常系: トリップの取得に失敗",
+			kratosID: testKratosID,
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetRideStatsByUserID(gomock.Any(), testUserID, gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+			mockTripRepo := tripDomain.NewMockITripRepository(ctrl)
+			uc := NewGetRouteUsecase(mockRouteRepo, mockUserRepo, mockTripRepo, collectionDomain.NewMockICollectionRepository(ctrl), newTestClubReader(ctrl))
+
+			mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(route, nil)
+			mockUserRepo.EXPECT().GetUserByID(gomock.Any(), route.UserID()).Return(createTestUser(), nil)
+			mockRouteRepo.EXPECT().CountForks(gomock.Any(), testRouteID).Return(int64(0), nil)
+			tt.mockFunc(mockUserRepo, mockTripRepo)
+
+			got, err := uc.GetRouteByID(context.Background(), testRouteID, tt.kratosID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetRouteByID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if (got.EstimatedDurationForMe == nil) != (tt.want == nil) ||
+				(tt.want != nil && *got.EstimatedDurationForMe != *tt.want) {
+				t.Errorf("EstimatedDurationForMe = %v, want %v", got.EstimatedDurationForMe, tt.want)
+			}
+		})
+	}
+}
+
+func Test_getRouteUsecase_GetRoutesByUserID_Collection(t *testing.T) {
+	const collectionID = "019b5a63-0000-7000-8000-000000000001"
+
+	tests := []struct {
+		name     string
+		input    SearchRoutesInputDto
+		mockFunc func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository, mockCollectionRepo *collectionDomain.MockICollectionRepository)
+		wantErr  error
+	}{
+		{
+			name:  "正常系: コレクションとタグで絞り込む",
+			input: SearchRoutesInputDto{KratosID: testKratosID, CollectionID: collectionID, Tag: "#Gravel"},
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository, mockCollectionRepo *collectionDomain.MockICollectionRepository) {
+				mockCollectionRepo.EXPECT().GetCollectionByID(gomock.Any(), collectionID).
+					Return(collectionDomain.ReconstructCollection(collectionID, testUserID, "峠", "", routeDomain.VisibilityPrivate, nil, "", ""), nil)
+				mockRouteRepo.EXPECT().
+					SearchRoutesByUserID(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, criteria *routeDomain.RouteSearchCriteria) (*routeDomain.RoutePage, error) {
+						if criteria.CollectionID() != collectionID || criteria.Tag() != "gravel" {
+							t.Errorf("CollectionID(), Tag() = %q, %q", criteria.CollectionID(), criteria.Tag())
+						}
+						return &routeDomain.RoutePage{Items: []*routeDomain.ExploreRouteResult{}}, nil
+					})
+			},
+		},
+		{
+			name:  "異常系: 他のユーザーの非公開コレクション",
+			input: SearchRoutesInputDto{KratosID: testKratosID, CollectionID: collectionID},
+			mockFunc: func(t *testing.T, mockRouteRepo *routeDomain.MockIRouteRepository, mockCollectionRepo *collectionDomain.MockICollectionRepository) {
+				mockCollectionRepo.EXPECT().GetCollectionByID(gomock.Any(), collectionID).
+					Return(collectionDomain.ReconstructCollection(collectionID, "019b5a8d-0000-7000-8000-0000000000ff", "峠", "", routeDomain.VisibilityPrivate, nil, "", ""), nil)
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+			mockCollectionRepo := collectionDomain.NewMockICollectionRepository(ctrl)
+			uc := NewGetRouteUsecase(mockRouteRepo, mockUserRepo, tripDomain.NewMockITripRepository(ctrl), mockCollectionRepo, newTestClubReader(ctrl))
+
+			mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			tt.mockFunc(t, mockRouteRepo, mockCollectionRepo)
+
+			_, err := uc.GetRoutesByUserID(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("GetRoutesByUserID() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetRoutesByUserID() error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_newRouteHighlight(t *testing.T) {
+	base := orb.LineString{{139.7600, 35.6800}, {139.7700, 35.6800}}
+	r := newTestRouteWithPath(t, "019b5a50-0000-7000-8000-000000000001", base)
+
+	tests := []struct {
+		name     string
+		keywords []string
+		want     *RouteHighlightDto
+	}{
+		{name: "正常系: キーワードなしの場合はnil", keywords: nil, want: nil},
+		{name: "正常系: 名前と説明の一致箇所を囲む", keywords: []string{"test"}, want: &RouteHighlightDto{Name: "<mark>Test</mark> Route", Description: "<mark>Test</mark> Description"}},
+		{name: "正常系: 説明だけに一致", keywords: []string{"description"}, want: &RouteHighlightDto{Description: "Test <mark>Description</mark>"}},
+		{name: "正常系: どちらにも一致しない場合はnil", keywords: []string{"road"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newRouteHighlight(r, tt.keywords)
+			if (got == nil) != (tt.want == nil) {
+				t.Fatalf("newRouteHighlight() = %v, want %v", got, tt.want)
+			}
+			if got != nil && *got != *tt.want {
+				t.Errorf("newRouteHighlight() = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func Test_getRouteUsecase_GetRouteByID_Visibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		route    *routeDomain.Route
+		kratosID string
+		clubIDs  []string
+		wantErr  error
+	}{
+		{name: "正常系: 公開のルートは未ログインでも取得できる", route: createTestForkSourceRoute(routeDomain.VisibilityPublic)},
+		{name: "正常系: クラブのメンバーはクラブのルートを取得できる", route: createTestClubForkSourceRoute(), kratosID: testKratosID, clubIDs: []string{testClubID}},
+		{name: "異常系: 非公開のルートは未ログインでは見つからない", route: createTestForkSourceRoute(routeDomain.VisibilityPrivate), wantErr: domainerror.ErrNotFound},
+		{name: "異常系: 非公開のルートは他のユーザーには見つからない", route: createTestForkSourceRoute(routeDomain.VisibilityPrivate), kratosID: testKratosID, wantErr: domainerror.ErrNotFound},
+		{name: "異常系: クラブのルートは未ログインでは見つからない", route: createTestClubForkSourceRoute(), wantErr: domainerror.ErrNotFound},
+		{name: "異常系: クラブのルートはメンバー以外には見つからない", route: createTestClubForkSourceRoute(), kratosID: testKratosID, wantErr: domainerror.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+			mockTripRepo := tripDomain.NewMockITripRepository(ctrl)
+			uc := NewGetRouteUsecase(mockRouteRepo, mockUserRepo, mockTripRepo, collectionDomain.NewMockICollectionRepository(ctrl), newTestClubReader(ctrl, tt.clubIDs...))
+
+			mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(tt.route, nil)
+			if tt.kratosID != "" {
+				mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), tt.kratosID).Return(createTestUser(), nil)
+			}
+			if tt.wantErr == nil {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), testSourceOwnerID).Return(createTestUser(), nil)
+				mockRouteRepo.EXPECT().CountForks(gomock.Any(), testRouteID).Return(int64(0), nil)
+				mockTripRepo.EXPECT().GetRideStatsByUserID(gomock.Any(), testUserID, gomock.Any()).Return([]tripDomain.RideStats{}, nil).AnyTimes()
+			}
+
+			_, err := uc.GetRouteByID(context.Background(), testRouteID, tt.kratosID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetRouteByID() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_exportGPXUsecase_ExportGPX_Visibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		route    *routeDomain.Route
+		kratosID string
+		wantErr  error
+	}{
+		{name: "正常系: 公開のルートを出力できる", route: createTestForkSourceRoute(routeDomain.VisibilityPublic), kratosID: testKratosID},
+		{name: "異常系: 他のユーザーの非公開のルートは見つからない", route: createTestForkSourceRoute(routeDomain.VisibilityPrivate), kratosID: testKratosID, wantErr: domainerror.ErrNotFound},
+		{name: "異常系: クラブのルートはメンバー以外には見つからない", route: createTestClubForkSourceRoute(), kratosID: testKratosID, wantErr: domainerror.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+			uc := NewExportGPXUsecase(mockRouteRepo, mockUserRepo, newTestClubReader(ctrl))
+
+			mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), tt.kratosID).Return(createTestUser(), nil)
+			mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(tt.route, nil)
+			if tt.wantErr == nil {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), testSourceOwnerID).Return(createTestUser(), nil)
+			}
+
+			_, err := uc.ExportGPX(context.Background(), testRouteID, tt.kratosID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ExportGPX() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_exportGPXUsecase_ExportGPX_ForkAttribution(t *testing.T) {
+	t.Parallel()
+	const originalID = "019b5a50-0000-7000-8000-0000000000f0"
+
+	tests := []struct {
+		name            string
+		original        *routeDomain.Route
+		wantAttribution bool
+	}{
+		{name: "正常系: 公開のフォーク元は名前と作成者を記載する", original: createTestForkSourceRoute(routeDomain.VisibilityPublic), wantAttribution: true},
+		{name: "正常系: 見られない非公開のフォーク元は記載しない", original: createTestForkSourceRoute(routeDomain.VisibilityPrivate)},
+		{name: "正常系: メンバーでないクラブのフォーク元は記載しない", original: createTestClubForkSourceRoute()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+			uc := NewExportGPXUsecase(mockRouteRepo, mockUserRepo, newTestClubReader(ctrl))
+
+			path := orb.LineString{{139.0, 35.0}, {139.1, 35.1}}
+			forked, _ := routeDomain.ReconstructRoute(
+				testRouteID, testUserID, "フォークしたルート", "", nil,
+				testDistance, testDuration, testElevationGain, testElevationLoss,
+				routeDomain.Geometry{Geometry: path},
+				routeDomain.Geometry{Geometry: path.Bound().ToPolygon()},
+				routeDomain.Geometry{Geometry: path[0]},
+				routeDomain.Geometry{Geometry: path[1]},
+				testPolyline, routeDomain.VisibilityPublic, 1, new(originalID), "", "",
+			)
+			mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(forked, nil)
+			mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), originalID).Return(tt.original, nil)
+			mockUserRepo.EXPECT().GetUserByID(gomock.Any(), testUserID).Return(createTestUser(), nil)
+			if tt.wantAttribution {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), testSourceOwnerID).Return(createTestUser(), nil)
+			}
+
+			got, err := uc.ExportGPX(context.Background(), testRouteID, testKratosID)
+			if err != nil {
+				t.Fatalf("ExportGPX() error = %v", err)
+			}
+			if hasAttribution := strings.Contains(string(got), "Forked from"); hasAttribution != tt.wantAttribution {
+				t.Errorf("ExportGPX() attribution = %v, want %v\n%s", hasAttribution, tt.wantAttribution, got)
+			}
+			if !tt.wantAttribution && strings.Contains(string(got), testRouteName) {
+				t.Errorf("ExportGPX() leaks the original route name\n%s", got)
+			}
+		})
+	}
+}
+
+func Test_getRouteUsecase_GetSavedRoutes(t *testing.T) {
+	t.Parallel()
+	const nextID = "019b5a50-0000-7000-8000-000000000002"
+	next, err := pagination.NewCursor(1700000000, nextID)
+	if err != nil {
+		t.Fatalf("failed to create cursor: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		input          SavedRoutesInputDto
+		page           *routeDomain.RoutePage
+		wantIDs        []string
+		wantNextCursor string
+		wantErr        error
+	}{
+		{
+			name:  "正常系: 次のページがある場合はカーソルを返す",
+			input: SavedRoutesInputDto{KratosID: testKratosID, Limit: 1},
+			page: &routeDomain.RoutePage{
+				Items: []*routeDomain.ExploreRouteResult{{Route: createTestForkSourceRoute(routeDomain.VisibilityPublic), UserName: "user"}},
+				Next:  next,
+			},
+			wantIDs:        []string{testRouteID},
+			wantNextCursor: cursor.Encode(savedRoutesCursorSort, 1700000000, nextID),
+		},
+		{
+			name:  "正常系: 保存した後に非公開になったルートは含まない",
+			input: SavedRoutesInputDto{KratosID: testKratosID},
+			page: &routeDomain.RoutePage{
+				Items: []*routeDomain.ExploreRouteResult{{Route: createTestForkSourceRoute(routeDomain.VisibilityPrivate), UserName: "user"}},
+			},
+			wantIDs: []string{},
+		},
+		{
+			name:    "異常系: 他の一覧のカーソルは使えない",
+			input:   SavedRoutesInputDto{KratosID: testKratosID, Cursor: cursor.Encode("newest", 1700000000, nextID)},
+			wantErr: domainerror.ErrValidation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+			uc := NewGetRouteUsecase(mockRouteRepo, mockUserRepo, tripDomain.NewMockITripRepository(ctrl), collectionDomain.NewMockICollectionRepository(ctrl), newTestClubReader(ctrl))
+
+			mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			if tt.page != nil {
+				mockRouteRepo.EXPECT().GetSavedRoutes(gomock.Any(), gomock.Any()).Return(tt.page, nil)
+			}
+
+			got, err := uc.GetSavedRoutes(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetSavedRoutes() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			gotIDs := make([]string, len(got.Items))
+			for i, item := range got.Items {
+				gotIDs[i] = item.ID
+			}
+			if !slices.Equal(gotIDs, tt.wantIDs) {
+				t.Errorf("Items = %v, want %v", gotIDs, tt.wantIDs)
+			}
+			if got.NextCursor != tt.wantNextCursor {
+				t.Errorf("NextCursor = %q, want %q", got.NextCursor, tt.wantNextCursor)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package route
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+)
+
+type IMatchTripUsecase interface {
+	MatchTrip(ctx context.Context, dto MatchTripUseCaseInputDto) (*MatchTripUseCaseOutputDto, error)
+}
+
+type matchTripUsecase struct {
+	userRepository user.IUserRepository
+	tripRepository trip.ITripRepository
+	matcher        routeDomain.MapMatcher
+}
+
+func NewMatchTripUsecase(userRepository user.IUserRepository, tripRepository trip.ITripRepository, matcher routeDomain.MapMatcher) IMatchTripUsecase {
+	return &matchTripUsecase{
+		userRepository: userRepository,
+		tripRepository: tripRepository,
+		matcher:        matcher,
+	}
+}
+
+type MatchTripUseCaseInputDto struct {
+	TripID   string
+	KratosID string
+	Locale   string // 案内文の言語。空の場合はユーザーのロケール
+}
+
+type MatchedSegmentOutput struct {
+	RoadName *string
+	Highway  string
+	Surface  *string
+	Distance float64
+	Path     orb.LineString
+}
+
+// 照合した経路。保存はせず、そのままルート作成のリクエストに使えるようにする
+type MatchTripUseCaseOutputDto struct {
+	Distance      float64
+	Duration      float64
+	PathGeom      orb.LineString
+	FirstPoint    orb.Point
+	LastPoint     orb.Point
+	MatchedPoints int
+	TotalPoints   int
+	Segments      []MatchedSegmentOutput
+	CoursePoints  []CoursePointOutput // 保存前のためIDは空
+}
+
+// MatchTrip は記録したトリップの軌跡を道路網に照合する
+// GPSの誤差を除いた、実際に通った道路に沿う経路と曲がり角の案内を返す
+func (u *matchTripUsecase) MatchTrip(ctx context.Context, dto MatchTripUseCaseInputDto) (*MatchTripUseCaseOutputDto, error) {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, dto.KratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := u.tripRepository.GetTripByID(ctx, dto.TripID)
+	if err != nil {
+		return nil, err
+	}
+	if t.UserID() != userEntity.ID().String() {
+		return nil, domainerror.New("user does not own the trip", domainerror.ErrUnauthorized)
+	}
+	var track orb.LineString
+	if t.PathGeom() != nil {
+		track, _ = t.PathGeom().Geometry.(orb.LineString)
+	}
+	if len(track) == 0 {
+		return nil, domainerror.New("trip has no track", domainerror.ErrNotFound)
+	}
+
+	matched, err := u.matcher.Match(ctx, track)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]MatchedSegmentOutput, len(matched.Segments))
+	for i, s := range matched.Segments {
+		segments[i] = MatchedSegmentOutput{
+			RoadName: s.RoadName,
+			Highway:  s.Highway,
+			Surface:  s.Surface,
+			Distance: s.Distance,
+			Path:     s.Path,
+		}
+	}
+	return &MatchTripUseCaseOutputDto{
+		Distance:      matched.Distance,
+		Duration:      matched.Duration,
+		PathGeom:      matched.Path,
+		FirstPoint:    matched.Path[0],
+		LastPoint:     matched.Path[len(matched.Path)-1],
+		MatchedPoints: matched.MatchedPoints,
+		TotalPoints:   matched.TotalPoints,
+		Segments:      segments,
+		CoursePoints:  plannedCoursePoints(&matched.PlannedRoute, cueLanguage(dto.Locale, userEntity)),
+	}, nil
+}
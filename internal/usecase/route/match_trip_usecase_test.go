@@ -0,0 +1,137 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	tripDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+const testTripID = "019b5a60-0000-7000-8000-000000000001"
+
+// stubMatcher は軌跡の始点から東へ進み、北へ曲がる経路を返す
+type stubMatcher struct{}
+
+func (stubMatcher) Match(ctx context.Context, track orb.LineString) (*routeDomain.MatchedRoute, error) {
+	if err := routeDomain.ValidateMatchTrack(track); err != nil {
+		return nil, err
+	}
+	left := "left"
+	name := "内堀通り"
+	start := track[0]
+	corner := orb.Point{start.Lon() + 0.002, start.Lat()}
+	end := orb.Point{corner.Lon(), corner.Lat() + 0.002}
+	return &routeDomain.MatchedRoute{
+		PlannedRoute: routeDomain.PlannedRoute{
+			Path:     orb.LineString{start, corner, end},
+			Distance: 403,
+			Duration: 72,
+			Maneuvers: []routeDomain.PlannedManeuver{
+				{ManeuverType: "depart", RoadName: &name, Location: start},
+				{ManeuverType: "turn", Modifier: &left, Location: corner, CumDistM: 181, CumDuration: 32},
+				{ManeuverType: "arrive", Location: end, CumDistM: 403, CumDuration: 72},
+			},
+		},
+		Segments: []routeDomain.MatchedSegment{
+			{RoadName: &name, Highway: "secondary", Distance: 181, Path: orb.LineString{start, corner}},
+			{Highway: "residential", Distance: 222, Path: orb.LineString{corner, end}},
+		},
+		MatchedPoints: len(track),
+		TotalPoints:   len(track),
+	}, nil
+}
+
+// テスト用のトリップ作成ヘルパー
+func createTestTrip(userID string, path orb.LineString) *tripDomain.Trip {
+	t, _ := tripDomain.NewTrip(userID, "朝のライド", "", 1, 0)
+	if path != nil {
+		_ = t.SetMetrics(&tripDomain.Geometry{Geometry: path}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+	return t
+}
+
+func Test_matchTripUsecase_MatchTrip(t *testing.T) {
+	t.Parallel()
+
+	track := orb.LineString{{139.7501, 35.68005}, {139.7510, 35.67995}, {139.7519, 35.68004}, {139.75205, 35.6810}}
+
+	tests := []struct {
+		name       string
+		setupMocks func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository)
+		wantErr    error
+	}{
+		{
+			name: "正常系: 照合した経路と曲がり角のコースポイントを返す",
+			setupMocks: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetTripByID(gomock.Any(), testTripID).Return(createTestTrip(testUserID, track), nil)
+			},
+		},
+		{
+			name: "異常系: 他のユーザーのトリップ",
+			setupMocks: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetTripByID(gomock.Any(), testTripID).Return(createTestTrip("other-user-id", track), nil)
+			},
+			wantErr: domainerror.ErrUnauthorized,
+		},
+		{
+			name: "異常系: 軌跡のない室内トレーニング",
+			setupMocks: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetTripByID(gomock.Any(), testTripID).Return(createTestTrip(testUserID, nil), nil)
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+		{
+			name: "異常系: 存在しないトリップ",
+			setupMocks: func(userRepo *userDomain.MockIUserRepository, tripRepo *tripDomain.MockITripRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				tripRepo.EXPECT().GetTripByID(gomock.Any(), testTripID).Return(nil, domainerror.New("trip not found", domainerror.ErrNotFound))
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			tripRepo := tripDomain.NewMockITripRepository(ctrl)
+			tt.setupMocks(userRepo, tripRepo)
+
+			uc := NewMatchTripUsecase(userRepo, tripRepo, stubMatcher{})
+			got, err := uc.MatchTrip(context.Background(), MatchTripUseCaseInputDto{TripID: testTripID, KratosID: testKratosID, Locale: "en"})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("MatchTrip() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MatchTrip() error = %v", err)
+			}
+			if got.MatchedPoints != 4 || got.TotalPoints != 4 || len(got.Segments) != 2 {
+				t.Errorf("MatchTrip() = %+v", got)
+			}
+			if got.FirstPoint != track[0] || got.Distance != 403 {
+				t.Errorf("FirstPoint = %v, Distance = %v", got.FirstPoint, got.Distance)
+			}
+			// 出発・左折・到着の3つ
+			if len(got.CoursePoints) != 3 {
+				t.Fatalf("len(CoursePoints) = %d, want 3", len(got.CoursePoints))
+			}
+			turn := got.CoursePoints[1]
+			if turn.Instruction == nil || *turn.Instruction != "Turn left" || *turn.SegDistM != 222 {
+				t.Errorf("CoursePoints[1] = %+v", turn)
+			}
+		})
+	}
+}
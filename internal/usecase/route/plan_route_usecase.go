@@ -0,0 +1,112 @@
+package route
+
+import (
+	"context"
+
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+)
+
+type IPlanRouteUsecase interface {
+	PlanRoute(ctx context.Context, dto PlanRouteUseCaseInputDto) (*PlanRouteUseCaseOutputDto, error)
+}
+
+type planRouteUsecase struct {
+	userRepository user.IUserRepository
+	router         routeDomain.Router
+}
+
+func NewPlanRouteUsecase(userRepository user.IUserRepository, router routeDomain.Router) IPlanRouteUsecase {
+	return &planRouteUsecase{
+		userRepository: userRepository,
+		router:         router,
+	}
+}
+
+type PlanRouteUseCaseInputDto struct {
+	KratosID  string
+	Waypoints []orb.Point // 通る順の経由地（始点・終点を含む）
+	Profile   string      // road, gravel, avoid_highways。空の場合はroad
+	Locale    string      // 案内文の言語。空の場合はユーザーのロケール
+}
+
+// 探索した経路。保存はせず、そのままルート作成のリクエストに使えるようにする
+type PlanRouteUseCaseOutputDto struct {
+	Profile      string
+	Distance     float64
+	Duration     float64
+	PathGeom     orb.LineString
+	FirstPoint   orb.Point
+	LastPoint    orb.Point
+	CoursePoints []CoursePointOutput // 保存前のためIDは空
+}
+
+// PlanRoute はルーティングエンジンで経由地を順に通る経路を探索する
+func (u *planRouteUsecase) PlanRoute(ctx context.Context, dto PlanRouteUseCaseInputDto) (*PlanRouteUseCaseOutputDto, error) {
+	profile, err := routeDomain.ParseRoutingProfile(dto.Profile)
+	if err != nil {
+		return nil, err
+	}
+	if err := routeDomain.ValidatePlanWaypoints(dto.Waypoints); err != nil {
+		return nil, err
+	}
+
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, dto.KratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := u.router.Plan(ctx, dto.Waypoints, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlanRouteUseCaseOutputDto{
+		Profile:      string(profile),
+		Distance:     planned.Distance,
+		Duration:     planned.Duration,
+		PathGeom:     planned.Path,
+		FirstPoint:   planned.Path[0],
+		LastPoint:    planned.Path[len(planned.Path)-1],
+		CoursePoints: plannedCoursePoints(planned, cueLanguage(dto.Locale, userEntity)),
+	}, nil
+}
+
+// plannedCoursePoints は案内が必要な操作をコースポイントにする
+// 区間距離・所要時間は次のコースポイントまでの値にする
+func plannedCoursePoints(planned *routeDomain.PlannedRoute, lang routeDomain.CueLanguage) []CoursePointOutput {
+	cues := planned.CueManeuvers()
+	coursePoints := make([]CoursePointOutput, len(cues))
+	for i, m := range cues {
+		nextDist, nextDuration := planned.Distance, planned.Duration
+		if i < len(cues)-1 {
+			nextDist, nextDuration = cues[i+1].CumDistM, cues[i+1].CumDuration
+		}
+
+		var instruction *string
+		if text := routeDomain.CueInstruction(m.ManeuverType, m.Modifier, lang); text != "" {
+			instruction = &text
+		}
+		segDist := max(nextDist-m.CumDistM, 0)
+		cumDist := m.CumDistM
+		duration := max(nextDuration-m.CumDuration, 0)
+		maneuverType := m.ManeuverType
+		location := m.Location
+
+		coursePoints[i] = CoursePointOutput{
+			StepOrder:     int32(i),
+			SegDistM:      &segDist,
+			CumDistM:      &cumDist,
+			Duration:      &duration,
+			Instruction:   instruction,
+			RoadName:      m.RoadName,
+			ManeuverType:  &maneuverType,
+			Modifier:      m.Modifier,
+			Location:      &location,
+			BearingBefore: m.BearingBefore,
+			BearingAfter:  m.BearingAfter,
+		}
+	}
+	return coursePoints
+}
@@ -0,0 +1,102 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/routing"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_planRouteUsecase_PlanRoute(t *testing.T) {
+	t.Parallel()
+
+	// 東へ進んで経由地で北へ曲がる
+	waypoints := []orb.Point{{139.70, 35.68}, {139.705, 35.68}, {139.705, 35.685}}
+
+	tests := []struct {
+		name            string
+		dto             PlanRouteUseCaseInputDto
+		setupMocks      func(userRepo *userDomain.MockIUserRepository)
+		wantProfile     string
+		wantInstruction string
+		wantErr         error
+	}{
+		{
+			name: "正常系: 経由地を通る経路とコースポイントを返す",
+			dto:  PlanRouteUseCaseInputDto{KratosID: testKratosID, Waypoints: waypoints, Profile: "gravel", Locale: "en"},
+			setupMocks: func(userRepo *userDomain.MockIUserRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			},
+			wantProfile:     "gravel",
+			wantInstruction: "Turn left",
+		},
+		{
+			name: "正常系: 条件の指定がなければroadで探索する",
+			dto:  PlanRouteUseCaseInputDto{KratosID: testKratosID, Waypoints: waypoints},
+			setupMocks: func(userRepo *userDomain.MockIUserRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			},
+			wantProfile:     "road",
+			wantInstruction: "左折です",
+		},
+		{
+			name:       "異常系: 未対応の条件",
+			dto:        PlanRouteUseCaseInputDto{KratosID: testKratosID, Waypoints: waypoints, Profile: "mtb"},
+			setupMocks: func(userRepo *userDomain.MockIUserRepository) {},
+			wantErr:    domainerror.ErrValidation,
+		},
+		{
+			name:       "異常系: 経由地が1つしかない",
+			dto:        PlanRouteUseCaseInputDto{KratosID: testKratosID, Waypoints: waypoints[:1]},
+			setupMocks: func(userRepo *userDomain.MockIUserRepository) {},
+			wantErr:    domainerror.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			tt.setupMocks(userRepo)
+
+			uc := NewPlanRouteUsecase(userRepo, routing.NewFakeRouter())
+			got, err := uc.PlanRoute(context.Background(), tt.dto)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("PlanRoute() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PlanRoute() error = %v", err)
+			}
+			if got.Profile != tt.wantProfile {
+				t.Errorf("Profile = %s, want %s", got.Profile, tt.wantProfile)
+			}
+			if len(got.PathGeom) != 3 || got.FirstPoint != waypoints[0] || got.LastPoint != waypoints[2] {
+				t.Errorf("PathGeom = %v", got.PathGeom)
+			}
+			if len(got.CoursePoints) != 3 {
+				t.Fatalf("len(CoursePoints) = %d, want 3", len(got.CoursePoints))
+			}
+			if *got.CoursePoints[1].Instruction != tt.wantInstruction {
+				t.Errorf("Instruction = %s, want %s", *got.CoursePoints[1].Instruction, tt.wantInstruction)
+			}
+			// 区間距離を足すと総距離になる
+			total := 0.0
+			for _, cp := range got.CoursePoints {
+				total += *cp.SegDistM
+			}
+			if math.Abs(total-got.Distance) > 1e-6 {
+				t.Errorf("sum of SegDistM = %v, want %v", total, got.Distance)
+			}
+		})
+	}
+}
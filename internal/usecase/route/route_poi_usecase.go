@@ -0,0 +1,203 @@
+package route
+
+import (
+	"context"
+	"strconv"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/poi"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+)
+
+// POIの追加先
+type POIPromoteTarget string
+
+const (
+	POIPromoteTargetWaypoint    POIPromoteTarget = "waypoint"
+	POIPromoteTargetCoursePoint POIPromoteTarget = "course_point"
+)
+
+// IRoutePOIUsecase はルート沿いのPOI（カフェ、水飲み場など）を探し、ルートのウェイポイントやコースポイントにする
+type IRoutePOIUsecase interface {
+	ListRoutePOIs(ctx context.Context, dto ListRoutePOIsInputDto) (*RoutePOIListOutputDto, error)
+	PromotePOI(ctx context.Context, dto PromotePOIInputDto) error
+}
+
+type routePOIUsecase struct {
+	userRepository user.IUserRepository
+	txManager      transaction.TransactionManager
+	routeRepo      routeDomain.IRouteRepository
+	poiRepo        poi.IPOIRepository
+	clubs          routeDomain.ClubMembershipReader
+}
+
+func NewRoutePOIUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository, poiRepo poi.IPOIRepository, clubs routeDomain.ClubMembershipReader) IRoutePOIUsecase {
+	return &routePOIUsecase{
+		userRepository: userRepository,
+		txManager:      txManager,
+		routeRepo:      routeRepo,
+		poiRepo:        poiRepo,
+		clubs:          clubs,
+	}
+}
+
+// poiCursorSort はルート沿いのPOIのカーソルに入れる並び順。POIは経路上の位置の順だけ
+const poiCursorSort = "along_route"
+
+type ListRoutePOIsInputDto struct {
+	RouteID    string
+	KratosID   string
+	BufferM    float64  // ルートからの距離(m)。0の場合は既定値
+	Categories []string // 空の場合はすべての種類
+	Limit      int32    // 0の場合は既定値
+	Cursor     string   // 前のページのNextCursor。空の場合は始点から
+}
+
+type RoutePOIListOutputDto struct {
+	POIs       []RoutePOIOutputDto
+	NextCursor string // 次のページがない場合は空文字
+}
+
+type RoutePOIOutputDto struct {
+	ID       int64
+	Category string
+	Name     string
+	Location orb.Point
+	CumDistM float64 // 経路上の位置（始点からの距離(m)）
+	OffsetM  float64 // 経路からの距離(m)
+}
+
+type PromotePOIInputDto struct {
+	RouteID         string
+	KratosID        string
+	ExpectedVersion int32 // ルート取得時のバージョン
+	POIID           int64
+	Target          POIPromoteTarget
+}
+
+// ListRoutePOIs は閲覧できるルートの経路沿いのPOIを、経路上の位置の順に返す
+func (u *routePOIUsecase) ListRoutePOIs(ctx context.Context, dto ListRoutePOIsInputDto) (*RoutePOIListOutputDto, error) {
+	limit, err := pagination.NormalizeLimit(dto.Limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodePOICursor(dto.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	categories := make([]poi.Category, 0, len(dto.Categories))
+	for _, s := range dto.Categories {
+		c, err := poi.ParseCategory(s)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, c)
+	}
+	criteria, err := poi.NewAlongRouteCriteria(dto.RouteID, dto.BufferM, categories, limit, after)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := getVisibleRoute(ctx, u.userRepository, u.routeRepo, u.clubs, dto.RouteID, dto.KratosID); err != nil {
+		return nil, err
+	}
+
+	page, err := u.poiRepo.ListPOIsAlongRoute(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	output := &RoutePOIListOutputDto{POIs: make([]RoutePOIOutputDto, len(page.Items))}
+	for i, p := range page.Items {
+		output.POIs[i] = RoutePOIOutputDto{
+			ID:       p.POI.ID(),
+			Category: string(p.POI.Category()),
+			Name:     p.POI.Name(),
+			Location: p.POI.Location(),
+			CumDistM: p.CumDistM,
+			OffsetM:  p.OffsetM,
+		}
+	}
+	if page.Next != nil {
+		output.NextCursor = cursor.Encode(poiCursorSort, page.Next.CumDistM(), strconv.FormatInt(page.Next.ID(), 10))
+	}
+	return output, nil
+}
+
+// decodePOICursor はカーソルトークンを経路上の位置とPOIのIDに変換する
+func decodePOICursor(token string) (*poi.AlongRouteCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	sort, cumDistM, id, err := cursor.Decode(token)
+	if err != nil || sort != poiCursorSort {
+		return nil, domainerror.New("invalid cursor", domainerror.ErrValidation)
+	}
+	poiID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, domainerror.New("invalid cursor", domainerror.ErrValidation)
+	}
+	return poi.NewAlongRouteCursor(cumDistM, poiID), nil
+}
+
+// PromotePOI はPOIを自分のルートのウェイポイントまたはコースポイントとして追加する
+// 追加前の状態は版として残す
+func (u *routePOIUsecase) PromotePOI(ctx context.Context, dto PromotePOIInputDto) error {
+	if dto.Target != POIPromoteTargetWaypoint && dto.Target != POIPromoteTargetCoursePoint {
+		return domainerror.New("target must be waypoint or course_point", domainerror.ErrValidation)
+	}
+
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, dto.KratosID)
+	if err != nil {
+		return err
+	}
+
+	route, err := u.routeRepo.GetRouteByID(ctx, dto.RouteID)
+	if err != nil {
+		return err
+	}
+	if route.UserID() != userEntity.ID().String() {
+		return domainerror.New("user does not own the route", domainerror.ErrUnauthorized)
+	}
+	if err := route.CheckVersion(dto.ExpectedVersion); err != nil {
+		return err
+	}
+
+	target, err := u.poiRepo.GetPOIByID(ctx, dto.POIID)
+	if err != nil {
+		return err
+	}
+
+	current, err := routeDomain.NewRouteVersion(route, userEntity.ID().String())
+	if err != nil {
+		return err
+	}
+	location := routeDomain.Geometry{Geometry: target.Location()}
+	if dto.Target == POIPromoteTargetWaypoint {
+		err = route.InsertWaypoint(location)
+	} else {
+		category := string(target.Category())
+		var instruction *string
+		if name := target.Name(); name != "" {
+			instruction = &name
+		}
+		err = route.InsertCoursePoint(location, routeDomain.ManeuverPOI, &category, instruction)
+	}
+	if err != nil {
+		return err
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		routeRepo := repository.NewRouteRepository(q)
+		if err := routeRepo.SaveRouteVersion(ctx, current); err != nil {
+			return err
+		}
+		return routeRepo.UpdateRoute(ctx, route)
+	})
+}
@@ -0,0 +1,261 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	poiDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/poi"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+// newTestRouteWithCues の東へ進む区間のすぐ北にあるカフェ
+var testCafePOI = poiDomain.ReconstructPOI(1, poiDomain.OSMTypeNode, 5001, poiDomain.CategoryCafe, "喫茶ひなた", orb.Point{139.7025, 35.6801})
+
+func Test_routePOIUsecase_ListRoutePOIs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		dto        ListRoutePOIsInputDto
+		setupMocks func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository)
+		want       int
+		wantNext   bool
+		wantErr    error
+	}{
+		{
+			name: "正常系: 既定の範囲で経路沿いのPOIを返す",
+			dto:  ListRoutePOIsInputDto{RouteID: testRouteID, KratosID: testKratosID, Categories: []string{"cafe", "drinking_water"}},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithCues(t), nil)
+				poiRepo.EXPECT().ListPOIsAlongRoute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *poiDomain.AlongRouteCriteria) (*poiDomain.RoutePOIPage, error) {
+						if c.BufferM() != poiDomain.DefaultBufferM || len(c.Categories()) != 2 || c.Limit() != pagination.DefaultLimit || c.After() != nil {
+							t.Errorf("criteria = %+v", c)
+						}
+						return &poiDomain.RoutePOIPage{Items: []*poiDomain.RoutePOI{{POI: testCafePOI, CumDistM: 226, OffsetM: 11}}}, nil
+					})
+			},
+			want: 1,
+		},
+		{
+			name: "正常系: カーソルの経路上の位置とIDから続きを返す",
+			dto:  ListRoutePOIsInputDto{RouteID: testRouteID, KratosID: testKratosID, Limit: 1, Cursor: cursor.Encode(poiCursorSort, 120.5, "7")},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(newTestRouteWithCues(t), nil)
+				poiRepo.EXPECT().ListPOIsAlongRoute(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *poiDomain.AlongRouteCriteria) (*poiDomain.RoutePOIPage, error) {
+						if c.Limit() != 1 || c.After() == nil || c.After().CumDistM() != 120.5 || c.After().ID() != 7 {
+							t.Errorf("criteria = %+v", c)
+						}
+						return &poiDomain.RoutePOIPage{
+							Items: []*poiDomain.RoutePOI{{POI: testCafePOI, CumDistM: 226, OffsetM: 11}},
+							Next:  poiDomain.NewAlongRouteCursor(226, 1),
+						}, nil
+					})
+			},
+			want:     1,
+			wantNext: true,
+		},
+		{
+			name: "異常系: 他の一覧のカーソル",
+			dto:  ListRoutePOIsInputDto{RouteID: testRouteID, KratosID: testKratosID, Cursor: cursor.Encode("newest", 1, "019b5a50-0000-7000-8000-000000000001")},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository) {
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name: "異常系: 取得件数が上限を超える",
+			dto:  ListRoutePOIsInputDto{RouteID: testRouteID, KratosID: testKratosID, Limit: pagination.MaxLimit + 1},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository) {
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name: "異常系: 未対応の種類",
+			dto:  ListRoutePOIsInputDto{RouteID: testRouteID, KratosID: testKratosID, Categories: []string{"restaurant"}},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository) {
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name: "異常系: 範囲が広すぎる",
+			dto:  ListRoutePOIsInputDto{RouteID: testRouteID, KratosID: testKratosID, BufferM: 5000},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository) {
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name: "異常系: 他のユーザーの非公開ルート",
+			dto:  ListRoutePOIsInputDto{RouteID: testRouteID, KratosID: testKratosID},
+			setupMocks: func(t *testing.T, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestForkSourceRoute(routeDomain.VisibilityPrivate), nil)
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			routeRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			poiRepo := poiDomain.NewMockIPOIRepository(ctrl)
+			txManager := transactionApp.NewMockTransactionManager(ctrl)
+			tt.setupMocks(t, routeRepo, userRepo, poiRepo)
+
+			uc := NewRoutePOIUsecase(userRepo, txManager, routeRepo, poiRepo, newTestClubReader(ctrl))
+			got, err := uc.ListRoutePOIs(context.Background(), tt.dto)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ListRoutePOIs() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListRoutePOIs() error = %v", err)
+			}
+			if len(got.POIs) != tt.want {
+				t.Fatalf("len(ListRoutePOIs().POIs) = %d, want %d", len(got.POIs), tt.want)
+			}
+			if p := got.POIs[0]; p.ID != 1 || p.Category != "cafe" || p.Name != "喫茶ひなた" || p.CumDistM != 226 {
+				t.Errorf("ListRoutePOIs().POIs[0] = %+v", p)
+			}
+			if (got.NextCursor != "") != tt.wantNext {
+				t.Fatalf("NextCursor = %q, wantNext %v", got.NextCursor, tt.wantNext)
+			}
+			if tt.wantNext {
+				// 次のページのカーソルは最後のPOIの経路上の位置とIDを指す
+				next, err := decodePOICursor(got.NextCursor)
+				if err != nil || next.CumDistM() != 226 || next.ID() != 1 {
+					t.Errorf("decodePOICursor(NextCursor) = %+v, %v", next, err)
+				}
+			}
+		})
+	}
+}
+
+func Test_routePOIUsecase_PromotePOI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		target          POIPromoteTarget
+		expectedVersion int32
+		setupMocks      func(t *testing.T, route *routeDomain.Route, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository, txManager *transactionApp.MockTransactionManager)
+		check           func(t *testing.T, route *routeDomain.Route)
+		wantErr         error
+	}{
+		{
+			name:            "正常系: コースポイントとして経路上の位置に追加する",
+			target:          POIPromoteTargetCoursePoint,
+			expectedVersion: 1,
+			setupMocks: func(t *testing.T, route *routeDomain.Route, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(route, nil)
+				poiRepo.EXPECT().GetPOIByID(gomock.Any(), int64(1)).Return(testCafePOI, nil)
+				txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, route *routeDomain.Route) {
+				cps := route.CoursePoints()
+				if len(cps) != 4 {
+					t.Fatalf("len(CoursePoints) = %d, want 4", len(cps))
+				}
+				// 出発と左折の間に入る
+				cp := cps[1]
+				if *cp.ManeuverType() != routeDomain.ManeuverPOI || *cp.Modifier() != "cafe" || *cp.Instruction() != "喫茶ひなた" {
+					t.Errorf("CoursePoints[1] = %+v", cp)
+				}
+			},
+		},
+		{
+			name:            "正常系: ウェイポイントとして追加する",
+			target:          POIPromoteTargetWaypoint,
+			expectedVersion: 1,
+			setupMocks: func(t *testing.T, route *routeDomain.Route, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(route, nil)
+				poiRepo.EXPECT().GetPOIByID(gomock.Any(), int64(1)).Return(testCafePOI, nil)
+				txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			check: func(t *testing.T, route *routeDomain.Route) {
+				wps := route.Waypoints()
+				if len(wps) != 1 || wps[0].Location().Geometry != testCafePOI.Location() {
+					t.Errorf("Waypoints = %+v", wps)
+				}
+			},
+		},
+		{
+			name:            "異常系: 追加先が不正",
+			target:          "photo",
+			expectedVersion: 1,
+			setupMocks: func(t *testing.T, route *routeDomain.Route, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository, txManager *transactionApp.MockTransactionManager) {
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name:            "異常系: バージョンが古い",
+			target:          POIPromoteTargetCoursePoint,
+			expectedVersion: 0,
+			setupMocks: func(t *testing.T, route *routeDomain.Route, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(route, nil)
+			},
+			wantErr: domainerror.ErrConflict,
+		},
+		{
+			name:            "異常系: POIが存在しない",
+			target:          POIPromoteTargetWaypoint,
+			expectedVersion: 1,
+			setupMocks: func(t *testing.T, route *routeDomain.Route, routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, poiRepo *poiDomain.MockIPOIRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(route, nil)
+				poiRepo.EXPECT().GetPOIByID(gomock.Any(), int64(1)).Return(nil, domainerror.New("poi not found", domainerror.ErrNotFound))
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			routeRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			poiRepo := poiDomain.NewMockIPOIRepository(ctrl)
+			txManager := transactionApp.NewMockTransactionManager(ctrl)
+			route := newTestRouteWithCues(t)
+			tt.setupMocks(t, route, routeRepo, userRepo, poiRepo, txManager)
+
+			uc := NewRoutePOIUsecase(userRepo, txManager, routeRepo, poiRepo, newTestClubReader(ctrl))
+			err := uc.PromotePOI(context.Background(), PromotePOIInputDto{
+				RouteID:         testRouteID,
+				KratosID:        testKratosID,
+				ExpectedVersion: tt.expectedVersion,
+				POIID:           1,
+				Target:          tt.target,
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("PromotePOI() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PromotePOI() error = %v", err)
+			}
+			tt.check(t, route)
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package route
+
+import (
+	"context"
+	"log"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	feedDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/feed"
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+)
+
+// IRouteReactionUsecase はルートへのいいね・コメント・保存を扱う
+// いいね・コメントはフォロワーのフィードに表示する出来事として同じトランザクションで記録し、取り消したときは出来事も削除する
+// いいね・コメント・保存はルートの所有者に通知する
+type IRouteReactionUsecase interface {
+	LikeRoute(ctx context.Context, kratosID string, routeID string) error
+	UnlikeRoute(ctx context.Context, kratosID string, routeID string) error
+	// kratosIDが空の場合は未ログインのユーザーとして扱う。コメントは古い順に返す
+	ListComments(ctx context.Context, routeID string, kratosID string, input ListRouteCommentsInputDto) (*RouteCommentListOutputDto, error)
+	AddComment(ctx context.Context, kratosID string, routeID string, content string) (*RouteCommentOutputDto, error)
+	DeleteComment(ctx context.Context, kratosID string, routeID string, commentID string) error
+	SaveRoute(ctx context.Context, kratosID string, routeID string) error
+	UnsaveRoute(ctx context.Context, kratosID string, routeID string) error
+}
+
+type routeReactionUsecase struct {
+	userRepository user.IUserRepository
+	txManager      transaction.TransactionManager
+	routeRepo      routeDomain.IRouteRepository
+	clubs          routeDomain.ClubMembershipReader
+	publisher      notificationDomain.Publisher
+}
+
+func NewRouteReactionUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository, clubs routeDomain.ClubMembershipReader, publisher notificationDomain.Publisher) IRouteReactionUsecase {
+	return &routeReactionUsecase{
+		userRepository: userRepository,
+		txManager:      txManager,
+		routeRepo:      routeRepo,
+		clubs:          clubs,
+		publisher:      publisher,
+	}
+}
+
+// commentCursorSort はコメント一覧のカーソルに入れる並び順。コメントは古い順だけ
+const commentCursorSort = "oldest"
+
+type ListRouteCommentsInputDto struct {
+	Limit  int32  // 0の場合は既定値
+	Cursor string // 前のページのNextCursor。空の場合は先頭から
+}
+
+type RouteCommentListOutputDto struct {
+	Comments   []RouteCommentOutputDto
+	NextCursor string // 次のページがない場合は空文字
+}
+
+type RouteCommentOutputDto struct {
+	ID        string
+	UserID    string
+	UserName  string
+	Content   string
+	CreatedAt string
+}
+
+// LikeRoute はルートにいいねする。閲覧できないルートは見つからないものとして扱う
+func (u *routeReactionUsecase) LikeRoute(ctx context.Context, kratosID string, routeID string) error {
+	route, viewer, err := u.getVisibleRoute(ctx, routeID, kratosID)
+	if err != nil {
+		return err
+	}
+	event, err := feedDomain.NewEvent(feedDomain.EventTypeRouteLiked, viewer.UserID(), route.ID())
+	if err != nil {
+		return err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		if err := repository.NewRouteRepository(q).LikeRoute(ctx, viewer.UserID(), route.ID()); err != nil {
+			return err
+		}
+		return repository.NewFeedRepository(q).SaveEvent(ctx, event)
+	})
+	if err != nil {
+		return err
+	}
+	publishActivity(ctx, u.publisher, notificationDomain.NewRouteActivity(notificationDomain.TypeRouteLiked, viewer.UserID(), route.UserID(), route.ID()))
+	return nil
+}
+
+// UnlikeRoute はいいねを取り消す。公開範囲が変わって閲覧できなくなったルートでも取り消せる
+func (u *routeReactionUsecase) UnlikeRoute(ctx context.Context, kratosID string, routeID string) error {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+	userID := userEntity.ID().String()
+
+	return u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		if err := repository.NewRouteRepository(q).UnlikeRoute(ctx, userID, routeID); err != nil {
+			return err
+		}
+		return repository.NewFeedRepository(q).DeleteEvents(ctx, userID, feedDomain.EventTypeRouteLiked, routeID)
+	})
+}
+
+func (u *routeReactionUsecase) ListComments(ctx context.Context, routeID string, kratosID string, input ListRouteCommentsInputDto) (*RouteCommentListOutputDto, error) {
+	limit, err := pagination.NormalizeLimit(input.Limit)
+	if err != nil {
+		return nil, err
+	}
+	cursorSort, after, err := decodeCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if after != nil && cursorSort != commentCursorSort {
+		return nil, domainerror.New("invalid cursor", domainerror.ErrValidation)
+	}
+
+	route, _, err := u.getVisibleRoute(ctx, routeID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := routeDomain.NewCommentCriteria(route.ID(), limit, after)
+	if err != nil {
+		return nil, err
+	}
+	page, err := u.routeRepo.GetComments(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	output := &RouteCommentListOutputDto{Comments: make([]RouteCommentOutputDto, len(page.Items))}
+	for i, c := range page.Items {
+		output.Comments[i] = toRouteCommentOutputDto(c)
+	}
+	if page.Next != nil {
+		output.NextCursor = cursor.Encode(commentCursorSort, page.Next.SortKey(), page.Next.ID())
+	}
+	return output, nil
+}
+
+func (u *routeReactionUsecase) AddComment(ctx context.Context, kratosID string, routeID string, content string) (*RouteCommentOutputDto, error) {
+	route, viewer, err := u.getVisibleRoute(ctx, routeID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := routeDomain.NewComment(route.ID(), viewer.UserID(), content)
+	if err != nil {
+		return nil, err
+	}
+	event, err := feedDomain.NewEvent(feedDomain.EventTypeRouteCommented, viewer.UserID(), c.ID())
+	if err != nil {
+		return nil, err
+	}
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		if err := repository.NewRouteRepository(q).SaveComment(ctx, c); err != nil {
+			return err
+		}
+		return repository.NewFeedRepository(q).SaveEvent(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	publishActivity(ctx, u.publisher, notificationDomain.NewRouteActivity(notificationDomain.TypeRouteCommented, viewer.UserID(), route.UserID(), route.ID()))
+
+	saved, err := u.routeRepo.GetComment(ctx, c.ID())
+	if err != nil {
+		return nil, err
+	}
+	output := toRouteCommentOutputDto(saved)
+	return &output, nil
+}
+
+// DeleteComment はコメントを削除する。削除できるのはコメントした本人とルートの所有者
+func (u *routeReactionUsecase) DeleteComment(ctx context.Context, kratosID string, routeID string, commentID string) error {
+	route, viewer, err := u.getVisibleRoute(ctx, routeID, kratosID)
+	if err != nil {
+		return err
+	}
+
+	c, err := u.routeRepo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if c.RouteID() != route.ID() {
+		return domainerror.New("comment not found", domainerror.ErrNotFound)
+	}
+	if !c.CanBeDeletedBy(viewer.UserID(), route) {
+		return domainerror.New("user cannot delete the comment", domainerror.ErrUnauthorized)
+	}
+	return u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		if err := repository.NewRouteRepository(q).DeleteComment(ctx, c.ID()); err != nil {
+			return err
+		}
+		// 出来事の行為者はルートの所有者ではなくコメントした本人
+		return repository.NewFeedRepository(q).DeleteEvents(ctx, c.UserID(), feedDomain.EventTypeRouteCommented, c.ID())
+	})
+}
+
+// SaveRoute はルートを保存する。閲覧できないルートは見つからないものとして扱う
+func (u *routeReactionUsecase) SaveRoute(ctx context.Context, kratosID string, routeID string) error {
+	route, viewer, err := u.getVisibleRoute(ctx, routeID, kratosID)
+	if err != nil {
+		return err
+	}
+	if err := u.routeRepo.AddSavedRoute(ctx, viewer.UserID(), route.ID()); err != nil {
+		return err
+	}
+	publishActivity(ctx, u.publisher, notificationDomain.NewRouteActivity(notificationDomain.TypeRouteSaved, viewer.UserID(), route.UserID(), route.ID()))
+	return nil
+}
+
+// UnsaveRoute は保存を取り消す。公開範囲が変わって閲覧できなくなったルートでも取り消せる
+func (u *routeReactionUsecase) UnsaveRoute(ctx context.Context, kratosID string, routeID string) error {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+	return u.routeRepo.RemoveSavedRoute(ctx, userEntity.ID().String(), routeID)
+}
+
+// publishActivity はルートへの反応をActivityとして発行する
+// 通知に失敗してもいいねなどは取り消さないため、ログに出すだけにする
+func publishActivity(ctx context.Context, publisher notificationDomain.Publisher, activity notificationDomain.Activity) {
+	if err := publisher.Publish(ctx, activity); err != nil {
+		log.Printf("failed to publish %s notification to %s: %v\n", activity.Type(), activity.RecipientID(), err)
+	}
+}
+
+func (u *routeReactionUsecase) getVisibleRoute(ctx context.Context, routeID string, kratosID string) (*routeDomain.Route, routeDomain.Viewer, error) {
+	return getVisibleRoute(ctx, u.userRepository, u.routeRepo, u.clubs, routeID, kratosID)
+}
+
+func toRouteCommentOutputDto(c *routeDomain.Comment) RouteCommentOutputDto {
+	return RouteCommentOutputDto{
+		ID:        c.ID(),
+		UserID:    c.UserID(),
+		UserName:  c.UserName(),
+		Content:   c.Content(),
+		CreatedAt: c.CreatedAt(),
+	}
+}
@@ -0,0 +1,289 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"go.uber.org/mock/gomock"
+)
+
+const testCommentID = "019b5a67-0000-7000-8000-000000000101"
+
+type routeReactionTestMocks struct {
+	userRepo  *userDomain.MockIUserRepository
+	routeRepo *routeDomain.MockIRouteRepository
+	txManager *transactionApp.MockTransactionManager
+	publisher *notificationDomain.MockPublisher
+	usecase   IRouteReactionUsecase
+}
+
+func setupRouteReactionMocks(t *testing.T) *routeReactionTestMocks {
+	ctrl := gomock.NewController(t)
+	m := &routeReactionTestMocks{
+		userRepo:  userDomain.NewMockIUserRepository(ctrl),
+		routeRepo: routeDomain.NewMockIRouteRepository(ctrl),
+		txManager: transactionApp.NewMockTransactionManager(ctrl),
+		publisher: notificationDomain.NewMockPublisher(ctrl),
+	}
+	m.usecase = NewRouteReactionUsecase(m.userRepo, m.txManager, m.routeRepo, newTestClubReader(ctrl), m.publisher)
+	return m
+}
+
+func Test_routeReactionUsecase_LikeRoute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		route   *routeDomain.Route
+		wantErr error
+	}{
+		{name: "正常系: 他のユーザーの公開ルートにいいねする", route: createTestForkSourceRoute(routeDomain.VisibilityPublic)},
+		{name: "異常系: 他のユーザーの非公開ルートは見つからない", route: createTestForkSourceRoute(routeDomain.VisibilityPrivate), wantErr: domainerror.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupRouteReactionMocks(t)
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(tt.route, nil)
+			if tt.wantErr == nil {
+				m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+				m.publisher.EXPECT().Publish(gomock.Any(), notificationDomain.NewRouteActivity(notificationDomain.TypeRouteLiked, testUserID, testSourceOwnerID, testRouteID)).Return(nil)
+			}
+
+			err := m.usecase.LikeRoute(context.Background(), testKratosID, testRouteID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("LikeRoute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_routeReactionUsecase_UnlikeRoute(t *testing.T) {
+	t.Parallel()
+	m := setupRouteReactionMocks(t)
+	m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+	// 閲覧できなくなったルートでも取り消せるよう、ルートは取得しない
+	m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+	if err := m.usecase.UnlikeRoute(context.Background(), testKratosID, testRouteID); err != nil {
+		t.Errorf("UnlikeRoute() error = %v", err)
+	}
+}
+
+func Test_routeReactionUsecase_ListComments(t *testing.T) {
+	t.Parallel()
+	m := setupRouteReactionMocks(t)
+	// 未ログインでも公開ルートのコメントは取得できる
+	m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestForkSourceRoute(routeDomain.VisibilityPublic), nil)
+	next, _ := pagination.NewCursor(1720083600, testCommentID)
+	m.routeRepo.EXPECT().GetComments(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *routeDomain.CommentCriteria) (*routeDomain.CommentPage, error) {
+		if c.RouteID() != testRouteID || c.Limit() != pagination.DefaultLimit || c.After() != nil {
+			t.Errorf("GetComments() criteria = %+v", c)
+		}
+		return &routeDomain.CommentPage{
+			Items: []*routeDomain.Comment{
+				routeDomain.ReconstructComment(testCommentID, testRouteID, testUserID, testUserName, "景色がいいです", "2024-07-04T09:00:00Z"),
+			},
+			Next: next,
+		}, nil
+	})
+
+	got, err := m.usecase.ListComments(context.Background(), testRouteID, "", ListRouteCommentsInputDto{})
+	if err != nil {
+		t.Fatalf("ListComments() error = %v", err)
+	}
+	if len(got.Comments) != 1 || got.Comments[0].ID != testCommentID || got.Comments[0].Content != "景色がいいです" || got.Comments[0].UserName != testUserName {
+		t.Errorf("ListComments() = %+v", got)
+	}
+	if want := cursor.Encode(commentCursorSort, 1720083600, testCommentID); got.NextCursor != want {
+		t.Errorf("NextCursor = %q, want %q", got.NextCursor, want)
+	}
+}
+
+func Test_routeReactionUsecase_ListComments_Cursor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cursor  string
+		wantErr error
+	}{
+		{name: "正常系: 前のページの続きから取得する", cursor: cursor.Encode(commentCursorSort, 1720083600, testCommentID)},
+		{name: "異常系: 不正なカーソル", cursor: "invalid", wantErr: domainerror.ErrValidation},
+		{name: "異常系: 他の一覧のカーソル", cursor: cursor.Encode("newest", 1720083600, testCommentID), wantErr: domainerror.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupRouteReactionMocks(t)
+			if tt.wantErr == nil {
+				m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestForkSourceRoute(routeDomain.VisibilityPublic), nil)
+				m.routeRepo.EXPECT().GetComments(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *routeDomain.CommentCriteria) (*routeDomain.CommentPage, error) {
+					if c.After() == nil || c.After().ID() != testCommentID {
+						t.Errorf("GetComments() after = %+v", c.After())
+					}
+					return &routeDomain.CommentPage{Items: []*routeDomain.Comment{}}, nil
+				})
+			}
+
+			got, err := m.usecase.ListComments(context.Background(), testRouteID, "", ListRouteCommentsInputDto{Cursor: tt.cursor})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ListComments() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (len(got.Comments) != 0 || got.NextCursor != "") {
+				t.Errorf("ListComments() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_routeReactionUsecase_AddComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "正常系: コメントする", content: " 景色がいいです "},
+		{name: "異常系: 空のコメント", content: "  ", wantErr: domainerror.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupRouteReactionMocks(t)
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestForkSourceRoute(routeDomain.VisibilityPublic), nil)
+			if tt.wantErr == nil {
+				m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+				m.publisher.EXPECT().Publish(gomock.Any(), notificationDomain.NewRouteActivity(notificationDomain.TypeRouteCommented, testUserID, testSourceOwnerID, testRouteID)).Return(nil)
+				m.routeRepo.EXPECT().GetComment(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id string) (*routeDomain.Comment, error) {
+					return routeDomain.ReconstructComment(id, testRouteID, testUserID, testUserName, "景色がいいです", "2024-07-04T09:00:00Z"), nil
+				})
+			}
+
+			got, err := m.usecase.AddComment(context.Background(), testKratosID, testRouteID, tt.content)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("AddComment() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddComment() error = %v", err)
+			}
+			if got.ID == "" || got.Content != "景色がいいです" || got.UserID != testUserID {
+				t.Errorf("AddComment() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_routeReactionUsecase_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	const otherUserID = "019b5a46-a03e-7ea3-af09-86f74ff39aa2"
+	tests := []struct {
+		name    string
+		route   *routeDomain.Route
+		comment *routeDomain.Comment
+		wantErr error
+	}{
+		{
+			name:    "正常系: 自分のルートへの他人のコメントを削除する",
+			route:   createTestRoute(testUserID),
+			comment: routeDomain.ReconstructComment(testCommentID, testRouteID, otherUserID, "Other", "宣伝です", ""),
+		},
+		{
+			name:    "正常系: 他人のルートへの自分のコメントを削除する",
+			route:   createTestForkSourceRoute(routeDomain.VisibilityPublic),
+			comment: routeDomain.ReconstructComment(testCommentID, testRouteID, testUserID, testUserName, "景色がいいです", ""),
+		},
+		{
+			name:    "異常系: 他人のルートへの他人のコメント",
+			route:   createTestForkSourceRoute(routeDomain.VisibilityPublic),
+			comment: routeDomain.ReconstructComment(testCommentID, testRouteID, otherUserID, "Other", "宣伝です", ""),
+			wantErr: domainerror.ErrUnauthorized,
+		},
+		{
+			name:    "異常系: 別のルートへのコメント",
+			route:   createTestRoute(testUserID),
+			comment: routeDomain.ReconstructComment(testCommentID, "019b5a50-0000-7000-8000-000000000009", otherUserID, "Other", "宣伝です", ""),
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupRouteReactionMocks(t)
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(tt.route, nil)
+			m.routeRepo.EXPECT().GetComment(gomock.Any(), testCommentID).Return(tt.comment, nil)
+			if tt.wantErr == nil {
+				m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err := m.usecase.DeleteComment(context.Background(), testKratosID, testRouteID, testCommentID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeleteComment() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_routeReactionUsecase_SaveRoute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		route      *routeDomain.Route
+		saveErr    error
+		publishErr error
+		wantErr    error
+	}{
+		{name: "正常系: 他のユーザーの公開ルートを保存して所有者に通知する", route: createTestForkSourceRoute(routeDomain.VisibilityPublic)},
+		{name: "正常系: 通知に失敗しても保存は取り消さない", route: createTestForkSourceRoute(routeDomain.VisibilityPublic), publishErr: errors.New("db error")},
+		{name: "異常系: 保存済みのルート", route: createTestForkSourceRoute(routeDomain.VisibilityPublic), saveErr: domainerror.New("route already saved", domainerror.ErrConflict), wantErr: domainerror.ErrConflict},
+		{name: "異常系: 他のユーザーの非公開ルートは見つからない", route: createTestForkSourceRoute(routeDomain.VisibilityPrivate), wantErr: domainerror.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupRouteReactionMocks(t)
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			m.routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(tt.route, nil)
+			if tt.route.IsVisibleTo(routeDomain.NewViewer(testUserID, nil)) {
+				m.routeRepo.EXPECT().AddSavedRoute(gomock.Any(), testUserID, testRouteID).Return(tt.saveErr)
+			}
+			if tt.wantErr == nil {
+				m.publisher.EXPECT().Publish(gomock.Any(), notificationDomain.NewRouteActivity(notificationDomain.TypeRouteSaved, testUserID, testSourceOwnerID, testRouteID)).Return(tt.publishErr)
+			}
+
+			err := m.usecase.SaveRoute(context.Background(), testKratosID, testRouteID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SaveRoute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_routeReactionUsecase_UnsaveRoute(t *testing.T) {
+	t.Parallel()
+	m := setupRouteReactionMocks(t)
+	m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+	// 閲覧できなくなったルートでも取り消せるよう、ルートは取得しない
+	m.routeRepo.EXPECT().RemoveSavedRoute(gomock.Any(), testUserID, testRouteID).Return(nil)
+
+	if err := m.usecase.UnsaveRoute(context.Background(), testKratosID, testRouteID); err != nil {
+		t.Errorf("UnsaveRoute() error = %v", err)
+	}
+}
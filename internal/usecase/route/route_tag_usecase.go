@@ -0,0 +1,65 @@
+package route
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+)
+
+// IRouteTagUsecase は自分のルートに付けるタグを管理する
+type IRouteTagUsecase interface {
+	UpdateRouteTags(ctx context.Context, dto UpdateRouteTagsInputDto) ([]string, error)
+}
+
+type routeTagUsecase struct {
+	userRepository user.IUserRepository
+	txManager      transaction.TransactionManager
+	routeRepo      routeDomain.IRouteRepository
+}
+
+func NewRouteTagUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository) IRouteTagUsecase {
+	return &routeTagUsecase{
+		userRepository: userRepository,
+		txManager:      txManager,
+		routeRepo:      routeRepo,
+	}
+}
+
+type UpdateRouteTagsInputDto struct {
+	RouteID  string
+	KratosID string
+	Tags     []string // 付けるタグのすべて。空の場合はタグを外す
+}
+
+// UpdateRouteTags はルートのタグを置き換え、表記を揃えたタグを返す
+// タグは経路を変えないため、バージョンの確認はしない
+func (u *routeTagUsecase) UpdateRouteTags(ctx context.Context, dto UpdateRouteTagsInputDto) ([]string, error) {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, dto.KratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := u.routeRepo.GetRouteByID(ctx, dto.RouteID)
+	if err != nil {
+		return nil, err
+	}
+	if route.UserID() != userEntity.ID().String() {
+		return nil, domainerror.New("user does not own the route", domainerror.ErrUnauthorized)
+	}
+	if err := route.UpdateTags(dto.Tags); err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewRouteRepository(q).SaveRouteTags(ctx, route)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return route.Tags(), nil
+}
@@ -0,0 +1,96 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_routeTagUsecase_UpdateRouteTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		tags       []string
+		setupMocks func(routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager)
+		want       []string
+		wantErr    error
+	}{
+		{
+			name: "正常系: 表記を揃えて保存する",
+			tags: []string{"#Gravel", "峠", "gravel"},
+			setupMocks: func(routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute(testUserID), nil)
+				txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: []string{"gravel", "峠"},
+		},
+		{
+			name: "正常系: 空の場合はタグを外す",
+			tags: []string{},
+			setupMocks: func(routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute(testUserID), nil)
+				txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: []string{},
+		},
+		{
+			name: "異常系: 他のユーザーのルート",
+			tags: []string{"峠"},
+			setupMocks: func(routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestForkSourceRoute(routeDomain.VisibilityPublic), nil)
+			},
+			wantErr: domainerror.ErrUnauthorized,
+		},
+		{
+			name: "異常系: タグが長すぎる",
+			tags: []string{strings.Repeat("a", routeDomain.MaxTagLength+1)},
+			setupMocks: func(routeRepo *routeDomain.MockIRouteRepository, userRepo *userDomain.MockIUserRepository, txManager *transactionApp.MockTransactionManager) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				routeRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute(testUserID), nil)
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			routeRepo := routeDomain.NewMockIRouteRepository(ctrl)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			txManager := transactionApp.NewMockTransactionManager(ctrl)
+			tt.setupMocks(routeRepo, userRepo, txManager)
+
+			uc := NewRouteTagUsecase(userRepo, txManager, routeRepo)
+			got, err := uc.UpdateRouteTags(context.Background(), UpdateRouteTagsInputDto{
+				RouteID:  testRouteID,
+				KratosID: testKratosID,
+				Tags:     tt.tags,
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("UpdateRouteTags() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateRouteTags() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateRouteTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
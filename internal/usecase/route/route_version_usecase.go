@@ -0,0 +1,202 @@
+package route
+
+import (
+	"context"
+	"fmt"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+)
+
+type IRouteVersionUsecase interface {
+	ListRouteVersions(ctx context.Context, routeID string, kratosID string) ([]*RouteVersionSummaryDto, error)
+	GetRouteVersion(ctx context.Context, routeID string, kratosID string, versionNumber int32) (*RouteVersionDto, error)
+	RestoreRouteVersion(ctx context.Context, routeID string, kratosID string, versionNumber int32) error
+}
+
+type routeVersionUsecase struct {
+	userRepository user.IUserRepository
+	txManager      transaction.TransactionManager
+	routeRepo      routeDomain.IRouteRepository
+	geocoder       place.Geocoder
+}
+
+func NewRouteVersionUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository, geocoder place.Geocoder) IRouteVersionUsecase {
+	return &routeVersionUsecase{
+		userRepository: userRepository,
+		txManager:      txManager,
+		routeRepo:      routeRepo,
+		geocoder:       geocoder,
+	}
+}
+
+// 版の一覧の出力DTO。差分は「この版の値 - 現在の値」
+type RouteVersionSummaryDto struct {
+	VersionNumber       int32
+	EditorID            string
+	Name                string
+	Distance            float64
+	Duration            float64
+	ElevationGain       float64
+	ElevationLoss       float64
+	CreatedAt           string
+	DistanceChange      float64
+	DurationChange      float64
+	ElevationGainChange float64
+	ElevationLossChange float64
+}
+
+// 版の詳細の出力DTO
+type RouteVersionDto struct {
+	RouteVersionSummaryDto
+	RouteID            string
+	Description        string
+	HighlightedPhotoID *int64
+	PathGeom           orb.LineString
+	FirstPoint         orb.Point
+	LastPoint          orb.Point
+	Visibility         int16
+	CoursePoints       []CoursePointOutput
+	Waypoints          []WaypointOutput
+}
+
+func (u *routeVersionUsecase) ListRouteVersions(ctx context.Context, routeID string, kratosID string) ([]*RouteVersionSummaryDto, error) {
+	route, err := u.getOwnedRoute(ctx, routeID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := u.routeRepo.GetRouteVersions(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*RouteVersionSummaryDto, len(versions))
+	for i, v := range versions {
+		summary := toRouteVersionSummaryDto(v, route)
+		dtos[i] = &summary
+	}
+	return dtos, nil
+}
+
+func (u *routeVersionUsecase) GetRouteVersion(ctx context.Context, routeID string, kratosID string, versionNumber int32) (*RouteVersionDto, error) {
+	route, err := u.getOwnedRoute(ctx, routeID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := u.routeRepo.GetRouteVersion(ctx, routeID, versionNumber)
+	if err != nil {
+		return nil, err
+	}
+	path, ok := v.PathGeom().Geometry.(orb.LineString)
+	if !ok {
+		return nil, fmt.Errorf("route version %d of %s has invalid path: %T", versionNumber, routeID, v.PathGeom().Geometry)
+	}
+	firstPoint, ok := v.FirstPoint().Geometry.(orb.Point)
+	if !ok {
+		return nil, fmt.Errorf("route version %d of %s has invalid first point: %T", versionNumber, routeID, v.FirstPoint().Geometry)
+	}
+	lastPoint, ok := v.LastPoint().Geometry.(orb.Point)
+	if !ok {
+		return nil, fmt.Errorf("route version %d of %s has invalid last point: %T", versionNumber, routeID, v.LastPoint().Geometry)
+	}
+
+	return &RouteVersionDto{
+		RouteVersionSummaryDto: toRouteVersionSummaryDto(v, route),
+		RouteID:                v.RouteID(),
+		Description:            v.Description(),
+		HighlightedPhotoID:     v.HighlightedPhotoID(),
+		PathGeom:               path,
+		FirstPoint:             firstPoint,
+		LastPoint:              lastPoint,
+		Visibility:             v.Visibility(),
+		CoursePoints:           toCoursePointOutputs(v.CoursePoints()),
+		Waypoints:              toWaypointOutputs(v.Waypoints()),
+	}, nil
+}
+
+// RestoreRouteVersion はルートを指定した版の状態に戻す
+// 復元も更新の一種として扱い、復元前の状態を新しい版として残す
+func (u *routeVersionUsecase) RestoreRouteVersion(ctx context.Context, routeID string, kratosID string, versionNumber int32) error {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+
+	route, err := u.routeRepo.GetRouteByID(ctx, routeID)
+	if err != nil {
+		return err
+	}
+	if route.UserID() != userEntity.ID().String() {
+		return domainerror.New("user does not own the route", domainerror.ErrUnauthorized)
+	}
+
+	target, err := u.routeRepo.GetRouteVersion(ctx, routeID, versionNumber)
+	if err != nil {
+		return err
+	}
+
+	current, err := routeDomain.NewRouteVersion(route, userEntity.ID().String())
+	if err != nil {
+		return err
+	}
+	if err := route.RestoreVersion(target); err != nil {
+		return err
+	}
+	if err := route.ResolvePlaces(ctx, u.geocoder); err != nil {
+		return err
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		routeRepo := repository.NewRouteRepository(q)
+		if err := routeRepo.SaveRouteVersion(ctx, current); err != nil {
+			return err
+		}
+		if err := routeRepo.UpdateRoute(ctx, route); err != nil {
+			return err
+		}
+		return refreshRouteDifficulty(ctx, routeRepo, route)
+	})
+}
+
+// getOwnedRoute はログインユーザーが所有するルートを取得する。履歴は所有者のみ閲覧できる
+func (u *routeVersionUsecase) getOwnedRoute(ctx context.Context, routeID string, kratosID string) (*routeDomain.Route, error) {
+	userEntity, err := u.userRepository.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := u.routeRepo.GetRouteByID(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	if route.UserID() != userEntity.ID().String() {
+		return nil, domainerror.New("user does not own the route", domainerror.ErrUnauthorized)
+	}
+	return route, nil
+}
+
+func toRouteVersionSummaryDto(v *routeDomain.RouteVersion, current *routeDomain.Route) RouteVersionSummaryDto {
+	diff := v.DiffFrom(current)
+	return RouteVersionSummaryDto{
+		VersionNumber:       v.VersionNumber(),
+		EditorID:            v.EditorID(),
+		Name:                v.Name(),
+		Distance:            v.Distance(),
+		Duration:            v.Duration(),
+		ElevationGain:       v.ElevationGain(),
+		ElevationLoss:       v.ElevationLoss(),
+		CreatedAt:           v.CreatedAt(),
+		DistanceChange:      diff.DistanceChange,
+		DurationChange:      diff.DurationChange,
+		ElevationGainChange: diff.ElevationGainChange,
+		ElevationLossChange: diff.ElevationLossChange,
+	}
+}
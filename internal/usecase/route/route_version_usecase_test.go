@@ -0,0 +1,232 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	placeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+type routeVersionTestMocks struct {
+	mockRouteRepo *routeDomain.MockIRouteRepository
+	mockUserRepo  *userDomain.MockIUserRepository
+	mockTxManager *transactionApp.MockTransactionManager
+	mockGeocoder  *placeDomain.MockGeocoder
+	usecase       IRouteVersionUsecase
+}
+
+func setupRouteVersionMocks(t *testing.T) *routeVersionTestMocks {
+	ctrl := gomock.NewController(t)
+	mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
+	mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
+	mockTxManager := transactionApp.NewMockTransactionManager(ctrl)
+	mockGeocoder := placeDomain.NewMockGeocoder(ctrl)
+
+	return &routeVersionTestMocks{
+		mockRouteRepo: mockRouteRepo,
+		mockUserRepo:  mockUserRepo,
+		mockTxManager: mockTxManager,
+		mockGeocoder:  mockGeocoder,
+		usecase:       NewRouteVersionUsecase(mockUserRepo, mockTxManager, mockRouteRepo, mockGeocoder),
+	}
+}
+
+// テスト用の版作成ヘルパー
+func createTestRouteVersion(versionNumber int32, distance float64) *routeDomain.RouteVersion {
+	path := orb.LineString{{139.0, 35.0}, {139.1, 35.1}}
+	return routeDomain.ReconstructRouteVersion(
+		"019b5a50-0000-7000-8000-0000000000a1",
+		testRouteID,
+		versionNumber,
+		testUserID,
+		"Old Route",
+		testRouteDesc,
+		nil,
+		distance,
+		testDuration,
+		testElevationGain+50,
+		testElevationLoss,
+		routeDomain.Geometry{Geometry: path},
+		routeDomain.Geometry{Geometry: path[0]},
+		routeDomain.Geometry{Geometry: path[1]},
+		testVisibility,
+		nil,
+		nil,
+		"2026-01-01T00:00:00Z",
+	)
+}
+
+func Test_routeVersionUsecase_ListRouteVersions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 現在のルートとの差分を返す", func(t *testing.T) {
+		t.Parallel()
+		m := setupRouteVersionMocks(t)
+		m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute(testUserID), nil)
+		m.mockRouteRepo.EXPECT().GetRouteVersions(gomock.Any(), testRouteID).
+			Return([]*routeDomain.RouteVersion{createTestRouteVersion(2, 1200), createTestRouteVersion(1, 800)}, nil)
+
+		got, err := m.usecase.ListRouteVersions(context.Background(), testRouteID, testKratosID)
+		if err != nil {
+			t.Fatalf("ListRouteVersions() error = %v", err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("len = %d, want 2", len(got))
+		}
+		if got[0].VersionNumber != 2 || got[0].DistanceChange != 200 || got[0].ElevationGainChange != 50 {
+			t.Errorf("got[0] = %+v, want version 2 with distance +200, elevation gain +50", got[0])
+		}
+		if got[1].DistanceChange != -200 {
+			t.Errorf("got[1].DistanceChange = %v, want -200", got[1].DistanceChange)
+		}
+	})
+
+	t.Run("異常系: ルートの所有者ではない", func(t *testing.T) {
+		t.Parallel()
+		m := setupRouteVersionMocks(t)
+		m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute("different-user-id"), nil)
+
+		_, err := m.usecase.ListRouteVersions(context.Background(), testRouteID, testKratosID)
+		if !errors.Is(err, domainerror.ErrUnauthorized) {
+			t.Errorf("ListRouteVersions() error = %v, want ErrUnauthorized", err)
+		}
+	})
+}
+
+func Test_routeVersionUsecase_GetRouteVersion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 版の形状を返す", func(t *testing.T) {
+		t.Parallel()
+		m := setupRouteVersionMocks(t)
+		m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute(testUserID), nil)
+		m.mockRouteRepo.EXPECT().GetRouteVersion(gomock.Any(), testRouteID, int32(1)).Return(createTestRouteVersion(1, 800), nil)
+
+		got, err := m.usecase.GetRouteVersion(context.Background(), testRouteID, testKratosID, 1)
+		if err != nil {
+			t.Fatalf("GetRouteVersion() error = %v", err)
+		}
+		if len(got.PathGeom) != 2 || got.FirstPoint != (orb.Point{139.0, 35.0}) {
+			t.Errorf("PathGeom/FirstPoint = %v/%v", got.PathGeom, got.FirstPoint)
+		}
+		if got.Name != "Old Route" || got.DistanceChange != -200 {
+			t.Errorf("Name/DistanceChange = %s/%v, want Old Route/-200", got.Name, got.DistanceChange)
+		}
+	})
+
+	t.Run("異常系: 版が存在しない", func(t *testing.T) {
+		t.Parallel()
+		m := setupRouteVersionMocks(t)
+		m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute(testUserID), nil)
+		m.mockRouteRepo.EXPECT().GetRouteVersion(gomock.Any(), testRouteID, int32(9)).
+			Return(nil, domainerror.New("route version not found", domainerror.ErrNotFound))
+
+		_, err := m.usecase.GetRouteVersion(context.Background(), testRouteID, testKratosID, 9)
+		if !errors.Is(err, domainerror.ErrNotFound) {
+			t.Errorf("GetRouteVersion() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("異常系: 版の形状が不正でもパニックしない", func(t *testing.T) {
+		t.Parallel()
+		m := setupRouteVersionMocks(t)
+		m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute(testUserID), nil)
+		point := routeDomain.Geometry{Geometry: orb.Point{139.0, 35.0}}
+		broken := routeDomain.ReconstructRouteVersion(
+			"019b5a50-0000-7000-8000-0000000000a1",
+			testRouteID,
+			1,
+			testUserID,
+			"Old Route",
+			testRouteDesc,
+			nil,
+			800,
+			testDuration,
+			testElevationGain,
+			testElevationLoss,
+			point, // 経路が点になっている
+			point,
+			point,
+			testVisibility,
+			nil,
+			nil,
+			"2026-01-01T00:00:00Z",
+		)
+		m.mockRouteRepo.EXPECT().GetRouteVersion(gomock.Any(), testRouteID, int32(1)).Return(broken, nil)
+
+		got, err := m.usecase.GetRouteVersion(context.Background(), testRouteID, testKratosID, 1)
+		if err == nil || got != nil {
+			t.Errorf("GetRouteVersion() = %v, %v, want error", got, err)
+		}
+	})
+}
+
+func Test_routeVersionUsecase_RestoreRouteVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setupMocks func(m *routeVersionTestMocks)
+		wantErr    error
+	}{
+		{
+			name: "正常系: 版を復元する",
+			setupMocks: func(m *routeVersionTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute(testUserID), nil)
+				m.mockRouteRepo.EXPECT().GetRouteVersion(gomock.Any(), testRouteID, int32(1)).Return(createTestRouteVersion(1, 800), nil)
+				m.mockGeocoder.EXPECT().ReverseGeocode(gomock.Any(), gomock.Any()).Return(testKyotoPlace, nil).Times(2)
+				m.mockTxManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "異常系: ルートの所有者ではない",
+			setupMocks: func(m *routeVersionTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute("different-user-id"), nil)
+			},
+			wantErr: domainerror.ErrUnauthorized,
+		},
+		{
+			name: "異常系: 版が存在しない",
+			setupMocks: func(m *routeVersionTestMocks) {
+				m.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+				m.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(createTestRoute(testUserID), nil)
+				m.mockRouteRepo.EXPECT().GetRouteVersion(gomock.Any(), testRouteID, int32(1)).
+					Return(nil, domainerror.New("route version not found", domainerror.ErrNotFound))
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupRouteVersionMocks(t)
+			tt.setupMocks(m)
+
+			err := m.usecase.RestoreRouteVersion(context.Background(), testRouteID, testKratosID, 1)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("RestoreRouteVersion() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RestoreRouteVersion() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
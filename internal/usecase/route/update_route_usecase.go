@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
 	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
 	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
 	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
@@ -20,13 +21,17 @@ type updateRouteUsecase struct {
 	userRepository user.IUserRepository
 	txManager      transaction.TransactionManager
 	routeRepo      routeDomain.IRouteRepository
+	geocoder       place.Geocoder
+	clubs          routeDomain.ClubMembershipReader
 }
 
-func NewUpdateRouteUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository) IUpdateRouteUsecase {
+func NewUpdateRouteUsecase(userRepository user.IUserRepository, txManager transaction.TransactionManager, routeRepo routeDomain.IRouteRepository, geocoder place.Geocoder, clubs routeDomain.ClubMembershipReader) IUpdateRouteUsecase {
 	return &updateRouteUsecase{
 		userRepository: userRepository,
 		txManager:      txManager,
 		routeRepo:      routeRepo,
+		geocoder:       geocoder,
+		clubs:          clubs,
 	}
 }
 
@@ -61,8 +66,10 @@ type UpdateRouteUseCaseInputDto struct {
 	FirstPoint         orb.Point
 	LastPoint          orb.Point
 	Visibility         int16
+	ClubID             *string // 公開範囲がクラブのメンバーのみの場合の共有先
 	CoursePoints       []UpdatedCoursePointInput
 	Waypoints          []UpdatedWaypointInput
+	ExpectedVersion    int32 // クライアントが編集を始めた時点のルートのバージョン（If-Matchヘッダー）
 }
 
 func (u *updateRouteUsecase) UpdateRoute(ctx context.Context, dto UpdateRouteUseCaseInputDto) error {
@@ -83,6 +90,17 @@ func (u *updateRouteUsecase) UpdateRoute(ctx context.Context, dto UpdateRouteUse
 		return errors.New("unauthorized: user does not own the route")
 	}
 
+	// 別のタブやデバイスで先に更新されていた場合は上書きしない
+	if err := route.CheckVersion(dto.ExpectedVersion); err != nil {
+		return err
+	}
+
+	// 更新前の状態を履歴として残す
+	version, err := routeDomain.NewRouteVersion(route, userEntity.ID().String())
+	if err != nil {
+		return err
+	}
+
 	// 基本情報の更新
 	if err := route.UpdateBasicInfo(
 		dto.Name,
@@ -92,6 +110,13 @@ func (u *updateRouteUsecase) UpdateRoute(ctx context.Context, dto UpdateRouteUse
 	); err != nil {
 		return err
 	}
+	owner, err := routeDomain.ResolveViewer(ctx, u.clubs, userEntity.ID().String())
+	if err != nil {
+		return err
+	}
+	if err := route.ChangeVisibility(owner, dto.Visibility, dto.ClubID); err != nil {
+		return err
+	}
 
 	// ジオメトリ情報の更新
 	if err := route.UpdateRouteGeometry(
@@ -106,6 +131,11 @@ func (u *updateRouteUsecase) UpdateRoute(ctx context.Context, dto UpdateRouteUse
 		return err
 	}
 
+	// 出発地点と目的地の地名を求め直す
+	if err := route.ResolvePlaces(ctx, u.geocoder); err != nil {
+		return err
+	}
+
 	// コースポイントとウェイポイントをクリアして再設定
 	route.ClearCoursePointsAndWaypoints()
 
@@ -139,7 +169,13 @@ func (u *updateRouteUsecase) UpdateRoute(ctx context.Context, dto UpdateRouteUse
 	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
 		// トランザクション用のQueriesでリポジトリを作成
 		routeRepo := repository.NewRouteRepository(q)
-		return routeRepo.SaveRoute(ctx, route)
+		if err := routeRepo.SaveRouteVersion(ctx, version); err != nil {
+			return err
+		}
+		if err := routeRepo.UpdateRoute(ctx, route); err != nil {
+			return err
+		}
+		return refreshRouteDifficulty(ctx, routeRepo, route)
 	})
 
 	if err != nil {
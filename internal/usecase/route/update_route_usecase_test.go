@@ -6,6 +6,8 @@ import (
 	"strings"
 	"testing"
 
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	placeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
 	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
 	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
 	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
@@ -31,6 +33,9 @@ const (
 	testPolyline      = "aqtxEshssY]q@"
 )
 
+// 出発地点と目的地の地名
+var testKyotoPlace = placeDomain.ReconstructPlace("京都市", "京都府", "JP")
+
 
 // テスト用のデフォルトDTO作成ヘルパー
 func createDefaultUpdateDTO() UpdateRouteUseCaseInputDto {
@@ -81,6 +86,7 @@ func createDefaultUpdateDTO() UpdateRouteUseCaseInputDto {
 			{Location: orb.Point{139.713592, 35.670692}},
 			{Location: orb.Point{139.712618, 35.672179}},
 		},
+		ExpectedVersion: 1,
 	}
 }
 
@@ -111,7 +117,7 @@ func createTestRoute(userID string) *routeDomain.Route {
 		routeDomain.Geometry{},
 		routeDomain.Geometry{},
 		routeDomain.Geometry{},
-		testPolyline, testVisibility, "", "",
+		testPolyline, testVisibility, 1, nil, "", "",
 	)
 	return route
 }
@@ -122,6 +128,7 @@ type updateRouteTestMocks struct {
 	mockRouteRepo      *routeDomain.MockIRouteRepository
 	mockUserRepo       *userDomain.MockIUserRepository
 	mockTxManager      *transactionApp.MockTransactionManager
+	mockGeocoder       *placeDomain.MockGeocoder
 	usecase            IUpdateRouteUsecase
 }
 
@@ -131,13 +138,16 @@ func setupUpdateRouteMocks(t *testing.T) *updateRouteTestMocks {
 	mockRouteRepo := routeDomain.NewMockIRouteRepository(ctrl)
 	mockUserRepo := userDomain.NewMockIUserRepository(ctrl)
 	mockTxManager := transactionApp.NewMockTransactionManager(ctrl)
-	uc := NewUpdateRouteUsecase(mockUserRepo, mockTxManager, mockRouteRepo)
+	mockGeocoder := placeDomain.NewMockGeocoder(ctrl)
+	// ログインユーザーは testClubID のクラブに所属している
+	uc := NewUpdateRouteUsecase(mockUserRepo, mockTxManager, mockRouteRepo, mockGeocoder, newTestClubReader(ctrl, testClubID))
 
 	return &updateRouteTestMocks{
 		ctrl:          ctrl,
 		mockRouteRepo: mockRouteRepo,
 		mockUserRepo:  mockUserRepo,
 		mockTxManager: mockTxManager,
+		mockGeocoder:  mockGeocoder,
 		usecase:       uc,
 	}
 }
@@ -164,6 +174,11 @@ func Test_updateRouteUsecase_UpdateRoute(t *testing.T) {
 					GetRouteByID(gomock.Any(), testRouteID).
 					Return(createTestRoute(testUserID), nil)
 
+				m.mockGeocoder.EXPECT().
+					ReverseGeocode(gomock.Any(), gomock.Any()).
+					Return(testKyotoPlace, nil).
+					Times(2)
+
 				m.mockTxManager.EXPECT().
 					RunInTransaction(gomock.Any(), gomock.Any()).
 					Return(nil)
@@ -187,6 +202,11 @@ func Test_updateRouteUsecase_UpdateRoute(t *testing.T) {
 					GetRouteByID(gomock.Any(), testRouteID).
 					Return(createTestRoute(testUserID), nil)
 
+				m.mockGeocoder.EXPECT().
+					ReverseGeocode(gomock.Any(), gomock.Any()).
+					Return(testKyotoPlace, nil).
+					Times(2)
+
 				m.mockTxManager.EXPECT().
 					RunInTransaction(gomock.Any(), gomock.Any()).
 					Return(nil)
@@ -244,6 +264,44 @@ func Test_updateRouteUsecase_UpdateRoute(t *testing.T) {
 			wantErr:        true,
 			wantErrContain: "unauthorized",
 		},
+		{
+			name: "異常系: 取得後に別のリクエストで更新されている",
+			dto: func() UpdateRouteUseCaseInputDto {
+				dto := createDefaultUpdateDTO()
+				dto.ExpectedVersion = 0
+				return dto
+			}(),
+			setupMocks: func(m *updateRouteTestMocks) {
+				m.mockUserRepo.EXPECT().
+					GetUserByKratosID(gomock.Any(), testKratosID).
+					Return(createTestUser(), nil)
+
+				m.mockRouteRepo.EXPECT().
+					GetRouteByID(gomock.Any(), testRouteID).
+					Return(createTestRoute(testUserID), nil)
+			},
+			wantErr:        true,
+			wantErrContain: "modified by another request",
+		},
+		{
+			name: "異常系: 地名の取得に失敗",
+			dto:  createDefaultUpdateDTO(),
+			setupMocks: func(m *updateRouteTestMocks) {
+				m.mockUserRepo.EXPECT().
+					GetUserByKratosID(gomock.Any(), testKratosID).
+					Return(createTestUser(), nil)
+
+				m.mockRouteRepo.EXPECT().
+					GetRouteByID(gomock.Any(), testRouteID).
+					Return(createTestRoute(testUserID), nil)
+
+				m.mockGeocoder.EXPECT().
+					ReverseGeocode(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("geocoder unavailable"))
+			},
+			wantErr:        true,
+			wantErrContain: "geocoder unavailable",
+		},
 		{
 			name: "異常系: トランザクション内での更新に失敗",
 			dto:  createDefaultUpdateDTO(),
@@ -256,6 +314,11 @@ func Test_updateRouteUsecase_UpdateRoute(t *testing.T) {
 					GetRouteByID(gomock.Any(), testRouteID).
 					Return(createTestRoute(testUserID), nil)
 
+				m.mockGeocoder.EXPECT().
+					ReverseGeocode(gomock.Any(), gomock.Any()).
+					Return(testKyotoPlace, nil).
+					Times(2)
+
 				m.mockTxManager.EXPECT().
 					RunInTransaction(gomock.Any(), gomock.Any()).
 					Return(errors.New("database error"))
@@ -263,6 +326,26 @@ func Test_updateRouteUsecase_UpdateRoute(t *testing.T) {
 			wantErr:        true,
 			wantErrContain: "database error",
 		},
+		{
+			name: "異常系: 所属していないクラブのメンバーのみにする",
+			dto: func() UpdateRouteUseCaseInputDto {
+				dto := createDefaultUpdateDTO()
+				dto.Visibility = routeDomain.VisibilityClub
+				dto.ClubID = new("019b5a66-0000-7000-8000-0000000000ff")
+				return dto
+			}(),
+			setupMocks: func(m *updateRouteTestMocks) {
+				m.mockUserRepo.EXPECT().
+					GetUserByKratosID(gomock.Any(), testKratosID).
+					Return(createTestUser(), nil)
+
+				m.mockRouteRepo.EXPECT().
+					GetRouteByID(gomock.Any(), testRouteID).
+					Return(createTestRoute(testUserID), nil)
+			},
+			wantErr:        true,
+			wantErrContain: "not a member of the club",
+		},
 	}
 
 	for _, tt := range tests {
@@ -293,3 +376,45 @@ func Test_updateRouteUsecase_UpdateRoute(t *testing.T) {
 		})
 	}
 }
+
+func Test_updateRouteUsecase_UpdateRoute_ResolvesPlaces(t *testing.T) {
+	t.Parallel()
+	mocks := setupUpdateRouteMocks(t)
+	route := createTestRoute(testUserID)
+	dto := createDefaultUpdateDTO()
+
+	mocks.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+	mocks.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(route, nil)
+	mocks.mockGeocoder.EXPECT().ReverseGeocode(gomock.Any(), dto.FirstPoint).Return(testKyotoPlace, nil)
+	// 目的地は行政区域の外
+	mocks.mockGeocoder.EXPECT().ReverseGeocode(gomock.Any(), dto.LastPoint).Return(nil, domainerror.New("place not found", domainerror.ErrNotFound))
+	mocks.mockTxManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+	if err := mocks.usecase.UpdateRoute(context.Background(), dto); err != nil {
+		t.Fatalf("UpdateRoute() error = %v", err)
+	}
+	if route.StartPlace() != testKyotoPlace || route.EndPlace() != nil {
+		t.Errorf("places = %+v, %+v", route.StartPlace(), route.EndPlace())
+	}
+}
+
+func Test_updateRouteUsecase_UpdateRoute_ClubVisibility(t *testing.T) {
+	t.Parallel()
+	mocks := setupUpdateRouteMocks(t)
+	route := createTestRoute(testUserID)
+	dto := createDefaultUpdateDTO()
+	dto.Visibility = routeDomain.VisibilityClub
+	dto.ClubID = new(testClubID)
+
+	mocks.mockUserRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+	mocks.mockRouteRepo.EXPECT().GetRouteByID(gomock.Any(), testRouteID).Return(route, nil)
+	mocks.mockGeocoder.EXPECT().ReverseGeocode(gomock.Any(), gomock.Any()).Return(testKyotoPlace, nil).Times(2)
+	mocks.mockTxManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+	if err := mocks.usecase.UpdateRoute(context.Background(), dto); err != nil {
+		t.Fatalf("UpdateRoute() error = %v", err)
+	}
+	if route.Visibility() != routeDomain.VisibilityClub || route.ClubID() == nil || *route.ClubID() != testClubID {
+		t.Errorf("visibility = %d, club_id = %v", route.Visibility(), route.ClubID())
+	}
+}
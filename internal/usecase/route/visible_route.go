@@ -0,0 +1,35 @@
+package route
+
+import (
+	"context"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+)
+
+// getVisibleRoute はルートを取得し、閲覧ユーザーが見られることを確かめる。kratosIDが空の場合は未ログインとして扱う
+// 見られない場合はルートの存在を明かさないよう、見つからないものとして扱う
+func getVisibleRoute(ctx context.Context, userRepository user.IUserRepository, routeRepo routeDomain.IRouteRepository, clubs routeDomain.ClubMembershipReader, routeID string, kratosID string) (*routeDomain.Route, routeDomain.Viewer, error) {
+	var userID string
+	if kratosID != "" {
+		userEntity, err := userRepository.GetUserByKratosID(ctx, kratosID)
+		if err != nil {
+			return nil, routeDomain.Viewer{}, err
+		}
+		userID = userEntity.ID().String()
+	}
+	viewer, err := routeDomain.ResolveViewer(ctx, clubs, userID)
+	if err != nil {
+		return nil, routeDomain.Viewer{}, err
+	}
+
+	route, err := routeRepo.GetRouteByID(ctx, routeID)
+	if err != nil {
+		return nil, routeDomain.Viewer{}, err
+	}
+	if !route.IsVisibleTo(viewer) {
+		return nil, routeDomain.Viewer{}, domainerror.New("route not found", domainerror.ErrNotFound)
+	}
+	return route, viewer, nil
+}
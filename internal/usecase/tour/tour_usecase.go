@@ -0,0 +1,440 @@
+package tour
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	tourDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/tour"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/fit"
+	gpxpkg "github.com/YukiAminaka/cycle-route-backend/internal/pkg/gpx"
+	"github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+	"github.com/tkrajina/gpxgo/gpx"
+)
+
+// ツアーの書き出し形式
+type ExportFormat string
+
+const (
+	ExportFormatGPX ExportFormat = "gpx"
+	ExportFormatFIT ExportFormat = "fit"
+)
+
+// ITourUsecase は複数日のツアーを管理する
+// 公開範囲はルートと同じで、変更できるのは所有者だけ
+type ITourUsecase interface {
+	ListMyTours(ctx context.Context, kratosID string) ([]TourOutputDto, error)
+	// kratosIDが空の場合は未ログインのユーザーとして扱う
+	GetTour(ctx context.Context, tourID string, kratosID string) (*TourOutputDto, error)
+	CreateTour(ctx context.Context, kratosID string, dto TourInputDto) (*TourOutputDto, error)
+	UpdateTour(ctx context.Context, kratosID string, tourID string, dto TourInputDto) (*TourOutputDto, error)
+	DeleteTour(ctx context.Context, kratosID string, tourID string) error
+	ExportTour(ctx context.Context, dto ExportTourInputDto) ([]byte, error)
+}
+
+type tourUsecase struct {
+	userRepo  userDomain.IUserRepository
+	txManager transaction.TransactionManager
+	tourRepo  tourDomain.ITourRepository
+	routeRepo routeDomain.IRouteRepository
+	clubs     routeDomain.ClubMembershipReader
+}
+
+func NewTourUsecase(userRepo userDomain.IUserRepository, txManager transaction.TransactionManager, tourRepo tourDomain.ITourRepository, routeRepo routeDomain.IRouteRepository, clubs routeDomain.ClubMembershipReader) ITourUsecase {
+	return &tourUsecase{
+		userRepo:  userRepo,
+		txManager: txManager,
+		tourRepo:  tourRepo,
+		routeRepo: routeRepo,
+		clubs:     clubs,
+	}
+}
+
+type TourInputDto struct {
+	Name        string
+	Description string
+	Visibility  int16
+	ClubID      *string             // 公開範囲がクラブのメンバーのみの場合の共有先
+	Stages      []TourStageInputDto // 日程の順
+}
+
+type TourStageInputDto struct {
+	RouteID       string
+	OvernightStop string
+}
+
+type ExportTourInputDto struct {
+	TourID   string
+	KratosID string // 空の場合は未ログインのユーザーとして扱う
+	Format   ExportFormat
+}
+
+// TourOutputDto のステージと合計は閲覧ユーザーが見られるルートのみを対象にする
+type TourOutputDto struct {
+	ID            string
+	UserID        string
+	Name          string
+	Description   string
+	Visibility    int16
+	ClubID        *string
+	Stages        []TourStageOutputDto
+	Distance      float64 // 合計距離(m)
+	Duration      float64 // 合計所要時間(s)
+	ElevationGain float64
+	ElevationLoss float64
+	CreatedAt     string
+	UpdatedAt     string
+}
+
+type TourStageOutputDto struct {
+	Day           int // 1日目が1
+	OvernightStop string
+	RouteID       string
+	RouteName     string
+	UserName      string // ルートの作成者
+	Distance      float64
+	Duration      float64
+	ElevationGain float64
+	ElevationLoss float64
+	Polyline      string
+	Difficulty    string // easy, moderate, hard, expert。まだ求めていない場合は空文字
+}
+
+func (u *tourUsecase) ListMyTours(ctx context.Context, kratosID string) ([]TourOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer, err := routeDomain.ResolveViewer(ctx, u.clubs, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	tours, err := u.tourRepo.GetToursByUserID(ctx, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	var routeIDs []string
+	for _, t := range tours {
+		routeIDs = append(routeIDs, t.RouteIDs()...)
+	}
+	routes, err := u.getRoutes(ctx, routeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]TourOutputDto, len(tours))
+	for i, t := range tours {
+		outputs[i] = toTourOutputDto(t, routes, viewer)
+	}
+	return outputs, nil
+}
+
+// GetTour はツアーを、閲覧ユーザーが見られるステージとその合計とともに返す
+// ステージのルートが非公開になった場合などは、ツアーに入ったまま結果からは除く
+func (u *tourUsecase) GetTour(ctx context.Context, tourID string, kratosID string) (*TourOutputDto, error) {
+	t, viewer, err := u.getVisibleTour(ctx, tourID, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := u.getRoutes(ctx, t.RouteIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	output := toTourOutputDto(t, routes, viewer)
+	return &output, nil
+}
+
+// CreateTour はツアーを作成する。ステージには自分のルートのほか、他のユーザーの閲覧できるルートも使える
+func (u *tourUsecase) CreateTour(ctx context.Context, kratosID string, dto TourInputDto) (*TourOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := routeDomain.ResolveViewer(ctx, u.clubs, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	stages, err := toStages(dto.Stages)
+	if err != nil {
+		return nil, err
+	}
+	t, err := tourDomain.NewTour(owner, dto.Name, dto.Description, dto.Visibility, dto.ClubID, stages)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := u.getStageRoutes(ctx, t, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewTourRepository(q).SaveTour(ctx, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := toTourOutputDto(t, routes, owner)
+	return &output, nil
+}
+
+// UpdateTour はツアーの名前・公開範囲とステージをまとめて置き換える
+func (u *tourUsecase) UpdateTour(ctx context.Context, kratosID string, tourID string, dto TourInputDto) (*TourOutputDto, error) {
+	t, err := u.getOwnedTour(ctx, kratosID, tourID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := routeDomain.ResolveViewer(ctx, u.clubs, t.UserID())
+	if err != nil {
+		return nil, err
+	}
+	stages, err := toStages(dto.Stages)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.Update(owner, dto.Name, dto.Description, dto.Visibility, dto.ClubID, stages); err != nil {
+		return nil, err
+	}
+	routes, err := u.getStageRoutes(ctx, t, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		return repository.NewTourRepository(q).UpdateTour(ctx, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := toTourOutputDto(t, routes, owner)
+	return &output, nil
+}
+
+// DeleteTour はツアーを削除する。ステージのルートは削除しない
+func (u *tourUsecase) DeleteTour(ctx context.Context, kratosID string, tourID string) error {
+	t, err := u.getOwnedTour(ctx, kratosID, tourID)
+	if err != nil {
+		return err
+	}
+	return u.tourRepo.DeleteTour(ctx, t.ID().String())
+}
+
+// ExportTour はツアーをGPXまたはFITに書き出す。閲覧ユーザーが見られるステージのみを含める
+// GPXはステージごとに1つのトラック、FITはステージごとに1つのラップにし、宿泊地はステージの終点に置く
+func (u *tourUsecase) ExportTour(ctx context.Context, dto ExportTourInputDto) ([]byte, error) {
+	if dto.Format != ExportFormatGPX && dto.Format != ExportFormatFIT {
+		return nil, domainerror.New("unsupported export format", domainerror.ErrValidation)
+	}
+
+	t, viewer, err := u.getVisibleTour(ctx, dto.TourID, dto.KratosID)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := u.getRoutes(ctx, t.RouteIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	type exportStage struct {
+		route         *routeDomain.Route
+		path          orb.LineString
+		overnightStop string
+	}
+	var stages []exportStage
+	for _, s := range t.Stages() {
+		r, ok := routes[s.RouteID()]
+		if !ok || !r.Route.IsVisibleTo(viewer) {
+			continue
+		}
+		path, ok := r.Route.PathGeom().Geometry.(orb.LineString)
+		if !ok || len(path) < 2 {
+			return nil, domainerror.New("route has no path", domainerror.ErrValidation)
+		}
+		stages = append(stages, exportStage{route: r.Route, path: path, overnightStop: s.OvernightStop()})
+	}
+	if len(stages) == 0 {
+		return nil, domainerror.New("tour has no stages to export", domainerror.ErrNotFound)
+	}
+
+	switch dto.Format {
+	case ExportFormatGPX:
+		author, err := u.userRepo.GetUserByID(ctx, t.UserID())
+		if err != nil {
+			return nil, err
+		}
+		gpxStages := make([]gpxpkg.TourStage, len(stages))
+		for i, s := range stages {
+			gpxStages[i] = gpxpkg.TourStage{
+				Name:          s.route.Name(),
+				Description:   s.route.Description(),
+				Path:          s.path,
+				OvernightStop: s.overnightStop,
+			}
+		}
+		gpxData, err := gpxpkg.TourToGPX(t.Name(), t.Description(), author.Name(), gpxStages)
+		if err != nil {
+			return nil, err
+		}
+		return gpxData.ToXml(gpx.ToXmlParams{Version: "1.1", Indent: true})
+	default:
+		course := &fit.Course{Name: t.Name(), TimeCreated: time.Now()}
+		for _, s := range stages {
+			course.Stages = append(course.Stages, fit.Stage{
+				Path:          s.path,
+				Duration:      s.route.Duration(),
+				ElevationGain: s.route.ElevationGain(),
+				ElevationLoss: s.route.ElevationLoss(),
+				EndName:       s.overnightStop,
+			})
+		}
+		var buf bytes.Buffer
+		if err := fit.WriteCourse(&buf, course); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+}
+
+// getVisibleTour はユーザーが閲覧できるツアーと、閲覧ユーザーを返す
+func (u *tourUsecase) getVisibleTour(ctx context.Context, tourID string, kratosID string) (*tourDomain.Tour, routeDomain.Viewer, error) {
+	var viewerID string
+	if kratosID != "" {
+		userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+		if err != nil {
+			return nil, routeDomain.Viewer{}, err
+		}
+		viewerID = userEntity.ID().String()
+	}
+
+	// ツアーとステージのルートはクラブのメンバーのみのものもあるため、所属するクラブとともに判定する
+	viewer, err := routeDomain.ResolveViewer(ctx, u.clubs, viewerID)
+	if err != nil {
+		return nil, routeDomain.Viewer{}, err
+	}
+
+	t, err := u.tourRepo.GetTourByID(ctx, tourID)
+	if err != nil {
+		return nil, routeDomain.Viewer{}, err
+	}
+	// 閲覧できないツアーは存在しないものとして扱う
+	if !t.IsVisibleTo(viewer) {
+		return nil, routeDomain.Viewer{}, domainerror.New("tour not found", domainerror.ErrNotFound)
+	}
+	return t, viewer, nil
+}
+
+// getOwnedTour はユーザーが所有するツアーを取得する
+func (u *tourUsecase) getOwnedTour(ctx context.Context, kratosID string, tourID string) (*tourDomain.Tour, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := u.tourRepo.GetTourByID(ctx, tourID)
+	if err != nil {
+		return nil, err
+	}
+	if t.UserID() != userEntity.ID().String() {
+		return nil, domainerror.New("user does not own the tour", domainerror.ErrUnauthorized)
+	}
+	return t, nil
+}
+
+// getStageRoutes はステージのルートを取得し、所有者がすべて閲覧できることを確かめる
+func (u *tourUsecase) getStageRoutes(ctx context.Context, t *tourDomain.Tour, owner routeDomain.Viewer) (map[string]*routeDomain.ExploreRouteResult, error) {
+	routes, err := u.getRoutes(ctx, t.RouteIDs())
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range t.RouteIDs() {
+		r, ok := routes[id]
+		if !ok || !r.Route.IsVisibleTo(owner) {
+			return nil, domainerror.New("route not found", domainerror.ErrNotFound)
+		}
+	}
+	return routes, nil
+}
+
+// getRoutes はルートをまとめて取得し、IDで引けるようにする
+func (u *tourUsecase) getRoutes(ctx context.Context, ids []string) (map[string]*routeDomain.ExploreRouteResult, error) {
+	routes := make(map[string]*routeDomain.ExploreRouteResult, len(ids))
+	if len(ids) == 0 {
+		return routes, nil
+	}
+	results, err := u.routeRepo.GetRoutesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		routes[r.Route.ID()] = r
+	}
+	return routes, nil
+}
+
+func toStages(inputs []TourStageInputDto) ([]tourDomain.Stage, error) {
+	stages := make([]tourDomain.Stage, len(inputs))
+	for i, in := range inputs {
+		s, err := tourDomain.NewStage(in.RouteID, in.OvernightStop)
+		if err != nil {
+			return nil, err
+		}
+		stages[i] = s
+	}
+	return stages, nil
+}
+
+// toTourOutputDto はツアーを、閲覧ユーザーが見られるステージとその合計とともに変換する
+// 日数はツアー全体での日程の順のままにする
+func toTourOutputDto(t *tourDomain.Tour, routes map[string]*routeDomain.ExploreRouteResult, viewer routeDomain.Viewer) TourOutputDto {
+	stages := make([]TourStageOutputDto, 0, len(t.Stages()))
+	var visible []*routeDomain.Route
+	for i, s := range t.Stages() {
+		r, ok := routes[s.RouteID()]
+		if !ok || !r.Route.IsVisibleTo(viewer) {
+			continue
+		}
+		visible = append(visible, r.Route)
+		stages = append(stages, TourStageOutputDto{
+			Day:           i + 1,
+			OvernightStop: s.OvernightStop(),
+			RouteID:       r.Route.ID(),
+			RouteName:     r.Route.Name(),
+			UserName:      r.UserName,
+			Distance:      r.Route.Distance(),
+			Duration:      r.Route.Duration(),
+			ElevationGain: r.Route.ElevationGain(),
+			ElevationLoss: r.Route.ElevationLoss(),
+			Polyline:      r.Route.Polyline(),
+			Difficulty:    r.Route.Difficulty().String(),
+		})
+	}
+	totals := tourDomain.SumRoutes(visible)
+
+	return TourOutputDto{
+		ID:            t.ID().String(),
+		UserID:        t.UserID(),
+		Name:          t.Name(),
+		Description:   t.Description(),
+		Visibility:    t.Visibility(),
+		ClubID:        t.ClubID(),
+		Stages:        stages,
+		Distance:      totals.Distance,
+		Duration:      totals.Duration,
+		ElevationGain: totals.ElevationGain,
+		ElevationLoss: totals.ElevationLoss,
+		CreatedAt:     t.CreatedAt(),
+		UpdatedAt:     t.UpdatedAt(),
+	}
+}
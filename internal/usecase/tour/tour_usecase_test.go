@@ -0,0 +1,370 @@
+package tour
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	tourDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/tour"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserID      = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+	testKratosID    = "2eb50f70-3a23-4067-99f6-9fd645686880"
+	testOtherUserID = "019b5a8d-0000-7000-8000-0000000000ff"
+	testTourID      = "019b5a64-0000-7000-8000-000000000001"
+	testRouteID1    = "019b5a50-0000-7000-8000-000000000001"
+	testRouteID2    = "019b5a50-0000-7000-8000-000000000002"
+	testRouteID3    = "019b5a50-0000-7000-8000-000000000003"
+	testClubID      = "019b5a8d-16a7-700a-be92-9ae11e7e5c01"
+)
+
+func createTestUser() *userDomain.User {
+	user, _ := userDomain.ReconstructUser(
+		userDomain.UserID(testUserID),
+		testKratosID,
+		"Test User",
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false,
+	)
+	return user
+}
+
+func createTestRoute(id string, userID string, visibility int16, distance float64) *routeDomain.Route {
+	path := orb.LineString{{139.70, 35.60}, {139.75, 35.65}}
+	route, _ := routeDomain.ReconstructRoute(
+		id, userID, "ルート"+id[len(id)-1:], "", nil, distance, distance/5, distance/100, distance/200,
+		routeDomain.Geometry{Geometry: path}, routeDomain.Geometry{}, routeDomain.Geometry{}, routeDomain.Geometry{},
+		"", visibility, 1, nil, "", "",
+	)
+	return route
+}
+
+// testRoutes は自分の公開ルート・自分の非公開ルート・他のユーザーの非公開ルート
+func testRoutes() []*routeDomain.ExploreRouteResult {
+	return []*routeDomain.ExploreRouteResult{
+		{Route: createTestRoute(testRouteID1, testUserID, routeDomain.VisibilityPublic, 60000), UserName: "Test User"},
+		{Route: createTestRoute(testRouteID2, testUserID, routeDomain.VisibilityPrivate, 40000), UserName: "Test User"},
+		{Route: createTestRoute(testRouteID3, testOtherUserID, routeDomain.VisibilityPrivate, 30000), UserName: "other"},
+	}
+}
+
+func createTestTour(userID string, visibility int16, routeIDs ...string) *tourDomain.Tour {
+	return createTestClubTour(userID, visibility, nil, routeIDs...)
+}
+
+func createTestClubTour(userID string, visibility int16, clubID *string, routeIDs ...string) *tourDomain.Tour {
+	stages := make([]tourDomain.Stage, len(routeIDs))
+	for i, id := range routeIDs {
+		stages[i] = tourDomain.ReconstructStage(id, "")
+	}
+	stages[0] = tourDomain.ReconstructStage(routeIDs[0], "尾道のゲストハウス")
+	return tourDomain.ReconstructTour(testTourID, userID, "しまなみ", "", visibility, clubID, stages, "", "")
+}
+
+type tourTestMocks struct {
+	userRepo  *userDomain.MockIUserRepository
+	tourRepo  *tourDomain.MockITourRepository
+	routeRepo *routeDomain.MockIRouteRepository
+	clubs     *routeDomain.MockClubMembershipReader
+	txManager *transactionApp.MockTransactionManager
+	usecase   ITourUsecase
+}
+
+func setupTourMocks(t *testing.T) *tourTestMocks {
+	return setupTourMocksWithClubs(t, nil)
+}
+
+// setupTourMocksWithClubs はどのユーザーもclubIDsのクラブに所属しているものとしてモックを用意する
+func setupTourMocksWithClubs(t *testing.T, clubIDs []string) *tourTestMocks {
+	ctrl := gomock.NewController(t)
+	m := &tourTestMocks{
+		userRepo:  userDomain.NewMockIUserRepository(ctrl),
+		tourRepo:  tourDomain.NewMockITourRepository(ctrl),
+		routeRepo: routeDomain.NewMockIRouteRepository(ctrl),
+		clubs:     routeDomain.NewMockClubMembershipReader(ctrl),
+		txManager: transactionApp.NewMockTransactionManager(ctrl),
+	}
+	m.clubs.EXPECT().GetClubIDsByUserID(gomock.Any(), gomock.Any()).Return(clubIDs, nil).AnyTimes()
+	m.usecase = NewTourUsecase(m.userRepo, m.txManager, m.tourRepo, m.routeRepo, m.clubs)
+	return m
+}
+
+func stageRouteIDs(stages []TourStageOutputDto) []string {
+	var ids []string
+	for _, s := range stages {
+		ids = append(ids, s.RouteID)
+	}
+	return ids
+}
+
+func Test_tourUsecase_GetTour(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		kratosID     string
+		clubIDs      []string
+		tour         *tourDomain.Tour
+		wantRouteIDs []string
+		wantDistance float64
+		wantErr      error
+	}{
+		{
+			name:         "正常系: 所有者には自分の非公開ルートのステージも返す",
+			kratosID:     testKratosID,
+			tour:         createTestTour(testUserID, routeDomain.VisibilityPrivate, testRouteID1, testRouteID2),
+			wantRouteIDs: []string{testRouteID1, testRouteID2},
+			wantDistance: 100000,
+		},
+		{
+			name:         "正常系: 未ログインでも公開のツアーは閲覧でき、見られるステージだけを合計する",
+			tour:         createTestTour(testUserID, routeDomain.VisibilityPublic, testRouteID1, testRouteID2),
+			wantRouteIDs: []string{testRouteID1},
+			wantDistance: 60000,
+		},
+		{
+			name:         "正常系: 他のユーザーのルートが非公開になったステージは除く",
+			kratosID:     testKratosID,
+			tour:         createTestTour(testUserID, routeDomain.VisibilityPublic, testRouteID1, testRouteID3),
+			wantRouteIDs: []string{testRouteID1},
+			wantDistance: 60000,
+		},
+		{
+			name:         "正常系: 共有先のクラブのメンバーは他のユーザーのクラブのツアーを閲覧できる",
+			kratosID:     testKratosID,
+			clubIDs:      []string{testClubID},
+			tour:         createTestClubTour(testOtherUserID, routeDomain.VisibilityClub, new(testClubID), testRouteID1),
+			wantRouteIDs: []string{testRouteID1},
+			wantDistance: 60000,
+		},
+		{
+			name:    "異常系: 他のユーザーの友達のみのツアー",
+			tour:    createTestTour(testOtherUserID, routeDomain.VisibilityFriends, testRouteID1),
+			wantErr: domainerror.ErrNotFound,
+		},
+		{
+			name:     "異常系: 共有先のクラブのメンバーでなければクラブのツアーは閲覧できない",
+			kratosID: testKratosID,
+			clubIDs:  []string{"019b5a8d-16a7-700a-be92-9ae11e7e5c02"},
+			tour:     createTestClubTour(testOtherUserID, routeDomain.VisibilityClub, new(testClubID), testRouteID1),
+			wantErr:  domainerror.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupTourMocksWithClubs(t, tt.clubIDs)
+			if tt.kratosID != "" {
+				m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), tt.kratosID).Return(createTestUser(), nil)
+			}
+			m.tourRepo.EXPECT().GetTourByID(gomock.Any(), testTourID).Return(tt.tour, nil)
+			if tt.wantErr == nil {
+				m.routeRepo.EXPECT().GetRoutesByIDs(gomock.Any(), tt.tour.RouteIDs()).Return(testRoutes(), nil)
+			}
+
+			got, err := m.usecase.GetTour(context.Background(), testTourID, tt.kratosID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("GetTour() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetTour() error = %v", err)
+			}
+			if ids := stageRouteIDs(got.Stages); !reflect.DeepEqual(ids, tt.wantRouteIDs) {
+				t.Errorf("stage route ids = %v, want %v", ids, tt.wantRouteIDs)
+			}
+			if got.Distance != tt.wantDistance || got.ElevationGain != tt.wantDistance/100 {
+				t.Errorf("Distance, ElevationGain = %v, %v, want %v, %v", got.Distance, got.ElevationGain, tt.wantDistance, tt.wantDistance/100)
+			}
+			if got.Stages[0].Day != 1 || got.Stages[0].OvernightStop != "尾道のゲストハウス" {
+				t.Errorf("Stages[0] = %+v", got.Stages[0])
+			}
+		})
+	}
+}
+
+func Test_tourUsecase_CreateTour(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		visibility int16
+		clubID     *string
+		stages     []TourStageInputDto
+		needRoutes bool
+		wantErr    error
+	}{
+		{
+			name:       "正常系: 同じルートを複数日に使える",
+			stages:     []TourStageInputDto{{RouteID: testRouteID1, OvernightStop: "宿"}, {RouteID: testRouteID2}, {RouteID: testRouteID1}},
+			needRoutes: true,
+		},
+		{
+			name:       "異常系: 他のユーザーの非公開ルート",
+			stages:     []TourStageInputDto{{RouteID: testRouteID1}, {RouteID: testRouteID3}},
+			needRoutes: true,
+			wantErr:    domainerror.ErrNotFound,
+		},
+		{
+			name:       "異常系: 存在しないルート",
+			stages:     []TourStageInputDto{{RouteID: "019b5a50-0000-7000-8000-0000000000ff"}},
+			needRoutes: true,
+			wantErr:    domainerror.ErrNotFound,
+		},
+		{
+			name:       "正常系: 所属するクラブのメンバーのみに共有する",
+			visibility: routeDomain.VisibilityClub,
+			clubID:     new(testClubID),
+			stages:     []TourStageInputDto{{RouteID: testRouteID1, OvernightStop: "宿"}, {RouteID: testRouteID2}, {RouteID: testRouteID1}},
+			needRoutes: true,
+		},
+		{
+			name:       "異常系: 所属していないクラブを共有先にする",
+			visibility: routeDomain.VisibilityClub,
+			clubID:     new("019b5a8d-16a7-700a-be92-9ae11e7e5c02"),
+			stages:     []TourStageInputDto{{RouteID: testRouteID1}},
+			wantErr:    domainerror.ErrUnauthorized,
+		},
+		{
+			name:    "異常系: ステージがない",
+			wantErr: domainerror.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := setupTourMocksWithClubs(t, []string{testClubID})
+			m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			if tt.needRoutes {
+				m.routeRepo.EXPECT().GetRoutesByIDs(gomock.Any(), gomock.Any()).Return(testRoutes(), nil)
+			}
+			if tt.wantErr == nil {
+				m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			got, err := m.usecase.CreateTour(context.Background(), testKratosID, TourInputDto{Name: "しまなみ", Visibility: tt.visibility, ClubID: tt.clubID, Stages: tt.stages})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("CreateTour() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateTour() error = %v", err)
+			}
+			if len(got.Stages) != 3 || got.Distance != 160000 || got.Stages[2].Day != 3 || !reflect.DeepEqual(got.ClubID, tt.clubID) {
+				t.Errorf("CreateTour() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_tourUsecase_UpdateTour(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: ステージを置き換えると合計も変わる", func(t *testing.T) {
+		t.Parallel()
+		m := setupTourMocks(t)
+		m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.tourRepo.EXPECT().GetTourByID(gomock.Any(), testTourID).Return(createTestTour(testUserID, 0, testRouteID1), nil)
+		m.routeRepo.EXPECT().GetRoutesByIDs(gomock.Any(), []string{testRouteID2, testRouteID1}).Return(testRoutes(), nil)
+		m.txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+		got, err := m.usecase.UpdateTour(context.Background(), testKratosID, testTourID, TourInputDto{
+			Name:   "しまなみ2日間",
+			Stages: []TourStageInputDto{{RouteID: testRouteID2, OvernightStop: "今治"}, {RouteID: testRouteID1}},
+		})
+		if err != nil {
+			t.Fatalf("UpdateTour() error = %v", err)
+		}
+		if got.Name != "しまなみ2日間" || got.Distance != 100000 || got.Stages[0].OvernightStop != "今治" {
+			t.Errorf("UpdateTour() = %+v", got)
+		}
+	})
+
+	t.Run("異常系: 他のユーザーのツアー", func(t *testing.T) {
+		t.Parallel()
+		m := setupTourMocks(t)
+		m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.tourRepo.EXPECT().GetTourByID(gomock.Any(), testTourID).Return(createTestTour(testOtherUserID, 1, testRouteID1), nil)
+
+		_, err := m.usecase.UpdateTour(context.Background(), testKratosID, testTourID, TourInputDto{
+			Name:   "しまなみ",
+			Stages: []TourStageInputDto{{RouteID: testRouteID1}},
+		})
+		if !errors.Is(err, domainerror.ErrUnauthorized) {
+			t.Errorf("UpdateTour() error = %v, want ErrUnauthorized", err)
+		}
+	})
+}
+
+func Test_tourUsecase_ExportTour(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: GPXはステージごとのトラックと宿泊地のウェイポイントにする", func(t *testing.T) {
+		t.Parallel()
+		m := setupTourMocks(t)
+		m.userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+		m.tourRepo.EXPECT().GetTourByID(gomock.Any(), testTourID).Return(createTestTour(testUserID, 0, testRouteID1, testRouteID2), nil)
+		m.routeRepo.EXPECT().GetRoutesByIDs(gomock.Any(), gomock.Any()).Return(testRoutes(), nil)
+		m.userRepo.EXPECT().GetUserByID(gomock.Any(), testUserID).Return(createTestUser(), nil)
+
+		got, err := m.usecase.ExportTour(context.Background(), ExportTourInputDto{TourID: testTourID, KratosID: testKratosID, Format: ExportFormatGPX})
+		if err != nil {
+			t.Fatalf("ExportTour() error = %v", err)
+		}
+		xml := string(got)
+		if strings.Count(xml, "<trk>") != 2 || strings.Count(xml, "<wpt ") != 1 || !strings.Contains(xml, "尾道のゲストハウス") {
+			t.Errorf("ExportTour() = %s", xml)
+		}
+	})
+
+	t.Run("正常系: FITは未ログインでも見られるステージだけを書き出す", func(t *testing.T) {
+		t.Parallel()
+		m := setupTourMocks(t)
+		m.tourRepo.EXPECT().GetTourByID(gomock.Any(), testTourID).Return(createTestTour(testUserID, 1, testRouteID1, testRouteID2), nil)
+		m.routeRepo.EXPECT().GetRoutesByIDs(gomock.Any(), gomock.Any()).Return(testRoutes(), nil)
+
+		got, err := m.usecase.ExportTour(context.Background(), ExportTourInputDto{TourID: testTourID, Format: ExportFormatFIT})
+		if err != nil {
+			t.Fatalf("ExportTour() error = %v", err)
+		}
+		if len(got) < 14 || string(got[8:12]) != ".FIT" {
+			t.Errorf("ExportTour() header = %q", got[:min(len(got), 14)])
+		}
+	})
+
+	t.Run("異常系: 見られるステージがない", func(t *testing.T) {
+		t.Parallel()
+		m := setupTourMocks(t)
+		m.tourRepo.EXPECT().GetTourByID(gomock.Any(), testTourID).Return(createTestTour(testUserID, 1, testRouteID2), nil)
+		m.routeRepo.EXPECT().GetRoutesByIDs(gomock.Any(), gomock.Any()).Return(testRoutes(), nil)
+
+		_, err := m.usecase.ExportTour(context.Background(), ExportTourInputDto{TourID: testTourID, Format: ExportFormatFIT})
+		if !errors.Is(err, domainerror.ErrNotFound) {
+			t.Errorf("ExportTour() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("異常系: 未対応の形式", func(t *testing.T) {
+		t.Parallel()
+		m := setupTourMocks(t)
+
+		_, err := m.usecase.ExportTour(context.Background(), ExportTourInputDto{TourID: testTourID, Format: "kml"})
+		if !errors.Is(err, domainerror.ErrValidation) {
+			t.Errorf("ExportTour() error = %v, want ErrValidation", err)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package trip
+
+import (
+	"context"
+	"time"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	feedDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/feed"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	routeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/route"
+	tripDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/database/dbgen"
+	"github.com/YukiAminaka/cycle-route-backend/internal/infrastructure/repository"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	gpxpkg "github.com/YukiAminaka/cycle-route-backend/internal/pkg/gpx"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+)
+
+// tripCursorSort はトリップ一覧のカーソルに入れる並び順。トリップは新しい順だけ
+const tripCursorSort = "newest"
+
+// ITripUsecase はログインユーザーのトリップを扱う
+type ITripUsecase interface {
+	ListTrips(ctx context.Context, kratosID string, dto ListTripsInputDto) (*TripListOutputDto, error)
+	UploadTrip(ctx context.Context, kratosID string, dto UploadTripInputDto) (*TripOutputDto, error)
+}
+
+type tripUsecase struct {
+	userRepo  userDomain.IUserRepository
+	tripRepo  tripDomain.ITripRepository
+	txManager transactionApp.TransactionManager
+}
+
+func NewTripUsecase(userRepo userDomain.IUserRepository, tripRepo tripDomain.ITripRepository, txManager transactionApp.TransactionManager) ITripUsecase {
+	return &tripUsecase{
+		userRepo:  userRepo,
+		tripRepo:  tripRepo,
+		txManager: txManager,
+	}
+}
+
+type ListTripsInputDto struct {
+	Limit  int32  // 0の場合は既定値
+	Cursor string // 前のページのNextCursor。空の場合は先頭から
+}
+
+type TripListOutputDto struct {
+	Trips      []TripOutputDto
+	NextCursor string // 次のページがない場合は空文字
+}
+
+type UploadTripInputDto struct {
+	GPX         []byte
+	Name        string // 空の場合はGPXに記録された名前
+	Description string
+	Visibility  int16 // トリップはクラブに共有できないため、クラブのメンバーのみは指定できない
+}
+
+type TripOutputDto struct {
+	ID            string
+	Name          string
+	Description   string
+	Visibility    int16
+	Distance      *float64 // 計測値を求めていない場合はnil
+	Duration      *int32
+	MovingTime    *int32
+	ElevationGain *float64
+	ElevationLoss *float64
+	DepartedAt    *string
+	CreatedAt     string
+}
+
+// ListTrips はログインユーザーのトリップを作成日時の新しい順に返す
+func (u *tripUsecase) ListTrips(ctx context.Context, kratosID string, dto ListTripsInputDto) (*TripListOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := pagination.NormalizeLimit(dto.Limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeCursor(dto.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	criteria, err := tripDomain.NewTripListCriteria(userEntity.ID().String(), limit, after)
+	if err != nil {
+		return nil, err
+	}
+	page, err := u.tripRepo.GetTripsByUserID(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	trips := make([]TripOutputDto, 0, len(page.Items))
+	for _, t := range page.Items {
+		trips = append(trips, toTripOutputDto(t))
+	}
+
+	output := &TripListOutputDto{Trips: trips}
+	if page.Next != nil {
+		output.NextCursor = cursor.Encode(tripCursorSort, page.Next.SortKey(), page.Next.ID())
+	}
+	return output, nil
+}
+
+// UploadTrip はGPXのトラックからトリップを作成し、フォロワーのフィードに表示する出来事を同じトランザクションで記録する
+func (u *tripUsecase) UploadTrip(ctx context.Context, kratosID string, dto UploadTripInputDto) (*TripOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+	if err := routeDomain.CheckVisibility(routeDomain.Viewer{}, dto.Visibility, nil); err != nil {
+		return nil, err
+	}
+
+	track, err := gpxpkg.ParseTrack(dto.GPX)
+	if err != nil {
+		return nil, domainerror.New(err.Error(), domainerror.ErrValidation)
+	}
+	name := dto.Name
+	if name == "" {
+		name = track.Name
+	}
+
+	trip, err := tripDomain.NewTrip(userEntity.ID().String(), name, dto.Description, dto.Visibility, 0)
+	if err != nil {
+		return nil, err
+	}
+	var departedAt *string
+	if track.DepartedAt != nil {
+		departedAt = new(track.DepartedAt.Format(time.RFC3339))
+	}
+	err = trip.SetMetrics(
+		&tripDomain.Geometry{Geometry: track.Path},
+		&tripDomain.Geometry{Geometry: track.Path[0]},
+		&tripDomain.Geometry{Geometry: track.Path[len(track.Path)-1]},
+		&tripDomain.Geometry{Geometry: track.Path.Bound().ToPolygon()},
+		&track.Distance, track.Duration, track.MovingTime,
+		track.ElevationGain, track.ElevationLoss,
+		track.AvgSpeed, track.MaxSpeed,
+		departedAt, nil, nil, nil, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	event, err := feedDomain.NewEvent(feedDomain.EventTypeTripUploaded, trip.UserID(), trip.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(q *dbgen.Queries) error {
+		if err := repository.NewTripRepository(q).SaveTrip(ctx, trip); err != nil {
+			return err
+		}
+		return repository.NewFeedRepository(q).SaveEvent(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := toTripOutputDto(trip)
+	return &output, nil
+}
+
+func toTripOutputDto(t *tripDomain.Trip) TripOutputDto {
+	return TripOutputDto{
+		ID:            t.ID(),
+		Name:          t.Name(),
+		Description:   t.Description(),
+		Visibility:    t.Visibility(),
+		Distance:      t.Distance(),
+		Duration:      t.Duration(),
+		MovingTime:    t.MovingTime(),
+		ElevationGain: t.ElevationGain(),
+		ElevationLoss: t.ElevationLoss(),
+		DepartedAt:    t.DepartedAt(),
+		CreatedAt:     t.CreatedAt(),
+	}
+}
+
+func decodeCursor(token string) (*pagination.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	sort, sortKey, id, err := cursor.Decode(token)
+	if err != nil || sort != tripCursorSort {
+		return nil, domainerror.New("invalid cursor", domainerror.ErrValidation)
+	}
+	return pagination.NewCursor(sortKey, id)
+}
@@ -0,0 +1,194 @@
+package trip
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/pagination"
+	tripDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/trip"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/YukiAminaka/cycle-route-backend/internal/pkg/cursor"
+	transactionApp "github.com/YukiAminaka/cycle-route-backend/internal/usecase/transaction"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserID   = "019b5a8d-16a7-700a-be92-9ae11e7e5b9a"
+	testKratosID = "2eb50f70-3a23-4067-99f6-9fd645686880"
+	testTripID   = "019b5a51-0000-7000-8000-000000000001"
+)
+
+func createTestUser() *userDomain.User {
+	user, _ := userDomain.ReconstructUser(
+		userDomain.UserID(testUserID),
+		testKratosID,
+		"Test User",
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, false,
+	)
+	return user
+}
+
+func Test_tripUsecase_ListTrips(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		input          ListTripsInputDto
+		wantLimit      int32
+		wantAfterID    string
+		hasNext        bool
+		wantNextCursor string
+		wantErr        error
+	}{
+		{
+			name:           "正常系: 次のページがある場合はカーソルを返す",
+			input:          ListTripsInputDto{},
+			wantLimit:      pagination.DefaultLimit,
+			hasNext:        true,
+			wantNextCursor: cursor.Encode(tripCursorSort, 1719824400, testTripID),
+		},
+		{
+			name:        "正常系: 前のページの続きから取得する",
+			input:       ListTripsInputDto{Limit: 5, Cursor: cursor.Encode(tripCursorSort, 1719824400, testTripID)},
+			wantLimit:   5,
+			wantAfterID: testTripID,
+		},
+		{name: "異常系: 不正なカーソル", input: ListTripsInputDto{Cursor: "invalid"}, wantErr: domainerror.ErrValidation},
+		{name: "異常系: 他の一覧のカーソル", input: ListTripsInputDto{Cursor: cursor.Encode("saved_at", 1719824400, testTripID)}, wantErr: domainerror.ErrValidation},
+		{name: "異常系: 上限を超える件数", input: ListTripsInputDto{Limit: pagination.MaxLimit + 1}, wantErr: domainerror.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			tripRepo := tripDomain.NewMockITripRepository(ctrl)
+			uc := NewTripUsecase(userRepo, tripRepo, transactionApp.NewMockTransactionManager(ctrl))
+
+			userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			if tt.wantErr == nil {
+				tripRepo.EXPECT().GetTripsByUserID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *tripDomain.TripListCriteria) (*tripDomain.TripPage, error) {
+					if c.UserID() != testUserID || c.Limit() != tt.wantLimit {
+						t.Errorf("GetTripsByUserID() criteria = %+v", c)
+					}
+					if (tt.wantAfterID == "") != (c.After() == nil) || (c.After() != nil && c.After().ID() != tt.wantAfterID) {
+						t.Errorf("GetTripsByUserID() after = %+v, want %q", c.After(), tt.wantAfterID)
+					}
+					trip, _ := tripDomain.NewTrip(testUserID, "朝のライド", "", 1, 0)
+					page := &tripDomain.TripPage{Items: []*tripDomain.Trip{trip}}
+					if tt.hasNext {
+						page.Next, _ = pagination.NewCursor(1719824400, testTripID)
+					}
+					return page, nil
+				})
+			}
+
+			got, err := uc.ListTrips(context.Background(), testKratosID, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ListTrips() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(got.Trips) != 1 || got.Trips[0].Name != "朝のライド" {
+				t.Errorf("ListTrips() trips = %+v", got.Trips)
+			}
+			if got.NextCursor != tt.wantNextCursor {
+				t.Errorf("NextCursor = %q, want %q", got.NextCursor, tt.wantNextCursor)
+			}
+		})
+	}
+}
+
+// testGPX は時刻と標高を記録した3点のトラック。東へ約180m進んで5m上る
+const testGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <metadata><name>朝のライド</name></metadata>
+  <trk><trkseg>
+    <trkpt lat="35.6800" lon="139.7500"><ele>10</ele><time>2024-03-01T06:00:00Z</time></trkpt>
+    <trkpt lat="35.6800" lon="139.7510"><ele>12</ele><time>2024-03-01T06:00:20Z</time></trkpt>
+    <trkpt lat="35.6800" lon="139.7520"><ele>15</ele><time>2024-03-01T06:00:40Z</time></trkpt>
+  </trkseg></trk>
+</gpx>`
+
+// testGPXWithoutTime は時刻と標高のないトラック
+const testGPXWithoutTime = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk><name>トラック</name><trkseg>
+    <trkpt lat="35.6800" lon="139.7500"></trkpt>
+    <trkpt lat="35.6800" lon="139.7520"></trkpt>
+  </trkseg></trk>
+</gpx>`
+
+func Test_tripUsecase_UploadTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        UploadTripInputDto
+		wantName     string
+		wantDuration *int32
+		wantMetrics  bool // 時刻と標高から求める計測値があるか
+		wantErr      error
+	}{
+		{
+			name:         "正常系: GPXの名前と計測値でトリップを作成する",
+			input:        UploadTripInputDto{GPX: []byte(testGPX), Visibility: 1},
+			wantName:     "朝のライド",
+			wantDuration: new(int32(40)),
+			wantMetrics:  true,
+		},
+		{
+			name:     "正常系: 指定した名前を使い、時刻と標高がない計測値は求めない",
+			input:    UploadTripInputDto{GPX: []byte(testGPXWithoutTime), Name: "夕方のライド"},
+			wantName: "夕方のライド",
+		},
+		{name: "異常系: GPXではない", input: UploadTripInputDto{GPX: []byte("not gpx")}, wantErr: domainerror.ErrValidation},
+		{
+			name:    "異常系: トラックの点が足りない",
+			input:   UploadTripInputDto{GPX: []byte(`<gpx version="1.1"><trk><trkseg><trkpt lat="35.68" lon="139.75"></trkpt></trkseg></trk></gpx>`)},
+			wantErr: domainerror.ErrValidation,
+		},
+		{name: "異常系: トリップはクラブに共有できない", input: UploadTripInputDto{GPX: []byte(testGPX), Visibility: 3}, wantErr: domainerror.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			txManager := transactionApp.NewMockTransactionManager(ctrl)
+			uc := NewTripUsecase(userRepo, tripDomain.NewMockITripRepository(ctrl), txManager)
+
+			userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUser(), nil)
+			if tt.wantErr == nil {
+				// トリップとフィードの出来事は同じトランザクションで保存する
+				txManager.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			got, err := uc.UploadTrip(context.Background(), testKratosID, tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UploadTrip() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.Name != tt.wantName || got.Visibility != tt.input.Visibility {
+				t.Errorf("UploadTrip() = %+v", got)
+			}
+			if got.Distance == nil || *got.Distance < 170 || *got.Distance > 190 {
+				t.Errorf("Distance = %v, want about 180", got.Distance)
+			}
+			if (got.Duration == nil) != (tt.wantDuration == nil) || (got.Duration != nil && *got.Duration != *tt.wantDuration) {
+				t.Errorf("Duration = %v, want %v", got.Duration, tt.wantDuration)
+			}
+			if hasMetrics := got.ElevationGain != nil && got.DepartedAt != nil && got.MovingTime != nil; hasMetrics != tt.wantMetrics {
+				t.Errorf("ElevationGain = %v, DepartedAt = %v, MovingTime = %v, want metrics %v", got.ElevationGain, got.DepartedAt, got.MovingTime, tt.wantMetrics)
+			}
+			if tt.wantMetrics && *got.DepartedAt != "2024-03-01T06:00:00Z" {
+				t.Errorf("DepartedAt = %s", *got.DepartedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+	"log"
+
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+)
+
+// IFollowUsecase はユーザーのフォローを扱う。フォローしたユーザーの出来事がフィードに表示される
+type IFollowUsecase interface {
+	FollowUser(ctx context.Context, kratosID string, userID string) error
+	UnfollowUser(ctx context.Context, kratosID string, userID string) error
+}
+
+type followUsecase struct {
+	userRepo   userDomain.IUserRepository
+	followRepo userDomain.IFollowRepository
+	publisher  notificationDomain.Publisher
+}
+
+func NewFollowUsecase(userRepo userDomain.IUserRepository, followRepo userDomain.IFollowRepository, publisher notificationDomain.Publisher) IFollowUsecase {
+	return &followUsecase{
+		userRepo:   userRepo,
+		followRepo: followRepo,
+		publisher:  publisher,
+	}
+}
+
+func (u *followUsecase) FollowUser(ctx context.Context, kratosID string, userID string) error {
+	follower, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+	followee, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	follow, err := userDomain.NewFollow(follower.ID().String(), followee.ID().String())
+	if err != nil {
+		return err
+	}
+	if err := u.followRepo.SaveFollow(ctx, follow); err != nil {
+		return err
+	}
+
+	// 通知に失敗してもフォローは取り消さない
+	activity := notificationDomain.NewFollowActivity(follow.FollowerID(), follow.FolloweeID())
+	if err := u.publisher.Publish(ctx, activity); err != nil {
+		log.Printf("failed to publish %s notification to %s: %v\n", activity.Type(), activity.RecipientID(), err)
+	}
+	return nil
+}
+
+func (u *followUsecase) UnfollowUser(ctx context.Context, kratosID string, userID string) error {
+	follower, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+	return u.followRepo.DeleteFollow(ctx, follower.ID().String(), userID)
+}
@@ -0,0 +1,116 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	notificationDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/notification"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_followUsecase_FollowUser(t *testing.T) {
+	t.Parallel()
+
+	const followeeID = "019b5a46-1e77-7b9d-ac62-b438a0fc89cb"
+	tests := []struct {
+		name     string
+		userID   string
+		mockFunc func(userRepo *userDomain.MockIUserRepository, followRepo *userDomain.MockIFollowRepository, publisher *notificationDomain.MockPublisher)
+		wantErr  error
+	}{
+		{
+			name:   "正常系: ユーザーをフォローする",
+			userID: followeeID,
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, followRepo *userDomain.MockIFollowRepository, publisher *notificationDomain.MockPublisher) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), followeeID).Return(createTestUserByID(followeeID), nil)
+				followRepo.EXPECT().SaveFollow(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f *userDomain.Follow) error {
+					if f.FollowerID() != testUserID || f.FolloweeID() != followeeID {
+						t.Errorf("SaveFollow() follow = %+v", f)
+					}
+					return nil
+				})
+				publisher.EXPECT().Publish(gomock.Any(), notificationDomain.NewFollowActivity(testUserID, followeeID)).Return(nil)
+			},
+		},
+		{
+			name:   "正常系: 通知に失敗してもフォローは成功する",
+			userID: followeeID,
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, followRepo *userDomain.MockIFollowRepository, publisher *notificationDomain.MockPublisher) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), followeeID).Return(createTestUserByID(followeeID), nil)
+				followRepo.EXPECT().SaveFollow(gomock.Any(), gomock.Any()).Return(nil)
+				publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+		},
+		{
+			name:   "異常系: 自分自身はフォローできない",
+			userID: testUserID,
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, followRepo *userDomain.MockIFollowRepository, publisher *notificationDomain.MockPublisher) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), testUserID).Return(createTestUserByID(testUserID), nil)
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name:   "異常系: 存在しないユーザー",
+			userID: followeeID,
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, followRepo *userDomain.MockIFollowRepository, publisher *notificationDomain.MockPublisher) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), followeeID).Return(nil, domainerror.New("user not found", domainerror.ErrNotFound))
+			},
+			wantErr: domainerror.ErrNotFound,
+		},
+		{
+			name:   "異常系: フォロー済み",
+			userID: followeeID,
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, followRepo *userDomain.MockIFollowRepository, publisher *notificationDomain.MockPublisher) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), followeeID).Return(createTestUserByID(followeeID), nil)
+				followRepo.EXPECT().SaveFollow(gomock.Any(), gomock.Any()).Return(domainerror.New("user already followed", domainerror.ErrConflict))
+			},
+			wantErr: domainerror.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			followRepo := userDomain.NewMockIFollowRepository(ctrl)
+			publisher := notificationDomain.NewMockPublisher(ctrl)
+			tt.mockFunc(userRepo, followRepo, publisher)
+
+			uc := NewFollowUsecase(userRepo, followRepo, publisher)
+			err := uc.FollowUser(context.Background(), testKratosID, tt.userID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("FollowUser() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FollowUser() error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_followUsecase_UnfollowUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userRepo := userDomain.NewMockIUserRepository(ctrl)
+	followRepo := userDomain.NewMockIFollowRepository(ctrl)
+	userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+	followRepo.EXPECT().DeleteFollow(gomock.Any(), testUserID, "019b5a46-1e77-7b9d-ac62-b438a0fc89cb").
+		Return(domainerror.New("follow not found", domainerror.ErrNotFound))
+
+	uc := NewFollowUsecase(userRepo, followRepo, notificationDomain.NewMockPublisher(ctrl))
+	err := uc.UnfollowUser(context.Background(), testKratosID, "019b5a46-1e77-7b9d-ac62-b438a0fc89cb")
+	if !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("UnfollowUser() error = %v, want not found", err)
+	}
+}
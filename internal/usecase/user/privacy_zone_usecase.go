@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+)
+
+type IPrivacyZoneUsecase interface {
+	ListPrivacyZones(ctx context.Context, kratosID string) ([]PrivacyZoneOutputDto, error)
+	CreatePrivacyZone(ctx context.Context, kratosID string, dto CreatePrivacyZoneInputDto) (*PrivacyZoneOutputDto, error)
+	DeletePrivacyZone(ctx context.Context, kratosID string, zoneID string) error
+}
+
+type privacyZoneUsecase struct {
+	userRepo        userDomain.IUserRepository
+	privacyZoneRepo userDomain.IPrivacyZoneRepository
+}
+
+func NewPrivacyZoneUsecase(userRepo userDomain.IUserRepository, privacyZoneRepo userDomain.IPrivacyZoneRepository) IPrivacyZoneUsecase {
+	return &privacyZoneUsecase{
+		userRepo:        userRepo,
+		privacyZoneRepo: privacyZoneRepo,
+	}
+}
+
+type CreatePrivacyZoneInputDto struct {
+	Name    string
+	Center  orb.Point
+	RadiusM float64
+}
+
+type PrivacyZoneOutputDto struct {
+	ID        string
+	Name      string
+	Center    orb.Point
+	RadiusM   float64
+	CreatedAt string
+}
+
+func (u *privacyZoneUsecase) ListPrivacyZones(ctx context.Context, kratosID string) ([]PrivacyZoneOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := u.privacyZoneRepo.ListPrivacyZones(ctx, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]PrivacyZoneOutputDto, len(zones))
+	for i, z := range zones {
+		outputs[i] = toPrivacyZoneOutputDto(z)
+	}
+	return outputs, nil
+}
+
+func (u *privacyZoneUsecase) CreatePrivacyZone(ctx context.Context, kratosID string, dto CreatePrivacyZoneInputDto) (*PrivacyZoneOutputDto, error) {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	zones, err := u.privacyZoneRepo.ListPrivacyZones(ctx, userEntity.ID().String())
+	if err != nil {
+		return nil, err
+	}
+	if len(zones) >= userDomain.MaxPrivacyZonesPerUser {
+		return nil, domainerror.New(fmt.Sprintf("privacy zones must be at most %d", userDomain.MaxPrivacyZonesPerUser), domainerror.ErrValidation)
+	}
+
+	zone, err := userDomain.NewPrivacyZone(userEntity.ID().String(), dto.Name, dto.Center, dto.RadiusM)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.privacyZoneRepo.SavePrivacyZone(ctx, zone); err != nil {
+		return nil, err
+	}
+
+	output := toPrivacyZoneOutputDto(zone)
+	return &output, nil
+}
+
+func (u *privacyZoneUsecase) DeletePrivacyZone(ctx context.Context, kratosID string, zoneID string) error {
+	userEntity, err := u.userRepo.GetUserByKratosID(ctx, kratosID)
+	if err != nil {
+		return err
+	}
+	return u.privacyZoneRepo.DeletePrivacyZone(ctx, userEntity.ID().String(), zoneID)
+}
+
+func toPrivacyZoneOutputDto(z *userDomain.PrivacyZone) PrivacyZoneOutputDto {
+	return PrivacyZoneOutputDto{
+		ID:        z.ID().String(),
+		Name:      z.Name(),
+		Center:    z.Center(),
+		RadiusM:   z.RadiusM(),
+		CreatedAt: z.CreatedAt(),
+	}
+}
@@ -0,0 +1,97 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_privacyZoneUsecase_CreatePrivacyZone(t *testing.T) {
+	t.Parallel()
+
+	full := make([]*userDomain.PrivacyZone, userDomain.MaxPrivacyZonesPerUser)
+	for i := range full {
+		full[i] = userDomain.ReconstructPrivacyZone(fmt.Sprintf("zone-%d", i), testUserID, "", orb.Point{139.7, 35.68}, 200, "")
+	}
+
+	tests := []struct {
+		name     string
+		input    CreatePrivacyZoneInputDto
+		mockFunc func(userRepo *userDomain.MockIUserRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository)
+		wantErr  error
+	}{
+		{
+			name:  "正常系: プライバシーゾーンを作成する",
+			input: CreatePrivacyZoneInputDto{Name: " 自宅 ", Center: orb.Point{139.7, 35.68}, RadiusM: 200},
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+				zoneRepo.EXPECT().ListPrivacyZones(gomock.Any(), testUserID).Return(nil, nil)
+				zoneRepo.EXPECT().SavePrivacyZone(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:  "異常系: 半径が小さすぎる",
+			input: CreatePrivacyZoneInputDto{Center: orb.Point{139.7, 35.68}, RadiusM: 10},
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+				zoneRepo.EXPECT().ListPrivacyZones(gomock.Any(), testUserID).Return(nil, nil)
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+		{
+			name:  "異常系: 上限の数まで登録済み",
+			input: CreatePrivacyZoneInputDto{Center: orb.Point{139.7, 35.68}, RadiusM: 200},
+			mockFunc: func(userRepo *userDomain.MockIUserRepository, zoneRepo *userDomain.MockIPrivacyZoneRepository) {
+				userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+				zoneRepo.EXPECT().ListPrivacyZones(gomock.Any(), testUserID).Return(full, nil)
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			zoneRepo := userDomain.NewMockIPrivacyZoneRepository(ctrl)
+			tt.mockFunc(userRepo, zoneRepo)
+
+			uc := NewPrivacyZoneUsecase(userRepo, zoneRepo)
+			got, err := uc.CreatePrivacyZone(context.Background(), testKratosID, tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("CreatePrivacyZone() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreatePrivacyZone() error = %v", err)
+			}
+			if got.ID == "" || got.Name != "自宅" || got.RadiusM != 200 {
+				t.Errorf("CreatePrivacyZone() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_privacyZoneUsecase_DeletePrivacyZone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userRepo := userDomain.NewMockIUserRepository(ctrl)
+	zoneRepo := userDomain.NewMockIPrivacyZoneRepository(ctrl)
+	userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+	// 他のユーザーのゾーンは見つからない扱い
+	zoneRepo.EXPECT().DeletePrivacyZone(gomock.Any(), testUserID, "zone-1").
+		Return(domainerror.New("privacy zone not found", domainerror.ErrNotFound))
+
+	uc := NewPrivacyZoneUsecase(userRepo, zoneRepo)
+	if err := uc.DeletePrivacyZone(context.Background(), testKratosID, "zone-1"); !errors.Is(err, domainerror.ErrNotFound) {
+		t.Errorf("DeletePrivacyZone() error = %v, want ErrNotFound", err)
+	}
+}
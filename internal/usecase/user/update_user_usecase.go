@@ -1,9 +1,14 @@
 package user
 
 import (
+	"cmp"
 	"context"
+	"errors"
 
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	"github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
 	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
 )
 
 type IUpdateUserUsecase interface {
@@ -13,11 +18,13 @@ type IUpdateUserUsecase interface {
 
 type updateUserUsecase struct {
 	userRepo userDomain.IUserRepository
+	geocoder place.Geocoder
 }
 
-func NewUpdateUserUsecase(userRepo userDomain.IUserRepository) IUpdateUserUsecase {
+func NewUpdateUserUsecase(userRepo userDomain.IUserRepository, geocoder place.Geocoder) IUpdateUserUsecase {
 	return &updateUserUsecase{
 		userRepo: userRepo,
+		geocoder: geocoder,
 	}
 }
 
@@ -28,6 +35,7 @@ type UpdateUserUseCaseInputDto struct {
 	LastName    *string
 }
 
+// 地域・行政区・国コードは Geom から求め、求められなかった項目だけ指定した値を使う
 type UpdateUserLocationUseCaseInputDto struct {
 	Locality           string
 	AdministrativeArea string
@@ -59,7 +67,20 @@ func (u *updateUserUsecase) UpdateUserLocation(ctx context.Context, kratosID str
 		return err
 	}
 
-	if err := userEntity.SetLocation(dto.Locality, dto.AdministrativeArea, dto.CountryCode, dto.PostalCode, dto.Geom); err != nil {
+	locality, administrativeArea, countryCode := dto.Locality, dto.AdministrativeArea, dto.CountryCode
+	if point, ok := dto.Geom.Geometry.(orb.Point); ok {
+		p, err := u.geocoder.ReverseGeocode(ctx, point)
+		switch {
+		case err == nil:
+			locality = cmp.Or(p.Locality(), locality)
+			administrativeArea = cmp.Or(p.AdministrativeArea(), administrativeArea)
+			countryCode = cmp.Or(p.CountryCode(), countryCode)
+		case !errors.Is(err, domainerror.ErrNotFound):
+			return err
+		}
+	}
+
+	if err := userEntity.SetLocation(locality, administrativeArea, countryCode, dto.PostalCode, dto.Geom); err != nil {
 		return err
 	}
 
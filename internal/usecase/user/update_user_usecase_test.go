@@ -0,0 +1,114 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainerror "github.com/YukiAminaka/cycle-route-backend/internal/domain/error"
+	placeDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/place"
+	userDomain "github.com/YukiAminaka/cycle-route-backend/internal/domain/user"
+	"github.com/paulmach/orb"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_updateUserUsecase_UpdateUserLocation(t *testing.T) {
+	t.Parallel()
+
+	kyoto := orb.Point{135.768, 35.011}
+
+	tests := []struct {
+		name                   string
+		input                  UpdateUserLocationUseCaseInputDto
+		mockFunc               func(geocoder *placeDomain.MockGeocoder)
+		wantLocality           string
+		wantAdministrativeArea string
+		wantCountryCode        string
+		wantErr                error
+	}{
+		{
+			name:  "正常系: 地点から地名を求める",
+			input: UpdateUserLocationUseCaseInputDto{PostalCode: "604-8571", Geom: userDomain.Geometry{Geometry: kyoto}},
+			mockFunc: func(geocoder *placeDomain.MockGeocoder) {
+				geocoder.EXPECT().ReverseGeocode(gomock.Any(), kyoto).Return(placeDomain.ReconstructPlace("京都市", "京都府", "JP"), nil)
+			},
+			wantLocality:           "京都市",
+			wantAdministrativeArea: "京都府",
+			wantCountryCode:        "JP",
+		},
+		{
+			name: "正常系: 求められなかった項目は指定した値を使う",
+			input: UpdateUserLocationUseCaseInputDto{
+				Locality:   "中京区",
+				PostalCode: "604-8571",
+				Geom:       userDomain.Geometry{Geometry: kyoto},
+			},
+			mockFunc: func(geocoder *placeDomain.MockGeocoder) {
+				geocoder.EXPECT().ReverseGeocode(gomock.Any(), kyoto).Return(placeDomain.ReconstructPlace("", "京都府", "JP"), nil)
+			},
+			wantLocality:           "中京区",
+			wantAdministrativeArea: "京都府",
+			wantCountryCode:        "JP",
+		},
+		{
+			name: "正常系: 行政区域の外では指定した値を使う",
+			input: UpdateUserLocationUseCaseInputDto{
+				Locality:           testLocality,
+				AdministrativeArea: testAdministrativeArea,
+				CountryCode:        testCountryCode,
+				PostalCode:         testPostalCode,
+				Geom:               userDomain.Geometry{Geometry: kyoto},
+			},
+			mockFunc: func(geocoder *placeDomain.MockGeocoder) {
+				geocoder.EXPECT().ReverseGeocode(gomock.Any(), kyoto).Return(nil, domainerror.New("place not found", domainerror.ErrNotFound))
+			},
+			wantLocality:           testLocality,
+			wantAdministrativeArea: testAdministrativeArea,
+			wantCountryCode:        testCountryCode,
+		},
+		{
+			name:  "異常系: 地名が分からず指定もない",
+			input: UpdateUserLocationUseCaseInputDto{PostalCode: testPostalCode, Geom: userDomain.Geometry{Geometry: kyoto}},
+			mockFunc: func(geocoder *placeDomain.MockGeocoder) {
+				geocoder.EXPECT().ReverseGeocode(gomock.Any(), kyoto).Return(nil, domainerror.New("place not found", domainerror.ErrNotFound))
+			},
+			wantErr: domainerror.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			userRepo := userDomain.NewMockIUserRepository(ctrl)
+			geocoder := placeDomain.NewMockGeocoder(ctrl)
+			userRepo.EXPECT().GetUserByKratosID(gomock.Any(), testKratosID).Return(createTestUserByKratosID(testKratosID), nil)
+			tt.mockFunc(geocoder)
+
+			var saved *userDomain.User
+			if tt.wantErr == nil {
+				userRepo.EXPECT().UpdateUserLocation(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, u *userDomain.User) error {
+					saved = u
+					return nil
+				})
+			}
+
+			uc := NewUpdateUserUsecase(userRepo, geocoder)
+			err := uc.UpdateUserLocation(context.Background(), testKratosID, tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("UpdateUserLocation() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateUserLocation() error = %v", err)
+			}
+			if *saved.Locality() != tt.wantLocality || *saved.AdministrativeArea() != tt.wantAdministrativeArea || *saved.CountryCode() != tt.wantCountryCode {
+				t.Errorf("location = %s/%s/%s, want %s/%s/%s",
+					*saved.Locality(), *saved.AdministrativeArea(), *saved.CountryCode(),
+					tt.wantLocality, tt.wantAdministrativeArea, tt.wantCountryCode)
+			}
+		})
+	}
+}